@@ -312,6 +312,7 @@ type PollForDecisionTaskResponse struct {
 	PartitionConfig           *TaskListPartitionConfig     `protobuf:"bytes,19,opt,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty"`
 	LoadBalancerHints         *LoadBalancerHints           `protobuf:"bytes,20,opt,name=load_balancer_hints,json=loadBalancerHints,proto3" json:"load_balancer_hints,omitempty"`
 	AutoConfigHint            *v1.AutoConfigHint           `protobuf:"bytes,21,opt,name=auto_config_hint,json=autoConfigHint,proto3" json:"auto_config_hint,omitempty"`
+	HopTrace                  string                       `protobuf:"bytes,22,opt,name=hop_trace,json=hopTrace,proto3" json:"hop_trace,omitempty"`
 	XXX_NoUnkeyedLiteral      struct{}                     `json:"-"`
 	XXX_unrecognized          []byte                       `json:"-"`
 	XXX_sizecache             int32                        `json:"-"`
@@ -497,6 +498,13 @@ func (m *PollForDecisionTaskResponse) GetAutoConfigHint() *v1.AutoConfigHint {
 	return nil
 }
 
+func (m *PollForDecisionTaskResponse) GetHopTrace() string {
+	if m != nil {
+		return m.HopTrace
+	}
+	return ""
+}
+
 type PollForActivityTaskRequest struct {
 	Request              *v1.PollForActivityTaskRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
 	DomainId             string                         `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
@@ -596,6 +604,7 @@ type PollForActivityTaskResponse struct {
 	LoadBalancerHints          *LoadBalancerHints       `protobuf:"bytes,17,opt,name=load_balancer_hints,json=loadBalancerHints,proto3" json:"load_balancer_hints,omitempty"`
 	PartitionConfig            *TaskListPartitionConfig `protobuf:"bytes,19,opt,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty"`
 	AutoConfigHint             *v1.AutoConfigHint       `protobuf:"bytes,20,opt,name=auto_config_hint,json=autoConfigHint,proto3" json:"auto_config_hint,omitempty"`
+	HopTrace                   string                   `protobuf:"bytes,21,opt,name=hop_trace,json=hopTrace,proto3" json:"hop_trace,omitempty"`
 	XXX_NoUnkeyedLiteral       struct{}                 `json:"-"`
 	XXX_unrecognized           []byte                   `json:"-"`
 	XXX_sizecache              int32                    `json:"-"`
@@ -767,6 +776,13 @@ func (m *PollForActivityTaskResponse) GetAutoConfigHint() *v1.AutoConfigHint {
 	return nil
 }
 
+func (m *PollForActivityTaskResponse) GetHopTrace() string {
+	if m != nil {
+		return m.HopTrace
+	}
+	return ""
+}
+
 type AddDecisionTaskRequest struct {
 	DomainId               string                `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
 	WorkflowExecution      *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
@@ -2135,165 +2151,167 @@ func init() {
 }
 
 var fileDescriptor_826e827d3aabf7fc = []byte{
-	// 2528 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcb, 0x6f, 0x1c, 0x49,
-	0x19, 0x57, 0x8f, 0x3d, 0x7e, 0x7c, 0x63, 0x8f, 0xed, 0xb2, 0xd7, 0xe9, 0x4c, 0x62, 0xc7, 0x99,
-	0x6c, 0xb2, 0x5e, 0x58, 0xc6, 0x6b, 0xef, 0x26, 0x64, 0xb3, 0x62, 0x83, 0x1f, 0x71, 0x32, 0x68,
-	0x43, 0xb2, 0x1d, 0x6f, 0x22, 0xc1, 0x2a, 0x4d, 0x79, 0xba, 0xec, 0x69, 0xdc, 0xd3, 0xdd, 0xe9,
-	0xae, 0xb6, 0x77, 0xf6, 0xc0, 0x01, 0x01, 0x42, 0xe2, 0x0a, 0x77, 0x5e, 0xff, 0x04, 0x17, 0xce,
-	0x1c, 0x39, 0x22, 0xad, 0x90, 0x20, 0x12, 0x7f, 0x00, 0x48, 0xdc, 0x38, 0xa0, 0x7a, 0xf4, 0x4c,
-	0xf7, 0x4c, 0xf5, 0x3c, 0x6c, 0x27, 0xcb, 0x81, 0x9b, 0xbb, 0xea, 0x7b, 0xd5, 0xf7, 0xfa, 0x7d,
-	0x55, 0x63, 0xb8, 0x11, 0xed, 0x93, 0x60, 0xad, 0x86, 0x2d, 0xe2, 0xd6, 0xc8, 0x5a, 0x03, 0xd3,
-	0x5a, 0xdd, 0x76, 0x0f, 0xd7, 0x8e, 0xd7, 0xd7, 0x42, 0x12, 0x1c, 0xdb, 0x35, 0x52, 0xf1, 0x03,
-	0x8f, 0x7a, 0x48, 0x67, 0x74, 0x15, 0x49, 0x57, 0x89, 0xe9, 0x2a, 0xc7, 0xeb, 0xa5, 0xe5, 0x43,
-	0xcf, 0x3b, 0x74, 0xc8, 0x1a, 0xa7, 0xdb, 0x8f, 0x0e, 0xd6, 0xac, 0x28, 0xc0, 0xd4, 0xf6, 0x5c,
-	0xc1, 0x59, 0xba, 0xd2, 0xb9, 0x4f, 0xed, 0x06, 0x09, 0x29, 0x6e, 0xf8, 0x92, 0xa0, 0x4b, 0xc0,
-	0x49, 0x80, 0x7d, 0x9f, 0x04, 0xa1, 0xdc, 0x5f, 0x49, 0x99, 0x88, 0x7d, 0x9b, 0x59, 0x57, 0xf3,
-	0x1a, 0x8d, 0xb6, 0x0a, 0x15, 0xc5, 0x8b, 0x88, 0x04, 0x4d, 0x49, 0x50, 0x56, 0x11, 0x50, 0x1c,
-	0x1e, 0x39, 0x76, 0x48, 0x25, 0xcd, 0xaa, 0x8a, 0x46, 0x3a, 0xc1, 0x3c, 0xf1, 0x82, 0x23, 0x12,
-	0x48, 0xca, 0xaf, 0xf5, 0xa3, 0x3c, 0x70, 0xbc, 0x13, 0x49, 0x7b, 0x55, 0x45, 0x5b, 0xb7, 0x43,
-	0xea, 0xb5, 0x8c, 0x7b, 0x33, 0x45, 0x12, 0xd6, 0x71, 0x40, 0xac, 0x6e, 0xaa, 0xeb, 0x19, 0x54,
-	0xe9, 0x53, 0x94, 0x3f, 0x82, 0xb9, 0x3d, 0x1c, 0x1e, 0x7d, 0x6c, 0x87, 0xf4, 0x31, 0x0e, 0xa8,
-	0xcd, 0x02, 0x81, 0xde, 0x86, 0x59, 0x3b, 0xf4, 0x1c, 0x1e, 0x15, 0xf3, 0x30, 0xf0, 0x22, 0x3f,
-	0xd4, 0xb5, 0x95, 0x91, 0xd5, 0x49, 0x63, 0xa6, 0xb5, 0x7e, 0x9f, 0x2f, 0x97, 0xff, 0x3e, 0x0a,
-	0x17, 0xba, 0x04, 0x6c, 0x7b, 0xee, 0x81, 0x7d, 0x88, 0x74, 0x18, 0x3f, 0x26, 0x41, 0x68, 0x7b,
-	0xae, 0xae, 0xad, 0x68, 0xab, 0x23, 0x46, 0xfc, 0x89, 0x36, 0x60, 0xde, 0x8d, 0x1a, 0x66, 0x40,
-	0xb0, 0x65, 0xfa, 0x31, 0x57, 0xa8, 0xe7, 0x56, 0xb4, 0xd5, 0xfc, 0x56, 0x4e, 0xd7, 0x8c, 0x39,
-	0x37, 0x6a, 0x18, 0x04, 0x5b, 0x2d, 0x91, 0x21, 0x7a, 0x1f, 0x16, 0x18, 0xcf, 0x49, 0x60, 0x53,
-	0x92, 0x64, 0x1a, 0x69, 0x31, 0x21, 0x37, 0x6a, 0x3c, 0x63, 0xdb, 0x09, 0x2e, 0x17, 0x66, 0x3a,
-	0xb5, 0x8c, 0xae, 0x8c, 0xac, 0x16, 0x36, 0xee, 0x55, 0xb2, 0x32, 0xb4, 0x92, 0x71, 0x9e, 0x4a,
-	0xda, 0xa0, 0x7b, 0x2e, 0x0d, 0x9a, 0x46, 0x31, 0x48, 0x5b, 0xf9, 0x02, 0x66, 0xbb, 0x2c, 0xcc,
-	0x73, 0x85, 0xbb, 0xc3, 0x2b, 0xec, 0x38, 0x8c, 0xd0, 0x38, 0x73, 0x92, 0x5e, 0x2d, 0xb9, 0x30,
-	0xaf, 0xb0, 0x0c, 0xcd, 0xc2, 0xc8, 0x11, 0x69, 0x72, 0xcf, 0xe7, 0x0d, 0xf6, 0x27, 0xda, 0x84,
-	0xfc, 0x31, 0x76, 0x22, 0xc2, 0xfd, 0x5c, 0xd8, 0xf8, 0xfa, 0x10, 0x06, 0x19, 0x82, 0xf3, 0x4e,
-	0xee, 0xb6, 0x56, 0xf2, 0x60, 0x41, 0x65, 0xd8, 0x2b, 0x53, 0x58, 0xfe, 0x01, 0xcc, 0x7d, 0xec,
-	0x61, 0x6b, 0x0b, 0x3b, 0xd8, 0xad, 0x91, 0xe0, 0x81, 0xed, 0xd2, 0x10, 0x5d, 0x83, 0xe9, 0x7d,
-	0x5c, 0x3b, 0x72, 0xbc, 0x43, 0xb3, 0xe6, 0x45, 0x2e, 0x95, 0x29, 0x36, 0x25, 0x17, 0xb7, 0xd9,
-	0x1a, 0xba, 0x01, 0x33, 0x01, 0x66, 0xc1, 0x20, 0x81, 0x19, 0x92, 0x9a, 0xe7, 0x5a, 0xdc, 0x14,
-	0xcd, 0x98, 0x66, 0xcb, 0x8f, 0x49, 0xf0, 0x84, 0x2f, 0x96, 0xff, 0xa9, 0x41, 0xe9, 0xb1, 0xe7,
-	0x38, 0xbb, 0x5e, 0xb0, 0x43, 0x6a, 0x36, 0xcb, 0x51, 0x66, 0x91, 0x41, 0x5e, 0x44, 0x24, 0xa4,
-	0xa8, 0x0a, 0xe3, 0x81, 0xf8, 0x93, 0x6b, 0x29, 0x6c, 0xac, 0xa5, 0x4f, 0x82, 0x7d, 0x9b, 0x1d,
-	0x22, 0x5b, 0x82, 0x11, 0xf3, 0xa3, 0x4b, 0x30, 0x69, 0x79, 0x0d, 0x6c, 0xbb, 0xa6, 0x2d, 0x6c,
-	0x99, 0x34, 0x26, 0xc4, 0x42, 0xd5, 0x62, 0x9b, 0xbe, 0xe7, 0x38, 0x24, 0x60, 0x9b, 0x23, 0x62,
-	0x53, 0x2c, 0x54, 0x2d, 0x74, 0x1d, 0x8a, 0x07, 0x5e, 0x70, 0x82, 0x03, 0x8b, 0x58, 0xe6, 0x41,
-	0xe0, 0x35, 0xf4, 0x51, 0x4e, 0x31, 0xdd, 0x5a, 0xdd, 0x0d, 0xbc, 0x06, 0x7a, 0x0b, 0x66, 0x3a,
-	0x6a, 0x57, 0xcf, 0x73, 0xba, 0x62, 0xba, 0x74, 0xcb, 0x7f, 0x2c, 0xc0, 0x25, 0xa5, 0xc5, 0xa1,
-	0xef, 0xb9, 0x21, 0x41, 0x4b, 0x00, 0xac, 0x57, 0x98, 0xd4, 0x3b, 0x22, 0xa2, 0x80, 0xa7, 0x8c,
-	0x49, 0xb6, 0xb2, 0xc7, 0x16, 0xd0, 0xa7, 0x80, 0xe2, 0xd6, 0x65, 0x92, 0xcf, 0x49, 0x2d, 0x62,
-	0x92, 0x65, 0xa0, 0x6f, 0x28, 0xdd, 0xf3, 0x4c, 0x92, 0xdf, 0x8b, 0xa9, 0x8d, 0xb9, 0x93, 0xce,
-	0x25, 0xb4, 0x0b, 0xd3, 0x2d, 0xb1, 0xb4, 0xe9, 0x13, 0xee, 0x86, 0xc2, 0xc6, 0xd5, 0x9e, 0x12,
-	0xf7, 0x9a, 0x3e, 0x31, 0xa6, 0x4e, 0x12, 0x5f, 0xe8, 0x29, 0x5c, 0xf4, 0x03, 0x72, 0x6c, 0x7b,
-	0x51, 0x68, 0x86, 0x14, 0x07, 0x94, 0x58, 0x26, 0x39, 0x26, 0x2e, 0x65, 0xae, 0x1d, 0xe5, 0x32,
-	0x2f, 0x55, 0x04, 0x90, 0x54, 0x62, 0x20, 0xa9, 0x54, 0x5d, 0x7a, 0xeb, 0xfd, 0xa7, 0x2c, 0xef,
-	0x8c, 0xc5, 0x98, 0xfb, 0x89, 0x60, 0xbe, 0xc7, 0x78, 0xab, 0x16, 0x5a, 0x85, 0xd9, 0x2e, 0x71,
-	0x79, 0x9e, 0x79, 0xc5, 0x30, 0x4d, 0xa9, 0xc3, 0x38, 0xa6, 0x94, 0x34, 0x7c, 0xaa, 0x8f, 0xf1,
-	0x92, 0x88, 0x3f, 0x51, 0x19, 0xa6, 0x5d, 0xf2, 0x39, 0x6d, 0x0b, 0x18, 0xe7, 0x02, 0x0a, 0x6c,
-	0x31, 0xe6, 0x7e, 0x07, 0x50, 0x2a, 0xbd, 0xcd, 0xba, 0xed, 0x52, 0x7d, 0x82, 0x13, 0xce, 0x26,
-	0x73, 0x9c, 0x55, 0x03, 0xba, 0x0d, 0x7a, 0x48, 0xed, 0xda, 0x51, 0xb3, 0x1d, 0x0a, 0x93, 0xb8,
-	0x78, 0xdf, 0x21, 0x96, 0x3e, 0xb9, 0xa2, 0xad, 0x4e, 0x18, 0x8b, 0x62, 0xbf, 0xe5, 0xe8, 0x7b,
-	0x62, 0x17, 0xdd, 0x86, 0x3c, 0x07, 0x3e, 0x1d, 0xb8, 0x4f, 0xca, 0x3d, 0xfd, 0xfc, 0x09, 0xa3,
-	0x34, 0x04, 0x03, 0x32, 0x60, 0xda, 0x92, 0x79, 0x63, 0xda, 0xee, 0x81, 0xa7, 0x17, 0xb8, 0x84,
-	0x6f, 0xa4, 0x25, 0x08, 0xe0, 0xe1, 0x25, 0x1e, 0x60, 0x37, 0xb4, 0x89, 0x4b, 0xe3, 0x6c, 0xab,
-	0xba, 0x07, 0x9e, 0x31, 0x65, 0x25, 0xbe, 0xd0, 0x73, 0xb8, 0xdc, 0x9d, 0x54, 0x26, 0x4f, 0x43,
-	0x86, 0x59, 0xfa, 0x14, 0x57, 0xb1, 0xa4, 0x34, 0x32, 0x6e, 0x21, 0xc6, 0xc5, 0xae, 0xac, 0x8a,
-	0xb7, 0x50, 0x05, 0xe6, 0x85, 0xd3, 0x19, 0x52, 0x12, 0x33, 0x46, 0xa7, 0x69, 0x1e, 0x9f, 0x39,
-	0xbe, 0xf5, 0x84, 0xed, 0x3c, 0x95, 0x38, 0x75, 0x15, 0xa6, 0xf6, 0x03, 0xec, 0xd6, 0xea, 0xb2,
-	0x0a, 0x8a, 0xbc, 0x0a, 0x0a, 0x62, 0x4d, 0xd4, 0xc1, 0x26, 0x14, 0xc3, 0x5a, 0x9d, 0x58, 0x91,
-	0x43, 0x2c, 0x93, 0x8d, 0x2a, 0xfa, 0x0c, 0x37, 0xb2, 0xd4, 0x95, 0x5d, 0x7b, 0xf1, 0x1c, 0x63,
-	0x4c, 0xb7, 0x38, 0xd8, 0x1a, 0xfa, 0x16, 0x4c, 0xc5, 0x39, 0xc5, 0x05, 0xcc, 0xf6, 0x15, 0x50,
-	0x90, 0xf4, 0x9c, 0xfd, 0x33, 0x18, 0x67, 0x11, 0xb1, 0x49, 0xa8, 0xcf, 0x71, 0xa4, 0xd9, 0xca,
-	0xee, 0xb3, 0x3d, 0x0a, 0xbe, 0xf2, 0x89, 0x10, 0x22, 0x50, 0x26, 0x16, 0xc9, 0x5c, 0x46, 0x3d,
-	0x8a, 0x1d, 0x53, 0x8e, 0x17, 0xe6, 0x7e, 0x93, 0x92, 0x50, 0x47, 0x3c, 0x13, 0xe7, 0xf8, 0xd6,
-	0x03, 0xb1, 0xb3, 0xc5, 0x36, 0xd0, 0x67, 0x30, 0xdb, 0x82, 0x3e, 0xb3, 0xc6, 0x71, 0x4c, 0x9f,
-	0xe7, 0x07, 0x5a, 0x1f, 0x1a, 0x00, 0x8d, 0x19, 0xbf, 0x63, 0xa4, 0xf8, 0x3e, 0xcc, 0x3b, 0x1e,
-	0xb6, 0xcc, 0x7d, 0x89, 0x05, 0xbc, 0x2c, 0x42, 0x7d, 0xa1, 0x1f, 0xbe, 0x74, 0xe1, 0x87, 0x31,
-	0xe7, 0x74, 0x41, 0xca, 0x43, 0x98, 0xc5, 0x11, 0xf5, 0xa4, 0xd5, 0xa2, 0xe2, 0xde, 0xe0, 0x92,
-	0xaf, 0x29, 0x33, 0x6e, 0x33, 0xa2, 0x9e, 0xb0, 0x8b, 0xf1, 0x1b, 0x45, 0x9c, 0xfa, 0x2e, 0x3d,
-	0x87, 0xa9, 0xa4, 0x4b, 0x93, 0xf8, 0x38, 0x29, 0xf0, 0xf1, 0x76, 0x1a, 0x1f, 0x07, 0x2a, 0xbe,
-	0x36, 0x2c, 0x26, 0x40, 0x6b, 0xb3, 0x46, 0xed, 0x63, 0x9b, 0x36, 0x4f, 0x0f, 0x5a, 0x0a, 0x09,
-	0xff, 0x8b, 0xa0, 0xf5, 0x2b, 0x68, 0x81, 0x56, 0xda, 0xe2, 0xaf, 0x14, 0xb4, 0xae, 0x40, 0x01,
-	0x4b, 0x6b, 0xda, 0x4e, 0x80, 0x78, 0xa9, 0x6a, 0x31, 0x54, 0x6b, 0x11, 0x70, 0x54, 0x1b, 0xed,
-	0x81, 0x6a, 0xad, 0x83, 0x71, 0x54, 0xc3, 0x89, 0x2f, 0xb4, 0x01, 0x79, 0xdb, 0xf5, 0x23, 0xca,
-	0xbd, 0x53, 0xd8, 0xb8, 0xac, 0x8e, 0x28, 0x6e, 0xb2, 0xdc, 0x36, 0x04, 0xa9, 0xa2, 0x41, 0x8d,
-	0x9d, 0xb5, 0x41, 0x8d, 0x0f, 0xd7, 0xa0, 0xf6, 0xe0, 0x62, 0x2c, 0xcf, 0x64, 0xe5, 0xe5, 0x78,
-	0x21, 0xe1, 0x82, 0xbc, 0x48, 0x40, 0x5a, 0x61, 0xe3, 0x62, 0x97, 0xac, 0x1d, 0x79, 0x2b, 0x34,
-	0x16, 0x63, 0xde, 0x3d, 0x6f, 0x9b, 0x71, 0xee, 0x09, 0x46, 0xf4, 0x5d, 0x58, 0xe4, 0x4a, 0xba,
-	0x45, 0x4e, 0xf6, 0x13, 0x39, 0xcf, 0x19, 0x3b, 0xe4, 0xed, 0xc2, 0x5c, 0x9d, 0xe0, 0x80, 0xee,
-	0x13, 0x4c, 0x5b, 0xa2, 0xa0, 0x9f, 0xa8, 0xd9, 0x16, 0x4f, 0x2c, 0x27, 0x81, 0xfb, 0x85, 0x34,
-	0xee, 0x3f, 0x87, 0xe5, 0x74, 0x24, 0x4c, 0xef, 0xc0, 0xa4, 0x75, 0x3b, 0x34, 0x63, 0x86, 0xa9,
-	0xbe, 0x8e, 0x2d, 0xa5, 0x22, 0xf3, 0xe8, 0x60, 0xaf, 0x6e, 0x87, 0x9b, 0x52, 0x7e, 0x35, 0x79,
-	0x02, 0x8b, 0x50, 0x6c, 0x3b, 0x21, 0xc7, 0xb6, 0x7e, 0x99, 0xd2, 0x3e, 0xc4, 0x8e, 0xe0, 0xea,
-	0x1e, 0xc3, 0x8a, 0xa7, 0x1b, 0xc3, 0xde, 0x82, 0x99, 0x96, 0x1c, 0xd1, 0x31, 0x38, 0x3c, 0x4e,
-	0x1a, 0xc5, 0x78, 0x79, 0x87, 0xaf, 0xa2, 0xf7, 0x60, 0xac, 0x4e, 0xb0, 0x45, 0x02, 0x89, 0x7e,
-	0x97, 0x94, 0x9a, 0x1e, 0x70, 0x12, 0x43, 0x92, 0x66, 0xa1, 0xc1, 0xdc, 0xb9, 0xa0, 0xc1, 0xab,
-	0x05, 0x32, 0x15, 0xd6, 0x2c, 0x9c, 0x1a, 0x6b, 0xca, 0x7f, 0x19, 0x85, 0xc5, 0x4d, 0xcb, 0x52,
-	0x5d, 0x5e, 0x52, 0xcd, 0x5b, 0xeb, 0x68, 0xde, 0xaf, 0xa8, 0x21, 0xde, 0x81, 0xc9, 0xf6, 0xd0,
-	0x36, 0x32, 0xc8, 0xd0, 0x36, 0x41, 0xe3, 0x19, 0xed, 0x0a, 0x14, 0x5a, 0xdd, 0x42, 0xce, 0xea,
-	0x23, 0x06, 0xc4, 0x4b, 0x55, 0xab, 0xb3, 0x9d, 0xc8, 0x26, 0x20, 0x0b, 0x36, 0x3f, 0x44, 0x3b,
-	0xe1, 0xa3, 0x7d, 0x5c, 0xb6, 0x77, 0x60, 0x2c, 0xf4, 0xa2, 0xa0, 0x26, 0xda, 0x63, 0xb1, 0x13,
-	0x8c, 0x13, 0x73, 0x2c, 0x0e, 0x8f, 0x9e, 0x70, 0x4a, 0x43, 0x72, 0x28, 0x50, 0x6e, 0x5c, 0x85,
-	0x72, 0xbe, 0x22, 0xa3, 0x26, 0xfa, 0x3d, 0x46, 0xa8, 0xa3, 0x5a, 0xe9, 0x48, 0x30, 0xf9, 0x34,
-	0xd0, 0x91, 0x65, 0xa5, 0x2d, 0x58, 0x50, 0x11, 0x2a, 0x46, 0x91, 0x85, 0xe4, 0x28, 0x32, 0x99,
-	0x1c, 0x33, 0x4e, 0xe0, 0x42, 0x97, 0x0d, 0x12, 0x6d, 0x55, 0x25, 0xa2, 0x9d, 0x57, 0x89, 0x94,
-	0xff, 0x95, 0xe7, 0x39, 0xad, 0x9a, 0x6d, 0xbe, 0x8a, 0x9c, 0x66, 0x37, 0x3f, 0x1e, 0x6e, 0xb3,
-	0xad, 0x5a, 0x20, 0x7d, 0x51, 0xac, 0xef, 0xc4, 0x06, 0xa4, 0xb2, 0x7f, 0xf4, 0x4c, 0xd9, 0x9f,
-	0x1f, 0x2e, 0xfb, 0xc7, 0xce, 0x9e, 0xfd, 0xe3, 0xe7, 0x90, 0xfd, 0x13, 0xaa, 0xec, 0x77, 0x41,
-	0xc7, 0x89, 0x50, 0xee, 0xd8, 0xa1, 0xcf, 0xb2, 0x82, 0xdd, 0xfb, 0x24, 0x62, 0x6f, 0xf4, 0xa8,
-	0x82, 0x0c, 0x4e, 0x23, 0x53, 0xa6, 0xb2, 0xda, 0x60, 0x80, 0x6a, 0x53, 0xe4, 0xdb, 0x6b, 0xac,
-	0xb6, 0x2f, 0x47, 0x40, 0xcf, 0x3a, 0x2c, 0xfa, 0x0e, 0xcc, 0xb4, 0x07, 0x08, 0x7e, 0x5b, 0x95,
-	0xe5, 0xa6, 0xc6, 0x65, 0x79, 0x2f, 0xe3, 0x4f, 0x0a, 0x46, 0x7b, 0x08, 0xe4, 0xdf, 0x5d, 0x33,
-	0x5d, 0x6e, 0xb8, 0x99, 0x2e, 0x31, 0xe5, 0x8c, 0x0c, 0x3b, 0xe5, 0x8c, 0x9e, 0xff, 0x94, 0x93,
-	0x3f, 0x9f, 0x29, 0x67, 0xec, 0xdc, 0xa6, 0x9c, 0x71, 0xd5, 0x94, 0x23, 0x7b, 0xa9, 0xf2, 0xe6,
-	0xf2, 0x6a, 0x7b, 0xe9, 0x97, 0x1a, 0x2c, 0xf0, 0x0b, 0x64, 0x7c, 0x8a, 0xb8, 0x93, 0x6e, 0x77,
-	0xde, 0x12, 0xdf, 0x56, 0x1e, 0x5e, 0xc5, 0x3b, 0xe0, 0xfd, 0xf0, 0x2c, 0xb3, 0xc0, 0x60, 0xd7,
-	0xc7, 0xf2, 0x7f, 0x34, 0x78, 0xa3, 0xc3, 0x42, 0xe9, 0xd5, 0xbb, 0x30, 0xc5, 0x5f, 0xab, 0xcc,
-	0x80, 0x84, 0x91, 0x13, 0x9f, 0xb1, 0x77, 0x9e, 0x14, 0x38, 0x87, 0xc1, 0x19, 0x50, 0x15, 0x8a,
-	0xb1, 0x80, 0x1f, 0x92, 0x1a, 0x25, 0x56, 0xcf, 0xbb, 0xba, 0xb8, 0xa3, 0x4b, 0x4a, 0x63, 0xfa,
-	0x45, 0xf2, 0x13, 0x3d, 0x53, 0x44, 0x58, 0xf8, 0xe3, 0x9d, 0x9e, 0xfe, 0xe8, 0x1b, 0xdc, 0x7f,
-	0x68, 0xb0, 0x22, 0x4e, 0x6c, 0x71, 0x03, 0x18, 0xe3, 0xb6, 0xd7, 0xf0, 0x1d, 0xc2, 0xac, 0x90,
-	0x31, 0x7a, 0xd4, 0x19, 0xe8, 0x9b, 0x4a, 0xa5, 0xfd, 0xe4, 0xbc, 0x86, 0xa0, 0x5f, 0x80, 0x71,
-	0xce, 0x2b, 0x87, 0xbf, 0x49, 0x63, 0x8c, 0x7d, 0x56, 0xad, 0xf2, 0x35, 0xb8, 0xda, 0xc3, 0x3c,
-	0x11, 0xf1, 0xf2, 0x5f, 0x35, 0xb8, 0xbc, 0xcd, 0xc6, 0x78, 0xe7, 0x51, 0x44, 0x43, 0x8a, 0x5d,
-	0xcb, 0x76, 0x0f, 0x1f, 0x7b, 0x8e, 0x33, 0xd0, 0xec, 0x90, 0x7a, 0xcc, 0xc8, 0x75, 0x3c, 0x66,
-	0xdc, 0x87, 0x62, 0xeb, 0x50, 0xed, 0xc7, 0xe9, 0x62, 0x46, 0xbf, 0x88, 0x4f, 0x26, 0xfa, 0x05,
-	0x4d, 0x7c, 0x9d, 0x65, 0x40, 0x28, 0x5f, 0x81, 0xa5, 0x8c, 0xe3, 0x49, 0x07, 0xfc, 0x08, 0x2e,
-	0xec, 0x90, 0xb0, 0x16, 0xd8, 0xfb, 0xa4, 0xc5, 0x2e, 0x8f, 0xbe, 0xdb, 0x99, 0x03, 0xea, 0xc4,
-	0xcb, 0x60, 0x1f, 0x2c, 0xf4, 0xe5, 0x3f, 0xe4, 0x40, 0xef, 0x96, 0x20, 0xeb, 0xf1, 0x03, 0x18,
-	0x17, 0xee, 0x14, 0x3f, 0x28, 0x16, 0x36, 0xae, 0x64, 0x3e, 0x4a, 0x91, 0x80, 0x03, 0x7c, 0x4c,
-	0xcf, 0x6e, 0x4c, 0x6d, 0xef, 0x87, 0x14, 0xd3, 0x28, 0x94, 0xb5, 0x78, 0xad, 0xa7, 0xef, 0x9e,
-	0x70, 0x52, 0xa3, 0x48, 0x53, 0xdf, 0xaf, 0xac, 0x1a, 0xcf, 0x14, 0xdc, 0x10, 0x96, 0x78, 0x92,
-	0x74, 0xea, 0x0a, 0xe3, 0x08, 0x2e, 0xc2, 0x98, 0x04, 0x18, 0x91, 0xb9, 0xf2, 0x2b, 0xad, 0x34,
-	0x37, 0x9c, 0xd2, 0x9f, 0xe5, 0x60, 0x39, 0x4b, 0xab, 0x0c, 0xdb, 0x0b, 0x58, 0x6a, 0xbf, 0x5f,
-	0xb5, 0x82, 0x90, 0xf8, 0x89, 0x53, 0x04, 0xb3, 0x32, 0x98, 0xe7, 0x1e, 0x12, 0x8a, 0x2d, 0x4c,
-	0xb1, 0x51, 0x4a, 0x0e, 0x6f, 0x69, 0xd5, 0x4c, 0x65, 0xeb, 0xe7, 0x05, 0xa5, 0xca, 0xdc, 0xe9,
-	0x54, 0x5a, 0x89, 0x8b, 0x4c, 0x5a, 0x65, 0xf9, 0x26, 0x5c, 0xba, 0x4f, 0x5a, 0x6e, 0x08, 0xb7,
-	0x9a, 0x02, 0xb5, 0xfb, 0xf8, 0xbe, 0xfc, 0xfb, 0x51, 0xb8, 0xac, 0xe6, 0x93, 0xde, 0xfb, 0x89,
-	0x06, 0x8b, 0x8a, 0xb3, 0x34, 0xb0, 0x2f, 0xfd, 0xf6, 0x28, 0x1b, 0xe1, 0x7b, 0x09, 0xae, 0xec,
-	0x74, 0x9c, 0xe5, 0x21, 0xf6, 0xc5, 0x68, 0x3a, 0x6f, 0x75, 0xef, 0x70, 0x33, 0x14, 0x51, 0x64,
-	0x66, 0xe4, 0xce, 0x64, 0xc6, 0x66, 0x47, 0x14, 0xdb, 0x66, 0xe0, 0xee, 0x9d, 0xd2, 0x17, 0xac,
-	0x3d, 0xa8, 0xed, 0x56, 0x4c, 0xca, 0x0f, 0xd2, 0x4f, 0xe4, 0x3d, 0xae, 0x08, 0x59, 0x3d, 0x27,
-	0xf9, 0xd3, 0xf5, 0x17, 0xe9, 0xe1, 0xfa, 0x75, 0xea, 0x2e, 0xff, 0x26, 0x07, 0x6f, 0x7e, 0xea,
-	0x5b, 0x98, 0x92, 0xac, 0x56, 0x32, 0x08, 0x40, 0x9d, 0xa1, 0xd0, 0xcf, 0x0f, 0xbf, 0x54, 0xbd,
-	0x73, 0xf4, 0x3c, 0x26, 0x99, 0xb7, 0xe0, 0x7a, 0x1f, 0x17, 0x49, 0x90, 0xfb, 0x6d, 0x0e, 0xae,
-	0x1b, 0xe4, 0x20, 0x20, 0x61, 0xfd, 0xff, 0xde, 0xcc, 0xf2, 0xe6, 0x2a, 0xdc, 0xe8, 0xe7, 0x23,
-	0xe1, 0xce, 0x8d, 0x7f, 0x4f, 0x41, 0xe1, 0xa1, 0xcc, 0xe7, 0xcd, 0xc7, 0x55, 0xf4, 0x63, 0x0d,
-	0xe6, 0x15, 0x3f, 0x15, 0xa2, 0xf7, 0x87, 0xfc, 0x65, 0x91, 0x87, 0xa0, 0x74, 0xf3, 0x54, 0xbf,
-	0x47, 0x26, 0x8d, 0x48, 0x16, 0xed, 0x00, 0x46, 0x28, 0xae, 0xf0, 0x03, 0x18, 0xa1, 0xbc, 0x96,
-	0x1d, 0xc3, 0x4c, 0xc7, 0xeb, 0x17, 0x7a, 0x77, 0xd8, 0xc7, 0xba, 0xd2, 0xfa, 0x10, 0x1c, 0x29,
-	0xbd, 0xa9, 0x73, 0xbf, 0x3b, 0xec, 0xb3, 0x45, 0x1f, 0xbd, 0xca, 0xf3, 0xfa, 0x30, 0x9d, 0xba,
-	0x49, 0xa1, 0x4a, 0xb6, 0x0c, 0xd5, 0xa5, 0xb0, 0xb4, 0x36, 0x30, 0xbd, 0xd4, 0xf8, 0x4b, 0x0d,
-	0x2e, 0x66, 0x8e, 0xf5, 0xe8, 0x4e, 0xb6, 0xb8, 0x7e, 0x57, 0x95, 0xd2, 0x87, 0xa7, 0xe2, 0x95,
-	0x66, 0xfd, 0x5c, 0x83, 0x37, 0x94, 0x83, 0x36, 0xba, 0x95, 0x2d, 0xb6, 0xd7, 0xc5, 0xa3, 0xf4,
-	0xcd, 0xa1, 0xf9, 0xa4, 0x29, 0x4d, 0x98, 0xed, 0x04, 0x18, 0xb4, 0x3e, 0x0c, 0x18, 0x09, 0xfd,
-	0xa7, 0xc0, 0x2f, 0xf4, 0x0b, 0x0d, 0x16, 0xd5, 0xb3, 0x21, 0xea, 0x71, 0x9c, 0x9e, 0x33, 0x6c,
-	0xe9, 0xf6, 0xf0, 0x8c, 0xd2, 0x9a, 0x9f, 0x6a, 0xb0, 0xa0, 0x9a, 0x44, 0xd0, 0xcd, 0x61, 0x27,
-	0x17, 0x61, 0xc9, 0xad, 0xd3, 0x0d, 0x3c, 0xe8, 0xd7, 0x1a, 0x2c, 0xf5, 0xc4, 0x29, 0xf4, 0x51,
-	0xb6, 0xe4, 0x41, 0x66, 0x80, 0xd2, 0xdd, 0x53, 0xf3, 0x4b, 0x13, 0x7f, 0xa7, 0xc1, 0x72, 0xef,
-	0xe6, 0x8f, 0xee, 0xf6, 0x2a, 0x8f, 0x01, 0xa0, 0xb5, 0xf4, 0xed, 0xd3, 0x0b, 0x10, 0x56, 0x6e,
-	0xdd, 0xff, 0xd3, 0xcb, 0x65, 0xed, 0xcf, 0x2f, 0x97, 0xb5, 0xbf, 0xbd, 0x5c, 0xd6, 0xbe, 0xf7,
-	0xc1, 0xa1, 0x4d, 0xeb, 0xd1, 0x7e, 0xa5, 0xe6, 0x35, 0xd6, 0x52, 0xff, 0xbd, 0x5a, 0x39, 0x24,
-	0xae, 0xf8, 0x77, 0xdf, 0xe4, 0x7f, 0x1c, 0x7f, 0x18, 0xff, 0x7d, 0xbc, 0xbe, 0x3f, 0xc6, 0x77,
-	0xdf, 0xfb, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x48, 0x50, 0xe3, 0xd9, 0x9f, 0x2c, 0x00, 0x00,
+	// 2551 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4b, 0x6f, 0x1c, 0x49,
+	0x1d, 0x57, 0x8f, 0x3d, 0x7e, 0xfc, 0xc7, 0x1e, 0xdb, 0x65, 0xc7, 0xe9, 0x4c, 0x62, 0xc7, 0x99,
+	0x6c, 0x12, 0x2f, 0x2c, 0xe3, 0xb5, 0x37, 0x09, 0xd9, 0xac, 0xd8, 0xe0, 0x47, 0x9c, 0x0c, 0xda,
+	0x90, 0x6c, 0xc7, 0x9b, 0x48, 0xb0, 0x4a, 0x53, 0x9e, 0x2e, 0x7b, 0x1a, 0xf7, 0x74, 0x77, 0xba,
+	0xab, 0xed, 0xf5, 0x1e, 0x38, 0x20, 0x40, 0x48, 0x5c, 0xf9, 0x00, 0xbc, 0x3e, 0x01, 0x37, 0xbe,
+	0x01, 0x47, 0x8e, 0x48, 0x11, 0x12, 0x44, 0xe2, 0x03, 0x80, 0xc4, 0x8d, 0x03, 0xaa, 0x47, 0xcf,
+	0x74, 0xcf, 0x54, 0xcf, 0xc3, 0x76, 0xb2, 0x1c, 0xf6, 0xe6, 0xae, 0xfa, 0xbf, 0xea, 0xff, 0xfa,
+	0xfd, 0xab, 0xc6, 0x70, 0x3d, 0xda, 0x25, 0xc1, 0x4a, 0x0d, 0x5b, 0xc4, 0xad, 0x91, 0x95, 0x06,
+	0xa6, 0xb5, 0xba, 0xed, 0xee, 0xaf, 0x1c, 0xae, 0xae, 0x84, 0x24, 0x38, 0xb4, 0x6b, 0xa4, 0xe2,
+	0x07, 0x1e, 0xf5, 0x90, 0xce, 0xe8, 0x2a, 0x92, 0xae, 0x12, 0xd3, 0x55, 0x0e, 0x57, 0x4b, 0x8b,
+	0xfb, 0x9e, 0xb7, 0xef, 0x90, 0x15, 0x4e, 0xb7, 0x1b, 0xed, 0xad, 0x58, 0x51, 0x80, 0xa9, 0xed,
+	0xb9, 0x82, 0xb3, 0x74, 0xb9, 0x7d, 0x9f, 0xda, 0x0d, 0x12, 0x52, 0xdc, 0xf0, 0x25, 0x41, 0x87,
+	0x80, 0xa3, 0x00, 0xfb, 0x3e, 0x09, 0x42, 0xb9, 0xbf, 0x94, 0x32, 0x11, 0xfb, 0x36, 0xb3, 0xae,
+	0xe6, 0x35, 0x1a, 0x2d, 0x15, 0x2a, 0x8a, 0x97, 0x11, 0x09, 0x8e, 0x25, 0x41, 0x59, 0x45, 0x40,
+	0x71, 0x78, 0xe0, 0xd8, 0x21, 0x95, 0x34, 0xcb, 0x2a, 0x1a, 0xe9, 0x04, 0xf3, 0xc8, 0x0b, 0x0e,
+	0x48, 0x20, 0x29, 0xbf, 0xd1, 0x8b, 0x72, 0xcf, 0xf1, 0x8e, 0x24, 0xed, 0x15, 0x15, 0x6d, 0xdd,
+	0x0e, 0xa9, 0xd7, 0x34, 0xee, 0x9d, 0x14, 0x49, 0x58, 0xc7, 0x01, 0xb1, 0x3a, 0xa9, 0xae, 0x65,
+	0x50, 0xa5, 0x4f, 0x51, 0xfe, 0x18, 0x66, 0x76, 0x70, 0x78, 0xf0, 0x89, 0x1d, 0xd2, 0x27, 0x38,
+	0xa0, 0x36, 0x0b, 0x04, 0x7a, 0x17, 0xa6, 0xed, 0xd0, 0x73, 0x78, 0x54, 0xcc, 0xfd, 0xc0, 0x8b,
+	0xfc, 0x50, 0xd7, 0x96, 0x86, 0x96, 0xc7, 0x8d, 0xa9, 0xe6, 0xfa, 0x03, 0xbe, 0x5c, 0xfe, 0xc7,
+	0x30, 0x9c, 0xef, 0x10, 0xb0, 0xe9, 0xb9, 0x7b, 0xf6, 0x3e, 0xd2, 0x61, 0xf4, 0x90, 0x04, 0xa1,
+	0xed, 0xb9, 0xba, 0xb6, 0xa4, 0x2d, 0x0f, 0x19, 0xf1, 0x27, 0x5a, 0x83, 0x59, 0x37, 0x6a, 0x98,
+	0x01, 0xc1, 0x96, 0xe9, 0xc7, 0x5c, 0xa1, 0x9e, 0x5b, 0xd2, 0x96, 0xf3, 0x1b, 0x39, 0x5d, 0x33,
+	0x66, 0xdc, 0xa8, 0x61, 0x10, 0x6c, 0x35, 0x45, 0x86, 0xe8, 0x26, 0xcc, 0x31, 0x9e, 0xa3, 0xc0,
+	0xa6, 0x24, 0xc9, 0x34, 0xd4, 0x64, 0x42, 0x6e, 0xd4, 0x78, 0xce, 0xb6, 0x13, 0x5c, 0x2e, 0x4c,
+	0xb5, 0x6b, 0x19, 0x5e, 0x1a, 0x5a, 0x2e, 0xac, 0xdd, 0xaf, 0x64, 0x65, 0x68, 0x25, 0xe3, 0x3c,
+	0x95, 0xb4, 0x41, 0xf7, 0x5d, 0x1a, 0x1c, 0x1b, 0xc5, 0x20, 0x6d, 0xe5, 0x4b, 0x98, 0xee, 0xb0,
+	0x30, 0xcf, 0x15, 0x6e, 0x0f, 0xae, 0xb0, 0xed, 0x30, 0x42, 0xe3, 0xd4, 0x51, 0x7a, 0xb5, 0xe4,
+	0xc2, 0xac, 0xc2, 0x32, 0x34, 0x0d, 0x43, 0x07, 0xe4, 0x98, 0x7b, 0x3e, 0x6f, 0xb0, 0x3f, 0xd1,
+	0x3a, 0xe4, 0x0f, 0xb1, 0x13, 0x11, 0xee, 0xe7, 0xc2, 0xda, 0x37, 0x07, 0x30, 0xc8, 0x10, 0x9c,
+	0x77, 0x73, 0x77, 0xb4, 0x92, 0x07, 0x73, 0x2a, 0xc3, 0xde, 0x98, 0xc2, 0xf2, 0x8f, 0x60, 0xe6,
+	0x13, 0x0f, 0x5b, 0x1b, 0xd8, 0xc1, 0x6e, 0x8d, 0x04, 0x0f, 0x6d, 0x97, 0x86, 0xe8, 0x2a, 0x4c,
+	0xee, 0xe2, 0xda, 0x81, 0xe3, 0xed, 0x9b, 0x35, 0x2f, 0x72, 0xa9, 0x4c, 0xb1, 0x09, 0xb9, 0xb8,
+	0xc9, 0xd6, 0xd0, 0x75, 0x98, 0x0a, 0x30, 0x0b, 0x06, 0x09, 0xcc, 0x90, 0xd4, 0x3c, 0xd7, 0xe2,
+	0xa6, 0x68, 0xc6, 0x24, 0x5b, 0x7e, 0x42, 0x82, 0xa7, 0x7c, 0xb1, 0xfc, 0x2f, 0x0d, 0x4a, 0x4f,
+	0x3c, 0xc7, 0xd9, 0xf6, 0x82, 0x2d, 0x52, 0xb3, 0x59, 0x8e, 0x32, 0x8b, 0x0c, 0xf2, 0x32, 0x22,
+	0x21, 0x45, 0x55, 0x18, 0x0d, 0xc4, 0x9f, 0x5c, 0x4b, 0x61, 0x6d, 0x25, 0x7d, 0x12, 0xec, 0xdb,
+	0xec, 0x10, 0xd9, 0x12, 0x8c, 0x98, 0x1f, 0x5d, 0x84, 0x71, 0xcb, 0x6b, 0x60, 0xdb, 0x35, 0x6d,
+	0x61, 0xcb, 0xb8, 0x31, 0x26, 0x16, 0xaa, 0x16, 0xdb, 0xf4, 0x3d, 0xc7, 0x21, 0x01, 0xdb, 0x1c,
+	0x12, 0x9b, 0x62, 0xa1, 0x6a, 0xa1, 0x6b, 0x50, 0xdc, 0xf3, 0x82, 0x23, 0x1c, 0x58, 0xc4, 0x32,
+	0xf7, 0x02, 0xaf, 0xa1, 0x0f, 0x73, 0x8a, 0xc9, 0xe6, 0xea, 0x76, 0xe0, 0x35, 0xd0, 0x0d, 0x98,
+	0x6a, 0xab, 0x5d, 0x3d, 0xcf, 0xe9, 0x8a, 0xe9, 0xd2, 0x2d, 0xbf, 0x2a, 0xc0, 0x45, 0xa5, 0xc5,
+	0xa1, 0xef, 0xb9, 0x21, 0x41, 0x0b, 0x00, 0xac, 0x57, 0x98, 0xd4, 0x3b, 0x20, 0xa2, 0x80, 0x27,
+	0x8c, 0x71, 0xb6, 0xb2, 0xc3, 0x16, 0xd0, 0x67, 0x80, 0xe2, 0xd6, 0x65, 0x92, 0x2f, 0x48, 0x2d,
+	0x62, 0x92, 0x65, 0xa0, 0xaf, 0x2b, 0xdd, 0xf3, 0x5c, 0x92, 0xdf, 0x8f, 0xa9, 0x8d, 0x99, 0xa3,
+	0xf6, 0x25, 0xb4, 0x0d, 0x93, 0x4d, 0xb1, 0xf4, 0xd8, 0x27, 0xdc, 0x0d, 0x85, 0xb5, 0x2b, 0x5d,
+	0x25, 0xee, 0x1c, 0xfb, 0xc4, 0x98, 0x38, 0x4a, 0x7c, 0xa1, 0x67, 0x70, 0xc1, 0x0f, 0xc8, 0xa1,
+	0xed, 0x45, 0xa1, 0x19, 0x52, 0x1c, 0x50, 0x62, 0x99, 0xe4, 0x90, 0xb8, 0x94, 0xb9, 0x76, 0x98,
+	0xcb, 0xbc, 0x58, 0x11, 0x40, 0x52, 0x89, 0x81, 0xa4, 0x52, 0x75, 0xe9, 0xed, 0x9b, 0xcf, 0x58,
+	0xde, 0x19, 0xf3, 0x31, 0xf7, 0x53, 0xc1, 0x7c, 0x9f, 0xf1, 0x56, 0x2d, 0xb4, 0x0c, 0xd3, 0x1d,
+	0xe2, 0xf2, 0x3c, 0xf3, 0x8a, 0x61, 0x9a, 0x52, 0x87, 0x51, 0x4c, 0x29, 0x69, 0xf8, 0x54, 0x1f,
+	0xe1, 0x25, 0x11, 0x7f, 0xa2, 0x32, 0x4c, 0xba, 0xe4, 0x0b, 0xda, 0x12, 0x30, 0xca, 0x05, 0x14,
+	0xd8, 0x62, 0xcc, 0xfd, 0x1e, 0xa0, 0x54, 0x7a, 0x9b, 0x75, 0xdb, 0xa5, 0xfa, 0x18, 0x27, 0x9c,
+	0x4e, 0xe6, 0x38, 0xab, 0x06, 0x74, 0x07, 0xf4, 0x90, 0xda, 0xb5, 0x83, 0xe3, 0x56, 0x28, 0x4c,
+	0xe2, 0xe2, 0x5d, 0x87, 0x58, 0xfa, 0xf8, 0x92, 0xb6, 0x3c, 0x66, 0xcc, 0x8b, 0xfd, 0xa6, 0xa3,
+	0xef, 0x8b, 0x5d, 0x74, 0x07, 0xf2, 0x1c, 0xf8, 0x74, 0xe0, 0x3e, 0x29, 0x77, 0xf5, 0xf3, 0xa7,
+	0x8c, 0xd2, 0x10, 0x0c, 0xc8, 0x80, 0x49, 0x4b, 0xe6, 0x8d, 0x69, 0xbb, 0x7b, 0x9e, 0x5e, 0xe0,
+	0x12, 0xbe, 0x95, 0x96, 0x20, 0x80, 0x87, 0x97, 0x78, 0x80, 0xdd, 0xd0, 0x26, 0x2e, 0x8d, 0xb3,
+	0xad, 0xea, 0xee, 0x79, 0xc6, 0x84, 0x95, 0xf8, 0x42, 0x2f, 0xe0, 0x52, 0x67, 0x52, 0x99, 0x3c,
+	0x0d, 0x19, 0x66, 0xe9, 0x13, 0x5c, 0xc5, 0x82, 0xd2, 0xc8, 0xb8, 0x85, 0x18, 0x17, 0x3a, 0xb2,
+	0x2a, 0xde, 0x42, 0x15, 0x98, 0x15, 0x4e, 0x67, 0x48, 0x49, 0xcc, 0x18, 0x9d, 0x26, 0x79, 0x7c,
+	0x66, 0xf8, 0xd6, 0x53, 0xb6, 0xf3, 0x4c, 0xe2, 0xd4, 0x15, 0x98, 0xd8, 0x0d, 0xb0, 0x5b, 0xab,
+	0xcb, 0x2a, 0x28, 0xf2, 0x2a, 0x28, 0x88, 0x35, 0x51, 0x07, 0xeb, 0x50, 0x0c, 0x6b, 0x75, 0x62,
+	0x45, 0x0e, 0xb1, 0x4c, 0x36, 0xaa, 0xe8, 0x53, 0xdc, 0xc8, 0x52, 0x47, 0x76, 0xed, 0xc4, 0x73,
+	0x8c, 0x31, 0xd9, 0xe4, 0x60, 0x6b, 0xe8, 0x3b, 0x30, 0x11, 0xe7, 0x14, 0x17, 0x30, 0xdd, 0x53,
+	0x40, 0x41, 0xd2, 0x73, 0xf6, 0xcf, 0x61, 0x94, 0x45, 0xc4, 0x26, 0xa1, 0x3e, 0xc3, 0x91, 0x66,
+	0x23, 0xbb, 0xcf, 0x76, 0x29, 0xf8, 0xca, 0xa7, 0x42, 0x88, 0x40, 0x99, 0x58, 0x24, 0x73, 0x19,
+	0xf5, 0x28, 0x76, 0x4c, 0x39, 0x5e, 0x98, 0xbb, 0xc7, 0x94, 0x84, 0x3a, 0xe2, 0x99, 0x38, 0xc3,
+	0xb7, 0x1e, 0x8a, 0x9d, 0x0d, 0xb6, 0x81, 0x3e, 0x87, 0xe9, 0x26, 0xf4, 0x99, 0x35, 0x8e, 0x63,
+	0xfa, 0x2c, 0x3f, 0xd0, 0xea, 0xc0, 0x00, 0x68, 0x4c, 0xf9, 0x6d, 0x23, 0xc5, 0x0f, 0x61, 0xd6,
+	0xf1, 0xb0, 0x65, 0xee, 0x4a, 0x2c, 0xe0, 0x65, 0x11, 0xea, 0x73, 0xbd, 0xf0, 0xa5, 0x03, 0x3f,
+	0x8c, 0x19, 0xa7, 0x03, 0x52, 0x1e, 0xc1, 0x34, 0x8e, 0xa8, 0x27, 0xad, 0x16, 0x15, 0x77, 0x8e,
+	0x4b, 0xbe, 0xaa, 0xcc, 0xb8, 0xf5, 0x88, 0x7a, 0xc2, 0x2e, 0xc6, 0x6f, 0x14, 0x71, 0xea, 0x9b,
+	0x75, 0xf3, 0xba, 0xe7, 0x9b, 0x34, 0xc0, 0x35, 0xa2, 0xcf, 0x8b, 0x6e, 0x5e, 0xf7, 0xfc, 0x1d,
+	0xf6, 0x5d, 0x7a, 0x01, 0x13, 0x49, 0x7f, 0x27, 0xc1, 0x73, 0x5c, 0x80, 0xe7, 0x9d, 0x34, 0x78,
+	0xf6, 0x55, 0x99, 0x2d, 0xcc, 0x4c, 0x20, 0xda, 0x7a, 0x8d, 0xda, 0x87, 0x36, 0x3d, 0x3e, 0x39,
+	0xa2, 0x29, 0x24, 0xfc, 0x3f, 0x22, 0xda, 0x1f, 0xa1, 0x89, 0x68, 0x69, 0x8b, 0xbf, 0x52, 0x44,
+	0xbb, 0x0c, 0x05, 0x2c, 0xad, 0x69, 0x39, 0x01, 0xe2, 0xa5, 0xaa, 0xc5, 0x20, 0xaf, 0x49, 0xc0,
+	0x21, 0x6f, 0xb8, 0x0b, 0xe4, 0x35, 0x0f, 0xc6, 0x21, 0x0f, 0x27, 0xbe, 0xd0, 0x1a, 0xe4, 0x6d,
+	0xd7, 0x8f, 0x28, 0xf7, 0x4e, 0x61, 0xed, 0x92, 0x3a, 0xa2, 0xf8, 0x98, 0x25, 0xbe, 0x21, 0x48,
+	0x15, 0xdd, 0x6b, 0xe4, 0xb4, 0xdd, 0x6b, 0x74, 0xb0, 0xee, 0xb5, 0x03, 0x17, 0x62, 0x79, 0x26,
+	0xab, 0x3d, 0xc7, 0x0b, 0x09, 0x17, 0xe4, 0x45, 0x02, 0xef, 0x0a, 0x6b, 0x17, 0x3a, 0x64, 0x6d,
+	0xc9, 0x2b, 0xa3, 0x31, 0x1f, 0xf3, 0xee, 0x78, 0x9b, 0x8c, 0x73, 0x47, 0x30, 0xa2, 0xef, 0xc3,
+	0x3c, 0x57, 0xd2, 0x29, 0x72, 0xbc, 0x97, 0xc8, 0x59, 0xce, 0xd8, 0x26, 0x6f, 0x1b, 0x66, 0xea,
+	0x04, 0x07, 0x74, 0x97, 0x60, 0xda, 0x14, 0x05, 0xbd, 0x44, 0x4d, 0x37, 0x79, 0x62, 0x39, 0x89,
+	0xa1, 0xa0, 0x90, 0x1e, 0x0a, 0x5e, 0xc0, 0x62, 0x3a, 0x12, 0xa6, 0xb7, 0x67, 0xd2, 0xba, 0x1d,
+	0x9a, 0x31, 0xc3, 0x44, 0x4f, 0xc7, 0x96, 0x52, 0x91, 0x79, 0xbc, 0xb7, 0x53, 0xb7, 0xc3, 0x75,
+	0x29, 0xbf, 0x9a, 0x3c, 0x81, 0x45, 0x28, 0xb6, 0x9d, 0x90, 0x03, 0x5f, 0xaf, 0x4c, 0x69, 0x1d,
+	0x62, 0x4b, 0x70, 0x75, 0xce, 0x68, 0xc5, 0x93, 0xcd, 0x68, 0x37, 0x60, 0xaa, 0x29, 0x47, 0x74,
+	0x0c, 0x8e, 0x9d, 0xe3, 0x46, 0x31, 0x5e, 0xde, 0xe2, 0xab, 0xe8, 0x03, 0x18, 0xa9, 0x13, 0x6c,
+	0x91, 0x40, 0x42, 0xe3, 0x45, 0xa5, 0xa6, 0x87, 0x9c, 0xc4, 0x90, 0xa4, 0x59, 0x50, 0x31, 0x73,
+	0x26, 0x50, 0xf1, 0x66, 0x51, 0x4e, 0x05, 0x44, 0x73, 0x67, 0x04, 0x44, 0xe7, 0xd2, 0x40, 0x54,
+	0xfe, 0xeb, 0x30, 0xcc, 0xaf, 0x5b, 0x96, 0xea, 0xda, 0x93, 0xea, 0xec, 0x5a, 0x5b, 0x67, 0x7f,
+	0x43, 0xdd, 0xf2, 0x2e, 0x8c, 0xb7, 0xc6, 0xbd, 0xa1, 0x7e, 0xc6, 0xbd, 0x31, 0x1a, 0x4f, 0x77,
+	0x97, 0xa1, 0xd0, 0x6c, 0x25, 0x72, 0xca, 0x1f, 0x32, 0x20, 0x5e, 0xaa, 0x5a, 0xed, 0xbd, 0x46,
+	0x76, 0x08, 0x59, 0xcd, 0xf9, 0x01, 0x7a, 0x0d, 0xbf, 0x14, 0xc4, 0x35, 0x7d, 0x17, 0x46, 0x42,
+	0x2f, 0x0a, 0x6a, 0xa2, 0x77, 0x16, 0xdb, 0x91, 0x3a, 0x31, 0x01, 0xe3, 0xf0, 0xe0, 0x29, 0xa7,
+	0x34, 0x24, 0x87, 0x02, 0x02, 0x47, 0x55, 0x10, 0xe8, 0x2b, 0xd2, 0x6d, 0xac, 0xd7, 0x33, 0x86,
+	0x3a, 0xaa, 0x95, 0xb6, 0xec, 0x93, 0x8f, 0x0a, 0x6d, 0x29, 0x58, 0xda, 0x80, 0x39, 0x15, 0xa1,
+	0x62, 0x4e, 0x99, 0x4b, 0xce, 0x29, 0xe3, 0xc9, 0x19, 0xe4, 0x08, 0xce, 0x77, 0xd8, 0x20, 0xa1,
+	0x58, 0x55, 0x3f, 0xda, 0x59, 0xd5, 0x4f, 0xf9, 0xdf, 0x79, 0x9e, 0xd3, 0xaa, 0xc1, 0xe7, 0xab,
+	0xc8, 0x69, 0x76, 0x67, 0xe4, 0xe1, 0x36, 0x5b, 0xaa, 0xc5, 0x18, 0x50, 0x14, 0xeb, 0x5b, 0xb1,
+	0x01, 0xa9, 0xec, 0x1f, 0x3e, 0x55, 0xf6, 0xe7, 0x07, 0xcb, 0xfe, 0x91, 0xd3, 0x67, 0xff, 0xe8,
+	0x19, 0x64, 0xff, 0x98, 0x2a, 0xfb, 0x5d, 0xd0, 0x71, 0x22, 0x94, 0x5b, 0x76, 0xe8, 0xb3, 0xac,
+	0x60, 0x37, 0x46, 0x09, 0xe7, 0x6b, 0x5d, 0xaa, 0x20, 0x83, 0xd3, 0xc8, 0x94, 0xa9, 0xac, 0x36,
+	0xe8, 0xa3, 0xda, 0x14, 0xf9, 0xf6, 0x16, 0xab, 0xed, 0xd5, 0x10, 0xe8, 0x59, 0x87, 0x45, 0xdf,
+	0x83, 0xa9, 0xd6, 0x74, 0xc1, 0xef, 0xb9, 0xb2, 0xdc, 0xd4, 0xa0, 0x2d, 0x6f, 0x74, 0xfc, 0x31,
+	0xc2, 0x68, 0x4d, 0x88, 0xfc, 0xbb, 0x63, 0xe0, 0xcb, 0x0d, 0x36, 0xf0, 0x25, 0x46, 0xa0, 0xa1,
+	0x41, 0x47, 0xa0, 0xe1, 0xb3, 0x1f, 0x81, 0xf2, 0x67, 0x33, 0x02, 0x8d, 0x9c, 0xd9, 0x08, 0x34,
+	0xaa, 0x1a, 0x81, 0x64, 0x2f, 0x55, 0x5e, 0x6b, 0xde, 0x6c, 0x2f, 0x7d, 0xa5, 0xc1, 0x1c, 0xbf,
+	0x5d, 0xc6, 0xa7, 0x88, 0x3b, 0xe9, 0x66, 0xfb, 0x15, 0xf2, 0x5d, 0xe5, 0xe1, 0x55, 0xbc, 0x7d,
+	0x5e, 0x1e, 0x4f, 0x33, 0x0b, 0xf4, 0x77, 0xb7, 0x2c, 0xff, 0x57, 0x83, 0x73, 0x6d, 0x16, 0x4a,
+	0xaf, 0xde, 0x83, 0x09, 0xfe, 0xce, 0x65, 0x06, 0x24, 0x8c, 0x9c, 0xf8, 0x8c, 0xdd, 0xf3, 0xa4,
+	0xc0, 0x39, 0x0c, 0xce, 0x80, 0xaa, 0x50, 0x8c, 0x05, 0xfc, 0x98, 0xd4, 0x28, 0xb1, 0xba, 0x5e,
+	0xe4, 0xc5, 0x05, 0x5e, 0x52, 0x1a, 0x93, 0x2f, 0x93, 0x9f, 0xe8, 0xb9, 0x22, 0xc2, 0xc2, 0x1f,
+	0xef, 0x75, 0xf5, 0x47, 0xcf, 0xe0, 0xfe, 0x53, 0x83, 0x25, 0x71, 0x62, 0x8b, 0x1b, 0xc0, 0x18,
+	0x37, 0xbd, 0x86, 0xef, 0x10, 0x66, 0x85, 0x8c, 0xd1, 0xe3, 0xf6, 0x40, 0xdf, 0x52, 0x2a, 0xed,
+	0x25, 0xe7, 0x2d, 0x04, 0xfd, 0x3c, 0x8c, 0x72, 0x5e, 0x39, 0xfc, 0x8d, 0x1b, 0x23, 0xec, 0xb3,
+	0x6a, 0x95, 0xaf, 0xc2, 0x95, 0x2e, 0xe6, 0x89, 0x88, 0x97, 0xff, 0xa6, 0xc1, 0xa5, 0x4d, 0x36,
+	0xe3, 0x3b, 0x8f, 0x23, 0x1a, 0x52, 0xec, 0x5a, 0xb6, 0xbb, 0xff, 0xc4, 0x73, 0x9c, 0xbe, 0x66,
+	0x87, 0xd4, 0x4b, 0x47, 0xae, 0xed, 0xa5, 0xe3, 0x01, 0x14, 0x9b, 0x87, 0x6a, 0x3d, 0x6b, 0x17,
+	0x33, 0xfa, 0x45, 0x7c, 0x32, 0xd1, 0x2f, 0x68, 0xe2, 0xeb, 0x34, 0x03, 0x42, 0xf9, 0x32, 0x2c,
+	0x64, 0x1c, 0x4f, 0x3a, 0xe0, 0x27, 0x70, 0x7e, 0x8b, 0x84, 0xb5, 0xc0, 0xde, 0x25, 0x4d, 0x76,
+	0x79, 0xf4, 0xed, 0xf6, 0x1c, 0x50, 0x27, 0x5e, 0x06, 0x7b, 0x7f, 0xa1, 0x2f, 0xff, 0x29, 0x07,
+	0x7a, 0xa7, 0x04, 0x59, 0x8f, 0x1f, 0xc2, 0xa8, 0x70, 0xa7, 0xf8, 0x29, 0xb2, 0xb0, 0x76, 0x39,
+	0xf3, 0xc5, 0x8a, 0x04, 0x1c, 0xe0, 0x63, 0x7a, 0x76, 0x9d, 0x6a, 0x79, 0x3f, 0xa4, 0x98, 0x46,
+	0xa1, 0xac, 0xc5, 0xab, 0x5d, 0x7d, 0xf7, 0x94, 0x93, 0x1a, 0x45, 0x9a, 0xfa, 0x7e, 0x63, 0xd5,
+	0x78, 0xaa, 0xe0, 0x86, 0xb0, 0xc0, 0x93, 0xa4, 0x5d, 0x57, 0x18, 0x47, 0x70, 0x1e, 0x46, 0x24,
+	0xc0, 0x88, 0xcc, 0x95, 0x5f, 0x69, 0xa5, 0xb9, 0xc1, 0x94, 0xfe, 0x22, 0x07, 0x8b, 0x59, 0x5a,
+	0x65, 0xd8, 0x5e, 0xc2, 0x42, 0xeb, 0x71, 0xab, 0x19, 0x84, 0xc4, 0x8f, 0xa3, 0x22, 0x98, 0x95,
+	0xfe, 0x3c, 0xf7, 0x88, 0x50, 0x6c, 0x61, 0x8a, 0x8d, 0x52, 0x72, 0x78, 0x4b, 0xab, 0x66, 0x2a,
+	0x9b, 0x3f, 0x4c, 0x28, 0x55, 0xe6, 0x4e, 0xa6, 0xd2, 0x4a, 0x5c, 0x64, 0xd2, 0x2a, 0xcb, 0xb7,
+	0xe0, 0xe2, 0x03, 0xd2, 0x74, 0x43, 0xb8, 0x71, 0x2c, 0x50, 0xbb, 0x87, 0xef, 0xcb, 0x7f, 0x18,
+	0x86, 0x4b, 0x6a, 0x3e, 0xe9, 0xbd, 0x9f, 0x69, 0x30, 0xaf, 0x38, 0x4b, 0x03, 0xfb, 0xd2, 0x6f,
+	0x8f, 0xb3, 0x11, 0xbe, 0x9b, 0xe0, 0xca, 0x56, 0xdb, 0x59, 0x1e, 0x61, 0x5f, 0x8c, 0xa6, 0xb3,
+	0x56, 0xe7, 0x0e, 0x37, 0x43, 0x11, 0x45, 0x66, 0x46, 0xee, 0x54, 0x66, 0xac, 0xb7, 0x45, 0xb1,
+	0x65, 0x06, 0xee, 0xdc, 0x29, 0x7d, 0xc9, 0xda, 0x83, 0xda, 0x6e, 0xc5, 0xa4, 0xfc, 0x30, 0xfd,
+	0x7e, 0xde, 0xe5, 0x8a, 0x90, 0xd5, 0x73, 0x92, 0x3f, 0x7a, 0x7f, 0x99, 0x1e, 0xae, 0xdf, 0xa6,
+	0xee, 0xf2, 0x6f, 0x73, 0xf0, 0xce, 0x67, 0xbe, 0x85, 0x29, 0xc9, 0x6a, 0x25, 0xfd, 0x00, 0xd4,
+	0x29, 0x0a, 0xfd, 0xec, 0xf0, 0x4b, 0xd5, 0x3b, 0x87, 0xcf, 0x62, 0x92, 0xb9, 0x01, 0xd7, 0x7a,
+	0xb8, 0x48, 0x82, 0xdc, 0xef, 0x72, 0x70, 0xcd, 0x20, 0x7b, 0x01, 0x09, 0xeb, 0x5f, 0x7b, 0x33,
+	0xcb, 0x9b, 0xcb, 0x70, 0xbd, 0x97, 0x8f, 0x84, 0x3b, 0xd7, 0xfe, 0x33, 0x01, 0x85, 0x47, 0x32,
+	0x9f, 0xd7, 0x9f, 0x54, 0xd1, 0x4f, 0x35, 0x98, 0x55, 0xfc, 0xc8, 0x88, 0x6e, 0x0e, 0xf8, 0x9b,
+	0x24, 0x0f, 0x41, 0xe9, 0xd6, 0x89, 0x7e, 0xc9, 0x4c, 0x1a, 0x91, 0x2c, 0xda, 0x3e, 0x8c, 0x50,
+	0x5c, 0xe1, 0xfb, 0x30, 0x42, 0x79, 0x2d, 0x3b, 0x84, 0xa9, 0xb6, 0xd7, 0x2f, 0xf4, 0xfe, 0xa0,
+	0x8f, 0x75, 0xa5, 0xd5, 0x01, 0x38, 0x52, 0x7a, 0x53, 0xe7, 0x7e, 0x7f, 0xd0, 0x67, 0x8b, 0x1e,
+	0x7a, 0x95, 0xe7, 0xf5, 0x61, 0x32, 0x75, 0x93, 0x42, 0x95, 0x6c, 0x19, 0xaa, 0x4b, 0x61, 0x69,
+	0xa5, 0x6f, 0x7a, 0xa9, 0xf1, 0xd7, 0x1a, 0x5c, 0xc8, 0x1c, 0xeb, 0xd1, 0xdd, 0x6c, 0x71, 0xbd,
+	0xae, 0x2a, 0xa5, 0x8f, 0x4e, 0xc4, 0x2b, 0xcd, 0xfa, 0xa5, 0x06, 0xe7, 0x94, 0x83, 0x36, 0xba,
+	0x9d, 0x2d, 0xb6, 0xdb, 0xc5, 0xa3, 0xf4, 0xed, 0x81, 0xf9, 0xa4, 0x29, 0xc7, 0x30, 0xdd, 0x0e,
+	0x30, 0x68, 0x75, 0x10, 0x30, 0x12, 0xfa, 0x4f, 0x80, 0x5f, 0xe8, 0x57, 0x1a, 0xcc, 0xab, 0x67,
+	0x43, 0xd4, 0xe5, 0x38, 0x5d, 0x67, 0xd8, 0xd2, 0x9d, 0xc1, 0x19, 0xa5, 0x35, 0x3f, 0xd7, 0x60,
+	0x4e, 0x35, 0x89, 0xa0, 0x5b, 0x83, 0x4e, 0x2e, 0xc2, 0x92, 0xdb, 0x27, 0x1b, 0x78, 0xd0, 0x6f,
+	0x34, 0x58, 0xe8, 0x8a, 0x53, 0xe8, 0xe3, 0x6c, 0xc9, 0xfd, 0xcc, 0x00, 0xa5, 0x7b, 0x27, 0xe6,
+	0x97, 0x26, 0xfe, 0x5e, 0x83, 0xc5, 0xee, 0xcd, 0x1f, 0xdd, 0xeb, 0x56, 0x1e, 0x7d, 0x40, 0x6b,
+	0xe9, 0xbb, 0x27, 0x17, 0x20, 0xac, 0xdc, 0x78, 0xf0, 0xe7, 0xd7, 0x8b, 0xda, 0x5f, 0x5e, 0x2f,
+	0x6a, 0x7f, 0x7f, 0xbd, 0xa8, 0xfd, 0xe0, 0xc3, 0x7d, 0x9b, 0xd6, 0xa3, 0xdd, 0x4a, 0xcd, 0x6b,
+	0xac, 0xa4, 0xfe, 0xef, 0xb5, 0xb2, 0x4f, 0x5c, 0xf1, 0x8f, 0xc2, 0xc9, 0xff, 0x55, 0xfe, 0x28,
+	0xfe, 0xfb, 0x70, 0x75, 0x77, 0x84, 0xef, 0x7e, 0xf0, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x17,
+	0x57, 0x73, 0x1f, 0xd9, 0x2c, 0x00, 0x00,
 }
 
 func (m *TaskListPartition) Marshal() (dAtA []byte, err error) {
@@ -2551,6 +2569,15 @@ func (m *PollForDecisionTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.HopTrace) > 0 {
+		i -= len(m.HopTrace)
+		copy(dAtA[i:], m.HopTrace)
+		i = encodeVarintService(dAtA, i, uint64(len(m.HopTrace)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
 	if m.AutoConfigHint != nil {
 		{
 			size, err := m.AutoConfigHint.MarshalToSizedBuffer(dAtA[:i])
@@ -2869,6 +2896,15 @@ func (m *PollForActivityTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.HopTrace) > 0 {
+		i -= len(m.HopTrace)
+		copy(dAtA[i:], m.HopTrace)
+		i = encodeVarintService(dAtA, i, uint64(len(m.HopTrace)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
 	if m.AutoConfigHint != nil {
 		{
 			size, err := m.AutoConfigHint.MarshalToSizedBuffer(dAtA[:i])
@@ -4534,6 +4570,10 @@ func (m *PollForDecisionTaskResponse) Size() (n int) {
 		l = m.AutoConfigHint.Size()
 		n += 2 + l + sovService(uint64(l))
 	}
+	l = len(m.HopTrace)
+	if l > 0 {
+		n += 2 + l + sovService(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4653,6 +4693,10 @@ func (m *PollForActivityTaskResponse) Size() (n int) {
 		l = m.AutoConfigHint.Size()
 		n += 2 + l + sovService(uint64(l))
 	}
+	l = len(m.HopTrace)
+	if l > 0 {
+		n += 2 + l + sovService(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6666,6 +6710,38 @@ func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HopTrace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HopTrace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -7589,6 +7665,38 @@ func (m *PollForActivityTaskResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HopTrace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HopTrace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
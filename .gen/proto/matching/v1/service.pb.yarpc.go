@@ -783,164 +783,165 @@ var (
 var yarpcFileDescriptorClosure826e827d3aabf7fc = [][]byte{
 	// uber/cadence/matching/v1/service.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x49, 0x73, 0xdc, 0xc6,
-		0xf5, 0x2f, 0x0c, 0x39, 0x5c, 0xde, 0x90, 0x43, 0xb2, 0x49, 0x53, 0xd0, 0x48, 0x94, 0xa8, 0x91,
-		0x25, 0xd3, 0xff, 0xbf, 0x33, 0x34, 0x69, 0x49, 0x91, 0xa5, 0x8a, 0x15, 0x2e, 0xa2, 0x34, 0x29,
-		0x2b, 0x92, 0x21, 0x5a, 0xaa, 0x4a, 0x5c, 0x42, 0x9a, 0x83, 0x26, 0x07, 0x21, 0x06, 0x80, 0x80,
-		0x06, 0x69, 0xfa, 0x90, 0x43, 0x2a, 0x49, 0xa5, 0x2a, 0xd7, 0xe4, 0x9e, 0xed, 0x4b, 0xe4, 0x92,
-		0xcf, 0x91, 0x2a, 0x57, 0x0e, 0x39, 0xe4, 0x03, 0x24, 0x55, 0xb9, 0xe5, 0x90, 0xea, 0x05, 0x33,
-		0x00, 0xa6, 0x31, 0x0b, 0x49, 0xc9, 0x39, 0xe4, 0x46, 0x74, 0xbf, 0xad, 0xdf, 0xf6, 0x7b, 0xdd,
-		0x43, 0xb8, 0x19, 0xed, 0x91, 0x60, 0xb5, 0x81, 0x2d, 0xe2, 0x36, 0xc8, 0x6a, 0x0b, 0xd3, 0x46,
-		0xd3, 0x76, 0x0f, 0x56, 0x8f, 0xd6, 0x56, 0x43, 0x12, 0x1c, 0xd9, 0x0d, 0x52, 0xf3, 0x03, 0x8f,
-		0x7a, 0x48, 0x67, 0x74, 0x35, 0x49, 0x57, 0x8b, 0xe9, 0x6a, 0x47, 0x6b, 0x95, 0x2b, 0x07, 0x9e,
-		0x77, 0xe0, 0x90, 0x55, 0x4e, 0xb7, 0x17, 0xed, 0xaf, 0x5a, 0x51, 0x80, 0xa9, 0xed, 0xb9, 0x82,
-		0xb3, 0x72, 0x35, 0xbb, 0x4f, 0xed, 0x16, 0x09, 0x29, 0x6e, 0xf9, 0x92, 0xa0, 0x4b, 0xc0, 0x71,
-		0x80, 0x7d, 0x9f, 0x04, 0xa1, 0xdc, 0x5f, 0x4e, 0x99, 0x88, 0x7d, 0x9b, 0x59, 0xd7, 0xf0, 0x5a,
-		0xad, 0x8e, 0x0a, 0x15, 0xc5, 0xeb, 0x88, 0x04, 0x27, 0x92, 0xa0, 0xaa, 0x22, 0xa0, 0x38, 0x3c,
-		0x74, 0xec, 0x90, 0x4a, 0x9a, 0x15, 0x15, 0x8d, 0x74, 0x82, 0x79, 0xec, 0x05, 0x87, 0x24, 0x90,
-		0x94, 0xff, 0xd7, 0x8f, 0x72, 0xdf, 0xf1, 0x8e, 0x25, 0xed, 0x35, 0x15, 0x6d, 0xd3, 0x0e, 0xa9,
-		0xd7, 0x36, 0xee, 0xdd, 0x14, 0x49, 0xd8, 0xc4, 0x01, 0xb1, 0xba, 0xa9, 0x6e, 0xe4, 0x50, 0xa5,
-		0x4f, 0x51, 0xfd, 0x04, 0xe6, 0x76, 0x71, 0x78, 0xf8, 0xa9, 0x1d, 0xd2, 0x67, 0x38, 0xa0, 0x36,
-		0x0b, 0x04, 0x7a, 0x1f, 0x66, 0xed, 0xd0, 0x73, 0x78, 0x54, 0xcc, 0x83, 0xc0, 0x8b, 0xfc, 0x50,
-		0xd7, 0x96, 0x47, 0x56, 0x26, 0x8d, 0x99, 0xf6, 0xfa, 0x23, 0xbe, 0x5c, 0xfd, 0xdb, 0x28, 0x5c,
-		0xe8, 0x12, 0xb0, 0xe5, 0xb9, 0xfb, 0xf6, 0x01, 0xd2, 0x61, 0xfc, 0x88, 0x04, 0xa1, 0xed, 0xb9,
-		0xba, 0xb6, 0xac, 0xad, 0x8c, 0x18, 0xf1, 0x27, 0x5a, 0x87, 0x79, 0x37, 0x6a, 0x99, 0x01, 0xc1,
-		0x96, 0xe9, 0xc7, 0x5c, 0xa1, 0x5e, 0x58, 0xd6, 0x56, 0x8a, 0x9b, 0x05, 0x5d, 0x33, 0xe6, 0xdc,
-		0xa8, 0x65, 0x10, 0x6c, 0xb5, 0x45, 0x86, 0xe8, 0x16, 0x2c, 0x30, 0x9e, 0xe3, 0xc0, 0xa6, 0x24,
-		0xc9, 0x34, 0xd2, 0x66, 0x42, 0x6e, 0xd4, 0x7a, 0xc9, 0xb6, 0x13, 0x5c, 0x2e, 0xcc, 0x64, 0xb5,
-		0x8c, 0x2e, 0x8f, 0xac, 0x94, 0xd6, 0x1f, 0xd6, 0xf2, 0x32, 0xb4, 0x96, 0x73, 0x9e, 0x5a, 0xda,
-		0xa0, 0x87, 0x2e, 0x0d, 0x4e, 0x8c, 0x72, 0x90, 0xb6, 0xf2, 0x35, 0xcc, 0x76, 0x59, 0x58, 0xe4,
-		0x0a, 0x77, 0x86, 0x57, 0x98, 0x39, 0x8c, 0xd0, 0x38, 0x73, 0x9c, 0x5e, 0xad, 0xb8, 0x30, 0xaf,
-		0xb0, 0x0c, 0xcd, 0xc2, 0xc8, 0x21, 0x39, 0xe1, 0x9e, 0x2f, 0x1a, 0xec, 0x4f, 0xb4, 0x01, 0xc5,
-		0x23, 0xec, 0x44, 0x84, 0xfb, 0xb9, 0xb4, 0xfe, 0xff, 0x43, 0x18, 0x64, 0x08, 0xce, 0x7b, 0x85,
-		0xbb, 0x5a, 0xc5, 0x83, 0x05, 0x95, 0x61, 0x6f, 0x4c, 0x61, 0xf5, 0x47, 0x30, 0xf7, 0xa9, 0x87,
-		0xad, 0x4d, 0xec, 0x60, 0xb7, 0x41, 0x82, 0xc7, 0xb6, 0x4b, 0x43, 0x74, 0x1d, 0xa6, 0xf7, 0x70,
-		0xe3, 0xd0, 0xf1, 0x0e, 0xcc, 0x86, 0x17, 0xb9, 0x54, 0xa6, 0xd8, 0x94, 0x5c, 0xdc, 0x62, 0x6b,
-		0xe8, 0x26, 0xcc, 0x04, 0x98, 0x05, 0x83, 0x04, 0x66, 0x48, 0x1a, 0x9e, 0x6b, 0x71, 0x53, 0x34,
-		0x63, 0x9a, 0x2d, 0x3f, 0x23, 0xc1, 0x73, 0xbe, 0x58, 0xfd, 0x87, 0x06, 0x95, 0x67, 0x9e, 0xe3,
-		0xec, 0x78, 0xc1, 0x36, 0x69, 0xd8, 0x2c, 0x47, 0x99, 0x45, 0x06, 0x79, 0x1d, 0x91, 0x90, 0xa2,
-		0x3a, 0x8c, 0x07, 0xe2, 0x4f, 0xae, 0xa5, 0xb4, 0xbe, 0x9a, 0x3e, 0x09, 0xf6, 0x6d, 0x76, 0x88,
-		0x7c, 0x09, 0x46, 0xcc, 0x8f, 0x2e, 0xc1, 0xa4, 0xe5, 0xb5, 0xb0, 0xed, 0x9a, 0xb6, 0xb0, 0x65,
-		0xd2, 0x98, 0x10, 0x0b, 0x75, 0x8b, 0x6d, 0xfa, 0x9e, 0xe3, 0x90, 0x80, 0x6d, 0x8e, 0x88, 0x4d,
-		0xb1, 0x50, 0xb7, 0xd0, 0x0d, 0x28, 0xef, 0x7b, 0xc1, 0x31, 0x0e, 0x2c, 0x62, 0x99, 0xfb, 0x81,
-		0xd7, 0xd2, 0x47, 0x39, 0xc5, 0x74, 0x7b, 0x75, 0x27, 0xf0, 0x5a, 0xe8, 0x3d, 0x98, 0xc9, 0xd4,
-		0xae, 0x5e, 0xe4, 0x74, 0xe5, 0x74, 0xe9, 0x56, 0xff, 0x5c, 0x82, 0x4b, 0x4a, 0x8b, 0x43, 0xdf,
-		0x73, 0x43, 0x82, 0x96, 0x00, 0x58, 0xaf, 0x30, 0xa9, 0x77, 0x48, 0x44, 0x01, 0x4f, 0x19, 0x93,
-		0x6c, 0x65, 0x97, 0x2d, 0xa0, 0xcf, 0x01, 0xc5, 0xad, 0xcb, 0x24, 0x5f, 0x92, 0x46, 0xc4, 0x24,
-		0xcb, 0x40, 0xdf, 0x54, 0xba, 0xe7, 0xa5, 0x24, 0x7f, 0x18, 0x53, 0x1b, 0x73, 0xc7, 0xd9, 0x25,
-		0xb4, 0x03, 0xd3, 0x6d, 0xb1, 0xf4, 0xc4, 0x27, 0xdc, 0x0d, 0xa5, 0xf5, 0x6b, 0x3d, 0x25, 0xee,
-		0x9e, 0xf8, 0xc4, 0x98, 0x3a, 0x4e, 0x7c, 0xa1, 0x17, 0x70, 0xd1, 0x0f, 0xc8, 0x91, 0xed, 0x45,
-		0xa1, 0x19, 0x52, 0x1c, 0x50, 0x62, 0x99, 0xe4, 0x88, 0xb8, 0x94, 0xb9, 0x76, 0x94, 0xcb, 0xbc,
-		0x54, 0x13, 0x40, 0x52, 0x8b, 0x81, 0xa4, 0x56, 0x77, 0xe9, 0x9d, 0x5b, 0x2f, 0x58, 0xde, 0x19,
-		0x8b, 0x31, 0xf7, 0x73, 0xc1, 0xfc, 0x90, 0xf1, 0xd6, 0x2d, 0xb4, 0x02, 0xb3, 0x5d, 0xe2, 0x8a,
-		0x3c, 0xf3, 0xca, 0x61, 0x9a, 0x52, 0x87, 0x71, 0x4c, 0x29, 0x69, 0xf9, 0x54, 0x1f, 0xe3, 0x25,
-		0x11, 0x7f, 0xa2, 0x2a, 0x4c, 0xbb, 0xe4, 0x4b, 0xda, 0x11, 0x30, 0xce, 0x05, 0x94, 0xd8, 0x62,
-		0xcc, 0xfd, 0x01, 0xa0, 0x54, 0x7a, 0x9b, 0x4d, 0xdb, 0xa5, 0xfa, 0x04, 0x27, 0x9c, 0x4d, 0xe6,
-		0x38, 0xab, 0x06, 0x74, 0x17, 0xf4, 0x90, 0xda, 0x8d, 0xc3, 0x93, 0x4e, 0x28, 0x4c, 0xe2, 0xe2,
-		0x3d, 0x87, 0x58, 0xfa, 0xe4, 0xb2, 0xb6, 0x32, 0x61, 0x2c, 0x8a, 0xfd, 0xb6, 0xa3, 0x1f, 0x8a,
-		0x5d, 0x74, 0x17, 0x8a, 0x1c, 0xf8, 0x74, 0xe0, 0x3e, 0xa9, 0xf6, 0xf4, 0xf3, 0x67, 0x8c, 0xd2,
-		0x10, 0x0c, 0xc8, 0x80, 0x69, 0x4b, 0xe6, 0x8d, 0x69, 0xbb, 0xfb, 0x9e, 0x5e, 0xe2, 0x12, 0xbe,
-		0x95, 0x96, 0x20, 0x80, 0x87, 0x97, 0x78, 0x80, 0xdd, 0xd0, 0x26, 0x2e, 0x8d, 0xb3, 0xad, 0xee,
-		0xee, 0x7b, 0xc6, 0x94, 0x95, 0xf8, 0x42, 0xaf, 0xe0, 0x72, 0x77, 0x52, 0x99, 0x3c, 0x0d, 0x19,
-		0x66, 0xe9, 0x53, 0x5c, 0xc5, 0x92, 0xd2, 0xc8, 0xb8, 0x85, 0x18, 0x17, 0xbb, 0xb2, 0x2a, 0xde,
-		0x42, 0x35, 0x98, 0x17, 0x4e, 0x67, 0x48, 0x49, 0xcc, 0x18, 0x9d, 0xa6, 0x79, 0x7c, 0xe6, 0xf8,
-		0xd6, 0x73, 0xb6, 0xf3, 0x42, 0xe2, 0xd4, 0x35, 0x98, 0xda, 0x0b, 0xb0, 0xdb, 0x68, 0xca, 0x2a,
-		0x28, 0xf3, 0x2a, 0x28, 0x89, 0x35, 0x51, 0x07, 0x1b, 0x50, 0x0e, 0x1b, 0x4d, 0x62, 0x45, 0x0e,
-		0xb1, 0x4c, 0x36, 0xaa, 0xe8, 0x33, 0xdc, 0xc8, 0x4a, 0x57, 0x76, 0xed, 0xc6, 0x73, 0x8c, 0x31,
-		0xdd, 0xe6, 0x60, 0x6b, 0xe8, 0x3b, 0x30, 0x15, 0xe7, 0x14, 0x17, 0x30, 0xdb, 0x57, 0x40, 0x49,
-		0xd2, 0x73, 0xf6, 0x2f, 0x60, 0x9c, 0x45, 0xc4, 0x26, 0xa1, 0x3e, 0xc7, 0x91, 0x66, 0x33, 0xbf,
-		0xcf, 0xf6, 0x28, 0xf8, 0xda, 0x67, 0x42, 0x88, 0x40, 0x99, 0x58, 0x24, 0x73, 0x19, 0xf5, 0x28,
-		0x76, 0x4c, 0x39, 0x5e, 0x98, 0x7b, 0x27, 0x94, 0x84, 0x3a, 0xe2, 0x99, 0x38, 0xc7, 0xb7, 0x1e,
-		0x8b, 0x9d, 0x4d, 0xb6, 0x81, 0xbe, 0x80, 0xd9, 0x36, 0xf4, 0x99, 0x0d, 0x8e, 0x63, 0xfa, 0x3c,
-		0x3f, 0xd0, 0xda, 0xd0, 0x00, 0x68, 0xcc, 0xf8, 0x99, 0x91, 0xe2, 0x87, 0x30, 0xef, 0x78, 0xd8,
-		0x32, 0xf7, 0x24, 0x16, 0xf0, 0xb2, 0x08, 0xf5, 0x85, 0x7e, 0xf8, 0xd2, 0x85, 0x1f, 0xc6, 0x9c,
-		0xd3, 0x05, 0x29, 0x4f, 0x60, 0x16, 0x47, 0xd4, 0x93, 0x56, 0x8b, 0x8a, 0x7b, 0x87, 0x4b, 0xbe,
-		0xae, 0xcc, 0xb8, 0x8d, 0x88, 0x7a, 0xc2, 0x2e, 0xc6, 0x6f, 0x94, 0x71, 0xea, 0xbb, 0xf2, 0x0a,
-		0xa6, 0x92, 0x2e, 0x4d, 0xe2, 0xe3, 0xa4, 0xc0, 0xc7, 0xbb, 0x69, 0x7c, 0x1c, 0xa8, 0xf8, 0x3a,
-		0xb0, 0x98, 0x00, 0xad, 0x8d, 0x06, 0xb5, 0x8f, 0x6c, 0x7a, 0x72, 0x7a, 0xd0, 0x52, 0x48, 0xf8,
-		0x6f, 0x04, 0xad, 0xdf, 0x40, 0x1b, 0xb4, 0xd2, 0x16, 0x7f, 0xa3, 0xa0, 0x75, 0x15, 0x4a, 0x58,
-		0x5a, 0xd3, 0x71, 0x02, 0xc4, 0x4b, 0x75, 0x8b, 0xa1, 0x5a, 0x9b, 0x80, 0xa3, 0xda, 0x68, 0x0f,
-		0x54, 0x6b, 0x1f, 0x8c, 0xa3, 0x1a, 0x4e, 0x7c, 0xa1, 0x75, 0x28, 0xda, 0xae, 0x1f, 0x51, 0xee,
-		0x9d, 0xd2, 0xfa, 0x65, 0x75, 0x44, 0xf1, 0x09, 0xcb, 0x6d, 0x43, 0x90, 0x2a, 0x1a, 0xd4, 0xd8,
-		0x59, 0x1b, 0xd4, 0xf8, 0x70, 0x0d, 0x6a, 0x17, 0x2e, 0xc6, 0xf2, 0x4c, 0x56, 0x5e, 0x8e, 0x17,
-		0x12, 0x2e, 0xc8, 0x8b, 0x04, 0xa4, 0x95, 0xd6, 0x2f, 0x76, 0xc9, 0xda, 0x96, 0xb7, 0x42, 0x63,
-		0x31, 0xe6, 0xdd, 0xf5, 0xb6, 0x18, 0xe7, 0xae, 0x60, 0x44, 0xdf, 0x87, 0x45, 0xae, 0xa4, 0x5b,
-		0xe4, 0x64, 0x3f, 0x91, 0xf3, 0x9c, 0x31, 0x23, 0x6f, 0x07, 0xe6, 0x9a, 0x04, 0x07, 0x74, 0x8f,
-		0x60, 0xda, 0x16, 0x05, 0xfd, 0x44, 0xcd, 0xb6, 0x79, 0x62, 0x39, 0x09, 0xdc, 0x2f, 0xa5, 0x71,
-		0xff, 0x15, 0x5c, 0x49, 0x47, 0xc2, 0xf4, 0xf6, 0x4d, 0xda, 0xb4, 0x43, 0x33, 0x66, 0x98, 0xea,
-		0xeb, 0xd8, 0x4a, 0x2a, 0x32, 0x4f, 0xf7, 0x77, 0x9b, 0x76, 0xb8, 0x21, 0xe5, 0xd7, 0x93, 0x27,
-		0xb0, 0x08, 0xc5, 0xb6, 0x13, 0x72, 0x6c, 0xeb, 0x97, 0x29, 0x9d, 0x43, 0x6c, 0x0b, 0xae, 0xee,
-		0x31, 0xac, 0x7c, 0xba, 0x31, 0xec, 0x3d, 0x98, 0x69, 0xcb, 0x11, 0x1d, 0x83, 0xc3, 0xe3, 0xa4,
-		0x51, 0x8e, 0x97, 0xb7, 0xf9, 0x2a, 0xfa, 0x08, 0xc6, 0x9a, 0x04, 0x5b, 0x24, 0x90, 0xe8, 0x77,
-		0x49, 0xa9, 0xe9, 0x31, 0x27, 0x31, 0x24, 0x69, 0x1e, 0x1a, 0xcc, 0x9d, 0x0b, 0x1a, 0xbc, 0x59,
-		0x20, 0x53, 0x61, 0xcd, 0xc2, 0xa9, 0xb1, 0xa6, 0xfa, 0x97, 0x51, 0x58, 0xdc, 0xb0, 0x2c, 0xd5,
-		0xe5, 0x25, 0xd5, 0xbc, 0xb5, 0x4c, 0xf3, 0x7e, 0x43, 0x0d, 0xf1, 0x1e, 0x4c, 0x76, 0x86, 0xb6,
-		0x91, 0x41, 0x86, 0xb6, 0x09, 0x1a, 0xcf, 0x68, 0x57, 0xa1, 0xd4, 0xee, 0x16, 0x72, 0x56, 0x1f,
-		0x31, 0x20, 0x5e, 0xaa, 0x5b, 0xd9, 0x76, 0x22, 0x9b, 0x80, 0x2c, 0xd8, 0xe2, 0x10, 0xed, 0x84,
-		0x8f, 0xf6, 0x71, 0xd9, 0xde, 0x83, 0xb1, 0xd0, 0x8b, 0x82, 0x86, 0x68, 0x8f, 0xe5, 0x2c, 0x18,
-		0x27, 0xe6, 0x58, 0x1c, 0x1e, 0x3e, 0xe7, 0x94, 0x86, 0xe4, 0x50, 0xa0, 0xdc, 0xb8, 0x0a, 0xe5,
-		0x7c, 0x45, 0x46, 0x4d, 0xf4, 0x7b, 0x8c, 0x50, 0x47, 0xb5, 0x96, 0x49, 0x30, 0xf9, 0x34, 0x90,
-		0xc9, 0xb2, 0xca, 0x26, 0x2c, 0xa8, 0x08, 0x15, 0xa3, 0xc8, 0x42, 0x72, 0x14, 0x99, 0x4c, 0x8e,
-		0x19, 0xc7, 0x70, 0xa1, 0xcb, 0x06, 0x89, 0xb6, 0xaa, 0x12, 0xd1, 0xce, 0xab, 0x44, 0xaa, 0xff,
-		0x2c, 0xf2, 0x9c, 0x56, 0xcd, 0x36, 0xdf, 0x44, 0x4e, 0xb3, 0x9b, 0x1f, 0x0f, 0xb7, 0xd9, 0x51,
-		0x2d, 0x90, 0xbe, 0x2c, 0xd6, 0xb7, 0x63, 0x03, 0x52, 0xd9, 0x3f, 0x7a, 0xa6, 0xec, 0x2f, 0x0e,
-		0x97, 0xfd, 0x63, 0x67, 0xcf, 0xfe, 0xf1, 0x73, 0xc8, 0xfe, 0x09, 0x55, 0xf6, 0xbb, 0xa0, 0xe3,
-		0x44, 0x28, 0xb7, 0xed, 0xd0, 0x67, 0x59, 0xc1, 0xee, 0x7d, 0x12, 0xb1, 0xd7, 0x7b, 0x54, 0x41,
-		0x0e, 0xa7, 0x91, 0x2b, 0x53, 0x59, 0x6d, 0x30, 0x40, 0xb5, 0x29, 0xf2, 0xed, 0x2d, 0x56, 0xdb,
-		0xd7, 0x23, 0xa0, 0xe7, 0x1d, 0x16, 0x7d, 0x0f, 0x66, 0x3a, 0x03, 0x04, 0xbf, 0xad, 0xca, 0x72,
-		0x53, 0xe3, 0xb2, 0xbc, 0x97, 0xf1, 0x27, 0x05, 0xa3, 0x33, 0x04, 0xf2, 0xef, 0xae, 0x99, 0xae,
-		0x30, 0xdc, 0x4c, 0x97, 0x98, 0x72, 0x46, 0x86, 0x9d, 0x72, 0x46, 0xcf, 0x7f, 0xca, 0x29, 0x9e,
-		0xcf, 0x94, 0x33, 0x76, 0x6e, 0x53, 0xce, 0xb8, 0x6a, 0xca, 0x91, 0xbd, 0x54, 0x79, 0x73, 0x79,
-		0xb3, 0xbd, 0xf4, 0x6b, 0x0d, 0x16, 0xf8, 0x05, 0x32, 0x3e, 0x45, 0xdc, 0x49, 0xb7, 0xb2, 0xb7,
-		0xc4, 0xf7, 0x95, 0x87, 0x57, 0xf1, 0x0e, 0x78, 0x3f, 0x3c, 0xcb, 0x2c, 0x30, 0xd8, 0xf5, 0xb1,
-		0xfa, 0x6f, 0x0d, 0xde, 0xc9, 0x58, 0x28, 0xbd, 0xfa, 0x00, 0xa6, 0xf8, 0x6b, 0x95, 0x19, 0x90,
-		0x30, 0x72, 0xe2, 0x33, 0xf6, 0xce, 0x93, 0x12, 0xe7, 0x30, 0x38, 0x03, 0xaa, 0x43, 0x39, 0x16,
-		0xf0, 0x63, 0xd2, 0xa0, 0xc4, 0xea, 0x79, 0x57, 0x17, 0x77, 0x74, 0x49, 0x69, 0x4c, 0xbf, 0x4e,
-		0x7e, 0xa2, 0x97, 0x8a, 0x08, 0x0b, 0x7f, 0x7c, 0xd0, 0xd3, 0x1f, 0x7d, 0x83, 0xfb, 0x77, 0x0d,
-		0x96, 0xc5, 0x89, 0x2d, 0x6e, 0x00, 0x63, 0xdc, 0xf2, 0x5a, 0xbe, 0x43, 0x98, 0x15, 0x32, 0x46,
-		0x4f, 0xb3, 0x81, 0xbe, 0xad, 0x54, 0xda, 0x4f, 0xce, 0x5b, 0x08, 0xfa, 0x05, 0x18, 0xe7, 0xbc,
-		0x72, 0xf8, 0x9b, 0x34, 0xc6, 0xd8, 0x67, 0xdd, 0xaa, 0x5e, 0x87, 0x6b, 0x3d, 0xcc, 0x13, 0x11,
-		0xaf, 0xfe, 0x55, 0x83, 0xcb, 0x5b, 0x6c, 0x8c, 0x77, 0x9e, 0x46, 0x34, 0xa4, 0xd8, 0xb5, 0x6c,
-		0xf7, 0xe0, 0x99, 0xe7, 0x38, 0x03, 0xcd, 0x0e, 0xa9, 0xc7, 0x8c, 0x42, 0xe6, 0x31, 0xe3, 0x11,
-		0x94, 0xdb, 0x87, 0xea, 0x3c, 0x4e, 0x97, 0x73, 0xfa, 0x45, 0x7c, 0x32, 0xd1, 0x2f, 0x68, 0xe2,
-		0xeb, 0x2c, 0x03, 0x42, 0xf5, 0x2a, 0x2c, 0xe5, 0x1c, 0x4f, 0x3a, 0xe0, 0x27, 0x70, 0x61, 0x9b,
-		0x84, 0x8d, 0xc0, 0xde, 0x23, 0x6d, 0x76, 0x79, 0xf4, 0x9d, 0x6c, 0x0e, 0xa8, 0x13, 0x2f, 0x87,
-		0x7d, 0xb0, 0xd0, 0x57, 0xff, 0x54, 0x00, 0xbd, 0x5b, 0x82, 0xac, 0xc7, 0x8f, 0x61, 0x5c, 0xb8,
-		0x53, 0xfc, 0xa0, 0x58, 0x5a, 0xbf, 0x9a, 0xfb, 0x28, 0x45, 0x02, 0x0e, 0xf0, 0x31, 0x3d, 0xbb,
-		0x31, 0x75, 0xbc, 0x1f, 0x52, 0x4c, 0xa3, 0x50, 0xd6, 0xe2, 0xf5, 0x9e, 0xbe, 0x7b, 0xce, 0x49,
-		0x8d, 0x32, 0x4d, 0x7d, 0xbf, 0xb1, 0x6a, 0x3c, 0x53, 0x70, 0x43, 0x58, 0xe2, 0x49, 0x92, 0xd5,
-		0x15, 0xc6, 0x11, 0x5c, 0x84, 0x31, 0x09, 0x30, 0x22, 0x73, 0xe5, 0x57, 0x5a, 0x69, 0x61, 0x38,
-		0xa5, 0xbf, 0x28, 0xc0, 0x95, 0x3c, 0xad, 0x32, 0x6c, 0xaf, 0x61, 0xa9, 0xf3, 0x7e, 0xd5, 0x0e,
-		0x42, 0xe2, 0x27, 0x4e, 0x11, 0xcc, 0xda, 0x60, 0x9e, 0x7b, 0x42, 0x28, 0xb6, 0x30, 0xc5, 0x46,
-		0x25, 0x39, 0xbc, 0xa5, 0x55, 0x33, 0x95, 0xed, 0x9f, 0x17, 0x94, 0x2a, 0x0b, 0xa7, 0x53, 0x69,
-		0x25, 0x2e, 0x32, 0x69, 0x95, 0xd5, 0xdb, 0x70, 0xe9, 0x11, 0x69, 0xbb, 0x21, 0xdc, 0x3c, 0x11,
-		0xa8, 0xdd, 0xc7, 0xf7, 0xd5, 0x3f, 0x8e, 0xc2, 0x65, 0x35, 0x9f, 0xf4, 0xde, 0xcf, 0x34, 0x58,
-		0x54, 0x9c, 0xa5, 0x85, 0x7d, 0xe9, 0xb7, 0xa7, 0xf9, 0x08, 0xdf, 0x4b, 0x70, 0x6d, 0x3b, 0x73,
-		0x96, 0x27, 0xd8, 0x17, 0xa3, 0xe9, 0xbc, 0xd5, 0xbd, 0xc3, 0xcd, 0x50, 0x44, 0x91, 0x99, 0x51,
-		0x38, 0x93, 0x19, 0x1b, 0x99, 0x28, 0x76, 0xcc, 0xc0, 0xdd, 0x3b, 0x95, 0xaf, 0x58, 0x7b, 0x50,
-		0xdb, 0xad, 0x98, 0x94, 0x1f, 0xa7, 0x9f, 0xc8, 0x7b, 0x5c, 0x11, 0xf2, 0x7a, 0x4e, 0xf2, 0xa7,
-		0xeb, 0xaf, 0xd2, 0xc3, 0xf5, 0xdb, 0xd4, 0x5d, 0xfd, 0x5d, 0x01, 0xde, 0xfd, 0xdc, 0xb7, 0x30,
-		0x25, 0x79, 0xad, 0x64, 0x10, 0x80, 0x3a, 0x43, 0xa1, 0x9f, 0x1f, 0x7e, 0xa9, 0x7a, 0xe7, 0xe8,
-		0x79, 0x4c, 0x32, 0xef, 0xc1, 0x8d, 0x3e, 0x2e, 0x92, 0x20, 0xf7, 0xfb, 0x02, 0xdc, 0x30, 0xc8,
-		0x7e, 0x40, 0xc2, 0xe6, 0xff, 0xbc, 0x99, 0xe7, 0xcd, 0x15, 0xb8, 0xd9, 0xcf, 0x47, 0xc2, 0x9d,
-		0xeb, 0xff, 0x9a, 0x82, 0xd2, 0x13, 0x99, 0xcf, 0x1b, 0xcf, 0xea, 0xe8, 0xa7, 0x1a, 0xcc, 0x2b,
-		0x7e, 0x2a, 0x44, 0xb7, 0x86, 0xfc, 0x65, 0x91, 0x87, 0xa0, 0x72, 0xfb, 0x54, 0xbf, 0x47, 0x26,
-		0x8d, 0x48, 0x16, 0xed, 0x00, 0x46, 0x28, 0xae, 0xf0, 0x03, 0x18, 0xa1, 0xbc, 0x96, 0x1d, 0xc1,
-		0x4c, 0xe6, 0xf5, 0x0b, 0x7d, 0x38, 0xec, 0x63, 0x5d, 0x65, 0x6d, 0x08, 0x8e, 0x94, 0xde, 0xd4,
-		0xb9, 0x3f, 0x1c, 0xf6, 0xd9, 0xa2, 0x8f, 0x5e, 0xe5, 0x79, 0x7d, 0x98, 0x4e, 0xdd, 0xa4, 0x50,
-		0x2d, 0x5f, 0x86, 0xea, 0x52, 0x58, 0x59, 0x1d, 0x98, 0x5e, 0x6a, 0xfc, 0xb5, 0x06, 0x17, 0x73,
-		0xc7, 0x7a, 0x74, 0x2f, 0x5f, 0x5c, 0xbf, 0xab, 0x4a, 0xe5, 0xfe, 0xa9, 0x78, 0xa5, 0x59, 0xbf,
-		0xd4, 0xe0, 0x1d, 0xe5, 0xa0, 0x8d, 0xee, 0xe4, 0x8b, 0xed, 0x75, 0xf1, 0xa8, 0x7c, 0x7b, 0x68,
-		0x3e, 0x69, 0xca, 0x09, 0xcc, 0x66, 0x01, 0x06, 0xad, 0x0d, 0x03, 0x46, 0x42, 0xff, 0x29, 0xf0,
-		0x0b, 0xfd, 0x4a, 0x83, 0x45, 0xf5, 0x6c, 0x88, 0x7a, 0x1c, 0xa7, 0xe7, 0x0c, 0x5b, 0xb9, 0x3b,
-		0x3c, 0xa3, 0xb4, 0xe6, 0xe7, 0x1a, 0x2c, 0xa8, 0x26, 0x11, 0x74, 0x7b, 0xd8, 0xc9, 0x45, 0x58,
-		0x72, 0xe7, 0x74, 0x03, 0x0f, 0xfa, 0xad, 0x06, 0x4b, 0x3d, 0x71, 0x0a, 0x7d, 0x92, 0x2f, 0x79,
-		0x90, 0x19, 0xa0, 0xf2, 0xe0, 0xd4, 0xfc, 0xd2, 0xc4, 0x3f, 0x68, 0x70, 0xa5, 0x77, 0xf3, 0x47,
-		0x0f, 0x7a, 0x95, 0xc7, 0x00, 0xd0, 0x5a, 0xf9, 0xee, 0xe9, 0x05, 0x08, 0x2b, 0x37, 0xef, 0xff,
-		0xe0, 0xe3, 0x03, 0x9b, 0x36, 0xa3, 0xbd, 0x5a, 0xc3, 0x6b, 0xad, 0xa6, 0xfe, 0x63, 0xb5, 0x76,
-		0x40, 0x5c, 0xf1, 0x2f, 0xbe, 0xc9, 0xff, 0x32, 0xbe, 0x1f, 0xff, 0x7d, 0xb4, 0xb6, 0x37, 0xc6,
-		0x77, 0x3f, 0xfa, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7a, 0xe2, 0xa5, 0xb6, 0x93, 0x2c, 0x00,
-		0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4b, 0x73, 0xdc, 0x48,
+		0x1d, 0x2f, 0x8d, 0x3d, 0x7e, 0xfc, 0xc7, 0x1e, 0xdb, 0x6d, 0xc7, 0x51, 0x26, 0x71, 0xe2, 0x4c,
+		0x36, 0x59, 0x2f, 0x2c, 0xe3, 0xb5, 0x77, 0xb3, 0x64, 0x93, 0x62, 0x83, 0x1f, 0x71, 0x32, 0xd4,
+		0x86, 0x64, 0x15, 0x6f, 0x52, 0x05, 0x5b, 0x11, 0xed, 0x51, 0xdb, 0x23, 0xac, 0x91, 0x14, 0xa9,
+		0x65, 0xaf, 0xf7, 0xc0, 0x81, 0x02, 0x8a, 0x2a, 0xae, 0x7c, 0x00, 0x5e, 0x9f, 0x80, 0x1b, 0xdf,
+		0x84, 0xaa, 0x14, 0x07, 0x0e, 0x7c, 0x00, 0xa8, 0xe2, 0xc6, 0x81, 0xea, 0x87, 0x66, 0x24, 0x4d,
+		0x6b, 0x1e, 0xb6, 0x93, 0xe5, 0xc0, 0xcd, 0xea, 0xfe, 0xbf, 0xfa, 0xff, 0xfa, 0xfd, 0xbb, 0xc7,
+		0x70, 0x2b, 0xda, 0x23, 0xc1, 0x6a, 0x03, 0x5b, 0xc4, 0x6d, 0x90, 0xd5, 0x16, 0xa6, 0x8d, 0xa6,
+		0xed, 0x1e, 0xac, 0x1e, 0xad, 0xad, 0x86, 0x24, 0x38, 0xb2, 0x1b, 0xa4, 0xe6, 0x07, 0x1e, 0xf5,
+		0x90, 0xce, 0xe8, 0x6a, 0x92, 0xae, 0x16, 0xd3, 0xd5, 0x8e, 0xd6, 0x2a, 0x57, 0x0f, 0x3c, 0xef,
+		0xc0, 0x21, 0xab, 0x9c, 0x6e, 0x2f, 0xda, 0x5f, 0xb5, 0xa2, 0x00, 0x53, 0xdb, 0x73, 0x05, 0x67,
+		0xe5, 0x5a, 0x76, 0x9f, 0xda, 0x2d, 0x12, 0x52, 0xdc, 0xf2, 0x25, 0x41, 0x97, 0x80, 0xe3, 0x00,
+		0xfb, 0x3e, 0x09, 0x42, 0xb9, 0xbf, 0x9c, 0x32, 0x11, 0xfb, 0x36, 0xb3, 0xae, 0xe1, 0xb5, 0x5a,
+		0x1d, 0x15, 0x2a, 0x8a, 0x57, 0x11, 0x09, 0x4e, 0x24, 0x41, 0x55, 0x45, 0x40, 0x71, 0x78, 0xe8,
+		0xd8, 0x21, 0x95, 0x34, 0x2b, 0x2a, 0x1a, 0xe9, 0x04, 0xf3, 0xd8, 0x0b, 0x0e, 0x49, 0x20, 0x29,
+		0xbf, 0xd5, 0x8f, 0x72, 0xdf, 0xf1, 0x8e, 0x25, 0xed, 0x75, 0x15, 0x6d, 0xd3, 0x0e, 0xa9, 0xd7,
+		0x36, 0xee, 0x9d, 0x14, 0x49, 0xd8, 0xc4, 0x01, 0xb1, 0xba, 0xa9, 0x6e, 0xe6, 0x50, 0xa5, 0x4f,
+		0x51, 0xfd, 0x14, 0xe6, 0x76, 0x71, 0x78, 0xf8, 0x99, 0x1d, 0xd2, 0xa7, 0x38, 0xa0, 0x36, 0x0b,
+		0x04, 0x7a, 0x0f, 0x66, 0xed, 0xd0, 0x73, 0x78, 0x54, 0xcc, 0x83, 0xc0, 0x8b, 0xfc, 0x50, 0xd7,
+		0x96, 0x47, 0x56, 0x26, 0x8d, 0x99, 0xf6, 0xfa, 0x43, 0xbe, 0x5c, 0xfd, 0xfb, 0x28, 0x5c, 0xec,
+		0x12, 0xb0, 0xe5, 0xb9, 0xfb, 0xf6, 0x01, 0xd2, 0x61, 0xfc, 0x88, 0x04, 0xa1, 0xed, 0xb9, 0xba,
+		0xb6, 0xac, 0xad, 0x8c, 0x18, 0xf1, 0x27, 0x5a, 0x87, 0x79, 0x37, 0x6a, 0x99, 0x01, 0xc1, 0x96,
+		0xe9, 0xc7, 0x5c, 0xa1, 0x5e, 0x58, 0xd6, 0x56, 0x8a, 0x9b, 0x05, 0x5d, 0x33, 0xe6, 0xdc, 0xa8,
+		0x65, 0x10, 0x6c, 0xb5, 0x45, 0x86, 0xe8, 0x23, 0x58, 0x60, 0x3c, 0xc7, 0x81, 0x4d, 0x49, 0x92,
+		0x69, 0xa4, 0xcd, 0x84, 0xdc, 0xa8, 0xf5, 0x82, 0x6d, 0x27, 0xb8, 0x5c, 0x98, 0xc9, 0x6a, 0x19,
+		0x5d, 0x1e, 0x59, 0x29, 0xad, 0x3f, 0xa8, 0xe5, 0x65, 0x68, 0x2d, 0xe7, 0x3c, 0xb5, 0xb4, 0x41,
+		0x0f, 0x5c, 0x1a, 0x9c, 0x18, 0xe5, 0x20, 0x6d, 0xe5, 0x2b, 0x98, 0xed, 0xb2, 0xb0, 0xc8, 0x15,
+		0xee, 0x0c, 0xaf, 0x30, 0x73, 0x18, 0xa1, 0x71, 0xe6, 0x38, 0xbd, 0x5a, 0x71, 0x61, 0x5e, 0x61,
+		0x19, 0x9a, 0x85, 0x91, 0x43, 0x72, 0xc2, 0x3d, 0x5f, 0x34, 0xd8, 0x9f, 0x68, 0x03, 0x8a, 0x47,
+		0xd8, 0x89, 0x08, 0xf7, 0x73, 0x69, 0xfd, 0xdb, 0x43, 0x18, 0x64, 0x08, 0xce, 0xbb, 0x85, 0x3b,
+		0x5a, 0xc5, 0x83, 0x05, 0x95, 0x61, 0x6f, 0x4c, 0x61, 0xf5, 0x27, 0x30, 0xf7, 0x99, 0x87, 0xad,
+		0x4d, 0xec, 0x60, 0xb7, 0x41, 0x82, 0x47, 0xb6, 0x4b, 0x43, 0x74, 0x03, 0xa6, 0xf7, 0x70, 0xe3,
+		0xd0, 0xf1, 0x0e, 0xcc, 0x86, 0x17, 0xb9, 0x54, 0xa6, 0xd8, 0x94, 0x5c, 0xdc, 0x62, 0x6b, 0xe8,
+		0x16, 0xcc, 0x04, 0x98, 0x05, 0x83, 0x04, 0x66, 0x48, 0x1a, 0x9e, 0x6b, 0x71, 0x53, 0x34, 0x63,
+		0x9a, 0x2d, 0x3f, 0x25, 0xc1, 0x33, 0xbe, 0x58, 0xfd, 0xa7, 0x06, 0x95, 0xa7, 0x9e, 0xe3, 0xec,
+		0x78, 0xc1, 0x36, 0x69, 0xd8, 0x2c, 0x47, 0x99, 0x45, 0x06, 0x79, 0x15, 0x91, 0x90, 0xa2, 0x3a,
+		0x8c, 0x07, 0xe2, 0x4f, 0xae, 0xa5, 0xb4, 0xbe, 0x9a, 0x3e, 0x09, 0xf6, 0x6d, 0x76, 0x88, 0x7c,
+		0x09, 0x46, 0xcc, 0x8f, 0x2e, 0xc3, 0xa4, 0xe5, 0xb5, 0xb0, 0xed, 0x9a, 0xb6, 0xb0, 0x65, 0xd2,
+		0x98, 0x10, 0x0b, 0x75, 0x8b, 0x6d, 0xfa, 0x9e, 0xe3, 0x90, 0x80, 0x6d, 0x8e, 0x88, 0x4d, 0xb1,
+		0x50, 0xb7, 0xd0, 0x4d, 0x28, 0xef, 0x7b, 0xc1, 0x31, 0x0e, 0x2c, 0x62, 0x99, 0xfb, 0x81, 0xd7,
+		0xd2, 0x47, 0x39, 0xc5, 0x74, 0x7b, 0x75, 0x27, 0xf0, 0x5a, 0xe8, 0x5d, 0x98, 0xc9, 0xd4, 0xae,
+		0x5e, 0xe4, 0x74, 0xe5, 0x74, 0xe9, 0x56, 0x5f, 0x97, 0xe0, 0xb2, 0xd2, 0xe2, 0xd0, 0xf7, 0xdc,
+		0x90, 0xa0, 0x25, 0x00, 0xd6, 0x2b, 0x4c, 0xea, 0x1d, 0x12, 0x51, 0xc0, 0x53, 0xc6, 0x24, 0x5b,
+		0xd9, 0x65, 0x0b, 0xe8, 0x0b, 0x40, 0x71, 0xeb, 0x32, 0xc9, 0x57, 0xa4, 0x11, 0x31, 0xc9, 0x32,
+		0xd0, 0xb7, 0x94, 0xee, 0x79, 0x21, 0xc9, 0x1f, 0xc4, 0xd4, 0xc6, 0xdc, 0x71, 0x76, 0x09, 0xed,
+		0xc0, 0x74, 0x5b, 0x2c, 0x3d, 0xf1, 0x09, 0x77, 0x43, 0x69, 0xfd, 0x7a, 0x4f, 0x89, 0xbb, 0x27,
+		0x3e, 0x31, 0xa6, 0x8e, 0x13, 0x5f, 0xe8, 0x39, 0x5c, 0xf2, 0x03, 0x72, 0x64, 0x7b, 0x51, 0x68,
+		0x86, 0x14, 0x07, 0x94, 0x58, 0x26, 0x39, 0x22, 0x2e, 0x65, 0xae, 0x1d, 0xe5, 0x32, 0x2f, 0xd7,
+		0x04, 0x90, 0xd4, 0x62, 0x20, 0xa9, 0xd5, 0x5d, 0xfa, 0xf1, 0x47, 0xcf, 0x59, 0xde, 0x19, 0x8b,
+		0x31, 0xf7, 0x33, 0xc1, 0xfc, 0x80, 0xf1, 0xd6, 0x2d, 0xb4, 0x02, 0xb3, 0x5d, 0xe2, 0x8a, 0x3c,
+		0xf3, 0xca, 0x61, 0x9a, 0x52, 0x87, 0x71, 0x4c, 0x29, 0x69, 0xf9, 0x54, 0x1f, 0xe3, 0x25, 0x11,
+		0x7f, 0xa2, 0x2a, 0x4c, 0xbb, 0xe4, 0x2b, 0xda, 0x11, 0x30, 0xce, 0x05, 0x94, 0xd8, 0x62, 0xcc,
+		0xfd, 0x3e, 0xa0, 0x54, 0x7a, 0x9b, 0x4d, 0xdb, 0xa5, 0xfa, 0x04, 0x27, 0x9c, 0x4d, 0xe6, 0x38,
+		0xab, 0x06, 0x74, 0x07, 0xf4, 0x90, 0xda, 0x8d, 0xc3, 0x93, 0x4e, 0x28, 0x4c, 0xe2, 0xe2, 0x3d,
+		0x87, 0x58, 0xfa, 0xe4, 0xb2, 0xb6, 0x32, 0x61, 0x2c, 0x8a, 0xfd, 0xb6, 0xa3, 0x1f, 0x88, 0x5d,
+		0x74, 0x07, 0x8a, 0x1c, 0xf8, 0x74, 0xe0, 0x3e, 0xa9, 0xf6, 0xf4, 0xf3, 0xe7, 0x8c, 0xd2, 0x10,
+		0x0c, 0xc8, 0x80, 0x69, 0x4b, 0xe6, 0x8d, 0x69, 0xbb, 0xfb, 0x9e, 0x5e, 0xe2, 0x12, 0xbe, 0x93,
+		0x96, 0x20, 0x80, 0x87, 0x97, 0x78, 0x80, 0xdd, 0xd0, 0x26, 0x2e, 0x8d, 0xb3, 0xad, 0xee, 0xee,
+		0x7b, 0xc6, 0x94, 0x95, 0xf8, 0x42, 0x2f, 0xe1, 0x4a, 0x77, 0x52, 0x99, 0x3c, 0x0d, 0x19, 0x66,
+		0xe9, 0x53, 0x5c, 0xc5, 0x92, 0xd2, 0xc8, 0xb8, 0x85, 0x18, 0x97, 0xba, 0xb2, 0x2a, 0xde, 0x42,
+		0x35, 0x98, 0x17, 0x4e, 0x67, 0x48, 0x49, 0xcc, 0x18, 0x9d, 0xa6, 0x79, 0x7c, 0xe6, 0xf8, 0xd6,
+		0x33, 0xb6, 0xf3, 0x5c, 0xe2, 0xd4, 0x75, 0x98, 0xda, 0x0b, 0xb0, 0xdb, 0x68, 0xca, 0x2a, 0x28,
+		0xf3, 0x2a, 0x28, 0x89, 0x35, 0x51, 0x07, 0x1b, 0x50, 0x0e, 0x1b, 0x4d, 0x62, 0x45, 0x0e, 0xb1,
+		0x4c, 0x36, 0xaa, 0xe8, 0x33, 0xdc, 0xc8, 0x4a, 0x57, 0x76, 0xed, 0xc6, 0x73, 0x8c, 0x31, 0xdd,
+		0xe6, 0x60, 0x6b, 0xe8, 0x7b, 0x30, 0x15, 0xe7, 0x14, 0x17, 0x30, 0xdb, 0x57, 0x40, 0x49, 0xd2,
+		0x73, 0xf6, 0x2f, 0x61, 0x9c, 0x45, 0xc4, 0x26, 0xa1, 0x3e, 0xc7, 0x91, 0x66, 0x33, 0xbf, 0xcf,
+		0xf6, 0x28, 0xf8, 0xda, 0xe7, 0x42, 0x88, 0x40, 0x99, 0x58, 0x24, 0x73, 0x19, 0xf5, 0x28, 0x76,
+		0x4c, 0x39, 0x5e, 0x98, 0x7b, 0x27, 0x94, 0x84, 0x3a, 0xe2, 0x99, 0x38, 0xc7, 0xb7, 0x1e, 0x89,
+		0x9d, 0x4d, 0xb6, 0x81, 0xbe, 0x84, 0xd9, 0x36, 0xf4, 0x99, 0x0d, 0x8e, 0x63, 0xfa, 0x3c, 0x3f,
+		0xd0, 0xda, 0xd0, 0x00, 0x68, 0xcc, 0xf8, 0x99, 0x91, 0xe2, 0xc7, 0x30, 0xef, 0x78, 0xd8, 0x32,
+		0xf7, 0x24, 0x16, 0xf0, 0xb2, 0x08, 0xf5, 0x85, 0x7e, 0xf8, 0xd2, 0x85, 0x1f, 0xc6, 0x9c, 0xd3,
+		0x05, 0x29, 0x8f, 0x61, 0x16, 0x47, 0xd4, 0x93, 0x56, 0x8b, 0x8a, 0xbb, 0xc0, 0x25, 0xdf, 0x50,
+		0x66, 0xdc, 0x46, 0x44, 0x3d, 0x61, 0x17, 0xe3, 0x37, 0xca, 0x38, 0xf5, 0xcd, 0xba, 0x79, 0xd3,
+		0xf3, 0x4d, 0x1a, 0xe0, 0x06, 0xd1, 0x17, 0x45, 0x37, 0x6f, 0x7a, 0xfe, 0x2e, 0xfb, 0xae, 0xbc,
+		0x84, 0xa9, 0xa4, 0xbf, 0x93, 0xe0, 0x39, 0x29, 0xc0, 0xf3, 0x4e, 0x1a, 0x3c, 0x07, 0xaa, 0xcc,
+		0x0e, 0x66, 0x26, 0x10, 0x6d, 0xa3, 0x41, 0xed, 0x23, 0x9b, 0x9e, 0x9c, 0x1e, 0xd1, 0x14, 0x12,
+		0xfe, 0x17, 0x11, 0xed, 0xcf, 0xd0, 0x46, 0xb4, 0xb4, 0xc5, 0xdf, 0x28, 0xa2, 0x5d, 0x83, 0x12,
+		0x96, 0xd6, 0x74, 0x9c, 0x00, 0xf1, 0x52, 0xdd, 0x62, 0x90, 0xd7, 0x26, 0xe0, 0x90, 0x37, 0xda,
+		0x03, 0xf2, 0xda, 0x07, 0xe3, 0x90, 0x87, 0x13, 0x5f, 0x68, 0x1d, 0x8a, 0xb6, 0xeb, 0x47, 0x94,
+		0x7b, 0xa7, 0xb4, 0x7e, 0x45, 0x1d, 0x51, 0x7c, 0xc2, 0x12, 0xdf, 0x10, 0xa4, 0x8a, 0xee, 0x35,
+		0x76, 0xd6, 0xee, 0x35, 0x3e, 0x5c, 0xf7, 0xda, 0x85, 0x4b, 0xb1, 0x3c, 0x93, 0xd5, 0x9e, 0xe3,
+		0x85, 0x84, 0x0b, 0xf2, 0x22, 0x81, 0x77, 0xa5, 0xf5, 0x4b, 0x5d, 0xb2, 0xb6, 0xe5, 0x95, 0xd1,
+		0x58, 0x8c, 0x79, 0x77, 0xbd, 0x2d, 0xc6, 0xb9, 0x2b, 0x18, 0xd1, 0x0f, 0x61, 0x91, 0x2b, 0xe9,
+		0x16, 0x39, 0xd9, 0x4f, 0xe4, 0x3c, 0x67, 0xcc, 0xc8, 0xdb, 0x81, 0xb9, 0x26, 0xc1, 0x01, 0xdd,
+		0x23, 0x98, 0xb6, 0x45, 0x41, 0x3f, 0x51, 0xb3, 0x6d, 0x9e, 0x58, 0x4e, 0x62, 0x28, 0x28, 0xa5,
+		0x87, 0x82, 0x97, 0x70, 0x35, 0x1d, 0x09, 0xd3, 0xdb, 0x37, 0x69, 0xd3, 0x0e, 0xcd, 0x98, 0x61,
+		0xaa, 0xaf, 0x63, 0x2b, 0xa9, 0xc8, 0x3c, 0xd9, 0xdf, 0x6d, 0xda, 0xe1, 0x86, 0x94, 0x5f, 0x4f,
+		0x9e, 0xc0, 0x22, 0x14, 0xdb, 0x4e, 0xc8, 0x81, 0xaf, 0x5f, 0xa6, 0x74, 0x0e, 0xb1, 0x2d, 0xb8,
+		0xba, 0x67, 0xb4, 0xf2, 0xe9, 0x66, 0xb4, 0x77, 0x61, 0xa6, 0x2d, 0x47, 0x74, 0x0c, 0x8e, 0x9d,
+		0x93, 0x46, 0x39, 0x5e, 0xde, 0xe6, 0xab, 0xe8, 0x43, 0x18, 0x6b, 0x12, 0x6c, 0x91, 0x40, 0x42,
+		0xe3, 0x65, 0xa5, 0xa6, 0x47, 0x9c, 0xc4, 0x90, 0xa4, 0x79, 0x50, 0x31, 0x77, 0x2e, 0x50, 0xf1,
+		0x66, 0x51, 0x4e, 0x05, 0x44, 0x0b, 0xe7, 0x04, 0x44, 0x17, 0xd2, 0x40, 0x54, 0xfd, 0xeb, 0x28,
+		0x2c, 0x6e, 0x58, 0x96, 0xea, 0xda, 0x93, 0xea, 0xec, 0x5a, 0xa6, 0xb3, 0xbf, 0xa1, 0x6e, 0x79,
+		0x17, 0x26, 0x3b, 0xe3, 0xde, 0xc8, 0x20, 0xe3, 0xde, 0x04, 0x8d, 0xa7, 0xbb, 0x6b, 0x50, 0x6a,
+		0xb7, 0x12, 0x39, 0xe5, 0x8f, 0x18, 0x10, 0x2f, 0xd5, 0xad, 0x6c, 0xaf, 0x91, 0x1d, 0x42, 0x56,
+		0x73, 0x71, 0x88, 0x5e, 0xc3, 0x2f, 0x05, 0x71, 0x4d, 0xdf, 0x85, 0xb1, 0xd0, 0x8b, 0x82, 0x86,
+		0xe8, 0x9d, 0xe5, 0x2c, 0x52, 0x27, 0x26, 0x60, 0x1c, 0x1e, 0x3e, 0xe3, 0x94, 0x86, 0xe4, 0x50,
+		0x40, 0xe0, 0xb8, 0x0a, 0x02, 0x7d, 0x45, 0xba, 0x4d, 0xf4, 0x7b, 0xc6, 0x50, 0x47, 0xb5, 0x96,
+		0xc9, 0x3e, 0xf9, 0xa8, 0x90, 0x49, 0xc1, 0xca, 0x26, 0x2c, 0xa8, 0x08, 0x15, 0x73, 0xca, 0x42,
+		0x72, 0x4e, 0x99, 0x4c, 0xce, 0x20, 0xc7, 0x70, 0xb1, 0xcb, 0x06, 0x09, 0xc5, 0xaa, 0xfa, 0xd1,
+		0xce, 0xab, 0x7e, 0xaa, 0xff, 0x2a, 0xf2, 0x9c, 0x56, 0x0d, 0x3e, 0xdf, 0x44, 0x4e, 0xb3, 0x3b,
+		0x23, 0x0f, 0xb7, 0xd9, 0x51, 0x2d, 0xc6, 0x80, 0xb2, 0x58, 0xdf, 0x8e, 0x0d, 0x48, 0x65, 0xff,
+		0xe8, 0x99, 0xb2, 0xbf, 0x38, 0x5c, 0xf6, 0x8f, 0x9d, 0x3d, 0xfb, 0xc7, 0xcf, 0x21, 0xfb, 0x27,
+		0x54, 0xd9, 0xef, 0x82, 0x8e, 0x13, 0xa1, 0xdc, 0xb6, 0x43, 0x9f, 0x65, 0x05, 0xbb, 0x31, 0x4a,
+		0x38, 0x5f, 0xef, 0x51, 0x05, 0x39, 0x9c, 0x46, 0xae, 0x4c, 0x65, 0xb5, 0xc1, 0x00, 0xd5, 0xa6,
+		0xc8, 0xb7, 0xb7, 0x58, 0x6d, 0xaf, 0x47, 0x40, 0xcf, 0x3b, 0x2c, 0xfa, 0x01, 0xcc, 0x74, 0xa6,
+		0x0b, 0x7e, 0xcf, 0x95, 0xe5, 0xa6, 0x06, 0x6d, 0x79, 0xa3, 0xe3, 0x8f, 0x11, 0x46, 0x67, 0x42,
+		0xe4, 0xdf, 0x5d, 0x03, 0x5f, 0x61, 0xb8, 0x81, 0x2f, 0x31, 0x02, 0x8d, 0x0c, 0x3b, 0x02, 0x8d,
+		0x9e, 0xff, 0x08, 0x54, 0x3c, 0x9f, 0x11, 0x68, 0xec, 0xdc, 0x46, 0xa0, 0x71, 0xd5, 0x08, 0x24,
+		0x7b, 0xa9, 0xf2, 0x5a, 0xf3, 0x66, 0x7b, 0xe9, 0x6b, 0x0d, 0x16, 0xf8, 0xed, 0x32, 0x3e, 0x45,
+		0xdc, 0x49, 0xb7, 0xb2, 0x57, 0xc8, 0xf7, 0x94, 0x87, 0x57, 0xf1, 0x0e, 0x78, 0x79, 0x3c, 0xcb,
+		0x2c, 0x30, 0xd8, 0xdd, 0xb2, 0xfa, 0x1f, 0x0d, 0x2e, 0x64, 0x2c, 0x94, 0x5e, 0xbd, 0x0f, 0x53,
+		0xfc, 0x9d, 0xcb, 0x0c, 0x48, 0x18, 0x39, 0xf1, 0x19, 0x7b, 0xe7, 0x49, 0x89, 0x73, 0x18, 0x9c,
+		0x01, 0xd5, 0xa1, 0x1c, 0x0b, 0xf8, 0x29, 0x69, 0x50, 0x62, 0xf5, 0xbc, 0xc8, 0x8b, 0x0b, 0xbc,
+		0xa4, 0x34, 0xa6, 0x5f, 0x25, 0x3f, 0xd1, 0x0b, 0x45, 0x84, 0x85, 0x3f, 0xde, 0xef, 0xe9, 0x8f,
+		0xbe, 0xc1, 0xfd, 0x87, 0x06, 0xcb, 0xe2, 0xc4, 0x16, 0x37, 0x80, 0x31, 0x6e, 0x79, 0x2d, 0xdf,
+		0x21, 0xcc, 0x0a, 0x19, 0xa3, 0x27, 0xd9, 0x40, 0xdf, 0x56, 0x2a, 0xed, 0x27, 0xe7, 0x2d, 0x04,
+		0xfd, 0x22, 0x8c, 0x73, 0x5e, 0x39, 0xfc, 0x4d, 0x1a, 0x63, 0xec, 0xb3, 0x6e, 0x55, 0x6f, 0xc0,
+		0xf5, 0x1e, 0xe6, 0x89, 0x88, 0x57, 0xff, 0xa6, 0xc1, 0x95, 0x2d, 0x36, 0xe3, 0x3b, 0x4f, 0x22,
+		0x1a, 0x52, 0xec, 0x5a, 0xb6, 0x7b, 0xf0, 0xd4, 0x73, 0x9c, 0x81, 0x66, 0x87, 0xd4, 0x4b, 0x47,
+		0x21, 0xf3, 0xd2, 0xf1, 0x10, 0xca, 0xed, 0x43, 0x75, 0x9e, 0xb5, 0xcb, 0x39, 0xfd, 0x22, 0x3e,
+		0x99, 0xe8, 0x17, 0x34, 0xf1, 0x75, 0x96, 0x01, 0xa1, 0x7a, 0x0d, 0x96, 0x72, 0x8e, 0x27, 0x1d,
+		0xf0, 0x33, 0xb8, 0xb8, 0x4d, 0xc2, 0x46, 0x60, 0xef, 0x91, 0x36, 0xbb, 0x3c, 0xfa, 0x4e, 0x36,
+		0x07, 0xd4, 0x89, 0x97, 0xc3, 0x3e, 0x58, 0xe8, 0xab, 0x7f, 0x29, 0x80, 0xde, 0x2d, 0x41, 0xd6,
+		0xe3, 0x27, 0x30, 0x2e, 0xdc, 0x29, 0x7e, 0x8a, 0x2c, 0xad, 0x5f, 0xcb, 0x7d, 0xb1, 0x22, 0x01,
+		0x07, 0xf8, 0x98, 0x9e, 0x5d, 0xa7, 0x3a, 0xde, 0x0f, 0x29, 0xa6, 0x51, 0x28, 0x6b, 0xf1, 0x46,
+		0x4f, 0xdf, 0x3d, 0xe3, 0xa4, 0x46, 0x99, 0xa6, 0xbe, 0xdf, 0x58, 0x35, 0x9e, 0x29, 0xb8, 0x21,
+		0x2c, 0xf1, 0x24, 0xc9, 0xea, 0x0a, 0xe3, 0x08, 0x2e, 0xc2, 0x98, 0x04, 0x18, 0x91, 0xb9, 0xf2,
+		0x2b, 0xad, 0xb4, 0x30, 0x9c, 0xd2, 0x5f, 0x15, 0xe0, 0x6a, 0x9e, 0x56, 0x19, 0xb6, 0x57, 0xb0,
+		0xd4, 0x79, 0xdc, 0x6a, 0x07, 0x21, 0xf1, 0xe3, 0xa8, 0x08, 0x66, 0x6d, 0x30, 0xcf, 0x3d, 0x26,
+		0x14, 0x5b, 0x98, 0x62, 0xa3, 0x92, 0x1c, 0xde, 0xd2, 0xaa, 0x99, 0xca, 0xf6, 0x0f, 0x13, 0x4a,
+		0x95, 0x85, 0xd3, 0xa9, 0xb4, 0x12, 0x17, 0x99, 0xb4, 0xca, 0xea, 0x6d, 0xb8, 0xfc, 0x90, 0xb4,
+		0xdd, 0x10, 0x6e, 0x9e, 0x08, 0xd4, 0xee, 0xe3, 0xfb, 0xea, 0x9f, 0x46, 0xe1, 0x8a, 0x9a, 0x4f,
+		0x7a, 0xef, 0x17, 0x1a, 0x2c, 0x2a, 0xce, 0xd2, 0xc2, 0xbe, 0xf4, 0xdb, 0x93, 0x7c, 0x84, 0xef,
+		0x25, 0xb8, 0xb6, 0x9d, 0x39, 0xcb, 0x63, 0xec, 0x8b, 0xd1, 0x74, 0xde, 0xea, 0xde, 0xe1, 0x66,
+		0x28, 0xa2, 0xc8, 0xcc, 0x28, 0x9c, 0xc9, 0x8c, 0x8d, 0x4c, 0x14, 0x3b, 0x66, 0xe0, 0xee, 0x9d,
+		0xca, 0xd7, 0xac, 0x3d, 0xa8, 0xed, 0x56, 0x4c, 0xca, 0x8f, 0xd2, 0xef, 0xe7, 0x3d, 0xae, 0x08,
+		0x79, 0x3d, 0x27, 0xf9, 0xa3, 0xf7, 0xd7, 0xe9, 0xe1, 0xfa, 0x6d, 0xea, 0xae, 0xfe, 0xbe, 0x00,
+		0xef, 0x7c, 0xe1, 0x5b, 0x98, 0x92, 0xbc, 0x56, 0x32, 0x08, 0x40, 0x9d, 0xa1, 0xd0, 0xcf, 0x0f,
+		0xbf, 0x54, 0xbd, 0x73, 0xf4, 0x3c, 0x26, 0x99, 0x77, 0xe1, 0x66, 0x1f, 0x17, 0x49, 0x90, 0xfb,
+		0x43, 0x01, 0x6e, 0x1a, 0x64, 0x3f, 0x20, 0x61, 0xf3, 0xff, 0xde, 0xcc, 0xf3, 0xe6, 0x0a, 0xdc,
+		0xea, 0xe7, 0x23, 0xe1, 0xce, 0xf5, 0x7f, 0x4f, 0x41, 0xe9, 0xb1, 0xcc, 0xe7, 0x8d, 0xa7, 0x75,
+		0xf4, 0x73, 0x0d, 0xe6, 0x15, 0x3f, 0x32, 0xa2, 0x8f, 0x86, 0xfc, 0x4d, 0x92, 0x87, 0xa0, 0x72,
+		0xfb, 0x54, 0xbf, 0x64, 0x26, 0x8d, 0x48, 0x16, 0xed, 0x00, 0x46, 0x28, 0xae, 0xf0, 0x03, 0x18,
+		0xa1, 0xbc, 0x96, 0x1d, 0xc1, 0x4c, 0xe6, 0xf5, 0x0b, 0x7d, 0x30, 0xec, 0x63, 0x5d, 0x65, 0x6d,
+		0x08, 0x8e, 0x94, 0xde, 0xd4, 0xb9, 0x3f, 0x18, 0xf6, 0xd9, 0xa2, 0x8f, 0x5e, 0xe5, 0x79, 0x7d,
+		0x98, 0x4e, 0xdd, 0xa4, 0x50, 0x2d, 0x5f, 0x86, 0xea, 0x52, 0x58, 0x59, 0x1d, 0x98, 0x5e, 0x6a,
+		0xfc, 0xad, 0x06, 0x97, 0x72, 0xc7, 0x7a, 0x74, 0x37, 0x5f, 0x5c, 0xbf, 0xab, 0x4a, 0xe5, 0xde,
+		0xa9, 0x78, 0xa5, 0x59, 0xbf, 0xd6, 0xe0, 0x82, 0x72, 0xd0, 0x46, 0x1f, 0xe7, 0x8b, 0xed, 0x75,
+		0xf1, 0xa8, 0x7c, 0x77, 0x68, 0x3e, 0x69, 0xca, 0x09, 0xcc, 0x66, 0x01, 0x06, 0xad, 0x0d, 0x03,
+		0x46, 0x42, 0xff, 0x29, 0xf0, 0x0b, 0xfd, 0x46, 0x83, 0x45, 0xf5, 0x6c, 0x88, 0x7a, 0x1c, 0xa7,
+		0xe7, 0x0c, 0x5b, 0xb9, 0x33, 0x3c, 0xa3, 0xb4, 0xe6, 0x97, 0x1a, 0x2c, 0xa8, 0x26, 0x11, 0x74,
+		0x7b, 0xd8, 0xc9, 0x45, 0x58, 0xf2, 0xf1, 0xe9, 0x06, 0x1e, 0xf4, 0x3b, 0x0d, 0x96, 0x7a, 0xe2,
+		0x14, 0xfa, 0x34, 0x5f, 0xf2, 0x20, 0x33, 0x40, 0xe5, 0xfe, 0xa9, 0xf9, 0xa5, 0x89, 0x7f, 0xd4,
+		0xe0, 0x6a, 0xef, 0xe6, 0x8f, 0xee, 0xf7, 0x2a, 0x8f, 0x01, 0xa0, 0xb5, 0xf2, 0xfd, 0xd3, 0x0b,
+		0x10, 0x56, 0x6e, 0xde, 0xfb, 0xd1, 0x27, 0x07, 0x36, 0x6d, 0x46, 0x7b, 0xb5, 0x86, 0xd7, 0x5a,
+		0x4d, 0xfd, 0xaf, 0x6b, 0xed, 0x80, 0xb8, 0xe2, 0x9f, 0x83, 0x93, 0xff, 0x9f, 0x7c, 0x2f, 0xfe,
+		0xfb, 0x68, 0x6d, 0x6f, 0x8c, 0xef, 0x7e, 0xf8, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2e, 0x0c,
+		0x5e, 0xa8, 0xcd, 0x2c, 0x00, 0x00,
 	},
 	// google/protobuf/duration.proto
 	[]byte{
@@ -0,0 +1,210 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package activitycontrol defines how operators pause, reset and update pending activities of a running workflow.
+//
+// Each operation is requested by signaling the workflow with one of the reserved signals below and with an
+// encoded Request as input, so that the operation is recorded in workflow history and applied the same way
+// by the active cluster, by replication and by rebuilding mutable state. Resetting an activity sets its
+// attempt back to zero, updating its options replaces its retry policy and timeouts, and pausing it stops
+// history from dispatching new attempts of the activity until it is resumed. The timeouts of a paused
+// activity keep running, so they should be extended first when the pause may outlast them. Paused
+// activities are kept in the execution memo under MemoKey, keyed by the schedule ID of the activity.
+// Resuming, resetting or updating an activity generates its tasks again while mutable state applies the
+// signal, so the new attempt and timeouts apply right away on the active and the standby clusters.
+package activitycontrol
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// PauseSignalName is the name of the signal pausing the retries of an activity
+	PauseSignalName = "__cadence_activity_pause"
+	// UnpauseSignalName is the name of the signal resuming a paused activity
+	UnpauseSignalName = "__cadence_activity_unpause"
+	// ResetSignalName is the name of the signal resetting the attempt of an activity
+	ResetSignalName = "__cadence_activity_reset"
+	// UpdateOptionsSignalName is the name of the signal updating the retry policy and timeouts of an activity
+	UpdateOptionsSignalName = "__cadence_activity_update_options"
+	// MemoKey is the memo field holding the paused activities of a workflow
+	MemoKey = "__cadence_paused_activities"
+)
+
+type (
+	// Request is the input of the activity control signals
+	Request struct {
+		ActivityID string `json:"activityID"`
+		Reason     string `json:"reason,omitempty"`
+		// Options only apply to UpdateOptionsSignalName, nil options are left unchanged
+		RetryPolicy                   *types.RetryPolicy `json:"retryPolicy,omitempty"`
+		ScheduleToStartTimeoutSeconds *int32             `json:"scheduleToStartTimeoutSeconds,omitempty"`
+		ScheduleToCloseTimeoutSeconds *int32             `json:"scheduleToCloseTimeoutSeconds,omitempty"`
+		StartToCloseTimeoutSeconds    *int32             `json:"startToCloseTimeoutSeconds,omitempty"`
+		HeartbeatTimeoutSeconds       *int32             `json:"heartbeatTimeoutSeconds,omitempty"`
+	}
+
+	// PauseInfo describes why and when an activity was paused
+	PauseInfo struct {
+		ActivityID     string `json:"activityID"`
+		Reason         string `json:"reason,omitempty"`
+		Identity       string `json:"identity,omitempty"`
+		PausedTimeNano int64  `json:"pausedTimeNano"`
+	}
+
+	// PausedActivities are the paused activities of a workflow, keyed by schedule ID
+	PausedActivities map[int64]PauseInfo
+)
+
+// IsSignal returns whether the given signal name is the name of an activity control signal
+func IsSignal(signalName string) bool {
+	switch signalName {
+	case PauseSignalName, UnpauseSignalName, ResetSignalName, UpdateOptionsSignalName:
+		return true
+	}
+	return false
+}
+
+// EncodeRequest returns the signal input for the given request
+func EncodeRequest(request *Request) ([]byte, error) {
+	return json.Marshal(request)
+}
+
+// DecodeRequest returns the request carried by the given signal input
+func DecodeRequest(input []byte) (*Request, error) {
+	request := &Request{}
+	if err := json.Unmarshal(input, request); err != nil {
+		return nil, fmt.Errorf("invalid activity control request: %w", err)
+	}
+	return request, nil
+}
+
+// Validate validates the request sent with the given signal
+func (r *Request) Validate(signalName string) error {
+	if r.ActivityID == "" {
+		return errors.New("activity ID is not set")
+	}
+	hasOptions := r.RetryPolicy != nil ||
+		r.ScheduleToStartTimeoutSeconds != nil ||
+		r.ScheduleToCloseTimeoutSeconds != nil ||
+		r.StartToCloseTimeoutSeconds != nil ||
+		r.HeartbeatTimeoutSeconds != nil
+	if signalName != UpdateOptionsSignalName {
+		if hasOptions {
+			return errors.New("activity options can only be set when updating activity options")
+		}
+		return nil
+	}
+	if !hasOptions {
+		return errors.New("no activity option to update")
+	}
+	if r.RetryPolicy != nil {
+		if err := common.ValidateRetryPolicy(r.RetryPolicy); err != nil {
+			return err
+		}
+	}
+	for name, timeout := range map[string]*int32{
+		"schedule to start": r.ScheduleToStartTimeoutSeconds,
+		"schedule to close": r.ScheduleToCloseTimeoutSeconds,
+		"start to close":    r.StartToCloseTimeoutSeconds,
+		"heartbeat":         r.HeartbeatTimeoutSeconds,
+	} {
+		if timeout != nil && *timeout < 0 {
+			return fmt.Errorf("%v timeout cannot be negative", name)
+		}
+	}
+	return nil
+}
+
+// GetPausedActivities returns the paused activities kept in the given memo
+func GetPausedActivities(memo map[string][]byte) PausedActivities {
+	value, ok := memo[MemoKey]
+	if !ok {
+		return nil
+	}
+	encoded := map[string]PauseInfo{}
+	if err := json.Unmarshal(value, &encoded); err != nil {
+		return nil
+	}
+	paused := make(PausedActivities, len(encoded))
+	for key, info := range encoded {
+		scheduleID, err := strconv.ParseInt(key, 10, 64)
+		if err != nil {
+			continue
+		}
+		paused[scheduleID] = info
+	}
+	return paused
+}
+
+// IsPaused returns whether the activity with the given schedule ID is paused
+func IsPaused(memo map[string][]byte, scheduleID int64) bool {
+	if _, ok := memo[MemoKey]; !ok {
+		return false
+	}
+	_, ok := GetPausedActivities(memo)[scheduleID]
+	return ok
+}
+
+// SetPaused returns the memo resulting from pausing or resuming the activity with the given schedule ID,
+// and whether the paused state of the activity changed. The given memo is never modified, as it may be
+// shared with the workflow started event.
+func SetPaused(memo map[string][]byte, scheduleID int64, info *PauseInfo) (map[string][]byte, bool) {
+	paused := GetPausedActivities(memo)
+	_, wasPaused := paused[scheduleID]
+	if wasPaused == (info != nil) {
+		return memo, false
+	}
+	if info != nil {
+		if paused == nil {
+			paused = make(PausedActivities)
+		}
+		paused[scheduleID] = *info
+	} else {
+		delete(paused, scheduleID)
+	}
+
+	result := make(map[string][]byte, len(memo)+1)
+	for k, v := range memo {
+		result[k] = v
+	}
+	if len(paused) == 0 {
+		delete(result, MemoKey)
+		return result, true
+	}
+	encoded := make(map[string]PauseInfo, len(paused))
+	for id, pauseInfo := range paused {
+		encoded[strconv.FormatInt(id, 10)] = pauseInfo
+	}
+	value, err := json.Marshal(encoded)
+	if err != nil {
+		return memo, false
+	}
+	result[MemoKey] = value
+	return result, true
+}
@@ -0,0 +1,155 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package activitycontrol
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestIsSignal(t *testing.T) {
+	for _, signalName := range []string{PauseSignalName, UnpauseSignalName, ResetSignalName, UpdateOptionsSignalName} {
+		assert.True(t, IsSignal(signalName), signalName)
+	}
+	assert.False(t, IsSignal("__cadence_pause"))
+}
+
+func TestEncodeDecodeRequest(t *testing.T) {
+	request := &Request{
+		ActivityID:                 "payment",
+		Reason:                     "dependency down",
+		RetryPolicy:                &types.RetryPolicy{InitialIntervalInSeconds: 1, BackoffCoefficient: 2, MaximumAttempts: 5},
+		StartToCloseTimeoutSeconds: common.Int32Ptr(30),
+	}
+	input, err := EncodeRequest(request)
+	require.NoError(t, err)
+	decoded, err := DecodeRequest(input)
+	require.NoError(t, err)
+	assert.Equal(t, request, decoded)
+
+	_, err = DecodeRequest([]byte("not json"))
+	assert.ErrorContains(t, err, "invalid activity control request")
+}
+
+func TestRequest_Validate(t *testing.T) {
+	validPolicy := &types.RetryPolicy{InitialIntervalInSeconds: 1, BackoffCoefficient: 2, MaximumAttempts: 5}
+	tests := []struct {
+		name       string
+		signalName string
+		request    Request
+		expectErr  string
+	}{
+		{
+			name:       "pause",
+			signalName: PauseSignalName,
+			request:    Request{ActivityID: "payment"},
+		},
+		{
+			name:       "missing activity ID",
+			signalName: ResetSignalName,
+			request:    Request{},
+			expectErr:  "activity ID is not set",
+		},
+		{
+			name:       "options outside of update",
+			signalName: ResetSignalName,
+			request:    Request{ActivityID: "payment", HeartbeatTimeoutSeconds: common.Int32Ptr(10)},
+			expectErr:  "activity options can only be set when updating activity options",
+		},
+		{
+			name:       "update without options",
+			signalName: UpdateOptionsSignalName,
+			request:    Request{ActivityID: "payment"},
+			expectErr:  "no activity option to update",
+		},
+		{
+			name:       "update retry policy",
+			signalName: UpdateOptionsSignalName,
+			request:    Request{ActivityID: "payment", RetryPolicy: validPolicy},
+		},
+		{
+			name:       "invalid retry policy",
+			signalName: UpdateOptionsSignalName,
+			request:    Request{ActivityID: "payment", RetryPolicy: &types.RetryPolicy{}},
+			expectErr:  "InitialIntervalInSeconds",
+		},
+		{
+			name:       "negative timeout",
+			signalName: UpdateOptionsSignalName,
+			request:    Request{ActivityID: "payment", ScheduleToCloseTimeoutSeconds: common.Int32Ptr(-1)},
+			expectErr:  "schedule to close timeout cannot be negative",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate(tt.signalName)
+			if tt.expectErr != "" {
+				assert.ErrorContains(t, err, tt.expectErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSetPaused(t *testing.T) {
+	startMemo := map[string][]byte{"owner": []byte("payments")}
+	info := &PauseInfo{ActivityID: "payment", Reason: "dependency down", PausedTimeNano: 100}
+
+	memo, changed := SetPaused(startMemo, 5, info)
+	assert.True(t, changed)
+	assert.True(t, IsPaused(memo, 5))
+	assert.False(t, IsPaused(memo, 6))
+	assert.False(t, IsPaused(startMemo, 5), "the given memo must not be modified")
+	assert.Equal(t, PausedActivities{5: *info}, GetPausedActivities(memo))
+
+	_, changed = SetPaused(memo, 5, &PauseInfo{ActivityID: "payment"})
+	assert.False(t, changed)
+
+	memo, changed = SetPaused(memo, 7, &PauseInfo{ActivityID: "shipping"})
+	assert.True(t, changed)
+	assert.Len(t, GetPausedActivities(memo), 2)
+
+	memo, changed = SetPaused(memo, 5, nil)
+	assert.True(t, changed)
+	assert.False(t, IsPaused(memo, 5))
+	assert.True(t, IsPaused(memo, 7))
+
+	memo, changed = SetPaused(memo, 7, nil)
+	assert.True(t, changed)
+	assert.Equal(t, startMemo, memo)
+
+	_, changed = SetPaused(memo, 7, nil)
+	assert.False(t, changed)
+}
+
+func TestGetPausedActivities_Malformed(t *testing.T) {
+	assert.Nil(t, GetPausedActivities(nil))
+	assert.Nil(t, GetPausedActivities(map[string][]byte{MemoKey: []byte("not json")}))
+	assert.Empty(t, GetPausedActivities(map[string][]byte{MemoKey: []byte(`{"not-a-schedule-id":{}}`)}))
+}
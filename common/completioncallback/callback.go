@@ -0,0 +1,180 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package completioncallback defines the callbacks notified when a workflow closes.
+//
+// Callbacks are attached to a workflow when it is started or continued as new, as a JSON list under
+// MemoKey in the start memo. The memo is carried over to retries and cron runs, so the callbacks are
+// notified once the last run of the chain closes. Delivery is done by a transfer task of the history
+// service and is at least once: the task is retried until every callback is delivered or abandoned, and
+// each delivery of a callback carries the same DeliveryID for the receiver to deduplicate it. No delivery
+// state is written to the mutable state of the closed run, as such writes aren't replicated to other clusters.
+// Callbacks are delivered by the cluster where the workflow closed, deliveries still pending when the
+// domain fails over are not resumed by the other cluster.
+package completioncallback
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/pborman/uuid"
+)
+
+const (
+	// MemoKey is the memo field holding the callbacks of a workflow
+	MemoKey = "__cadence_completion_callbacks"
+	// MaxCallbacks is the maximum number of callbacks a workflow can have
+	MaxCallbacks = 10
+)
+
+type (
+	// Callback is notified when the workflow closes, exactly one of its fields is set
+	Callback struct {
+		HTTP   *HTTPCallback   `json:"http,omitempty"`
+		Signal *SignalCallback `json:"signal,omitempty"`
+	}
+
+	// HTTPCallback posts the Notification as JSON to URL
+	HTTPCallback struct {
+		URL string `json:"url"`
+	}
+
+	// SignalCallback signals a workflow with the Notification as JSON input.
+	// Domain defaults to the domain of the closed workflow and RunID to the current run.
+	SignalCallback struct {
+		Domain     string `json:"domain,omitempty"`
+		WorkflowID string `json:"workflowID"`
+		RunID      string `json:"runID,omitempty"`
+		SignalName string `json:"signalName"`
+	}
+
+	// Notification is the payload delivered to the callbacks
+	Notification struct {
+		Domain        string `json:"domain"`
+		WorkflowID    string `json:"workflowID"`
+		RunID         string `json:"runID"`
+		WorkflowType  string `json:"workflowType"`
+		CloseStatus   string `json:"closeStatus"`
+		CloseTimeNano int64  `json:"closeTimeNano"`
+	}
+)
+
+// Validate returns an error if the callbacks are malformed
+func Validate(callbacks []Callback) error {
+	if len(callbacks) > MaxCallbacks {
+		return fmt.Errorf("a workflow can have at most %v completion callbacks", MaxCallbacks)
+	}
+	for i, callback := range callbacks {
+		switch {
+		case callback.HTTP != nil && callback.Signal != nil:
+			return fmt.Errorf("completion callback %v must have a single target", i)
+		case callback.HTTP != nil:
+			u, err := url.Parse(callback.HTTP.URL)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return fmt.Errorf("completion callback %v has an invalid URL %q", i, callback.HTTP.URL)
+			}
+		case callback.Signal != nil:
+			if callback.Signal.WorkflowID == "" || callback.Signal.SignalName == "" {
+				return fmt.Errorf("completion callback %v must have a workflow ID and a signal name", i)
+			}
+		default:
+			return fmt.Errorf("completion callback %v has no target", i)
+		}
+	}
+	return nil
+}
+
+// Encode returns the memo value of the callbacks
+func Encode(callbacks []Callback) ([]byte, error) {
+	if err := Validate(callbacks); err != nil {
+		return nil, err
+	}
+	return json.Marshal(callbacks)
+}
+
+// HasCallbacks returns true if the memo holds callbacks
+func HasCallbacks(memo map[string][]byte) bool {
+	_, ok := memo[MemoKey]
+	return ok
+}
+
+// GetCallbacks returns the callbacks held by the memo, or nil if there are none
+func GetCallbacks(memo map[string][]byte) ([]Callback, error) {
+	value, ok := memo[MemoKey]
+	if !ok {
+		return nil, nil
+	}
+	var callbacks []Callback
+	if err := json.Unmarshal(value, &callbacks); err != nil {
+		return nil, fmt.Errorf("invalid completion callbacks: %v", err)
+	}
+	if err := Validate(callbacks); err != nil {
+		return nil, err
+	}
+	return callbacks, nil
+}
+
+// IsURLAllowed returns true if the URL starts with one of the allowed prefixes, as read from dynamic config
+func IsURLAllowed(rawURL string, allowedPrefixes []interface{}) bool {
+	for _, value := range allowedPrefixes {
+		prefix, ok := value.(string)
+		if ok && prefix != "" && strings.HasPrefix(rawURL, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateMemo returns an error if the callbacks held by the memo of a workflow of the given domain are
+// malformed or target what they aren't allowed to. Signal callbacks can only target the domain of the workflow
+// since they are sent by the history service without authorizing the workflow for the target domain.
+func ValidateMemo(memo map[string][]byte, domainName string, allowedURLPrefixes []interface{}) error {
+	callbacks, err := GetCallbacks(memo)
+	if err != nil {
+		return err
+	}
+	for _, callback := range callbacks {
+		if err := callback.ValidateTarget(domainName, allowedURLPrefixes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateTarget returns an error if the callback of a workflow of the given domain targets what it isn't allowed to
+func (c Callback) ValidateTarget(domainName string, allowedURLPrefixes []interface{}) error {
+	if c.HTTP != nil && !IsURLAllowed(c.HTTP.URL, allowedURLPrefixes) {
+		return fmt.Errorf("URL %v is not allowed for completion callbacks", c.HTTP.URL)
+	}
+	if c.Signal != nil && c.Signal.Domain != "" && c.Signal.Domain != domainName {
+		return fmt.Errorf("completion callbacks can't signal workflows of domain %v", c.Signal.Domain)
+	}
+	return nil
+}
+
+// DeliveryID returns the ID of every delivery of the callback at the given index of a run,
+// used as request ID of signals and as idempotency key of HTTP requests
+func DeliveryID(runID string, index int) string {
+	return uuid.NewSHA1(uuid.NameSpace_OID, []byte(fmt.Sprintf("%v/%v", runID, index))).String()
+}
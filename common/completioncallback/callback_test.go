@@ -0,0 +1,143 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package completioncallback
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	tests := map[string]struct {
+		callbacks []Callback
+		wantErr   bool
+	}{
+		"http and signal": {
+			callbacks: []Callback{
+				{HTTP: &HTTPCallback{URL: "https://hooks.example.com/done"}},
+				{Signal: &SignalCallback{WorkflowID: "waiter", SignalName: "child-done"}},
+			},
+		},
+		"no target": {
+			callbacks: []Callback{{}},
+			wantErr:   true,
+		},
+		"two targets": {
+			callbacks: []Callback{{
+				HTTP:   &HTTPCallback{URL: "https://hooks.example.com/done"},
+				Signal: &SignalCallback{WorkflowID: "waiter", SignalName: "child-done"},
+			}},
+			wantErr: true,
+		},
+		"invalid scheme": {
+			callbacks: []Callback{{HTTP: &HTTPCallback{URL: "file:///etc/passwd"}}},
+			wantErr:   true,
+		},
+		"missing host": {
+			callbacks: []Callback{{HTTP: &HTTPCallback{URL: "https://"}}},
+			wantErr:   true,
+		},
+		"missing signal name": {
+			callbacks: []Callback{{Signal: &SignalCallback{WorkflowID: "waiter"}}},
+			wantErr:   true,
+		},
+		"too many": {
+			callbacks: make([]Callback, MaxCallbacks+1),
+			wantErr:   true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := Validate(tc.callbacks)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGetCallbacks(t *testing.T) {
+	callbacks := []Callback{{Signal: &SignalCallback{Domain: "other", WorkflowID: "waiter", SignalName: "child-done"}}}
+	value, err := Encode(callbacks)
+	require.NoError(t, err)
+
+	memo := map[string][]byte{MemoKey: value}
+	assert.True(t, HasCallbacks(memo))
+	decoded, err := GetCallbacks(memo)
+	require.NoError(t, err)
+	assert.Equal(t, callbacks, decoded)
+
+	decoded, err = GetCallbacks(map[string][]byte{})
+	assert.NoError(t, err)
+	assert.Nil(t, decoded)
+
+	_, err = GetCallbacks(map[string][]byte{MemoKey: []byte("not json")})
+	assert.Error(t, err)
+	_, err = GetCallbacks(map[string][]byte{MemoKey: []byte(`[{}]`)})
+	assert.Error(t, err)
+
+	_, err = Encode([]Callback{{}})
+	assert.Error(t, err)
+}
+
+func TestValidateMemo(t *testing.T) {
+	allowed := []interface{}{"https://hooks.example.com/"}
+	memo := func(callbacks ...Callback) map[string][]byte {
+		value, err := Encode(callbacks)
+		require.NoError(t, err)
+		return map[string][]byte{MemoKey: value}
+	}
+
+	assert.NoError(t, ValidateMemo(nil, "payments", allowed))
+	assert.NoError(t, ValidateMemo(memo(
+		Callback{HTTP: &HTTPCallback{URL: "https://hooks.example.com/done"}},
+		Callback{Signal: &SignalCallback{WorkflowID: "waiter", SignalName: "done"}},
+		Callback{Signal: &SignalCallback{Domain: "payments", WorkflowID: "waiter", SignalName: "done"}},
+	), "payments", allowed))
+
+	assert.EqualError(t, ValidateMemo(memo(
+		Callback{HTTP: &HTTPCallback{URL: "https://evil.example.com/done"}},
+	), "payments", allowed), "URL https://evil.example.com/done is not allowed for completion callbacks")
+	assert.EqualError(t, ValidateMemo(memo(
+		Callback{Signal: &SignalCallback{Domain: "billing", WorkflowID: "waiter", SignalName: "done"}},
+	), "payments", allowed), "completion callbacks can't signal workflows of domain billing")
+	assert.Error(t, ValidateMemo(map[string][]byte{MemoKey: []byte("{")}, "payments", allowed))
+}
+
+func TestDeliveryID(t *testing.T) {
+	assert.Equal(t, DeliveryID("run", 1), DeliveryID("run", 1))
+	assert.NotEqual(t, DeliveryID("run", 1), DeliveryID("run", 2))
+	assert.NotEqual(t, DeliveryID("run", 1), DeliveryID("other-run", 1))
+}
+
+func TestIsURLAllowed(t *testing.T) {
+	allowed := []interface{}{"https://hooks.example.com/", "", 42}
+	assert.True(t, IsURLAllowed("https://hooks.example.com/done", allowed))
+	assert.False(t, IsURLAllowed("https://hooks.example.com.evil.com/done", allowed))
+	assert.False(t, IsURLAllowed("http://169.254.169.254/", allowed))
+	assert.False(t, IsURLAllowed("https://hooks.example.com/done", nil))
+}
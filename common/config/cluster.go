@@ -30,6 +30,9 @@ import (
 	"go.uber.org/yarpc/transport/tchannel"
 )
 
+// rpcCompressionSnappy must match the compressor name registered in common/rpc
+const rpcCompressionSnappy = "snappy"
+
 type (
 	// ClusterGroupMetadata contains all the clusters participating in a replication group(aka XDC/GlobalDomain)
 	ClusterGroupMetadata struct {
@@ -72,6 +75,11 @@ type (
 		// Allowed values: tchannel|grpc
 		// Default: tchannel
 		RPCTransport string `yaml:"rpcTransport"`
+		// RPCCompression specifies the compressor used for replication traffic to this cluster.
+		// Only supported with grpc transport, and the remote cluster must be running a version that supports it.
+		// Allowed values: snappy
+		// Default: no compression
+		RPCCompression string `yaml:"rpcCompression"`
 		// AuthorizationProvider contains the information to authorize the cluster
 		AuthorizationProvider AuthorizationProvider `yaml:"authorizationProvider"`
 		// TLS configures client TLS/SSL authentication for connections to this cluster
@@ -140,6 +148,16 @@ func (m *ClusterGroupMetadata) Validate() error {
 			errs = multierr.Append(errs, fmt.Errorf("cluster %v: rpc transport must %v or %v",
 				clusterName, tchannel.TransportName, grpc.TransportName))
 		}
+		if info.RPCCompression != "" {
+			if info.RPCTransport != grpc.TransportName {
+				errs = multierr.Append(errs, fmt.Errorf("cluster %v: rpc compression is only supported with %v transport",
+					clusterName, grpc.TransportName))
+			}
+			if info.RPCCompression != rpcCompressionSnappy {
+				errs = multierr.Append(errs, fmt.Errorf("cluster %v: rpc compression must be empty or %v",
+					clusterName, rpcCompressionSnappy))
+			}
+		}
 	}
 	if len(versionToClusterName) != len(m.ClusterGroup) {
 		errs = multierr.Append(errs, errors.New("initial versions of the cluster group have duplicates"))
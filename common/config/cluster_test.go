@@ -144,6 +144,33 @@ func TestClusterGroupMetadataValidate(t *testing.T) {
 			}),
 			err: "cluster active: rpc transport must tchannel or grpc",
 		},
+		{
+			msg: "valid rpc compression",
+			config: modify(validClusterGroupMetadata(), func(m *ClusterGroupMetadata) {
+				active := m.ClusterGroup["active"]
+				active.RPCCompression = "snappy"
+				m.ClusterGroup["active"] = active
+			}),
+		},
+		{
+			msg: "invalid rpc compression",
+			config: modify(validClusterGroupMetadata(), func(m *ClusterGroupMetadata) {
+				active := m.ClusterGroup["active"]
+				active.RPCCompression = "gzip"
+				m.ClusterGroup["active"] = active
+			}),
+			err: "cluster active: rpc compression must be empty or snappy",
+		},
+		{
+			msg: "rpc compression with tchannel transport",
+			config: modify(validClusterGroupMetadata(), func(m *ClusterGroupMetadata) {
+				active := m.ClusterGroup["active"]
+				active.RPCTransport = "tchannel"
+				active.RPCCompression = "snappy"
+				m.ClusterGroup["active"] = active
+			}),
+			err: "cluster active: rpc compression is only supported with grpc transport",
+		},
 		{
 			msg: "initial version duplicated",
 			config: modify(validClusterGroupMetadata(), func(m *ClusterGroupMetadata) {
@@ -58,6 +58,11 @@ const (
 	EmptyUUID = "emptyUuid"
 )
 
+const (
+	// ActivityTypePartitionConfigKey is the partition config key carrying the activity type of an activity task
+	ActivityTypePartitionConfigKey = "activity-type"
+)
+
 // Data encoding types
 const (
 	EncodingTypeJSON           EncodingType = "json"
@@ -185,6 +190,8 @@ const (
 	DomainDataKeyForWriteGroups = "WRITE_GROUPS"
 	// DomainDataKeyForProcessGroups stores which groups have process permission of the domain API
 	DomainDataKeyForProcessGroups = "PROCESS_GROUPS"
+	// DomainDataKeyForCrossDomainCallers stores which domains can start child workflows in and signal workflows of the domain
+	DomainDataKeyForCrossDomainCallers = "CROSS_DOMAIN_CALLERS"
 )
 
 type (
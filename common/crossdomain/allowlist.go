@@ -0,0 +1,62 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package crossdomain defines the allowlist of domains that can start child workflows in and
+// signal workflows of a domain which is not co-located with them.
+//
+// The allowlist is kept in the data of the target domain under constants.DomainDataKeyForCrossDomainCallers
+// as a space separated list of domain names, the same way the authorization groups of a domain are kept.
+// A single AnyCaller entry allows every domain.
+package crossdomain
+
+import (
+	"strings"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/constants"
+)
+
+const (
+	// AnyCaller allows every domain to call into the target domain
+	AnyCaller = "*"
+
+	callerSeparator = " "
+)
+
+// Callers returns the domains allowlisted by the target domain
+func Callers(targetDomainEntry *cache.DomainCacheEntry) []string {
+	if targetDomainEntry == nil || targetDomainEntry.GetInfo() == nil {
+		return nil
+	}
+	value := targetDomainEntry.GetInfo().Data[constants.DomainDataKeyForCrossDomainCallers]
+	return strings.Fields(value)
+}
+
+// IsCallerAllowed returns whether the target domain allowlists the source domain
+func IsCallerAllowed(targetDomainEntry *cache.DomainCacheEntry, sourceDomainName string) bool {
+	for _, caller := range Callers(targetDomainEntry) {
+		if caller == AnyCaller || caller == sourceDomainName {
+			return true
+		}
+	}
+	return false
+}
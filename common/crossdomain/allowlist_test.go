@@ -0,0 +1,73 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package crossdomain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence"
+)
+
+func TestIsCallerAllowed(t *testing.T) {
+	tests := map[string]struct {
+		data     map[string]string
+		source   string
+		expected bool
+	}{
+		"no allowlist": {
+			data:     nil,
+			source:   "source",
+			expected: false,
+		},
+		"allowlisted": {
+			data:     map[string]string{constants.DomainDataKeyForCrossDomainCallers: "other  source"},
+			source:   "source",
+			expected: true,
+		},
+		"not allowlisted": {
+			data:     map[string]string{constants.DomainDataKeyForCrossDomainCallers: "other sourcex"},
+			source:   "source",
+			expected: false,
+		},
+		"any caller": {
+			data:     map[string]string{constants.DomainDataKeyForCrossDomainCallers: AnyCaller},
+			source:   "source",
+			expected: true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			entry := cache.NewLocalDomainCacheEntryForTest(
+				&persistence.DomainInfo{Name: "target", Data: test.data},
+				nil,
+				"cluster",
+			)
+			assert.Equal(t, test.expected, IsCallerAllowed(entry, test.source))
+		})
+	}
+	assert.False(t, IsCallerAllowed(nil, "source"))
+}
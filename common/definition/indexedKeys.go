@@ -43,6 +43,12 @@ const (
 	CustomDomain    = "CustomDomain" // to support batch workflow
 	Operator        = "Operator"     // to support batch workflow
 
+	// populated by Cadence for workflows in active-active domains, ActiveClusterName is the cluster
+	// the workflow was active in when its visibility record was last written and is not updated on failover
+	ClusterAttributeScope = "ClusterAttributeScope"
+	ClusterAttributeName  = "ClusterAttributeName"
+	ActiveClusterName     = "ActiveClusterName"
+
 	CustomStringField    = "CustomStringField"
 	CustomKeywordField   = "CustomKeywordField"
 	CustomIntField       = "CustomIntField"
@@ -103,6 +109,18 @@ var systemIndexedKeys = map[string]interface{}{
 	IsCron:        types.IndexedValueTypeBool,
 	NumClusters:   types.IndexedValueTypeInt,
 	UpdateTime:    types.IndexedValueTypeInt,
+
+	ClusterAttributeScope: types.IndexedValueTypeKeyword,
+	ClusterAttributeName:  types.IndexedValueTypeKeyword,
+	ActiveClusterName:     types.IndexedValueTypeKeyword,
+}
+
+// systemSearchAttributeKeys are the system keys stored in the search attributes of visibility records
+// instead of the fields of the records
+var systemSearchAttributeKeys = map[string]struct{}{
+	ClusterAttributeScope: {},
+	ClusterAttributeName:  {},
+	ActiveClusterName:     {},
 }
 
 // IsSystemIndexedKey return true is key is system added
@@ -111,6 +129,12 @@ func IsSystemIndexedKey(key string) bool {
 	return ok
 }
 
+// IsSystemSearchAttributeKey return true if key is system added and stored in search attributes
+func IsSystemSearchAttributeKey(key string) bool {
+	_, ok := systemSearchAttributeKeys[key]
+	return ok
+}
+
 // IsSystemBoolKey return true is key is system added bool key
 func IsSystemBoolKey(key string) bool {
 	return systemIndexedKeys[key] == types.IndexedValueTypeBool
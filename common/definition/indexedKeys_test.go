@@ -42,3 +42,21 @@ func TestIsSystemBoolKey(t *testing.T) {
 		assert.Equal(t, test.expected, actualResult)
 	}
 }
+
+func TestIsSystemSearchAttributeKey(t *testing.T) {
+	tests := []struct {
+		key             string
+		isSystemKey     bool
+		isSearchAttrKey bool
+	}{
+		{"ActiveClusterName", true, true},
+		{"ClusterAttributeScope", true, true},
+		{"StartTime", true, false},
+		{"CustomKeywordField", false, false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.isSystemKey, IsSystemIndexedKey(test.key))
+		assert.Equal(t, test.isSearchAttrKey, IsSystemSearchAttributeKey(test.key))
+	}
+}
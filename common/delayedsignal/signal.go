@@ -0,0 +1,208 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package delayedsignal defines how signals are scheduled for delivery at a later time.
+//
+// A delayed signal is scheduled by signaling the workflow with SignalName and with an encoded Request
+// carrying the signal to deliver, and is cancelled by signaling the workflow with CancelSignalName and
+// with the ID of the delayed signal. Scheduling a delayed signal records it in the execution memo under
+// MemoKey, which makes pending delayed signals visible in DescribeWorkflowExecution and in visibility
+// records, and creates a delayed signal timer task. The ID of a delayed signal is the signal count of the
+// workflow once the scheduling signal is applied, since buffered signals get their event ID only when
+// they are flushed, and the signal count is the same wherever the history is replayed. When the
+// timer fires, history signals the workflow with the pending signal, using the request ID returned by
+// RequestID, and the signaled event removes the delayed signal from memo. Pending delayed signals are
+// dropped when the workflow closes.
+package delayedsignal
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pborman/uuid"
+)
+
+const (
+	// SignalName is the name of the signal scheduling a delayed signal
+	SignalName = "__cadence_delayed_signal"
+	// CancelSignalName is the name of the signal cancelling a pending delayed signal
+	CancelSignalName = "__cadence_delayed_signal_cancel"
+	// MemoKey is the memo field holding the pending delayed signals of a workflow
+	MemoKey = "__cadence_delayed_signals"
+	// MaxPending is the maximum number of pending delayed signals of a workflow
+	MaxPending = 100
+
+	// reservedSignalNamePrefix is reserved.Prefix, which can't be imported as the reserved package
+	// depends on this one
+	reservedSignalNamePrefix = "__cadence_"
+)
+
+type (
+	// Request is the input of the delayed signal and cancel signals
+	Request struct {
+		// SignalName, Input and DeliverAfterNano only apply to SignalName
+		SignalName       string `json:"signalName,omitempty"`
+		Input            []byte `json:"input,omitempty"`
+		DeliverAfterNano int64  `json:"deliverAfterNano,omitempty"`
+		// ID only applies to CancelSignalName
+		ID int64 `json:"id,omitempty"`
+	}
+
+	// Pending describes a delayed signal which is not delivered yet
+	Pending struct {
+		SignalName        string `json:"signalName"`
+		Input             []byte `json:"input,omitempty"`
+		Identity          string `json:"identity,omitempty"`
+		DeliverAfterNano  int64  `json:"deliverAfterNano"`
+		ScheduledTimeNano int64  `json:"scheduledTimeNano"`
+		Version           int64  `json:"version"`
+	}
+
+	// PendingSignals are the pending delayed signals of a workflow, keyed by ID
+	PendingSignals map[int64]Pending
+)
+
+// IsSignal returns whether the given signal name is the name of a delayed signal or cancel signal
+func IsSignal(signalName string) bool {
+	return signalName == SignalName || signalName == CancelSignalName
+}
+
+// EncodeRequest returns the signal input for the given request
+func EncodeRequest(request *Request) ([]byte, error) {
+	return json.Marshal(request)
+}
+
+// DecodeRequest returns the request carried by the given signal input
+func DecodeRequest(input []byte) (*Request, error) {
+	request := &Request{}
+	if err := json.Unmarshal(input, request); err != nil {
+		return nil, fmt.Errorf("invalid delayed signal request: %w", err)
+	}
+	return request, nil
+}
+
+// Validate validates the request sent with the given signal
+func (r *Request) Validate(signalName string) error {
+	if signalName == CancelSignalName {
+		if r.ID <= 0 {
+			return errors.New("delayed signal ID is not set")
+		}
+		if r.SignalName != "" || len(r.Input) != 0 || r.DeliverAfterNano != 0 {
+			return errors.New("only the delayed signal ID can be set when cancelling a delayed signal")
+		}
+		return nil
+	}
+	if r.ID != 0 {
+		return errors.New("delayed signal ID can only be set when cancelling a delayed signal")
+	}
+	if r.SignalName == "" {
+		return errors.New("signal name is not set")
+	}
+	if strings.HasPrefix(r.SignalName, reservedSignalNamePrefix) {
+		return fmt.Errorf("signal name %v is reserved", r.SignalName)
+	}
+	if r.DeliverAfterNano <= 0 {
+		return errors.New("delivery time is not set")
+	}
+	return nil
+}
+
+// RequestID returns the request ID of the signal delivering the delayed signal with the given ID
+func RequestID(runID string, id int64) string {
+	return uuid.NewSHA1(uuid.NameSpace_OID, []byte(runID+"/"+strconv.FormatInt(id, 10))).String()
+}
+
+// GetPending returns the pending delayed signals kept in the given memo
+func GetPending(memo map[string][]byte) PendingSignals {
+	value, ok := memo[MemoKey]
+	if !ok {
+		return nil
+	}
+	encoded := map[string]Pending{}
+	if err := json.Unmarshal(value, &encoded); err != nil {
+		return nil
+	}
+	pending := make(PendingSignals, len(encoded))
+	for key, signal := range encoded {
+		id, err := strconv.ParseInt(key, 10, 64)
+		if err != nil {
+			continue
+		}
+		pending[id] = signal
+	}
+	return pending
+}
+
+// GetByRequestID returns the ID of the pending delayed signal delivered with the given request ID,
+// and whether there is such a delayed signal
+func GetByRequestID(memo map[string][]byte, runID string, requestID string) (int64, bool) {
+	if _, ok := memo[MemoKey]; !ok || requestID == "" {
+		return 0, false
+	}
+	for id := range GetPending(memo) {
+		if RequestID(runID, id) == requestID {
+			return id, true
+		}
+	}
+	return 0, false
+}
+
+// SetPending returns the memo resulting from adding or removing the delayed signal with the given ID,
+// and whether the pending delayed signals changed. The given memo is never modified, as it may be
+// shared with the workflow started event.
+func SetPending(memo map[string][]byte, id int64, signal *Pending) (map[string][]byte, bool) {
+	pending := GetPending(memo)
+	_, wasPending := pending[id]
+	if wasPending == (signal != nil) {
+		return memo, false
+	}
+	if signal != nil {
+		if pending == nil {
+			pending = make(PendingSignals)
+		}
+		pending[id] = *signal
+	} else {
+		delete(pending, id)
+	}
+
+	result := make(map[string][]byte, len(memo)+1)
+	for k, v := range memo {
+		result[k] = v
+	}
+	if len(pending) == 0 {
+		delete(result, MemoKey)
+		return result, true
+	}
+	encoded := make(map[string]Pending, len(pending))
+	for pendingID, pendingSignal := range pending {
+		encoded[strconv.FormatInt(pendingID, 10)] = pendingSignal
+	}
+	value, err := json.Marshal(encoded)
+	if err != nil {
+		return memo, false
+	}
+	result[MemoKey] = value
+	return result, true
+}
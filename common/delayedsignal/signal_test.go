@@ -0,0 +1,149 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package delayedsignal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsSignal(t *testing.T) {
+	assert.True(t, IsSignal(SignalName))
+	assert.True(t, IsSignal(CancelSignalName))
+	assert.False(t, IsSignal("__cadence_pause"))
+}
+
+func TestEncodeDecodeRequest(t *testing.T) {
+	request := &Request{SignalName: "reminder", Input: []byte("payload"), DeliverAfterNano: 100}
+	input, err := EncodeRequest(request)
+	require.NoError(t, err)
+	decoded, err := DecodeRequest(input)
+	require.NoError(t, err)
+	assert.Equal(t, request, decoded)
+
+	_, err = DecodeRequest([]byte("not json"))
+	assert.ErrorContains(t, err, "invalid delayed signal request")
+}
+
+func TestRequest_Validate(t *testing.T) {
+	tests := map[string]struct {
+		signalName string
+		request    Request
+		err        string
+	}{
+		"valid schedule": {
+			signalName: SignalName,
+			request:    Request{SignalName: "reminder", DeliverAfterNano: 100},
+		},
+		"schedule without signal name": {
+			signalName: SignalName,
+			request:    Request{DeliverAfterNano: 100},
+			err:        "signal name is not set",
+		},
+		"schedule of reserved signal": {
+			signalName: SignalName,
+			request:    Request{SignalName: SignalName, DeliverAfterNano: 100},
+			err:        "is reserved",
+		},
+		"schedule without delivery time": {
+			signalName: SignalName,
+			request:    Request{SignalName: "reminder"},
+			err:        "delivery time is not set",
+		},
+		"schedule with ID": {
+			signalName: SignalName,
+			request:    Request{SignalName: "reminder", DeliverAfterNano: 100, ID: 5},
+			err:        "can only be set when cancelling",
+		},
+		"valid cancel": {
+			signalName: CancelSignalName,
+			request:    Request{ID: 5},
+		},
+		"cancel without ID": {
+			signalName: CancelSignalName,
+			request:    Request{},
+			err:        "delayed signal ID is not set",
+		},
+		"cancel with signal": {
+			signalName: CancelSignalName,
+			request:    Request{ID: 5, SignalName: "reminder"},
+			err:        "only the delayed signal ID can be set",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := test.request.Validate(test.signalName)
+			if test.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, test.err)
+			}
+		})
+	}
+}
+
+func TestRequestID(t *testing.T) {
+	assert.Equal(t, RequestID("run", 5), RequestID("run", 5))
+	assert.NotEqual(t, RequestID("run", 5), RequestID("run", 6))
+	assert.NotEqual(t, RequestID("run", 5), RequestID("other-run", 5))
+}
+
+func TestSetPending(t *testing.T) {
+	startMemo := map[string][]byte{"owner": []byte("payments")}
+	signal := &Pending{SignalName: "reminder", DeliverAfterNano: 100, ScheduledTimeNano: 10}
+
+	memo, changed := SetPending(startMemo, 5, signal)
+	assert.True(t, changed)
+	assert.Equal(t, PendingSignals{5: *signal}, GetPending(memo))
+	assert.Nil(t, GetPending(startMemo), "the given memo must not be modified")
+
+	_, changed = SetPending(memo, 5, &Pending{SignalName: "other"})
+	assert.False(t, changed)
+
+	memo, changed = SetPending(memo, 7, &Pending{SignalName: "other"})
+	assert.True(t, changed)
+	assert.Len(t, GetPending(memo), 2)
+
+	id, ok := GetByRequestID(memo, "run", RequestID("run", 7))
+	assert.True(t, ok)
+	assert.Equal(t, int64(7), id)
+	_, ok = GetByRequestID(memo, "run", RequestID("other-run", 7))
+	assert.False(t, ok)
+
+	memo, changed = SetPending(memo, 5, nil)
+	assert.True(t, changed)
+	memo, changed = SetPending(memo, 7, nil)
+	assert.True(t, changed)
+	assert.Equal(t, startMemo, memo)
+
+	_, changed = SetPending(memo, 7, nil)
+	assert.False(t, changed)
+}
+
+func TestGetPending_Malformed(t *testing.T) {
+	assert.Nil(t, GetPending(nil))
+	assert.Nil(t, GetPending(map[string][]byte{MemoKey: []byte("not json")}))
+	assert.Empty(t, GetPending(map[string][]byte{MemoKey: []byte(`{"not-an-id":{}}`)}))
+}
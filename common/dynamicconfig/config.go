@@ -412,6 +412,26 @@ func (c *Collection) GetMapPropertyFilteredByDomain(key dynamicproperties.MapKey
 	}
 }
 
+// GetMapPropertyFilteredByTaskListInfo gets property with taskListInfo as filters and asserts that it's a map
+func (c *Collection) GetMapPropertyFilteredByTaskListInfo(key dynamicproperties.MapKey) dynamicproperties.MapPropertyFnWithTaskListInfoFilters {
+	return func(domain string, taskList string, taskType int) map[string]interface{} {
+		filters := c.toFilterMap(
+			dynamicproperties.DomainFilter(domain),
+			dynamicproperties.TaskListFilter(taskList),
+			dynamicproperties.TaskTypeFilter(taskType),
+		)
+		val, err := c.client.GetMapValue(
+			key,
+			filters,
+		)
+		if err != nil {
+			c.logError(key, filters, err)
+			return key.DefaultMap()
+		}
+		return val
+	}
+}
+
 // GetStringPropertyFilteredByDomain gets property with domain filter and asserts that it's a string
 func (c *Collection) GetStringPropertyFilteredByDomain(key dynamicproperties.StringKey) dynamicproperties.StringPropertyFnWithDomainFilter {
 	return func(domain string) string {
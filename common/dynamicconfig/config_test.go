@@ -305,6 +305,20 @@ func (s *configSuite) TestGetMapPropertyFilteredByDomain() {
 	s.Equal("321", value(domain)["testKey"])
 }
 
+func (s *configSuite) TestGetMapPropertyFilteredByTaskListInfo() {
+	key := dynamicproperties.TestGetMapPropertyKey
+	domain := "testDomain"
+	taskList := "testTaskList"
+	taskType := 0
+	val := map[string]interface{}{
+		"testKey": 123,
+	}
+	value := s.cln.GetMapPropertyFilteredByTaskListInfo(key)
+	s.Equal(key.DefaultMap(), value(domain, taskList, taskType))
+	s.client.SetValue(key, val)
+	s.Equal(val, value(domain, taskList, taskType))
+}
+
 func (s *configSuite) TestGetListProperty() {
 	key := dynamicproperties.TestGetListPropertyKey
 	arr := []interface{}{}
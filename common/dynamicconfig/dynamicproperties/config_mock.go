@@ -115,3 +115,8 @@ func GetStringPropertyFnFilteredByDomain(value string) func(domain string) strin
 func GetMapPropertyFn(value map[string]interface{}) func(opts ...FilterOption) map[string]interface{} {
 	return func(...FilterOption) map[string]interface{} { return value }
 }
+
+// GetMapPropertyFnFilteredByTaskListInfo returns value as MapPropertyFnWithTaskListInfoFilters
+func GetMapPropertyFnFilteredByTaskListInfo(value map[string]interface{}) func(domain string, taskList string, taskType int) map[string]interface{} {
+	return func(domain string, taskList string, taskType int) map[string]interface{} { return value }
+}
@@ -826,6 +826,12 @@ const (
 	// Default value: 2
 	// Allowed filters: DomainName,TasklistName,TasklistType
 	MatchingIsolationGroupsPerPartition
+	// MatchingPartitionBacklogUpscaleMaxPartitions is the maximum number of read partitions the adaptive scaler will keep because of an understaffed backlog
+	// KeyName: matching.partitionBacklogUpscaleMaxPartitions
+	// Value type: Int
+	// Default value: 10
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingPartitionBacklogUpscaleMaxPartitions
 
 	// key for history
 
@@ -948,6 +954,15 @@ const (
 	// Allowed filters: N/A
 	TaskSchedulerDispatcherCount
 	TaskSchedulerGlobalDomainRPS
+	// TaskSchedulerDomainMaxConcurrency is the max number of tasks of a domain submitted to the task scheduler of a history host
+	// and not yet completed, tasks over the limit are rescheduled. Zero or negative means no limit. It only caps the tasks of a
+	// domain, the weights of the domains are the ones of history.taskSchedulerDomainRoundRobinWeight, which apply to the share
+	// of tasks handled by the domain-keyed scheduler
+	// KeyName: history.taskSchedulerDomainMaxConcurrency
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: DomainName
+	TaskSchedulerDomainMaxConcurrency
 	// TaskCriticalRetryCount is the critical retry count for background tasks
 	// when task attempt exceeds this threshold:
 	// - task attempt metrics and additional error logs will be emitted
@@ -1001,6 +1016,18 @@ const (
 	// Default value: 20
 	// Allowed filters: N/A
 	TimerProcessorMaxPollRPS
+	// HousekeepingTaskBatchSize is batch size for housekeeping queue processor to process tasks
+	// KeyName: history.housekeepingTaskBatchSize
+	// Value type: Int
+	// Default value: 100
+	// Allowed filters: N/A
+	HousekeepingTaskBatchSize
+	// HousekeepingProcessorMaxPollRPS is max poll rate per second for housekeeping queue processor
+	// KeyName: history.housekeepingProcessorMaxPollRPS
+	// Value type: Int
+	// Default value: 10
+	// Allowed filters: N/A
+	HousekeepingProcessorMaxPollRPS
 	// TimerProcessorMaxRedispatchQueueSize is the threshold of the number of tasks in the redispatch queue for timer processor
 	// KeyName: history.timerProcessorMaxRedispatchQueueSize
 	// Value type: Int
@@ -1076,6 +1103,27 @@ const (
 	// Default value: 10
 	// Allowed filters: ShardID, ClusterName
 	ReplicatorTaskBatchStepCount
+	// ReplicatorTargetTaskBatchBytes is the payload size that ReplicatorProcessor aims to keep the replication messages below,
+	// the batch size of the replication tasks is decreased when the payload is larger. It should be smaller than the max response size.
+	// KeyName: history.replicatorTargetTaskBatchBytes
+	// Value type: Int
+	// Default value: 2097152 (2MB)
+	// Allowed filters: ShardID, ClusterName
+	ReplicatorTargetTaskBatchBytes
+	// ReplicationDomainPendingTaskThreshold is the number of replication tasks of a domain not applied yet by a shard of the
+	// standby cluster, above which the domain is reported as lagging behind
+	// KeyName: history.replicationDomainPendingTaskThreshold
+	// Value type: Int
+	// Default value: 1000
+	// Allowed filters: DomainName
+	ReplicationDomainPendingTaskThreshold
+	// ReplicationDomainBacklogMaxTasks is the max number of replication tasks a shard of the source cluster reads to count
+	// the tasks of each domain not applied yet by a standby cluster
+	// KeyName: history.replicationDomainBacklogMaxTasks
+	// Value type: Int
+	// Default value: 10000
+	// Allowed filters: ShardID
+	ReplicationDomainBacklogMaxTasks
 	// ReplicatorTaskDeleteBatchSize is batch size for ReplicatorProcessor to delete replication tasks
 	// KeyName: history.replicatorTaskDeleteBatchSize
 	// Value type: Int
@@ -1232,6 +1280,18 @@ const (
 	// Default value: 0
 	// Allowed filters: DomainName
 	MaxActivityCountDispatchByDomain
+	// CompletionCallbackMaxAttempts is the number of times the completion callbacks of a closed workflow are attempted before they are given up
+	// KeyName: history.completionCallbackMaxAttempts
+	// Value type: Int
+	// Default value: 10
+	// Allowed filters: DomainName
+	CompletionCallbackMaxAttempts
+	// SignalDedupMaxRequestIDs is the maximum number of signal request IDs a workflow remembers for the signal deduplication window
+	// KeyName: history.signalDedupMaxRequestIDs
+	// Value type: Int
+	// Default value: 1000
+	// Allowed filters: DomainName
+	SignalDedupMaxRequestIDs
 
 	// key for history replication
 
@@ -1446,6 +1506,18 @@ const (
 	// Value type: Int
 	// Default value: 100
 	ESAnalyzerMinNumWorkflowsForAvg
+	// BatcherMaxRPS is the maximum RPS a batch job can process workflows of a domain at
+	// KeyName: worker.batcherMaxRPS
+	// Value type: Int
+	// Default value: 1000
+	// Allowed filters: DomainName
+	BatcherMaxRPS
+	// BatcherMaxConcurrency is the maximum number of workflows a batch job can process in parallel for a domain
+	// KeyName: worker.batcherMaxConcurrency
+	// Value type: Int
+	// Default value: 50
+	// Allowed filters: DomainName
+	BatcherMaxConcurrency
 
 	// key for shard manager
 
@@ -1521,6 +1593,12 @@ const (
 	DeleteHistoryEventContextTimeout
 
 	QueueMaxVirtualQueueCount
+	// QueueDomainTaskFailureRateMinTaskCount is the min number of pending tasks a domain must have in a queue before its task failure rate is checked
+	// KeyName: history.queueDomainTaskFailureRateMinTaskCount
+	// Value type: Int
+	// Default value: 100
+	// Allowed filters: N/A
+	QueueDomainTaskFailureRateMinTaskCount
 
 	// LastIntKey must be the last one in this const group
 	LastIntKey
@@ -1594,6 +1672,13 @@ const (
 	// Default value: true
 	// Allowed filters: N/A
 	EnableGracefulFailover
+	// EnableGracefulFailoverTaskDraining is whether the old active cluster waits for in-flight transfer and timer tasks
+	// to be processed before emitting the graceful failover markers
+	// KeyName: history.enableGracefulFailoverTaskDraining
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	EnableGracefulFailoverTaskDraining
 	// DisallowQuery is the key to disallow query for a domain
 	// KeyName: system.disallowQuery
 	// Value type: Bool
@@ -1684,6 +1769,12 @@ const (
 	MatchingEnableAdaptiveScaler
 	MatchingEnablePartitionEmptyCheck
 	MatchingEnableReturnAllTaskListKinds
+	// MatchingEnableAdaptiveScalerBacklogScaling enables the adaptive scaler to consider how long a backlog persisted and the poller-to-backlog ratio
+	// KeyName: matching.enableAdaptiveScalerBacklogScaling
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingEnableAdaptiveScalerBacklogScaling
 
 	// key for history
 
@@ -1786,6 +1877,13 @@ const (
 	// Default value: false
 	// Allowed filters: DomainName
 	EnableCrossClusterOperationsForDomain
+	// EnableCrossDomainCalls allows a domain to start child workflows in and signal workflows of
+	// domains that are not co-located with it, as long as the target domain allowlists it
+	// KeyName: history.enableCrossDomainCalls
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	EnableCrossDomainCalls
 	// EnableHistoryCorruptionCheck enables additional sanity check for corrupted history. This allows early catches of DB corruptions but potiantally increased latency.
 	// KeyName: history.enableHistoryCorruptionCheck
 	// Value type: Bool
@@ -1945,6 +2043,18 @@ const (
 	// Default value: true
 	// Allowed filters: N/A
 	EnableFailoverManager
+	// EnableScheduler indicates if the worker runs the schedule workflows
+	// KeyName: worker.enableScheduler
+	// Value type: Bool
+	// Default value: true
+	// Allowed filters: N/A
+	EnableScheduler
+	// EnableReplicationDLQTriage indicates if the worker periodically triages and replays the replication DLQ messages
+	// KeyName: worker.enableReplicationDLQTriage
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	EnableReplicationDLQTriage
 	// ConcreteExecutionFixerDomainAllow is which domains are allowed to be fixed by concrete fixer workflow
 	// KeyName: worker.concreteExecutionFixerDomainAllow
 	// Value type: Bool
@@ -2041,6 +2151,22 @@ const (
 	// Default value: false
 	EnableTasklistIsolation
 
+	// EnableWorkerVersioning enables routing decision tasks of a domain only to pollers whose build ID is compatible
+	// with the build that last processed the workflow
+	// KeyName: system.enableWorkerVersioning
+	// Value type: bool
+	// Default value: false
+	// Allowed filters: DomainName
+	EnableWorkerVersioning
+
+	// EnableActivityTypeDispatchRateLimit enables attaching the activity type to the activity tasks of a domain, so that
+	// matching can apply the per activity type dispatch rates of matching.activityTypeDispatchRPS to them
+	// KeyName: system.enableActivityTypeDispatchRateLimit
+	// Value type: bool
+	// Default value: false
+	// Allowed filters: DomainName
+	EnableActivityTypeDispatchRateLimit
+
 	// EnablePartitionIsolationGroupAssignment enables assigning isolation groups to individual TaskList partitions
 	// KeyName: matching.enablePartitionIsolationGroupAssignment
 	// Value type: bool
@@ -2111,8 +2237,33 @@ const (
 
 	EnableTransferQueueV2
 	EnableTimerQueueV2
+	// EnableHousekeepingTaskQueue is to write the timer tasks deleting workflows after retention
+	// to the dedicated housekeeping queue instead of the timer queue. It takes effect when a shard is loaded
+	// KeyName: history.enableHousekeepingTaskQueue
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: ShardID
+	EnableHousekeepingTaskQueue
 	EnableTransferQueueV2PendingTaskCountAlert
 	EnableTimerQueueV2PendingTaskCountAlert
+	// EnableQueueV2StuckSliceAlert is to enable the queue v2 alert raised when the ack level of a queue makes no progress
+	// KeyName: history.enableQueueV2StuckSliceAlert
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: ShardID
+	EnableQueueV2StuckSliceAlert
+	// EnableQueueV2DomainTaskFailureAlert is to enable the queue v2 alert raised when the tasks of a domain keep failing
+	// KeyName: history.enableQueueV2DomainTaskFailureAlert
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: ShardID
+	EnableQueueV2DomainTaskFailureAlert
+	// EnableQueueV2ScheduledLagAlert is to enable the queue v2 alert raised when a scheduled queue falls behind
+	// KeyName: history.enableQueueV2ScheduledLagAlert
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: ShardID
+	EnableQueueV2ScheduledLagAlert
 
 	// LastBoolKey must be the last one in this const group
 	LastBoolKey
@@ -2287,6 +2438,12 @@ const (
 	HistoryGlobalRatelimiterNewDataWeight
 
 	MatchingPartitionDownscaleFactor
+	// MatchingPartitionUpscaleBacklogPollerRatio is the poller-to-backlog ratio below which a persistent backlog triggers upscale
+	// KeyName: matching.partitionUpscaleBacklogPollerRatio
+	// Value type: Float64
+	// Default value: 0.01
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingPartitionUpscaleBacklogPollerRatio
 
 	// Key for shard distributor
 
@@ -2303,6 +2460,12 @@ const (
 	// Default value: 0
 	// Allowed filters: N/A
 	ShardDistributorExecutorErrorInjectionRate
+	// QueueCriticalDomainTaskFailureRate is the ratio of failing pending tasks of a domain above which a queue alert is raised
+	// KeyName: history.queueCriticalDomainTaskFailureRate
+	// Value type: Float64
+	// Default value: 0.8
+	// Allowed filters: N/A
+	QueueCriticalDomainTaskFailureRate
 
 	// LastFloatKey must be the last one in this const group
 	LastFloatKey
@@ -2554,6 +2717,19 @@ const (
 	// Default value: 1m
 	// Allowed filters: DomainName,TasklistName,TasklistType
 	MatchingIsolationGroupNoPollersSustainedDuration
+	// MatchingPartitionUpscaleBacklogAge is the age of the oldest task in the backlog above which the adaptive scaler considers upscaling
+	// KeyName: matching.partitionUpscaleBacklogAge
+	// Value type: Duration
+	// Default value: 5m
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingPartitionUpscaleBacklogAge
+	// MatchingWorkerVersioningPollerWindow is the time period in which pollers of a build set must have polled for
+	// the tasks pinned to that build set to be dispatched only to them. Otherwise the tasks can be dispatched to any poller.
+	// KeyName: matching.workerVersioningPollerWindow
+	// Value type: Duration
+	// Default value: 10s
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingWorkerVersioningPollerWindow
 
 	// HistoryLongPollExpirationInterval is the long poll expiration interval in the history service
 	// KeyName: history.longPollExpirationInterval
@@ -2641,6 +2817,18 @@ const (
 	// Allowed filters: N/A
 	QueueProcessorPollBackoffInterval
 	VirtualSliceForceAppendInterval
+	// QueueStuckSliceDuration is the duration the ack level of a queue can stay unchanged with pending tasks before a queue alert is raised
+	// KeyName: history.queueStuckSliceDuration
+	// Value type: Duration
+	// Default value: 10m (10*time.Minute)
+	// Allowed filters: N/A
+	QueueStuckSliceDuration
+	// QueueMaxScheduledLag is the max lag of a scheduled queue's ack level behind the current time before a queue alert is raised
+	// KeyName: history.queueMaxScheduledLag
+	// Value type: Duration
+	// Default value: 10m (10*time.Minute)
+	// Allowed filters: N/A
+	QueueMaxScheduledLag
 	// TimerProcessorUpdateAckInterval is update interval for timer processor
 	// KeyName: history.timerProcessorUpdateAckInterval
 	// Value type: Duration
@@ -2668,6 +2856,18 @@ const (
 	// Default value: 5m (5*time.Minute)
 	// Allowed filters: N/A
 	TimerProcessorMaxPollInterval
+	// HousekeepingProcessorUpdateAckInterval is update interval for housekeeping queue processor
+	// KeyName: history.housekeepingProcessorUpdateAckInterval
+	// Value type: Duration
+	// Default value: 1m (1*time.Minute)
+	// Allowed filters: N/A
+	HousekeepingProcessorUpdateAckInterval
+	// HousekeepingProcessorMaxPollInterval is max poll interval for housekeeping queue processor
+	// KeyName: history.housekeepingProcessorMaxPollInterval
+	// Value type: Duration
+	// Default value: 5m (5*time.Minute)
+	// Allowed filters: N/A
+	HousekeepingProcessorMaxPollInterval
 	// TimerProcessorSplitQueueInterval is the split processing queue interval for timer processor
 	// KeyName: history.timerProcessorSplitQueueInterval
 	// Value type: Duration
@@ -2773,12 +2973,25 @@ const (
 	// Default value: 5s (5*time.Second)
 	// Allowed filters: N/A
 	NotifyFailoverMarkerInterval
+	// GracefulFailoverDrainTimeout is the maximum time the old active cluster waits for in-flight tasks to be processed
+	// before emitting the graceful failover markers
+	// KeyName: history.gracefulFailoverDrainTimeout
+	// Value type: Duration
+	// Default value: 1m (time.Minute)
+	// Allowed filters: N/A
+	GracefulFailoverDrainTimeout
 	// ActivityMaxScheduleToStartTimeoutForRetry is maximum value allowed when overwritting the schedule to start timeout for activities with retry policy
 	// KeyName: history.activityMaxScheduleToStartTimeoutForRetry
 	// Value type: Duration
 	// Default value: 30m (30*time.Minute)
 	// Allowed filters: DomainName
 	ActivityMaxScheduleToStartTimeoutForRetry
+	// SignalDedupWindow is how long signal request IDs are remembered to deduplicate signals across continue as new, 0 disables the window. It applies to the runs started afterwards
+	// KeyName: history.signalDedupWindow
+	// Value type: Duration
+	// Default value: 0
+	// Allowed filters: DomainName
+	SignalDedupWindow
 	// ReplicationTaskFetcherAggregationInterval determines how frequently the fetch requests are sent
 	// KeyName: history.ReplicationTaskFetcherAggregationInterval
 	// Value type: Duration
@@ -2845,6 +3058,13 @@ const (
 	// Default value: 0
 	// Allowed filters: N/A
 	ReplicationTaskProcessorLatencyLogThreshold
+	// ReplicationDomainLagThreshold is the replication lag of a domain on the standby cluster above which the domain is reported
+	// as lagging behind
+	// KeyName: history.replicationDomainLagThreshold
+	// Value type: Duration
+	// Default value: 5m
+	// Allowed filters: DomainName
+	ReplicationDomainLagThreshold
 	// WorkerESProcessorFlushInterval is flush interval for esProcessor
 	// KeyName: worker.ESProcessorFlushInterval
 	// Value type: Duration
@@ -2999,6 +3219,23 @@ const (
 	// Allowed filters: N/A
 	SearchAttributesHiddenValueKeys
 
+	// WorkerVersioningBuildSets maps worker build IDs to the name of the compatible build set they belong to.
+	// Build IDs that are not present form a build set of their own.
+	// KeyName: system.workerVersioningBuildSets
+	// Value type: Map
+	// Default value: empty map
+	// Allowed filters: DomainName
+	WorkerVersioningBuildSets
+
+	// MatchingActivityTypeDispatchRPS maps activity types to the rate at which their tasks are dispatched from
+	// the task list, summed over all partitions. Activity types that are not present are only limited by the
+	// dispatch rate of the task list.
+	// KeyName: matching.activityTypeDispatchRPS
+	// Value type: Map
+	// Default value: empty map
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingActivityTypeDispatchRPS
+
 	// LastMapKey must be the last one in this const group
 	LastMapKey
 )
@@ -3024,6 +3261,14 @@ const (
 	// Default value: forward all headers.  (this is a problematic value, and it will be changing as we reduce to a list of known values)
 	HeaderForwardingRules
 
+	// CompletionCallbackAllowedURLPrefixes is the list of URL prefixes HTTP completion callbacks may target.
+	// HTTP completion callbacks are rejected when the list is empty.
+	// KeyName: history.completionCallbackAllowedURLPrefixes
+	// Value type: []string
+	// Default value: empty
+	// Allowed filters: N/A
+	CompletionCallbackAllowedURLPrefixes
+
 	LastListKey
 )
 
@@ -3472,6 +3717,12 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "MatchingIsolationGroupsPerPartition is the target number of isolation groups to assign to each partition",
 		DefaultValue: 2,
 	},
+	MatchingPartitionBacklogUpscaleMaxPartitions: {
+		KeyName:      "matching.partitionBacklogUpscaleMaxPartitions",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingPartitionBacklogUpscaleMaxPartitions is the maximum number of read partitions the adaptive scaler will keep because of an understaffed backlog",
+		DefaultValue: 10,
+	},
 	HistoryRPS: {
 		KeyName:      "history.rps",
 		Description:  "HistoryRPS is request rate per second for each history host",
@@ -3585,6 +3836,12 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "TaskSchedulerGlobalDomainRPS is the task scheduling domain rate limit per second for the whole Cadence cluster",
 		DefaultValue: 1000,
 	},
+	TaskSchedulerDomainMaxConcurrency: {
+		KeyName:      "history.taskSchedulerDomainMaxConcurrency",
+		Filters:      []Filter{DomainName},
+		Description:  "TaskSchedulerDomainMaxConcurrency is the max number of tasks of a domain submitted to the task scheduler of a history host and not yet completed, tasks over the limit are rescheduled. Zero or negative means no limit. It only caps the tasks of a domain, the weights of the domains are the ones of history.taskSchedulerDomainRoundRobinWeight, which apply to the share of tasks handled by the domain-keyed scheduler",
+		DefaultValue: 0,
+	},
 	TaskCriticalRetryCount: {
 		KeyName:      "history.taskCriticalRetryCount",
 		Description:  "TaskCriticalRetryCount is the critical retry count for background tasks, when task attempt exceeds this threshold:- task attempt metrics and additional error logs will be emitted- task priority will be lowered",
@@ -3635,6 +3892,16 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "TimerProcessorMaxPollRPS is max poll rate per second for timer processor",
 		DefaultValue: 20,
 	},
+	HousekeepingTaskBatchSize: {
+		KeyName:      "history.housekeepingTaskBatchSize",
+		Description:  "HousekeepingTaskBatchSize is batch size for housekeeping queue processor to process tasks",
+		DefaultValue: 100,
+	},
+	HousekeepingProcessorMaxPollRPS: {
+		KeyName:      "history.housekeepingProcessorMaxPollRPS",
+		Description:  "HousekeepingProcessorMaxPollRPS is max poll rate per second for housekeeping queue processor",
+		DefaultValue: 10,
+	},
 	TimerProcessorMaxRedispatchQueueSize: {
 		KeyName:      "history.timerProcessorMaxRedispatchQueueSize",
 		Description:  "TimerProcessorMaxRedispatchQueueSize is the threshold of the number of tasks in the redispatch queue for timer processor",
@@ -3698,6 +3965,24 @@ var IntKeys = map[IntKey]DynamicInt{
 		Filters:      []Filter{ShardID, ClusterName},
 		DefaultValue: 10,
 	},
+	ReplicatorTargetTaskBatchBytes: {
+		KeyName:      "history.replicatorTargetTaskBatchBytes",
+		Description:  "ReplicatorTargetTaskBatchBytes is the payload size that ReplicatorProcessor aims to keep the replication messages below, the batch size of the replication tasks is decreased when the payload is larger. It should be smaller than the max response size.",
+		Filters:      []Filter{ShardID, ClusterName},
+		DefaultValue: 2 * 1024 * 1024,
+	},
+	ReplicationDomainPendingTaskThreshold: {
+		KeyName:      "history.replicationDomainPendingTaskThreshold",
+		Filters:      []Filter{DomainName},
+		Description:  "ReplicationDomainPendingTaskThreshold is the number of replication tasks of a domain not applied yet by a shard of the standby cluster, above which the domain is reported as lagging behind",
+		DefaultValue: 1000,
+	},
+	ReplicationDomainBacklogMaxTasks: {
+		KeyName:      "history.replicationDomainBacklogMaxTasks",
+		Filters:      []Filter{ShardID},
+		Description:  "ReplicationDomainBacklogMaxTasks is the max number of replication tasks a shard of the source cluster reads to count the tasks of each domain not applied yet by a standby cluster",
+		DefaultValue: 10000,
+	},
 	ReplicatorTaskDeleteBatchSize: {
 		KeyName:      "history.replicatorTaskDeleteBatchSize",
 		Description:  "ReplicatorTaskDeleteBatchSize is batch size for ReplicatorProcessor to delete replication tasks",
@@ -3841,6 +4126,18 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "MaxActivityCountDispatchByDomain max # of activity tasks to dispatch to matching before creating transfer tasks. This is an performance optimization to skip activity scheduling efforts.",
 		DefaultValue: 0,
 	},
+	CompletionCallbackMaxAttempts: {
+		KeyName:      "history.completionCallbackMaxAttempts",
+		Filters:      []Filter{DomainName},
+		Description:  "CompletionCallbackMaxAttempts is the number of times the completion callbacks of a closed workflow are attempted before they are given up",
+		DefaultValue: 10,
+	},
+	SignalDedupMaxRequestIDs: {
+		KeyName:      "history.signalDedupMaxRequestIDs",
+		Filters:      []Filter{DomainName},
+		Description:  "SignalDedupMaxRequestIDs is the maximum number of signal request IDs a workflow remembers for the signal deduplication window",
+		DefaultValue: 1000,
+	},
 	ReplicationTaskFetcherParallelism: {
 		KeyName:      "history.ReplicationTaskFetcherParallelism",
 		Description:  "ReplicationTaskFetcherParallelism determines how many go routines we spin up for fetching tasks",
@@ -4019,6 +4316,18 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "ESAnalyzerMinNumWorkflowsForAvg controls how many workflows to have at least to rely on workflow run time avg per type",
 		DefaultValue: 100,
 	},
+	BatcherMaxRPS: {
+		KeyName:      "worker.batcherMaxRPS",
+		Filters:      []Filter{DomainName},
+		Description:  "BatcherMaxRPS is the maximum RPS a batch job can process workflows of a domain at",
+		DefaultValue: 1000,
+	},
+	BatcherMaxConcurrency: {
+		KeyName:      "worker.batcherMaxConcurrency",
+		Filters:      []Filter{DomainName},
+		Description:  "BatcherMaxConcurrency is the maximum number of workflows a batch job can process in parallel for a domain",
+		DefaultValue: 50,
+	},
 	ShardManagerPersistenceMaxQPS: {
 		KeyName:      "shardManager.persistenceMaxQPS",
 		Description:  "ShardManagerPersistenceMaxQPS is the max qps shard manager host can query DB",
@@ -4084,6 +4393,11 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "QueueMaxVirtualQueueCount is the max number of virtual queues",
 		DefaultValue: 2,
 	},
+	QueueDomainTaskFailureRateMinTaskCount: {
+		KeyName:      "history.queueDomainTaskFailureRateMinTaskCount",
+		Description:  "QueueDomainTaskFailureRateMinTaskCount is the min number of pending tasks a domain must have in a queue before its task failure rate is checked",
+		DefaultValue: 100,
+	},
 }
 
 var BoolKeys = map[BoolKey]DynamicBool{
@@ -4174,6 +4488,11 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "EnableGracefulFailover is whether enabling graceful failover",
 		DefaultValue: true,
 	},
+	EnableGracefulFailoverTaskDraining: {
+		KeyName:      "history.enableGracefulFailoverTaskDraining",
+		Description:  "EnableGracefulFailoverTaskDraining is whether the old active cluster waits for in-flight transfer and timer tasks to be processed before emitting the graceful failover markers",
+		DefaultValue: false,
+	},
 	DisallowQuery: {
 		KeyName:      "system.disallowQuery",
 		Filters:      []Filter{DomainName},
@@ -4263,6 +4582,12 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "MatchingEnablePartitionEmptyCheck enables using TaskListStatus.empty to check if a partition is empty",
 		DefaultValue: false,
 	},
+	MatchingEnableAdaptiveScalerBacklogScaling: {
+		KeyName:      "matching.enableAdaptiveScalerBacklogScaling",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingEnableAdaptiveScalerBacklogScaling enables the adaptive scaler to consider how long a backlog persisted and the poller-to-backlog ratio",
+		DefaultValue: false,
+	},
 	MatchingEnableReturnAllTaskListKinds: {
 		KeyName:      "matching.matchingReturnAllTaskListKinds",
 		Description:  "Returns TaskLists of all kinds when GetTaskListsByDomain is called. Useful in testing Cadence",
@@ -4371,6 +4696,12 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "EnableCrossClusterOperationsForDomain indicates if cross cluster operations can be scheduled for a domain",
 		DefaultValue: false,
 	},
+	EnableCrossDomainCalls: {
+		KeyName:      "history.enableCrossDomainCalls",
+		Filters:      []Filter{DomainName},
+		Description:  "EnableCrossDomainCalls allows a domain to start child workflows in and signal workflows of domains that are not co-located with it, as long as the target domain allowlists it",
+		DefaultValue: false,
+	},
 	EnableHistoryCorruptionCheck: {
 		KeyName:      "history.enableHistoryCorruptionCheck",
 		Filters:      []Filter{DomainName},
@@ -4501,6 +4832,16 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "EnableFailoverManager indicates if failover manager is enabled",
 		DefaultValue: true,
 	},
+	EnableScheduler: {
+		KeyName:      "worker.enableScheduler",
+		Description:  "EnableScheduler indicates if the worker runs the schedule workflows",
+		DefaultValue: true,
+	},
+	EnableReplicationDLQTriage: {
+		KeyName:      "worker.enableReplicationDLQTriage",
+		Description:  "EnableReplicationDLQTriage indicates if the worker periodically triages and replays the replication DLQ messages",
+		DefaultValue: false,
+	},
 	ConcreteExecutionFixerDomainAllow: {
 		KeyName:      "worker.concreteExecutionFixerDomainAllow",
 		Filters:      []Filter{DomainName},
@@ -4549,6 +4890,18 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "EnableTasklistIsolation is a feature to enable isolation-groups for a domain. Should not be enabled without a deep understanding of this feature",
 		DefaultValue: false,
 	},
+	EnableWorkerVersioning: {
+		KeyName:      "system.enableWorkerVersioning",
+		Filters:      []Filter{DomainName},
+		Description:  "EnableWorkerVersioning enables routing decision tasks of a domain only to pollers whose build ID is compatible with the build that last processed the workflow",
+		DefaultValue: false,
+	},
+	EnableActivityTypeDispatchRateLimit: {
+		KeyName:      "system.enableActivityTypeDispatchRateLimit",
+		Filters:      []Filter{DomainName},
+		Description:  "EnableActivityTypeDispatchRateLimit enables attaching the activity type to the activity tasks of a domain, so that matching can apply the per activity type dispatch rates of matching.activityTypeDispatchRPS to them",
+		DefaultValue: false,
+	},
 	EnableServiceAuthorization: {
 		KeyName:      "system.enableServiceAuthorization",
 		Description:  "EnableServiceAuthorization is the key to enable authorization for a service, only for extension binary:",
@@ -4682,6 +5035,12 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Filters:      []Filter{ShardID},
 		DefaultValue: false,
 	},
+	EnableHousekeepingTaskQueue: {
+		KeyName:      "history.enableHousekeepingTaskQueue",
+		Description:  "EnableHousekeepingTaskQueue is to write the timer tasks deleting workflows after retention to the dedicated housekeeping queue instead of the timer queue. It takes effect when a shard is loaded",
+		Filters:      []Filter{ShardID},
+		DefaultValue: false,
+	},
 	EnableTransferQueueV2PendingTaskCountAlert: {
 		KeyName:      "history.enableTransferQueueV2PendingTaskCountAlert",
 		Description:  "EnableTransferQueueV2PendingTaskCountAlert is to enable transfer queue v2 pending task count alert",
@@ -4694,6 +5053,24 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Filters:      []Filter{ShardID},
 		DefaultValue: false,
 	},
+	EnableQueueV2StuckSliceAlert: {
+		KeyName:      "history.enableQueueV2StuckSliceAlert",
+		Description:  "EnableQueueV2StuckSliceAlert is to enable the queue v2 alert raised when the ack level of a queue makes no progress",
+		Filters:      []Filter{ShardID},
+		DefaultValue: false,
+	},
+	EnableQueueV2DomainTaskFailureAlert: {
+		KeyName:      "history.enableQueueV2DomainTaskFailureAlert",
+		Description:  "EnableQueueV2DomainTaskFailureAlert is to enable the queue v2 alert raised when the tasks of a domain keep failing",
+		Filters:      []Filter{ShardID},
+		DefaultValue: false,
+	},
+	EnableQueueV2ScheduledLagAlert: {
+		KeyName:      "history.enableQueueV2ScheduledLagAlert",
+		Description:  "EnableQueueV2ScheduledLagAlert is to enable the queue v2 alert raised when a scheduled queue falls behind",
+		Filters:      []Filter{ShardID},
+		DefaultValue: false,
+	},
 }
 
 var FloatKeys = map[FloatKey]DynamicFloat{
@@ -4748,6 +5125,11 @@ var FloatKeys = map[FloatKey]DynamicFloat{
 		Description:  "QueueProcessorPollBackoffIntervalJitterCoefficient is backoff interval jitter coefficient",
 		DefaultValue: 0.15,
 	},
+	QueueCriticalDomainTaskFailureRate: {
+		KeyName:      "history.queueCriticalDomainTaskFailureRate",
+		Description:  "QueueCriticalDomainTaskFailureRate is the ratio of failing pending tasks of a domain above which a queue alert is raised",
+		DefaultValue: 0.8,
+	},
 	TimerProcessorUpdateAckIntervalJitterCoefficient: {
 		KeyName:      "history.timerProcessorUpdateAckIntervalJitterCoefficient",
 		Description:  "TimerProcessorUpdateAckIntervalJitterCoefficient is the update interval jitter coefficient",
@@ -4846,6 +5228,12 @@ var FloatKeys = map[FloatKey]DynamicFloat{
 		Filters:      []Filter{DomainName, TaskListName, TaskType},
 		DefaultValue: 0.75,
 	},
+	MatchingPartitionUpscaleBacklogPollerRatio: {
+		KeyName:      "matching.partitionUpscaleBacklogPollerRatio",
+		Description:  "MatchingPartitionUpscaleBacklogPollerRatio is the poller-to-backlog ratio below which a persistent backlog triggers upscale",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		DefaultValue: 0.01,
+	},
 	ShardDistributorErrorInjectionRate: {
 		KeyName:      "sharddistributor.errorInjectionRate",
 		Description:  "ShardDistributorInjectionRate is rate for injecting random error in shard distributor client",
@@ -5092,6 +5480,18 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "MatchingIsolationGroupNoPollersSustainedDuration is the sustained period to wait before considering an isolation group as inactive and unassigning all partitions from it",
 		DefaultValue: time.Minute,
 	},
+	MatchingPartitionUpscaleBacklogAge: {
+		KeyName:      "matching.partitionUpscaleBacklogAge",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingPartitionUpscaleBacklogAge is the age of the oldest task in the backlog above which the adaptive scaler considers upscaling",
+		DefaultValue: 5 * time.Minute,
+	},
+	MatchingWorkerVersioningPollerWindow: {
+		KeyName:      "matching.workerVersioningPollerWindow",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingWorkerVersioningPollerWindow is the time period in which pollers of a build set must have polled for the tasks pinned to that build set to be dispatched only to them. Otherwise the tasks can be dispatched to any poller.",
+		DefaultValue: time.Second * 10,
+	},
 	MatchingAdaptiveScalerUpdateInterval: {
 		KeyName:      "matching.adaptiveScalerUpdateInterval",
 		Filters:      []Filter{DomainName, TaskListName, TaskType},
@@ -5183,6 +5583,16 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "VirtualSliceForceAppendInterval is the duration forcing a new virtual slice to be appended to the root virtual queue instead of being merged. It has 2 benefits: First, virtual slices won't grow infinitely, task loading for that slice can complete and its scope can be shrinked. Second, when we need to unload a virtual slice to free memory, we won't unload too many tasks.",
 		DefaultValue: time.Minute * 5,
 	},
+	QueueStuckSliceDuration: {
+		KeyName:      "history.queueStuckSliceDuration",
+		Description:  "QueueStuckSliceDuration is the duration the ack level of a queue can stay unchanged with pending tasks before a queue alert is raised",
+		DefaultValue: time.Minute * 10,
+	},
+	QueueMaxScheduledLag: {
+		KeyName:      "history.queueMaxScheduledLag",
+		Description:  "QueueMaxScheduledLag is the max lag of a scheduled queue's ack level behind the current time before a queue alert is raised",
+		DefaultValue: time.Minute * 10,
+	},
 	TimerProcessorUpdateAckInterval: {
 		KeyName:      "history.timerProcessorUpdateAckInterval",
 		Description:  "TimerProcessorUpdateAckInterval is update interval for timer processor",
@@ -5203,6 +5613,16 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "TimerProcessorMaxPollInterval is max poll interval for timer processor",
 		DefaultValue: time.Minute * 5,
 	},
+	HousekeepingProcessorUpdateAckInterval: {
+		KeyName:      "history.housekeepingProcessorUpdateAckInterval",
+		Description:  "HousekeepingProcessorUpdateAckInterval is update interval for housekeeping queue processor",
+		DefaultValue: time.Minute,
+	},
+	HousekeepingProcessorMaxPollInterval: {
+		KeyName:      "history.housekeepingProcessorMaxPollInterval",
+		Description:  "HousekeepingProcessorMaxPollInterval is max poll interval for housekeeping queue processor",
+		DefaultValue: time.Minute * 5,
+	},
 	TimerProcessorSplitQueueInterval: {
 		KeyName:      "history.timerProcessorSplitQueueInterval",
 		Description:  "TimerProcessorSplitQueueInterval is the split processing queue interval for timer processor",
@@ -5291,12 +5711,23 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "NotifyFailoverMarkerInterval is determines the frequency to notify failover marker",
 		DefaultValue: time.Second * 5,
 	},
+	GracefulFailoverDrainTimeout: {
+		KeyName:      "history.gracefulFailoverDrainTimeout",
+		Description:  "GracefulFailoverDrainTimeout is the maximum time the old active cluster waits for in-flight tasks to be processed before emitting the graceful failover markers",
+		DefaultValue: time.Minute,
+	},
 	ActivityMaxScheduleToStartTimeoutForRetry: {
 		KeyName:      "history.activityMaxScheduleToStartTimeoutForRetry",
 		Filters:      []Filter{DomainName},
 		Description:  "ActivityMaxScheduleToStartTimeoutForRetry is maximum value allowed when overwritting the schedule to start timeout for activities with retry policy",
 		DefaultValue: time.Minute * 30,
 	},
+	SignalDedupWindow: {
+		KeyName:      "history.signalDedupWindow",
+		Filters:      []Filter{DomainName},
+		Description:  "SignalDedupWindow is how long signal request IDs are remembered to deduplicate signals across continue as new, 0 disables the window. It applies to the runs started afterwards",
+		DefaultValue: time.Duration(0),
+	},
 	ReplicationTaskFetcherAggregationInterval: {
 		KeyName:      "history.ReplicationTaskFetcherAggregationInterval",
 		Description:  "ReplicationTaskFetcherAggregationInterval determines how frequently the fetch requests are sent",
@@ -5359,6 +5790,12 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "ReplicationTaskProcessorLatencyLogThreshold is is the threshold of whether history will log history replication latency.",
 		DefaultValue: 0,
 	},
+	ReplicationDomainLagThreshold: {
+		KeyName:      "history.replicationDomainLagThreshold",
+		Filters:      []Filter{DomainName},
+		Description:  "ReplicationDomainLagThreshold is the replication lag of a domain on the standby cluster above which the domain is reported as lagging behind",
+		DefaultValue: 5 * time.Minute,
+	},
 	WorkerESProcessorFlushInterval: {
 		KeyName:      "worker.ESProcessorFlushInterval",
 		Description:  "WorkerESProcessorFlushInterval is flush interval for esProcessor",
@@ -5488,6 +5925,18 @@ var MapKeys = map[MapKey]DynamicMap{
 		Description:  "SearchAttributesHiddenValueKeys is the list of search attributes that values should be hidden",
 		DefaultValue: map[string]interface{}{},
 	},
+	WorkerVersioningBuildSets: {
+		KeyName:      "system.workerVersioningBuildSets",
+		Filters:      []Filter{DomainName},
+		Description:  "WorkerVersioningBuildSets maps worker build IDs to the name of the compatible build set they belong to. Build IDs that are not present form a build set of their own.",
+		DefaultValue: map[string]interface{}{},
+	},
+	MatchingActivityTypeDispatchRPS: {
+		KeyName:      "matching.activityTypeDispatchRPS",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingActivityTypeDispatchRPS maps activity types to the rate at which their tasks are dispatched from the task list, summed over all partitions. Activity types that are not present are only limited by the dispatch rate of the task list.",
+		DefaultValue: map[string]interface{}{},
+	},
 }
 
 var ListKeys = map[ListKey]DynamicList{
@@ -5514,6 +5963,10 @@ var ListKeys = map[ListKey]DynamicList{
 			},
 		},
 	},
+	CompletionCallbackAllowedURLPrefixes: {
+		KeyName:     "history.completionCallbackAllowedURLPrefixes",
+		Description: "CompletionCallbackAllowedURLPrefixes is the list of URL prefixes HTTP completion callbacks may target. HTTP completion callbacks are rejected when the list is empty.",
+	},
 }
 
 var _keyNames map[string]Key
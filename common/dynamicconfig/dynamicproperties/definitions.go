@@ -80,6 +80,9 @@ type MapPropertyFn func(opts ...FilterOption) map[string]interface{}
 // MapPropertyFnWithDomainFilter is a wrapper to get map property from dynamic config with domainName as filter
 type MapPropertyFnWithDomainFilter func(domain string) map[string]interface{}
 
+// MapPropertyFnWithTaskListInfoFilters is a wrapper to get map property from dynamic config with three filters: domain, taskList, taskType
+type MapPropertyFnWithTaskListInfoFilters func(domain string, taskList string, taskType int) map[string]interface{}
+
 // StringPropertyFnWithDomainFilter is a wrapper to get string property from dynamic config
 type StringPropertyFnWithDomainFilter func(domain string) string
 
@@ -144,7 +144,7 @@ func (qv *VisibilityQueryValidator) validateComparisonExpr(expr sqlparser.Expr)
 		return fmt.Errorf("invalid search attribute %q", colNameStr)
 	}
 
-	if !definition.IsSystemIndexedKey(colNameStr) { // add search attribute prefix
+	if isSearchAttributeKey(colNameStr) { // add search attribute prefix
 		comparisonExpr.Left = &sqlparser.ColName{
 			Metadata:  colName.Metadata,
 			Name:      sqlparser.NewColIdent(definition.Attr + "." + colNameStr),
@@ -167,7 +167,7 @@ func (qv *VisibilityQueryValidator) validateRangeExpr(expr sqlparser.Expr) error
 		return fmt.Errorf("invalid search attribute %q", colNameStr)
 	}
 
-	if !definition.IsSystemIndexedKey(colNameStr) { // add search attribute prefix
+	if isSearchAttributeKey(colNameStr) { // add search attribute prefix
 		rangeCond.Left = &sqlparser.ColName{
 			Metadata:  colName.Metadata,
 			Name:      sqlparser.NewColIdent(definition.Attr + "." + colNameStr),
@@ -186,7 +186,7 @@ func (qv *VisibilityQueryValidator) validateOrderByExpr(orderBy sqlparser.OrderB
 		}
 		colNameStr := colName.Name.String()
 		if qv.isValidSearchAttributes(colNameStr) {
-			if !definition.IsSystemIndexedKey(colNameStr) { // add search attribute prefix
+			if isSearchAttributeKey(colNameStr) { // add search attribute prefix
 				orderByExpr.Expr = &sqlparser.ColName{
 					Metadata:  colName.Metadata,
 					Name:      sqlparser.NewColIdent(definition.Attr + "." + colNameStr),
@@ -209,3 +209,8 @@ func (qv *VisibilityQueryValidator) isValidSearchAttributes(key string) bool {
 	}
 	return true
 }
+
+// isSearchAttributeKey return true if key is stored in search attributes
+func isSearchAttributeKey(key string) bool {
+	return !definition.IsSystemIndexedKey(key) || definition.IsSystemSearchAttributeKey(key)
+}
@@ -53,6 +53,11 @@ func TestValidateQuery(t *testing.T) {
 			query:     "CustomStringField = 'custom'",
 			validated: "`Attr.CustomStringField` = 'custom'",
 		},
+		{
+			msg:       "system field stored in search attributes",
+			query:     "ActiveClusterName = 'cluster0'",
+			validated: "`Attr.ActiveClusterName` = 'cluster0'",
+		},
 		{
 			msg:       "complex query",
 			query:     "WorkflowID = 'wid' and ((CustomStringField = 'custom') or CustomIntField between 1 and 10)",
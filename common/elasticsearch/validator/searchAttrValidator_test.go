@@ -105,6 +105,13 @@ func (s *searchAttributesValidatorSuite) TestValidateSearchAttributes() {
 	err = validator.ValidateSearchAttributes(attr, domain)
 	s.Equal(`StartTime is read-only Cadence reservered attribute`, err.Error())
 
+	fields = map[string][]byte{
+		"ActiveClusterName": []byte(`"c1"`),
+	}
+	attr.IndexedFields = fields
+	err = validator.ValidateSearchAttributes(attr, domain)
+	s.Equal(`ActiveClusterName is read-only Cadence reservered attribute`, err.Error())
+
 	fields = map[string][]byte{
 		"CustomKeywordField": []byte(`"123456"`),
 	}
@@ -0,0 +1,463 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package failoverreadiness tells whether the standby cluster of a global domain has caught up with its active
+// cluster, so that a planned failover does not strand replication tasks or fork workflow histories.
+//
+// The check compares what both clusters expose through their admin APIs: the replication lag reported by the
+// standby for the domain, the size of the standby replication DLQ and the version histories of a sample of the
+// open workflows of the domain.
+package failoverreadiness
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/replicationstatus"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// MismatchType tells how the version history of a workflow differs between the clusters
+	MismatchType string
+
+	// Options configures the readiness check
+	Options struct {
+		// MaxDLQMessages is the number of replication DLQ messages from the active cluster tolerated in the standby
+		MaxDLQMessages int64
+		// SampleSize is the number of open workflows whose version histories are compared, zero skips the comparison
+		SampleSize int
+	}
+
+	// Params contains the clients of the clusters to compare
+	Params struct {
+		// StandbyCluster is the cluster the domains would fail over to
+		StandbyCluster string
+		// FrontendClient is the frontend client of the active cluster
+		FrontendClient frontend.Client
+		// ActiveAdminClient is the admin client of the active cluster
+		ActiveAdminClient admin.Client
+		// StandbyAdminClient is the admin client of the standby cluster
+		StandbyAdminClient admin.Client
+		// StatusCache if set, keeps the replication status of the standby cluster across checks
+		StatusCache *StatusCache
+		Options     Options
+	}
+
+	// Checker checks the failover readiness of domains
+	Checker struct {
+		standbyCluster     string
+		frontendClient     frontend.Client
+		activeAdminClient  admin.Client
+		standbyAdminClient admin.Client
+		statusCache        *StatusCache
+		options            Options
+	}
+
+	// StatusCache keeps the replication status read from a standby cluster for a while, so that checking
+	// consecutive batches of domains does not describe all the shards of the standby cluster again.
+	// A cached status may miss the tasks replicated since it was read, so the TTL should stay short.
+	StatusCache struct {
+		ttl        time.Duration
+		timeSource clock.TimeSource
+
+		mu      sync.Mutex
+		entries map[statusCacheKey]statusCacheEntry
+	}
+
+	statusCacheKey struct {
+		standbyCluster string
+		sourceCluster  string
+	}
+
+	statusCacheEntry struct {
+		statuses []*replicationstatus.DomainStatus
+		expiry   time.Time
+	}
+
+	// Report is the failover readiness of a domain
+	Report struct {
+		Domain         string
+		ActiveCluster  string
+		StandbyCluster string
+		Ready          bool
+		// Reasons explain why the domain is not ready to fail over
+		Reasons                 []string `json:",omitempty"`
+		ReplicationLag          time.Duration
+		PendingReplicationTasks int64
+		LaggingShards           []int32 `json:",omitempty"`
+		DLQMessages             int64
+		SampledWorkflows        int
+		Mismatches              []WorkflowMismatch `json:",omitempty"`
+	}
+
+	// WorkflowMismatch is a sampled workflow whose version history differs between the clusters
+	WorkflowMismatch struct {
+		WorkflowID  string
+		RunID       string
+		Type        MismatchType
+		ActiveItem  *types.VersionHistoryItem `json:",omitempty"`
+		StandbyItem *types.VersionHistoryItem `json:",omitempty"`
+	}
+)
+
+const (
+	// MismatchTypeMissing means the workflow does not exist in the standby cluster
+	MismatchTypeMissing MismatchType = "missing"
+	// MismatchTypeBehind means the standby cluster misses the latest events of the workflow
+	MismatchTypeBehind MismatchType = "behind"
+	// MismatchTypeDiverged means the histories of the workflow have forked between the clusters
+	MismatchTypeDiverged MismatchType = "diverged"
+
+	// describeQueueConcurrency is the number of shards of the standby cluster described at the same time
+	describeQueueConcurrency = 32
+)
+
+// NewStatusCache returns a new cache of replication status whose entries expire after the TTL
+func NewStatusCache(ttl time.Duration, timeSource clock.TimeSource) *StatusCache {
+	return &StatusCache{
+		ttl:        ttl,
+		timeSource: timeSource,
+		entries:    make(map[statusCacheKey]statusCacheEntry),
+	}
+}
+
+func (c *StatusCache) get(key statusCacheKey) ([]*replicationstatus.DomainStatus, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !c.timeSource.Now().Before(entry.expiry) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return entry.statuses, true
+}
+
+func (c *StatusCache) put(key statusCacheKey, statuses []*replicationstatus.DomainStatus) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[key] = statusCacheEntry{
+		statuses: statuses,
+		expiry:   c.timeSource.Now().Add(c.ttl),
+	}
+}
+
+// NewChecker returns a new failover readiness checker
+func NewChecker(params *Params) *Checker {
+	return &Checker{
+		standbyCluster:     params.StandbyCluster,
+		frontendClient:     params.FrontendClient,
+		activeAdminClient:  params.ActiveAdminClient,
+		standbyAdminClient: params.StandbyAdminClient,
+		statusCache:        params.StatusCache,
+		options:            params.Options,
+	}
+}
+
+// Check returns the failover readiness of a domain
+func (c *Checker) Check(ctx context.Context, domain string) (*Report, error) {
+	reports, err := c.CheckDomains(ctx, []string{domain})
+	if err != nil {
+		return nil, err
+	}
+	return reports[0], nil
+}
+
+// CheckDomains returns the failover readiness of the domains, in the same order.
+// The replication lags and the DLQ size are read once for all the domains, the replication lags are also reused
+// from the status cache if any.
+func (c *Checker) CheckDomains(ctx context.Context, domains []string) ([]*Report, error) {
+	lagsBySource := make(map[string][]*replicationstatus.DomainStatus)
+	var dlqCounts map[types.HistoryDLQCountKey]int64
+
+	reports := make([]*Report, 0, len(domains))
+	for _, domain := range domains {
+		resp, err := c.frontendClient.DescribeDomain(ctx, &types.DescribeDomainRequest{Name: common.StringPtr(domain)})
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe domain %v: %w", domain, err)
+		}
+		report := &Report{
+			Domain:         domain,
+			ActiveCluster:  resp.ReplicationConfiguration.GetActiveClusterName(),
+			StandbyCluster: c.standbyCluster,
+		}
+		reports = append(reports, report)
+		if reason := c.checkDomain(resp); reason != "" {
+			report.Reasons = append(report.Reasons, reason)
+			continue
+		}
+
+		statuses, ok := lagsBySource[report.ActiveCluster]
+		if !ok {
+			if statuses, err = c.getReplicationStatus(ctx, report.ActiveCluster); err != nil {
+				return nil, err
+			}
+			lagsBySource[report.ActiveCluster] = statuses
+		}
+		c.checkReplicationLag(report, resp.DomainInfo.GetUUID(), statuses)
+
+		if dlqCounts == nil {
+			countResp, err := c.standbyAdminClient.CountDLQMessages(ctx, &types.CountDLQMessagesRequest{ForceFetch: true})
+			if err != nil {
+				return nil, fmt.Errorf("failed to count DLQ messages in %v: %w", c.standbyCluster, err)
+			}
+			dlqCounts = countResp.History
+			if dlqCounts == nil {
+				dlqCounts = make(map[types.HistoryDLQCountKey]int64)
+			}
+		}
+		c.checkDLQ(report, dlqCounts)
+
+		if err := c.checkVersionHistories(ctx, report); err != nil {
+			return nil, err
+		}
+		report.Ready = len(report.Reasons) == 0
+	}
+	return reports, nil
+}
+
+func (c *Checker) checkDomain(resp *types.DescribeDomainResponse) string {
+	if !resp.IsGlobalDomain {
+		return "domain is not a global domain"
+	}
+	activeCluster := resp.ReplicationConfiguration.GetActiveClusterName()
+	if activeCluster == c.standbyCluster {
+		return fmt.Sprintf("domain is already active in %v", c.standbyCluster)
+	}
+	for _, cluster := range resp.ReplicationConfiguration.GetClusters() {
+		if cluster.GetClusterName() == c.standbyCluster {
+			return ""
+		}
+	}
+	return fmt.Sprintf("domain is not replicated to %v", c.standbyCluster)
+}
+
+func (c *Checker) getReplicationStatus(ctx context.Context, sourceCluster string) ([]*replicationstatus.DomainStatus, error) {
+	if c.statusCache == nil {
+		return c.describeReplicationStatus(ctx, sourceCluster)
+	}
+	key := statusCacheKey{standbyCluster: c.standbyCluster, sourceCluster: sourceCluster}
+	if statuses, ok := c.statusCache.get(key); ok {
+		return statuses, nil
+	}
+	statuses, err := c.describeReplicationStatus(ctx, sourceCluster)
+	if err != nil {
+		return nil, err
+	}
+	c.statusCache.put(key, statuses)
+	return statuses, nil
+}
+
+func (c *Checker) describeReplicationStatus(ctx context.Context, sourceCluster string) ([]*replicationstatus.DomainStatus, error) {
+	resp, err := c.standbyAdminClient.DescribeShardDistribution(ctx, &types.DescribeShardDistributionRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get number of shards of %v: %w", c.standbyCluster, err)
+	}
+
+	shardLags := make([][]*replicationstatus.DomainLag, resp.NumberOfShards)
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(describeQueueConcurrency)
+	for shardID := int32(0); shardID < resp.NumberOfShards; shardID++ {
+		g.Go(func() error {
+			queueResp, err := c.standbyAdminClient.DescribeQueue(ctx, &types.DescribeQueueRequest{
+				ShardID:     shardID,
+				ClusterName: sourceCluster,
+				Type:        common.Int32Ptr(int32(constants.TaskTypeReplication)),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to describe replication status of shard %d: %w", shardID, err)
+			}
+			for _, state := range queueResp.ProcessingQueueStates {
+				lag, err := replicationstatus.DecodeDomainLag(state)
+				if err != nil {
+					return err
+				}
+				shardLags[shardID] = append(shardLags[shardID], lag)
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	var lags []*replicationstatus.DomainLag
+	for _, l := range shardLags {
+		lags = append(lags, l...)
+	}
+	return replicationstatus.Aggregate(lags), nil
+}
+
+// checkReplicationLag reports a domain without replication status as not ready, as the shards of the standby cluster
+// only report the domains they received tasks for since they were loaded, or which have tasks pending in the active
+// cluster, so a missing status cannot tell an idle domain from a domain whose status was not read.
+func (c *Checker) checkReplicationLag(report *Report, domainID string, statuses []*replicationstatus.DomainStatus) {
+	for _, status := range statuses {
+		if status.DomainID != domainID {
+			continue
+		}
+		report.ReplicationLag = status.Lag
+		report.PendingReplicationTasks = status.PendingTasks
+		report.LaggingShards = status.LaggingShards
+		if status.IsLagging() {
+			report.Reasons = append(report.Reasons, fmt.Sprintf("replication lags behind in %d shards", len(status.LaggingShards)))
+		}
+		return
+	}
+	report.Reasons = append(report.Reasons, fmt.Sprintf("no replication status of the domain in %v", c.standbyCluster))
+}
+
+// checkDLQ counts the DLQ messages of all the domains, the DLQ is not partitioned by domain
+// and a message of another domain may still hold tasks the domain depends on.
+func (c *Checker) checkDLQ(report *Report, dlqCounts map[types.HistoryDLQCountKey]int64) {
+	for key, count := range dlqCounts {
+		if key.SourceCluster == report.ActiveCluster {
+			report.DLQMessages += count
+		}
+	}
+	if report.DLQMessages > c.options.MaxDLQMessages {
+		report.Reasons = append(report.Reasons, fmt.Sprintf("%d replication DLQ messages from %v", report.DLQMessages, report.ActiveCluster))
+	}
+}
+
+func (c *Checker) checkVersionHistories(ctx context.Context, report *Report) error {
+	if c.options.SampleSize <= 0 {
+		return nil
+	}
+	resp, err := c.frontendClient.ListOpenWorkflowExecutions(ctx, &types.ListOpenWorkflowExecutionsRequest{
+		Domain:          report.Domain,
+		MaximumPageSize: int32(c.options.SampleSize),
+		StartTimeFilter: &types.StartTimeFilter{
+			EarliestTime: common.Int64Ptr(0),
+			LatestTime:   common.Int64Ptr(time.Now().UnixNano()),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list open workflows of %v: %w", report.Domain, err)
+	}
+
+	for _, info := range resp.Executions {
+		mismatch, compared, err := c.compareVersionHistories(ctx, report.Domain, info.Execution)
+		if err != nil {
+			return err
+		}
+		if compared {
+			report.SampledWorkflows++
+		}
+		if mismatch != nil {
+			report.Mismatches = append(report.Mismatches, *mismatch)
+		}
+	}
+	if len(report.Mismatches) > 0 {
+		report.Reasons = append(report.Reasons, fmt.Sprintf("%d of %d sampled workflows differ", len(report.Mismatches), report.SampledWorkflows))
+	}
+	return nil
+}
+
+// compareVersionHistories returns the mismatch of the version histories if any, and whether they were compared
+func (c *Checker) compareVersionHistories(
+	ctx context.Context,
+	domain string,
+	execution *types.WorkflowExecution,
+) (*WorkflowMismatch, bool, error) {
+	// the workflow may close and be deleted from the active cluster after being listed
+	activeHistory, err := getVersionHistory(ctx, c.activeAdminClient, domain, execution)
+	var notExists *types.EntityNotExistsError
+	if errors.As(err, &notExists) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get version history of %v: %w", execution.GetWorkflowID(), err)
+	}
+	activeItem, err := activeHistory.GetLastItem()
+	if err != nil {
+		return nil, false, err
+	}
+
+	mismatch := &WorkflowMismatch{
+		WorkflowID: execution.GetWorkflowID(),
+		RunID:      execution.GetRunID(),
+		ActiveItem: activeItem.ToInternalType(),
+	}
+	standbyHistory, err := getVersionHistory(ctx, c.standbyAdminClient, domain, execution)
+	if errors.As(err, &notExists) {
+		mismatch.Type = MismatchTypeMissing
+		return mismatch, true, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get version history of %v in %v: %w", execution.GetWorkflowID(), c.standbyCluster, err)
+	}
+	standbyItem, err := standbyHistory.GetLastItem()
+	if err != nil {
+		return nil, false, err
+	}
+	mismatch.StandbyItem = standbyItem.ToInternalType()
+
+	lcaItem, err := activeHistory.FindLCAItem(standbyHistory)
+	switch {
+	case err != nil:
+		mismatch.Type = MismatchTypeDiverged
+	case activeHistory.IsLCAAppendable(lcaItem) && standbyHistory.IsLCAAppendable(lcaItem):
+		return nil, true, nil
+	case standbyHistory.IsLCAAppendable(lcaItem):
+		mismatch.Type = MismatchTypeBehind
+	default:
+		mismatch.Type = MismatchTypeDiverged
+	}
+	return mismatch, true, nil
+}
+
+func getVersionHistory(
+	ctx context.Context,
+	adminClient admin.Client,
+	domain string,
+	execution *types.WorkflowExecution,
+) (*persistence.VersionHistory, error) {
+	resp, err := adminClient.GetWorkflowExecutionRawHistoryV2(ctx, &types.GetWorkflowExecutionRawHistoryV2Request{
+		Domain:          domain,
+		Execution:       execution,
+		MaximumPageSize: 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.VersionHistory == nil {
+		return nil, fmt.Errorf("version history of %v is not set", execution.GetWorkflowID())
+	}
+	return persistence.NewVersionHistoryFromInternalType(resp.VersionHistory), nil
+}
@@ -0,0 +1,277 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package failoverreadiness
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/replicationstatus"
+	"github.com/uber/cadence/common/types"
+)
+
+type checkerMocks struct {
+	frontendClient     *frontend.MockClient
+	activeAdminClient  *admin.MockClient
+	standbyAdminClient *admin.MockClient
+}
+
+func newTestChecker(t *testing.T, options Options) (*Checker, *checkerMocks) {
+	ctrl := gomock.NewController(t)
+	mocks := &checkerMocks{
+		frontendClient:     frontend.NewMockClient(ctrl),
+		activeAdminClient:  admin.NewMockClient(ctrl),
+		standbyAdminClient: admin.NewMockClient(ctrl),
+	}
+	return NewChecker(&Params{
+		StandbyCluster:     "standby",
+		FrontendClient:     mocks.frontendClient,
+		ActiveAdminClient:  mocks.activeAdminClient,
+		StandbyAdminClient: mocks.standbyAdminClient,
+		Options:            options,
+	}), mocks
+}
+
+func (m *checkerMocks) expectDomain(name, id string, isGlobal bool, activeCluster string, clusters ...string) {
+	replicationConfig := &types.DomainReplicationConfiguration{ActiveClusterName: activeCluster}
+	for _, cluster := range clusters {
+		replicationConfig.Clusters = append(replicationConfig.Clusters, &types.ClusterReplicationConfiguration{ClusterName: cluster})
+	}
+	m.frontendClient.EXPECT().DescribeDomain(gomock.Any(), &types.DescribeDomainRequest{Name: common.StringPtr(name)}).
+		Return(&types.DescribeDomainResponse{
+			DomainInfo:               &types.DomainInfo{Name: name, UUID: id},
+			ReplicationConfiguration: replicationConfig,
+			IsGlobalDomain:           isGlobal,
+		}, nil)
+}
+
+func (m *checkerMocks) expectReplicationLags(t *testing.T, lags ...[]*replicationstatus.DomainLag) {
+	m.standbyAdminClient.EXPECT().DescribeShardDistribution(gomock.Any(), gomock.Any()).
+		Return(&types.DescribeShardDistributionResponse{NumberOfShards: int32(len(lags))}, nil)
+	for shardID, shardLags := range lags {
+		states := make([]string, 0, len(shardLags))
+		for _, lag := range shardLags {
+			state, err := replicationstatus.EncodeDomainLag(lag)
+			require.NoError(t, err)
+			states = append(states, state)
+		}
+		m.standbyAdminClient.EXPECT().DescribeQueue(gomock.Any(), &types.DescribeQueueRequest{
+			ShardID:     int32(shardID),
+			ClusterName: "active",
+			Type:        common.Int32Ptr(int32(constants.TaskTypeReplication)),
+		}).Return(&types.DescribeQueueResponse{ProcessingQueueStates: states}, nil)
+	}
+}
+
+func (m *checkerMocks) expectDLQCounts(counts map[types.HistoryDLQCountKey]int64) {
+	m.standbyAdminClient.EXPECT().CountDLQMessages(gomock.Any(), &types.CountDLQMessagesRequest{ForceFetch: true}).
+		Return(&types.CountDLQMessagesResponse{History: counts}, nil)
+}
+
+func (m *checkerMocks) expectOpenWorkflows(t *testing.T, domain string, workflowIDs ...string) {
+	executions := make([]*types.WorkflowExecutionInfo, 0, len(workflowIDs))
+	for _, workflowID := range workflowIDs {
+		executions = append(executions, &types.WorkflowExecutionInfo{
+			Execution: &types.WorkflowExecution{WorkflowID: workflowID, RunID: workflowID + "-run"},
+		})
+	}
+	m.frontendClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, request *types.ListOpenWorkflowExecutionsRequest, _ ...interface{}) (*types.ListOpenWorkflowExecutionsResponse, error) {
+			assert.Equal(t, domain, request.Domain)
+			assert.Equal(t, int32(10), request.MaximumPageSize)
+			return &types.ListOpenWorkflowExecutionsResponse{Executions: executions}, nil
+		})
+}
+
+func expectVersionHistory(adminClient *admin.MockClient, workflowID string, err error, items ...*types.VersionHistoryItem) {
+	adminClient.EXPECT().GetWorkflowExecutionRawHistoryV2(gomock.Any(), &types.GetWorkflowExecutionRawHistoryV2Request{
+		Domain:          "domain",
+		Execution:       &types.WorkflowExecution{WorkflowID: workflowID, RunID: workflowID + "-run"},
+		MaximumPageSize: 1,
+	}).Return(&types.GetWorkflowExecutionRawHistoryV2Response{VersionHistory: &types.VersionHistory{Items: items}}, err)
+}
+
+func item(eventID, version int64) *types.VersionHistoryItem {
+	return &types.VersionHistoryItem{EventID: eventID, Version: version}
+}
+
+func TestCheckDomains_Ready(t *testing.T) {
+	checker, mocks := newTestChecker(t, Options{SampleSize: 10})
+	mocks.expectDomain("domain", "domain-id", true, "active", "active", "standby")
+	mocks.expectDomain("other", "other-id", true, "active", "active", "standby")
+	mocks.expectReplicationLags(t,
+		[]*replicationstatus.DomainLag{
+			{DomainID: "domain-id", DomainName: "domain", SourceCluster: "active", ShardID: 0, Lag: time.Second, PendingTasks: 1},
+		},
+		[]*replicationstatus.DomainLag{
+			{DomainID: "domain-id", DomainName: "domain", SourceCluster: "active", ShardID: 1, Lag: 2 * time.Second},
+			{DomainID: "other-id", DomainName: "other", SourceCluster: "active", ShardID: 1, Lag: time.Hour, ExceedsThresholds: true},
+		},
+	)
+	mocks.expectDLQCounts(map[types.HistoryDLQCountKey]int64{
+		{ShardID: 0, SourceCluster: "active"}: 0,
+		{ShardID: 1, SourceCluster: "third"}:  3,
+	})
+	mocks.expectOpenWorkflows(t, "domain", "wf")
+	expectVersionHistory(mocks.activeAdminClient, "wf", nil, item(10, 1), item(20, 11))
+	expectVersionHistory(mocks.standbyAdminClient, "wf", nil, item(10, 1), item(20, 11))
+	mocks.expectOpenWorkflows(t, "other")
+
+	reports, err := checker.CheckDomains(context.Background(), []string{"domain", "other"})
+	require.NoError(t, err)
+	require.Len(t, reports, 2)
+	assert.Equal(t, &Report{
+		Domain:                  "domain",
+		ActiveCluster:           "active",
+		StandbyCluster:          "standby",
+		Ready:                   true,
+		ReplicationLag:          2 * time.Second,
+		PendingReplicationTasks: 1,
+		SampledWorkflows:        1,
+	}, reports[0])
+	assert.False(t, reports[1].Ready)
+	assert.Equal(t, []int32{1}, reports[1].LaggingShards)
+	assert.Equal(t, []string{"replication lags behind in 1 shards"}, reports[1].Reasons)
+}
+
+func TestCheck_NotReady(t *testing.T) {
+	checker, mocks := newTestChecker(t, Options{MaxDLQMessages: 1, SampleSize: 10})
+	mocks.expectDomain("domain", "domain-id", true, "active", "active", "standby")
+	mocks.expectReplicationLags(t, nil)
+	mocks.expectDLQCounts(map[types.HistoryDLQCountKey]int64{
+		{ShardID: 0, SourceCluster: "active"}: 1,
+		{ShardID: 1, SourceCluster: "active"}: 1,
+	})
+	mocks.expectOpenWorkflows(t, "domain", "missing", "behind", "diverged", "closed")
+	expectVersionHistory(mocks.activeAdminClient, "missing", nil, item(5, 1))
+	expectVersionHistory(mocks.standbyAdminClient, "missing", &types.EntityNotExistsError{})
+	expectVersionHistory(mocks.activeAdminClient, "behind", nil, item(5, 1), item(9, 11))
+	expectVersionHistory(mocks.standbyAdminClient, "behind", nil, item(5, 1), item(7, 11))
+	expectVersionHistory(mocks.activeAdminClient, "diverged", nil, item(5, 1), item(9, 11))
+	expectVersionHistory(mocks.standbyAdminClient, "diverged", nil, item(5, 1), item(8, 12))
+	expectVersionHistory(mocks.activeAdminClient, "closed", &types.EntityNotExistsError{})
+
+	report, err := checker.Check(context.Background(), "domain")
+	require.NoError(t, err)
+	assert.False(t, report.Ready)
+	assert.Equal(t, int64(2), report.DLQMessages)
+	assert.Equal(t, 3, report.SampledWorkflows)
+	assert.Equal(t, []WorkflowMismatch{
+		{WorkflowID: "missing", RunID: "missing-run", Type: MismatchTypeMissing, ActiveItem: item(5, 1)},
+		{WorkflowID: "behind", RunID: "behind-run", Type: MismatchTypeBehind, ActiveItem: item(9, 11), StandbyItem: item(7, 11)},
+		{WorkflowID: "diverged", RunID: "diverged-run", Type: MismatchTypeDiverged, ActiveItem: item(9, 11), StandbyItem: item(8, 12)},
+	}, report.Mismatches)
+	assert.Equal(t, []string{
+		"no replication status of the domain in standby",
+		"2 replication DLQ messages from active",
+		"3 of 3 sampled workflows differ",
+	}, report.Reasons)
+}
+
+func TestCheck_StatusCache(t *testing.T) {
+	checker, mocks := newTestChecker(t, Options{})
+	timeSource := clock.NewMockedTimeSource()
+	checker.statusCache = NewStatusCache(time.Minute, timeSource)
+	lags := []*replicationstatus.DomainLag{
+		{DomainID: "domain-id", DomainName: "domain", SourceCluster: "active", ShardID: 0, Lag: time.Second},
+	}
+	mocks.expectDLQCounts(nil)
+	mocks.expectDLQCounts(nil)
+	mocks.expectDLQCounts(nil)
+
+	// the second check reuses the replication status of the first one
+	mocks.expectDomain("domain", "domain-id", true, "active", "active", "standby")
+	mocks.expectDomain("domain", "domain-id", true, "active", "active", "standby")
+	mocks.expectReplicationLags(t, lags)
+	for i := 0; i < 2; i++ {
+		report, err := checker.Check(context.Background(), "domain")
+		require.NoError(t, err)
+		assert.True(t, report.Ready)
+	}
+
+	// the replication status is read again once expired
+	timeSource.Advance(time.Minute)
+	mocks.expectDomain("domain", "domain-id", true, "active", "active", "standby")
+	mocks.expectReplicationLags(t, lags)
+	report, err := checker.Check(context.Background(), "domain")
+	require.NoError(t, err)
+	assert.True(t, report.Ready)
+}
+
+func TestCheck_DomainNotEligible(t *testing.T) {
+	tests := map[string]struct {
+		isGlobal       bool
+		activeCluster  string
+		clusters       []string
+		expectedReason string
+	}{
+		"local domain": {
+			activeCluster:  "active",
+			clusters:       []string{"active"},
+			expectedReason: "domain is not a global domain",
+		},
+		"already active": {
+			isGlobal:       true,
+			activeCluster:  "standby",
+			clusters:       []string{"active", "standby"},
+			expectedReason: "domain is already active in standby",
+		},
+		"not replicated": {
+			isGlobal:       true,
+			activeCluster:  "active",
+			clusters:       []string{"active", "third"},
+			expectedReason: "domain is not replicated to standby",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			checker, mocks := newTestChecker(t, Options{SampleSize: 10})
+			mocks.expectDomain("domain", "domain-id", test.isGlobal, test.activeCluster, test.clusters...)
+
+			report, err := checker.Check(context.Background(), "domain")
+			require.NoError(t, err)
+			assert.False(t, report.Ready)
+			assert.Equal(t, []string{test.expectedReason}, report.Reasons)
+		})
+	}
+}
+
+func TestCheck_Error(t *testing.T) {
+	checker, mocks := newTestChecker(t, Options{})
+	mocks.expectDomain("domain", "domain-id", true, "active", "active", "standby")
+	mocks.standbyAdminClient.EXPECT().DescribeShardDistribution(gomock.Any(), gomock.Any()).Return(nil, errors.New("test error"))
+
+	_, err := checker.Check(context.Background(), "domain")
+	assert.ErrorContains(t, err, "test error")
+}
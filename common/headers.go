@@ -51,6 +51,21 @@ const (
 	PartitionConfigHeaderName = "cadence-workflow-partition-config"
 	// IsolationGroupHeaderName refers to the name of the header that contains the isolation group of the client
 	IsolationGroupHeaderName = "cadence-worker-isolation-group"
+	// WorkerHostHeaderName refers to the name of the header that contains the host the polling worker runs on
+	WorkerHostHeaderName = "cadence-worker-host"
+	// WorkerBuildIDHeaderName refers to the name of the header that contains the build ID of the polling worker
+	WorkerBuildIDHeaderName = "cadence-worker-build-id"
+	// WorkerMaxConcurrentTasksHeaderName refers to the name of the header that contains the maximum number
+	// of tasks the polling worker executes concurrently
+	WorkerMaxConcurrentTasksHeaderName = "cadence-worker-max-concurrent-tasks"
+
+	// ResetReapplyPolicyHeaderName refers to the name of the header that contains the json encoded policy
+	// selecting the events reapplied by a workflow reset
+	ResetReapplyPolicyHeaderName = "cadence-reset-reapply-policy"
+
+	// QueueEditHeaderName refers to the name of the header that contains the json encoded virtual queue edit
+	// applied by a queue reset instead of resetting the queue, see queueedit.EditClusterName
+	QueueEditHeaderName = "cadence-queue-edit"
 
 	// ClientIsolationGroupHeaderName refers to the name of the header that contains the isolation group which the client request is from
 	ClientIsolationGroupHeaderName = "cadence-client-isolation-group"
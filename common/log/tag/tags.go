@@ -1153,6 +1153,14 @@ func PartitionDownscaleFactor(qps float64) Tag {
 	return newFloat64Tag("partition-downscale-factor", qps)
 }
 
+func BacklogAge(age time.Duration) Tag {
+	return newDurationTag("backlog-age", age)
+}
+
+func PollerBacklogRatio(ratio float64) Tag {
+	return newFloat64Tag("poller-backlog-ratio", ratio)
+}
+
 func MatchingTaskID(id int64) Tag {
 	return newInt64("matching-task-id", id)
 }
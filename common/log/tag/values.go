@@ -106,6 +106,7 @@ var (
 	ComponentTransferQueueV2                  = component("transfer-queue-processor-v2")
 	ComponentTimerQueue                       = component("timer-queue-processor")
 	ComponentTimerQueueV2                     = component("timer-queue-processor-v2")
+	ComponentHousekeepingQueueV2              = component("housekeeping-queue-processor-v2")
 	ComponentTimerBuilder                     = component("timer-builder")
 	ComponentReplicatorQueue                  = component("replicator-queue-processor")
 	ComponentShardController                  = component("shard-controller")
@@ -127,10 +128,13 @@ var (
 	ComponentESVisibilityManager              = component("es-visibility-manager")
 	ComponentArchiver                         = component("archiver")
 	ComponentBatcher                          = component("batcher")
+	ComponentScheduler                        = component("scheduler")
+	ComponentReplicationDLQTriage             = component("replication-dlq-triage")
 	ComponentWorker                           = component("worker")
 	ComponentServiceResolver                  = component("service-resolver")
 	ComponentFailoverCoordinator              = component("failover-coordinator")
 	ComponentFailoverMarkerNotifier           = component("failover-marker-notifier")
+	ComponentFailoverMarkerDrainer            = component("failover-marker-drainer")
 	ComponentCrossClusterQueueProcessor       = component("cross-cluster-queue-processor")
 	ComponentCrossClusterTaskProcessor        = component("cross-cluster-task-processor")
 	ComponentCrossClusterTaskFetcher          = component("cross-cluster-task-fetcher")
@@ -843,6 +843,8 @@ const (
 	TaskSchedulerScope
 	// TaskSchedulerRateLimiterScope is used by task scheduler rate limiter logic
 	TaskSchedulerRateLimiterScope
+	// TaskSchedulerConcurrencyLimiterScope is used by task scheduler concurrency limiter logic
+	TaskSchedulerConcurrencyLimiterScope
 
 	// HistoryArchiverScope is used by history archivers
 	HistoryArchiverScope
@@ -883,6 +885,8 @@ const (
 
 	// P2PRPCPeerChooserScope is the metrics scope for P2P RPC peer chooser
 	P2PRPCPeerChooserScope
+	// RPCCompressionScope is the metrics scope for gRPC compression
+	RPCCompressionScope
 
 	// PartitionConfigProviderScope is the metrics scope for Partition Config Provider
 	PartitionConfigProviderScope
@@ -1208,6 +1212,8 @@ const (
 	TransferActiveTaskRecordChildExecutionCompletedScope
 	// TransferActiveTaskApplyParentClosePolicyScope is the scope used for apply parent close policy task processing by transfer queue processor
 	TransferActiveTaskApplyParentClosePolicyScope
+	// TransferActiveTaskCompletionCallbackScope is the scope used for completion callback task processing by transfer queue processor
+	TransferActiveTaskCompletionCallbackScope
 	// TransferStandbyTaskResetWorkflowScope is the scope used for record workflow started task processing by transfer queue processor
 	TransferStandbyTaskResetWorkflowScope
 	// TransferStandbyTaskActivityScope is the scope used for activity task processing by transfer queue processor
@@ -1232,10 +1238,14 @@ const (
 	TransferStandbyTaskRecordChildExecutionCompletedScope
 	// TransferActiveTaskApplyParentClosePolicyScope is the scope used for apply parent close policy task processing by transfer queue processor
 	TransferStandbyTaskApplyParentClosePolicyScope
+	// TransferStandbyTaskCompletionCallbackScope is the scope used for completion callback task processing by transfer queue processor
+	TransferStandbyTaskCompletionCallbackScope
 	// TimerQueueProcessorScope is the scope used by all metric emitted by timer queue processor
 	TimerQueueProcessorScope
 	// TimerQueueProcessorV2Scope is the scope used by all metric emitted by timer queue processor
 	TimerQueueProcessorV2Scope
+	// HousekeepingQueueProcessorV2Scope is the scope used by all metric emitted by housekeeping queue processor
+	HousekeepingQueueProcessorV2Scope
 	// TimerActiveQueueProcessorScope is the scope used by all metric emitted by timer queue processor
 	TimerActiveQueueProcessorScope
 	// TimerQueueProcessorScope is the scope used by all metric emitted by timer queue processor
@@ -1252,6 +1262,8 @@ const (
 	TimerActiveTaskActivityRetryTimerScope
 	// TimerActiveTaskWorkflowBackoffTimerScope is the scope used by metric emitted by timer queue processor for processing retry task.
 	TimerActiveTaskWorkflowBackoffTimerScope
+	// TimerActiveTaskDelayedSignalTimerScope is the scope used by metric emitted by timer queue processor for processing delayed signal task.
+	TimerActiveTaskDelayedSignalTimerScope
 	// TimerActiveTaskDeleteHistoryEventScope is the scope used by metric emitted by timer queue processor for processing history event cleanup
 	TimerActiveTaskDeleteHistoryEventScope
 	// TimerStandbyTaskActivityTimeoutScope is the scope used by metric emitted by timer queue processor for processing activity timeouts
@@ -1268,6 +1280,8 @@ const (
 	TimerStandbyTaskDeleteHistoryEventScope
 	// TimerStandbyTaskWorkflowBackoffTimerScope is the scope used by metric emitted by timer queue processor for processing retry task.
 	TimerStandbyTaskWorkflowBackoffTimerScope
+	// TimerStandbyTaskDelayedSignalTimerScope is the scope used by metric emitted by timer queue processor for processing delayed signal task.
+	TimerStandbyTaskDelayedSignalTimerScope
 	// CrossClusterQueueProcessorScope is the scope used by all metric emitted by cross cluster queue processor in the source cluster
 	CrossClusterQueueProcessorScope
 	// CrossClusterTaskProcessorScope is the scope used by all metric emitted by cross cluster task processor in the target cluster
@@ -1832,6 +1846,7 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		ParallelTaskProcessingScope:                                {operation: "ParallelTaskProcessing"},
 		TaskSchedulerScope:                                         {operation: "TaskScheduler"},
 		TaskSchedulerRateLimiterScope:                              {operation: "TaskSchedulerRateLimiter"},
+		TaskSchedulerConcurrencyLimiterScope:                       {operation: "TaskSchedulerConcurrencyLimiter"},
 
 		HistoryArchiverScope:    {operation: "HistoryArchiver"},
 		VisibilityArchiverScope: {operation: "VisibilityArchiver"},
@@ -1856,6 +1871,7 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		GlobalRatelimiterAggregator: {operation: "GlobalRatelimiterAggregator"},
 
 		P2PRPCPeerChooserScope:       {operation: "P2PRPCPeerChooser"},
+		RPCCompressionScope:          {operation: "RPCCompression"},
 		PartitionConfigProviderScope: {operation: "PartitionConfigProvider"},
 
 		ShardDistributorClientGetShardOwnerScope:     {operation: "ShardDistributorClientGetShardOwner"},
@@ -2020,6 +2036,7 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		TransferActiveTaskRecordWorkflowClosedScope:                     {operation: "TransferActiveTaskRecordWorkflowClosed"},
 		TransferActiveTaskRecordChildExecutionCompletedScope:            {operation: "TransferActiveTaskRecordChildExecutionCompleted"},
 		TransferActiveTaskApplyParentClosePolicyScope:                   {operation: "TransferActiveTaskApplyParentClosePolicy"},
+		TransferActiveTaskCompletionCallbackScope:                       {operation: "TransferActiveTaskCompletionCallback"},
 		TransferStandbyTaskActivityScope:                                {operation: "TransferStandbyTaskActivity"},
 		TransferStandbyTaskDecisionScope:                                {operation: "TransferStandbyTaskDecision"},
 		TransferStandbyTaskCloseExecutionScope:                          {operation: "TransferStandbyTaskCloseExecution"},
@@ -2032,8 +2049,10 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		TransferStandbyTaskRecordWorkflowClosedScope:                    {operation: "TransferStandbyTaskRecordWorkflowClosed"},
 		TransferStandbyTaskRecordChildExecutionCompletedScope:           {operation: "TransferStandbyTaskRecordChildExecutionCompleted"},
 		TransferStandbyTaskApplyParentClosePolicyScope:                  {operation: "TransferStandbyTaskApplyParentClosePolicy"},
+		TransferStandbyTaskCompletionCallbackScope:                      {operation: "TransferStandbyTaskCompletionCallback"},
 		TimerQueueProcessorScope:                                        {operation: "TimerQueueProcessor"},
 		TimerQueueProcessorV2Scope:                                      {operation: "TimerQueueProcessorV2"},
+		HousekeepingQueueProcessorV2Scope:                               {operation: "HousekeepingQueueProcessorV2"},
 		TimerActiveQueueProcessorScope:                                  {operation: "TimerActiveQueueProcessor"},
 		TimerStandbyQueueProcessorScope:                                 {operation: "TimerStandbyQueueProcessor"},
 		TimerActiveTaskActivityTimeoutScope:                             {operation: "TimerActiveTaskActivityTimeout"},
@@ -2042,6 +2061,7 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		TimerActiveTaskWorkflowTimeoutScope:                             {operation: "TimerActiveTaskWorkflowTimeout"},
 		TimerActiveTaskActivityRetryTimerScope:                          {operation: "TimerActiveTaskActivityRetryTimer"},
 		TimerActiveTaskWorkflowBackoffTimerScope:                        {operation: "TimerActiveTaskWorkflowBackoffTimer"},
+		TimerActiveTaskDelayedSignalTimerScope:                          {operation: "TimerActiveTaskDelayedSignalTimer"},
 		TimerActiveTaskDeleteHistoryEventScope:                          {operation: "TimerActiveTaskDeleteHistoryEvent"},
 		TimerStandbyTaskActivityTimeoutScope:                            {operation: "TimerStandbyTaskActivityTimeout"},
 		TimerStandbyTaskDecisionTimeoutScope:                            {operation: "TimerStandbyTaskDecisionTimeout"},
@@ -2049,6 +2069,7 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		TimerStandbyTaskWorkflowTimeoutScope:                            {operation: "TimerStandbyTaskWorkflowTimeout"},
 		TimerStandbyTaskActivityRetryTimerScope:                         {operation: "TimerStandbyTaskActivityRetryTimer"},
 		TimerStandbyTaskWorkflowBackoffTimerScope:                       {operation: "TimerStandbyTaskWorkflowBackoffTimer"},
+		TimerStandbyTaskDelayedSignalTimerScope:                         {operation: "TimerStandbyTaskDelayedSignalTimer"},
 		TimerStandbyTaskDeleteHistoryEventScope:                         {operation: "TimerStandbyTaskDeleteHistoryEvent"},
 		CrossClusterQueueProcessorScope:                                 {operation: "CrossClusterQueueProcessor"},
 		CrossClusterTaskProcessorScope:                                  {operation: "CrossClusterTaskProcessor"},
@@ -2402,6 +2423,10 @@ const (
 	P2PPeersCount
 	P2PPeerAdded
 	P2PPeerRemoved
+	// rpc compression metrics
+	RPCCompressionInputBytes
+	RPCCompressionOutputBytes
+	RPCCompressionBytesSaved
 	// task list partition config metrics
 	TaskListPartitionConfigVersionGauge
 	TaskListPartitionConfigNumReadGauge
@@ -2475,6 +2500,7 @@ const (
 	TaskEnqueueToFetchLatency
 	TransferTaskMissingEventCounterPerDomain
 	ReplicationTasksAppliedPerDomain
+	ReplicationLagThresholdExceededPerDomain
 	WorkflowTerminateCounterPerDomain
 	TaskSchedulerAllowedCounterPerDomain
 	TaskSchedulerThrottledCounterPerDomain
@@ -2682,6 +2708,7 @@ const (
 	ReplicationTasksReturnedDiff
 	ReplicationTasksAppliedLatency
 	ReplicationTasksBatchSize
+	ReplicationTasksReturnedBytes
 	ReplicationDynamicTaskBatchSizerDecision
 	ReplicationDLQFailed
 	ReplicationDLQMaxLevelGauge
@@ -2721,6 +2748,9 @@ const (
 	FailoverMarkerNotificationFailure
 	FailoverMarkerUpdateShardFailure
 	FailoverMarkerCallbackCount
+	FailoverMarkerDrainLatency
+	FailoverMarkerDrainTimeout
+	GracefulFailoverReplicationLatency
 	HistoryFailoverCallbackCount
 	WorkflowVersionCount
 	WorkflowTypeCount
@@ -2752,6 +2782,7 @@ const (
 	RespondQueryTaskFailedPerTaskListCounter
 	SyncThrottlePerTaskListCounter
 	BufferThrottlePerTaskListCounter
+	BufferActivityTypeThrottlePerTaskListCounter
 	BufferUnknownTaskDispatchError
 	BufferIsolationGroupRedirectCounter
 	BufferIsolationGroupRedirectFailureCounter
@@ -2821,6 +2852,17 @@ const (
 	IsolationGroupUpscale
 	IsolationGroupDownscale
 	PartitionDrained
+	PartitionBacklogUpscale
+	TaskListBacklogAgeGauge
+	TaskListPollerBacklogRatioGauge
+	WorkerVersioningLeakPerTaskList
+	TaskListPollerFleetPollersGauge
+	TaskListPollerFleetWaitingPollersGauge
+	TaskListPollerFleetHostsGauge
+	TaskListPollerFleetMaxConcurrentTasksGauge
+	ForwardedTaskMatchLatencyPerTaskList
+	ForwardedTaskHopsPerTaskList
+	ForwardedTaskHopLatencyPerTaskList
 
 	NumMatchingMetrics
 )
@@ -3201,6 +3243,9 @@ var MetricDefs = map[ServiceIdx]map[MetricIdx]metricDefinition{
 		P2PPeersCount:                        {metricName: "peers_count", metricType: Gauge},
 		P2PPeerAdded:                         {metricName: "peer_added", metricType: Counter},
 		P2PPeerRemoved:                       {metricName: "peer_removed", metricType: Counter},
+		RPCCompressionInputBytes:             {metricName: "rpc_compression_input_bytes", metricType: Counter},
+		RPCCompressionOutputBytes:            {metricName: "rpc_compression_output_bytes", metricType: Counter},
+		RPCCompressionBytesSaved:             {metricName: "rpc_compression_bytes_saved", metricType: Counter},
 		TaskListPartitionConfigVersionGauge:  {metricName: "task_list_partition_config_version", metricType: Gauge},
 		TaskListPartitionConfigNumReadGauge:  {metricName: "task_list_partition_config_num_read", metricType: Gauge},
 		TaskListPartitionConfigNumWriteGauge: {metricName: "task_list_partition_config_num_write", metricType: Gauge},
@@ -3265,6 +3310,7 @@ var MetricDefs = map[ServiceIdx]map[MetricIdx]metricDefinition{
 		TaskEnqueueToFetchLatency:                {metricName: "task_latency_enqueue_to_fetch", metricType: Histogram, buckets: HistoryTaskLatencyBuckets},
 		TransferTaskMissingEventCounterPerDomain: {metricName: "transfer_task_missing_event_counter_per_domain", metricRollupName: "transfer_task_missing_event_counter", metricType: Counter},
 		ReplicationTasksAppliedPerDomain:         {metricName: "replication_tasks_applied_per_domain", metricType: Counter},
+		ReplicationLagThresholdExceededPerDomain: {metricName: "replication_lag_threshold_exceeded_per_domain", metricType: Counter},
 		WorkflowTerminateCounterPerDomain:        {metricName: "workflow_terminate_counter_per_domain", metricRollupName: "workflow_terminate_counter", metricType: Counter},
 		TaskSchedulerAllowedCounterPerDomain:     {metricName: "task_scheduler_allowed_counter_per_domain", metricRollupName: "task_scheduler_allowed_counter", metricType: Counter},
 		TaskSchedulerThrottledCounterPerDomain:   {metricName: "task_scheduler_throttled_counter_per_domain", metricRollupName: "task_scheduler_throttled_counter", metricType: Counter},
@@ -3463,6 +3509,7 @@ var MetricDefs = map[ServiceIdx]map[MetricIdx]metricDefinition{
 		ReplicationTasksReturnedDiff:                                 {metricName: "replication_tasks_returned_diff", metricType: Timer},
 		ReplicationTasksAppliedLatency:                               {metricName: "replication_tasks_applied_latency", metricType: Timer},
 		ReplicationTasksBatchSize:                                    {metricName: "replication_tasks_batch_size", metricType: Gauge},
+		ReplicationTasksReturnedBytes:                                {metricName: "replication_tasks_returned_bytes", metricType: Timer},
 		ReplicationDynamicTaskBatchSizerDecision:                     {metricName: "replication_dynamic_task_batch_sizer_decision", metricType: Counter},
 		ReplicationDLQFailed:                                         {metricName: "replication_dlq_enqueue_failed", metricType: Counter},
 		ReplicationDLQMaxLevelGauge:                                  {metricName: "replication_dlq_max_level", metricType: Gauge},
@@ -3502,6 +3549,9 @@ var MetricDefs = map[ServiceIdx]map[MetricIdx]metricDefinition{
 		FailoverMarkerNotificationFailure:                            {metricName: "failover_marker_notification_failures", metricType: Counter},
 		FailoverMarkerUpdateShardFailure:                             {metricName: "failover_marker_update_shard_failures", metricType: Counter},
 		FailoverMarkerCallbackCount:                                  {metricName: "failover_marker_callback_count", metricType: Counter},
+		FailoverMarkerDrainLatency:                                   {metricName: "failover_marker_drain_latency", metricType: Timer},
+		FailoverMarkerDrainTimeout:                                   {metricName: "failover_marker_drain_timeouts", metricType: Counter},
+		GracefulFailoverReplicationLatency:                           {metricName: "graceful_failover_replication_latency", metricType: Timer},
 		HistoryFailoverCallbackCount:                                 {metricName: "failover_callback_handler_count", metricType: Counter},
 		TransferTasksCount:                                           {metricName: "transfer_tasks_count", metricType: Timer},
 		TimerTasksCount:                                              {metricName: "timer_tasks_count", metricType: Timer},
@@ -3533,6 +3583,7 @@ var MetricDefs = map[ServiceIdx]map[MetricIdx]metricDefinition{
 		RespondQueryTaskFailedPerTaskListCounter:                {metricName: "respond_query_failed_per_tl", metricRollupName: "respond_query_failed"},
 		SyncThrottlePerTaskListCounter:                          {metricName: "sync_throttle_count_per_tl", metricRollupName: "sync_throttle_count"},
 		BufferThrottlePerTaskListCounter:                        {metricName: "buffer_throttle_count_per_tl", metricRollupName: "buffer_throttle_count"},
+		BufferActivityTypeThrottlePerTaskListCounter:            {metricName: "buffer_activity_type_throttle_count_per_tl", metricRollupName: "buffer_activity_type_throttle_count"},
 		BufferUnknownTaskDispatchError:                          {metricName: "buffer_unknown_task_dispatch_error_per_tl", metricRollupName: "buffer_unknown_task_dispatch_error"},
 		BufferIsolationGroupRedirectCounter:                     {metricName: "buffer_isolation_group_redirected_per_tl", metricRollupName: "buffer_isolation_group_redirected"},
 		BufferIsolationGroupRedirectFailureCounter:              {metricName: "buffer_isolation_group_redirect_failure_per_tl", metricRollupName: "buffer_isolation_group_redirect_failure"},
@@ -3596,6 +3647,17 @@ var MetricDefs = map[ServiceIdx]map[MetricIdx]metricDefinition{
 		PartitionUpscale:                                        {metricName: "partition_upscale_per_tl", metricRollupName: "partition_upscale"},
 		PartitionDownscale:                                      {metricName: "partition_downscale_per_tl", metricRollupName: "partition_downscale"},
 		PartitionDrained:                                        {metricName: "partition_drained_per_tl", metricRollupName: "partition_drained"},
+		PartitionBacklogUpscale:                                 {metricName: "partition_backlog_upscale_per_tl", metricRollupName: "partition_backlog_upscale"},
+		TaskListBacklogAgeGauge:                                 {metricName: "tasklist_backlog_age_per_tl", metricType: Gauge},
+		TaskListPollerBacklogRatioGauge:                         {metricName: "tasklist_poller_backlog_ratio_per_tl", metricType: Gauge},
+		WorkerVersioningLeakPerTaskList:                         {metricName: "worker_versioning_leak_per_tl", metricRollupName: "worker_versioning_leak"},
+		TaskListPollerFleetPollersGauge:                         {metricName: "tasklist_poller_fleet_pollers_per_tl", metricType: Gauge},
+		TaskListPollerFleetWaitingPollersGauge:                  {metricName: "tasklist_poller_fleet_waiting_pollers_per_tl", metricType: Gauge},
+		TaskListPollerFleetHostsGauge:                           {metricName: "tasklist_poller_fleet_hosts_per_tl", metricType: Gauge},
+		TaskListPollerFleetMaxConcurrentTasksGauge:              {metricName: "tasklist_poller_fleet_max_concurrent_tasks_per_tl", metricType: Gauge},
+		ForwardedTaskMatchLatencyPerTaskList:                    {metricName: "forwarded_task_match_latency_per_tl", metricRollupName: "forwarded_task_match_latency", metricType: Timer},
+		ForwardedTaskHopsPerTaskList:                            {metricName: "forwarded_task_hops_per_tl", metricRollupName: "forwarded_task_hops", metricType: Timer},
+		ForwardedTaskHopLatencyPerTaskList:                      {metricName: "forwarded_task_hop_latency_per_tl", metricRollupName: "forwarded_task_hop_latency", metricType: Timer},
 		IsolationRebalance:                                      {metricName: "isolation_rebalance_per_tl", metricRollupName: "isolation_rebalance"},
 		IsolationGroupStartedPolling:                            {metricName: "ig_started_polling_per_tl", metricRollupName: "ig_started_polling"},
 		IsolationGroupStoppedPolling:                            {metricName: "ig_stopped_polling_per_tl", metricRollupName: "ig_stopped_polling"},
@@ -52,6 +52,7 @@ const (
 	shardScannerFixResult     = "shardscanner_fix_result"
 	kafkaPartition            = "kafkaPartition"
 	transport                 = "transport"
+	compressor                = "compressor"
 	caller                    = "caller"
 	service                   = "service"
 	destService               = "dest_service"
@@ -71,6 +72,9 @@ const (
 	mode                      = "mode"
 	isRetry                   = "is_retry"
 	queryConsistencyLevel     = "query_consistency_level"
+	clientImpl                = "client_impl"
+	matchingHop               = "matching_hop"
+	matchingHopSource         = "matching_hop_source"
 
 	// limiter-side tags
 	globalRatelimitKey            = "global_ratelimit_key"
@@ -228,11 +232,26 @@ func KafkaPartitionTag(value int32) Tag {
 	return simpleMetric{key: kafkaPartition, value: strconv.Itoa(int(value))}
 }
 
+// MatchingHopTag returns a new tag for the position of a task list partition in the hops of a forwarded task.
+func MatchingHopTag(value int) Tag {
+	return simpleMetric{key: matchingHop, value: strconv.Itoa(value)}
+}
+
+// MatchingHopSourceTag returns a new tag for whether a task waited in a task list partition for a sync match or in its backlog.
+func MatchingHopSourceTag(value string) Tag {
+	return metricWithUnknown(matchingHopSource, value)
+}
+
 // TransportTag returns a new RPC Transport type tag.
 func TransportTag(value string) Tag {
 	return simpleMetric{key: transport, value: value}
 }
 
+// CompressorTag returns a new RPC compressor tag.
+func CompressorTag(value string) Tag {
+	return simpleMetric{key: compressor, value: value}
+}
+
 // CallerTag returns a new RPC Caller type tag.
 func CallerTag(value string) Tag {
 	return simpleMetric{key: caller, value: value}
@@ -277,6 +296,11 @@ func PollerIsolationGroupTag(value string) Tag {
 	return metricWithUnknown(pollerIsolationGroup, value)
 }
 
+// ClientImplTag returns a new client implementation tag
+func ClientImplTag(value string) Tag {
+	return metricWithUnknown(clientImpl, value)
+}
+
 // AsyncWFRequestTypeTag returns a new AsyncWFRequestTypeTag tag
 func AsyncWFRequestTypeTag(value string) Tag {
 	return metricWithUnknown(asyncWFRequestType, value)
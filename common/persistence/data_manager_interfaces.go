@@ -203,9 +203,10 @@ const (
 )
 
 const (
-	HistoryTaskCategoryIDTransfer    = 1
-	HistoryTaskCategoryIDTimer       = 2
-	HistoryTaskCategoryIDReplication = 3
+	HistoryTaskCategoryIDTransfer     = 1
+	HistoryTaskCategoryIDTimer        = 2
+	HistoryTaskCategoryIDReplication  = 3
+	HistoryTaskCategoryIDHousekeeping = 4
 )
 
 var (
@@ -224,6 +225,13 @@ var (
 		categoryID:   HistoryTaskCategoryIDReplication,
 		categoryName: "replication",
 	}
+	// HistoryTaskCategoryHousekeeping holds background cleanup tasks (e.g. workflow deletion after retention)
+	// so that bursts of them are stored and processed separately from user facing timers
+	HistoryTaskCategoryHousekeeping = HistoryTaskCategory{
+		categoryType: HistoryTaskCategoryTypeScheduled,
+		categoryID:   HistoryTaskCategoryIDHousekeeping,
+		categoryName: "housekeeping",
+	}
 )
 
 // Transfer task types
@@ -240,6 +248,7 @@ const (
 	TransferTaskTypeRecordWorkflowClosed
 	TransferTaskTypeRecordChildExecutionCompleted
 	TransferTaskTypeApplyParentClosePolicy // Deprecated: this is related to cross-cluster tasks
+	TransferTaskTypeCompletionCallback
 )
 
 // Types of replication tasks
@@ -258,6 +267,7 @@ const (
 	TaskTypeDeleteHistoryEvent
 	TaskTypeActivityRetryTimer
 	TaskTypeWorkflowBackoffTimer
+	TaskTypeDelayedSignalTimer
 )
 
 // WorkflowRequestType is the type of workflow request
@@ -1747,6 +1757,11 @@ func (t *TransferTaskInfo) ToTask() (Task, error) {
 			WorkflowIdentifier: workflowIdentifier,
 			TaskData:           taskData,
 		}, nil
+	case TransferTaskTypeCompletionCallback:
+		return &CompletionCallbackTask{
+			WorkflowIdentifier: workflowIdentifier,
+			TaskData:           taskData,
+		}, nil
 	case TransferTaskTypeRecordChildExecutionCompleted:
 		return &RecordChildExecutionCompletedTask{
 			WorkflowIdentifier: workflowIdentifier,
@@ -1928,6 +1943,12 @@ func (t *TimerTaskInfo) ToTask() (Task, error) {
 			TaskData:           taskData,
 			TimeoutType:        t.TimeoutType,
 		}, nil
+	case TaskTypeDelayedSignalTimer:
+		return &DelayedSignalTimerTask{
+			WorkflowIdentifier: workflowIdentifier,
+			TaskData:           taskData,
+			DelayedSignalID:    t.EventID,
+		}, nil
 	default:
 		return nil, fmt.Errorf("unknown task type: %d", t.TaskType)
 	}
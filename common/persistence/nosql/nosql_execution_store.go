@@ -814,8 +814,12 @@ func (d *nosqlExecutionStore) getScheduledHistoryTasks(
 	request *persistence.GetHistoryTasksRequest,
 ) (*persistence.GetHistoryTasksResponse, error) {
 	switch request.TaskCategory.ID() {
-	case persistence.HistoryTaskCategoryIDTimer:
-		timers, nextPageToken, err := d.db.SelectTimerTasksOrderByVisibilityTime(ctx, d.shardID, request.PageSize, request.NextPageToken, request.InclusiveMinTaskKey.GetScheduledTime(), request.ExclusiveMaxTaskKey.GetScheduledTime())
+	case persistence.HistoryTaskCategoryIDTimer, persistence.HistoryTaskCategoryIDHousekeeping:
+		selectTasks := d.db.SelectTimerTasksOrderByVisibilityTime
+		if request.TaskCategory.ID() == persistence.HistoryTaskCategoryIDHousekeeping {
+			selectTasks = d.db.SelectHousekeepingTasksOrderByVisibilityTime
+		}
+		timers, nextPageToken, err := selectTasks(ctx, d.shardID, request.PageSize, request.NextPageToken, request.InclusiveMinTaskKey.GetScheduledTime(), request.ExclusiveMaxTaskKey.GetScheduledTime())
 		if err != nil {
 			return nil, convertCommonErrors(d.db, "GetScheduledHistoryTasks", err)
 		}
@@ -865,8 +869,12 @@ func (d *nosqlExecutionStore) completeScheduledHistoryTask(
 	request *persistence.CompleteHistoryTaskRequest,
 ) error {
 	switch request.TaskCategory.ID() {
-	case persistence.HistoryTaskCategoryIDTimer:
-		err := d.db.DeleteTimerTask(ctx, d.shardID, request.TaskKey.GetTaskID(), request.TaskKey.GetScheduledTime())
+	case persistence.HistoryTaskCategoryIDTimer, persistence.HistoryTaskCategoryIDHousekeeping:
+		deleteTask := d.db.DeleteTimerTask
+		if request.TaskCategory.ID() == persistence.HistoryTaskCategoryIDHousekeeping {
+			deleteTask = d.db.DeleteHousekeepingTask
+		}
+		err := deleteTask(ctx, d.shardID, request.TaskKey.GetTaskID(), request.TaskKey.GetScheduledTime())
 		if err != nil {
 			return convertCommonErrors(d.db, "CompleteScheduledHistoryTask", err)
 		}
@@ -917,8 +925,12 @@ func (d *nosqlExecutionStore) rangeCompleteScheduledHistoryTask(
 	request *persistence.RangeCompleteHistoryTaskRequest,
 ) (*persistence.RangeCompleteHistoryTaskResponse, error) {
 	switch request.TaskCategory.ID() {
-	case persistence.HistoryTaskCategoryIDTimer:
-		err := d.db.RangeDeleteTimerTasks(ctx, d.shardID, request.InclusiveMinTaskKey.GetScheduledTime(), request.ExclusiveMaxTaskKey.GetScheduledTime())
+	case persistence.HistoryTaskCategoryIDTimer, persistence.HistoryTaskCategoryIDHousekeeping:
+		rangeDeleteTasks := d.db.RangeDeleteTimerTasks
+		if request.TaskCategory.ID() == persistence.HistoryTaskCategoryIDHousekeeping {
+			rangeDeleteTasks = d.db.RangeDeleteHousekeepingTasks
+		}
+		err := rangeDeleteTasks(ctx, d.shardID, request.InclusiveMinTaskKey.GetScheduledTime(), request.ExclusiveMaxTaskKey.GetScheduledTime())
 		if err != nil {
 			return nil, convertCommonErrors(d.db, "RangeCompleteTimerTask", err)
 		}
@@ -245,6 +245,9 @@ func (d *nosqlExecutionStore) prepareTimerTasksForWorkflowTxn(domainID, workflow
 		case *persistence.WorkflowBackoffTimerTask:
 			timeoutType = t.TimeoutType
 
+		case *persistence.DelayedSignalTimerTask:
+			eventID = t.DelayedSignalID
+
 		case *persistence.WorkflowTimeoutTask:
 			// noop
 
@@ -365,7 +368,8 @@ func (d *nosqlExecutionStore) prepareNoSQLTasksForWorkflowTxn(
 				return err
 			}
 			outputTasks[c] = append(outputTasks[c], transferTasks...)
-		case persistence.HistoryTaskCategoryIDTimer:
+		case persistence.HistoryTaskCategoryIDTimer, persistence.HistoryTaskCategoryIDHousekeeping:
+			// housekeeping tasks are stored with the same layout as timer tasks
 			timerTasks, err := d.prepareTimerTasksForWorkflowTxn(domainID, workflowID, runID, tasks)
 			if err != nil {
 				return err
@@ -443,7 +447,8 @@ func (d *nosqlExecutionStore) prepareTransferTasksForWorkflowTxn(domainID, workf
 			persistence.TransferTaskTypeRecordWorkflowStarted,
 			persistence.TransferTaskTypeResetWorkflow,
 			persistence.TransferTaskTypeUpsertWorkflowSearchAttributes,
-			persistence.TransferTaskTypeRecordWorkflowClosed:
+			persistence.TransferTaskTypeRecordWorkflowClosed,
+			persistence.TransferTaskTypeCompletionCallback:
 			// No explicit property needs to be set
 
 		default:
@@ -68,6 +68,7 @@ const (
 	rowTypeWorkflowRequestCancel
 	rowTypeWorkflowRequestReset
 	rowTypeWorkflowActiveClusterSelectionPolicy
+	rowTypeHousekeepingTask
 )
 
 // Guidelines for creating new special UUID constants
@@ -97,6 +98,10 @@ const (
 	rowTypeTimerDomainID   = "10000000-4000-f000-f000-000000000000"
 	rowTypeTimerWorkflowID = "20000000-4000-f000-f000-000000000000"
 	rowTypeTimerRunID      = "30000000-4000-f000-f000-000000000000"
+	// Row Constants for Housekeeping Task Row
+	rowTypeHousekeepingDomainID   = "10000000-8000-f000-f000-000000000000"
+	rowTypeHousekeepingWorkflowID = "20000000-8000-f000-f000-000000000000"
+	rowTypeHousekeepingRunID      = "30000000-8000-f000-f000-000000000000"
 	// Row Constants for Replication Task Row
 	rowTypeReplicationDomainID   = "10000000-5000-f000-f000-000000000000"
 	rowTypeReplicationWorkflowID = "20000000-5000-f000-f000-000000000000"
@@ -112,3 +117,28 @@ const (
 	rowTypeWorkflowActiveClusterSelectionVersion = int64(-1001)
 	workflowRequestTTLInSeconds                  = 10800
 )
+
+// scheduledTaskRowKey identifies the executions table partition holding one category of scheduled tasks.
+// Timer and housekeeping tasks share the same row layout but live in different partitions,
+// so reading one category never pages through rows of the other.
+type scheduledTaskRowKey struct {
+	rowType    int
+	domainID   string
+	workflowID string
+	runID      string
+}
+
+var (
+	timerTaskRowKey = scheduledTaskRowKey{
+		rowType:    rowTypeTimerTask,
+		domainID:   rowTypeTimerDomainID,
+		workflowID: rowTypeTimerWorkflowID,
+		runID:      rowTypeTimerRunID,
+	}
+	housekeepingTaskRowKey = scheduledTaskRowKey{
+		rowType:    rowTypeHousekeepingTask,
+		domainID:   rowTypeHousekeepingDomainID,
+		workflowID: rowTypeHousekeepingWorkflowID,
+		runID:      rowTypeHousekeepingRunID,
+	}
+)
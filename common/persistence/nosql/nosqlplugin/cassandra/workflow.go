@@ -464,15 +464,46 @@ func (db *CDB) RangeDeleteTransferTasks(ctx context.Context, shardID int, exclus
 }
 
 func (db *CDB) SelectTimerTasksOrderByVisibilityTime(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTime, exclusiveMaxTime time.Time) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
+	return db.selectScheduledTasksOrderByVisibilityTime(ctx, "SelectTimerTasksOrderByVisibilityTime", timerTaskRowKey, shardID, pageSize, pageToken, inclusiveMinTime, exclusiveMaxTime)
+}
+
+func (db *CDB) DeleteTimerTask(ctx context.Context, shardID int, taskID int64, visibilityTimestamp time.Time) error {
+	return db.deleteScheduledTask(ctx, timerTaskRowKey, shardID, taskID, visibilityTimestamp)
+}
+
+func (db *CDB) RangeDeleteTimerTasks(ctx context.Context, shardID int, inclusiveMinTime, exclusiveMaxTime time.Time) error {
+	return db.rangeDeleteScheduledTasks(ctx, timerTaskRowKey, shardID, inclusiveMinTime, exclusiveMaxTime)
+}
+
+func (db *CDB) SelectHousekeepingTasksOrderByVisibilityTime(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTime, exclusiveMaxTime time.Time) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
+	return db.selectScheduledTasksOrderByVisibilityTime(ctx, "SelectHousekeepingTasksOrderByVisibilityTime", housekeepingTaskRowKey, shardID, pageSize, pageToken, inclusiveMinTime, exclusiveMaxTime)
+}
+
+func (db *CDB) DeleteHousekeepingTask(ctx context.Context, shardID int, taskID int64, visibilityTimestamp time.Time) error {
+	return db.deleteScheduledTask(ctx, housekeepingTaskRowKey, shardID, taskID, visibilityTimestamp)
+}
+
+func (db *CDB) RangeDeleteHousekeepingTasks(ctx context.Context, shardID int, inclusiveMinTime, exclusiveMaxTime time.Time) error {
+	return db.rangeDeleteScheduledTasks(ctx, housekeepingTaskRowKey, shardID, inclusiveMinTime, exclusiveMaxTime)
+}
+
+func (db *CDB) selectScheduledTasksOrderByVisibilityTime(
+	ctx context.Context,
+	operation string,
+	rowKey scheduledTaskRowKey,
+	shardID, pageSize int,
+	pageToken []byte,
+	inclusiveMinTime, exclusiveMaxTime time.Time,
+) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
 	// Reading timer tasks need to be quorum level consistent, otherwise we could loose task
 	minTimestamp := persistence.UnixNanoToDBTimestamp(inclusiveMinTime.UnixNano())
 	maxTimestamp := persistence.UnixNanoToDBTimestamp(exclusiveMaxTime.UnixNano())
 	query := db.session.Query(templateGetTimerTasksQuery,
 		shardID,
-		rowTypeTimerTask,
-		rowTypeTimerDomainID,
-		rowTypeTimerWorkflowID,
-		rowTypeTimerRunID,
+		rowKey.rowType,
+		rowKey.domainID,
+		rowKey.workflowID,
+		rowKey.runID,
 		minTimestamp,
 		maxTimestamp,
 	).PageSize(pageSize).PageState(pageToken).WithContext(ctx)
@@ -480,7 +511,7 @@ func (db *CDB) SelectTimerTasksOrderByVisibilityTime(ctx context.Context, shardI
 	iter := query.Iter()
 	if iter == nil {
 		return nil, nil, &types.InternalServiceError{
-			Message: "SelectTimerTasksOrderByVisibilityTime operation failed.  Not able to create query iterator.",
+			Message: operation + " operation failed.  Not able to create query iterator.",
 		}
 	}
 
@@ -510,14 +541,14 @@ func (db *CDB) SelectTimerTasksOrderByVisibilityTime(ctx context.Context, shardI
 	return timers, nextPageToken, err
 }
 
-func (db *CDB) DeleteTimerTask(ctx context.Context, shardID int, taskID int64, visibilityTimestamp time.Time) error {
+func (db *CDB) deleteScheduledTask(ctx context.Context, rowKey scheduledTaskRowKey, shardID int, taskID int64, visibilityTimestamp time.Time) error {
 	ts := persistence.UnixNanoToDBTimestamp(visibilityTimestamp.UnixNano())
 	query := db.session.Query(templateCompleteTimerTaskQuery,
 		shardID,
-		rowTypeTimerTask,
-		rowTypeTimerDomainID,
-		rowTypeTimerWorkflowID,
-		rowTypeTimerRunID,
+		rowKey.rowType,
+		rowKey.domainID,
+		rowKey.workflowID,
+		rowKey.runID,
 		ts,
 		taskID,
 	).WithContext(ctx)
@@ -525,15 +556,15 @@ func (db *CDB) DeleteTimerTask(ctx context.Context, shardID int, taskID int64, v
 	return db.executeWithConsistencyAll(query)
 }
 
-func (db *CDB) RangeDeleteTimerTasks(ctx context.Context, shardID int, inclusiveMinTime, exclusiveMaxTime time.Time) error {
+func (db *CDB) rangeDeleteScheduledTasks(ctx context.Context, rowKey scheduledTaskRowKey, shardID int, inclusiveMinTime, exclusiveMaxTime time.Time) error {
 	start := persistence.UnixNanoToDBTimestamp(inclusiveMinTime.UnixNano())
 	end := persistence.UnixNanoToDBTimestamp(exclusiveMaxTime.UnixNano())
 	query := db.session.Query(templateRangeCompleteTimerTaskQuery,
 		shardID,
-		rowTypeTimerTask,
-		rowTypeTimerDomainID,
-		rowTypeTimerWorkflowID,
-		rowTypeTimerRunID,
+		rowKey.rowType,
+		rowKey.domainID,
+		rowKey.workflowID,
+		rowKey.runID,
 		start,
 		end,
 	).WithContext(ctx)
@@ -435,6 +435,8 @@ func createTasksByCategory(
 			createTransferTasks(batch, shardID, domainID, workflowID, tasks, timeStamp)
 		case persistence.HistoryTaskCategoryIDTimer:
 			createTimerTasks(batch, shardID, domainID, workflowID, tasks, timeStamp)
+		case persistence.HistoryTaskCategoryIDHousekeeping:
+			createHousekeepingTasks(batch, shardID, domainID, workflowID, tasks, timeStamp)
 		case persistence.HistoryTaskCategoryIDReplication:
 			createReplicationTasks(batch, shardID, domainID, workflowID, tasks, timeStamp)
 		}
@@ -451,7 +453,30 @@ func createTimerTasks(
 	timerTasks []*nosqlplugin.HistoryMigrationTask,
 	timeStamp time.Time,
 ) error {
-	for _, timer := range timerTasks {
+	return createScheduledTasks(batch, timerTaskRowKey, shardID, domainID, workflowID, timerTasks, timeStamp)
+}
+
+func createHousekeepingTasks(
+	batch gocql.Batch,
+	shardID int,
+	domainID string,
+	workflowID string,
+	housekeepingTasks []*nosqlplugin.HistoryMigrationTask,
+	timeStamp time.Time,
+) error {
+	return createScheduledTasks(batch, housekeepingTaskRowKey, shardID, domainID, workflowID, housekeepingTasks, timeStamp)
+}
+
+func createScheduledTasks(
+	batch gocql.Batch,
+	rowKey scheduledTaskRowKey,
+	shardID int,
+	domainID string,
+	workflowID string,
+	scheduledTasks []*nosqlplugin.HistoryMigrationTask,
+	timeStamp time.Time,
+) error {
+	for _, timer := range scheduledTasks {
 		task := timer.Timer
 		taskBlob, taskEncoding := persistence.FromDataBlob(timer.Task)
 		// Ignoring possible type cast errors.
@@ -459,10 +484,10 @@ func createTimerTasks(
 
 		batch.Query(templateCreateTimerTaskQuery,
 			shardID,
-			rowTypeTimerTask,
-			rowTypeTimerDomainID,
-			rowTypeTimerWorkflowID,
-			rowTypeTimerRunID,
+			rowKey.rowType,
+			rowKey.domainID,
+			rowKey.workflowID,
+			rowKey.runID,
 			domainID,
 			workflowID,
 			task.RunID,
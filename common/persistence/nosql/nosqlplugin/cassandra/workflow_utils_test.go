@@ -1093,6 +1093,42 @@ func TestCreateTimerTasks(t *testing.T) {
 	}
 }
 
+func TestCreateHousekeepingTasks(t *testing.T) {
+	ts, err := time.Parse(time.RFC3339, "2023-12-12T22:08:41Z")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	batch := &fakeBatch{}
+	err = createHousekeepingTasks(batch, 1000, "domain_xyz", "workflow_xyz", []*nosqlplugin.HistoryMigrationTask{
+		{
+			Timer: &nosqlplugin.TimerTask{
+				RunID:               "rundid_1",
+				TaskID:              1,
+				TaskType:            persistence.TaskTypeDeleteHistoryEvent,
+				VisibilityTimestamp: ts,
+			},
+			Task: &persistence.DataBlob{
+				Data:     []byte("delete"),
+				Encoding: constants.EncodingTypeThriftRW,
+			},
+		},
+	}, FixedTime)
+	if err != nil {
+		t.Fatalf("createHousekeepingTasks failed: %v", err)
+	}
+
+	wantQueries := []string{
+		`INSERT INTO executions (shard_id, type, domain_id, workflow_id, run_id, timer, data, data_encoding, visibility_ts, task_id, created_time) ` +
+			`VALUES(1000, 12, 10000000-8000-f000-f000-000000000000, 20000000-8000-f000-f000-000000000000, 30000000-8000-f000-f000-000000000000, ` +
+			`{domain_id: domain_xyz, workflow_id: workflow_xyz, run_id: rundid_1, visibility_ts: 1702418921000, task_id: 1, type: 4, timeout_type: 0, event_id: 0, schedule_attempt: 0, version: 0}, ` +
+			`[100 101 108 101 116 101], thriftrw, 1702418921000, 1, 2025-01-06T15:00:00Z)`,
+	}
+	if diff := cmp.Diff(wantQueries, batch.queries); diff != "" {
+		t.Fatalf("Query mismatch (-want +got):\n%s", diff)
+	}
+}
+
 func TestReplicationTasks(t *testing.T) {
 	ts, err := time.Parse(time.RFC3339, "2023-12-12T22:08:41Z")
 	if err != nil {
@@ -109,6 +109,18 @@ func (db *ddb) RangeDeleteTimerTasks(ctx context.Context, shardID int, inclusive
 	panic("TODO")
 }
 
+func (db *ddb) SelectHousekeepingTasksOrderByVisibilityTime(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTime, exclusiveMaxTime time.Time) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
+	panic("TODO")
+}
+
+func (db *ddb) DeleteHousekeepingTask(ctx context.Context, shardID int, taskID int64, visibilityTimestamp time.Time) error {
+	panic("TODO")
+}
+
+func (db *ddb) RangeDeleteHousekeepingTasks(ctx context.Context, shardID int, inclusiveMinTime, exclusiveMaxTime time.Time) error {
+	panic("TODO")
+}
+
 func (db *ddb) SelectReplicationTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTaskID, exclusiveMaxTaskID int64) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
 	panic("TODO")
 }
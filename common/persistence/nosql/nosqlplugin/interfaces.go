@@ -480,6 +480,14 @@ type (
 		// delete a range of timer tasks
 		RangeDeleteTimerTasks(ctx context.Context, shardID int, inclusiveMinTime, exclusiveMaxTime time.Time) error
 
+		// housekeeping tasks share the layout of timer tasks but are stored separately from them
+		// within a shard, paging through housekeeping tasks order by taskID(ASC), filtered by visibilityTimestamp
+		SelectHousekeepingTasksOrderByVisibilityTime(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTime, exclusiveMaxTime time.Time) ([]*HistoryMigrationTask, []byte, error)
+		// delete a single housekeeping task
+		DeleteHousekeepingTask(ctx context.Context, shardID int, taskID int64, visibilityTimestamp time.Time) error
+		// delete a range of housekeeping tasks
+		RangeDeleteHousekeepingTasks(ctx context.Context, shardID int, inclusiveMinTime, exclusiveMaxTime time.Time) error
+
 		// replication_task table
 		// within a shard, paging through replication tasks order by taskID(ASC), filtered by minTaskID(inclusive) and maxTaskID(exclusive)
 		SelectReplicationTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTaskID, exclusiveMaxTaskID int64) ([]*HistoryMigrationTask, []byte, error)
@@ -233,6 +233,20 @@ func (mr *MockDBMockRecorder) DeleteFromHistoryTreeAndNode(ctx, treeFilter, node
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromHistoryTreeAndNode", reflect.TypeOf((*MockDB)(nil).DeleteFromHistoryTreeAndNode), ctx, treeFilter, nodeFilters)
 }
 
+// DeleteHousekeepingTask mocks base method.
+func (m *MockDB) DeleteHousekeepingTask(ctx context.Context, shardID int, taskID int64, visibilityTimestamp time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteHousekeepingTask", ctx, shardID, taskID, visibilityTimestamp)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteHousekeepingTask indicates an expected call of DeleteHousekeepingTask.
+func (mr *MockDBMockRecorder) DeleteHousekeepingTask(ctx, shardID, taskID, visibilityTimestamp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHousekeepingTask", reflect.TypeOf((*MockDB)(nil).DeleteHousekeepingTask), ctx, shardID, taskID, visibilityTimestamp)
+}
+
 // DeleteMessage mocks base method.
 func (m *MockDB) DeleteMessage(ctx context.Context, queueType persistence.QueueType, messageID int64) error {
 	m.ctrl.T.Helper()
@@ -671,6 +685,20 @@ func (mr *MockDBMockRecorder) PluginName() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PluginName", reflect.TypeOf((*MockDB)(nil).PluginName))
 }
 
+// RangeDeleteHousekeepingTasks mocks base method.
+func (m *MockDB) RangeDeleteHousekeepingTasks(ctx context.Context, shardID int, inclusiveMinTime, exclusiveMaxTime time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RangeDeleteHousekeepingTasks", ctx, shardID, inclusiveMinTime, exclusiveMaxTime)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RangeDeleteHousekeepingTasks indicates an expected call of RangeDeleteHousekeepingTasks.
+func (mr *MockDBMockRecorder) RangeDeleteHousekeepingTasks(ctx, shardID, inclusiveMinTime, exclusiveMaxTime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteHousekeepingTasks", reflect.TypeOf((*MockDB)(nil).RangeDeleteHousekeepingTasks), ctx, shardID, inclusiveMinTime, exclusiveMaxTime)
+}
+
 // RangeDeleteReplicationDLQTasks mocks base method.
 func (m *MockDB) RangeDeleteReplicationDLQTasks(ctx context.Context, shardID int, sourceCluster string, inclusiveBeginTaskID, exclusiveEndTaskID int64) error {
 	m.ctrl.T.Helper()
@@ -897,6 +925,22 @@ func (mr *MockDBMockRecorder) SelectFromHistoryTree(ctx, filter any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromHistoryTree", reflect.TypeOf((*MockDB)(nil).SelectFromHistoryTree), ctx, filter)
 }
 
+// SelectHousekeepingTasksOrderByVisibilityTime mocks base method.
+func (m *MockDB) SelectHousekeepingTasksOrderByVisibilityTime(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTime, exclusiveMaxTime time.Time) ([]*HistoryMigrationTask, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectHousekeepingTasksOrderByVisibilityTime", ctx, shardID, pageSize, pageToken, inclusiveMinTime, exclusiveMaxTime)
+	ret0, _ := ret[0].([]*HistoryMigrationTask)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SelectHousekeepingTasksOrderByVisibilityTime indicates an expected call of SelectHousekeepingTasksOrderByVisibilityTime.
+func (mr *MockDBMockRecorder) SelectHousekeepingTasksOrderByVisibilityTime(ctx, shardID, pageSize, pageToken, inclusiveMinTime, exclusiveMaxTime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectHousekeepingTasksOrderByVisibilityTime", reflect.TypeOf((*MockDB)(nil).SelectHousekeepingTasksOrderByVisibilityTime), ctx, shardID, pageSize, pageToken, inclusiveMinTime, exclusiveMaxTime)
+}
+
 // SelectLastEnqueuedMessageID mocks base method.
 func (m *MockDB) SelectLastEnqueuedMessageID(ctx context.Context, queueType persistence.QueueType) (int64, error) {
 	m.ctrl.T.Helper()
@@ -1348,6 +1392,20 @@ func (mr *MocktableCRUDMockRecorder) DeleteFromHistoryTreeAndNode(ctx, treeFilte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromHistoryTreeAndNode", reflect.TypeOf((*MocktableCRUD)(nil).DeleteFromHistoryTreeAndNode), ctx, treeFilter, nodeFilters)
 }
 
+// DeleteHousekeepingTask mocks base method.
+func (m *MocktableCRUD) DeleteHousekeepingTask(ctx context.Context, shardID int, taskID int64, visibilityTimestamp time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteHousekeepingTask", ctx, shardID, taskID, visibilityTimestamp)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteHousekeepingTask indicates an expected call of DeleteHousekeepingTask.
+func (mr *MocktableCRUDMockRecorder) DeleteHousekeepingTask(ctx, shardID, taskID, visibilityTimestamp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHousekeepingTask", reflect.TypeOf((*MocktableCRUD)(nil).DeleteHousekeepingTask), ctx, shardID, taskID, visibilityTimestamp)
+}
+
 // DeleteMessage mocks base method.
 func (m *MocktableCRUD) DeleteMessage(ctx context.Context, queueType persistence.QueueType, messageID int64) error {
 	m.ctrl.T.Helper()
@@ -1716,6 +1774,20 @@ func (mr *MocktableCRUDMockRecorder) ListTaskList(ctx, pageSize, nextPageToken a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskList", reflect.TypeOf((*MocktableCRUD)(nil).ListTaskList), ctx, pageSize, nextPageToken)
 }
 
+// RangeDeleteHousekeepingTasks mocks base method.
+func (m *MocktableCRUD) RangeDeleteHousekeepingTasks(ctx context.Context, shardID int, inclusiveMinTime, exclusiveMaxTime time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RangeDeleteHousekeepingTasks", ctx, shardID, inclusiveMinTime, exclusiveMaxTime)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RangeDeleteHousekeepingTasks indicates an expected call of RangeDeleteHousekeepingTasks.
+func (mr *MocktableCRUDMockRecorder) RangeDeleteHousekeepingTasks(ctx, shardID, inclusiveMinTime, exclusiveMaxTime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteHousekeepingTasks", reflect.TypeOf((*MocktableCRUD)(nil).RangeDeleteHousekeepingTasks), ctx, shardID, inclusiveMinTime, exclusiveMaxTime)
+}
+
 // RangeDeleteReplicationDLQTasks mocks base method.
 func (m *MocktableCRUD) RangeDeleteReplicationDLQTasks(ctx context.Context, shardID int, sourceCluster string, inclusiveBeginTaskID, exclusiveEndTaskID int64) error {
 	m.ctrl.T.Helper()
@@ -1942,6 +2014,22 @@ func (mr *MocktableCRUDMockRecorder) SelectFromHistoryTree(ctx, filter any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromHistoryTree", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromHistoryTree), ctx, filter)
 }
 
+// SelectHousekeepingTasksOrderByVisibilityTime mocks base method.
+func (m *MocktableCRUD) SelectHousekeepingTasksOrderByVisibilityTime(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTime, exclusiveMaxTime time.Time) ([]*HistoryMigrationTask, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectHousekeepingTasksOrderByVisibilityTime", ctx, shardID, pageSize, pageToken, inclusiveMinTime, exclusiveMaxTime)
+	ret0, _ := ret[0].([]*HistoryMigrationTask)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SelectHousekeepingTasksOrderByVisibilityTime indicates an expected call of SelectHousekeepingTasksOrderByVisibilityTime.
+func (mr *MocktableCRUDMockRecorder) SelectHousekeepingTasksOrderByVisibilityTime(ctx, shardID, pageSize, pageToken, inclusiveMinTime, exclusiveMaxTime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectHousekeepingTasksOrderByVisibilityTime", reflect.TypeOf((*MocktableCRUD)(nil).SelectHousekeepingTasksOrderByVisibilityTime), ctx, shardID, pageSize, pageToken, inclusiveMinTime, exclusiveMaxTime)
+}
+
 // SelectLastEnqueuedMessageID mocks base method.
 func (m *MocktableCRUD) SelectLastEnqueuedMessageID(ctx context.Context, queueType persistence.QueueType) (int64, error) {
 	m.ctrl.T.Helper()
@@ -3186,6 +3274,20 @@ func (mr *MockWorkflowCRUDMockRecorder) DeleteCurrentWorkflow(ctx, shardID, doma
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCurrentWorkflow", reflect.TypeOf((*MockWorkflowCRUD)(nil).DeleteCurrentWorkflow), ctx, shardID, domainID, workflowID, currentRunIDCondition)
 }
 
+// DeleteHousekeepingTask mocks base method.
+func (m *MockWorkflowCRUD) DeleteHousekeepingTask(ctx context.Context, shardID int, taskID int64, visibilityTimestamp time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteHousekeepingTask", ctx, shardID, taskID, visibilityTimestamp)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteHousekeepingTask indicates an expected call of DeleteHousekeepingTask.
+func (mr *MockWorkflowCRUDMockRecorder) DeleteHousekeepingTask(ctx, shardID, taskID, visibilityTimestamp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHousekeepingTask", reflect.TypeOf((*MockWorkflowCRUD)(nil).DeleteHousekeepingTask), ctx, shardID, taskID, visibilityTimestamp)
+}
+
 // DeleteReplicationDLQTask mocks base method.
 func (m *MockWorkflowCRUD) DeleteReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, taskID int64) error {
 	m.ctrl.T.Helper()
@@ -3313,6 +3415,20 @@ func (mr *MockWorkflowCRUDMockRecorder) IsWorkflowExecutionExists(ctx, shardID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkflowExecutionExists", reflect.TypeOf((*MockWorkflowCRUD)(nil).IsWorkflowExecutionExists), ctx, shardID, domainID, workflowID, runID)
 }
 
+// RangeDeleteHousekeepingTasks mocks base method.
+func (m *MockWorkflowCRUD) RangeDeleteHousekeepingTasks(ctx context.Context, shardID int, inclusiveMinTime, exclusiveMaxTime time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RangeDeleteHousekeepingTasks", ctx, shardID, inclusiveMinTime, exclusiveMaxTime)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RangeDeleteHousekeepingTasks indicates an expected call of RangeDeleteHousekeepingTasks.
+func (mr *MockWorkflowCRUDMockRecorder) RangeDeleteHousekeepingTasks(ctx, shardID, inclusiveMinTime, exclusiveMaxTime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteHousekeepingTasks", reflect.TypeOf((*MockWorkflowCRUD)(nil).RangeDeleteHousekeepingTasks), ctx, shardID, inclusiveMinTime, exclusiveMaxTime)
+}
+
 // RangeDeleteReplicationDLQTasks mocks base method.
 func (m *MockWorkflowCRUD) RangeDeleteReplicationDLQTasks(ctx context.Context, shardID int, sourceCluster string, inclusiveBeginTaskID, exclusiveEndTaskID int64) error {
 	m.ctrl.T.Helper()
@@ -3431,6 +3547,22 @@ func (mr *MockWorkflowCRUDMockRecorder) SelectCurrentWorkflow(ctx, shardID, doma
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectCurrentWorkflow", reflect.TypeOf((*MockWorkflowCRUD)(nil).SelectCurrentWorkflow), ctx, shardID, domainID, workflowID)
 }
 
+// SelectHousekeepingTasksOrderByVisibilityTime mocks base method.
+func (m *MockWorkflowCRUD) SelectHousekeepingTasksOrderByVisibilityTime(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTime, exclusiveMaxTime time.Time) ([]*HistoryMigrationTask, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectHousekeepingTasksOrderByVisibilityTime", ctx, shardID, pageSize, pageToken, inclusiveMinTime, exclusiveMaxTime)
+	ret0, _ := ret[0].([]*HistoryMigrationTask)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SelectHousekeepingTasksOrderByVisibilityTime indicates an expected call of SelectHousekeepingTasksOrderByVisibilityTime.
+func (mr *MockWorkflowCRUDMockRecorder) SelectHousekeepingTasksOrderByVisibilityTime(ctx, shardID, pageSize, pageToken, inclusiveMinTime, exclusiveMaxTime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectHousekeepingTasksOrderByVisibilityTime", reflect.TypeOf((*MockWorkflowCRUD)(nil).SelectHousekeepingTasksOrderByVisibilityTime), ctx, shardID, pageSize, pageToken, inclusiveMinTime, exclusiveMaxTime)
+}
+
 // SelectReplicationDLQTasksCount mocks base method.
 func (m *MockWorkflowCRUD) SelectReplicationDLQTasksCount(ctx context.Context, shardID int, sourceCluster string) (int64, error) {
 	m.ctrl.T.Helper()
@@ -109,6 +109,18 @@ func (db *mdb) RangeDeleteTimerTasks(ctx context.Context, shardID int, inclusive
 	panic("TODO")
 }
 
+func (db *mdb) SelectHousekeepingTasksOrderByVisibilityTime(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTime, exclusiveMaxTime time.Time) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
+	panic("TODO")
+}
+
+func (db *mdb) DeleteHousekeepingTask(ctx context.Context, shardID int, taskID int64, visibilityTimestamp time.Time) error {
+	panic("TODO")
+}
+
+func (db *mdb) RangeDeleteHousekeepingTasks(ctx context.Context, shardID int, inclusiveMinTime, exclusiveMaxTime time.Time) error {
+	panic("TODO")
+}
+
 func (db *mdb) SelectReplicationTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTaskID, exclusiveMaxTaskID int64) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
 	panic("TODO")
 }
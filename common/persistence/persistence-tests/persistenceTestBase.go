@@ -1115,6 +1115,7 @@ func (s *TestBase) UpdateWorkflowExecutionWithReplication(
 			*persistence.ActivityTask,
 			*persistence.CloseExecutionTask,
 			*persistence.RecordWorkflowClosedTask,
+			*persistence.CompletionCallbackTask,
 			*persistence.RecordChildExecutionCompletedTask,
 			*persistence.CancelExecutionTask,
 			*persistence.StartChildExecutionTask,
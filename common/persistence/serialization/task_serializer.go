@@ -54,7 +54,7 @@ func (s *taskSerializerImpl) SerializeTask(category persistence.HistoryTaskCateg
 	switch category.ID() {
 	case persistence.HistoryTaskCategoryIDTransfer:
 		return s.serializeTransferTask(task)
-	case persistence.HistoryTaskCategoryIDTimer:
+	case persistence.HistoryTaskCategoryIDTimer, persistence.HistoryTaskCategoryIDHousekeeping:
 		return s.serializeTimerTask(task)
 	case persistence.HistoryTaskCategoryIDReplication:
 		return s.serializeReplicationTask(task)
@@ -67,7 +67,7 @@ func (s *taskSerializerImpl) DeserializeTask(category persistence.HistoryTaskCat
 	switch category.ID() {
 	case persistence.HistoryTaskCategoryIDTransfer:
 		return s.deserializeTransferTask(blob)
-	case persistence.HistoryTaskCategoryIDTimer:
+	case persistence.HistoryTaskCategoryIDTimer, persistence.HistoryTaskCategoryIDHousekeeping:
 		return s.deserializeTimerTask(blob)
 	case persistence.HistoryTaskCategoryIDReplication:
 		return s.deserializeReplicationTask(blob)
@@ -148,6 +148,10 @@ func (s *taskSerializerImpl) serializeTransferTask(task persistence.Task) (persi
 		info.DomainID = MustParseUUID(t.DomainID)
 		info.WorkflowID = t.WorkflowID
 		info.RunID = MustParseUUID(t.RunID)
+	case *persistence.CompletionCallbackTask:
+		info.DomainID = MustParseUUID(t.DomainID)
+		info.WorkflowID = t.WorkflowID
+		info.RunID = MustParseUUID(t.RunID)
 	case *persistence.ResetWorkflowTask:
 		info.DomainID = MustParseUUID(t.DomainID)
 		info.WorkflowID = t.WorkflowID
@@ -249,6 +253,11 @@ func (s *taskSerializerImpl) deserializeTransferTask(blob *persistence.DataBlob)
 			WorkflowIdentifier: workflowIdentifier,
 			TaskData:           taskData,
 		}
+	case persistence.TransferTaskTypeCompletionCallback:
+		task = &persistence.CompletionCallbackTask{
+			WorkflowIdentifier: workflowIdentifier,
+			TaskData:           taskData,
+		}
 	case persistence.TransferTaskTypeRecordChildExecutionCompleted:
 		task = &persistence.RecordChildExecutionCompletedTask{
 			WorkflowIdentifier: workflowIdentifier,
@@ -300,6 +309,11 @@ func (s *taskSerializerImpl) serializeTimerTask(task persistence.Task) (persiste
 		info.WorkflowID = t.WorkflowID
 		info.RunID = MustParseUUID(t.RunID)
 		info.TimeoutType = common.Int16Ptr(int16(t.TimeoutType))
+	case *persistence.DelayedSignalTimerTask:
+		info.DomainID = MustParseUUID(t.DomainID)
+		info.WorkflowID = t.WorkflowID
+		info.RunID = MustParseUUID(t.RunID)
+		info.EventID = t.DelayedSignalID
 	case *persistence.WorkflowTimeoutTask:
 		info.DomainID = MustParseUUID(t.DomainID)
 		info.WorkflowID = t.WorkflowID
@@ -376,6 +390,12 @@ func (s *taskSerializerImpl) deserializeTimerTask(blob *persistence.DataBlob) (p
 			TaskData:           taskData,
 			TimeoutType:        int(info.GetTimeoutType()),
 		}
+	case persistence.TaskTypeDelayedSignalTimer:
+		task = &persistence.DelayedSignalTimerTask{
+			WorkflowIdentifier: workflowIdentifier,
+			TaskData:           taskData,
+			DelayedSignalID:    info.GetEventID(),
+		}
 	default:
 		return nil, fmt.Errorf("unknown timer task type: %v", info.GetTaskType())
 	}
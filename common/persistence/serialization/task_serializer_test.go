@@ -181,6 +181,17 @@ func TestTaskSerializerThriftRW(t *testing.T) {
 				},
 			},
 		},
+		{
+			category: persistence.HistoryTaskCategoryTransfer,
+			task: &persistence.CompletionCallbackTask{
+				WorkflowIdentifier: workflowIdentifier,
+				TaskData: persistence.TaskData{
+					Version:             9,
+					TaskID:              9,
+					VisibilityTimestamp: time.Unix(9, 9),
+				},
+			},
+		},
 		{
 			category: persistence.HistoryTaskCategoryTransfer,
 			task: &persistence.RecordChildExecutionCompletedTask{
@@ -257,6 +268,17 @@ func TestTaskSerializerThriftRW(t *testing.T) {
 				},
 			},
 		},
+		{
+			category: persistence.HistoryTaskCategoryHousekeeping,
+			task: &persistence.DeleteHistoryEventTask{
+				WorkflowIdentifier: workflowIdentifier,
+				TaskData: persistence.TaskData{
+					Version:             15,
+					TaskID:              15,
+					VisibilityTimestamp: time.Unix(15, 15),
+				},
+			},
+		},
 		{
 			category: persistence.HistoryTaskCategoryTimer,
 			task: &persistence.ActivityRetryTimerTask{
@@ -282,6 +304,18 @@ func TestTaskSerializerThriftRW(t *testing.T) {
 				TimeoutType: 17,
 			},
 		},
+		{
+			category: persistence.HistoryTaskCategoryTimer,
+			task: &persistence.DelayedSignalTimerTask{
+				WorkflowIdentifier: workflowIdentifier,
+				TaskData: persistence.TaskData{
+					Version:             17,
+					TaskID:              17,
+					VisibilityTimestamp: time.Unix(17, 17),
+				},
+				DelayedSignalID: 17,
+			},
+		},
 		{
 			category: persistence.HistoryTaskCategoryReplication,
 			task: &persistence.HistoryReplicationTask{
@@ -1228,7 +1228,11 @@ func (m *sqlExecutionStore) getScheduledHistoryTasks(
 	request *p.GetHistoryTasksRequest,
 ) (*p.GetHistoryTasksResponse, error) {
 	switch request.TaskCategory.ID() {
-	case p.HistoryTaskCategoryIDTimer:
+	case p.HistoryTaskCategoryIDTimer, p.HistoryTaskCategoryIDHousekeeping:
+		selectTasks := m.db.SelectFromTimerTasks
+		if request.TaskCategory.ID() == p.HistoryTaskCategoryIDHousekeeping {
+			selectTasks = m.db.SelectFromHousekeepingTasks
+		}
 		pageToken := &timerTaskPageToken{TaskID: math.MinInt64, Timestamp: request.InclusiveMinTaskKey.GetScheduledTime()}
 		if len(request.NextPageToken) > 0 {
 			if err := pageToken.deserialize(request.NextPageToken); err != nil {
@@ -1237,7 +1241,7 @@ func (m *sqlExecutionStore) getScheduledHistoryTasks(
 				}
 			}
 		}
-		rows, err := m.db.SelectFromTimerTasks(ctx, &sqlplugin.TimerTasksFilter{
+		rows, err := selectTasks(ctx, &sqlplugin.TimerTasksFilter{
 			ShardID:                m.shardID,
 			MinVisibilityTimestamp: pageToken.Timestamp,
 			TaskID:                 pageToken.TaskID,
@@ -1299,8 +1303,12 @@ func (m *sqlExecutionStore) completeScheduledHistoryTask(
 	request *p.CompleteHistoryTaskRequest,
 ) error {
 	switch request.TaskCategory.ID() {
-	case p.HistoryTaskCategoryIDTimer:
-		if _, err := m.db.DeleteFromTimerTasks(ctx, &sqlplugin.TimerTasksFilter{
+	case p.HistoryTaskCategoryIDTimer, p.HistoryTaskCategoryIDHousekeeping:
+		deleteTask := m.db.DeleteFromTimerTasks
+		if request.TaskCategory.ID() == p.HistoryTaskCategoryIDHousekeeping {
+			deleteTask = m.db.DeleteFromHousekeepingTasks
+		}
+		if _, err := deleteTask(ctx, &sqlplugin.TimerTasksFilter{
 			ShardID:             m.shardID,
 			VisibilityTimestamp: request.TaskKey.GetScheduledTime(),
 			TaskID:              request.TaskKey.GetTaskID(),
@@ -1358,8 +1366,12 @@ func (m *sqlExecutionStore) rangeCompleteScheduledHistoryTask(
 	request *p.RangeCompleteHistoryTaskRequest,
 ) (*p.RangeCompleteHistoryTaskResponse, error) {
 	switch request.TaskCategory.ID() {
-	case p.HistoryTaskCategoryIDTimer:
-		result, err := m.db.RangeDeleteFromTimerTasks(ctx, &sqlplugin.TimerTasksFilter{
+	case p.HistoryTaskCategoryIDTimer, p.HistoryTaskCategoryIDHousekeeping:
+		rangeDeleteTasks := m.db.RangeDeleteFromTimerTasks
+		if request.TaskCategory.ID() == p.HistoryTaskCategoryIDHousekeeping {
+			rangeDeleteTasks = m.db.RangeDeleteFromHousekeepingTasks
+		}
+		result, err := rangeDeleteTasks(ctx, &sqlplugin.TimerTasksFilter{
 			ShardID:                m.shardID,
 			MinVisibilityTimestamp: request.InclusiveMinTaskKey.GetScheduledTime(),
 			MaxVisibilityTimestamp: request.ExclusiveMaxTaskKey.GetScheduledTime(),
@@ -24,6 +24,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"math"
 	"testing"
 	"time"
 
@@ -2633,6 +2634,24 @@ func TestRangeCompleteHistoryTask(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		{
+			name: "success - scheduled housekeeping task",
+			request: &persistence.RangeCompleteHistoryTaskRequest{
+				TaskCategory:        persistence.HistoryTaskCategoryHousekeeping,
+				InclusiveMinTaskKey: persistence.NewHistoryTaskKey(time.Unix(0, 0), 0),
+				ExclusiveMaxTaskKey: persistence.NewHistoryTaskKey(time.Unix(0, 0).Add(time.Minute), 0),
+				PageSize:            1000,
+			},
+			setupMock: func(mockDB *sqlplugin.MockDB) {
+				mockDB.EXPECT().RangeDeleteFromHousekeepingTasks(ctx, &sqlplugin.TimerTasksFilter{
+					ShardID:                shardID,
+					MinVisibilityTimestamp: time.Unix(0, 0),
+					MaxVisibilityTimestamp: time.Unix(0, 0).Add(time.Minute),
+					PageSize:               1000,
+				}).Return(&sqlResult{rowsAffected: 1}, nil)
+			},
+			expectedError: nil,
+		},
 		{
 			name: "success - immediate transfer task",
 			request: &persistence.RangeCompleteHistoryTaskRequest{
@@ -2922,6 +2941,42 @@ func TestGetHistoryTasks_SQL(t *testing.T) {
 				return token
 			}(),
 		},
+		{
+			name: "success - get scheduled housekeeping tasks",
+			request: &persistence.GetHistoryTasksRequest{
+				TaskCategory:        persistence.HistoryTaskCategoryHousekeeping,
+				InclusiveMinTaskKey: persistence.NewHistoryTaskKey(time.Unix(0, 0).UTC(), 0),
+				ExclusiveMaxTaskKey: persistence.NewHistoryTaskKey(time.Unix(0, 0).Add(time.Minute).UTC(), 0),
+				PageSize:            10,
+			},
+			setupMock: func(mockDB *sqlplugin.MockDB, mockTaskSerializer *serialization.MockTaskSerializer) {
+				mockDB.EXPECT().SelectFromHousekeepingTasks(ctx, &sqlplugin.TimerTasksFilter{
+					ShardID:                shardID,
+					MinVisibilityTimestamp: time.Unix(0, 0).UTC(),
+					TaskID:                 math.MinInt64,
+					MaxVisibilityTimestamp: time.Unix(0, 0).Add(time.Minute).UTC(),
+					PageSize:               11,
+				}).Return([]sqlplugin.TimerTasksRow{
+					{
+						ShardID:             shardID,
+						TaskID:              10,
+						VisibilityTimestamp: time.Unix(1, 1),
+						Data:                []byte(`{"task": "housekeeping"}`),
+						DataEncoding:        "json",
+					},
+				}, nil)
+				mockTaskSerializer.EXPECT().DeserializeTask(persistence.HistoryTaskCategoryHousekeeping, persistence.NewDataBlob([]byte(`{"task": "housekeeping"}`), constants.EncodingTypeJSON)).Return(&persistence.DeleteHistoryEventTask{}, nil)
+			},
+			expectedError: nil,
+			expectedTasks: []persistence.Task{
+				&persistence.DeleteHistoryEventTask{
+					TaskData: persistence.TaskData{
+						TaskID:              10,
+						VisibilityTimestamp: time.Unix(1, 1),
+					},
+				},
+			},
+		},
 		{
 			name: "success - get immediate replication tasks",
 			request: &persistence.GetHistoryTasksRequest{
@@ -3057,6 +3112,22 @@ func TestCompleteHistoryTask(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		{
+			name: "success - complete scheduled housekeeping task",
+			request: &persistence.CompleteHistoryTaskRequest{
+				TaskCategory: persistence.HistoryTaskCategoryHousekeeping,
+				TaskKey:      persistence.NewHistoryTaskKey(time.Unix(10, 10), 1),
+			},
+			setupMock: func(mockDB any) {
+				mock := mockDB.(*sqlplugin.MockDB)
+				mock.EXPECT().DeleteFromHousekeepingTasks(ctx, &sqlplugin.TimerTasksFilter{
+					ShardID:             shardID,
+					VisibilityTimestamp: time.Unix(10, 10),
+					TaskID:              1,
+				}).Return(&sqlResult{rowsAffected: 1}, nil)
+			},
+			expectedError: nil,
+		},
 		{
 			name: "success - complete immediate transfer task",
 			request: &persistence.CompleteHistoryTaskRequest{
@@ -715,6 +715,8 @@ func createScheduledTasks(
 	switch categoryID {
 	case p.HistoryTaskCategoryIDTimer:
 		return createTimerTasks(ctx, tx, tasks, shardID, taskSerializer)
+	case p.HistoryTaskCategoryIDHousekeeping:
+		return createHousekeepingTasks(ctx, tx, tasks, shardID, taskSerializer)
 	}
 	// TODO: implement creating tasks for other categories
 	return nil
@@ -856,6 +858,52 @@ func createTimerTasks(
 	return nil
 }
 
+func createHousekeepingTasks(
+	ctx context.Context,
+	tx sqlplugin.Tx,
+	housekeepingTasks []p.Task,
+	shardID int,
+	taskSerializer serialization.TaskSerializer,
+) error {
+
+	if len(housekeepingTasks) == 0 {
+		return nil
+	}
+
+	housekeepingTasksRows := make([]sqlplugin.TimerTasksRow, len(housekeepingTasks))
+
+	for i, task := range housekeepingTasks {
+		blob, err := taskSerializer.SerializeTask(p.HistoryTaskCategoryHousekeeping, task)
+		if err != nil {
+			return err
+		}
+		housekeepingTasksRows[i].ShardID = shardID
+		housekeepingTasksRows[i].VisibilityTimestamp = task.GetVisibilityTimestamp()
+		housekeepingTasksRows[i].TaskID = task.GetTaskID()
+		housekeepingTasksRows[i].Data = blob.Data
+		housekeepingTasksRows[i].DataEncoding = string(blob.Encoding)
+	}
+
+	result, err := tx.InsertIntoHousekeepingTasks(ctx, housekeepingTasksRows)
+	if err != nil {
+		return convertCommonErrors(tx, "createHousekeepingTasks", "", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return &types.InternalServiceError{
+			Message: fmt.Sprintf("createHousekeepingTasks failed. Could not verify number of rows inserted. Error: %v", err),
+		}
+	}
+
+	if int(rowsAffected) != len(housekeepingTasks) {
+		return &types.InternalServiceError{
+			Message: fmt.Sprintf("createHousekeepingTasks failed. Inserted %v instead of %v rows into housekeeping_tasks.", rowsAffected, len(housekeepingTasks)),
+		}
+	}
+
+	return nil
+}
+
 func assertNotCurrentExecution(
 	ctx context.Context,
 	tx sqlplugin.Tx,
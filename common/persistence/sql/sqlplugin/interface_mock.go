@@ -234,6 +234,21 @@ func (mr *MocktableCRUDMockRecorder) DeleteFromHistoryTree(ctx, filter any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromHistoryTree", reflect.TypeOf((*MocktableCRUD)(nil).DeleteFromHistoryTree), ctx, filter)
 }
 
+// DeleteFromHousekeepingTasks mocks base method.
+func (m *MocktableCRUD) DeleteFromHousekeepingTasks(ctx context.Context, filter *TimerTasksFilter) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFromHousekeepingTasks", ctx, filter)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFromHousekeepingTasks indicates an expected call of DeleteFromHousekeepingTasks.
+func (mr *MocktableCRUDMockRecorder) DeleteFromHousekeepingTasks(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromHousekeepingTasks", reflect.TypeOf((*MocktableCRUD)(nil).DeleteFromHousekeepingTasks), ctx, filter)
+}
+
 // DeleteFromReplicationTasks mocks base method.
 func (m *MocktableCRUD) DeleteFromReplicationTasks(ctx context.Context, filter *ReplicationTasksFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -682,6 +697,21 @@ func (mr *MocktableCRUDMockRecorder) InsertIntoHistoryTree(ctx, row any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoHistoryTree", reflect.TypeOf((*MocktableCRUD)(nil).InsertIntoHistoryTree), ctx, row)
 }
 
+// InsertIntoHousekeepingTasks mocks base method.
+func (m *MocktableCRUD) InsertIntoHousekeepingTasks(ctx context.Context, rows []TimerTasksRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoHousekeepingTasks", ctx, rows)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoHousekeepingTasks indicates an expected call of InsertIntoHousekeepingTasks.
+func (mr *MocktableCRUDMockRecorder) InsertIntoHousekeepingTasks(ctx, rows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoHousekeepingTasks", reflect.TypeOf((*MocktableCRUD)(nil).InsertIntoHousekeepingTasks), ctx, rows)
+}
+
 // InsertIntoQueue mocks base method.
 func (m *MocktableCRUD) InsertIntoQueue(ctx context.Context, row *QueueRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -951,6 +981,21 @@ func (mr *MocktableCRUDMockRecorder) RangeDeleteFromCrossClusterTasks(ctx, filte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteFromCrossClusterTasks", reflect.TypeOf((*MocktableCRUD)(nil).RangeDeleteFromCrossClusterTasks), ctx, filter)
 }
 
+// RangeDeleteFromHousekeepingTasks mocks base method.
+func (m *MocktableCRUD) RangeDeleteFromHousekeepingTasks(ctx context.Context, filter *TimerTasksFilter) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RangeDeleteFromHousekeepingTasks", ctx, filter)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RangeDeleteFromHousekeepingTasks indicates an expected call of RangeDeleteFromHousekeepingTasks.
+func (mr *MocktableCRUDMockRecorder) RangeDeleteFromHousekeepingTasks(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteFromHousekeepingTasks", reflect.TypeOf((*MocktableCRUD)(nil).RangeDeleteFromHousekeepingTasks), ctx, filter)
+}
+
 // RangeDeleteFromReplicationTasks mocks base method.
 func (m *MocktableCRUD) RangeDeleteFromReplicationTasks(ctx context.Context, filter *ReplicationTasksFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -1296,6 +1341,21 @@ func (mr *MocktableCRUDMockRecorder) SelectFromHistoryTree(ctx, filter any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromHistoryTree", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromHistoryTree), ctx, filter)
 }
 
+// SelectFromHousekeepingTasks mocks base method.
+func (m *MocktableCRUD) SelectFromHousekeepingTasks(ctx context.Context, filter *TimerTasksFilter) ([]TimerTasksRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromHousekeepingTasks", ctx, filter)
+	ret0, _ := ret[0].([]TimerTasksRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromHousekeepingTasks indicates an expected call of SelectFromHousekeepingTasks.
+func (mr *MocktableCRUDMockRecorder) SelectFromHousekeepingTasks(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromHousekeepingTasks", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromHousekeepingTasks), ctx, filter)
+}
+
 // SelectFromReplicationDLQ mocks base method.
 func (m *MocktableCRUD) SelectFromReplicationDLQ(ctx context.Context, filter *ReplicationTaskDLQFilter) (int64, error) {
 	m.ctrl.T.Helper()
@@ -2027,6 +2087,21 @@ func (mr *MockTxMockRecorder) DeleteFromHistoryTree(ctx, filter any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromHistoryTree", reflect.TypeOf((*MockTx)(nil).DeleteFromHistoryTree), ctx, filter)
 }
 
+// DeleteFromHousekeepingTasks mocks base method.
+func (m *MockTx) DeleteFromHousekeepingTasks(ctx context.Context, filter *TimerTasksFilter) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFromHousekeepingTasks", ctx, filter)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFromHousekeepingTasks indicates an expected call of DeleteFromHousekeepingTasks.
+func (mr *MockTxMockRecorder) DeleteFromHousekeepingTasks(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromHousekeepingTasks", reflect.TypeOf((*MockTx)(nil).DeleteFromHousekeepingTasks), ctx, filter)
+}
+
 // DeleteFromReplicationTasks mocks base method.
 func (m *MockTx) DeleteFromReplicationTasks(ctx context.Context, filter *ReplicationTasksFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -2475,6 +2550,21 @@ func (mr *MockTxMockRecorder) InsertIntoHistoryTree(ctx, row any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoHistoryTree", reflect.TypeOf((*MockTx)(nil).InsertIntoHistoryTree), ctx, row)
 }
 
+// InsertIntoHousekeepingTasks mocks base method.
+func (m *MockTx) InsertIntoHousekeepingTasks(ctx context.Context, rows []TimerTasksRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoHousekeepingTasks", ctx, rows)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoHousekeepingTasks indicates an expected call of InsertIntoHousekeepingTasks.
+func (mr *MockTxMockRecorder) InsertIntoHousekeepingTasks(ctx, rows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoHousekeepingTasks", reflect.TypeOf((*MockTx)(nil).InsertIntoHousekeepingTasks), ctx, rows)
+}
+
 // InsertIntoQueue mocks base method.
 func (m *MockTx) InsertIntoQueue(ctx context.Context, row *QueueRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -2800,6 +2890,21 @@ func (mr *MockTxMockRecorder) RangeDeleteFromCrossClusterTasks(ctx, filter any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteFromCrossClusterTasks", reflect.TypeOf((*MockTx)(nil).RangeDeleteFromCrossClusterTasks), ctx, filter)
 }
 
+// RangeDeleteFromHousekeepingTasks mocks base method.
+func (m *MockTx) RangeDeleteFromHousekeepingTasks(ctx context.Context, filter *TimerTasksFilter) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RangeDeleteFromHousekeepingTasks", ctx, filter)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RangeDeleteFromHousekeepingTasks indicates an expected call of RangeDeleteFromHousekeepingTasks.
+func (mr *MockTxMockRecorder) RangeDeleteFromHousekeepingTasks(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteFromHousekeepingTasks", reflect.TypeOf((*MockTx)(nil).RangeDeleteFromHousekeepingTasks), ctx, filter)
+}
+
 // RangeDeleteFromReplicationTasks mocks base method.
 func (m *MockTx) RangeDeleteFromReplicationTasks(ctx context.Context, filter *ReplicationTasksFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -3159,6 +3264,21 @@ func (mr *MockTxMockRecorder) SelectFromHistoryTree(ctx, filter any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromHistoryTree", reflect.TypeOf((*MockTx)(nil).SelectFromHistoryTree), ctx, filter)
 }
 
+// SelectFromHousekeepingTasks mocks base method.
+func (m *MockTx) SelectFromHousekeepingTasks(ctx context.Context, filter *TimerTasksFilter) ([]TimerTasksRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromHousekeepingTasks", ctx, filter)
+	ret0, _ := ret[0].([]TimerTasksRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromHousekeepingTasks indicates an expected call of SelectFromHousekeepingTasks.
+func (mr *MockTxMockRecorder) SelectFromHousekeepingTasks(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromHousekeepingTasks", reflect.TypeOf((*MockTx)(nil).SelectFromHousekeepingTasks), ctx, filter)
+}
+
 // SelectFromReplicationDLQ mocks base method.
 func (m *MockTx) SelectFromReplicationDLQ(ctx context.Context, filter *ReplicationTaskDLQFilter) (int64, error) {
 	m.ctrl.T.Helper()
@@ -3734,6 +3854,21 @@ func (mr *MockDBMockRecorder) DeleteFromHistoryTree(ctx, filter any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromHistoryTree", reflect.TypeOf((*MockDB)(nil).DeleteFromHistoryTree), ctx, filter)
 }
 
+// DeleteFromHousekeepingTasks mocks base method.
+func (m *MockDB) DeleteFromHousekeepingTasks(ctx context.Context, filter *TimerTasksFilter) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFromHousekeepingTasks", ctx, filter)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFromHousekeepingTasks indicates an expected call of DeleteFromHousekeepingTasks.
+func (mr *MockDBMockRecorder) DeleteFromHousekeepingTasks(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromHousekeepingTasks", reflect.TypeOf((*MockDB)(nil).DeleteFromHousekeepingTasks), ctx, filter)
+}
+
 // DeleteFromReplicationTasks mocks base method.
 func (m *MockDB) DeleteFromReplicationTasks(ctx context.Context, filter *ReplicationTasksFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -4196,6 +4331,21 @@ func (mr *MockDBMockRecorder) InsertIntoHistoryTree(ctx, row any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoHistoryTree", reflect.TypeOf((*MockDB)(nil).InsertIntoHistoryTree), ctx, row)
 }
 
+// InsertIntoHousekeepingTasks mocks base method.
+func (m *MockDB) InsertIntoHousekeepingTasks(ctx context.Context, rows []TimerTasksRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoHousekeepingTasks", ctx, rows)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoHousekeepingTasks indicates an expected call of InsertIntoHousekeepingTasks.
+func (mr *MockDBMockRecorder) InsertIntoHousekeepingTasks(ctx, rows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoHousekeepingTasks", reflect.TypeOf((*MockDB)(nil).InsertIntoHousekeepingTasks), ctx, rows)
+}
+
 // InsertIntoQueue mocks base method.
 func (m *MockDB) InsertIntoQueue(ctx context.Context, row *QueueRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -4535,6 +4685,21 @@ func (mr *MockDBMockRecorder) RangeDeleteFromCrossClusterTasks(ctx, filter any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteFromCrossClusterTasks", reflect.TypeOf((*MockDB)(nil).RangeDeleteFromCrossClusterTasks), ctx, filter)
 }
 
+// RangeDeleteFromHousekeepingTasks mocks base method.
+func (m *MockDB) RangeDeleteFromHousekeepingTasks(ctx context.Context, filter *TimerTasksFilter) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RangeDeleteFromHousekeepingTasks", ctx, filter)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RangeDeleteFromHousekeepingTasks indicates an expected call of RangeDeleteFromHousekeepingTasks.
+func (mr *MockDBMockRecorder) RangeDeleteFromHousekeepingTasks(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteFromHousekeepingTasks", reflect.TypeOf((*MockDB)(nil).RangeDeleteFromHousekeepingTasks), ctx, filter)
+}
+
 // RangeDeleteFromReplicationTasks mocks base method.
 func (m *MockDB) RangeDeleteFromReplicationTasks(ctx context.Context, filter *ReplicationTasksFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -4880,6 +5045,21 @@ func (mr *MockDBMockRecorder) SelectFromHistoryTree(ctx, filter any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromHistoryTree", reflect.TypeOf((*MockDB)(nil).SelectFromHistoryTree), ctx, filter)
 }
 
+// SelectFromHousekeepingTasks mocks base method.
+func (m *MockDB) SelectFromHousekeepingTasks(ctx context.Context, filter *TimerTasksFilter) ([]TimerTasksRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromHousekeepingTasks", ctx, filter)
+	ret0, _ := ret[0].([]TimerTasksRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromHousekeepingTasks indicates an expected call of SelectFromHousekeepingTasks.
+func (mr *MockDBMockRecorder) SelectFromHousekeepingTasks(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromHousekeepingTasks", reflect.TypeOf((*MockDB)(nil).SelectFromHousekeepingTasks), ctx, filter)
+}
+
 // SelectFromReplicationDLQ mocks base method.
 func (m *MockDB) SelectFromReplicationDLQ(ctx context.Context, filter *ReplicationTaskDLQFilter) (int64, error) {
 	m.ctrl.T.Helper()
@@ -694,6 +694,18 @@ type (
 		// Required filter Params: {shardID, minVisibilityTimestamp, maxVisibilityTimestamp}
 		RangeDeleteFromTimerTasks(ctx context.Context, filter *TimerTasksFilter) (sql.Result, error)
 
+		// housekeeping_tasks shares the layout of timer_tasks, so the timer row and filter types are reused
+		InsertIntoHousekeepingTasks(ctx context.Context, rows []TimerTasksRow) (sql.Result, error)
+		// SelectFromHousekeepingTasks returns one or more rows from housekeeping_tasks table
+		// Required filter Params - {shardID, taskID, minVisibilityTimestamp, maxVisibilityTimestamp, pageSize}
+		SelectFromHousekeepingTasks(ctx context.Context, filter *TimerTasksFilter) ([]TimerTasksRow, error)
+		// DeleteFromHousekeepingTasks deletes one row from housekeeping_tasks table
+		// Required filter Params: {shardID, visibilityTimestamp, taskID}
+		DeleteFromHousekeepingTasks(ctx context.Context, filter *TimerTasksFilter) (sql.Result, error)
+		// RangeDeleteFromHousekeepingTasks deletes one or more rows from housekeeping_tasks table
+		// Required filter Params: {shardID, minVisibilityTimestamp, maxVisibilityTimestamp}
+		RangeDeleteFromHousekeepingTasks(ctx context.Context, filter *TimerTasksFilter) (sql.Result, error)
+
 		InsertIntoBufferedEvents(ctx context.Context, rows []BufferedEventsRow) (sql.Result, error)
 		SelectFromBufferedEvents(ctx context.Context, filter *BufferedEventsFilter) ([]BufferedEventsRow, error)
 		DeleteFromBufferedEvents(ctx context.Context, filter *BufferedEventsFilter) (sql.Result, error)
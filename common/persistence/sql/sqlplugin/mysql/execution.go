@@ -116,6 +116,19 @@ VALUES(:target_cluster, :shard_id, :task_id, :data, :data_encoding)`
 	rangeDeleteTimerTaskQuery        = `DELETE FROM timer_tasks WHERE shard_id = ? AND visibility_timestamp >= ? AND visibility_timestamp < ?`
 	rangeDeleteTimerTaskByBatchQuery = rangeDeleteTimerTaskQuery + ` ORDER BY visibility_timestamp,task_id LIMIT ?`
 
+	createHousekeepingTasksQuery = `INSERT INTO housekeeping_tasks (shard_id, visibility_timestamp, task_id, data, data_encoding)
+  VALUES (:shard_id, :visibility_timestamp, :task_id, :data, :data_encoding)`
+
+	getHousekeepingTasksQuery = `SELECT visibility_timestamp, task_id, data, data_encoding FROM housekeeping_tasks
+  WHERE shard_id = ?
+  AND ((visibility_timestamp >= ? AND task_id >= ?) OR visibility_timestamp > ?)
+  AND visibility_timestamp < ?
+  ORDER BY visibility_timestamp,task_id LIMIT ?`
+
+	deleteHousekeepingTaskQuery             = `DELETE FROM housekeeping_tasks WHERE shard_id = ? AND visibility_timestamp = ? AND task_id = ?`
+	rangeDeleteHousekeepingTaskQuery        = `DELETE FROM housekeeping_tasks WHERE shard_id = ? AND visibility_timestamp >= ? AND visibility_timestamp < ?`
+	rangeDeleteHousekeepingTaskByBatchQuery = rangeDeleteHousekeepingTaskQuery + ` ORDER BY visibility_timestamp,task_id LIMIT ?`
+
 	createReplicationTasksQuery = `INSERT INTO replication_tasks (shard_id, task_id, data, data_encoding)
   VALUES(:shard_id, :task_id, :data, :data_encoding)`
 
@@ -393,6 +406,53 @@ func (mdb *DB) RangeDeleteFromTimerTasks(ctx context.Context, filter *sqlplugin.
 	return mdb.driver.ExecContext(ctx, dbShardID, rangeDeleteTimerTaskQuery, filter.ShardID, filter.MinVisibilityTimestamp, filter.MaxVisibilityTimestamp)
 }
 
+// InsertIntoHousekeepingTasks inserts one or more rows into housekeeping_tasks table
+func (mdb *DB) InsertIntoHousekeepingTasks(ctx context.Context, rows []sqlplugin.TimerTasksRow) (sql.Result, error) {
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	dbShardID := sqlplugin.GetDBShardIDFromHistoryShardID(rows[0].ShardID, mdb.GetTotalNumDBShards())
+	for i := range rows {
+		rows[i].VisibilityTimestamp = mdb.converter.ToDateTime(rows[i].VisibilityTimestamp)
+	}
+	return mdb.driver.NamedExecContext(ctx, dbShardID, createHousekeepingTasksQuery, rows)
+}
+
+// SelectFromHousekeepingTasks reads one or more rows from housekeeping_tasks table
+func (mdb *DB) SelectFromHousekeepingTasks(ctx context.Context, filter *sqlplugin.TimerTasksFilter) ([]sqlplugin.TimerTasksRow, error) {
+	var rows []sqlplugin.TimerTasksRow
+	dbShardID := sqlplugin.GetDBShardIDFromHistoryShardID(filter.ShardID, mdb.GetTotalNumDBShards())
+	filter.MinVisibilityTimestamp = mdb.converter.ToDateTime(filter.MinVisibilityTimestamp)
+	filter.MaxVisibilityTimestamp = mdb.converter.ToDateTime(filter.MaxVisibilityTimestamp)
+	err := mdb.driver.SelectContext(ctx, dbShardID, &rows, getHousekeepingTasksQuery, filter.ShardID, filter.MinVisibilityTimestamp,
+		filter.TaskID, filter.MinVisibilityTimestamp, filter.MaxVisibilityTimestamp, filter.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].VisibilityTimestamp = mdb.converter.FromDateTime(rows[i].VisibilityTimestamp)
+	}
+	return rows, err
+}
+
+// DeleteFromHousekeepingTasks deletes one row from housekeeping_tasks table
+func (mdb *DB) DeleteFromHousekeepingTasks(ctx context.Context, filter *sqlplugin.TimerTasksFilter) (sql.Result, error) {
+	filter.VisibilityTimestamp = mdb.converter.ToDateTime(filter.VisibilityTimestamp)
+	dbShardID := sqlplugin.GetDBShardIDFromHistoryShardID(filter.ShardID, mdb.GetTotalNumDBShards())
+	return mdb.driver.ExecContext(ctx, dbShardID, deleteHousekeepingTaskQuery, filter.ShardID, filter.VisibilityTimestamp, filter.TaskID)
+}
+
+// RangeDeleteFromHousekeepingTasks deletes multi rows from housekeeping_tasks table
+func (mdb *DB) RangeDeleteFromHousekeepingTasks(ctx context.Context, filter *sqlplugin.TimerTasksFilter) (sql.Result, error) {
+	filter.MinVisibilityTimestamp = mdb.converter.ToDateTime(filter.MinVisibilityTimestamp)
+	filter.MaxVisibilityTimestamp = mdb.converter.ToDateTime(filter.MaxVisibilityTimestamp)
+	dbShardID := sqlplugin.GetDBShardIDFromHistoryShardID(filter.ShardID, mdb.GetTotalNumDBShards())
+	if filter.PageSize > 0 {
+		return mdb.driver.ExecContext(ctx, dbShardID, rangeDeleteHousekeepingTaskByBatchQuery, filter.ShardID, filter.MinVisibilityTimestamp, filter.MaxVisibilityTimestamp, filter.PageSize)
+	}
+	return mdb.driver.ExecContext(ctx, dbShardID, rangeDeleteHousekeepingTaskQuery, filter.ShardID, filter.MinVisibilityTimestamp, filter.MaxVisibilityTimestamp)
+}
+
 // InsertIntoBufferedEvents inserts one or more rows into buffered_events table
 func (mdb *DB) InsertIntoBufferedEvents(ctx context.Context, rows []sqlplugin.BufferedEventsRow) (sql.Result, error) {
 	if len(rows) == 0 {
@@ -119,6 +119,20 @@ workflow_id = :workflow_id
 	rangeDeleteTimerTaskByBatchQuery = `DELETE FROM timer_tasks WHERE shard_id = $1 AND (visibility_timestamp,task_id) IN (SELECT visibility_timestamp,task_id FROM
 		timer_tasks WHERE shard_id = $1 AND visibility_timestamp >= $2 AND visibility_timestamp < $3 ORDER BY visibility_timestamp,task_id LIMIT $4)`
 
+	createHousekeepingTasksQuery = `INSERT INTO housekeeping_tasks (shard_id, visibility_timestamp, task_id, data, data_encoding)
+  VALUES (:shard_id, :visibility_timestamp, :task_id, :data, :data_encoding)`
+
+	getHousekeepingTasksQuery = `SELECT visibility_timestamp, task_id, data, data_encoding FROM housekeeping_tasks
+  WHERE shard_id = $1
+  AND ((visibility_timestamp >= $2 AND task_id >= $3) OR visibility_timestamp > $4)
+  AND visibility_timestamp < $5
+  ORDER BY visibility_timestamp,task_id LIMIT $6`
+
+	deleteHousekeepingTaskQuery             = `DELETE FROM housekeeping_tasks WHERE shard_id = $1 AND visibility_timestamp = $2 AND task_id = $3`
+	rangeDeleteHousekeepingTaskQuery        = `DELETE FROM housekeeping_tasks WHERE shard_id = $1 AND visibility_timestamp >= $2 AND visibility_timestamp < $3`
+	rangeDeleteHousekeepingTaskByBatchQuery = `DELETE FROM housekeeping_tasks WHERE shard_id = $1 AND (visibility_timestamp,task_id) IN (SELECT visibility_timestamp,task_id FROM
+		housekeeping_tasks WHERE shard_id = $1 AND visibility_timestamp >= $2 AND visibility_timestamp < $3 ORDER BY visibility_timestamp,task_id LIMIT $4)`
+
 	createReplicationTasksQuery = `INSERT INTO replication_tasks (shard_id, task_id, data, data_encoding)
   VALUES(:shard_id, :task_id, :data, :data_encoding)`
 
@@ -396,6 +410,53 @@ func (pdb *db) RangeDeleteFromTimerTasks(ctx context.Context, filter *sqlplugin.
 	return pdb.driver.ExecContext(ctx, dbShardID, rangeDeleteTimerTaskQuery, filter.ShardID, filter.MinVisibilityTimestamp, filter.MaxVisibilityTimestamp)
 }
 
+// InsertIntoHousekeepingTasks inserts one or more rows into housekeeping_tasks table
+func (pdb *db) InsertIntoHousekeepingTasks(ctx context.Context, rows []sqlplugin.TimerTasksRow) (sql.Result, error) {
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	dbShardID := sqlplugin.GetDBShardIDFromHistoryShardID(rows[0].ShardID, pdb.GetTotalNumDBShards())
+	for i := range rows {
+		rows[i].VisibilityTimestamp = pdb.converter.ToPostgresDateTime(rows[i].VisibilityTimestamp)
+	}
+	return pdb.driver.NamedExecContext(ctx, dbShardID, createHousekeepingTasksQuery, rows)
+}
+
+// SelectFromHousekeepingTasks reads one or more rows from housekeeping_tasks table
+func (pdb *db) SelectFromHousekeepingTasks(ctx context.Context, filter *sqlplugin.TimerTasksFilter) ([]sqlplugin.TimerTasksRow, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromHistoryShardID(int(filter.ShardID), pdb.GetTotalNumDBShards())
+	var rows []sqlplugin.TimerTasksRow
+	filter.MinVisibilityTimestamp = pdb.converter.ToPostgresDateTime(filter.MinVisibilityTimestamp)
+	filter.MaxVisibilityTimestamp = pdb.converter.ToPostgresDateTime(filter.MaxVisibilityTimestamp)
+	err := pdb.driver.SelectContext(ctx, dbShardID, &rows, getHousekeepingTasksQuery, filter.ShardID, filter.MinVisibilityTimestamp,
+		filter.TaskID, filter.MinVisibilityTimestamp, filter.MaxVisibilityTimestamp, filter.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].VisibilityTimestamp = pdb.converter.FromPostgresDateTime(rows[i].VisibilityTimestamp)
+	}
+	return rows, err
+}
+
+// DeleteFromHousekeepingTasks deletes one or more rows from housekeeping_tasks table
+func (pdb *db) DeleteFromHousekeepingTasks(ctx context.Context, filter *sqlplugin.TimerTasksFilter) (sql.Result, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromHistoryShardID(int(filter.ShardID), pdb.GetTotalNumDBShards())
+	filter.VisibilityTimestamp = pdb.converter.ToPostgresDateTime(filter.VisibilityTimestamp)
+	return pdb.driver.ExecContext(ctx, dbShardID, deleteHousekeepingTaskQuery, filter.ShardID, filter.VisibilityTimestamp, filter.TaskID)
+}
+
+// RangeDeleteFromHousekeepingTasks deletes multi rows from housekeeping_tasks table
+func (pdb *db) RangeDeleteFromHousekeepingTasks(ctx context.Context, filter *sqlplugin.TimerTasksFilter) (sql.Result, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromHistoryShardID(int(filter.ShardID), pdb.GetTotalNumDBShards())
+	filter.MinVisibilityTimestamp = pdb.converter.ToPostgresDateTime(filter.MinVisibilityTimestamp)
+	filter.MaxVisibilityTimestamp = pdb.converter.ToPostgresDateTime(filter.MaxVisibilityTimestamp)
+	if filter.PageSize > 0 {
+		return pdb.driver.ExecContext(ctx, dbShardID, rangeDeleteHousekeepingTaskByBatchQuery, filter.ShardID, filter.MinVisibilityTimestamp, filter.MaxVisibilityTimestamp, filter.PageSize)
+	}
+	return pdb.driver.ExecContext(ctx, dbShardID, rangeDeleteHousekeepingTaskQuery, filter.ShardID, filter.MinVisibilityTimestamp, filter.MaxVisibilityTimestamp)
+}
+
 // InsertIntoBufferedEvents inserts one or more rows into buffered_events table
 func (pdb *db) InsertIntoBufferedEvents(ctx context.Context, rows []sqlplugin.BufferedEventsRow) (sql.Result, error) {
 	if len(rows) == 0 {
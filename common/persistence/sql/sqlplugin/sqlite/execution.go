@@ -80,6 +80,18 @@ WHERE (shard_id, task_id) IN (SELECT shard_id, task_id FROM tasks_to_delete);`
 )
 
 DELETE FROM timer_tasks
+WHERE (shard_id, visibility_timestamp, task_id) IN (SELECT shard_id, visibility_timestamp, task_id FROM tasks_to_delete);`
+
+	rangeDeleteHousekeepingTaskQuery        = `DELETE FROM housekeeping_tasks WHERE shard_id = ? AND visibility_timestamp >= ? AND visibility_timestamp < ?`
+	rangeDeleteHousekeepingTaskByBatchQuery = `WITH tasks_to_delete AS (
+    SELECT shard_id, visibility_timestamp, task_id
+    FROM housekeeping_tasks
+    WHERE shard_id = ? AND visibility_timestamp >= ? AND visibility_timestamp < ?
+    ORDER BY visibility_timestamp,task_id
+    LIMIT ?
+)
+
+DELETE FROM housekeeping_tasks
 WHERE (shard_id, visibility_timestamp, task_id) IN (SELECT shard_id, visibility_timestamp, task_id FROM tasks_to_delete);`
 )
 
@@ -144,3 +156,14 @@ func (mdb *DB) RangeDeleteFromTimerTasks(ctx context.Context, filter *sqlplugin.
 	}
 	return mdb.driver.ExecContext(ctx, dbShardID, rangeDeleteTimerTaskQuery, filter.ShardID, filter.MinVisibilityTimestamp, filter.MaxVisibilityTimestamp)
 }
+
+// RangeDeleteFromHousekeepingTasks deletes multi rows from housekeeping_tasks table
+func (mdb *DB) RangeDeleteFromHousekeepingTasks(ctx context.Context, filter *sqlplugin.TimerTasksFilter) (sql.Result, error) {
+	filter.MinVisibilityTimestamp = mdb.converter.ToDateTime(filter.MinVisibilityTimestamp)
+	filter.MaxVisibilityTimestamp = mdb.converter.ToDateTime(filter.MaxVisibilityTimestamp)
+	dbShardID := sqlplugin.GetDBShardIDFromHistoryShardID(filter.ShardID, mdb.GetTotalNumDBShards())
+	if filter.PageSize > 0 {
+		return mdb.driver.ExecContext(ctx, dbShardID, rangeDeleteHousekeepingTaskByBatchQuery, filter.ShardID, filter.MinVisibilityTimestamp, filter.MaxVisibilityTimestamp, filter.PageSize)
+	}
+	return mdb.driver.ExecContext(ctx, dbShardID, rangeDeleteHousekeepingTaskQuery, filter.ShardID, filter.MinVisibilityTimestamp, filter.MaxVisibilityTimestamp)
+}
@@ -177,6 +177,12 @@ type (
 		TaskData
 	}
 
+	// CompletionCallbackTask identifies a transfer task for delivering the completion callbacks of a closed workflow
+	CompletionCallbackTask struct {
+		WorkflowIdentifier
+		TaskData
+	}
+
 	// RecordChildExecutionCompletedTask identifies a task for recording the competion of a child workflow
 	RecordChildExecutionCompletedTask struct {
 		WorkflowIdentifier
@@ -217,6 +223,14 @@ type (
 		TimeoutType int // 0 for retry, 1 for cron.
 	}
 
+	// DelayedSignalTimerTask delivers a signal that was scheduled for a later time
+	DelayedSignalTimerTask struct {
+		WorkflowIdentifier
+		TaskData
+		// DelayedSignalID is the ID of the pending delayed signal, persisted in the EventID of the timer task
+		DelayedSignalID int64
+	}
+
 	// HistoryReplicationTask is the replication task created for shipping history replication events to other clusters
 	HistoryReplicationTask struct {
 		WorkflowIdentifier
@@ -257,10 +271,12 @@ var (
 	_ Task = (*UpsertWorkflowSearchAttributesTask)(nil)
 	_ Task = (*StartChildExecutionTask)(nil)
 	_ Task = (*RecordWorkflowClosedTask)(nil)
+	_ Task = (*CompletionCallbackTask)(nil)
 	_ Task = (*ActivityTimeoutTask)(nil)
 	_ Task = (*UserTimerTask)(nil)
 	_ Task = (*ActivityRetryTimerTask)(nil)
 	_ Task = (*WorkflowBackoffTimerTask)(nil)
+	_ Task = (*DelayedSignalTimerTask)(nil)
 	_ Task = (*HistoryReplicationTask)(nil)
 	_ Task = (*SyncActivityTask)(nil)
 	_ Task = (*FailoverMarkerTask)(nil)
@@ -820,6 +836,44 @@ func (r *WorkflowBackoffTimerTask) ToInternalReplicationTaskInfo() (*types.Repli
 	return nil, fmt.Errorf("workflow backoff timer task is not replication task")
 }
 
+// GetType returns the type of the delayed signal timer task
+func (d *DelayedSignalTimerTask) GetTaskType() int {
+	return TaskTypeDelayedSignalTimer
+}
+
+func (d *DelayedSignalTimerTask) GetTaskCategory() HistoryTaskCategory {
+	return HistoryTaskCategoryTimer
+}
+
+func (d *DelayedSignalTimerTask) GetTaskKey() HistoryTaskKey {
+	return NewHistoryTaskKey(d.VisibilityTimestamp, d.TaskID)
+}
+
+func (d *DelayedSignalTimerTask) ByteSize() uint64 {
+	return d.WorkflowIdentifier.ByteSize() + d.TaskData.ByteSize() + 8
+}
+
+func (d *DelayedSignalTimerTask) ToTransferTaskInfo() (*TransferTaskInfo, error) {
+	return nil, fmt.Errorf("delayed signal timer task is not transfer task")
+}
+
+func (d *DelayedSignalTimerTask) ToTimerTaskInfo() (*TimerTaskInfo, error) {
+	return &TimerTaskInfo{
+		TaskType:            TaskTypeDelayedSignalTimer,
+		DomainID:            d.DomainID,
+		WorkflowID:          d.WorkflowID,
+		RunID:               d.RunID,
+		TaskID:              d.TaskID,
+		VisibilityTimestamp: d.VisibilityTimestamp,
+		Version:             d.Version,
+		EventID:             d.DelayedSignalID,
+	}, nil
+}
+
+func (d *DelayedSignalTimerTask) ToInternalReplicationTaskInfo() (*types.ReplicationTaskInfo, error) {
+	return nil, fmt.Errorf("delayed signal timer task is not replication task")
+}
+
 // GetType returns the type of the timeout task.
 func (u *WorkflowTimeoutTask) GetTaskType() int {
 	return TaskTypeWorkflowTimeout
@@ -1095,6 +1149,43 @@ func (u *RecordWorkflowClosedTask) ToInternalReplicationTaskInfo() (*types.Repli
 	return nil, fmt.Errorf("record workflow closed task is not replication task")
 }
 
+// GetType returns the type of the completion callback task
+func (u *CompletionCallbackTask) GetTaskType() int {
+	return TransferTaskTypeCompletionCallback
+}
+
+func (u *CompletionCallbackTask) GetTaskCategory() HistoryTaskCategory {
+	return HistoryTaskCategoryTransfer
+}
+
+func (u *CompletionCallbackTask) GetTaskKey() HistoryTaskKey {
+	return NewImmediateTaskKey(u.TaskID)
+}
+
+func (u *CompletionCallbackTask) ByteSize() uint64 {
+	return u.WorkflowIdentifier.ByteSize() + u.TaskData.ByteSize()
+}
+
+func (u *CompletionCallbackTask) ToTransferTaskInfo() (*TransferTaskInfo, error) {
+	return &TransferTaskInfo{
+		TaskType:            TransferTaskTypeCompletionCallback,
+		DomainID:            u.DomainID,
+		WorkflowID:          u.WorkflowID,
+		RunID:               u.RunID,
+		TaskID:              u.TaskID,
+		VisibilityTimestamp: u.VisibilityTimestamp,
+		Version:             u.Version,
+	}, nil
+}
+
+func (u *CompletionCallbackTask) ToTimerTaskInfo() (*TimerTaskInfo, error) {
+	return nil, fmt.Errorf("completion callback task is not timer task")
+}
+
+func (u *CompletionCallbackTask) ToInternalReplicationTaskInfo() (*types.ReplicationTaskInfo, error) {
+	return nil, fmt.Errorf("completion callback task is not replication task")
+}
+
 // GetType returns the type of the history replication task
 func (a *HistoryReplicationTask) GetTaskType() int {
 	return ReplicationTaskTypeHistory
@@ -131,7 +131,7 @@ func (qv *VisibilityQueryValidator) validateRangeExpr(expr sqlparser.Expr) (stri
 		return "", fmt.Errorf("invalid search attribute %q", colNameStr)
 	}
 
-	if definition.IsSystemIndexedKey(colNameStr) {
+	if definition.IsSystemIndexedKey(colNameStr) && !definition.IsSystemSearchAttributeKey(colNameStr) {
 		if _, ok = timeSystemKeys[colNameStr]; ok {
 			if lowerBound, ok := rangeCond.From.(*sqlparser.SQLVal); ok {
 				trimmed, err := trimTimeFieldValueFromNanoToMilliSeconds(lowerBound)
@@ -219,7 +219,7 @@ func (qv *VisibilityQueryValidator) validateComparisonExpr(expr sqlparser.Expr)
 	// Case1: it is system key
 	// this means that we don't need to change the structure of the query,
 	// just need to check if a value == "missing"
-	if definition.IsSystemIndexedKey(colNameStr) {
+	if definition.IsSystemIndexedKey(colNameStr) && !definition.IsSystemSearchAttributeKey(colNameStr) {
 		return qv.processSystemKey(expr)
 	}
 	// Case2: when a value is not system key
@@ -78,6 +78,10 @@ func TestValidateQuery(t *testing.T) {
 			query:     "CustomKeywordField = 'custom'",
 			validated: `(JSON_MATCH(Attr, '"$.CustomKeywordField"=''custom''') or JSON_MATCH(Attr, '"$.CustomKeywordField[*]"=''custom'''))`,
 		},
+		"Case5-1: system keyword field stored in search attributes query": {
+			query:     "ActiveClusterName = 'cluster0'",
+			validated: `(JSON_MATCH(Attr, '"$.ActiveClusterName"=''cluster0''') or JSON_MATCH(Attr, '"$.ActiveClusterName[*]"=''cluster0'''))`,
+		},
 		"Case6-1: complex query I: with parenthesis": {
 			query:     "(CustomStringField = 'custom and custom2 or custom3 order by') or CustomIntField between 1 and 10",
 			validated: `(JSON_MATCH(Attr, '"$.CustomStringField" is not null') AND JSON_MATCH(Attr, 'REGEXP_LIKE("$.CustomStringField", ''.*custom and custom2 or custom3 order by.*'')') or (JSON_MATCH(Attr, '"$.CustomIntField" is not null') AND CAST(JSON_EXTRACT_SCALAR(Attr, '$.CustomIntField') AS INT) >= 1 AND CAST(JSON_EXTRACT_SCALAR(Attr, '$.CustomIntField') AS INT) <= 10))`,
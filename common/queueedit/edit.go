@@ -0,0 +1,182 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package queueedit defines the manual edits operators apply to the virtual queues of a history queue during an
+// incident, and the description of the virtual queues shown to them.
+//
+// ResetQueue has no field to carry an edit, so callers send it JSON encoded in the common.QueueEditHeaderName
+// request header, which the frontend forwards to history, and set the cluster name of the request to the one
+// returned by EditClusterName. A reset for such a cluster name applies the edit instead of resetting the queue.
+// Hosts which can't apply edits don't know the cluster name and reject the reset, or ignore it for the virtual
+// queues they don't edit. DescribeQueue returns one JSON encoded VirtualQueueDescription per virtual queue.
+package queueedit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// Operation is the kind of edit applied to a virtual queue
+	Operation string
+
+	// Edit is a manual change of the virtual queues of a history queue
+	Edit struct {
+		Operation Operation `json:"operation"`
+		// VirtualQueueID is the virtual queue being edited
+		VirtualQueueID int64 `json:"virtualQueueID"`
+		// SliceIndex is the position of the slice to split within the virtual queue
+		SliceIndex int `json:"sliceIndex,omitempty"`
+		// DomainIDs are the domains split out of the slice
+		DomainIDs []string `json:"domainIDs,omitempty"`
+		// TargetVirtualQueueID is the virtual queue receiving the split or merged slices
+		TargetVirtualQueueID int64 `json:"targetVirtualQueueID,omitempty"`
+		// PauseDuration is how long the virtual queue stops loading tasks
+		PauseDuration time.Duration `json:"pauseDuration,omitempty"`
+	}
+
+	// VirtualQueueDescription describes a virtual queue and the slices in it
+	VirtualQueueDescription struct {
+		VirtualQueueID int64              `json:"virtualQueueID"`
+		Paused         bool               `json:"paused,omitempty"`
+		Slices         []SliceDescription `json:"slices"`
+	}
+
+	// SliceDescription describes a slice of a virtual queue
+	SliceDescription struct {
+		Range                     *types.TaskRange `json:"range"`
+		Predicate                 *types.Predicate `json:"predicate"`
+		PendingTaskCount          int              `json:"pendingTaskCount"`
+		PendingTaskCountPerDomain map[string]int   `json:"pendingTaskCountPerDomain,omitempty"`
+	}
+)
+
+const (
+	// OperationSplit moves the tasks of some domains out of a slice into another virtual queue
+	OperationSplit Operation = "split"
+	// OperationMerge moves all the slices of a virtual queue into another virtual queue
+	OperationMerge Operation = "merge"
+	// OperationPause stops a virtual queue from loading tasks for a while
+	OperationPause Operation = "pause"
+	// OperationResume lets a paused virtual queue load tasks again
+	OperationResume Operation = "resume"
+)
+
+// editClusterNameSuffix marks the cluster name of a queue reset applying an edit
+const editClusterNameSuffix = "#queue-edit"
+
+// Operations are all the supported operations
+var Operations = []Operation{OperationSplit, OperationMerge, OperationPause, OperationResume}
+
+// EditClusterName returns the cluster name of a queue reset applying an edit to the queue of the given cluster
+func EditClusterName(clusterName string) string {
+	return clusterName + editClusterNameSuffix
+}
+
+// ParseClusterName returns the cluster of the queue targeted by the given queue reset cluster name, and whether
+// the reset applies an edit
+func ParseClusterName(clusterName string) (string, bool) {
+	return strings.CutSuffix(clusterName, editClusterNameSuffix)
+}
+
+// Encode returns the header value for the given edit
+func Encode(edit *Edit) (string, error) {
+	value, err := json.Marshal(edit)
+	if err != nil {
+		return "", err
+	}
+	return string(value), nil
+}
+
+// Decode returns the edit carried by the given header value
+func Decode(value string) (*Edit, error) {
+	edit := &Edit{}
+	if err := json.Unmarshal([]byte(value), edit); err != nil {
+		return nil, fmt.Errorf("invalid queue edit: %w", err)
+	}
+	if err := edit.Validate(); err != nil {
+		return nil, err
+	}
+	return edit, nil
+}
+
+// Validate validates the edit
+func (e *Edit) Validate() error {
+	if e.VirtualQueueID < 0 {
+		return errors.New("invalid queue edit: virtual queue ID must not be negative")
+	}
+	switch e.Operation {
+	case OperationSplit:
+		if e.SliceIndex < 0 {
+			return errors.New("invalid queue edit: slice index must not be negative")
+		}
+		if len(e.DomainIDs) == 0 {
+			return errors.New("invalid queue edit: domain IDs are required to split a slice")
+		}
+		return e.validateTarget()
+	case OperationMerge:
+		return e.validateTarget()
+	case OperationPause:
+		if e.PauseDuration <= 0 {
+			return errors.New("invalid queue edit: pause duration must be positive")
+		}
+		return nil
+	case OperationResume:
+		return nil
+	default:
+		return fmt.Errorf("invalid queue edit: unknown operation %q, supported operations are %v", e.Operation, Operations)
+	}
+}
+
+func (e *Edit) validateTarget() error {
+	if e.TargetVirtualQueueID < 0 {
+		return errors.New("invalid queue edit: target virtual queue ID must not be negative")
+	}
+	if e.TargetVirtualQueueID == e.VirtualQueueID {
+		return errors.New("invalid queue edit: target virtual queue must be different from the edited one")
+	}
+	return nil
+}
+
+// EncodeDescription returns the description as returned by DescribeQueue
+func EncodeDescription(description *VirtualQueueDescription) (string, error) {
+	value, err := json.Marshal(description)
+	if err != nil {
+		return "", err
+	}
+	return string(value), nil
+}
+
+// DecodeDescription returns the virtual queue description returned by DescribeQueue, it returns false if the
+// value is not a virtual queue description, e.g. it describes a processing queue of the previous queue implementation
+func DecodeDescription(value string) (*VirtualQueueDescription, bool) {
+	description := &VirtualQueueDescription{}
+	if err := json.Unmarshal([]byte(value), description); err != nil || description.Slices == nil {
+		return nil, false
+	}
+	return description, true
+}
@@ -0,0 +1,144 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package queueedit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/types"
+)
+
+func TestValidate(t *testing.T) {
+	tests := map[string]struct {
+		edit    Edit
+		wantErr bool
+	}{
+		"split": {
+			edit: Edit{Operation: OperationSplit, VirtualQueueID: 0, SliceIndex: 1, DomainIDs: []string{"domain"}, TargetVirtualQueueID: 1},
+		},
+		"split without domains": {
+			edit:    Edit{Operation: OperationSplit, VirtualQueueID: 0, TargetVirtualQueueID: 1},
+			wantErr: true,
+		},
+		"split with negative slice index": {
+			edit:    Edit{Operation: OperationSplit, VirtualQueueID: 0, SliceIndex: -1, DomainIDs: []string{"domain"}, TargetVirtualQueueID: 1},
+			wantErr: true,
+		},
+		"split into the same virtual queue": {
+			edit:    Edit{Operation: OperationSplit, VirtualQueueID: 1, DomainIDs: []string{"domain"}, TargetVirtualQueueID: 1},
+			wantErr: true,
+		},
+		"merge": {
+			edit: Edit{Operation: OperationMerge, VirtualQueueID: 1, TargetVirtualQueueID: 0},
+		},
+		"merge into negative virtual queue": {
+			edit:    Edit{Operation: OperationMerge, VirtualQueueID: 1, TargetVirtualQueueID: -1},
+			wantErr: true,
+		},
+		"pause": {
+			edit: Edit{Operation: OperationPause, VirtualQueueID: 1, PauseDuration: time.Minute},
+		},
+		"pause without duration": {
+			edit:    Edit{Operation: OperationPause, VirtualQueueID: 1},
+			wantErr: true,
+		},
+		"resume": {
+			edit: Edit{Operation: OperationResume, VirtualQueueID: 1},
+		},
+		"negative virtual queue": {
+			edit:    Edit{Operation: OperationResume, VirtualQueueID: -1},
+			wantErr: true,
+		},
+		"unknown operation": {
+			edit:    Edit{Operation: "drop"},
+			wantErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := test.edit.Validate()
+			if test.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestEncodeDecode(t *testing.T) {
+	edit := &Edit{Operation: OperationSplit, VirtualQueueID: 0, SliceIndex: 2, DomainIDs: []string{"domain"}, TargetVirtualQueueID: 1}
+	value, err := Encode(edit)
+	require.NoError(t, err)
+	decoded, err := Decode(value)
+	require.NoError(t, err)
+	assert.Equal(t, edit, decoded)
+
+	_, err = Decode("not json")
+	assert.Error(t, err)
+	_, err = Decode(`{"operation":"split","virtualQueueID":0}`)
+	assert.Error(t, err)
+}
+
+func TestEncodeDecodeDescription(t *testing.T) {
+	description := &VirtualQueueDescription{
+		VirtualQueueID: 1,
+		Paused:         true,
+		Slices: []SliceDescription{
+			{
+				Range: &types.TaskRange{
+					InclusiveMin: &types.TaskKey{TaskID: 1},
+					ExclusiveMax: &types.TaskKey{TaskID: 10},
+				},
+				Predicate:                 &types.Predicate{PredicateType: types.PredicateTypeUniversal},
+				PendingTaskCount:          2,
+				PendingTaskCountPerDomain: map[string]int{"domain": 2},
+			},
+		},
+	}
+	value, err := EncodeDescription(description)
+	require.NoError(t, err)
+	decoded, ok := DecodeDescription(value)
+	require.True(t, ok)
+	assert.Equal(t, description, decoded)
+
+	_, ok = DecodeDescription("Alert QueueStuckSlice: raised at 1970-01-01T00:16:40Z, unresolved")
+	assert.False(t, ok)
+	_, ok = DecodeDescription(`{"ackLevel":10}`)
+	assert.False(t, ok)
+}
+
+func TestClusterName(t *testing.T) {
+	clusterName, isEdit := ParseClusterName(EditClusterName("active"))
+	assert.True(t, isEdit)
+	assert.Equal(t, "active", clusterName)
+
+	clusterName, isEdit = ParseClusterName("active")
+	assert.False(t, isEdit)
+	assert.Equal(t, "active", clusterName)
+}
@@ -0,0 +1,146 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package reapply defines which events are reapplied to a workflow when it is reset.
+//
+// Events recorded after the reset point are reapplied to the reset run according to a Policy, and by default
+// only signals are reapplied. ResetWorkflowExecution has no field to carry a policy, so callers send it JSON
+// encoded in the common.ResetReapplyPolicyHeaderName request header, which the frontend forwards to history.
+package reapply
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// Policy selects the events reapplied when a workflow is reset
+	Policy struct {
+		// EventTypes are the types of events to reapply, out of ReapplicableEventTypes
+		EventTypes []types.EventType `json:"eventTypes,omitempty"`
+		// SignalNames, when not empty, restricts the reapplied signals to the ones with these names
+		SignalNames []string `json:"signalNames,omitempty"`
+		// ExcludedSignalNames are the names of signals which are never reapplied
+		ExcludedSignalNames []string `json:"excludedSignalNames,omitempty"`
+	}
+)
+
+// ReapplicableEventTypes are the types of events which can be reapplied
+var ReapplicableEventTypes = []types.EventType{
+	types.EventTypeWorkflowExecutionSignaled,
+	types.EventTypeUpsertWorkflowSearchAttributes,
+	types.EventTypeWorkflowExecutionCancelRequested,
+}
+
+// DefaultPolicy returns the policy used when none is given, which reapplies all signals
+func DefaultPolicy() *Policy {
+	return &Policy{EventTypes: []types.EventType{types.EventTypeWorkflowExecutionSignaled}}
+}
+
+// Encode returns the header value for the given policy
+func Encode(policy *Policy) (string, error) {
+	value, err := json.Marshal(policy)
+	if err != nil {
+		return "", err
+	}
+	return string(value), nil
+}
+
+// Decode returns the policy carried by the given header value
+func Decode(value string) (*Policy, error) {
+	policy := &Policy{}
+	if err := json.Unmarshal([]byte(value), policy); err != nil {
+		return nil, fmt.Errorf("invalid reset reapply policy: %w", err)
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// Validate validates the policy
+func (p *Policy) Validate() error {
+	for _, eventType := range p.EventTypes {
+		if !isReapplicable(eventType) {
+			return fmt.Errorf("invalid reset reapply policy: %v events cannot be reapplied", eventType)
+		}
+	}
+	if len(p.SignalNames) != 0 || len(p.ExcludedSignalNames) != 0 {
+		if !p.hasEventType(types.EventTypeWorkflowExecutionSignaled) {
+			return errors.New("invalid reset reapply policy: signal names are set but signals are not reapplied")
+		}
+	}
+	for _, name := range p.ExcludedSignalNames {
+		if contains(p.SignalNames, name) {
+			return fmt.Errorf("invalid reset reapply policy: signal %v is both included and excluded", name)
+		}
+	}
+	return nil
+}
+
+// ShouldReapply returns whether the given event is reapplied, a nil policy is the default policy
+func (p *Policy) ShouldReapply(event *types.HistoryEvent) bool {
+	if p == nil {
+		return DefaultPolicy().ShouldReapply(event)
+	}
+	if !p.hasEventType(event.GetEventType()) {
+		return false
+	}
+	if event.GetEventType() != types.EventTypeWorkflowExecutionSignaled {
+		return true
+	}
+	signalName := event.GetWorkflowExecutionSignaledEventAttributes().GetSignalName()
+	if len(p.SignalNames) != 0 && !contains(p.SignalNames, signalName) {
+		return false
+	}
+	return !contains(p.ExcludedSignalNames, signalName)
+}
+
+func (p *Policy) hasEventType(eventType types.EventType) bool {
+	for _, t := range p.EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func isReapplicable(eventType types.EventType) bool {
+	for _, t := range ReapplicableEventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
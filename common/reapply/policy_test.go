@@ -0,0 +1,128 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package reapply
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/types"
+)
+
+func signaled(name string) *types.HistoryEvent {
+	return &types.HistoryEvent{
+		EventType: types.EventTypeWorkflowExecutionSignaled.Ptr(),
+		WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{
+			SignalName: name,
+		},
+	}
+}
+
+func event(eventType types.EventType) *types.HistoryEvent {
+	return &types.HistoryEvent{EventType: eventType.Ptr()}
+}
+
+func TestShouldReapply(t *testing.T) {
+	tests := map[string]struct {
+		policy   *Policy
+		event    *types.HistoryEvent
+		expected bool
+	}{
+		"default policy reapplies signals": {
+			policy:   nil,
+			event:    signaled("signal"),
+			expected: true,
+		},
+		"default policy skips upserts": {
+			policy:   nil,
+			event:    event(types.EventTypeUpsertWorkflowSearchAttributes),
+			expected: false,
+		},
+		"allowed signal name": {
+			policy:   &Policy{EventTypes: []types.EventType{types.EventTypeWorkflowExecutionSignaled}, SignalNames: []string{"a"}},
+			event:    signaled("a"),
+			expected: true,
+		},
+		"signal name not allowed": {
+			policy:   &Policy{EventTypes: []types.EventType{types.EventTypeWorkflowExecutionSignaled}, SignalNames: []string{"a"}},
+			event:    signaled("b"),
+			expected: false,
+		},
+		"excluded signal name": {
+			policy:   &Policy{EventTypes: []types.EventType{types.EventTypeWorkflowExecutionSignaled}, ExcludedSignalNames: []string{"b"}},
+			event:    signaled("b"),
+			expected: false,
+		},
+		"cancel request": {
+			policy:   &Policy{EventTypes: []types.EventType{types.EventTypeWorkflowExecutionCancelRequested}},
+			event:    event(types.EventTypeWorkflowExecutionCancelRequested),
+			expected: true,
+		},
+		"signals not selected": {
+			policy:   &Policy{EventTypes: []types.EventType{types.EventTypeUpsertWorkflowSearchAttributes}},
+			event:    signaled("a"),
+			expected: false,
+		},
+		"other events": {
+			policy:   &Policy{EventTypes: ReapplicableEventTypes},
+			event:    event(types.EventTypeActivityTaskScheduled),
+			expected: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.policy.ShouldReapply(tc.event))
+		})
+	}
+}
+
+func TestEncodeDecode(t *testing.T) {
+	policy := &Policy{
+		EventTypes:          []types.EventType{types.EventTypeWorkflowExecutionSignaled, types.EventTypeUpsertWorkflowSearchAttributes},
+		ExcludedSignalNames: []string{"noisy"},
+	}
+	value, err := Encode(policy)
+	require.NoError(t, err)
+	assert.Contains(t, value, "WorkflowExecutionSignaled")
+
+	decoded, err := Decode(value)
+	require.NoError(t, err)
+	assert.Equal(t, policy, decoded)
+}
+
+func TestDecode_Invalid(t *testing.T) {
+	for name, value := range map[string]string{
+		"malformed":             "{",
+		"unknown event type":    `{"eventTypes":["NotAnEventType"]}`,
+		"not reapplicable":      `{"eventTypes":["ActivityTaskScheduled"]}`,
+		"signal names only":     `{"eventTypes":["UpsertWorkflowSearchAttributes"],"signalNames":["a"]}`,
+		"included and excluded": `{"eventTypes":["WorkflowExecutionSignaled"],"signalNames":["a"],"excludedSignalNames":["a"]}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := Decode(value)
+			assert.ErrorContains(t, err, "invalid reset reapply policy")
+		})
+	}
+}
@@ -0,0 +1,227 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package replicationstatus describes how far a standby cluster is behind in replicating each domain.
+//
+// The replication task processors of a history shard track the lag of the domains they apply tasks for, and take
+// the tasks not applied yet from the replication queue of the source cluster. Aggregate merges the lags of all the
+// shards into the status of each domain.
+//
+// DescribeQueue has no dedicated response for replication, so both are returned as JSON encoded strings in the
+// ProcessingQueueStates of DescribeQueue of the replication task type, encoded with the Version below:
+//   - for the name of a source cluster, one DomainLag per domain replicated to the shard from that cluster;
+//   - for the cluster name returned by BacklogClusterName, one DomainBacklog per domain having replication tasks
+//     in the shard that the given cluster hasn't applied yet.
+//
+// Hosts which don't know the backlog cluster name return no domain for it.
+package replicationstatus
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// Version is the version of the encoding of DomainLag and DomainBacklog, values with a newer version are rejected
+	Version = 1
+
+	backlogClusterNameSuffix = "#replication-backlog"
+)
+
+type (
+	// DomainLag is the replication lag of a domain in a shard of the standby cluster
+	DomainLag struct {
+		Version       int    `json:"version"`
+		DomainID      string `json:"domainID"`
+		DomainName    string `json:"domainName"`
+		SourceCluster string `json:"sourceCluster"`
+		ShardID       int32  `json:"shardID"`
+		// Lag is the time since the creation of the oldest task not applied yet, zero when all the tasks are applied
+		Lag time.Duration `json:"lag"`
+		// PendingTasks is the number of tasks of the source cluster not applied yet
+		PendingTasks int64 `json:"pendingTasks"`
+		// LastAppliedTime is when the last task of the domain was applied, zero if none was applied yet
+		LastAppliedTime time.Time `json:"lastAppliedTime"`
+		// ExceedsThresholds tells whether the lag is over the thresholds configured for the domain
+		ExceedsThresholds bool `json:"exceedsThresholds,omitempty"`
+	}
+
+	// DomainBacklog are the replication tasks of a domain in a shard of the source cluster not applied yet by a
+	// standby cluster
+	DomainBacklog struct {
+		Version  int    `json:"version"`
+		DomainID string `json:"domainID"`
+		// PendingTasks is the number of tasks not applied yet, up to the number of tasks the source reads per shard
+		PendingTasks int64 `json:"pendingTasks"`
+		// OldestTaskTime is the creation time of the oldest task not applied yet
+		OldestTaskTime time.Time `json:"oldestTaskTime"`
+	}
+
+	// DomainStatus is the replication lag of a domain over all the shards of the standby cluster
+	DomainStatus struct {
+		DomainID        string
+		DomainName      string
+		SourceCluster   string
+		Lag             time.Duration
+		PendingTasks    int64
+		LastAppliedTime time.Time
+		// LaggingShards are the shards where the lag of the domain is over its thresholds
+		LaggingShards []int32
+	}
+
+	// Thresholds are the replication lag above which a domain is considered lagging behind, zero values are ignored
+	Thresholds struct {
+		MaxLag          time.Duration
+		MaxPendingTasks int64
+	}
+)
+
+// Exceeded returns true if the lag is over the thresholds
+func (t Thresholds) Exceeded(lag time.Duration, pendingTasks int64) bool {
+	return (t.MaxLag > 0 && lag > t.MaxLag) || (t.MaxPendingTasks > 0 && pendingTasks > t.MaxPendingTasks)
+}
+
+// IsLagging returns true if the domain lags behind in any shard
+func (s *DomainStatus) IsLagging() bool {
+	return len(s.LaggingShards) > 0
+}
+
+// BacklogClusterName returns the cluster name of DescribeQueue describing the replication backlog of the given
+// standby cluster
+func BacklogClusterName(clusterName string) string {
+	return clusterName + backlogClusterNameSuffix
+}
+
+// ParseBacklogClusterName returns the standby cluster of the given DescribeQueue cluster name, and whether the
+// replication backlog of the cluster is described
+func ParseBacklogClusterName(clusterName string) (string, bool) {
+	return strings.CutSuffix(clusterName, backlogClusterNameSuffix)
+}
+
+// EncodeDomainLag returns the domain lag as returned by DescribeQueue
+func EncodeDomainLag(lag *DomainLag) (string, error) {
+	versioned := *lag
+	versioned.Version = Version
+	return encode(&versioned)
+}
+
+// DecodeDomainLag returns the domain lag returned by DescribeQueue
+func DecodeDomainLag(value string) (*DomainLag, error) {
+	lag := &DomainLag{}
+	if err := json.Unmarshal([]byte(value), lag); err != nil {
+		return nil, fmt.Errorf("invalid domain replication lag: %w", err)
+	}
+	if err := validate(value, lag.Version, lag.DomainID); err != nil {
+		return nil, fmt.Errorf("invalid domain replication lag: %w", err)
+	}
+	return lag, nil
+}
+
+// EncodeDomainBacklog returns the domain backlog as returned by DescribeQueue
+func EncodeDomainBacklog(backlog *DomainBacklog) (string, error) {
+	versioned := *backlog
+	versioned.Version = Version
+	return encode(&versioned)
+}
+
+// DecodeDomainBacklog returns the domain backlog returned by DescribeQueue
+func DecodeDomainBacklog(value string) (*DomainBacklog, error) {
+	backlog := &DomainBacklog{}
+	if err := json.Unmarshal([]byte(value), backlog); err != nil {
+		return nil, fmt.Errorf("invalid domain replication backlog: %w", err)
+	}
+	if err := validate(value, backlog.Version, backlog.DomainID); err != nil {
+		return nil, fmt.Errorf("invalid domain replication backlog: %w", err)
+	}
+	return backlog, nil
+}
+
+func encode(v interface{}) (string, error) {
+	value, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(value), nil
+}
+
+func validate(value string, version int, domainID string) error {
+	if version > Version {
+		return fmt.Errorf("version %v is newer than %v in %q", version, Version, value)
+	}
+	if domainID == "" {
+		return fmt.Errorf("domain ID is not set in %q", value)
+	}
+	return nil
+}
+
+// Aggregate merges the lags of a domain in all the shards: the lag of the domain is its largest lag in a shard and
+// pending tasks are summed. The statuses are sorted by source cluster and domain name.
+func Aggregate(lags []*DomainLag) []*DomainStatus {
+	type statusKey struct {
+		sourceCluster string
+		domainID      string
+	}
+	statuses := make(map[statusKey]*DomainStatus)
+	for _, lag := range lags {
+		key := statusKey{sourceCluster: lag.SourceCluster, domainID: lag.DomainID}
+		status, ok := statuses[key]
+		if !ok {
+			status = &DomainStatus{
+				DomainID:      lag.DomainID,
+				SourceCluster: lag.SourceCluster,
+			}
+			statuses[key] = status
+		}
+		if status.DomainName == "" {
+			status.DomainName = lag.DomainName
+		}
+		if lag.Lag > status.Lag {
+			status.Lag = lag.Lag
+		}
+		status.PendingTasks += lag.PendingTasks
+		if lag.LastAppliedTime.After(status.LastAppliedTime) {
+			status.LastAppliedTime = lag.LastAppliedTime
+		}
+		if lag.ExceedsThresholds {
+			status.LaggingShards = append(status.LaggingShards, lag.ShardID)
+		}
+	}
+
+	result := make([]*DomainStatus, 0, len(statuses))
+	for _, status := range statuses {
+		sort.Slice(status.LaggingShards, func(i, j int) bool { return status.LaggingShards[i] < status.LaggingShards[j] })
+		result = append(result, status)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].SourceCluster != result[j].SourceCluster {
+			return result[i].SourceCluster < result[j].SourceCluster
+		}
+		if result[i].DomainName != result[j].DomainName {
+			return result[i].DomainName < result[j].DomainName
+		}
+		return result[i].DomainID < result[j].DomainID
+	})
+	return result
+}
@@ -0,0 +1,120 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package replicationstatus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestThresholdsExceeded(t *testing.T) {
+	thresholds := Thresholds{MaxLag: time.Minute, MaxPendingTasks: 10}
+	assert.False(t, thresholds.Exceeded(time.Minute, 10))
+	assert.True(t, thresholds.Exceeded(time.Minute+time.Second, 0))
+	assert.True(t, thresholds.Exceeded(0, 11))
+	assert.False(t, Thresholds{}.Exceeded(time.Hour, 1000))
+}
+
+func TestEncodeDecodeDomainLag(t *testing.T) {
+	lag := &DomainLag{
+		DomainID:          "domain-id",
+		DomainName:        "domain",
+		SourceCluster:     "active",
+		ShardID:           3,
+		Lag:               time.Second,
+		PendingTasks:      5,
+		LastAppliedTime:   time.Unix(100, 0).UTC(),
+		ExceedsThresholds: true,
+	}
+	value, err := EncodeDomainLag(lag)
+	require.NoError(t, err)
+
+	decoded, err := DecodeDomainLag(value)
+	require.NoError(t, err)
+	lag.Version = Version
+	assert.Equal(t, lag, decoded)
+
+	_, err = DecodeDomainLag("not json")
+	assert.Error(t, err)
+	_, err = DecodeDomainLag(`{"shardID":1}`)
+	assert.Error(t, err)
+	_, err = DecodeDomainLag(`{"version":2,"domainID":"domain-id"}`)
+	assert.Error(t, err)
+}
+
+func TestEncodeDecodeDomainBacklog(t *testing.T) {
+	backlog := &DomainBacklog{
+		DomainID:       "domain-id",
+		PendingTasks:   5,
+		OldestTaskTime: time.Unix(100, 0).UTC(),
+	}
+	value, err := EncodeDomainBacklog(backlog)
+	require.NoError(t, err)
+	assert.Zero(t, backlog.Version)
+
+	decoded, err := DecodeDomainBacklog(value)
+	require.NoError(t, err)
+	assert.Equal(t, &DomainBacklog{
+		Version:        Version,
+		DomainID:       "domain-id",
+		PendingTasks:   5,
+		OldestTaskTime: time.Unix(100, 0).UTC(),
+	}, decoded)
+
+	_, err = DecodeDomainBacklog(`{"pendingTasks":1}`)
+	assert.Error(t, err)
+	_, err = DecodeDomainBacklog(`{"version":2,"domainID":"domain-id"}`)
+	assert.Error(t, err)
+}
+
+func TestBacklogClusterName(t *testing.T) {
+	clusterName, ok := ParseBacklogClusterName(BacklogClusterName("standby"))
+	assert.True(t, ok)
+	assert.Equal(t, "standby", clusterName)
+
+	clusterName, ok = ParseBacklogClusterName("standby")
+	assert.False(t, ok)
+	assert.Equal(t, "standby", clusterName)
+}
+
+func TestAggregate(t *testing.T) {
+	now := time.Unix(1000, 0)
+	lags := []*DomainLag{
+		{DomainID: "b-id", DomainName: "b", SourceCluster: "active", ShardID: 2, Lag: time.Second, PendingTasks: 2, LastAppliedTime: now},
+		{DomainID: "a-id", DomainName: "a", SourceCluster: "active", ShardID: 1, Lag: 3 * time.Second, PendingTasks: 1, LastAppliedTime: now.Add(-time.Minute), ExceedsThresholds: true},
+		{DomainID: "b-id", DomainName: "b", SourceCluster: "active", ShardID: 1, Lag: 2 * time.Second, PendingTasks: 4, LastAppliedTime: now.Add(-time.Second)},
+		{DomainID: "a-id", DomainName: "a", SourceCluster: "other", ShardID: 1},
+	}
+
+	statuses := Aggregate(lags)
+	assert.Equal(t, []*DomainStatus{
+		{DomainID: "a-id", DomainName: "a", SourceCluster: "active", Lag: 3 * time.Second, PendingTasks: 1, LastAppliedTime: now.Add(-time.Minute), LaggingShards: []int32{1}},
+		{DomainID: "b-id", DomainName: "b", SourceCluster: "active", Lag: 2 * time.Second, PendingTasks: 6, LastAppliedTime: now},
+		{DomainID: "a-id", DomainName: "a", SourceCluster: "other"},
+	}, statuses)
+	assert.True(t, statuses[0].IsLagging())
+	assert.False(t, statuses[1].IsLagging())
+}
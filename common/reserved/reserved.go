@@ -0,0 +1,118 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package reserved defines the signal names and memo keys which are reserved for the server.
+//
+// Features such as workflow pause and updates are built on signals and on state kept in the execution
+// memo, using names prefixed with Prefix. Users can't send signals with the prefix, except the signals
+// which stand for an API and are authorized as that API, and they can't set the memo keys of the state
+// which history derives from these signals.
+package reserved
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/uber/cadence/common/activitycontrol"
+	"github.com/uber/cadence/common/authorization"
+	"github.com/uber/cadence/common/delayedsignal"
+	"github.com/uber/cadence/common/searchattrupdate"
+	"github.com/uber/cadence/common/signaldedup"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/workflowpause"
+	"github.com/uber/cadence/common/workflowupdate"
+)
+
+// Prefix prefixes the signal names and memo keys reserved for the server
+const Prefix = "__cadence_"
+
+type (
+	// SignalAPI is the API a reserved signal stands for
+	SignalAPI struct {
+		// Name is the API name the signal is authorized as
+		Name       string
+		Permission authorization.Permission
+	}
+)
+
+var (
+	// signalAPIs are the reserved signals users can send. Updating search attributes from outside of the
+	// workflow is meant for batch operations and resets, so it requires admin permission.
+	signalAPIs = map[string]SignalAPI{
+		workflowpause.PauseSignalName:           {Name: "PauseWorkflowExecution", Permission: authorization.PermissionWrite},
+		workflowpause.UnpauseSignalName:         {Name: "UnpauseWorkflowExecution", Permission: authorization.PermissionWrite},
+		activitycontrol.PauseSignalName:         {Name: "PauseActivity", Permission: authorization.PermissionWrite},
+		activitycontrol.UnpauseSignalName:       {Name: "UnpauseActivity", Permission: authorization.PermissionWrite},
+		activitycontrol.ResetSignalName:         {Name: "ResetActivity", Permission: authorization.PermissionWrite},
+		activitycontrol.UpdateOptionsSignalName: {Name: "UpdateActivityOptions", Permission: authorization.PermissionWrite},
+		searchattrupdate.SignalName:             {Name: "UpsertWorkflowSearchAttributes", Permission: authorization.PermissionAdmin},
+		delayedsignal.SignalName:                {Name: "SignalWorkflowExecutionDelayed", Permission: authorization.PermissionWrite},
+		delayedsignal.CancelSignalName:          {Name: "CancelDelayedSignal", Permission: authorization.PermissionWrite},
+	}
+
+	updateSignalAPI = SignalAPI{Name: "UpdateWorkflowExecution", Permission: authorization.PermissionWrite}
+
+	// memoKeys are the memo keys of the state history keeps in memo
+	memoKeys = []string{
+		activitycontrol.MemoKey,
+		delayedsignal.MemoKey,
+		signaldedup.MemoKey,
+		workflowpause.MemoKey,
+		workflowupdate.MemoKey,
+	}
+)
+
+// IsSignalName returns whether the signal name is reserved for the server
+func IsSignalName(signalName string) bool {
+	return strings.HasPrefix(signalName, Prefix)
+}
+
+// GetSignalAPI returns the API the given reserved signal stands for. Users can only send the reserved signals
+// which stand for an API.
+func GetSignalAPI(signalName string) (SignalAPI, bool) {
+	if workflowupdate.IsSignal(signalName) {
+		return updateSignalAPI, true
+	}
+	api, ok := signalAPIs[signalName]
+	return api, ok
+}
+
+// ValidateSignalName returns an error if users can't send a signal with the given name
+func ValidateSignalName(signalName string) error {
+	if !IsSignalName(signalName) {
+		return nil
+	}
+	if _, ok := GetSignalAPI(signalName); !ok {
+		return &types.BadRequestError{Message: fmt.Sprintf("Signal name %v is reserved.", signalName)}
+	}
+	return nil
+}
+
+// ValidateMemo returns an error if the memo of a new run sets a key of the state history keeps in memo
+func ValidateMemo(memo map[string][]byte) error {
+	for _, key := range memoKeys {
+		if _, ok := memo[key]; ok {
+			return &types.BadRequestError{Message: fmt.Sprintf("Memo key %v is reserved.", key)}
+		}
+	}
+	return nil
+}
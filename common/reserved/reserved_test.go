@@ -0,0 +1,80 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package reserved
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/activitycontrol"
+	"github.com/uber/cadence/common/authorization"
+	"github.com/uber/cadence/common/delayedsignal"
+	"github.com/uber/cadence/common/searchattrupdate"
+	"github.com/uber/cadence/common/signaldedup"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/workflowpause"
+	"github.com/uber/cadence/common/workflowupdate"
+)
+
+func TestValidateSignalName(t *testing.T) {
+	assert.NoError(t, ValidateSignalName("signal"))
+	assert.NoError(t, ValidateSignalName(workflowpause.PauseSignalName))
+	assert.NoError(t, ValidateSignalName(activitycontrol.ResetSignalName))
+	assert.NoError(t, ValidateSignalName(workflowupdate.SignalName("approve")))
+
+	err := ValidateSignalName("__cadence_signal")
+	assert.IsType(t, &types.BadRequestError{}, err)
+	assert.EqualError(t, err, "Signal name __cadence_signal is reserved.")
+}
+
+func TestGetSignalAPI(t *testing.T) {
+	api, ok := GetSignalAPI(workflowpause.UnpauseSignalName)
+	assert.True(t, ok)
+	assert.Equal(t, SignalAPI{Name: "UnpauseWorkflowExecution", Permission: authorization.PermissionWrite}, api)
+
+	api, ok = GetSignalAPI(searchattrupdate.SignalName)
+	assert.True(t, ok)
+	assert.Equal(t, SignalAPI{Name: "UpsertWorkflowSearchAttributes", Permission: authorization.PermissionAdmin}, api)
+
+	api, ok = GetSignalAPI(workflowupdate.SignalName("approve"))
+	assert.True(t, ok)
+	assert.Equal(t, "UpdateWorkflowExecution", api.Name)
+
+	_, ok = GetSignalAPI("signal")
+	assert.False(t, ok)
+	_, ok = GetSignalAPI("__cadence_signal")
+	assert.False(t, ok)
+}
+
+func TestValidateMemo(t *testing.T) {
+	assert.NoError(t, ValidateMemo(nil))
+	assert.NoError(t, ValidateMemo(map[string][]byte{"owner": []byte("payments")}))
+
+	err := ValidateMemo(map[string][]byte{"owner": []byte("payments"), workflowpause.MemoKey: []byte("true")})
+	assert.IsType(t, &types.BadRequestError{}, err)
+	assert.EqualError(t, err, "Memo key __cadence_paused is reserved.")
+	assert.Error(t, ValidateMemo(map[string][]byte{activitycontrol.MemoKey: []byte("{}")}))
+	assert.Error(t, ValidateMemo(map[string][]byte{delayedsignal.MemoKey: []byte("{}")}))
+	assert.Error(t, ValidateMemo(map[string][]byte{signaldedup.MemoKey: []byte("{}")}))
+}
@@ -0,0 +1,132 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package rpc
+
+import (
+	"fmt"
+	"io"
+	"sync/atomic"
+
+	"go.uber.org/yarpc/api/transport"
+	yarpcgrpccompressor "go.uber.org/yarpc/compressor/grpc"
+	yarpcsnappy "go.uber.org/yarpc/compressor/snappy"
+	"google.golang.org/grpc/encoding"
+
+	"github.com/uber/cadence/common/metrics"
+)
+
+// CompressionSnappy is the name of the snappy compressor that can be used for cross cluster gRPC traffic
+const CompressionSnappy = "snappy"
+
+var (
+	// compressionScope is shared by all registered compressors, since gRPC compressors are process wide.
+	// It is a noop scope until SetCompressionMetricsClient is called.
+	compressionScope atomic.Pointer[metrics.Scope]
+
+	compressors = map[string]transport.Compressor{
+		CompressionSnappy: yarpcsnappy.New(),
+	}
+)
+
+func init() {
+	noopScope := metrics.NewNoopMetricsClient().Scope(metrics.RPCCompressionScope)
+	compressionScope.Store(&noopScope)
+
+	// compressors must be registered for every process, so that a remote cluster using compression
+	// for its requests can be served, and responses are compressed with the same compressor.
+	// gRPC negotiates the compressor per call through the grpc-encoding and grpc-accept-encoding headers.
+	for _, compressor := range compressors {
+		encoding.RegisterCompressor(&countingCompressor{
+			Compressor: yarpcgrpccompressor.New(compressor),
+		})
+	}
+}
+
+// SetCompressionMetricsClient sets the metrics client used to report bytes saved by gRPC compression
+func SetCompressionMetricsClient(metricsClient metrics.Client) {
+	scope := metricsClient.Scope(metrics.RPCCompressionScope)
+	compressionScope.Store(&scope)
+}
+
+func getCompressor(name string) (transport.Compressor, error) {
+	if name == "" {
+		return nil, nil
+	}
+	compressor, ok := compressors[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown rpc compression: %v", name)
+	}
+	return compressor, nil
+}
+
+// countingCompressor reports the number of bytes before and after compression
+type countingCompressor struct {
+	encoding.Compressor
+}
+
+func (c *countingCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	output := &countingWriter{Writer: w}
+	input, err := c.Compressor.Compress(output)
+	if err != nil {
+		return nil, err
+	}
+	return &compressingWriter{
+		WriteCloser: input,
+		output:      output,
+		name:        c.Name(),
+	}, nil
+}
+
+type countingWriter struct {
+	io.Writer
+	count int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.Writer.Write(p)
+	w.count += int64(n)
+	return n, err
+}
+
+type compressingWriter struct {
+	io.WriteCloser
+	output *countingWriter
+	name   string
+	count  int64
+}
+
+func (w *compressingWriter) Write(p []byte) (int, error) {
+	n, err := w.WriteCloser.Write(p)
+	w.count += int64(n)
+	return n, err
+}
+
+func (w *compressingWriter) Close() error {
+	// compressed output is only complete after the compressor is flushed
+	err := w.WriteCloser.Close()
+	scope := (*compressionScope.Load()).Tagged(metrics.CompressorTag(w.name))
+	scope.AddCounter(metrics.RPCCompressionInputBytes, w.count)
+	scope.AddCounter(metrics.RPCCompressionOutputBytes, w.output.count)
+	scope.AddCounter(metrics.RPCCompressionBytesSaved, w.count-w.output.count)
+	return err
+}
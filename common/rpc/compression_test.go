@@ -0,0 +1,83 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package rpc
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+	"google.golang.org/grpc/encoding"
+
+	"github.com/uber/cadence/common/metrics"
+)
+
+func TestGetCompressor(t *testing.T) {
+	compressor, err := getCompressor("")
+	assert.NoError(t, err)
+	assert.Nil(t, compressor)
+
+	compressor, err = getCompressor(CompressionSnappy)
+	assert.NoError(t, err)
+	assert.Equal(t, CompressionSnappy, compressor.Name())
+
+	_, err = getCompressor("unknown")
+	assert.Error(t, err)
+}
+
+func TestCountingCompressor(t *testing.T) {
+	metricScope := tally.NewTestScope("", nil)
+	SetCompressionMetricsClient(metrics.NewClient(metricScope, metrics.ServiceIdx(0), metrics.HistogramMigration{}))
+	defer SetCompressionMetricsClient(metrics.NewNoopMetricsClient())
+
+	compressor := encoding.GetCompressor(CompressionSnappy)
+	require.NotNil(t, compressor)
+
+	payload := bytes.Repeat([]byte("replication task payload "), 1000)
+	var compressed bytes.Buffer
+	w, err := compressor.Compress(&compressed)
+	require.NoError(t, err)
+	_, err = w.Write(payload)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	assert.Less(t, compressed.Len(), len(payload))
+
+	r, err := compressor.Decompress(&compressed)
+	require.NoError(t, err)
+	decompressed, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, payload, decompressed)
+
+	counters := metricScope.Snapshot().Counters()
+	input := counters["rpc_compression_input_bytes+compressor=snappy,operation=RPCCompression"]
+	output := counters["rpc_compression_output_bytes+compressor=snappy,operation=RPCCompression"]
+	saved := counters["rpc_compression_bytes_saved+compressor=snappy,operation=RPCCompression"]
+	require.NotNil(t, input)
+	require.NotNil(t, output)
+	require.NotNil(t, saved)
+	assert.Equal(t, int64(len(payload)), input.Value())
+	assert.Equal(t, input.Value()-output.Value(), saved.Value())
+}
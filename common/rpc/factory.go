@@ -29,6 +29,7 @@ import (
 	"sync"
 
 	"go.uber.org/yarpc"
+	yarpctransport "go.uber.org/yarpc/api/transport"
 	"go.uber.org/yarpc/transport/grpc"
 	yarpchttp "go.uber.org/yarpc/transport/http"
 	"go.uber.org/yarpc/transport/tchannel"
@@ -240,10 +241,13 @@ func (d *FactoryImpl) listenMembershipChanges(svc string, ch chan *membership.Ch
 	}
 }
 
-func createDialer(transport *grpc.Transport, tlsConfig *tls.Config) *grpc.Dialer {
+func createDialer(transport *grpc.Transport, tlsConfig *tls.Config, compressor yarpctransport.Compressor) *grpc.Dialer {
 	var dialOptions []grpc.DialOption
 	if tlsConfig != nil {
 		dialOptions = append(dialOptions, grpc.DialerCredentials(credentials.NewTLS(tlsConfig)))
 	}
+	if compressor != nil {
+		dialOptions = append(dialOptions, grpc.Compressor(compressor))
+	}
 	return transport.NewDialer(dialOptions...)
 }
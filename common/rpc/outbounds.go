@@ -180,7 +180,11 @@ func (b crossDCOutbounds) Build(grpcTransport *grpc.Transport, tchannelTransport
 			if err != nil {
 				return nil, err
 			}
-			peerChooser, err := b.pcf.CreatePeerChooser(createDialer(grpcTransport, tlsConfig), PeerChooserOptions{Address: clusterInfo.RPCAddress})
+			compressor, err := getCompressor(clusterInfo.RPCCompression)
+			if err != nil {
+				return nil, err
+			}
+			peerChooser, err := b.pcf.CreatePeerChooser(createDialer(grpcTransport, tlsConfig, compressor), PeerChooserOptions{Address: clusterInfo.RPCAddress})
 			if err != nil {
 				return nil, err
 			}
@@ -231,7 +235,7 @@ func (o directOutbound) Build(grpc *grpc.Transport, tchannel *tchannel.Transport
 	var err error
 	var directChooser PeerChooser
 	if o.grpcEnabled {
-		directChooser, err = o.pcf.CreatePeerChooser(createDialer(grpc, o.tlsConfig), opts)
+		directChooser, err = o.pcf.CreatePeerChooser(createDialer(grpc, o.tlsConfig, nil), opts)
 		if err != nil {
 			return nil, err
 		}
@@ -91,6 +91,9 @@ func NewParams(serviceName string, config *config.Config, dc *dynamicconfig.Coll
 		}
 	}
 
+	// gRPC compressors are registered process wide, so their metrics client is also set process wide
+	SetCompressionMetricsClient(metricsCl)
+
 	enableGRPCOutbound := dc.GetBoolProperty(dynamicproperties.EnableGRPCOutbound)()
 
 	publicClientOutbound, err := newPublicClientOutbound(config)
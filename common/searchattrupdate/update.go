@@ -0,0 +1,64 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package searchattrupdate defines how search attributes of a running workflow are updated from outside of it.
+//
+// Workflows upsert their own search attributes through a decision. To update them without involving the
+// workflow, it is signaled with SignalName and the search attributes to upsert as input. Mutable state
+// merges them into the search attributes of the execution when the signal is applied, the same way as an
+// upsert decision, so the update is recorded in history and replicated like any other signal. The signal
+// doesn't schedule a decision task. It is meant for batch operations and resets, so the frontend only
+// accepts it from callers with admin permission on the domain.
+package searchattrupdate
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/uber/cadence/common/types"
+)
+
+// SignalName is the name of the signal updating the search attributes of a workflow
+const SignalName = "__cadence_upsert_search_attributes"
+
+// IsSignal returns whether the given signal name is the name of the search attributes update signal
+func IsSignal(signalName string) bool {
+	return signalName == SignalName
+}
+
+// EncodeRequest returns the signal input for upserting the given search attributes
+func EncodeRequest(searchAttributes *types.SearchAttributes) ([]byte, error) {
+	return json.Marshal(searchAttributes)
+}
+
+// DecodeRequest returns the search attributes to upsert from the given signal input
+func DecodeRequest(input []byte) (*types.SearchAttributes, error) {
+	searchAttributes := &types.SearchAttributes{}
+	if err := json.Unmarshal(input, searchAttributes); err != nil {
+		return nil, fmt.Errorf("invalid search attributes update request: %w", err)
+	}
+	if len(searchAttributes.GetIndexedFields()) == 0 {
+		return nil, errors.New("invalid search attributes update request: no search attributes to update")
+	}
+	return searchAttributes, nil
+}
@@ -0,0 +1,60 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package searchattrupdate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/types"
+)
+
+func TestEncodeDecodeRequest(t *testing.T) {
+	searchAttributes := &types.SearchAttributes{IndexedFields: map[string][]byte{"CustomKeywordField": []byte(`"value"`)}}
+	input, err := EncodeRequest(searchAttributes)
+	require.NoError(t, err)
+
+	decoded, err := DecodeRequest(input)
+	require.NoError(t, err)
+	assert.Equal(t, searchAttributes, decoded)
+}
+
+func TestDecodeRequest_Invalid(t *testing.T) {
+	for name, input := range map[string][]byte{
+		"malformed": []byte("{"),
+		"empty":     []byte("{}"),
+		"nil":       nil,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := DecodeRequest(input)
+			assert.ErrorContains(t, err, "invalid search attributes update request")
+		})
+	}
+}
+
+func TestIsSignal(t *testing.T) {
+	assert.True(t, IsSignal(SignalName))
+	assert.False(t, IsSignal("other"))
+}
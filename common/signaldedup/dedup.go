@@ -0,0 +1,176 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package signaldedup defines the signal deduplication window of a workflow.
+//
+// Signals are deduplicated by request ID against the signal requested IDs of mutable state, which only
+// cover the current run and are cleared once the signal is processed. When the window is enabled for a
+// domain, the active cluster sets a Window in the memo of the workflow started event under MemoKey, with
+// the length of the window and the maximum number of request IDs it keeps. The request IDs of the signals
+// applied to the run are then added to the window, together with the time of the signal. Only the window
+// of the started event and the signaled events are used for that, so every cluster keeps the same request
+// IDs, and changing the window of a domain applies to the runs started afterwards.
+//
+// On continue as new, retry and cron, the request IDs of the window are carried over to the started
+// event of the next run. On reset, the window is rebuilt from the history of the reset run, so it only
+// holds the request IDs of the signals before the reset point, as signals are reapplied without one.
+package signaldedup
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+)
+
+// MemoKey is the memo field holding the signal deduplication window of a workflow
+const MemoKey = "__cadence_signal_dedup"
+
+type (
+	// Window is the signal deduplication window of a run
+	Window struct {
+		LengthNano    int64      `json:"lengthNano"`
+		MaxRequestIDs int        `json:"maxRequestIDs"`
+		RequestIDs    RequestIDs `json:"requestIDs,omitempty"`
+	}
+
+	// RequestIDs are the signal request IDs of a workflow, with the time of the signal in nanoseconds
+	RequestIDs map[string]int64
+)
+
+// Get returns the signal deduplication window kept in the given memo
+func Get(memo map[string][]byte) *Window {
+	value, ok := memo[MemoKey]
+	if !ok {
+		return nil
+	}
+	window := &Window{}
+	if err := json.Unmarshal(value, window); err != nil || window.LengthNano <= 0 || window.MaxRequestIDs <= 0 {
+		return nil
+	}
+	return window
+}
+
+// IsDuplicate returns whether a signal with the given request ID was applied within the window
+func IsDuplicate(memo map[string][]byte, requestID string, nowNano int64) bool {
+	window := Get(memo)
+	if requestID == "" || window == nil {
+		return false
+	}
+	signalTimeNano, ok := window.RequestIDs[requestID]
+	return ok && nowNano-signalTimeNano < window.LengthNano
+}
+
+// Start returns the memo of the started event of a run, with a window of the given length and size
+// holding the request IDs of the previous run, if any. The window is removed when it is disabled.
+// The given memos are never modified, as they may be shared with the events they come from.
+func Start(
+	memo map[string][]byte,
+	previousMemo map[string][]byte,
+	nowNano int64,
+	length time.Duration,
+	maxRequestIDs int,
+) map[string][]byte {
+	if length <= 0 || maxRequestIDs <= 0 {
+		if _, ok := memo[MemoKey]; !ok {
+			return memo
+		}
+		result := copyMemo(memo)
+		delete(result, MemoKey)
+		return result
+	}
+	window := &Window{
+		LengthNano:    length.Nanoseconds(),
+		MaxRequestIDs: maxRequestIDs,
+		RequestIDs:    RequestIDs{},
+	}
+	for _, previous := range []*Window{Get(memo), Get(previousMemo)} {
+		if previous == nil {
+			continue
+		}
+		for requestID, signalTimeNano := range previous.RequestIDs {
+			if signalTimeNano > window.RequestIDs[requestID] {
+				window.RequestIDs[requestID] = signalTimeNano
+			}
+		}
+	}
+	result, _ := window.set(memo, nowNano)
+	return result
+}
+
+// Record returns the memo resulting from remembering the given request ID, and whether the memo changed.
+// Nothing is remembered without a window. Request IDs older than the window are dropped, and so are the
+// oldest ones beyond the maximum number of request IDs of the window.
+// The given memo is never modified, as it may be shared with the workflow started event.
+func Record(
+	memo map[string][]byte,
+	requestID string,
+	signalTimeNano int64,
+) (map[string][]byte, bool) {
+	window := Get(memo)
+	if requestID == "" || window == nil {
+		return memo, false
+	}
+	if window.RequestIDs == nil {
+		window.RequestIDs = RequestIDs{}
+	}
+	window.RequestIDs[requestID] = signalTimeNano
+	return window.set(memo, signalTimeNano)
+}
+
+func (w *Window) set(memo map[string][]byte, nowNano int64) (map[string][]byte, bool) {
+	for requestID, signalTimeNano := range w.RequestIDs {
+		if nowNano-signalTimeNano >= w.LengthNano {
+			delete(w.RequestIDs, requestID)
+		}
+	}
+	if len(w.RequestIDs) > w.MaxRequestIDs {
+		ordered := make([]string, 0, len(w.RequestIDs))
+		for requestID := range w.RequestIDs {
+			ordered = append(ordered, requestID)
+		}
+		sort.Slice(ordered, func(i, j int) bool {
+			if w.RequestIDs[ordered[i]] != w.RequestIDs[ordered[j]] {
+				return w.RequestIDs[ordered[i]] < w.RequestIDs[ordered[j]]
+			}
+			return ordered[i] < ordered[j]
+		})
+		for _, requestID := range ordered[:len(ordered)-w.MaxRequestIDs] {
+			delete(w.RequestIDs, requestID)
+		}
+	}
+
+	value, err := json.Marshal(w)
+	if err != nil {
+		return memo, false
+	}
+	result := copyMemo(memo)
+	result[MemoKey] = value
+	return result, true
+}
+
+func copyMemo(memo map[string][]byte) map[string][]byte {
+	result := make(map[string][]byte, len(memo)+1)
+	for k, v := range memo {
+		result[k] = v
+	}
+	return result
+}
@@ -0,0 +1,104 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package signaldedup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordAndIsDuplicate(t *testing.T) {
+	now := time.Unix(1000, 0).UnixNano()
+	memo := Start(map[string][]byte{"key": []byte("value")}, nil, now, time.Minute, 10)
+
+	result, changed := Record(memo, "request-1", now)
+	assert.True(t, changed)
+	assert.Empty(t, Get(memo).RequestIDs, "memo must not be modified")
+	assert.Equal(t, []byte("value"), result["key"])
+
+	assert.True(t, IsDuplicate(result, "request-1", now+int64(time.Second)))
+	assert.False(t, IsDuplicate(result, "request-1", now+int64(time.Minute)))
+	assert.False(t, IsDuplicate(result, "request-2", now))
+	assert.False(t, IsDuplicate(result, "", now))
+}
+
+func TestRecord_NoWindow(t *testing.T) {
+	memo := map[string][]byte{}
+
+	result, changed := Record(memo, "request-1", 1)
+	assert.False(t, changed)
+	assert.Equal(t, memo, result)
+	assert.False(t, IsDuplicate(result, "request-1", 1))
+
+	memo = Start(memo, nil, 1, time.Minute, 10)
+	result, changed = Record(memo, "", 1)
+	assert.False(t, changed)
+	assert.Equal(t, memo, result)
+}
+
+func TestRecord_DropsExpiredAndOldest(t *testing.T) {
+	now := time.Unix(1000, 0).UnixNano()
+	memo := Start(nil, nil, now, time.Minute, 2)
+	memo, _ = Record(memo, "expired", now-int64(2*time.Minute))
+	memo, _ = Record(memo, "oldest", now-int64(30*time.Second))
+	memo, _ = Record(memo, "older", now-int64(10*time.Second))
+	memo, _ = Record(memo, "latest", now)
+
+	assert.Equal(t, &Window{
+		LengthNano:    int64(time.Minute),
+		MaxRequestIDs: 2,
+		RequestIDs: RequestIDs{
+			"older":  now - int64(10*time.Second),
+			"latest": now,
+		},
+	}, Get(memo))
+}
+
+func TestStart(t *testing.T) {
+	now := time.Unix(1000, 0).UnixNano()
+	previousMemo := Start(nil, nil, now, time.Hour, 10)
+	previousMemo, _ = Record(previousMemo, "expired", now-int64(2*time.Minute))
+	previousMemo, _ = Record(previousMemo, "request-1", now-int64(time.Second))
+	memo := map[string][]byte{"key": []byte("value")}
+
+	result := Start(memo, previousMemo, now, time.Minute, 10)
+	assert.NotContains(t, memo, MemoKey, "memo must not be modified")
+	assert.Equal(t, []byte("value"), result["key"])
+	assert.Equal(t, &Window{
+		LengthNano:    int64(time.Minute),
+		MaxRequestIDs: 10,
+		RequestIDs:    RequestIDs{"request-1": now - int64(time.Second)},
+	}, Get(result))
+
+	// the window is removed once disabled, including the one of a retried or cron run
+	assert.Equal(t, memo, Start(memo, previousMemo, now, 0, 10))
+	assert.Equal(t, memo, Start(result, previousMemo, now, time.Minute, 0))
+}
+
+func TestGet_Malformed(t *testing.T) {
+	assert.Nil(t, Get(map[string][]byte{MemoKey: []byte("not json")}))
+	assert.Nil(t, Get(map[string][]byte{MemoKey: []byte("{}")}))
+	assert.Nil(t, Get(nil))
+}
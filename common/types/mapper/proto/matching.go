@@ -458,6 +458,7 @@ func FromMatchingPollForActivityTaskResponse(t *types.MatchingPollForActivityTas
 		PartitionConfig:            FromTaskListPartitionConfig(t.PartitionConfig),
 		LoadBalancerHints:          FromLoadBalancerHints(t.LoadBalancerHints),
 		AutoConfigHint:             FromAutoConfigHint(t.AutoConfigHint),
+		HopTrace:                   t.HopTrace,
 	}
 }
 
@@ -485,6 +486,7 @@ func ToMatchingPollForActivityTaskResponse(t *matchingv1.PollForActivityTaskResp
 		PartitionConfig:                 ToTaskListPartitionConfig(t.PartitionConfig),
 		LoadBalancerHints:               ToLoadBalancerHints(t.LoadBalancerHints),
 		AutoConfigHint:                  ToAutoConfigHint(t.AutoConfigHint),
+		HopTrace:                        t.HopTrace,
 	}
 }
 
@@ -540,6 +542,7 @@ func FromMatchingPollForDecisionTaskResponse(t *types.MatchingPollForDecisionTas
 		PartitionConfig:           FromTaskListPartitionConfig(t.PartitionConfig),
 		LoadBalancerHints:         FromLoadBalancerHints(t.LoadBalancerHints),
 		AutoConfigHint:            FromAutoConfigHint(t.AutoConfigHint),
+		HopTrace:                  t.HopTrace,
 	}
 }
 
@@ -569,6 +572,7 @@ func ToMatchingPollForDecisionTaskResponse(t *matchingv1.PollForDecisionTaskResp
 		PartitionConfig:           ToTaskListPartitionConfig(t.PartitionConfig),
 		LoadBalancerHints:         ToLoadBalancerHints(t.LoadBalancerHints),
 		AutoConfigHint:            ToAutoConfigHint(t.AutoConfigHint),
+		HopTrace:                  t.HopTrace,
 	}
 }
 
@@ -258,7 +258,8 @@ func TestMatchingPollForDecisionResponse(t *testing.T) {
 		opt := cmpopts.IgnoreFields(types.WorkflowExecutionStartedEventAttributes{}, "ParentWorkflowDomainID")
 		opt2 := cmpopts.IgnoreFields(types.MatchingPollForDecisionTaskResponse{}, "PartitionConfig")
 		opt3 := cmpopts.IgnoreFields(types.MatchingPollForDecisionTaskResponse{}, "LoadBalancerHints")
-		if diff := cmp.Diff(tc.input, roundTripObj, opt, opt2, opt3); diff != "" {
+		opt4 := cmpopts.IgnoreFields(types.MatchingPollForDecisionTaskResponse{}, "HopTrace")
+		if diff := cmp.Diff(tc.input, roundTripObj, opt, opt2, opt3, opt4); diff != "" {
 			t.Fatalf("Mismatch (-want +got):\n%s", diff)
 		}
 	}
@@ -287,7 +288,8 @@ func TestMatchingPollForActivityTaskResponse(t *testing.T) {
 		roundTripObj := ToMatchingPollForActivityTaskResponse(thriftObj)
 		opt := cmpopts.IgnoreFields(types.MatchingPollForActivityTaskResponse{}, "PartitionConfig")
 		opt2 := cmpopts.IgnoreFields(types.MatchingPollForActivityTaskResponse{}, "LoadBalancerHints")
-		if diff := cmp.Diff(tc.input, roundTripObj, opt, opt2); diff != "" {
+		opt3 := cmpopts.IgnoreFields(types.MatchingPollForActivityTaskResponse{}, "HopTrace")
+		if diff := cmp.Diff(tc.input, roundTripObj, opt, opt2, opt3); diff != "" {
 			t.Fatalf("Mismatch (-want +got):\n%s", diff)
 		}
 	}
@@ -451,6 +451,7 @@ type MatchingPollForDecisionTaskResponse struct {
 	PartitionConfig           *TaskListPartitionConfig
 	LoadBalancerHints         *LoadBalancerHints
 	AutoConfigHint            *AutoConfigHint
+	HopTrace                  string `json:"hopTrace,omitempty"`
 }
 
 // GetWorkflowExecution is an internal getter (TBD...)
@@ -522,6 +523,7 @@ type MatchingPollForActivityTaskResponse struct {
 	PartitionConfig                 *TaskListPartitionConfig
 	LoadBalancerHints               *LoadBalancerHints
 	AutoConfigHint                  *AutoConfigHint
+	HopTrace                        string `json:"hopTrace,omitempty"`
 }
 
 // MatchingQueryWorkflowRequest is an internal type (TBD...)
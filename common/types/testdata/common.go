@@ -86,6 +86,8 @@ const (
 	Version3 = int64(33)
 
 	IsolationGroup = "dca1"
+
+	HopTrace = "2:s:10,1:b:20"
 )
 
 var (
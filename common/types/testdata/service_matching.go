@@ -128,6 +128,7 @@ var (
 		PartitionConfig:                 &TaskListPartitionConfig,
 		LoadBalancerHints:               &LoadBalancerHints,
 		AutoConfigHint:                  &AutoConfigHint,
+		HopTrace:                        HopTrace,
 	}
 	MatchingPollForDecisionTaskRequest = types.MatchingPollForDecisionTaskRequest{
 		DomainUUID:     DomainID,
@@ -157,6 +158,7 @@ var (
 		PartitionConfig:           &TaskListPartitionConfig,
 		LoadBalancerHints:         &LoadBalancerHints,
 		AutoConfigHint:            &AutoConfigHint,
+		HopTrace:                  HopTrace,
 	}
 	MatchingQueryWorkflowRequest = types.MatchingQueryWorkflowRequest{
 		DomainUUID:    DomainID,
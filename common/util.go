@@ -999,3 +999,13 @@ func NewPerTaskListScope(
 	}
 	return client.Scope(scopeIdx, domainTag, taskListTag)
 }
+
+// HostFromWorkerIdentity returns the host of a worker from its identity. Client libraries default the identity
+// to "pid@host" or "pid@host@tasklist", an empty string is returned for identities that don't follow this format.
+func HostFromWorkerIdentity(identity string) string {
+	parts := strings.Split(identity, "@")
+	if len(parts) < 2 {
+		return ""
+	}
+	return parts[1]
+}
@@ -1704,3 +1704,21 @@ func TestCheckEventBlobSizeLimit(t *testing.T) {
 		})
 	}
 }
+
+func TestHostFromWorkerIdentity(t *testing.T) {
+	tests := map[string]struct {
+		identity string
+		want     string
+	}{
+		"go client":        {identity: "123@host-a@tasklist", want: "host-a"},
+		"java client":      {identity: "123@host-a", want: "host-a"},
+		"custom identity":  {identity: "my-worker", want: ""},
+		"empty identity":   {identity: "", want: ""},
+		"empty host field": {identity: "123@", want: ""},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, HostFromWorkerIdentity(tc.identity))
+		})
+	}
+}
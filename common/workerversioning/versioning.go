@@ -0,0 +1,84 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package workerversioning contains the helpers shared by history and matching to route
+// decision tasks only to pollers running a build compatible with the workflow.
+//
+// A worker declares its build ID through the binary checksum of its decision task polls.
+// The build that most recently completed a decision task of a workflow is recorded in the
+// workflow's auto-reset points. Build IDs are grouped into compatible build sets through the
+// domain-filtered WorkerVersioningBuildSets dynamic config: promoting build B to be compatible
+// with build A is done by mapping both to the same set, retiring a build is done by marking it
+// as a bad binary of the domain. Like isolation groups, the decision tasks of a build set that
+// has no recent pollers are dispatched to any poller so that their workflows don't get stuck.
+package workerversioning
+
+import (
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// BuildSetKey is the partition config key carrying the build set a decision task may only be dispatched to
+	BuildSetKey = "worker-build-set"
+)
+
+// GetBuildSet returns the name of the compatible build set the given build ID belongs to.
+// Build IDs that haven't been assigned to a set form a set of their own.
+func GetBuildSet(buildSets map[string]interface{}, buildID string) string {
+	if buildID == "" {
+		return ""
+	}
+	if set, ok := buildSets[buildID].(string); ok && set != "" {
+		return set
+	}
+	return buildID
+}
+
+// GetWorkflowBuildID returns the build ID that most recently completed a decision task of the
+// workflow, skipping builds that have been retired through the domain's bad binaries.
+// An empty string is returned when the workflow isn't bound to any build yet.
+func GetWorkflowBuildID(resetPoints *types.ResetPoints, badBinaries *types.BadBinaries) string {
+	if resetPoints == nil || len(resetPoints.Points) == 0 {
+		return ""
+	}
+	buildID := resetPoints.Points[len(resetPoints.Points)-1].GetBinaryChecksum()
+	if badBinaries != nil {
+		if _, ok := badBinaries.Binaries[buildID]; ok {
+			return ""
+		}
+	}
+	return buildID
+}
+
+// WithBuildSet returns a copy of the partition config with the build set the task is pinned to.
+// The original partition config is returned as is when there is no build set.
+func WithBuildSet(partitionConfig map[string]string, buildSet string) map[string]string {
+	if buildSet == "" {
+		return partitionConfig
+	}
+	result := make(map[string]string, len(partitionConfig)+1)
+	for k, v := range partitionConfig {
+		result[k] = v
+	}
+	result[BuildSetKey] = buildSet
+	return result
+}
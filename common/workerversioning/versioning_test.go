@@ -0,0 +1,102 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package workerversioning
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/types"
+)
+
+func TestGetBuildSet(t *testing.T) {
+	buildSets := map[string]interface{}{
+		"build-a": "set-1",
+		"build-b": "set-1",
+		"build-c": 123,
+	}
+	tests := map[string]struct {
+		buildID string
+		want    string
+	}{
+		"no build ID":            {buildID: "", want: ""},
+		"build in a set":         {buildID: "build-b", want: "set-1"},
+		"build not in any set":   {buildID: "build-d", want: "build-d"},
+		"invalid set is skipped": {buildID: "build-c", want: "build-c"},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, GetBuildSet(buildSets, tc.buildID))
+		})
+	}
+}
+
+func TestGetWorkflowBuildID(t *testing.T) {
+	resetPoints := &types.ResetPoints{
+		Points: []*types.ResetPointInfo{
+			{BinaryChecksum: "build-a"},
+			{BinaryChecksum: "build-b"},
+		},
+	}
+	tests := map[string]struct {
+		resetPoints *types.ResetPoints
+		badBinaries *types.BadBinaries
+		want        string
+	}{
+		"no reset points": {
+			resetPoints: nil,
+			want:        "",
+		},
+		"latest build": {
+			resetPoints: resetPoints,
+			want:        "build-b",
+		},
+		"latest build retired": {
+			resetPoints: resetPoints,
+			badBinaries: &types.BadBinaries{Binaries: map[string]*types.BadBinaryInfo{"build-b": {}}},
+			want:        "",
+		},
+		"other build retired": {
+			resetPoints: resetPoints,
+			badBinaries: &types.BadBinaries{Binaries: map[string]*types.BadBinaryInfo{"build-a": {}}},
+			want:        "build-b",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, GetWorkflowBuildID(tc.resetPoints, tc.badBinaries))
+		})
+	}
+}
+
+func TestWithBuildSet(t *testing.T) {
+	partitionConfig := map[string]string{"isolation-group": "zone-1"}
+
+	assert.Equal(t, partitionConfig, WithBuildSet(partitionConfig, ""))
+	assert.Nil(t, WithBuildSet(nil, ""))
+
+	result := WithBuildSet(partitionConfig, "set-1")
+	assert.Equal(t, map[string]string{"isolation-group": "zone-1", BuildSetKey: "set-1"}, result)
+	assert.Equal(t, map[string]string{"isolation-group": "zone-1"}, partitionConfig, "original partition config must not be modified")
+}
@@ -0,0 +1,139 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package workflowpause defines how workflow executions are paused and resumed.
+//
+// A workflow is paused by signaling it with PauseSignalName and resumed by signaling it with
+// UnpauseSignalName, so that pausing is recorded in workflow history and replicated like any other
+// signal. Mutable state derives the paused state from these signals and keeps it in the execution
+// memo under MemoKey, which makes it visible in DescribeWorkflowExecution and in visibility records.
+// While a workflow is paused, history doesn't dispatch its decision and activity tasks and doesn't
+// fire its user timers, activity timeouts and activity retries. Mutable state regenerates the skipped
+// tasks when it applies the resume signal, on active and standby clusters alike. The workflow backoff
+// timer and the workflow execution timeout keep running; a decision task scheduled by the backoff timer
+// is dispatched once the workflow is resumed.
+package workflowpause
+
+import (
+	"encoding/json"
+
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// PauseSignalName is the name of the signal pausing a workflow
+	PauseSignalName = "__cadence_pause"
+	// UnpauseSignalName is the name of the signal resuming a paused workflow
+	UnpauseSignalName = "__cadence_unpause"
+	// MemoKey is the memo field holding the Info of a paused workflow
+	MemoKey = "__cadence_paused"
+)
+
+type (
+	// Request is the input of the pause and unpause signals
+	Request struct {
+		Reason string `json:"reason,omitempty"`
+	}
+
+	// Info describes why and when a workflow was paused
+	Info struct {
+		Reason         string `json:"reason,omitempty"`
+		Identity       string `json:"identity,omitempty"`
+		PausedTimeNano int64  `json:"pausedTimeNano"`
+	}
+)
+
+// IsSignal returns whether the given signal name is the name of a pause or unpause signal
+func IsSignal(signalName string) bool {
+	return signalName == PauseSignalName || signalName == UnpauseSignalName
+}
+
+// IsPaused returns whether the workflow with the given memo is paused
+func IsPaused(memo map[string][]byte) bool {
+	_, ok := memo[MemoKey]
+	return ok
+}
+
+// GetInfo returns the pause info kept in the given memo, and whether the workflow is paused
+func GetInfo(memo map[string][]byte) (*Info, bool) {
+	value, ok := memo[MemoKey]
+	if !ok {
+		return nil, false
+	}
+	info := &Info{}
+	if err := json.Unmarshal(value, info); err != nil {
+		// the workflow is still paused, only the details are unreadable
+		return &Info{}, true
+	}
+	return info, true
+}
+
+// EncodeRequest returns the signal input for the given pause or unpause reason
+func EncodeRequest(reason string) ([]byte, error) {
+	return json.Marshal(Request{Reason: reason})
+}
+
+// Apply applies the given signaled event to the given memo and returns the resulting memo, and
+// whether the paused state changed. The given memo is never modified, as it may be shared with the
+// workflow started event. Events other than pause and unpause signals are ignored.
+func Apply(memo map[string][]byte, event *types.HistoryEvent) (map[string][]byte, bool) {
+	attributes := event.GetWorkflowExecutionSignaledEventAttributes()
+	if attributes == nil {
+		return memo, false
+	}
+	switch attributes.SignalName {
+	case PauseSignalName:
+		if IsPaused(memo) {
+			return memo, false
+		}
+		request := Request{}
+		// a malformed input still pauses the workflow, it only loses the reason
+		_ = json.Unmarshal(attributes.Input, &request)
+		value, err := json.Marshal(Info{
+			Reason:         request.Reason,
+			Identity:       attributes.Identity,
+			PausedTimeNano: event.GetTimestamp(),
+		})
+		if err != nil {
+			return memo, false
+		}
+		result := copyMemo(memo)
+		result[MemoKey] = value
+		return result, true
+	case UnpauseSignalName:
+		if !IsPaused(memo) {
+			return memo, false
+		}
+		result := copyMemo(memo)
+		delete(result, MemoKey)
+		return result, true
+	}
+	return memo, false
+}
+
+func copyMemo(memo map[string][]byte) map[string][]byte {
+	result := make(map[string][]byte, len(memo)+1)
+	for k, v := range memo {
+		result[k] = v
+	}
+	return result
+}
@@ -0,0 +1,102 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package workflowpause
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/types"
+)
+
+func signaled(signalName string, input []byte, timestamp int64) *types.HistoryEvent {
+	return &types.HistoryEvent{
+		Timestamp: &timestamp,
+		WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{
+			SignalName: signalName,
+			Input:      input,
+			Identity:   "operator",
+		},
+	}
+}
+
+func TestIsSignal(t *testing.T) {
+	assert.True(t, IsSignal(PauseSignalName))
+	assert.True(t, IsSignal(UnpauseSignalName))
+	assert.False(t, IsSignal("pause"))
+}
+
+func TestApply(t *testing.T) {
+	input, err := EncodeRequest("investigating incident")
+	require.NoError(t, err)
+	startMemo := map[string][]byte{"owner": []byte("payments")}
+
+	memo, changed := Apply(startMemo, signaled(PauseSignalName, input, 100))
+	assert.True(t, changed)
+	assert.True(t, IsPaused(memo))
+	assert.False(t, IsPaused(startMemo), "the given memo must not be modified")
+	assert.Equal(t, []byte("payments"), memo["owner"])
+	info, ok := GetInfo(memo)
+	require.True(t, ok)
+	assert.Equal(t, &Info{Reason: "investigating incident", Identity: "operator", PausedTimeNano: 100}, info)
+
+	// pausing a paused workflow keeps the original pause info
+	again, changed := Apply(memo, signaled(PauseSignalName, nil, 200))
+	assert.False(t, changed)
+	info, _ = GetInfo(again)
+	assert.Equal(t, int64(100), info.PausedTimeNano)
+
+	unpaused, changed := Apply(memo, signaled(UnpauseSignalName, nil, 300))
+	assert.True(t, changed)
+	assert.False(t, IsPaused(unpaused))
+	assert.True(t, IsPaused(memo), "the given memo must not be modified")
+	assert.Equal(t, []byte("payments"), unpaused["owner"])
+
+	_, changed = Apply(unpaused, signaled(UnpauseSignalName, nil, 400))
+	assert.False(t, changed)
+
+	_, changed = Apply(unpaused, signaled("other", nil, 500))
+	assert.False(t, changed)
+
+	_, changed = Apply(unpaused, &types.HistoryEvent{})
+	assert.False(t, changed)
+}
+
+func TestApply_MalformedInput(t *testing.T) {
+	memo, changed := Apply(nil, signaled(PauseSignalName, []byte("not json"), 100))
+	assert.True(t, changed)
+	info, ok := GetInfo(memo)
+	require.True(t, ok)
+	assert.Equal(t, "", info.Reason)
+}
+
+func TestGetInfo(t *testing.T) {
+	_, ok := GetInfo(nil)
+	assert.False(t, ok)
+
+	info, ok := GetInfo(map[string][]byte{MemoKey: []byte("not json")})
+	assert.True(t, ok)
+	assert.Equal(t, &Info{}, info)
+}
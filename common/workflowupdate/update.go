@@ -0,0 +1,283 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package workflowupdate defines how synchronous workflow updates are recorded in workflow history.
+//
+// An update is requested by signaling the workflow with a signal named after the update, using
+// SignalName, and with the update ID as the signal request ID. The workflow learns about the update on
+// its next decision task, like any other signal, and answers it by recording markers whose header
+// carries the update ID: an accepted marker once the update passed validation, then a completed marker
+// with the result, or a rejected marker with the reason of the rejection.
+//
+// Mutable state derives the stage of every update from these events and keeps it in the execution
+// memo under MemoKey, apart from the signal requested IDs, so that it is replicated with the events
+// and survives the removal of the signal request IDs. Updates are deduplicated by update ID against
+// this state, markers are only accepted for the updates it holds, and it is carried over to the next
+// run on continue as new. Callers wait for the outcome of an update by querying the workflow with
+// ResultQueryType, which history answers from this state without involving the workflow.
+package workflowupdate
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// SignalNamePrefix is the prefix of the name of the signals requesting an update
+	SignalNamePrefix = "__cadence_update:"
+	// AcceptedMarkerName is the name of the marker recorded once an update passed validation
+	AcceptedMarkerName = "__cadence_update_accepted"
+	// CompletedMarkerName is the name of the marker recorded with the result of an update
+	CompletedMarkerName = "__cadence_update_completed"
+	// RejectedMarkerName is the name of the marker recorded with the reason an update was rejected
+	RejectedMarkerName = "__cadence_update_rejected"
+	// IDHeaderKey is the marker header field carrying the ID of the update the marker answers
+	IDHeaderKey = "cadence-update-id"
+	// MemoKey is the memo field holding the Updates of a workflow
+	MemoKey = "__cadence_updates"
+	// ResultQueryType is the type of the query answered by history with the Outcome of the update whose ID
+	// is the query argument, once the update completed or was rejected
+	ResultQueryType = "__cadence_update_result"
+	// MaxFinished is the number of completed or rejected updates kept for deduplication and result lookups,
+	// older ones are dropped
+	MaxFinished = 50
+)
+
+type (
+	// Stage is how far an update went
+	Stage int
+
+	// Outcome is the outcome of an update, as returned by the result query
+	Outcome struct {
+		Stage Stage `json:"stage"`
+		// Result is the result of a completed update, or the reason of a rejected update
+		Result []byte `json:"result,omitempty"`
+	}
+
+	// Update is the state of an update requested in a workflow
+	Update struct {
+		Name string `json:"name"`
+		Outcome
+		RequestedTimeNano int64 `json:"requestedTimeNano"`
+		FinishedTimeNano  int64 `json:"finishedTimeNano,omitempty"`
+	}
+
+	// Updates are the updates requested in a workflow, by update ID
+	Updates map[string]*Update
+)
+
+const (
+	// StageRequested means that the workflow hasn't answered the update yet
+	StageRequested Stage = iota
+	// StageAccepted means that the update passed validation but hasn't completed yet
+	StageAccepted
+	// StageCompleted means that the update completed
+	StageCompleted
+	// StageRejected means that the update was rejected by the workflow
+	StageRejected
+)
+
+// SignalName returns the name of the signal requesting the given update
+func SignalName(updateName string) string {
+	return SignalNamePrefix + updateName
+}
+
+// UpdateName returns the name of the update requested by the given signal, and whether the signal requests an update
+func UpdateName(signalName string) (string, bool) {
+	if !strings.HasPrefix(signalName, SignalNamePrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(signalName, SignalNamePrefix), true
+}
+
+// IsSignal returns whether the given signal name is the name of a signal requesting an update
+func IsSignal(signalName string) bool {
+	_, ok := UpdateName(signalName)
+	return ok
+}
+
+// IsMarker returns whether the given marker name is the name of an update marker
+func IsMarker(markerName string) bool {
+	switch markerName {
+	case AcceptedMarkerName, CompletedMarkerName, RejectedMarkerName:
+		return true
+	}
+	return false
+}
+
+// UpdateID returns the ID of the update answered by the marker with the given header
+func UpdateID(markerHeader *types.Header) string {
+	if markerHeader == nil {
+		return ""
+	}
+	return string(markerHeader.Fields[IDHeaderKey])
+}
+
+// Get returns the updates kept in the given memo
+func Get(memo map[string][]byte) Updates {
+	value, ok := memo[MemoKey]
+	if !ok {
+		return nil
+	}
+	updates := Updates{}
+	if err := json.Unmarshal(value, &updates); err != nil {
+		return nil
+	}
+	return updates
+}
+
+// CanAnswer returns whether the update with the given ID was requested and is still waiting for an answer
+func CanAnswer(memo map[string][]byte, updateID string) bool {
+	update, ok := Get(memo)[updateID]
+	return ok && !update.IsFinal()
+}
+
+// Apply applies the given update signal or update marker event to the given memo and returns the resulting
+// memo, and whether the updates changed. Updates requested again, markers answering an unknown or finished
+// update and other events are ignored. The given memo is never modified, as it may be shared with the
+// workflow started event.
+func Apply(memo map[string][]byte, event *types.HistoryEvent) (map[string][]byte, bool) {
+	if attributes := event.GetWorkflowExecutionSignaledEventAttributes(); attributes != nil {
+		name, ok := UpdateName(attributes.SignalName)
+		if !ok || attributes.RequestID == "" {
+			return memo, false
+		}
+		updates := Get(memo)
+		if _, ok := updates[attributes.RequestID]; ok {
+			return memo, false
+		}
+		if updates == nil {
+			updates = Updates{}
+		}
+		updates[attributes.RequestID] = &Update{Name: name, RequestedTimeNano: event.GetTimestamp()}
+		return set(memo, updates)
+	}
+
+	attributes := event.GetMarkerRecordedEventAttributes()
+	if attributes == nil || !IsMarker(attributes.MarkerName) {
+		return memo, false
+	}
+	updates := Get(memo)
+	update, ok := updates[UpdateID(attributes.Header)]
+	if !ok || update.IsFinal() {
+		return memo, false
+	}
+	switch attributes.MarkerName {
+	case AcceptedMarkerName:
+		if update.Stage != StageRequested {
+			return memo, false
+		}
+		update.Stage = StageAccepted
+	case CompletedMarkerName:
+		update.Stage = StageCompleted
+		update.Result = attributes.Details
+		update.FinishedTimeNano = event.GetTimestamp()
+	case RejectedMarkerName:
+		update.Stage = StageRejected
+		update.Result = attributes.Details
+		update.FinishedTimeNano = event.GetTimestamp()
+	}
+	return set(memo, updates)
+}
+
+// Carry returns the memo of the next run, which keeps the updates of the given memo of the current run.
+// The given memos are never modified.
+func Carry(memo map[string][]byte, nextMemo map[string][]byte) map[string][]byte {
+	value, ok := memo[MemoKey]
+	if !ok {
+		return nextMemo
+	}
+	result := copyMemo(nextMemo)
+	result[MemoKey] = value
+	return result
+}
+
+// EncodeOutcome returns the result of the result query for the given outcome
+func EncodeOutcome(outcome Outcome) ([]byte, error) {
+	return json.Marshal(outcome)
+}
+
+// DecodeOutcome returns the outcome carried by the given result of the result query
+func DecodeOutcome(queryResult []byte) (Outcome, error) {
+	outcome := Outcome{}
+	err := json.Unmarshal(queryResult, &outcome)
+	return outcome, err
+}
+
+// IsFinal returns whether the update reached a final stage, either completed or rejected
+func (o *Outcome) IsFinal() bool {
+	return o.Stage == StageCompleted || o.Stage == StageRejected
+}
+
+// String returns the name of the stage
+func (s Stage) String() string {
+	switch s {
+	case StageRequested:
+		return "Requested"
+	case StageAccepted:
+		return "Accepted"
+	case StageCompleted:
+		return "Completed"
+	case StageRejected:
+		return "Rejected"
+	}
+	return "Unknown"
+}
+
+// set returns the memo holding the given updates, without the oldest finished updates beyond MaxFinished
+func set(memo map[string][]byte, updates Updates) (map[string][]byte, bool) {
+	var finished []string
+	for updateID, update := range updates {
+		if update.IsFinal() {
+			finished = append(finished, updateID)
+		}
+	}
+	if len(finished) > MaxFinished {
+		sort.Slice(finished, func(i, j int) bool {
+			if updates[finished[i]].FinishedTimeNano != updates[finished[j]].FinishedTimeNano {
+				return updates[finished[i]].FinishedTimeNano < updates[finished[j]].FinishedTimeNano
+			}
+			return finished[i] < finished[j]
+		})
+		for _, updateID := range finished[:len(finished)-MaxFinished] {
+			delete(updates, updateID)
+		}
+	}
+	value, err := json.Marshal(updates)
+	if err != nil {
+		return memo, false
+	}
+	result := copyMemo(memo)
+	result[MemoKey] = value
+	return result, true
+}
+
+func copyMemo(memo map[string][]byte) map[string][]byte {
+	result := make(map[string][]byte, len(memo)+1)
+	for k, v := range memo {
+		result[k] = v
+	}
+	return result
+}
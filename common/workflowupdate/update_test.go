@@ -0,0 +1,198 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package workflowupdate
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/types"
+)
+
+func TestSignalName(t *testing.T) {
+	updateName, ok := UpdateName(SignalName("set-price"))
+	assert.True(t, ok)
+	assert.Equal(t, "set-price", updateName)
+
+	_, ok = UpdateName("set-price")
+	assert.False(t, ok)
+}
+
+func TestIsMarker(t *testing.T) {
+	assert.True(t, IsMarker(AcceptedMarkerName))
+	assert.True(t, IsMarker(CompletedMarkerName))
+	assert.True(t, IsMarker(RejectedMarkerName))
+	assert.False(t, IsMarker("LocalActivity"))
+}
+
+func TestApply(t *testing.T) {
+	requested := func(updateID string, timestamp int64) *types.HistoryEvent {
+		return &types.HistoryEvent{
+			Timestamp: &timestamp,
+			WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{
+				SignalName: SignalName("set-price"),
+				RequestID:  updateID,
+			},
+		}
+	}
+	marker := func(markerName, updateID, details string, timestamp int64) *types.HistoryEvent {
+		return &types.HistoryEvent{
+			Timestamp: &timestamp,
+			MarkerRecordedEventAttributes: &types.MarkerRecordedEventAttributes{
+				MarkerName: markerName,
+				Details:    []byte(details),
+				Header:     &types.Header{Fields: map[string][]byte{IDHeaderKey: []byte(updateID)}},
+			},
+		}
+	}
+	tests := []struct {
+		name     string
+		events   []*types.HistoryEvent
+		expected Updates
+	}{
+		{
+			name: "other signal",
+			events: []*types.HistoryEvent{{WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{
+				SignalName: "set-price",
+				RequestID:  "update-id",
+			}}},
+		},
+		{
+			name:   "update without ID",
+			events: []*types.HistoryEvent{requested("", 1)},
+		},
+		{
+			name:     "requested",
+			events:   []*types.HistoryEvent{requested("update-id", 1)},
+			expected: Updates{"update-id": {Name: "set-price", RequestedTimeNano: 1}},
+		},
+		{
+			name:     "requested again",
+			events:   []*types.HistoryEvent{requested("update-id", 1), marker(AcceptedMarkerName, "update-id", "", 2), requested("update-id", 3)},
+			expected: Updates{"update-id": {Name: "set-price", Outcome: Outcome{Stage: StageAccepted}, RequestedTimeNano: 1}},
+		},
+		{
+			name:     "marker of an unknown update",
+			events:   []*types.HistoryEvent{requested("update-id", 1), marker(CompletedMarkerName, "other-id", "result", 2)},
+			expected: Updates{"update-id": {Name: "set-price", RequestedTimeNano: 1}},
+		},
+		{
+			name:     "marker of another kind",
+			events:   []*types.HistoryEvent{requested("update-id", 1), marker("LocalActivity", "update-id", "result", 2)},
+			expected: Updates{"update-id": {Name: "set-price", RequestedTimeNano: 1}},
+		},
+		{
+			name: "completed",
+			events: []*types.HistoryEvent{
+				requested("update-id", 1),
+				marker(AcceptedMarkerName, "update-id", "", 2),
+				marker(CompletedMarkerName, "update-id", "result", 3),
+			},
+			expected: Updates{"update-id": {
+				Name:              "set-price",
+				Outcome:           Outcome{Stage: StageCompleted, Result: []byte("result")},
+				RequestedTimeNano: 1,
+				FinishedTimeNano:  3,
+			}},
+		},
+		{
+			name: "answered after completion",
+			events: []*types.HistoryEvent{
+				requested("update-id", 1),
+				marker(RejectedMarkerName, "update-id", "reason", 2),
+				marker(CompletedMarkerName, "update-id", "result", 3),
+			},
+			expected: Updates{"update-id": {
+				Name:              "set-price",
+				Outcome:           Outcome{Stage: StageRejected, Result: []byte("reason")},
+				RequestedTimeNano: 1,
+				FinishedTimeNano:  2,
+			}},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			memo := map[string][]byte{"user": []byte("value")}
+			for _, event := range tc.events {
+				memo, _ = Apply(memo, event)
+			}
+			assert.Equal(t, tc.expected, Get(memo))
+			assert.Equal(t, []byte("value"), memo["user"])
+		})
+	}
+}
+
+func TestApply_DropsOldestFinishedUpdates(t *testing.T) {
+	var memo map[string][]byte
+	for i := 0; i <= MaxFinished; i++ {
+		updateID := fmt.Sprintf("update-%v", i)
+		timestamp := int64(i)
+		memo, _ = Apply(memo, &types.HistoryEvent{
+			Timestamp:                                &timestamp,
+			WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{SignalName: SignalName("name"), RequestID: updateID},
+		})
+		memo, _ = Apply(memo, &types.HistoryEvent{
+			Timestamp: &timestamp,
+			MarkerRecordedEventAttributes: &types.MarkerRecordedEventAttributes{
+				MarkerName: CompletedMarkerName,
+				Header:     &types.Header{Fields: map[string][]byte{IDHeaderKey: []byte(updateID)}},
+			},
+		})
+	}
+	memo, _ = Apply(memo, &types.HistoryEvent{
+		WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{SignalName: SignalName("name"), RequestID: "pending"},
+	})
+
+	updates := Get(memo)
+	assert.Len(t, updates, MaxFinished+1)
+	assert.NotContains(t, updates, "update-0")
+	assert.Contains(t, updates, fmt.Sprintf("update-%v", MaxFinished))
+	assert.True(t, CanAnswer(memo, "pending"))
+	assert.False(t, CanAnswer(memo, "update-1"))
+	assert.False(t, CanAnswer(memo, "unknown"))
+}
+
+func TestCarry(t *testing.T) {
+	memo, _ := Apply(nil, &types.HistoryEvent{
+		WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{SignalName: SignalName("name"), RequestID: "update-id"},
+	})
+	nextMemo := map[string][]byte{"user": []byte("value")}
+
+	carried := Carry(memo, nextMemo)
+	assert.Equal(t, map[string][]byte{"user": []byte("value"), MemoKey: memo[MemoKey]}, carried)
+	assert.Len(t, nextMemo, 1)
+	assert.Equal(t, nextMemo, Carry(nil, nextMemo))
+}
+
+func TestOutcome(t *testing.T) {
+	outcome := Outcome{Stage: StageCompleted, Result: []byte("result")}
+	encoded, err := EncodeOutcome(outcome)
+	assert.NoError(t, err)
+	decoded, err := DecodeOutcome(encoded)
+	assert.NoError(t, err)
+	assert.Equal(t, outcome, decoded)
+	assert.True(t, decoded.IsFinal())
+	assert.Equal(t, "Completed", decoded.Stage.String())
+}
@@ -0,0 +1,10 @@
+CREATE TABLE housekeeping_tasks
+(
+    shard_id             INT         NOT NULL,
+    visibility_timestamp DATETIME(6) NOT NULL,
+    task_id              BIGINT      NOT NULL,
+    --
+    data                 MEDIUMBLOB  NOT NULL,
+    data_encoding        VARCHAR(16) NOT NULL,
+    PRIMARY KEY (shard_id, visibility_timestamp, task_id)
+);
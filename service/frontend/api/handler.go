@@ -50,10 +50,12 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	persistenceutils "github.com/uber/cadence/common/persistence/persistence-utils"
+	"github.com/uber/cadence/common/reserved"
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/common/types/mapper/thrift"
+	"github.com/uber/cadence/common/workflowupdate"
 	"github.com/uber/cadence/service/frontend/config"
 	"github.com/uber/cadence/service/frontend/validate"
 	"github.com/uber/cadence/service/worker/diagnostics"
@@ -2172,6 +2174,10 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(
 		return validate.ErrSignalNameTooLong
 	}
 
+	if err := reserved.ValidateSignalName(signalRequest.GetSignalName()); err != nil {
+		return err
+	}
+
 	if !common.IsValidIDLength(
 		signalRequest.GetRequestID(),
 		scope,
@@ -2360,6 +2366,11 @@ func (wh *WorkflowHandler) validateSignalWithStartWorkflowExecutionRequest(ctx c
 		return validate.ErrSignalNameTooLong
 	}
 
+	// reserved signals which control an existing workflow don't apply to a workflow being started
+	if reserved.IsSignalName(signalWithStartRequest.GetSignalName()) && !workflowupdate.IsSignal(signalWithStartRequest.GetSignalName()) {
+		return &types.BadRequestError{Message: fmt.Sprintf("Signal name %v is reserved.", signalWithStartRequest.GetSignalName())}
+	}
+
 	if signalWithStartRequest.WorkflowType == nil || signalWithStartRequest.WorkflowType.GetName() == "" {
 		return validate.ErrWorkflowTypeNotSet
 	}
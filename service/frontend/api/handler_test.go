@@ -3512,6 +3512,18 @@ func (s *workflowHandlerSuite) TestSignalWorkflowExecution() {
 			expectError:     true,
 			expectErrorType: validate.ErrSignalNameTooLong,
 		},
+		"reserved signal name": {
+			request: &types.SignalWorkflowExecutionRequest{
+				Domain: s.testDomain,
+				WorkflowExecution: &types.WorkflowExecution{
+					WorkflowID: testWorkflowID,
+					RunID:      testRunID,
+				},
+				SignalName: "__cadence_signal",
+			},
+			mockFn:      func() {},
+			expectError: true,
+		},
 		"requestID length exceeds limit": {
 			request: validRequest,
 			mockFn: func() {
@@ -24,10 +24,17 @@ package accesscontrolled
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 
 	"github.com/uber/cadence/common/authorization"
+	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/reserved"
+	"github.com/uber/cadence/common/searchattrupdate"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/batcher"
+	"github.com/uber/cadence/service/worker/scheduler"
 )
 
 var errUnauthorized = &types.AccessDeniedError{Message: "Request unauthorized."}
@@ -71,3 +78,133 @@ func (a *apiHandler) getMetricsScopeWithDomain(
 	}
 	return a.GetMetricsClient().Scope(scope).Tagged(metrics.DomainUnknownTag())
 }
+
+// isAuthorizedForSchedule authorizes requests to schedule workflows against the domain of the schedule as well.
+// Schedules run in the system domain but act in their own domain, so access to the system domain alone must not
+// allow creating or changing a schedule of another domain. A schedule workflow must also be started with the
+// workflow ID of the schedule in its input, as the domain of a schedule is taken from its workflow ID.
+func (a *apiHandler) isAuthorizedForSchedule(
+	ctx context.Context,
+	attr *authorization.Attributes,
+	request interface {
+		GetDomain() string
+	},
+	scope metrics.Scope,
+) (bool, error) {
+	if request.GetDomain() != constants.SystemLocalDomainName {
+		return true, nil
+	}
+
+	var workflowID, workflowType string
+	var input []byte
+	switch r := request.(type) {
+	case *types.StartWorkflowExecutionRequest:
+		if r != nil {
+			workflowID, workflowType, input = r.WorkflowID, r.WorkflowType.GetName(), r.Input
+		}
+	case *types.StartWorkflowExecutionAsyncRequest:
+		return a.isAuthorizedForSchedule(ctx, attr, r.StartWorkflowExecutionRequest, scope)
+	case *types.SignalWithStartWorkflowExecutionRequest:
+		if r != nil {
+			workflowID, workflowType, input = r.WorkflowID, r.WorkflowType.GetName(), r.Input
+		}
+	case *types.SignalWithStartWorkflowExecutionAsyncRequest:
+		return a.isAuthorizedForSchedule(ctx, attr, r.SignalWithStartWorkflowExecutionRequest, scope)
+	case *types.SignalWorkflowExecutionRequest:
+		workflowID = r.GetWorkflowExecution().GetWorkflowID()
+	case *types.TerminateWorkflowExecutionRequest:
+		workflowID = r.GetWorkflowExecution().GetWorkflowID()
+	case *types.RequestCancelWorkflowExecutionRequest:
+		workflowID = r.GetWorkflowExecution().GetWorkflowID()
+	case *types.ResetWorkflowExecutionRequest:
+		workflowID = r.GetWorkflowExecution().GetWorkflowID()
+	}
+
+	domain, ok := scheduler.DomainFromWorkflowID(workflowID)
+	if workflowType == scheduler.WorkflowTypeName {
+		var params scheduler.Params
+		if err := json.Unmarshal(input, &params); err != nil {
+			return false, &types.BadRequestError{Message: fmt.Sprintf("Invalid schedule: %v", err)}
+		}
+		if expected := scheduler.WorkflowID(params.Domain, params.ScheduleID); workflowID != expected {
+			return false, &types.BadRequestError{Message: fmt.Sprintf("Schedule workflow ID must be %q.", expected)}
+		}
+		domain, ok = params.Domain, true
+	}
+	if !ok {
+		return true, nil
+	}
+	scheduleAttr := *attr
+	scheduleAttr.DomainName = domain
+	return a.isAuthorized(ctx, &scheduleAttr, scope)
+}
+
+// isAuthorizedForBatch authorizes a batch job against the domain it operates on, since the batcher is
+// authorized for more than the user starting the job
+func (a *apiHandler) isAuthorizedForBatch(
+	ctx context.Context,
+	attr *authorization.Attributes,
+	request interface {
+		GetDomain() string
+	},
+	scope metrics.Scope,
+) (bool, error) {
+	if request.GetDomain() != constants.BatcherLocalDomainName {
+		return true, nil
+	}
+
+	var workflowType string
+	var input []byte
+	switch r := request.(type) {
+	case *types.StartWorkflowExecutionRequest:
+		if r != nil {
+			workflowType, input = r.WorkflowType.GetName(), r.Input
+		}
+	case *types.StartWorkflowExecutionAsyncRequest:
+		return a.isAuthorizedForBatch(ctx, attr, r.StartWorkflowExecutionRequest, scope)
+	case *types.SignalWithStartWorkflowExecutionRequest:
+		if r != nil {
+			workflowType, input = r.WorkflowType.GetName(), r.Input
+		}
+	case *types.SignalWithStartWorkflowExecutionAsyncRequest:
+		return a.isAuthorizedForBatch(ctx, attr, r.SignalWithStartWorkflowExecutionRequest, scope)
+	}
+	if workflowType != batcher.BatchWFTypeName {
+		return true, nil
+	}
+
+	var params batcher.BatchParams
+	if err := json.Unmarshal(input, &params); err != nil {
+		return false, &types.BadRequestError{Message: fmt.Sprintf("Invalid batch parameters: %v", err)}
+	}
+	batchAttr := *attr
+	batchAttr.DomainName = params.DomainName
+	switch params.BatchType {
+	case batcher.BatchTypeUpdateSearchAttributes:
+		api, _ := reserved.GetSignalAPI(searchattrupdate.SignalName)
+		batchAttr.APIName, batchAttr.Permission = api.Name, api.Permission
+	case batcher.BatchTypeDelete:
+		batchAttr.APIName, batchAttr.Permission = "DeleteWorkflow", authorization.PermissionAdmin
+	}
+	return a.isAuthorized(ctx, &batchAttr, scope)
+}
+
+// isAuthorizedForReservedSignal authorizes a reserved signal as the API it stands for, so that a signal
+// doesn't grant more than calling the API would
+func (a *apiHandler) isAuthorizedForReservedSignal(
+	ctx context.Context,
+	attr *authorization.Attributes,
+	request interface {
+		GetSignalName() string
+	},
+	scope metrics.Scope,
+) (bool, error) {
+	api, ok := reserved.GetSignalAPI(request.GetSignalName())
+	if !ok {
+		return true, nil
+	}
+	signalAttr := *attr
+	signalAttr.APIName = api.Name
+	signalAttr.Permission = api.Permission
+	return a.isAuthorized(ctx, &signalAttr, scope)
+}
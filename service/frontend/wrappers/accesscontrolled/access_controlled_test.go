@@ -24,6 +24,7 @@ package accesscontrolled
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"testing"
 
@@ -31,10 +32,15 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/uber/cadence/common/authorization"
+	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/metrics/mocks"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/workflowpause"
+	"github.com/uber/cadence/common/workflowupdate"
 	"github.com/uber/cadence/service/frontend/admin"
+	"github.com/uber/cadence/service/worker/batcher"
+	"github.com/uber/cadence/service/worker/scheduler"
 )
 
 func TestIsAuthorized(t *testing.T) {
@@ -95,6 +101,267 @@ func TestIsAuthorized(t *testing.T) {
 	}
 }
 
+func TestIsAuthorizedForSchedule(t *testing.T) {
+	scheduleInput := func(domain string) []byte {
+		input, err := json.Marshal(scheduler.Params{Domain: domain, ScheduleID: "test-schedule"})
+		assert.NoError(t, err)
+		return input
+	}
+	scheduleType := &types.WorkflowType{Name: scheduler.WorkflowTypeName}
+	testCases := []struct {
+		name            string
+		request         interface{ GetDomain() string }
+		authorizeDomain string
+		wantErr         bool
+	}{
+		{
+			name: "other domain",
+			request: &types.SignalWorkflowExecutionRequest{
+				Domain:            "test-domain",
+				WorkflowExecution: &types.WorkflowExecution{WorkflowID: scheduler.WorkflowID("other-domain", "test-schedule")},
+			},
+		},
+		{
+			name: "other workflow of system domain",
+			request: &types.TerminateWorkflowExecutionRequest{
+				Domain:            constants.SystemLocalDomainName,
+				WorkflowExecution: &types.WorkflowExecution{WorkflowID: "test-workflow"},
+			},
+		},
+		{
+			name: "start schedule",
+			request: &types.StartWorkflowExecutionRequest{
+				Domain:       constants.SystemLocalDomainName,
+				WorkflowID:   scheduler.WorkflowID("test-domain", "test-schedule"),
+				WorkflowType: scheduleType,
+				Input:        scheduleInput("test-domain"),
+			},
+			authorizeDomain: "test-domain",
+		},
+		{
+			name: "start schedule async",
+			request: &types.StartWorkflowExecutionAsyncRequest{StartWorkflowExecutionRequest: &types.StartWorkflowExecutionRequest{
+				Domain:       constants.SystemLocalDomainName,
+				WorkflowID:   scheduler.WorkflowID("test-domain", "test-schedule"),
+				WorkflowType: scheduleType,
+				Input:        scheduleInput("test-domain"),
+			}},
+			authorizeDomain: "test-domain",
+		},
+		{
+			name: "start schedule of other domain than its workflow ID",
+			request: &types.SignalWithStartWorkflowExecutionRequest{
+				Domain:       constants.SystemLocalDomainName,
+				WorkflowID:   scheduler.WorkflowID("test-domain", "test-schedule"),
+				WorkflowType: scheduleType,
+				Input:        scheduleInput("other-domain"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "start schedule with invalid input",
+			request: &types.StartWorkflowExecutionRequest{
+				Domain:       constants.SystemLocalDomainName,
+				WorkflowID:   "test-workflow",
+				WorkflowType: scheduleType,
+				Input:        []byte("invalid"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "signal schedule",
+			request: &types.SignalWorkflowExecutionRequest{
+				Domain:            constants.SystemLocalDomainName,
+				WorkflowExecution: &types.WorkflowExecution{WorkflowID: scheduler.WorkflowID("test-domain", "test-schedule")},
+			},
+			authorizeDomain: "test-domain",
+		},
+		{
+			name: "terminate schedule",
+			request: &types.TerminateWorkflowExecutionRequest{
+				Domain:            constants.SystemLocalDomainName,
+				WorkflowExecution: &types.WorkflowExecution{WorkflowID: scheduler.WorkflowID("test-domain", "test-schedule")},
+			},
+			authorizeDomain: "test-domain",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+
+			mockAuthorizer := authorization.NewMockAuthorizer(controller)
+			mockMetricsScope := &mocks.Scope{}
+			if tc.authorizeDomain != "" {
+				mockAuthorizer.EXPECT().Authorize(gomock.Any(), &authorization.Attributes{APIName: "test-api", DomainName: tc.authorizeDomain}).
+					Return(authorization.Result{Decision: authorization.DecisionAllow}, nil)
+				mockMetricsScope.On("StartTimer", metrics.CadenceAuthorizationLatency).Return(metrics.NewTestStopwatch()).Once()
+			}
+
+			handler := &apiHandler{authorizer: mockAuthorizer}
+			got, err := handler.isAuthorizedForSchedule(context.Background(), &authorization.Attributes{
+				APIName:    "test-api",
+				DomainName: tc.request.GetDomain(),
+			}, tc.request, mockMetricsScope)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, got)
+			}
+		})
+	}
+}
+
+func TestIsAuthorizedForBatch(t *testing.T) {
+	batchInput := func(batchType string) []byte {
+		input, err := json.Marshal(batcher.BatchParams{DomainName: "test-domain", BatchType: batchType})
+		assert.NoError(t, err)
+		return input
+	}
+	batchType := &types.WorkflowType{Name: batcher.BatchWFTypeName}
+	testCases := []struct {
+		name          string
+		request       interface{ GetDomain() string }
+		authorizeAttr *authorization.Attributes
+		wantErr       bool
+	}{
+		{
+			name: "other domain",
+			request: &types.StartWorkflowExecutionRequest{
+				Domain:       "test-domain",
+				WorkflowType: batchType,
+				Input:        batchInput(batcher.BatchTypeDelete),
+			},
+		},
+		{
+			name: "terminate batch",
+			request: &types.StartWorkflowExecutionRequest{
+				Domain:       constants.BatcherLocalDomainName,
+				WorkflowType: batchType,
+				Input:        batchInput(batcher.BatchTypeTerminate),
+			},
+			authorizeAttr: &authorization.Attributes{APIName: "StartWorkflowExecution", Permission: authorization.PermissionWrite, DomainName: "test-domain"},
+		},
+		{
+			name: "update search attributes batch",
+			request: &types.StartWorkflowExecutionAsyncRequest{StartWorkflowExecutionRequest: &types.StartWorkflowExecutionRequest{
+				Domain:       constants.BatcherLocalDomainName,
+				WorkflowType: batchType,
+				Input:        batchInput(batcher.BatchTypeUpdateSearchAttributes),
+			}},
+			authorizeAttr: &authorization.Attributes{APIName: "UpsertWorkflowSearchAttributes", Permission: authorization.PermissionAdmin, DomainName: "test-domain"},
+		},
+		{
+			name: "delete batch",
+			request: &types.SignalWithStartWorkflowExecutionRequest{
+				Domain:       constants.BatcherLocalDomainName,
+				WorkflowType: batchType,
+				Input:        batchInput(batcher.BatchTypeDelete),
+			},
+			authorizeAttr: &authorization.Attributes{APIName: "DeleteWorkflow", Permission: authorization.PermissionAdmin, DomainName: "test-domain"},
+		},
+		{
+			name: "invalid batch parameters",
+			request: &types.StartWorkflowExecutionRequest{
+				Domain:       constants.BatcherLocalDomainName,
+				WorkflowType: batchType,
+				Input:        []byte("invalid"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+
+			mockAuthorizer := authorization.NewMockAuthorizer(controller)
+			mockMetricsScope := &mocks.Scope{}
+			if tc.authorizeAttr != nil {
+				mockAuthorizer.EXPECT().Authorize(gomock.Any(), tc.authorizeAttr).
+					Return(authorization.Result{Decision: authorization.DecisionAllow}, nil)
+				mockMetricsScope.On("StartTimer", metrics.CadenceAuthorizationLatency).Return(metrics.NewTestStopwatch()).Once()
+			}
+
+			handler := &apiHandler{authorizer: mockAuthorizer}
+			got, err := handler.isAuthorizedForBatch(context.Background(), &authorization.Attributes{
+				APIName:    "StartWorkflowExecution",
+				Permission: authorization.PermissionWrite,
+				DomainName: tc.request.GetDomain(),
+			}, tc.request, mockMetricsScope)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, got)
+			}
+		})
+	}
+}
+
+func TestIsAuthorizedForReservedSignal(t *testing.T) {
+	testCases := []struct {
+		name          string
+		signalName    string
+		authorizeAPI  string
+		authorizeDeny bool
+		want          bool
+	}{
+		{
+			name:       "user signal",
+			signalName: "signal",
+			want:       true,
+		},
+		{
+			name:         "pause",
+			signalName:   workflowpause.PauseSignalName,
+			authorizeAPI: "PauseWorkflowExecution",
+			want:         true,
+		},
+		{
+			name:          "update denied",
+			signalName:    workflowupdate.SignalName("approve"),
+			authorizeAPI:  "UpdateWorkflowExecution",
+			authorizeDeny: true,
+			want:          false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+
+			mockAuthorizer := authorization.NewMockAuthorizer(controller)
+			mockMetricsScope := &mocks.Scope{}
+			if tc.authorizeAPI != "" {
+				decision := authorization.DecisionAllow
+				if tc.authorizeDeny {
+					decision = authorization.DecisionDeny
+				}
+				mockAuthorizer.EXPECT().Authorize(gomock.Any(), &authorization.Attributes{
+					APIName:    tc.authorizeAPI,
+					Permission: authorization.PermissionWrite,
+					DomainName: "test-domain",
+				}).Return(authorization.Result{Decision: decision}, nil)
+				mockMetricsScope.On("StartTimer", metrics.CadenceAuthorizationLatency).Return(metrics.NewTestStopwatch()).Once()
+				if tc.authorizeDeny {
+					mockMetricsScope.On("IncCounter", metrics.CadenceErrUnauthorizedCounter).Once()
+				}
+			}
+
+			handler := &apiHandler{authorizer: mockAuthorizer}
+			got, err := handler.isAuthorizedForReservedSignal(context.Background(), &authorization.Attributes{
+				APIName:    "SignalWorkflowExecution",
+				Permission: authorization.PermissionWrite,
+				DomainName: "test-domain",
+			}, &types.SignalWorkflowExecutionRequest{SignalName: tc.signalName}, mockMetricsScope)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
 func TestDescribeCluster(t *testing.T) {
 	someErr := errors.New("some random err")
 	testCases := []struct {
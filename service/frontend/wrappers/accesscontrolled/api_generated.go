@@ -423,6 +423,13 @@ func (a *apiHandler) RequestCancelWorkflowExecution(ctx context.Context, rp1 *ty
 	if !isAuthorized {
 		return errUnauthorized
 	}
+	isAuthorized, err = a.isAuthorizedForSchedule(ctx, attr, rp1, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
 	return a.handler.RequestCancelWorkflowExecution(ctx, rp1)
 }
 
@@ -445,6 +452,13 @@ func (a *apiHandler) ResetWorkflowExecution(ctx context.Context, rp1 *types.Rese
 	if !isAuthorized {
 		return nil, errUnauthorized
 	}
+	isAuthorized, err = a.isAuthorizedForSchedule(ctx, attr, rp1, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
 	return a.handler.ResetWorkflowExecution(ctx, rp1)
 }
 
@@ -537,6 +551,27 @@ func (a *apiHandler) SignalWithStartWorkflowExecution(ctx context.Context, sp1 *
 	if !isAuthorized {
 		return nil, errUnauthorized
 	}
+	isAuthorized, err = a.isAuthorizedForSchedule(ctx, attr, sp1, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	isAuthorized, err = a.isAuthorizedForBatch(ctx, attr, sp1, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	isAuthorized, err = a.isAuthorizedForReservedSignal(ctx, attr, sp1, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
 	return a.handler.SignalWithStartWorkflowExecution(ctx, sp1)
 }
 
@@ -557,6 +592,27 @@ func (a *apiHandler) SignalWithStartWorkflowExecutionAsync(ctx context.Context,
 	if !isAuthorized {
 		return nil, errUnauthorized
 	}
+	isAuthorized, err = a.isAuthorizedForSchedule(ctx, attr, sp1, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	isAuthorized, err = a.isAuthorizedForBatch(ctx, attr, sp1, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	isAuthorized, err = a.isAuthorizedForReservedSignal(ctx, attr, sp1, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
 	return a.handler.SignalWithStartWorkflowExecutionAsync(ctx, sp1)
 }
 
@@ -575,6 +631,20 @@ func (a *apiHandler) SignalWorkflowExecution(ctx context.Context, sp1 *types.Sig
 	if !isAuthorized {
 		return errUnauthorized
 	}
+	isAuthorized, err = a.isAuthorizedForSchedule(ctx, attr, sp1, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	isAuthorized, err = a.isAuthorizedForReservedSignal(ctx, attr, sp1, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
 	return a.handler.SignalWorkflowExecution(ctx, sp1)
 }
 
@@ -595,6 +665,20 @@ func (a *apiHandler) StartWorkflowExecution(ctx context.Context, sp1 *types.Star
 	if !isAuthorized {
 		return nil, errUnauthorized
 	}
+	isAuthorized, err = a.isAuthorizedForSchedule(ctx, attr, sp1, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	isAuthorized, err = a.isAuthorizedForBatch(ctx, attr, sp1, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
 	return a.handler.StartWorkflowExecution(ctx, sp1)
 }
 
@@ -615,6 +699,20 @@ func (a *apiHandler) StartWorkflowExecutionAsync(ctx context.Context, sp1 *types
 	if !isAuthorized {
 		return nil, errUnauthorized
 	}
+	isAuthorized, err = a.isAuthorizedForSchedule(ctx, attr, sp1, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	isAuthorized, err = a.isAuthorizedForBatch(ctx, attr, sp1, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
 	return a.handler.StartWorkflowExecutionAsync(ctx, sp1)
 }
 
@@ -633,6 +731,13 @@ func (a *apiHandler) TerminateWorkflowExecution(ctx context.Context, tp1 *types.
 	if !isAuthorized {
 		return errUnauthorized
 	}
+	isAuthorized, err = a.isAuthorizedForSchedule(ctx, attr, tp1, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
 	return a.handler.TerminateWorkflowExecution(ctx, tp1)
 }
 
@@ -100,6 +100,7 @@ type Config struct {
 	TaskSchedulerRoundRobinWeights           dynamicproperties.MapPropertyFn
 	TaskSchedulerDomainRoundRobinWeights     dynamicproperties.MapPropertyFnWithDomainFilter
 	TaskSchedulerGlobalDomainRPS             dynamicproperties.IntPropertyFnWithDomainFilter
+	TaskSchedulerDomainMaxConcurrency        dynamicproperties.IntPropertyFnWithDomainFilter
 	TaskSchedulerEnableRateLimiter           dynamicproperties.BoolPropertyFn
 	TaskSchedulerEnableRateLimiterShadowMode dynamicproperties.BoolPropertyFnWithDomainFilter
 	TaskSchedulerEnableMigration             dynamicproperties.BoolPropertyFn
@@ -113,6 +114,7 @@ type Config struct {
 
 	// History Queue (v2) settings
 	EnableTimerQueueV2                         dynamicproperties.BoolPropertyFnWithShardIDFilter
+	EnableHousekeepingTaskQueue                dynamicproperties.BoolPropertyFnWithShardIDFilter
 	EnableTransferQueueV2                      dynamicproperties.BoolPropertyFnWithShardIDFilter
 	QueueMaxPendingTaskCount                   dynamicproperties.IntPropertyFn
 	EnableTimerQueueV2PendingTaskCountAlert    dynamicproperties.BoolPropertyFnWithShardIDFilter
@@ -120,6 +122,13 @@ type Config struct {
 	QueueCriticalPendingTaskCount              dynamicproperties.IntPropertyFn
 	QueueMaxVirtualQueueCount                  dynamicproperties.IntPropertyFn
 	VirtualSliceForceAppendInterval            dynamicproperties.DurationPropertyFn
+	EnableQueueV2StuckSliceAlert               dynamicproperties.BoolPropertyFnWithShardIDFilter
+	EnableQueueV2DomainTaskFailureAlert        dynamicproperties.BoolPropertyFnWithShardIDFilter
+	EnableQueueV2ScheduledLagAlert             dynamicproperties.BoolPropertyFnWithShardIDFilter
+	QueueStuckSliceDuration                    dynamicproperties.DurationPropertyFn
+	QueueCriticalDomainTaskFailureRate         dynamicproperties.FloatPropertyFn
+	QueueDomainTaskFailureRateMinTaskCount     dynamicproperties.IntPropertyFn
+	QueueMaxScheduledLag                       dynamicproperties.DurationPropertyFn
 
 	// QueueProcessor settings
 	QueueProcessorEnableSplit                          dynamicproperties.BoolPropertyFn
@@ -158,6 +167,12 @@ type Config struct {
 	TimerProcessorArchivalTimeLimit                   dynamicproperties.DurationPropertyFn
 	DisableTimerFailoverQueue                         dynamicproperties.BoolPropertyFn
 
+	// HousekeepingQueueProcessor settings
+	HousekeepingTaskBatchSize              dynamicproperties.IntPropertyFn
+	HousekeepingProcessorMaxPollRPS        dynamicproperties.IntPropertyFn
+	HousekeepingProcessorUpdateAckInterval dynamicproperties.DurationPropertyFn
+	HousekeepingProcessorMaxPollInterval   dynamicproperties.DurationPropertyFn
+
 	// TransferQueueProcessor settings
 	TransferTaskBatchSize                                dynamicproperties.IntPropertyFn
 	TransferTaskDeleteBatchSize                          dynamicproperties.IntPropertyFn
@@ -185,6 +200,7 @@ type Config struct {
 	ReplicatorProcessorMaxTaskBatchSize    dynamicproperties.IntPropertyFnWithShardIDFilter
 	ReplicatorProcessorMinTaskBatchSize    dynamicproperties.IntPropertyFnWithShardIDFilter
 	ReplicatorProcessorBatchSizeStepCount  dynamicproperties.IntPropertyFnWithShardIDFilter
+	ReplicatorProcessorTargetBatchBytes    dynamicproperties.IntPropertyFnWithShardIDFilter
 	ReplicatorUpperLatency                 dynamicproperties.DurationPropertyFn
 	ReplicatorCacheCapacity                dynamicproperties.IntPropertyFn
 	ReplicatorCacheMaxSize                 dynamicproperties.IntPropertyFn
@@ -281,6 +297,9 @@ type Config struct {
 	EnableReplicationTaskGeneration                    dynamicproperties.BoolPropertyFnWithDomainIDAndWorkflowIDFilter
 	EnableRecordWorkflowExecutionUninitialized         dynamicproperties.BoolPropertyFnWithDomainFilter
 	ReplicationTaskProcessorLatencyLogThreshold        dynamicproperties.DurationPropertyFn
+	ReplicationDomainLagThreshold                      dynamicproperties.DurationPropertyFnWithDomainFilter
+	ReplicationDomainPendingTaskThreshold              dynamicproperties.IntPropertyFnWithDomainFilter
+	ReplicationDomainBacklogMaxTasks                   dynamicproperties.IntPropertyFnWithShardIDFilter
 
 	// The following are used by the history workflowID cache
 	WorkflowIDExternalRPS dynamicproperties.IntPropertyFnWithDomainFilter
@@ -295,6 +314,7 @@ type Config struct {
 	EnableContextHeaderInVisibility dynamicproperties.BoolPropertyFnWithDomainFilter
 
 	EnableCrossClusterOperationsForDomain dynamicproperties.BoolPropertyFnWithDomainFilter
+	EnableCrossDomainCalls                dynamicproperties.BoolPropertyFnWithDomainFilter
 
 	// Data integrity check related config knobs
 	MutableStateChecksumGenProbability    dynamicproperties.IntPropertyFnWithDomainFilter
@@ -309,6 +329,8 @@ type Config struct {
 	NotifyFailoverMarkerInterval               dynamicproperties.DurationPropertyFn
 	NotifyFailoverMarkerTimerJitterCoefficient dynamicproperties.FloatPropertyFn
 	EnableGracefulFailover                     dynamicproperties.BoolPropertyFn
+	EnableGracefulFailoverTaskDraining         dynamicproperties.BoolPropertyFn
+	GracefulFailoverDrainTimeout               dynamicproperties.DurationPropertyFn
 
 	// Allows worker to dispatch activity tasks through local tunnel after decisions are made. This is an performance optimization to skip activity scheduling efforts.
 	EnableActivityLocalDispatchByDomain dynamicproperties.BoolPropertyFnWithDomainFilter
@@ -317,6 +339,21 @@ type Config struct {
 
 	ActivityMaxScheduleToStartTimeoutForRetry dynamicproperties.DurationPropertyFnWithDomainFilter
 
+	// Worker versioning, pins decision tasks to pollers compatible with the build that last processed the workflow
+	EnableWorkerVersioning    dynamicproperties.BoolPropertyFnWithDomainFilter
+	WorkerVersioningBuildSets dynamicproperties.MapPropertyFnWithDomainFilter
+
+	// Attaches the activity type to activity tasks for the per activity type dispatch rate limits of matching
+	EnableActivityTypeDispatchRateLimit dynamicproperties.BoolPropertyFnWithDomainFilter
+
+	// Completion callbacks, notify HTTP endpoints or other workflows when a workflow closes
+	CompletionCallbackMaxAttempts        dynamicproperties.IntPropertyFnWithDomainFilter
+	CompletionCallbackAllowedURLPrefixes dynamicproperties.ListPropertyFn
+
+	// Signal deduplication window, remembers signal request IDs across continue as new and reset
+	SignalDedupWindow        dynamicproperties.DurationPropertyFnWithDomainFilter
+	SignalDedupMaxRequestIDs dynamicproperties.IntPropertyFnWithDomainFilter
+
 	// Debugging configurations
 	EnableDebugMode               bool // note that this value is initialized once on service start
 	EnableTaskInfoLogByDomainID   dynamicproperties.BoolPropertyFnWithDomainIDFilter
@@ -402,6 +439,7 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, i
 		TaskSchedulerRoundRobinWeights:           dc.GetMapProperty(dynamicproperties.TaskSchedulerRoundRobinWeights),
 		TaskSchedulerDomainRoundRobinWeights:     dc.GetMapPropertyFilteredByDomain(dynamicproperties.TaskSchedulerDomainRoundRobinWeights),
 		TaskSchedulerGlobalDomainRPS:             dc.GetIntPropertyFilteredByDomain(dynamicproperties.TaskSchedulerGlobalDomainRPS),
+		TaskSchedulerDomainMaxConcurrency:        dc.GetIntPropertyFilteredByDomain(dynamicproperties.TaskSchedulerDomainMaxConcurrency),
 		TaskSchedulerEnableRateLimiter:           dc.GetBoolProperty(dynamicproperties.TaskSchedulerEnableRateLimiter),
 		TaskSchedulerEnableRateLimiterShadowMode: dc.GetBoolPropertyFilteredByDomain(dynamicproperties.TaskSchedulerEnableRateLimiterShadowMode),
 		TaskSchedulerEnableMigration:             dc.GetBoolProperty(dynamicproperties.TaskSchedulerEnableMigration),
@@ -414,6 +452,7 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, i
 		ResurrectionCheckMinDelay:                dc.GetDurationPropertyFilteredByDomain(dynamicproperties.ResurrectionCheckMinDelay),
 
 		EnableTimerQueueV2:                         dc.GetBoolPropertyFilteredByShardID(dynamicproperties.EnableTimerQueueV2),
+		EnableHousekeepingTaskQueue:                dc.GetBoolPropertyFilteredByShardID(dynamicproperties.EnableHousekeepingTaskQueue),
 		EnableTransferQueueV2:                      dc.GetBoolPropertyFilteredByShardID(dynamicproperties.EnableTransferQueueV2),
 		QueueMaxPendingTaskCount:                   dc.GetIntProperty(dynamicproperties.QueueMaxPendingTaskCount),
 		EnableTimerQueueV2PendingTaskCountAlert:    dc.GetBoolPropertyFilteredByShardID(dynamicproperties.EnableTimerQueueV2PendingTaskCountAlert),
@@ -421,6 +460,13 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, i
 		QueueCriticalPendingTaskCount:              dc.GetIntProperty(dynamicproperties.QueueCriticalPendingTaskCount),
 		QueueMaxVirtualQueueCount:                  dc.GetIntProperty(dynamicproperties.QueueMaxVirtualQueueCount),
 		VirtualSliceForceAppendInterval:            dc.GetDurationProperty(dynamicproperties.VirtualSliceForceAppendInterval),
+		EnableQueueV2StuckSliceAlert:               dc.GetBoolPropertyFilteredByShardID(dynamicproperties.EnableQueueV2StuckSliceAlert),
+		EnableQueueV2DomainTaskFailureAlert:        dc.GetBoolPropertyFilteredByShardID(dynamicproperties.EnableQueueV2DomainTaskFailureAlert),
+		EnableQueueV2ScheduledLagAlert:             dc.GetBoolPropertyFilteredByShardID(dynamicproperties.EnableQueueV2ScheduledLagAlert),
+		QueueStuckSliceDuration:                    dc.GetDurationProperty(dynamicproperties.QueueStuckSliceDuration),
+		QueueCriticalDomainTaskFailureRate:         dc.GetFloat64Property(dynamicproperties.QueueCriticalDomainTaskFailureRate),
+		QueueDomainTaskFailureRateMinTaskCount:     dc.GetIntProperty(dynamicproperties.QueueDomainTaskFailureRateMinTaskCount),
+		QueueMaxScheduledLag:                       dc.GetDurationProperty(dynamicproperties.QueueMaxScheduledLag),
 
 		QueueProcessorEnableSplit:                          dc.GetBoolProperty(dynamicproperties.QueueProcessorEnableSplit),
 		QueueProcessorSplitMaxLevel:                        dc.GetIntProperty(dynamicproperties.QueueProcessorSplitMaxLevel),
@@ -456,6 +502,10 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, i
 		TimerProcessorHistoryArchivalSizeLimit:               dc.GetIntProperty(dynamicproperties.TimerProcessorHistoryArchivalSizeLimit),
 		TimerProcessorArchivalTimeLimit:                      dc.GetDurationProperty(dynamicproperties.TimerProcessorArchivalTimeLimit),
 		DisableTimerFailoverQueue:                            dc.GetBoolProperty(dynamicproperties.DisableTimerFailoverQueue),
+		HousekeepingTaskBatchSize:                            dc.GetIntProperty(dynamicproperties.HousekeepingTaskBatchSize),
+		HousekeepingProcessorMaxPollRPS:                      dc.GetIntProperty(dynamicproperties.HousekeepingProcessorMaxPollRPS),
+		HousekeepingProcessorUpdateAckInterval:               dc.GetDurationProperty(dynamicproperties.HousekeepingProcessorUpdateAckInterval),
+		HousekeepingProcessorMaxPollInterval:                 dc.GetDurationProperty(dynamicproperties.HousekeepingProcessorMaxPollInterval),
 		TransferTaskBatchSize:                                dc.GetIntProperty(dynamicproperties.TransferTaskBatchSize),
 		TransferTaskDeleteBatchSize:                          dc.GetIntProperty(dynamicproperties.TransferTaskDeleteBatchSize),
 		TransferProcessorFailoverMaxStartJitterInterval:      dc.GetDurationProperty(dynamicproperties.TransferProcessorFailoverMaxStartJitterInterval),
@@ -481,6 +531,7 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, i
 		ReplicatorProcessorMaxTaskBatchSize:    dc.GetIntPropertyFilteredByShardID(dynamicproperties.ReplicatorMaxTaskBatchSize),
 		ReplicatorProcessorMinTaskBatchSize:    dc.GetIntPropertyFilteredByShardID(dynamicproperties.ReplicatorMinTaskBatchSize),
 		ReplicatorProcessorBatchSizeStepCount:  dc.GetIntPropertyFilteredByShardID(dynamicproperties.ReplicatorTaskBatchStepCount),
+		ReplicatorProcessorTargetBatchBytes:    dc.GetIntPropertyFilteredByShardID(dynamicproperties.ReplicatorTargetTaskBatchBytes),
 		ReplicatorUpperLatency:                 dc.GetDurationProperty(dynamicproperties.ReplicatorUpperLatency),
 		ReplicatorCacheCapacity:                dc.GetIntProperty(dynamicproperties.ReplicatorCacheCapacity),
 		ReplicatorCacheMaxSize:                 dc.GetIntProperty(dynamicproperties.ReplicatorCacheMaxSize),
@@ -555,6 +606,9 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, i
 		EnableReplicationTaskGeneration:                    dc.GetBoolPropertyFilteredByDomainIDAndWorkflowID(dynamicproperties.EnableReplicationTaskGeneration),
 		EnableRecordWorkflowExecutionUninitialized:         dc.GetBoolPropertyFilteredByDomain(dynamicproperties.EnableRecordWorkflowExecutionUninitialized),
 		ReplicationTaskProcessorLatencyLogThreshold:        dc.GetDurationProperty(dynamicproperties.ReplicationTaskProcessorLatencyLogThreshold),
+		ReplicationDomainLagThreshold:                      dc.GetDurationPropertyFilteredByDomain(dynamicproperties.ReplicationDomainLagThreshold),
+		ReplicationDomainPendingTaskThreshold:              dc.GetIntPropertyFilteredByDomain(dynamicproperties.ReplicationDomainPendingTaskThreshold),
+		ReplicationDomainBacklogMaxTasks:                   dc.GetIntPropertyFilteredByShardID(dynamicproperties.ReplicationDomainBacklogMaxTasks),
 
 		WorkflowIDExternalRPS: dc.GetIntPropertyFilteredByDomain(dynamicproperties.WorkflowIDExternalRPS),
 		WorkflowIDInternalRPS: dc.GetIntPropertyFilteredByDomain(dynamicproperties.WorkflowIDInternalRPS),
@@ -563,6 +617,7 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, i
 		EnableConsistentQueryByDomain:         dc.GetBoolPropertyFilteredByDomain(dynamicproperties.EnableConsistentQueryByDomain),
 		EnableContextHeaderInVisibility:       dc.GetBoolPropertyFilteredByDomain(dynamicproperties.EnableContextHeaderInVisibility),
 		EnableCrossClusterOperationsForDomain: dc.GetBoolPropertyFilteredByDomain(dynamicproperties.EnableCrossClusterOperationsForDomain),
+		EnableCrossDomainCalls:                dc.GetBoolPropertyFilteredByDomain(dynamicproperties.EnableCrossDomainCalls),
 		MaxBufferedQueryCount:                 dc.GetIntProperty(dynamicproperties.MaxBufferedQueryCount),
 		MutableStateChecksumGenProbability:    dc.GetIntPropertyFilteredByDomain(dynamicproperties.MutableStateChecksumGenProbability),
 		MutableStateChecksumVerifyProbability: dc.GetIntPropertyFilteredByDomain(dynamicproperties.MutableStateChecksumVerifyProbability),
@@ -574,12 +629,25 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, i
 		NotifyFailoverMarkerInterval:               dc.GetDurationProperty(dynamicproperties.NotifyFailoverMarkerInterval),
 		NotifyFailoverMarkerTimerJitterCoefficient: dc.GetFloat64Property(dynamicproperties.NotifyFailoverMarkerTimerJitterCoefficient),
 		EnableGracefulFailover:                     dc.GetBoolProperty(dynamicproperties.EnableGracefulFailover),
+		EnableGracefulFailoverTaskDraining:         dc.GetBoolProperty(dynamicproperties.EnableGracefulFailoverTaskDraining),
+		GracefulFailoverDrainTimeout:               dc.GetDurationProperty(dynamicproperties.GracefulFailoverDrainTimeout),
 
 		EnableActivityLocalDispatchByDomain: dc.GetBoolPropertyFilteredByDomain(dynamicproperties.EnableActivityLocalDispatchByDomain),
 		MaxActivityCountDispatchByDomain:    dc.GetIntPropertyFilteredByDomain(dynamicproperties.MaxActivityCountDispatchByDomain),
 
 		ActivityMaxScheduleToStartTimeoutForRetry: dc.GetDurationPropertyFilteredByDomain(dynamicproperties.ActivityMaxScheduleToStartTimeoutForRetry),
 
+		EnableWorkerVersioning:    dc.GetBoolPropertyFilteredByDomain(dynamicproperties.EnableWorkerVersioning),
+		WorkerVersioningBuildSets: dc.GetMapPropertyFilteredByDomain(dynamicproperties.WorkerVersioningBuildSets),
+
+		EnableActivityTypeDispatchRateLimit: dc.GetBoolPropertyFilteredByDomain(dynamicproperties.EnableActivityTypeDispatchRateLimit),
+
+		CompletionCallbackMaxAttempts:        dc.GetIntPropertyFilteredByDomain(dynamicproperties.CompletionCallbackMaxAttempts),
+		CompletionCallbackAllowedURLPrefixes: dc.GetListProperty(dynamicproperties.CompletionCallbackAllowedURLPrefixes),
+
+		SignalDedupWindow:        dc.GetDurationPropertyFilteredByDomain(dynamicproperties.SignalDedupWindow),
+		SignalDedupMaxRequestIDs: dc.GetIntPropertyFilteredByDomain(dynamicproperties.SignalDedupMaxRequestIDs),
+
 		EnableDebugMode:               dc.GetBoolProperty(dynamicproperties.EnableDebugMode)(),
 		EnableTaskInfoLogByDomainID:   dc.GetBoolPropertyFilteredByDomainID(dynamicproperties.HistoryEnableTaskInfoLogByDomainID),
 		EnableTimerDebugLogByDomainID: dc.GetBoolPropertyFilteredByDomainID(dynamicproperties.EnableTimerDebugLogByDomainID),
@@ -134,6 +134,10 @@ func TestNewConfig(t *testing.T) {
 		"TimerProcessorFailoverMaxPollRPS":                     {dynamicproperties.TimerProcessorFailoverMaxPollRPS, 43},
 		"TimerProcessorMaxPollRPS":                             {dynamicproperties.TimerProcessorMaxPollRPS, 44},
 		"TimerProcessorMaxPollInterval":                        {dynamicproperties.TimerProcessorMaxPollInterval, time.Second},
+		"HousekeepingTaskBatchSize":                            {dynamicproperties.HousekeepingTaskBatchSize, 106},
+		"HousekeepingProcessorMaxPollRPS":                      {dynamicproperties.HousekeepingProcessorMaxPollRPS, 107},
+		"HousekeepingProcessorUpdateAckInterval":               {dynamicproperties.HousekeepingProcessorUpdateAckInterval, time.Second},
+		"HousekeepingProcessorMaxPollInterval":                 {dynamicproperties.HousekeepingProcessorMaxPollInterval, time.Second},
 		"TimerProcessorMaxPollIntervalJitterCoefficient":       {dynamicproperties.TimerProcessorMaxPollIntervalJitterCoefficient, 3.0},
 		"TimerProcessorSplitQueueInterval":                     {dynamicproperties.TimerProcessorSplitQueueInterval, time.Second},
 		"TimerProcessorSplitQueueIntervalJitterCoefficient":    {dynamicproperties.TimerProcessorSplitQueueIntervalJitterCoefficient, 4.0},
@@ -164,6 +168,7 @@ func TestNewConfig(t *testing.T) {
 		"ReplicatorProcessorMinTaskBatchSize":                  {dynamicproperties.ReplicatorMinTaskBatchSize, 1},
 		"ReplicatorProcessorMaxTaskBatchSize":                  {dynamicproperties.ReplicatorMaxTaskBatchSize, 1000},
 		"ReplicatorProcessorBatchSizeStepCount":                {dynamicproperties.ReplicatorTaskBatchStepCount, 10},
+		"ReplicatorProcessorTargetBatchBytes":                  {dynamicproperties.ReplicatorTargetTaskBatchBytes, 11},
 		"ReplicatorUpperLatency":                               {dynamicproperties.ReplicatorUpperLatency, time.Second},
 		"ReplicatorCacheCapacity":                              {dynamicproperties.ReplicatorCacheCapacity, 56},
 		"ReplicatorCacheMaxSize":                               {dynamicproperties.ReplicatorCacheMaxSize, 2000},
@@ -236,6 +241,7 @@ func TestNewConfig(t *testing.T) {
 		"MaxBufferedQueryCount":                                {dynamicproperties.MaxBufferedQueryCount, 89},
 		"EnableContextHeaderInVisibility":                      {dynamicproperties.EnableContextHeaderInVisibility, true},
 		"EnableCrossClusterOperationsForDomain":                {dynamicproperties.EnableCrossClusterOperationsForDomain, true},
+		"EnableCrossDomainCalls":                               {dynamicproperties.EnableCrossDomainCalls, true},
 		"MutableStateChecksumGenProbability":                   {dynamicproperties.MutableStateChecksumGenProbability, 90},
 		"MutableStateChecksumVerifyProbability":                {dynamicproperties.MutableStateChecksumVerifyProbability, 91},
 		"MutableStateChecksumInvalidateBefore":                 {dynamicproperties.MutableStateChecksumInvalidateBefore, 15.0},
@@ -244,9 +250,18 @@ func TestNewConfig(t *testing.T) {
 		"NotifyFailoverMarkerInterval":                         {dynamicproperties.NotifyFailoverMarkerInterval, time.Second},
 		"NotifyFailoverMarkerTimerJitterCoefficient":           {dynamicproperties.NotifyFailoverMarkerTimerJitterCoefficient, 16.0},
 		"EnableGracefulFailover":                               {dynamicproperties.EnableGracefulFailover, true},
+		"EnableGracefulFailoverTaskDraining":                   {dynamicproperties.EnableGracefulFailoverTaskDraining, true},
+		"GracefulFailoverDrainTimeout":                         {dynamicproperties.GracefulFailoverDrainTimeout, time.Second},
 		"EnableActivityLocalDispatchByDomain":                  {dynamicproperties.EnableActivityLocalDispatchByDomain, true},
 		"MaxActivityCountDispatchByDomain":                     {dynamicproperties.MaxActivityCountDispatchByDomain, 92},
 		"ActivityMaxScheduleToStartTimeoutForRetry":            {dynamicproperties.ActivityMaxScheduleToStartTimeoutForRetry, time.Second},
+		"EnableWorkerVersioning":                               {dynamicproperties.EnableWorkerVersioning, true},
+		"WorkerVersioningBuildSets":                            {dynamicproperties.WorkerVersioningBuildSets, map[string]interface{}{"build-a": "set-1"}},
+		"EnableActivityTypeDispatchRateLimit":                  {dynamicproperties.EnableActivityTypeDispatchRateLimit, true},
+		"CompletionCallbackMaxAttempts":                        {dynamicproperties.CompletionCallbackMaxAttempts, 102},
+		"CompletionCallbackAllowedURLPrefixes":                 {dynamicproperties.CompletionCallbackAllowedURLPrefixes, []interface{}{"https://hooks.example.com/"}},
+		"SignalDedupWindow":                                    {dynamicproperties.SignalDedupWindow, time.Second},
+		"SignalDedupMaxRequestIDs":                             {dynamicproperties.SignalDedupMaxRequestIDs, 103},
 		"EnableDebugMode":                                      {dynamicproperties.EnableDebugMode, true},
 		"EnableTaskInfoLogByDomainID":                          {dynamicproperties.HistoryEnableTaskInfoLogByDomainID, true},
 		"EnableTimerDebugLogByDomainID":                        {dynamicproperties.EnableTimerDebugLogByDomainID, true},
@@ -262,6 +277,7 @@ func TestNewConfig(t *testing.T) {
 		"GlobalRatelimiterDecayAfter":                          {dynamicproperties.HistoryGlobalRatelimiterDecayAfter, time.Second},
 		"GlobalRatelimiterGCAfter":                             {dynamicproperties.HistoryGlobalRatelimiterGCAfter, time.Second},
 		"TaskSchedulerGlobalDomainRPS":                         {dynamicproperties.TaskSchedulerGlobalDomainRPS, 97},
+		"TaskSchedulerDomainMaxConcurrency":                    {dynamicproperties.TaskSchedulerDomainMaxConcurrency, 105},
 		"TaskSchedulerEnableRateLimiterShadowMode":             {dynamicproperties.TaskSchedulerEnableRateLimiterShadowMode, false},
 		"TaskSchedulerEnableRateLimiter":                       {dynamicproperties.TaskSchedulerEnableRateLimiter, true},
 		"HostName":                                             {nil, hostname},
@@ -271,13 +287,24 @@ func TestNewConfig(t *testing.T) {
 		"DisableTimerFailoverQueue":                            {dynamicproperties.DisableTimerFailoverQueue, true},
 		"EnableTransferQueueV2":                                {dynamicproperties.EnableTransferQueueV2, true},
 		"EnableTimerQueueV2":                                   {dynamicproperties.EnableTimerQueueV2, true},
+		"EnableHousekeepingTaskQueue":                          {dynamicproperties.EnableHousekeepingTaskQueue, true},
 		"QueueMaxPendingTaskCount":                             {dynamicproperties.QueueMaxPendingTaskCount, 99},
 		"EnableTimerQueueV2PendingTaskCountAlert":              {dynamicproperties.EnableTimerQueueV2PendingTaskCountAlert, true},
 		"EnableTransferQueueV2PendingTaskCountAlert":           {dynamicproperties.EnableTransferQueueV2PendingTaskCountAlert, true},
 		"QueueCriticalPendingTaskCount":                        {dynamicproperties.QueueCriticalPendingTaskCount, 100},
 		"QueueMaxVirtualQueueCount":                            {dynamicproperties.QueueMaxVirtualQueueCount, 101},
 		"VirtualSliceForceAppendInterval":                      {dynamicproperties.VirtualSliceForceAppendInterval, time.Second},
+		"EnableQueueV2StuckSliceAlert":                         {dynamicproperties.EnableQueueV2StuckSliceAlert, true},
+		"EnableQueueV2DomainTaskFailureAlert":                  {dynamicproperties.EnableQueueV2DomainTaskFailureAlert, true},
+		"EnableQueueV2ScheduledLagAlert":                       {dynamicproperties.EnableQueueV2ScheduledLagAlert, true},
+		"QueueStuckSliceDuration":                              {dynamicproperties.QueueStuckSliceDuration, time.Second},
+		"QueueCriticalDomainTaskFailureRate":                   {dynamicproperties.QueueCriticalDomainTaskFailureRate, 1.0},
+		"QueueDomainTaskFailureRateMinTaskCount":               {dynamicproperties.QueueDomainTaskFailureRateMinTaskCount, 104},
+		"QueueMaxScheduledLag":                                 {dynamicproperties.QueueMaxScheduledLag, time.Second},
 		"ReplicationTaskProcessorLatencyLogThreshold":          {dynamicproperties.ReplicationTaskProcessorLatencyLogThreshold, time.Duration(0)},
+		"ReplicationDomainLagThreshold":                        {dynamicproperties.ReplicationDomainLagThreshold, time.Second},
+		"ReplicationDomainPendingTaskThreshold":                {dynamicproperties.ReplicationDomainPendingTaskThreshold, 105},
+		"ReplicationDomainBacklogMaxTasks":                     {dynamicproperties.ReplicationDomainBacklogMaxTasks, 106},
 	}
 	client := dynamicconfig.NewInMemoryClient()
 	for fieldName, expected := range fields {
@@ -365,6 +392,8 @@ func getValue(f *reflect.Value) interface{} {
 			return fn("domain", "workflowID")
 		case dynamicproperties.MapPropertyFnWithDomainFilter:
 			return fn("domain")
+		case dynamicproperties.ListPropertyFn:
+			return fn()
 		case dynamicproperties.BoolPropertyFnWithShardIDFilter:
 			return fn(0)
 		case func() []string:
@@ -21,6 +21,7 @@
 package decision
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -28,15 +29,20 @@ import (
 	"github.com/pborman/uuid"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/completioncallback"
 	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/crossdomain"
 	"github.com/uber/cadence/common/elasticsearch/validator"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/reserved"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/workflowupdate"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/execution"
 )
@@ -48,6 +54,8 @@ type (
 		metricsClient             metrics.Client
 		logger                    log.Logger
 		searchAttributesValidator *validator.SearchAttributesValidator
+		authorizer                authorization.Authorizer
+		currentClusterName        string
 	}
 
 	workflowSizeChecker struct {
@@ -75,12 +83,16 @@ func newAttrValidator(
 	metricsClient metrics.Client,
 	config *config.Config,
 	logger log.Logger,
+	authorizer authorization.Authorizer,
+	currentClusterName string,
 ) *attrValidator {
 	return &attrValidator{
-		config:        config,
-		domainCache:   domainCache,
-		metricsClient: metricsClient,
-		logger:        logger,
+		config:             config,
+		domainCache:        domainCache,
+		metricsClient:      metricsClient,
+		logger:             logger,
+		authorizer:         authorizer,
+		currentClusterName: currentClusterName,
 		searchAttributesValidator: validator.NewSearchAttributesValidator(
 			logger,
 			config.EnableQueryAttributeValidation,
@@ -565,6 +577,7 @@ func (v *attrValidator) validateCancelExternalWorkflowExecutionAttributes(
 }
 
 func (v *attrValidator) validateSignalExternalWorkflowExecutionAttributes(
+	ctx context.Context,
 	domainID string,
 	targetDomainID string,
 	attributes *types.SignalExternalWorkflowExecutionDecisionAttributes,
@@ -575,7 +588,12 @@ func (v *attrValidator) validateSignalExternalWorkflowExecutionAttributes(
 		domainID,
 		targetDomainID,
 	); err != nil {
-		return err
+		if err = v.validateAllowlistedCrossDomainCall(ctx, domainID, targetDomainID, err, &authorization.Attributes{
+			APIName:    "SignalWorkflowExecution",
+			Permission: authorization.PermissionWrite,
+		}); err != nil {
+			return err
+		}
 	}
 
 	if attributes == nil {
@@ -620,6 +638,11 @@ func (v *attrValidator) validateSignalExternalWorkflowExecutionAttributes(
 	if attributes.SignalName == "" {
 		return &types.BadRequestError{Message: "SignalName is not set on decision."}
 	}
+	// updates are the only reserved signals a workflow can send, the other reserved signals stand for APIs
+	// which are authorized for the caller
+	if reserved.IsSignalName(attributes.SignalName) && !workflowupdate.IsSignal(attributes.SignalName) {
+		return &types.BadRequestError{Message: fmt.Sprintf("Signal name %v is reserved.", attributes.SignalName)}
+	}
 
 	return nil
 }
@@ -694,14 +717,26 @@ func (v *attrValidator) validateContinueAsNewWorkflowExecutionAttributes(
 		return &types.BadRequestError{Message: "BackoffStartInterval is less than 0."}
 	}
 
+	if err := reserved.ValidateMemo(attributes.Memo.GetFields()); err != nil {
+		return err
+	}
+
 	domainName, err := v.domainCache.GetDomainName(executionInfo.DomainID)
 	if err != nil {
 		return err
 	}
+	if err := completioncallback.ValidateMemo(
+		attributes.Memo.GetFields(),
+		domainName,
+		v.config.CompletionCallbackAllowedURLPrefixes(),
+	); err != nil {
+		return &types.BadRequestError{Message: err.Error()}
+	}
 	return v.searchAttributesValidator.ValidateSearchAttributes(attributes.GetSearchAttributes(), domainName)
 }
 
 func (v *attrValidator) validateStartChildExecutionAttributes(
+	ctx context.Context,
 	domainID string,
 	targetDomainID string,
 	attributes *types.StartChildWorkflowExecutionDecisionAttributes,
@@ -709,15 +744,27 @@ func (v *attrValidator) validateStartChildExecutionAttributes(
 	metricsScope metrics.ScopeIdx,
 ) error {
 
+	if attributes == nil {
+		return &types.BadRequestError{Message: "StartChildWorkflowExecutionDecisionAttributes is not set on decision."}
+	}
+
 	if err := v.validateCrossDomainCall(
 		domainID,
 		targetDomainID,
 	); err != nil {
-		return err
-	}
-
-	if attributes == nil {
-		return &types.BadRequestError{Message: "StartChildWorkflowExecutionDecisionAttributes is not set on decision."}
+		if err = v.validateAllowlistedCrossDomainCall(ctx, domainID, targetDomainID, err, &authorization.Attributes{
+			APIName:      "StartWorkflowExecution",
+			WorkflowType: attributes.WorkflowType,
+			TaskList:     attributes.TaskList,
+			Permission:   authorization.PermissionWrite,
+		}); err != nil {
+			return err
+		}
+		// the child workflow reports back to its parent through the history service of this cluster,
+		// so it can only be started in a domain that is active here
+		if err = v.validateTargetDomainActive(targetDomainID); err != nil {
+			return err
+		}
 	}
 
 	if attributes.GetWorkflowID() == "" {
@@ -768,6 +815,10 @@ func (v *attrValidator) validateStartChildExecutionAttributes(
 		return err
 	}
 
+	if err := reserved.ValidateMemo(attributes.Memo.GetFields()); err != nil {
+		return err
+	}
+
 	if attributes.GetCronSchedule() != "" {
 		if _, err := backoff.ValidateSchedule(attributes.GetCronSchedule()); err != nil {
 			return err
@@ -895,6 +946,70 @@ func (v *attrValidator) validateCrossDomainCall(
 	return v.createCrossDomainCallError(sourceDomainEntry, targetDomainEntry)
 }
 
+// validateAllowlistedCrossDomainCall is checked when the source and target domains are not co-located,
+// that is when validateCrossDomainCall fails with callErr. The call is still allowed if cross domain calls
+// are enabled for the source domain, the target domain allowlists the source domain and the caller is
+// authorized to make the call in the target domain.
+func (v *attrValidator) validateAllowlistedCrossDomainCall(
+	ctx context.Context,
+	sourceDomainID string,
+	targetDomainID string,
+	callErr error,
+	attributes *authorization.Attributes,
+) error {
+
+	if _, ok := callErr.(*types.BadRequestError); !ok {
+		return callErr
+	}
+
+	sourceDomainEntry, err := v.domainCache.GetDomainByID(sourceDomainID)
+	if err != nil {
+		return err
+	}
+	targetDomainEntry, err := v.domainCache.GetDomainByID(targetDomainID)
+	if err != nil {
+		return err
+	}
+
+	sourceDomainName := sourceDomainEntry.GetInfo().Name
+	if !v.config.EnableCrossDomainCalls(sourceDomainName) ||
+		!crossdomain.IsCallerAllowed(targetDomainEntry, sourceDomainName) {
+		return callErr
+	}
+
+	attributes.DomainName = targetDomainEntry.GetInfo().Name
+	result, err := v.authorizer.Authorize(ctx, attributes)
+	if err != nil {
+		return err
+	}
+	if result.Decision != authorization.DecisionAllow {
+		return &types.BadRequestError{Message: fmt.Sprintf(
+			"cross domain call from %v to %v is not authorized",
+			sourceDomainName,
+			targetDomainEntry.GetInfo().Name,
+		)}
+	}
+	return nil
+}
+
+func (v *attrValidator) validateTargetDomainActive(
+	targetDomainID string,
+) error {
+
+	targetDomainEntry, err := v.domainCache.GetDomainByID(targetDomainID)
+	if err != nil {
+		return err
+	}
+	if !targetDomainEntry.IsActiveIn(v.currentClusterName) {
+		return &types.BadRequestError{Message: fmt.Sprintf(
+			"cannot make cross domain call to %v as it is not active in cluster %v",
+			targetDomainEntry.GetInfo().Name,
+			v.currentClusterName,
+		)}
+	}
+	return nil
+}
+
 func (v *attrValidator) createCrossDomainCallError(
 	domainEntry *cache.DomainCacheEntry,
 	targetDomainEntry *cache.DomainCacheEntry,
@@ -23,6 +23,7 @@
 package decision
 
 import (
+	"context"
 	"sort"
 	"testing"
 	"time"
@@ -36,9 +37,12 @@ import (
 	"golang.org/x/exp/maps"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/completioncallback"
 	commonconstants "github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/crossdomain"
 	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/log"
@@ -46,6 +50,8 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/workflowpause"
+	"github.com/uber/cadence/common/workflowupdate"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/constants"
 	"github.com/uber/cadence/service/history/execution"
@@ -58,6 +64,7 @@ type (
 
 		controller      *gomock.Controller
 		mockDomainCache *cache.MockDomainCache
+		mockAuthorizer  *authorization.MockAuthorizer
 
 		validator *attrValidator
 
@@ -87,6 +94,7 @@ func (s *attrValidatorSuite) SetupTest() {
 
 	s.controller = gomock.NewController(s.T())
 	s.mockDomainCache = cache.NewMockDomainCache(s.controller)
+	s.mockAuthorizer = authorization.NewMockAuthorizer(s.controller)
 	config := &config.Config{
 		MaxIDLengthWarnLimit:              dynamicproperties.GetIntPropertyFn(128),
 		DomainNameMaxLength:               dynamicproperties.GetIntPropertyFilteredByDomain(1000),
@@ -109,12 +117,18 @@ func (s *attrValidatorSuite) SetupTest() {
 			time.Duration(s.testActivityMaxScheduleToStartTimeoutForRetryInSeconds) * time.Second,
 		),
 		EnableCrossClusterOperationsForDomain: dynamicproperties.GetBoolPropertyFnFilteredByDomain(false),
+		EnableCrossDomainCalls:                dynamicproperties.GetBoolPropertyFnFilteredByDomain(false),
+		CompletionCallbackAllowedURLPrefixes: func(...dynamicproperties.FilterOption) []interface{} {
+			return []interface{}{"https://hooks.example.com/"}
+		},
 	}
 	s.validator = newAttrValidator(
 		s.mockDomainCache,
 		metrics.NewNoopMetricsClient(),
 		config,
 		log.NewNoop(),
+		s.mockAuthorizer,
+		cluster.TestCurrentClusterName,
 	)
 }
 
@@ -139,32 +153,74 @@ func (s *attrValidatorSuite) TestValidateSignalExternalWorkflowExecutionAttribut
 
 	var attributes *types.SignalExternalWorkflowExecutionDecisionAttributes
 
-	err := s.validator.validateSignalExternalWorkflowExecutionAttributes(s.testDomainID, s.testTargetDomainID, attributes, metrics.HistoryRespondDecisionTaskCompletedScope)
+	err := s.validator.validateSignalExternalWorkflowExecutionAttributes(context.Background(), s.testDomainID, s.testTargetDomainID, attributes, metrics.HistoryRespondDecisionTaskCompletedScope)
 	s.EqualError(err, "SignalExternalWorkflowExecutionDecisionAttributes is not set on decision.")
 
 	attributes = &types.SignalExternalWorkflowExecutionDecisionAttributes{}
-	err = s.validator.validateSignalExternalWorkflowExecutionAttributes(s.testDomainID, s.testTargetDomainID, attributes, metrics.HistoryRespondDecisionTaskCompletedScope)
+	err = s.validator.validateSignalExternalWorkflowExecutionAttributes(context.Background(), s.testDomainID, s.testTargetDomainID, attributes, metrics.HistoryRespondDecisionTaskCompletedScope)
 	s.EqualError(err, "Execution is nil on decision.")
 
 	attributes.Execution = &types.WorkflowExecution{}
 	attributes.Execution.WorkflowID = "workflow-id"
-	err = s.validator.validateSignalExternalWorkflowExecutionAttributes(s.testDomainID, s.testTargetDomainID, attributes, metrics.HistoryRespondDecisionTaskCompletedScope)
+	err = s.validator.validateSignalExternalWorkflowExecutionAttributes(context.Background(), s.testDomainID, s.testTargetDomainID, attributes, metrics.HistoryRespondDecisionTaskCompletedScope)
 	s.EqualError(err, "SignalName is not set on decision.")
 
 	attributes.Execution.RunID = "run-id"
-	err = s.validator.validateSignalExternalWorkflowExecutionAttributes(s.testDomainID, s.testTargetDomainID, attributes, metrics.HistoryRespondDecisionTaskCompletedScope)
+	err = s.validator.validateSignalExternalWorkflowExecutionAttributes(context.Background(), s.testDomainID, s.testTargetDomainID, attributes, metrics.HistoryRespondDecisionTaskCompletedScope)
 	s.EqualError(err, "Invalid RunId set on decision.")
 	attributes.Execution.RunID = constants.TestRunID
 
+	attributes.SignalName = workflowpause.PauseSignalName
+	err = s.validator.validateSignalExternalWorkflowExecutionAttributes(context.Background(), s.testDomainID, s.testTargetDomainID, attributes, metrics.HistoryRespondDecisionTaskCompletedScope)
+	s.EqualError(err, "Signal name __cadence_pause is reserved.")
+
+	attributes.SignalName = workflowupdate.SignalName("update-id")
+	err = s.validator.validateSignalExternalWorkflowExecutionAttributes(context.Background(), s.testDomainID, s.testTargetDomainID, attributes, metrics.HistoryRespondDecisionTaskCompletedScope)
+	s.NoError(err)
+
 	attributes.SignalName = "my signal name"
-	err = s.validator.validateSignalExternalWorkflowExecutionAttributes(s.testDomainID, s.testTargetDomainID, attributes, metrics.HistoryRespondDecisionTaskCompletedScope)
+	err = s.validator.validateSignalExternalWorkflowExecutionAttributes(context.Background(), s.testDomainID, s.testTargetDomainID, attributes, metrics.HistoryRespondDecisionTaskCompletedScope)
 	s.NoError(err)
 
 	attributes.Input = []byte("test input")
-	err = s.validator.validateSignalExternalWorkflowExecutionAttributes(s.testDomainID, s.testTargetDomainID, attributes, metrics.HistoryRespondDecisionTaskCompletedScope)
+	err = s.validator.validateSignalExternalWorkflowExecutionAttributes(context.Background(), s.testDomainID, s.testTargetDomainID, attributes, metrics.HistoryRespondDecisionTaskCompletedScope)
 	s.NoError(err)
 }
 
+func (s *attrValidatorSuite) TestValidateContinueAsNewWorkflowExecutionAttributes_Memo() {
+	s.mockDomainCache.EXPECT().GetDomainName(s.testDomainID).Return("test-domain", nil).AnyTimes()
+	executionInfo := &persistence.WorkflowExecutionInfo{
+		DomainID:         s.testDomainID,
+		WorkflowTypeName: "workflow-type",
+		TaskList:         "task-list",
+	}
+	validate := func(memo map[string][]byte) error {
+		return s.validator.validateContinueAsNewWorkflowExecutionAttributes(
+			&types.ContinueAsNewWorkflowExecutionDecisionAttributes{Memo: &types.Memo{Fields: memo}},
+			executionInfo,
+			metrics.HistoryRespondDecisionTaskCompletedScope,
+			"test-domain",
+		)
+	}
+	callbacks := func(callbacks ...completioncallback.Callback) map[string][]byte {
+		value, err := completioncallback.Encode(callbacks)
+		s.NoError(err)
+		return map[string][]byte{completioncallback.MemoKey: value}
+	}
+
+	s.NoError(validate(map[string][]byte{"owner": []byte("payments")}))
+	s.NoError(validate(callbacks(completioncallback.Callback{HTTP: &completioncallback.HTTPCallback{URL: "https://hooks.example.com/done"}})))
+	s.EqualError(validate(map[string][]byte{workflowpause.MemoKey: []byte("{}")}), "Memo key __cadence_paused is reserved.")
+	s.EqualError(
+		validate(callbacks(completioncallback.Callback{HTTP: &completioncallback.HTTPCallback{URL: "https://evil.example.com/done"}})),
+		"URL https://evil.example.com/done is not allowed for completion callbacks",
+	)
+	s.EqualError(
+		validate(callbacks(completioncallback.Callback{Signal: &completioncallback.SignalCallback{Domain: "other-domain", WorkflowID: "waiter", SignalName: "done"}})),
+		"completion callbacks can't signal workflows of domain other-domain",
+	)
+}
+
 func (s *attrValidatorSuite) TestValidateUpsertWorkflowSearchAttributes() {
 	domainName := "testDomain"
 	var attributes *types.UpsertWorkflowSearchAttributesDecisionAttributes
@@ -545,6 +601,157 @@ func (s *attrValidatorSuite) TestValidateCrossDomainCall_GlobalToGlobal_DiffDoma
 	s.IsType(&types.BadRequestError{}, err)
 }
 
+func (s *attrValidatorSuite) TestValidateAllowlistedCrossDomainCall() {
+	newDomainEntry := func(name string, data map[string]string, activeCluster string, clusters ...string) *cache.DomainCacheEntry {
+		replicationConfig := &persistence.DomainReplicationConfig{ActiveClusterName: activeCluster}
+		for _, clusterName := range clusters {
+			replicationConfig.Clusters = append(replicationConfig.Clusters, &persistence.ClusterReplicationConfig{ClusterName: clusterName})
+		}
+		return cache.NewGlobalDomainCacheEntryForTest(&persistence.DomainInfo{Name: name, Data: data}, nil, replicationConfig, 1234)
+	}
+	allowlist := map[string]string{commonconstants.DomainDataKeyForCrossDomainCallers: "other-domain source-domain"}
+	signalAttributes := &types.SignalExternalWorkflowExecutionDecisionAttributes{
+		Domain:     s.testTargetDomainID,
+		Execution:  &types.WorkflowExecution{WorkflowID: "workflow-id"},
+		SignalName: "signal",
+	}
+	childAttributes := &types.StartChildWorkflowExecutionDecisionAttributes{
+		Domain:       s.testTargetDomainID,
+		WorkflowID:   "workflow-id",
+		WorkflowType: &types.WorkflowType{Name: "workflow-type"},
+		TaskList:     &types.TaskList{Name: "task-list"},
+	}
+
+	tests := map[string]struct {
+		targetDomainEntry *cache.DomainCacheEntry
+		enabled           bool
+		setupMocks        func(*authorization.MockAuthorizer)
+		expectedErr       string
+	}{
+		"cross domain calls not enabled": {
+			targetDomainEntry: newDomainEntry("target-domain", allowlist, cluster.TestCurrentClusterName, cluster.TestCurrentClusterName),
+			enabled:           false,
+			expectedErr:       "cannot make cross domain call",
+		},
+		"source domain not allowlisted": {
+			targetDomainEntry: newDomainEntry("target-domain", nil, cluster.TestCurrentClusterName, cluster.TestCurrentClusterName),
+			enabled:           true,
+			expectedErr:       "cannot make cross domain call",
+		},
+		"not authorized": {
+			targetDomainEntry: newDomainEntry("target-domain", allowlist, cluster.TestCurrentClusterName, cluster.TestCurrentClusterName),
+			enabled:           true,
+			setupMocks: func(mockAuthorizer *authorization.MockAuthorizer) {
+				mockAuthorizer.EXPECT().Authorize(gomock.Any(), gomock.Any()).Return(authorization.Result{Decision: authorization.DecisionDeny}, nil).Times(2)
+			},
+			expectedErr: "is not authorized",
+		},
+		"authorized": {
+			targetDomainEntry: newDomainEntry("target-domain", allowlist, cluster.TestCurrentClusterName, cluster.TestCurrentClusterName),
+			enabled:           true,
+			setupMocks: func(mockAuthorizer *authorization.MockAuthorizer) {
+				mockAuthorizer.EXPECT().Authorize(gomock.Any(), &authorization.Attributes{
+					APIName:    "SignalWorkflowExecution",
+					DomainName: "target-domain",
+					Permission: authorization.PermissionWrite,
+				}).Return(authorization.Result{Decision: authorization.DecisionAllow}, nil)
+				mockAuthorizer.EXPECT().Authorize(gomock.Any(), &authorization.Attributes{
+					APIName:      "StartWorkflowExecution",
+					DomainName:   "target-domain",
+					WorkflowType: childAttributes.WorkflowType,
+					TaskList:     childAttributes.TaskList,
+					Permission:   authorization.PermissionWrite,
+				}).Return(authorization.Result{Decision: authorization.DecisionAllow}, nil)
+			},
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			controller := gomock.NewController(s.T())
+			mockDomainCache := cache.NewMockDomainCache(controller)
+			mockAuthorizer := authorization.NewMockAuthorizer(controller)
+			config := *s.validator.config
+			config.EnableCrossDomainCalls = dynamicproperties.GetBoolPropertyFnFilteredByDomain(test.enabled)
+			validator := newAttrValidator(mockDomainCache, metrics.NewNoopMetricsClient(), &config, log.NewNoop(), mockAuthorizer, cluster.TestCurrentClusterName)
+
+			sourceDomainEntry := newDomainEntry("source-domain", nil, cluster.TestCurrentClusterName, cluster.TestCurrentClusterName, cluster.TestAlternativeClusterName)
+			mockDomainCache.EXPECT().GetDomainByID(s.testDomainID).Return(sourceDomainEntry, nil).AnyTimes()
+			mockDomainCache.EXPECT().GetDomainByID(s.testTargetDomainID).Return(test.targetDomainEntry, nil).AnyTimes()
+			if test.setupMocks != nil {
+				test.setupMocks(mockAuthorizer)
+			}
+
+			signalErr := validator.validateSignalExternalWorkflowExecutionAttributes(context.Background(), s.testDomainID, s.testTargetDomainID, signalAttributes, metrics.HistoryRespondDecisionTaskCompletedScope)
+			childErr := validator.validateStartChildExecutionAttributes(context.Background(), s.testDomainID, s.testTargetDomainID, childAttributes, &persistence.WorkflowExecutionInfo{}, metrics.HistoryRespondDecisionTaskCompletedScope)
+			if test.expectedErr == "" {
+				s.NoError(signalErr)
+				s.NoError(childErr)
+			} else {
+				s.IsType(&types.BadRequestError{}, signalErr)
+				s.ErrorContains(signalErr, test.expectedErr)
+				s.IsType(&types.BadRequestError{}, childErr)
+				s.ErrorContains(childErr, test.expectedErr)
+			}
+		})
+	}
+}
+
+func (s *attrValidatorSuite) TestValidateStartChildExecutionAttributes_TargetDomainNotActive() {
+	sourceDomainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{Name: s.testDomainID},
+		nil,
+		cluster.TestCurrentClusterName,
+	)
+	targetDomainEntry := cache.NewGlobalDomainCacheEntryForTest(
+		&persistence.DomainInfo{
+			Name: s.testTargetDomainID,
+			Data: map[string]string{commonconstants.DomainDataKeyForCrossDomainCallers: crossdomain.AnyCaller},
+		},
+		nil,
+		&persistence.DomainReplicationConfig{
+			ActiveClusterName: cluster.TestAlternativeClusterName,
+			Clusters: []*persistence.ClusterReplicationConfig{
+				{ClusterName: cluster.TestCurrentClusterName},
+				{ClusterName: cluster.TestAlternativeClusterName},
+			},
+		},
+		1234,
+	)
+	s.mockDomainCache.EXPECT().GetDomainByID(s.testDomainID).Return(sourceDomainEntry, nil).AnyTimes()
+	s.mockDomainCache.EXPECT().GetDomainByID(s.testTargetDomainID).Return(targetDomainEntry, nil).AnyTimes()
+	s.mockAuthorizer.EXPECT().Authorize(gomock.Any(), gomock.Any()).Return(authorization.Result{Decision: authorization.DecisionAllow}, nil).Times(2)
+	s.validator.config.EnableCrossDomainCalls = dynamicproperties.GetBoolPropertyFnFilteredByDomain(true)
+
+	err := s.validator.validateStartChildExecutionAttributes(
+		context.Background(),
+		s.testDomainID,
+		s.testTargetDomainID,
+		&types.StartChildWorkflowExecutionDecisionAttributes{
+			Domain:       s.testTargetDomainID,
+			WorkflowID:   "workflow-id",
+			WorkflowType: &types.WorkflowType{Name: "workflow-type"},
+		},
+		&persistence.WorkflowExecutionInfo{},
+		metrics.HistoryRespondDecisionTaskCompletedScope,
+	)
+	s.IsType(&types.BadRequestError{}, err)
+	s.ErrorContains(err, "is not active in cluster")
+
+	// signals are forwarded to the cluster the target domain is active in
+	err = s.validator.validateSignalExternalWorkflowExecutionAttributes(
+		context.Background(),
+		s.testDomainID,
+		s.testTargetDomainID,
+		&types.SignalExternalWorkflowExecutionDecisionAttributes{
+			Domain:     s.testTargetDomainID,
+			Execution:  &types.WorkflowExecution{WorkflowID: "workflow-id"},
+			SignalName: "signal",
+		},
+		metrics.HistoryRespondDecisionTaskCompletedScope,
+	)
+	s.NoError(err)
+}
+
 func (s *attrValidatorSuite) TestValidateTaskListName() {
 	taskList := func(name string) *types.TaskList {
 		kind := types.TaskListKindNormal
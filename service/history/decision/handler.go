@@ -98,6 +98,8 @@ func NewHandler(
 			shard.GetMetricsClient(),
 			config,
 			logger,
+			shard.GetService().GetAuthorizer(),
+			shard.GetClusterMetadata().GetCurrentClusterName(),
 		),
 		versionChecker: client.NewVersionChecker(),
 	}
@@ -34,6 +34,7 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/workflowupdate"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/execution"
 	"github.com/uber/cadence/service/history/workflow"
@@ -759,6 +760,18 @@ func (handler *taskHandlerImpl) handleDecisionRecordMarker(
 		return err
 	}
 
+	// update markers must answer an update that was requested and is not answered yet, otherwise callers waiting
+	// for the update would never be able to tell it apart from an update that was never delivered to the workflow
+	if workflowupdate.IsMarker(attr.GetMarkerName()) {
+		updateID := workflowupdate.UpdateID(attr.Header)
+		if !workflowupdate.CanAnswer(handler.mutableState.GetExecutionInfo().Memo, updateID) {
+			return handler.handlerFailDecision(
+				types.DecisionTaskFailedCauseBadRecordMarkerAttributes,
+				fmt.Sprintf("marker %v does not answer a pending update: %q", attr.GetMarkerName(), updateID),
+			)
+		}
+	}
+
 	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfBlobSizeExceedsLimit(
 		metrics.DecisionTypeTag(types.DecisionTypeRecordMarker.String()),
 		attr.Details,
@@ -894,6 +907,7 @@ func (handler *taskHandlerImpl) handleDecisionStartChildWorkflow(
 	if err := handler.validateDecisionAttr(
 		func() error {
 			return handler.attrValidator.validateStartChildExecutionAttributes(
+				ctx,
 				domainID,
 				targetDomainID,
 				attr,
@@ -964,6 +978,7 @@ func (handler *taskHandlerImpl) handleDecisionSignalExternalWorkflow(
 	if err := handler.validateDecisionAttr(
 		func() error {
 			return handler.attrValidator.validateSignalExternalWorkflowExecutionAttributes(
+				ctx,
 				domainID,
 				targetDomainID,
 				attr,
@@ -47,6 +47,7 @@ import (
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/common/types/testdata"
+	"github.com/uber/cadence/common/workflowupdate"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/constants"
 	"github.com/uber/cadence/service/history/execution"
@@ -1214,6 +1215,55 @@ func TestHandleDecisionRecordMarker(t *testing.T) {
 				assert.Nil(t, err)
 			},
 		},
+		{
+			name: "update marker answering a requested update",
+			attributes: &types.RecordMarkerDecisionAttributes{
+				MarkerName: workflowupdate.CompletedMarkerName,
+				Details:    []byte("some-result"),
+				Header:     &types.Header{Fields: map[string][]byte{workflowupdate.IDHeaderKey: []byte("update-id")}},
+			},
+			expectMockCalls: func(taskHandler *taskHandlerImpl, attr *types.RecordMarkerDecisionAttributes) {
+				memo, _ := workflowupdate.Apply(nil, &types.HistoryEvent{
+					WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{
+						SignalName: workflowupdate.SignalName("set-price"),
+						RequestID:  "update-id",
+					},
+				})
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{Memo: memo}).Times(2)
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().AddRecordMarkerEvent(taskHandler.decisionTaskCompletedID, attr)
+			},
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, attr *types.RecordMarkerDecisionAttributes, err error) {
+				assert.Nil(t, err)
+				assert.False(t, taskHandler.failDecision)
+			},
+		},
+		{
+			name: "update marker answering an unknown update",
+			attributes: &types.RecordMarkerDecisionAttributes{
+				MarkerName: workflowupdate.AcceptedMarkerName,
+				Header:     &types.Header{Fields: map[string][]byte{workflowupdate.IDHeaderKey: []byte("update-id")}},
+			},
+			expectMockCalls: func(taskHandler *taskHandlerImpl, attr *types.RecordMarkerDecisionAttributes) {
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{})
+			},
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, attr *types.RecordMarkerDecisionAttributes, err error) {
+				assert.Nil(t, err)
+				assert.True(t, taskHandler.failDecision)
+				assert.Equal(t, types.DecisionTaskFailedCauseBadRecordMarkerAttributes, *taskHandler.failDecisionCause)
+			},
+		},
+		{
+			name:       "update marker without update ID",
+			attributes: &types.RecordMarkerDecisionAttributes{MarkerName: workflowupdate.RejectedMarkerName},
+			expectMockCalls: func(taskHandler *taskHandlerImpl, attr *types.RecordMarkerDecisionAttributes) {
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{})
+			},
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, attr *types.RecordMarkerDecisionAttributes, err error) {
+				assert.Nil(t, err)
+				assert.True(t, taskHandler.failDecision)
+				assert.Equal(t, types.DecisionTaskFailedCauseBadRecordMarkerAttributes, *taskHandler.failDecisionCause)
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -1661,7 +1711,7 @@ func newTaskHandlerForTest(t *testing.T) *taskHandlerImpl {
 		testTaskCompletedID,
 		constants.TestLocalDomainEntry,
 		mockMutableState,
-		newAttrValidator(mockDomainCache, metrics.NewClient(tally.NoopScope, metrics.History, metrics.HistogramMigration{}), testConfig, testlogger.New(t)),
+		newAttrValidator(mockDomainCache, metrics.NewClient(tally.NoopScope, metrics.History, metrics.HistogramMigration{}), testConfig, testlogger.New(t), nil, cluster.TestCurrentClusterName),
 		workflowSizeChecker,
 		common.NewMockTaskTokenSerializer(ctrl),
 		testLogger,
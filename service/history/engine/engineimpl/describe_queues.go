@@ -26,6 +26,7 @@ import (
 	"fmt"
 
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/replicationstatus"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/queue"
 )
@@ -44,6 +45,66 @@ func (e *historyEngineImpl) DescribeTimerQueue(
 	return e.describeQueue(ctx, persistence.HistoryTaskCategoryTimer, clusterName)
 }
 
+// DescribeReplicationQueue returns the replication lag of the domains replicated to the shard from the given cluster,
+// or the replication backlog of the shard for the standby cluster of a cluster name returned by
+// replicationstatus.BacklogClusterName. See replicationstatus for the encoding of the response.
+func (e *historyEngineImpl) DescribeReplicationQueue(
+	ctx context.Context,
+	clusterName string,
+) (*types.DescribeQueueResponse, error) {
+	if standbyCluster, ok := replicationstatus.ParseBacklogClusterName(clusterName); ok {
+		return e.describeReplicationBacklog(ctx, standbyCluster)
+	}
+
+	var domainLags []string
+	for _, replicationTaskProcessor := range e.replicationTaskProcessors {
+		if replicationTaskProcessor.GetSourceCluster() != clusterName {
+			continue
+		}
+		lags, err := replicationTaskProcessor.DescribeDomainLags(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, lag := range lags {
+			value, err := replicationstatus.EncodeDomainLag(lag)
+			if err != nil {
+				return nil, err
+			}
+			domainLags = append(domainLags, value)
+		}
+	}
+	return &types.DescribeQueueResponse{
+		ProcessingQueueStates: domainLags,
+	}, nil
+}
+
+func (e *historyEngineImpl) describeReplicationBacklog(
+	ctx context.Context,
+	standbyCluster string,
+) (*types.DescribeQueueResponse, error) {
+	backlogs, err := e.replicationAckManager.GetBacklog(
+		ctx,
+		standbyCluster,
+		e.config.ReplicatorProcessorFetchTasksBatchSize(e.shard.GetShardID()),
+		e.config.ReplicationDomainBacklogMaxTasks(e.shard.GetShardID()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	domainBacklogs := make([]string, 0, len(backlogs))
+	for _, backlog := range backlogs {
+		value, err := replicationstatus.EncodeDomainBacklog(backlog)
+		if err != nil {
+			return nil, err
+		}
+		domainBacklogs = append(domainBacklogs, value)
+	}
+	return &types.DescribeQueueResponse{
+		ProcessingQueueStates: domainBacklogs,
+	}, nil
+}
+
 func (e *historyEngineImpl) describeQueue(
 	ctx context.Context,
 	category persistence.HistoryTaskCategory,
@@ -58,10 +119,11 @@ func (e *historyEngineImpl) describeQueue(
 		return nil, err
 	}
 
-	serializedStates := make([]string, 0, len(resp.GetStateActionResult.States))
+	serializedStates := make([]string, 0, len(resp.GetStateActionResult.States)+len(resp.GetStateActionResult.Descriptions))
 	for _, state := range resp.GetStateActionResult.States {
 		serializedStates = append(serializedStates, e.serializeQueueState(state))
 	}
+	serializedStates = append(serializedStates, resp.GetStateActionResult.Descriptions...)
 	return &types.DescribeQueueResponse{
 		ProcessingQueueStates: serializedStates,
 	}, nil
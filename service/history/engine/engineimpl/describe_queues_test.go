@@ -0,0 +1,139 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/replicationstatus"
+	"github.com/uber/cadence/common/types/mapper/proto"
+	"github.com/uber/cadence/service/history/config"
+	"github.com/uber/cadence/service/history/replication"
+	"github.com/uber/cadence/service/history/shard"
+)
+
+type fakeReplicationTaskProcessor struct {
+	replication.TaskProcessor
+	sourceCluster string
+	lags          []*replicationstatus.DomainLag
+	err           error
+}
+
+func (p *fakeReplicationTaskProcessor) GetSourceCluster() string {
+	return p.sourceCluster
+}
+
+func (p *fakeReplicationTaskProcessor) DescribeDomainLags(ctx context.Context) ([]*replicationstatus.DomainLag, error) {
+	return p.lags, p.err
+}
+
+func TestDescribeReplicationQueue(t *testing.T) {
+	lag := &replicationstatus.DomainLag{DomainID: "domain-id", SourceCluster: "active", ShardID: 1, Lag: time.Second, PendingTasks: 2}
+	engine := &historyEngineImpl{
+		replicationTaskProcessors: []replication.TaskProcessor{
+			&fakeReplicationTaskProcessor{sourceCluster: "active", lags: []*replicationstatus.DomainLag{lag}},
+			&fakeReplicationTaskProcessor{sourceCluster: "other", err: errors.New("not described")},
+		},
+	}
+
+	resp, err := engine.DescribeReplicationQueue(context.Background(), "active")
+	require.NoError(t, err)
+	require.Len(t, resp.ProcessingQueueStates, 1)
+	decoded, err := replicationstatus.DecodeDomainLag(resp.ProcessingQueueStates[0])
+	require.NoError(t, err)
+	lag.Version = replicationstatus.Version
+	assert.Equal(t, lag, decoded)
+
+	resp, err = engine.DescribeReplicationQueue(context.Background(), "unknown")
+	require.NoError(t, err)
+	assert.Empty(t, resp.ProcessingQueueStates)
+
+	_, err = engine.DescribeReplicationQueue(context.Background(), "other")
+	assert.Error(t, err)
+}
+
+func TestDescribeReplicationQueue_Backlog(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockShard := shard.NewMockContext(ctrl)
+	mockShard.EXPECT().GetShardID().Return(1).AnyTimes()
+	mockShard.EXPECT().GetQueueClusterAckLevel(persistence.HistoryTaskCategoryReplication, "standby").Return(persistence.NewImmediateTaskKey(10))
+	mockShard.EXPECT().UpdateIfNeededAndGetQueueMaxReadLevel(persistence.HistoryTaskCategoryReplication, "standby").Return(persistence.NewImmediateTaskKey(20))
+	mockExecutionManager := persistence.NewMockExecutionManager(ctrl)
+	mockExecutionManager.EXPECT().GetHistoryTasks(gomock.Any(), &persistence.GetHistoryTasksRequest{
+		TaskCategory:        persistence.HistoryTaskCategoryReplication,
+		InclusiveMinTaskKey: persistence.NewImmediateTaskKey(11),
+		ExclusiveMaxTaskKey: persistence.NewImmediateTaskKey(21),
+		PageSize:            100,
+	}).Return(&persistence.GetHistoryTasksResponse{
+		Tasks: []persistence.Task{
+			&persistence.HistoryReplicationTask{
+				WorkflowIdentifier: persistence.WorkflowIdentifier{DomainID: "domain-id"},
+				TaskData:           persistence.TaskData{TaskID: 11, VisibilityTimestamp: time.Unix(1, 0).UTC()},
+			},
+		},
+	}, nil)
+
+	cfg := config.NewForTest()
+	cfg.ReplicatorProcessorFetchTasksBatchSize = dynamicproperties.GetIntPropertyFilteredByShardID(100)
+	cfg.ReplicationDomainBacklogMaxTasks = dynamicproperties.GetIntPropertyFilteredByShardID(1000)
+	engine := &historyEngineImpl{
+		shard:  mockShard,
+		config: cfg,
+		replicationAckManager: replication.NewTaskAckManager(
+			1,
+			mockShard,
+			metrics.NewNoopMetricsClient(),
+			log.NewNoop(),
+			replication.NewTaskReader(1, mockExecutionManager),
+			nil,
+			clock.NewMockedTimeSource(),
+			cfg,
+			proto.ReplicationMessagesSize,
+			nil,
+		),
+	}
+
+	resp, err := engine.DescribeReplicationQueue(context.Background(), replicationstatus.BacklogClusterName("standby"))
+	require.NoError(t, err)
+	require.Len(t, resp.ProcessingQueueStates, 1)
+	decoded, err := replicationstatus.DecodeDomainBacklog(resp.ProcessingQueueStates[0])
+	require.NoError(t, err)
+	assert.Equal(t, &replicationstatus.DomainBacklog{
+		Version:        replicationstatus.Version,
+		DomainID:       "domain-id",
+		PendingTasks:   1,
+		OldestTaskTime: time.Unix(1, 0).UTC(),
+	}, decoded)
+}
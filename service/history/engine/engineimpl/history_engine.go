@@ -108,6 +108,7 @@ type historyEngineImpl struct {
 	clientChecker             client.VersionChecker
 	replicationDLQHandler     replication.DLQHandler
 	failoverMarkerNotifier    failover.MarkerNotifier
+	failoverMarkerDrainer     failover.MarkerDrainer
 
 	updateWithActionFn func(
 		context.Context,
@@ -151,6 +152,8 @@ func NewEngineWithShardContext(
 	historyV2Manager := shard.GetHistoryManager()
 	executionCache := execution.NewCache(shard)
 	failoverMarkerNotifier := failover.NewMarkerNotifier(shard, config, failoverCoordinator)
+	queueProcessors := make(map[persistence.HistoryTaskCategory]queue.Processor)
+	failoverMarkerDrainer := failover.NewMarkerDrainer(shard, config, queueProcessors)
 	replicationHydrator := replication.NewDeferredTaskHydrator(shard.GetShardID(), historyV2Manager, executionCache, shard.GetDomainCache())
 	replicationTaskStore := replication.NewTaskStore(
 		shard.GetConfig(),
@@ -188,6 +191,7 @@ func NewEngineWithShardContext(
 		rawMatchingClient:      rawMatchingClient,
 		clientChecker:          client.NewVersionChecker(),
 		failoverMarkerNotifier: failoverMarkerNotifier,
+		failoverMarkerDrainer:  failoverMarkerDrainer,
 		replicationHydrator:    replicationHydrator,
 		replicationAckManager: replication.NewTaskAckManager(
 			shard.GetShardID(),
@@ -205,7 +209,7 @@ func NewEngineWithShardContext(
 		replicationMetricsEmitter: replication.NewMetricsEmitter(
 			shard.GetShardID(), shard, replicationReader, shard.GetMetricsClient()),
 		updateWithActionFn: workflow.UpdateWithAction,
-		queueProcessors:    make(map[persistence.HistoryTaskCategory]queue.Processor),
+		queueProcessors:    queueProcessors,
 	}
 	historyEngImpl.decisionHandler = decision.NewHandler(
 		shard,
@@ -220,11 +224,14 @@ func NewEngineWithShardContext(
 	openExecutionCheck := invariant.NewConcreteExecutionExists(pRetry, shard.GetDomainCache())
 
 	for _, factory := range queueFactories {
-		historyEngImpl.queueProcessors[factory.Category()] = factory.CreateQueue(
+		// a factory doesn't create a queue when the queue isn't enabled for the shard
+		if processor := factory.CreateQueue(
 			shard,
 			executionCache,
 			openExecutionCheck,
-		)
+		); processor != nil {
+			historyEngImpl.queueProcessors[factory.Category()] = processor
+		}
 	}
 
 	historyEngImpl.eventsReapplier = ndc.NewEventsReapplier(shard.GetMetricsClient(), logger)
@@ -319,6 +326,7 @@ func (e *historyEngineImpl) Start() {
 	if e.config.EnableGracefulFailover() {
 		e.failoverMarkerNotifier.Start()
 	}
+	e.failoverMarkerDrainer.Start()
 
 }
 
@@ -341,6 +349,9 @@ func (e *historyEngineImpl) Stop() {
 
 	// unset the failover callback
 	e.shard.GetDomainCache().UnregisterDomainChangeCallback(createShardNameFromShardID(e.shard.GetShardID()))
+
+	// stop the drainer after the failover callback is unset so no markers are left behind
+	e.failoverMarkerDrainer.Stop()
 }
 
 // ScheduleDecisionTask schedules a decision if no outstanding decision found
@@ -43,19 +43,25 @@ import (
 	hclient "github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/activitycontrol"
 	"github.com/uber/cadence/common/cache"
 	cc "github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/completioncallback"
 	commonconstants "github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/delayedsignal"
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/testlogger"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/searchattrupdate"
+	"github.com/uber/cadence/common/signaldedup"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/common/types/mapper/thrift"
+	"github.com/uber/cadence/common/workflowpause"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/constants"
 	"github.com/uber/cadence/service/history/decision"
@@ -1466,6 +1472,71 @@ func (s *engineSuite) TestValidateSignalRequest() {
 	s.Error(err, "startRequest doesn't have request id, it should error out")
 }
 
+func (s *engineSuite) TestValidateStartWorkflowExecutionRequest_CompletionCallbacks() {
+	encode := func(callbacks ...completioncallback.Callback) []byte {
+		value, err := json.Marshal(callbacks)
+		s.NoError(err)
+		return value
+	}
+	s.mockHistoryEngine.config.CompletionCallbackAllowedURLPrefixes = func(...dynamicproperties.FilterOption) []interface{} {
+		return []interface{}{"https://hooks.example.com/"}
+	}
+
+	testCases := map[string]struct {
+		memo    map[string][]byte
+		wantErr bool
+	}{
+		"no callbacks": {},
+		"allowed callbacks": {
+			memo: map[string][]byte{completioncallback.MemoKey: encode(
+				completioncallback.Callback{HTTP: &completioncallback.HTTPCallback{URL: "https://hooks.example.com/done"}},
+				completioncallback.Callback{Signal: &completioncallback.SignalCallback{WorkflowID: "waiter", SignalName: "done"}},
+			)},
+		},
+		"URL not allowed": {
+			memo: map[string][]byte{completioncallback.MemoKey: encode(
+				completioncallback.Callback{HTTP: &completioncallback.HTTPCallback{URL: "http://169.254.169.254/latest"}},
+			)},
+			wantErr: true,
+		},
+		"malformed callbacks": {
+			memo:    map[string][]byte{completioncallback.MemoKey: []byte("not json")},
+			wantErr: true,
+		},
+		"signal of other domain": {
+			memo: map[string][]byte{completioncallback.MemoKey: encode(
+				completioncallback.Callback{Signal: &completioncallback.SignalCallback{Domain: "other-domain", WorkflowID: "waiter", SignalName: "done"}},
+			)},
+			wantErr: true,
+		},
+		"reserved memo key": {
+			memo:    map[string][]byte{workflowpause.MemoKey: []byte("{}")},
+			wantErr: true,
+		},
+	}
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			startRequest := &types.StartWorkflowExecutionRequest{
+				Domain:                              constants.TestDomainName,
+				WorkflowID:                          "ID",
+				WorkflowType:                        &types.WorkflowType{Name: "testType"},
+				TaskList:                            &types.TaskList{Name: "taskptr"},
+				ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(10),
+				TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+				RequestID:                           uuid.New(),
+				Memo:                                &types.Memo{Fields: tc.memo},
+			}
+
+			err := s.mockHistoryEngine.validateStartWorkflowExecutionRequest(startRequest, 0)
+			if tc.wantErr {
+				s.IsType(&types.BadRequestError{}, err)
+			} else {
+				s.NoError(err)
+			}
+		})
+	}
+}
+
 func (s *engineSuite) TestRespondDecisionTaskCompletedMaxAttemptsExceeded() {
 	testActiveClusterInfo := &types.ActiveClusterInfo{
 		ActiveClusterName: constants.TestLocalDomainEntry.GetReplicationConfig().ActiveClusterName,
@@ -5709,6 +5780,544 @@ func (s *engineSuite) TestSignalWorkflowExecution_WorkflowCompleted() {
 	s.EqualError(err, "workflow execution already completed")
 }
 
+func (s *engineSuite) TestSignalWorkflowExecution_Pause() {
+	testActiveClusterInfo := &types.ActiveClusterInfo{
+		ActiveClusterName: constants.TestLocalDomainEntry.GetReplicationConfig().ActiveClusterName,
+		FailoverVersion:   constants.TestLocalDomainEntry.GetFailoverVersion(),
+	}
+	s.mockShard.Resource.ActiveClusterMgr.EXPECT().GetActiveClusterInfoByWorkflow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(testActiveClusterInfo, nil).AnyTimes()
+
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	input, err := workflowpause.EncodeRequest("investigating incident")
+	s.NoError(err)
+	signalRequest := &types.HistorySignalWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		SignalRequest: &types.SignalWorkflowExecutionRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			Identity:          identity,
+			SignalName:        workflowpause.PauseSignalName,
+			Input:             input,
+		},
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		testlogger.New(s.Suite.T()),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity, nil)
+	di := test.AddDecisionTaskScheduledEvent(msBuilder)
+	test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tasklist, identity)
+	test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, di.ScheduleID+1, nil, identity)
+	ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	var update *persistence.UpdateWorkflowExecutionRequest
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		update = args.Get(1).(*persistence.UpdateWorkflowExecutionRequest)
+	}).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err = s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
+	s.Nil(err)
+	s.NotNil(update)
+	info, ok := workflowpause.GetInfo(update.UpdateWorkflowMutation.ExecutionInfo.Memo)
+	s.True(ok)
+	s.Equal("investigating incident", info.Reason)
+	s.Equal(identity, info.Identity)
+	// pausing doesn't schedule a decision
+	s.Equal(commonconstants.EmptyEventID, update.UpdateWorkflowMutation.ExecutionInfo.DecisionScheduleID)
+}
+
+func (s *engineSuite) TestSignalWorkflowExecution_Pause_AlreadyPaused() {
+	testActiveClusterInfo := &types.ActiveClusterInfo{
+		ActiveClusterName: constants.TestLocalDomainEntry.GetReplicationConfig().ActiveClusterName,
+		FailoverVersion:   constants.TestLocalDomainEntry.GetFailoverVersion(),
+	}
+	s.mockShard.Resource.ActiveClusterMgr.EXPECT().GetActiveClusterInfoByWorkflow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(testActiveClusterInfo, nil).AnyTimes()
+
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	signalRequest := &types.HistorySignalWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		SignalRequest: &types.SignalWorkflowExecutionRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			Identity:          identity,
+			SignalName:        workflowpause.PauseSignalName,
+		},
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		testlogger.New(s.Suite.T()),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity, nil)
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+	ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	ms.ExecutionInfo.Memo = map[string][]byte{workflowpause.MemoKey: []byte("{}")}
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
+	s.Nil(err)
+}
+
+func (s *engineSuite) TestSignalWorkflowExecution_Unpause() {
+	testActiveClusterInfo := &types.ActiveClusterInfo{
+		ActiveClusterName: constants.TestLocalDomainEntry.GetReplicationConfig().ActiveClusterName,
+		FailoverVersion:   constants.TestLocalDomainEntry.GetFailoverVersion(),
+	}
+	s.mockShard.Resource.ActiveClusterMgr.EXPECT().GetActiveClusterInfoByWorkflow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(testActiveClusterInfo, nil).AnyTimes()
+
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	signalRequest := &types.HistorySignalWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		SignalRequest: &types.SignalWorkflowExecutionRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			Identity:          identity,
+			SignalName:        workflowpause.UnpauseSignalName,
+		},
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		testlogger.New(s.Suite.T()),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity, nil)
+	di := test.AddDecisionTaskScheduledEvent(msBuilder)
+	ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	ms.ExecutionInfo.Memo = map[string][]byte{workflowpause.MemoKey: []byte("{}")}
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	var update *persistence.UpdateWorkflowExecutionRequest
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		update = args.Get(1).(*persistence.UpdateWorkflowExecutionRequest)
+	}).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
+	s.Nil(err)
+	s.NotNil(update)
+	s.False(workflowpause.IsPaused(update.UpdateWorkflowMutation.ExecutionInfo.Memo))
+	// the decision skipped while paused is dispatched again
+	regenerated := false
+	for _, task := range update.UpdateWorkflowMutation.TasksByCategory[persistence.HistoryTaskCategoryTransfer] {
+		if decisionTask, ok := task.(*persistence.DecisionTask); ok && decisionTask.ScheduleID == di.ScheduleID {
+			regenerated = true
+		}
+	}
+	s.True(regenerated)
+}
+
+func (s *engineSuite) TestSignalWorkflowExecution_ActivityReset() {
+	testActiveClusterInfo := &types.ActiveClusterInfo{
+		ActiveClusterName: constants.TestLocalDomainEntry.GetReplicationConfig().ActiveClusterName,
+		FailoverVersion:   constants.TestLocalDomainEntry.GetFailoverVersion(),
+	}
+	s.mockShard.Resource.ActiveClusterMgr.EXPECT().GetActiveClusterInfoByWorkflow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(testActiveClusterInfo, nil).AnyTimes()
+
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	input, err := activitycontrol.EncodeRequest(&activitycontrol.Request{ActivityID: "activity1"})
+	s.NoError(err)
+	signalRequest := &types.HistorySignalWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		SignalRequest: &types.SignalWorkflowExecutionRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			Identity:          identity,
+			SignalName:        activitycontrol.ResetSignalName,
+			Input:             input,
+		},
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		testlogger.New(s.Suite.T()),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity, nil)
+	di := test.AddDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tasklist, identity)
+	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, decisionStartedEvent.ID, nil, identity)
+	activityScheduledEvent, _ := test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.ID, "activity1", "activity_type1", tasklist, []byte("input1"), 100, 10, 1, 5)
+	ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	ms.ActivityInfos[activityScheduledEvent.ID].Attempt = 3
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	var update *persistence.UpdateWorkflowExecutionRequest
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		update = args.Get(1).(*persistence.UpdateWorkflowExecutionRequest)
+	}).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err = s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
+	s.Nil(err)
+	s.NotNil(update)
+	// resetting an activity doesn't schedule a decision
+	s.Equal(commonconstants.EmptyEventID, update.UpdateWorkflowMutation.ExecutionInfo.DecisionScheduleID)
+	var resetActivity *persistence.ActivityInfo
+	for _, ai := range update.UpdateWorkflowMutation.UpsertActivityInfos {
+		if ai.ScheduleID == activityScheduledEvent.ID {
+			resetActivity = ai
+		}
+	}
+	s.NotNil(resetActivity)
+	s.Equal(int32(0), resetActivity.Attempt)
+	// the new attempt is dispatched right away
+	dispatched := false
+	for _, task := range update.UpdateWorkflowMutation.TasksByCategory[persistence.HistoryTaskCategoryTimer] {
+		if retryTask, ok := task.(*persistence.ActivityRetryTimerTask); ok && retryTask.EventID == activityScheduledEvent.ID {
+			s.Equal(int64(0), retryTask.Attempt)
+			dispatched = true
+		}
+	}
+	s.True(dispatched)
+}
+
+func (s *engineSuite) TestSignalWorkflowExecution_ActivityControl_ActivityNotFound() {
+	testActiveClusterInfo := &types.ActiveClusterInfo{
+		ActiveClusterName: constants.TestLocalDomainEntry.GetReplicationConfig().ActiveClusterName,
+		FailoverVersion:   constants.TestLocalDomainEntry.GetFailoverVersion(),
+	}
+	s.mockShard.Resource.ActiveClusterMgr.EXPECT().GetActiveClusterInfoByWorkflow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(testActiveClusterInfo, nil).AnyTimes()
+
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	input, err := activitycontrol.EncodeRequest(&activitycontrol.Request{ActivityID: "activity1"})
+	s.NoError(err)
+	signalRequest := &types.HistorySignalWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		SignalRequest: &types.SignalWorkflowExecutionRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			Identity:          identity,
+			SignalName:        activitycontrol.PauseSignalName,
+			Input:             input,
+		},
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		testlogger.New(s.Suite.T()),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity, nil)
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+	ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err = s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
+	s.Equal(workflow.ErrActivityTaskNotFound, err)
+}
+
+func (s *engineSuite) TestSignalWorkflowExecution_SearchAttributesUpdate() {
+	testActiveClusterInfo := &types.ActiveClusterInfo{
+		ActiveClusterName: constants.TestLocalDomainEntry.GetReplicationConfig().ActiveClusterName,
+		FailoverVersion:   constants.TestLocalDomainEntry.GetFailoverVersion(),
+	}
+	s.mockShard.Resource.ActiveClusterMgr.EXPECT().GetActiveClusterInfoByWorkflow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(testActiveClusterInfo, nil).AnyTimes()
+
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	input, err := searchattrupdate.EncodeRequest(&types.SearchAttributes{IndexedFields: map[string][]byte{"CustomKeywordField": []byte(`"value"`)}})
+	s.NoError(err)
+	signalRequest := &types.HistorySignalWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		SignalRequest: &types.SignalWorkflowExecutionRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			Identity:          identity,
+			SignalName:        searchattrupdate.SignalName,
+			Input:             input,
+		},
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		testlogger.New(s.Suite.T()),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity, nil)
+	di := test.AddDecisionTaskScheduledEvent(msBuilder)
+	test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tasklist, identity)
+	test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, di.ScheduleID+1, nil, identity)
+	ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	var update *persistence.UpdateWorkflowExecutionRequest
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		update = args.Get(1).(*persistence.UpdateWorkflowExecutionRequest)
+	}).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err = s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
+	s.Nil(err)
+	s.NotNil(update)
+	s.Equal([]byte(`"value"`), update.UpdateWorkflowMutation.ExecutionInfo.SearchAttributes["CustomKeywordField"])
+	s.Equal(commonconstants.EmptyEventID, update.UpdateWorkflowMutation.ExecutionInfo.DecisionScheduleID)
+}
+
+func (s *engineSuite) TestSignalWorkflowExecution_SearchAttributesUpdate_Invalid() {
+	testActiveClusterInfo := &types.ActiveClusterInfo{
+		ActiveClusterName: constants.TestLocalDomainEntry.GetReplicationConfig().ActiveClusterName,
+		FailoverVersion:   constants.TestLocalDomainEntry.GetFailoverVersion(),
+	}
+	s.mockShard.Resource.ActiveClusterMgr.EXPECT().GetActiveClusterInfoByWorkflow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(testActiveClusterInfo, nil).AnyTimes()
+
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	input, err := searchattrupdate.EncodeRequest(&types.SearchAttributes{IndexedFields: map[string][]byte{"WorkflowID": []byte(`"value"`)}})
+	s.NoError(err)
+	signalRequest := &types.HistorySignalWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		SignalRequest: &types.SignalWorkflowExecutionRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			Identity:          identity,
+			SignalName:        searchattrupdate.SignalName,
+			Input:             input,
+		},
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		testlogger.New(s.Suite.T()),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity, nil)
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+	ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err = s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
+	var badRequest *types.BadRequestError
+	s.ErrorAs(err, &badRequest)
+}
+
+func (s *engineSuite) TestSignalWorkflowExecution_DelayedSignal() {
+	testActiveClusterInfo := &types.ActiveClusterInfo{
+		ActiveClusterName: constants.TestLocalDomainEntry.GetReplicationConfig().ActiveClusterName,
+		FailoverVersion:   constants.TestLocalDomainEntry.GetFailoverVersion(),
+	}
+	s.mockShard.Resource.ActiveClusterMgr.EXPECT().GetActiveClusterInfoByWorkflow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(testActiveClusterInfo, nil).AnyTimes()
+
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	deliverAfter := time.Unix(0, time.Now().Add(time.Hour).UnixNano())
+	input, err := delayedsignal.EncodeRequest(&delayedsignal.Request{
+		SignalName:       "reminder",
+		Input:            []byte("payload"),
+		DeliverAfterNano: deliverAfter.UnixNano(),
+	})
+	s.NoError(err)
+	signalRequest := &types.HistorySignalWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		SignalRequest: &types.SignalWorkflowExecutionRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			Identity:          identity,
+			SignalName:        delayedsignal.SignalName,
+			Input:             input,
+		},
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		testlogger.New(s.Suite.T()),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity, nil)
+	di := test.AddDecisionTaskScheduledEvent(msBuilder)
+	test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tasklist, identity)
+	test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, di.ScheduleID+1, nil, identity)
+	ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	var update *persistence.UpdateWorkflowExecutionRequest
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		update = args.Get(1).(*persistence.UpdateWorkflowExecutionRequest)
+	}).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err = s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
+	s.Nil(err)
+	s.NotNil(update)
+	pending := delayedsignal.GetPending(update.UpdateWorkflowMutation.ExecutionInfo.Memo)
+	s.Len(pending, 1)
+	s.Equal("reminder", pending[1].SignalName)
+	s.Equal(commonconstants.EmptyEventID, update.UpdateWorkflowMutation.ExecutionInfo.DecisionScheduleID)
+	var timerTask *persistence.DelayedSignalTimerTask
+	for _, task := range update.UpdateWorkflowMutation.TasksByCategory[persistence.HistoryTaskCategoryTimer] {
+		if t, ok := task.(*persistence.DelayedSignalTimerTask); ok {
+			timerTask = t
+		}
+	}
+	s.NotNil(timerTask)
+	s.Equal(int64(1), timerTask.DelayedSignalID)
+	s.WithinDuration(deliverAfter, timerTask.VisibilityTimestamp, time.Millisecond)
+}
+
+func (s *engineSuite) TestSignalWorkflowExecution_DelayedSignal_CancelNotPending() {
+	testActiveClusterInfo := &types.ActiveClusterInfo{
+		ActiveClusterName: constants.TestLocalDomainEntry.GetReplicationConfig().ActiveClusterName,
+		FailoverVersion:   constants.TestLocalDomainEntry.GetFailoverVersion(),
+	}
+	s.mockShard.Resource.ActiveClusterMgr.EXPECT().GetActiveClusterInfoByWorkflow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(testActiveClusterInfo, nil).AnyTimes()
+
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	input, err := delayedsignal.EncodeRequest(&delayedsignal.Request{ID: 1})
+	s.NoError(err)
+	signalRequest := &types.HistorySignalWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		SignalRequest: &types.SignalWorkflowExecutionRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			Identity:          identity,
+			SignalName:        delayedsignal.CancelSignalName,
+			Input:             input,
+		},
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		testlogger.New(s.Suite.T()),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity, nil)
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+	ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err = s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
+	var badRequest *types.BadRequestError
+	s.ErrorAs(err, &badRequest)
+	s.ErrorContains(err, "Delayed signal 1 is not pending.")
+}
+
+func (s *engineSuite) TestSignalWorkflowExecution_DedupWindow() {
+	testActiveClusterInfo := &types.ActiveClusterInfo{
+		ActiveClusterName: constants.TestLocalDomainEntry.GetReplicationConfig().ActiveClusterName,
+		FailoverVersion:   constants.TestLocalDomainEntry.GetFailoverVersion(),
+	}
+	s.mockShard.Resource.ActiveClusterMgr.EXPECT().GetActiveClusterInfoByWorkflow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(testActiveClusterInfo, nil).AnyTimes()
+
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	signalRequest := &types.HistorySignalWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		SignalRequest: &types.SignalWorkflowExecutionRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			Identity:          identity,
+			SignalName:        "signal",
+			RequestID:         "request-from-previous-run",
+		},
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		testlogger.New(s.Suite.T()),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity, nil)
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+	ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	// the request ID was carried over from the previous run on continue as new
+	ms.ExecutionInfo.Memo, _ = signaldedup.Record(
+		signaldedup.Start(nil, nil, s.mockHistoryEngine.timeSource.Now().UnixNano(), time.Hour, 10),
+		"request-from-previous-run",
+		s.mockHistoryEngine.timeSource.Now().Add(-time.Minute).UnixNano(),
+	)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
+	s.NoError(err)
+	s.mockExecutionMgr.AssertNotCalled(s.T(), "UpdateWorkflowExecution", mock.Anything, mock.Anything)
+}
+
 func (s *engineSuite) TestRemoveSignalMutableState() {
 	testActiveClusterInfo := &types.ActiveClusterInfo{
 		ActiveClusterName: s.mockHistoryEngine.clusterMetadata.GetCurrentClusterName(),
@@ -5870,7 +6479,7 @@ func (s *engineSuite) TestReapplyEvents_ResetWorkflow() {
 	s.mockEventsReapplier.EXPECT().ReapplyEvents(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 	s.mockWorkflowResetter.EXPECT().ResetWorkflow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
 		gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
-		gomock.Any(),
+		gomock.Any(), gomock.Any(),
 	).Return(nil).Times(1)
 	err = s.mockHistoryEngine.ReapplyEvents(
 		context.Background(),
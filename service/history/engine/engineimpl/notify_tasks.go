@@ -72,6 +72,23 @@ func (e *historyEngineImpl) NotifyNewTimerTasks(info *hcommon.NotifyTaskInfo) {
 	}
 }
 
+func (e *historyEngineImpl) NotifyNewHousekeepingTasks(info *hcommon.NotifyTaskInfo) {
+	if len(info.Tasks) == 0 {
+		return
+	}
+
+	task := info.Tasks[0]
+	clusterName, err := e.shard.GetClusterMetadata().ClusterNameForFailoverVersion(task.GetVersion())
+	if err == nil {
+		housekeepingProcessor, ok := e.queueProcessors[persistence.HistoryTaskCategoryHousekeeping]
+		if !ok {
+			e.logger.Error("housekeeping processor not found", tag.Error(err))
+			return
+		}
+		housekeepingProcessor.NotifyNewTask(clusterName, info)
+	}
+}
+
 func (e *historyEngineImpl) NotifyNewReplicationTasks(info *hcommon.NotifyTaskInfo) {
 	for _, task := range info.Tasks {
 		hTask, err := hydrateReplicationTask(task, info.ExecutionInfo, info.VersionHistories, info.Activities, info.History)
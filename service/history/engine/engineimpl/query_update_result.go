@@ -0,0 +1,129 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+	"time"
+
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/workflowupdate"
+	"github.com/uber/cadence/service/history/workflow"
+)
+
+// queryUpdateResult answers the update result query from the updates kept in mutable state, without involving the
+// workflow. It waits for the update to complete or be rejected until the long poll expires, and then returns the
+// current stage of the update. When no run ID is given, the update is looked up in the current run, so the wait
+// follows the update across continue as new.
+func (e *historyEngineImpl) queryUpdateResult(
+	ctx context.Context,
+	request *types.HistoryQueryWorkflowRequest,
+) (*types.HistoryQueryWorkflowResponse, error) {
+	updateID := string(request.GetRequest().GetQuery().GetQueryArgs())
+	if updateID == "" {
+		return nil, &types.BadRequestError{Message: "Update ID is not set on query."}
+	}
+	domainID := request.GetDomainUUID()
+	domainName, err := e.shard.GetDomainCache().GetDomainName(domainID)
+	if err != nil {
+		return nil, err
+	}
+	expirationInterval := e.shard.GetConfig().LongPollExpirationInterval(domainName)
+	if deadline, ok := ctx.Deadline(); ok {
+		expirationInterval = min(expirationInterval, deadline.Sub(e.shard.GetTimeSource().Now())-longPollCompletionBuffer)
+	}
+	timer := time.NewTimer(max(expirationInterval, 0))
+	defer timer.Stop()
+
+	execution := *request.GetRequest().GetExecution()
+	for {
+		update, runID, err := e.getUpdate(ctx, domainID, execution, updateID)
+		if err != nil || update.IsFinal() {
+			return newUpdateResultResponse(update, err)
+		}
+
+		wfIdentifier := definition.NewWorkflowIdentifier(domainID, execution.GetWorkflowID(), runID)
+		subscriberID, channel, err := e.historyEventNotifier.WatchHistoryEvent(wfIdentifier)
+		if err != nil {
+			return nil, err
+		}
+		// check again in case the update was answered before we subscribed
+		update, _, err = e.getUpdate(ctx, domainID, execution, updateID)
+		if err != nil || update.IsFinal() {
+			e.historyEventNotifier.UnwatchHistoryEvent(wfIdentifier, subscriberID) //nolint:errcheck
+			return newUpdateResultResponse(update, err)
+		}
+		select {
+		case <-channel:
+		case <-timer.C:
+			e.historyEventNotifier.UnwatchHistoryEvent(wfIdentifier, subscriberID) //nolint:errcheck
+			return newUpdateResultResponse(update, nil)
+		case <-ctx.Done():
+			e.historyEventNotifier.UnwatchHistoryEvent(wfIdentifier, subscriberID) //nolint:errcheck
+			return nil, ctx.Err()
+		}
+		e.historyEventNotifier.UnwatchHistoryEvent(wfIdentifier, subscriberID) //nolint:errcheck
+	}
+}
+
+// getUpdate returns the update with the given ID and the run ID of the execution it was found in. It fails if the
+// update is not known to the execution, or if the execution closed before answering the update.
+func (e *historyEngineImpl) getUpdate(
+	ctx context.Context,
+	domainID string,
+	execution types.WorkflowExecution,
+	updateID string,
+) (_ *workflowupdate.Update, _ string, retError error) {
+	wfContext, release, err := e.executionCache.GetOrCreateWorkflowExecution(ctx, domainID, execution)
+	if err != nil {
+		return nil, "", err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := wfContext.LoadWorkflowExecution(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	update, ok := workflowupdate.Get(mutableState.GetExecutionInfo().Memo)[updateID]
+	if !ok {
+		return nil, "", &types.EntityNotExistsError{Message: "Update not found."}
+	}
+	if !update.IsFinal() && !mutableState.IsWorkflowExecutionRunning() {
+		return nil, "", workflow.ErrAlreadyCompleted
+	}
+	return update, wfContext.GetExecution().GetRunID(), nil
+}
+
+func newUpdateResultResponse(update *workflowupdate.Update, err error) (*types.HistoryQueryWorkflowResponse, error) {
+	if err != nil {
+		return nil, err
+	}
+	result, err := workflowupdate.EncodeOutcome(update.Outcome)
+	if err != nil {
+		return nil, err
+	}
+	return &types.HistoryQueryWorkflowResponse{
+		Response: &types.QueryWorkflowResponse{QueryResult: result},
+	}, nil
+}
@@ -0,0 +1,119 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/workflowupdate"
+	"github.com/uber/cadence/service/history/constants"
+	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/workflow"
+)
+
+func TestGetUpdate(t *testing.T) {
+	memo, _ := workflowupdate.Apply(nil, &types.HistoryEvent{
+		WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{
+			SignalName: workflowupdate.SignalName("approve"),
+			RequestID:  "update-id",
+		},
+	})
+	answeredMemo, _ := workflowupdate.Apply(memo, &types.HistoryEvent{
+		MarkerRecordedEventAttributes: &types.MarkerRecordedEventAttributes{
+			MarkerName: workflowupdate.CompletedMarkerName,
+			Details:    []byte("result"),
+			Header:     &types.Header{Fields: map[string][]byte{workflowupdate.IDHeaderKey: []byte("update-id")}},
+		},
+	})
+	tests := []struct {
+		name      string
+		memo      map[string][]byte
+		running   bool
+		updateID  string
+		wantStage workflowupdate.Stage
+		wantErr   error
+	}{
+		{
+			name:      "pending update of a running workflow",
+			memo:      memo,
+			running:   true,
+			updateID:  "update-id",
+			wantStage: workflowupdate.StageRequested,
+		},
+		{
+			name:      "completed update of a closed workflow",
+			memo:      answeredMemo,
+			updateID:  "update-id",
+			wantStage: workflowupdate.StageCompleted,
+		},
+		{
+			name:     "pending update of a closed workflow",
+			memo:     memo,
+			updateID: "update-id",
+			wantErr:  workflow.ErrAlreadyCompleted,
+		},
+		{
+			name:     "unknown update",
+			memo:     memo,
+			running:  true,
+			updateID: "other-update-id",
+			wantErr:  &types.EntityNotExistsError{Message: "Update not found."},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mutableState := execution.NewMockMutableState(ctrl)
+			mutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{Memo: tt.memo}).AnyTimes()
+			mutableState.EXPECT().IsWorkflowExecutionRunning().Return(tt.running).AnyTimes()
+			wfContext := execution.NewMockContext(ctrl)
+			wfContext.EXPECT().LoadWorkflowExecution(gomock.Any()).Return(mutableState, nil)
+			wfContext.EXPECT().GetExecution().Return(&types.WorkflowExecution{WorkflowID: "wid", RunID: "current-run-id"}).AnyTimes()
+			cache := execution.NewMockCache(ctrl)
+			cache.EXPECT().GetOrCreateWorkflowExecution(gomock.Any(), constants.TestDomainID, types.WorkflowExecution{WorkflowID: "wid"}).
+				Return(wfContext, func(error) {}, nil)
+			engine := &historyEngineImpl{executionCache: cache}
+
+			update, runID, err := engine.getUpdate(context.Background(), constants.TestDomainID, types.WorkflowExecution{WorkflowID: "wid"}, tt.updateID)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStage, update.Stage)
+			assert.Equal(t, "current-run-id", runID)
+
+			resp, err := newUpdateResultResponse(update, nil)
+			assert.NoError(t, err)
+			outcome, err := workflowupdate.DecodeOutcome(resp.Response.QueryResult)
+			assert.NoError(t, err)
+			assert.Equal(t, update.Outcome, outcome)
+		})
+	}
+}
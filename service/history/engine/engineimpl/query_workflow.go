@@ -33,6 +33,7 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/workflowupdate"
 	"github.com/uber/cadence/service/history/query"
 	"github.com/uber/cadence/service/history/workflow"
 )
@@ -42,6 +43,10 @@ func (e *historyEngineImpl) QueryWorkflow(
 	request *types.HistoryQueryWorkflowRequest,
 ) (retResp *types.HistoryQueryWorkflowResponse, retErr error) {
 
+	if request.GetRequest().GetQuery().GetQueryType() == workflowupdate.ResultQueryType {
+		return e.queryUpdateResult(ctx, request)
+	}
+
 	scope := e.metricsClient.Scope(metrics.HistoryQueryWorkflowScope).Tagged(metrics.DomainTag(request.GetRequest().GetDomain()))
 	shardMetricScope := e.metricsClient.Scope(metrics.HistoryQueryWorkflowScope, metrics.ShardIDTag(e.shard.GetShardID()))
 
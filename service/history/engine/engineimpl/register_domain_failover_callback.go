@@ -146,7 +146,10 @@ func (e *historyEngineImpl) domainChangeCB(nextDomains []*cache.DomainCacheEntry
 
 	// This is a debug metric
 	e.metricsClient.IncCounter(metrics.FailoverMarkerScope, metrics.HistoryFailoverCallbackCount)
-	if len(failoverMarkerTasks) > 0 {
+	if len(failoverMarkerTasks) > 0 && e.config.EnableGracefulFailoverTaskDraining() {
+		// the domain is fenced already, hold the markers until the in-flight tasks are processed
+		e.failoverMarkerDrainer.Drain(failoverMarkerTasks, shardNotificationVersion)
+	} else if len(failoverMarkerTasks) > 0 {
 		if err := e.shard.ReplicateFailoverMarkers(
 			context.Background(),
 			failoverMarkerTasks,
@@ -158,8 +161,9 @@ func (e *historyEngineImpl) domainChangeCB(nextDomains []*cache.DomainCacheEntry
 		}
 	}
 
+	// the version is not moved past the markers held by the drainer until they are inserted
 	//nolint:errcheck
-	e.shard.UpdateDomainNotificationVersion(nextDomains[len(nextDomains)-1].GetNotificationVersion() + 1)
+	e.failoverMarkerDrainer.UpdateDomainNotificationVersion(nextDomains[len(nextDomains)-1].GetNotificationVersion() + 1)
 }
 
 func (e *historyEngineImpl) notifyQueues() {
@@ -35,10 +35,13 @@ import (
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
+	hconfig "github.com/uber/cadence/service/history/config"
+	"github.com/uber/cadence/service/history/failover"
 	"github.com/uber/cadence/service/history/queue"
 	"github.com/uber/cadence/service/history/shard"
 	"github.com/uber/cadence/service/history/task"
@@ -446,7 +449,8 @@ func TestDomainCallback(t *testing.T) {
 			timerProcessor *queue.MockProcessor,
 			taskProcessor *task.MockProcessor,
 		)
-		expectedErr error
+		drainedMarkers []*persistence.FailoverMarkerTask
+		expectedErr    error
 	}{
 		"t1 - non-failover domain update. - from the point of view of cluster 0": {
 			domainUpdates: nonFailoverDomainUpdateT1,
@@ -592,6 +596,30 @@ func TestDomainCallback(t *testing.T) {
 				timerProcessor.EXPECT().UnlockTaskProcessing()
 			},
 		},
+		"graceful failover domain update. cluster1 POV - draining in-flight tasks": {
+			domainUpdates: failoverUpdateT3,
+			asCluster:     "cluster1",
+			affordances: func(
+				shardCtx *shard.MockContext,
+				txProcessor *queue.MockProcessor,
+				timerProcessor *queue.MockProcessor,
+				taskProcessor *task.MockProcessor) {
+
+				shardCtx.EXPECT().GetDomainNotificationVersion().Return(int64(1))
+				shardCtx.EXPECT().UpdateDomainNotificationVersion(int64(6))
+
+				txProcessor.EXPECT().UnlockTaskProcessing()
+				timerProcessor.EXPECT().UnlockTaskProcessing()
+			},
+			drainedMarkers: []*persistence.FailoverMarkerTask{
+				{
+					TaskData: persistence.TaskData{
+						Version: 11,
+					},
+					DomainID: "83b48dab-68cb-4f73-8752-c75d9271977f",
+				},
+			},
+		},
 		"invalid failover version": {
 			domainUpdates: invalidDomainUpdate,
 			asCluster:     "cluster1",
@@ -668,11 +696,22 @@ func TestDomainCallback(t *testing.T) {
 
 			td.affordances(shardCtx, txProcessor, timeProcessor, queueTaskProcessor)
 
+			historyConfig := hconfig.NewForTest()
+			failoverMarkerDrainer := failover.NewMockMarkerDrainer(ctrl)
+			// the drainer holds the version back while markers are pending, none are in these tests
+			failoverMarkerDrainer.EXPECT().UpdateDomainNotificationVersion(gomock.Any()).DoAndReturn(shardCtx.UpdateDomainNotificationVersion).AnyTimes()
+			if td.drainedMarkers != nil {
+				historyConfig.EnableGracefulFailoverTaskDraining = dynamicproperties.GetBoolPropertyFn(true)
+				failoverMarkerDrainer.EXPECT().Drain(td.drainedMarkers, gomock.Any())
+			}
+
 			he := historyEngineImpl{
-				logger:             log.NewNoop(),
-				clusterMetadata:    cluster,
-				currentClusterName: td.asCluster,
-				metricsClient:      metrics.NewNoopMetricsClient(),
+				config:                historyConfig,
+				failoverMarkerDrainer: failoverMarkerDrainer,
+				logger:                log.NewNoop(),
+				clusterMetadata:       cluster,
+				currentClusterName:    td.asCluster,
+				metricsClient:         metrics.NewNoopMetricsClient(),
 				queueProcessors: map[persistence.HistoryTaskCategory]queue.Processor{
 					persistence.HistoryTaskCategoryTransfer: txProcessor,
 					persistence.HistoryTaskCategoryTimer:    timeProcessor,
@@ -25,7 +25,12 @@ import (
 	"context"
 	"fmt"
 
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/queueedit"
+	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/queue"
 )
 
@@ -37,7 +42,11 @@ func (e *historyEngineImpl) ResetTransferQueue(
 	if !ok {
 		return fmt.Errorf("transfer processor not found")
 	}
-	_, err := transferProcessor.HandleAction(ctx, clusterName, queue.NewResetAction())
+	clusterName, action, err := resetQueueAction(ctx, clusterName)
+	if err != nil {
+		return err
+	}
+	_, err = transferProcessor.HandleAction(ctx, clusterName, action)
 	return err
 }
 
@@ -49,6 +58,34 @@ func (e *historyEngineImpl) ResetTimerQueue(
 	if !ok {
 		return fmt.Errorf("timer processor not found")
 	}
-	_, err := timerProcessor.HandleAction(ctx, clusterName, queue.NewResetAction())
+	clusterName, action, err := resetQueueAction(ctx, clusterName)
+	if err != nil {
+		return err
+	}
+	_, err = timerProcessor.HandleAction(ctx, clusterName, action)
 	return err
 }
+
+// resetQueueAction returns the cluster of the queue and the action performed by a queue reset, which edits
+// the virtual queues instead of resetting the queue when the cluster name marks an edit
+func resetQueueAction(ctx context.Context, clusterName string) (string, *queue.Action, error) {
+	var value string
+	if call := yarpc.CallFromContext(ctx); call != nil {
+		value = call.Header(common.QueueEditHeaderName)
+	}
+	clusterName, isEdit := queueedit.ParseClusterName(clusterName)
+	if !isEdit {
+		if value != "" {
+			return "", nil, &types.BadRequestError{Message: "Queue edit is only applied for the cluster name of an edit."}
+		}
+		return clusterName, queue.NewResetAction(), nil
+	}
+	if value == "" {
+		return "", nil, &types.BadRequestError{Message: "Queue edit is not set."}
+	}
+	edit, err := queueedit.Decode(value)
+	if err != nil {
+		return "", nil, &types.BadRequestError{Message: err.Error()}
+	}
+	return clusterName, queue.NewEditVirtualQueueAction(edit), nil
+}
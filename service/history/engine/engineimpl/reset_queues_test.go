@@ -0,0 +1,77 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/yarpc/yarpctest"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/queueedit"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/queue"
+)
+
+func TestResetQueueAction(t *testing.T) {
+	editCall := func(value string) context.Context {
+		return yarpctest.ContextWithCall(context.Background(), &yarpctest.Call{
+			Headers: map[string]string{common.QueueEditHeaderName: value},
+		})
+	}
+
+	clusterName, action, err := resetQueueAction(context.Background(), "active")
+	require.NoError(t, err)
+	assert.Equal(t, "active", clusterName)
+	assert.Equal(t, queue.NewResetAction(), action)
+
+	clusterName, action, err = resetQueueAction(yarpctest.ContextWithCall(context.Background(), &yarpctest.Call{}), "active")
+	require.NoError(t, err)
+	assert.Equal(t, "active", clusterName)
+	assert.Equal(t, queue.NewResetAction(), action)
+
+	clusterName, action, err = resetQueueAction(
+		editCall(`{"operation":"pause","virtualQueueID":1,"pauseDuration":60000000000}`),
+		queueedit.EditClusterName("active"),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "active", clusterName)
+	assert.Equal(t, queue.NewEditVirtualQueueAction(&queueedit.Edit{
+		Operation:      queueedit.OperationPause,
+		VirtualQueueID: 1,
+		PauseDuration:  time.Minute,
+	}), action)
+
+	_, _, err = resetQueueAction(editCall(`{"operation":"pause","virtualQueueID":1}`), queueedit.EditClusterName("active"))
+	assert.IsType(t, &types.BadRequestError{}, err)
+
+	// an edit is never mistaken for a reset
+	_, _, err = resetQueueAction(context.Background(), queueedit.EditClusterName("active"))
+	assert.IsType(t, &types.BadRequestError{}, err)
+	_, _, err = resetQueueAction(editCall(`{"operation":"resume","virtualQueueID":1}`), "active")
+	assert.IsType(t, &types.BadRequestError{}, err)
+}
@@ -26,6 +26,7 @@ import (
 	"fmt"
 
 	"github.com/pborman/uuid"
+	"go.uber.org/yarpc"
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/collection"
@@ -33,6 +34,7 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/persistence"
 	persistenceutils "github.com/uber/cadence/common/persistence/persistence-utils"
+	"github.com/uber/cadence/common/reapply"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/execution"
 )
@@ -51,6 +53,16 @@ func (e *historyEngineImpl) ResetWorkflowExecution(
 	workflowID := request.WorkflowExecution.GetWorkflowID()
 	baseRunID := request.WorkflowExecution.GetRunID()
 
+	reapplyPolicy, err := reapplyPolicyFromCall(ctx)
+	if err != nil {
+		return nil, &types.BadRequestError{Message: err.Error()}
+	}
+	if reapplyPolicy != nil && request.GetSkipSignalReapply() {
+		return nil, &types.BadRequestError{
+			Message: "Cannot skip signal reapply when a reapply policy is given.",
+		}
+	}
+
 	baseContext, baseReleaseFn, err := e.executionCache.GetOrCreateWorkflowExecution(
 		ctx,
 		domainID,
@@ -182,6 +194,7 @@ func (e *historyEngineImpl) ResetWorkflowExecution(
 		request.GetReason(),
 		nil,
 		request.GetSkipSignalReapply(),
+		reapplyPolicy,
 	); err != nil {
 		if t, ok := persistence.AsDuplicateRequestError(err); ok {
 			if t.RequestType == persistence.WorkflowRequestTypeReset {
@@ -293,3 +306,16 @@ func checkResetEventType(events []*types.HistoryEvent, resetEventID int64) error
 
 	return fmt.Errorf("reset event ID %v not found in the history events", resetEventID)
 }
+
+// reapplyPolicyFromCall returns the reapply policy sent in the request headers, or nil if none is sent
+func reapplyPolicyFromCall(ctx context.Context) (*reapply.Policy, error) {
+	call := yarpc.CallFromContext(ctx)
+	if call == nil {
+		return nil, nil
+	}
+	value := call.Header(common.ResetReapplyPolicyHeaderName)
+	if value == "" {
+		return nil, nil
+	}
+	return reapply.Decode(value)
+}
@@ -31,11 +31,13 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc/yarpctest"
 
 	"github.com/uber/cadence/common"
 	commonconstants "github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/reapply"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/constants"
 	"github.com/uber/cadence/service/history/engine/testdata"
@@ -430,6 +432,7 @@ func TestResetWorkflowExecution(t *testing.T) {
 						gomock.Eq(testRequestReason),
 						gomock.Nil(),
 						gomock.Eq(testRequestSkipSignalReapply),
+						gomock.Nil(),
 					).Return(nil).Times(1)
 				},
 			},
@@ -498,6 +501,7 @@ func TestResetWorkflowExecution(t *testing.T) {
 						gomock.Eq(testRequestReason),
 						gomock.Nil(),
 						gomock.Eq(testRequestSkipSignalReapply),
+						gomock.Nil(),
 					).Return(nil).Times(1)
 				},
 			},
@@ -555,6 +559,7 @@ func TestResetWorkflowExecution(t *testing.T) {
 						gomock.Eq(testRequestReason),
 						gomock.Nil(),
 						gomock.Eq(testRequestSkipSignalReapply),
+						gomock.Nil(),
 					).Return(nil).Times(1)
 				},
 			},
@@ -600,6 +605,7 @@ func TestResetWorkflowExecution(t *testing.T) {
 						gomock.Eq(testRequestReason),
 						gomock.Nil(),
 						gomock.Eq(testRequestSkipSignalReapply),
+						gomock.Nil(),
 					).Return(&persistence.DuplicateRequestError{
 						RequestType: persistence.WorkflowRequestTypeReset,
 						RunID:       "errorID",
@@ -650,6 +656,7 @@ func TestResetWorkflowExecution(t *testing.T) {
 						gomock.Eq(testRequestReason),
 						gomock.Nil(),
 						gomock.Eq(testRequestSkipSignalReapply),
+						gomock.Nil(),
 					).Return(&persistence.DuplicateRequestError{
 						RequestType: persistence.WorkflowRequestTypeStart,
 						RunID:       "errorID",
@@ -701,6 +708,7 @@ func TestResetWorkflowExecution(t *testing.T) {
 						gomock.Eq(testRequestReason),
 						gomock.Nil(),
 						gomock.Eq(testRequestSkipSignalReapply),
+						gomock.Nil(),
 					).Return(&types.BadRequestError{
 						Message: "didn't work",
 					}).Times(1)
@@ -736,6 +744,60 @@ func TestResetWorkflowExecution(t *testing.T) {
 	}
 }
 
+func TestResetWorkflowExecution_ReapplyPolicy(t *testing.T) {
+	cases := []struct {
+		name        string
+		policy      string
+		expectedErr string
+	}{
+		{
+			name:        "Invalid policy",
+			policy:      `{"eventTypes":["ActivityTaskScheduled"]}`,
+			expectedErr: "invalid reset reapply policy: ActivityTaskScheduled events cannot be reapplied",
+		},
+		{
+			name:        "Policy with skip signal reapply",
+			policy:      `{"eventTypes":["WorkflowExecutionSignaled"]}`,
+			expectedErr: "Cannot skip signal reapply when a reapply policy is given.",
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			eft := testdata.NewEngineForTest(t, NewEngineWithShardContext)
+			eft.Engine.Start()
+			callCtx := yarpctest.ContextWithCall(ctx.Background(), &yarpctest.Call{
+				Headers: map[string]string{common.ResetReapplyPolicyHeaderName: testCase.policy},
+			})
+			result, err := eft.Engine.ResetWorkflowExecution(callCtx, resetExecutionRequest(latestExecution, 24))
+			eft.Engine.Stop()
+
+			assert.Nil(t, result)
+			var badRequest *types.BadRequestError
+			assert.ErrorAs(t, err, &badRequest)
+			assert.EqualError(t, err, testCase.expectedErr)
+		})
+	}
+}
+
+func TestReapplyPolicyFromCall(t *testing.T) {
+	policy, err := reapplyPolicyFromCall(ctx.Background())
+	assert.NoError(t, err)
+	assert.Nil(t, policy)
+
+	policy, err = reapplyPolicyFromCall(yarpctest.ContextWithCall(ctx.Background(), &yarpctest.Call{}))
+	assert.NoError(t, err)
+	assert.Nil(t, policy)
+
+	policy, err = reapplyPolicyFromCall(yarpctest.ContextWithCall(ctx.Background(), &yarpctest.Call{
+		Headers: map[string]string{
+			common.ResetReapplyPolicyHeaderName: `{"eventTypes":["WorkflowExecutionCancelRequested"]}`,
+		},
+	}))
+	assert.NoError(t, err)
+	assert.Equal(t, &reapply.Policy{EventTypes: []types.EventType{types.EventTypeWorkflowExecutionCancelRequested}}, policy)
+}
+
 func TestResetWorkflowExecution_ResetPointsValidation(t *testing.T) {
 
 	testCases := []struct {
@@ -834,6 +896,7 @@ func TestResetWorkflowExecution_ResetPointsValidation(t *testing.T) {
 					gomock.Eq(testRequestReason),
 					gomock.Nil(),
 					gomock.Eq(testRequestSkipSignalReapply),
+					gomock.Nil(),
 				).Return(nil).Times(1)
 			},
 			resetEventID: 23,
@@ -857,6 +920,7 @@ func TestResetWorkflowExecution_ResetPointsValidation(t *testing.T) {
 					gomock.Eq(testRequestReason),
 					gomock.Nil(),
 					gomock.Eq(testRequestSkipSignalReapply),
+					gomock.Nil(),
 				).Return(nil).Times(1)
 			},
 			resetEventID: 9,
@@ -880,6 +944,7 @@ func TestResetWorkflowExecution_ResetPointsValidation(t *testing.T) {
 					gomock.Eq(testRequestReason),
 					gomock.Nil(),
 					gomock.Eq(testRequestSkipSignalReapply),
+					gomock.Nil(),
 				).Return(nil).Times(1)
 			},
 			resetEventID: 12,
@@ -903,6 +968,7 @@ func TestResetWorkflowExecution_ResetPointsValidation(t *testing.T) {
 					gomock.Eq(testRequestReason),
 					gomock.Nil(),
 					gomock.Eq(testRequestSkipSignalReapply),
+					gomock.Nil(),
 				).Return(nil).Times(1)
 			},
 			resetEventID: 24,
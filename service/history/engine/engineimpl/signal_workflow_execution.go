@@ -23,10 +23,18 @@ package engineimpl
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/uber/cadence/common/activitycontrol"
+	"github.com/uber/cadence/common/delayedsignal"
+	"github.com/uber/cadence/common/elasticsearch/validator"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/searchattrupdate"
+	"github.com/uber/cadence/common/signaldedup"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/workflowpause"
+	"github.com/uber/cadence/common/workflowupdate"
 	"github.com/uber/cadence/service/history/execution"
 	"github.com/uber/cadence/service/history/workflow"
 )
@@ -64,8 +72,17 @@ func (e *historyEngineImpl) SignalWorkflowExecution(
 			// first deduplicate by request id for signal decision
 			// this is done before workflow running check so that already completed error
 			// won't be returned for duplicated signals even if the workflow is closed.
+			// request IDs are also deduplicated within the signal deduplication window, which outlives the run,
+			// and update IDs for as long as the updates are kept
+			if workflowupdate.IsSignal(request.GetSignalName()) && request.GetRequestID() == "" {
+				return nil, &types.BadRequestError{Message: "Update ID is not set on request."}
+			}
 			if requestID := request.GetRequestID(); requestID != "" {
-				if mutableState.IsSignalRequested(requestID) {
+				if mutableState.IsSignalRequested(requestID) || signaldedup.IsDuplicate(
+					mutableState.GetExecutionInfo().Memo,
+					requestID,
+					e.timeSource.Now().UnixNano(),
+				) || isUpdateRequested(mutableState, request.GetSignalName(), requestID) {
 					return &workflow.UpdateAction{
 						Noop:           true,
 						CreateDecision: false,
@@ -109,6 +126,45 @@ func (e *historyEngineImpl) SignalWorkflowExecution(
 				}
 			}
 
+			// tasks skipped while the workflow or an activity is paused are generated again by mutable state
+			// when it is resumed, and so are the tasks of an activity which is reset or has its options updated
+			paused := workflowpause.IsPaused(executionInfo.Memo)
+			switch request.GetSignalName() {
+			case workflowpause.PauseSignalName:
+				if paused {
+					return &workflow.UpdateAction{Noop: true}, nil
+				}
+				// the workflow learns about the pause once it is resumed
+				createDecisionTask = false
+			case workflowpause.UnpauseSignalName:
+				if !paused {
+					return &workflow.UpdateAction{Noop: true}, nil
+				}
+			}
+			if activitycontrol.IsSignal(request.GetSignalName()) {
+				noop, err := validateActivityControl(request, mutableState)
+				if err != nil {
+					return nil, err
+				}
+				if noop {
+					return &workflow.UpdateAction{Noop: true}, nil
+				}
+				createDecisionTask = false
+			}
+			if searchattrupdate.IsSignal(request.GetSignalName()) {
+				if err := e.validateSearchAttributesUpdate(request, domainEntry.GetInfo().Name); err != nil {
+					return nil, err
+				}
+				createDecisionTask = false
+			}
+			if delayedsignal.IsSignal(request.GetSignalName()) {
+				if err := validateDelayedSignal(request, mutableState); err != nil {
+					return nil, err
+				}
+				// the workflow only sees the delayed signal once it is delivered
+				createDecisionTask = false
+			}
+
 			if requestID := request.GetRequestID(); requestID != "" {
 				mutableState.AddSignalRequested(requestID)
 			}
@@ -128,3 +184,83 @@ func (e *historyEngineImpl) SignalWorkflowExecution(
 			}, nil
 		})
 }
+
+// isUpdateRequested returns whether the signal requests an update which was already requested in the workflow
+func isUpdateRequested(mutableState execution.MutableState, signalName string, requestID string) bool {
+	if !workflowupdate.IsSignal(signalName) {
+		return false
+	}
+	_, ok := workflowupdate.Get(mutableState.GetExecutionInfo().Memo)[requestID]
+	return ok
+}
+
+// validateActivityControl validates an activity control signal against the pending activity it targets,
+// and returns whether the signal wouldn't change anything
+func validateActivityControl(
+	request *types.SignalWorkflowExecutionRequest,
+	mutableState execution.MutableState,
+) (bool, error) {
+	controlRequest, err := activitycontrol.DecodeRequest(request.GetInput())
+	if err != nil {
+		return false, &types.BadRequestError{Message: err.Error()}
+	}
+	if err := controlRequest.Validate(request.GetSignalName()); err != nil {
+		return false, &types.BadRequestError{Message: err.Error()}
+	}
+	ai, ok := mutableState.GetActivityByActivityID(controlRequest.ActivityID)
+	if !ok {
+		return false, workflow.ErrActivityTaskNotFound
+	}
+	paused := activitycontrol.IsPaused(mutableState.GetExecutionInfo().Memo, ai.ScheduleID)
+	switch request.GetSignalName() {
+	case activitycontrol.PauseSignalName:
+		return paused, nil
+	case activitycontrol.UnpauseSignalName:
+		return !paused, nil
+	}
+	return false, nil
+}
+
+// validateDelayedSignal validates a delayed signal or cancel signal against the pending delayed signals
+func validateDelayedSignal(
+	request *types.SignalWorkflowExecutionRequest,
+	mutableState execution.MutableState,
+) error {
+	delayedRequest, err := delayedsignal.DecodeRequest(request.GetInput())
+	if err != nil {
+		return &types.BadRequestError{Message: err.Error()}
+	}
+	if err := delayedRequest.Validate(request.GetSignalName()); err != nil {
+		return &types.BadRequestError{Message: err.Error()}
+	}
+	pending := delayedsignal.GetPending(mutableState.GetExecutionInfo().Memo)
+	if request.GetSignalName() == delayedsignal.CancelSignalName {
+		if _, ok := pending[delayedRequest.ID]; !ok {
+			return &types.BadRequestError{Message: fmt.Sprintf("Delayed signal %v is not pending.", delayedRequest.ID)}
+		}
+		return nil
+	}
+	if len(pending) >= delayedsignal.MaxPending {
+		return &types.LimitExceededError{Message: "Workflow has too many pending delayed signals."}
+	}
+	return nil
+}
+
+// validateSearchAttributesUpdate validates a search attributes update signal the same way as an upsert decision
+func (e *historyEngineImpl) validateSearchAttributesUpdate(
+	request *types.SignalWorkflowExecutionRequest,
+	domainName string,
+) error {
+	searchAttributes, err := searchattrupdate.DecodeRequest(request.GetInput())
+	if err != nil {
+		return &types.BadRequestError{Message: err.Error()}
+	}
+	return validator.NewSearchAttributesValidator(
+		e.logger,
+		e.config.EnableQueryAttributeValidation,
+		e.config.ValidSearchAttributes,
+		e.config.SearchAttributesNumberOfKeysLimit,
+		e.config.SearchAttributesSizeOfValueLimit,
+		e.config.SearchAttributesTotalSizeLimit,
+	).ValidateSearchAttributes(searchAttributes, domainName)
+}
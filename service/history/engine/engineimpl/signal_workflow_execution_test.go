@@ -0,0 +1,122 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package engineimpl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/activitycontrol"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/workflow"
+)
+
+func TestValidateActivityControl(t *testing.T) {
+	pausedMemo, _ := activitycontrol.SetPaused(nil, 5, &activitycontrol.PauseInfo{ActivityID: "payment"})
+	tests := []struct {
+		name       string
+		signalName string
+		request    *activitycontrol.Request
+		input      []byte
+		memo       map[string][]byte
+		wantNoop   bool
+		wantErr    error
+	}{
+		{
+			name:       "pause",
+			signalName: activitycontrol.PauseSignalName,
+			request:    &activitycontrol.Request{ActivityID: "payment"},
+		},
+		{
+			name:       "pause paused activity",
+			signalName: activitycontrol.PauseSignalName,
+			request:    &activitycontrol.Request{ActivityID: "payment"},
+			memo:       pausedMemo,
+			wantNoop:   true,
+		},
+		{
+			name:       "unpause",
+			signalName: activitycontrol.UnpauseSignalName,
+			request:    &activitycontrol.Request{ActivityID: "payment"},
+			memo:       pausedMemo,
+		},
+		{
+			name:       "unpause activity which is not paused",
+			signalName: activitycontrol.UnpauseSignalName,
+			request:    &activitycontrol.Request{ActivityID: "payment"},
+			wantNoop:   true,
+		},
+		{
+			name:       "reset",
+			signalName: activitycontrol.ResetSignalName,
+			request:    &activitycontrol.Request{ActivityID: "payment"},
+		},
+		{
+			name:       "activity not pending",
+			signalName: activitycontrol.ResetSignalName,
+			request:    &activitycontrol.Request{ActivityID: "shipping"},
+			wantErr:    workflow.ErrActivityTaskNotFound,
+		},
+		{
+			name:       "invalid request",
+			signalName: activitycontrol.UpdateOptionsSignalName,
+			request:    &activitycontrol.Request{ActivityID: "payment"},
+			wantErr:    &types.BadRequestError{Message: "no activity option to update"},
+		},
+		{
+			name:       "malformed request",
+			signalName: activitycontrol.ResetSignalName,
+			input:      []byte("not json"),
+			wantErr:    &types.BadRequestError{Message: "invalid activity control request: invalid character 'o' in literal null (expecting 'u')"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mutableState := execution.NewMockMutableState(gomock.NewController(t))
+			mutableState.EXPECT().GetActivityByActivityID(gomock.Any()).DoAndReturn(func(activityID string) (*persistence.ActivityInfo, bool) {
+				if activityID != "payment" {
+					return nil, false
+				}
+				return &persistence.ActivityInfo{ScheduleID: 5, ActivityID: activityID}, true
+			}).AnyTimes()
+			mutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{Memo: tt.memo}).AnyTimes()
+
+			input := tt.input
+			if input == nil {
+				var err error
+				input, err = activitycontrol.EncodeRequest(tt.request)
+				assert.NoError(t, err)
+			}
+			noop, err := validateActivityControl(&types.SignalWorkflowExecutionRequest{
+				SignalName: tt.signalName,
+				Input:      input,
+			}, mutableState)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantNoop, noop)
+		})
+	}
+}
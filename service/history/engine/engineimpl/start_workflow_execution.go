@@ -32,9 +32,12 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/activecluster"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/completioncallback"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/reserved"
+	"github.com/uber/cadence/common/signaldedup"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/execution"
 	"github.com/uber/cadence/service/history/workflow"
@@ -366,7 +369,11 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 				return nil, err1
 			}
 
-			if mutableState.IsSignalRequested(sRequest.GetRequestID()) {
+			if mutableState.IsSignalRequested(sRequest.GetRequestID()) || signaldedup.IsDuplicate(
+				mutableState.GetExecutionInfo().Memo,
+				sRequest.GetRequestID(),
+				e.timeSource.Now().UnixNano(),
+			) || isUpdateRequested(mutableState, sRequest.GetSignalName(), sRequest.GetRequestID()) {
 				return &types.StartWorkflowExecutionResponse{RunID: wfContext.GetExecution().RunID}, nil
 			}
 
@@ -584,6 +591,16 @@ func (e *historyEngineImpl) validateStartWorkflowExecutionRequest(request *types
 		tag.IDTypeWorkflowType) {
 		return &types.BadRequestError{Message: "WorkflowType exceeds length limit."}
 	}
+	if err := reserved.ValidateMemo(request.Memo.GetFields()); err != nil {
+		return err
+	}
+	if err := completioncallback.ValidateMemo(
+		request.Memo.GetFields(),
+		request.GetDomain(),
+		e.config.CompletionCallbackAllowedURLPrefixes(),
+	); err != nil {
+		return &types.BadRequestError{Message: err.Error()}
+	}
 
 	return common.ValidateRetryPolicy(request.RetryPolicy)
 }
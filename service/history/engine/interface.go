@@ -74,10 +74,12 @@ type (
 		ResetTimerQueue(ctx context.Context, clusterName string) error
 		DescribeTransferQueue(ctx context.Context, clusterName string) (*types.DescribeQueueResponse, error)
 		DescribeTimerQueue(ctx context.Context, clusterName string) (*types.DescribeQueueResponse, error)
+		DescribeReplicationQueue(ctx context.Context, clusterName string) (*types.DescribeQueueResponse, error)
 
 		NotifyNewHistoryEvent(event *events.Notification)
 		NotifyNewTransferTasks(info *hcommon.NotifyTaskInfo)
 		NotifyNewTimerTasks(info *hcommon.NotifyTaskInfo)
+		NotifyNewHousekeepingTasks(info *hcommon.NotifyTaskInfo)
 		NotifyNewReplicationTasks(info *hcommon.NotifyTaskInfo)
 	}
 )
@@ -74,6 +74,21 @@ func (mr *MockEngineMockRecorder) DescribeMutableState(ctx, request any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockEngine)(nil).DescribeMutableState), ctx, request)
 }
 
+// DescribeReplicationQueue mocks base method.
+func (m *MockEngine) DescribeReplicationQueue(ctx context.Context, clusterName string) (*types.DescribeQueueResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeReplicationQueue", ctx, clusterName)
+	ret0, _ := ret[0].(*types.DescribeQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeReplicationQueue indicates an expected call of DescribeReplicationQueue.
+func (mr *MockEngineMockRecorder) DescribeReplicationQueue(ctx, clusterName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeReplicationQueue", reflect.TypeOf((*MockEngine)(nil).DescribeReplicationQueue), ctx, clusterName)
+}
+
 // DescribeTimerQueue mocks base method.
 func (m *MockEngine) DescribeTimerQueue(ctx context.Context, clusterName string) (*types.DescribeQueueResponse, error) {
 	m.ctrl.T.Helper()
@@ -191,6 +206,18 @@ func (mr *MockEngineMockRecorder) NotifyNewHistoryEvent(event any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyNewHistoryEvent", reflect.TypeOf((*MockEngine)(nil).NotifyNewHistoryEvent), event)
 }
 
+// NotifyNewHousekeepingTasks mocks base method.
+func (m *MockEngine) NotifyNewHousekeepingTasks(info *common.NotifyTaskInfo) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "NotifyNewHousekeepingTasks", info)
+}
+
+// NotifyNewHousekeepingTasks indicates an expected call of NotifyNewHousekeepingTasks.
+func (mr *MockEngineMockRecorder) NotifyNewHousekeepingTasks(info any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyNewHousekeepingTasks", reflect.TypeOf((*MockEngine)(nil).NotifyNewHousekeepingTasks), info)
+}
+
 // NotifyNewReplicationTasks mocks base method.
 func (m *MockEngine) NotifyNewReplicationTasks(info *common.NotifyTaskInfo) {
 	m.ctrl.T.Helper()
@@ -1006,6 +1006,14 @@ func notifyTasks(
 	engine.NotifyNewTransferTasks(transferTaskInfo)
 	engine.NotifyNewTimerTasks(timerTaskInfo)
 	engine.NotifyNewReplicationTasks(replicationTaskInfo)
+	// housekeeping tasks are only generated when the housekeeping queue is enabled
+	if housekeepingTasks := tasksByCategory[persistence.HistoryTaskCategoryHousekeeping]; len(housekeepingTasks) > 0 {
+		engine.NotifyNewHousekeepingTasks(&hcommon.NotifyTaskInfo{
+			ExecutionInfo:    executionInfo,
+			Tasks:            housekeepingTasks,
+			PersistenceError: persistenceError,
+		})
+	}
 }
 
 func mergeContinueAsNewReplicationTasks(
@@ -257,6 +257,9 @@ func TestNotifyTasksFromWorkflowSnapshot(t *testing.T) {
 							NextEventID:  10,
 						},
 					},
+					persistence.HistoryTaskCategoryHousekeeping: {
+						&persistence.DeleteHistoryEventTask{},
+					},
 				},
 			},
 			history: events.PersistedBlobs{
@@ -321,6 +324,17 @@ func TestNotifyTasksFromWorkflowSnapshot(t *testing.T) {
 					},
 					PersistenceError: true,
 				})
+				mockEngine.EXPECT().NotifyNewHousekeepingTasks(&hcommon.NotifyTaskInfo{
+					ExecutionInfo: &persistence.WorkflowExecutionInfo{
+						DomainID:   "test-domain-id",
+						WorkflowID: "test-workflow-id",
+						RunID:      "test-run-id",
+					},
+					Tasks: []persistence.Task{
+						&persistence.DeleteHistoryEventTask{},
+					},
+					PersistenceError: true,
+				})
 			},
 		},
 		{
@@ -189,6 +189,7 @@ type (
 		ReplicateDecisionTaskTimedOutEvent(*types.HistoryEvent) error
 		ReplicateExternalWorkflowExecutionCancelRequested(*types.HistoryEvent) error
 		ReplicateExternalWorkflowExecutionSignaled(*types.HistoryEvent) error
+		ReplicateMarkerRecordedEvent(*types.HistoryEvent) error
 		ReplicateRequestCancelExternalWorkflowExecutionFailedEvent(*types.HistoryEvent) error
 		ReplicateRequestCancelExternalWorkflowExecutionInitiatedEvent(int64, *types.HistoryEvent, string) (*persistence.RequestCancelInfo, error)
 		ReplicateSignalExternalWorkflowExecutionFailedEvent(*types.HistoryEvent) error
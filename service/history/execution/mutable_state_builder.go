@@ -44,6 +44,7 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/workflowupdate"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/events"
 	"github.com/uber/cadence/service/history/query"
@@ -1157,7 +1158,23 @@ func (e *mutableStateBuilder) AddRecordMarkerEvent(
 		return nil, err
 	}
 
-	return e.hBuilder.AddMarkerRecordedEvent(decisionCompletedEventID, attributes), nil
+	event := e.hBuilder.AddMarkerRecordedEvent(decisionCompletedEventID, attributes)
+	if err := e.ReplicateMarkerRecordedEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateMarkerRecordedEvent(
+	event *types.HistoryEvent,
+) error {
+
+	// update markers answer the updates kept in memo, visibility is not updated for them
+	// since the updates are only read by history
+	if memo, changed := workflowupdate.Apply(e.executionInfo.Memo, event); changed {
+		e.executionInfo.Memo = memo
+	}
+	return nil
 }
 
 func (e *mutableStateBuilder) AddWorkflowExecutionTerminatedEvent(
@@ -1486,11 +1503,7 @@ func (e *mutableStateBuilder) CloseTransactionAsMutation(
 		NewBufferedEvents:         e.updateBufferedEvents,
 		ClearBufferedEvents:       e.clearBufferedEvents,
 
-		TasksByCategory: map[persistence.HistoryTaskCategory][]persistence.Task{
-			persistence.HistoryTaskCategoryTransfer:    e.insertTransferTasks,
-			persistence.HistoryTaskCategoryReplication: e.insertReplicationTasks,
-			persistence.HistoryTaskCategoryTimer:       e.insertTimerTasks,
-		},
+		TasksByCategory: e.getTasksByCategory(),
 
 		WorkflowRequests: convertWorkflowRequests(e.workflowRequests),
 
@@ -1567,11 +1580,7 @@ func (e *mutableStateBuilder) CloseTransactionAsSnapshot(
 		SignalInfos:         maps.Values(e.pendingSignalInfoIDs),
 		SignalRequestedIDs:  maps.Keys(e.pendingSignalRequestedIDs),
 
-		TasksByCategory: map[persistence.HistoryTaskCategory][]persistence.Task{
-			persistence.HistoryTaskCategoryTransfer:    e.insertTransferTasks,
-			persistence.HistoryTaskCategoryReplication: e.insertReplicationTasks,
-			persistence.HistoryTaskCategoryTimer:       e.insertTimerTasks,
-		},
+		TasksByCategory: e.getTasksByCategory(),
 
 		WorkflowRequests: convertWorkflowRequests(e.workflowRequests),
 
@@ -1586,6 +1595,30 @@ func (e *mutableStateBuilder) CloseTransactionAsSnapshot(
 	return workflowSnapshot, workflowEventsSeq, nil
 }
 
+func (e *mutableStateBuilder) getTasksByCategory() map[persistence.HistoryTaskCategory][]persistence.Task {
+	tasksByCategory := map[persistence.HistoryTaskCategory][]persistence.Task{
+		persistence.HistoryTaskCategoryTransfer:    e.insertTransferTasks,
+		persistence.HistoryTaskCategoryReplication: e.insertReplicationTasks,
+		persistence.HistoryTaskCategoryTimer:       e.insertTimerTasks,
+	}
+
+	// the timer tasks deleting workflows after retention are moved to the housekeeping queue,
+	// so that a large number of retention deletions won't delay user timers
+	var timerTasks, housekeepingTasks []persistence.Task
+	for _, task := range e.insertTimerTasks {
+		if task.GetTaskType() == persistence.TaskTypeDeleteHistoryEvent {
+			housekeepingTasks = append(housekeepingTasks, task)
+		} else {
+			timerTasks = append(timerTasks, task)
+		}
+	}
+	if len(housekeepingTasks) > 0 && e.shard.IsHousekeepingQueueEnabled() {
+		tasksByCategory[persistence.HistoryTaskCategoryTimer] = timerTasks
+		tasksByCategory[persistence.HistoryTaskCategoryHousekeeping] = housekeepingTasks
+	}
+	return tasksByCategory
+}
+
 func (e *mutableStateBuilder) IsResourceDuplicated(
 	resourceDedupKey definition.DeduplicationID,
 ) bool {
@@ -28,12 +28,14 @@ import (
 	"time"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/activitycontrol"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/workflowpause"
 )
 
 // GetActivityInfo gives details about an activity that is currently in progress.
@@ -156,9 +158,114 @@ func (e *mutableStateBuilder) DeleteActivity(
 
 	delete(e.updateActivityInfos, scheduleEventID)
 	e.deleteActivityInfos[scheduleEventID] = struct{}{}
+
+	// a closed activity is not paused anymore
+	if memo, changed := activitycontrol.SetPaused(e.executionInfo.Memo, scheduleEventID, nil); changed {
+		e.executionInfo.Memo = memo
+		return e.taskGenerator.GenerateWorkflowSearchAttrTasks()
+	}
 	return nil
 }
 
+// applyActivityControl applies an activity control signal to the pending activity it targets.
+// Requests are validated before being recorded, so a request which doesn't apply anymore,
+// e.g. because the activity closed in between, is ignored instead of failing replication.
+func (e *mutableStateBuilder) applyActivityControl(
+	event *types.HistoryEvent,
+) error {
+
+	attributes := event.WorkflowExecutionSignaledEventAttributes
+	request, err := activitycontrol.DecodeRequest(attributes.Input)
+	if err != nil {
+		e.logWarn("ignoring malformed activity control request", tag.Error(err), tag.WorkflowEventID(event.ID))
+		return nil
+	}
+	ai, ok := e.GetActivityByActivityID(request.ActivityID)
+	if !ok {
+		return nil
+	}
+
+	switch attributes.SignalName {
+	case activitycontrol.PauseSignalName, activitycontrol.UnpauseSignalName:
+		var info *activitycontrol.PauseInfo
+		if attributes.SignalName == activitycontrol.PauseSignalName {
+			info = &activitycontrol.PauseInfo{
+				ActivityID:     ai.ActivityID,
+				Reason:         request.Reason,
+				Identity:       attributes.Identity,
+				PausedTimeNano: event.GetTimestamp(),
+			}
+		}
+		memo, changed := activitycontrol.SetPaused(e.executionInfo.Memo, ai.ScheduleID, info)
+		if !changed {
+			return nil
+		}
+		e.executionInfo.Memo = memo
+		if info == nil {
+			// the tasks dropped while the activity was paused are generated again
+			if err := e.generateActivityControlTasks(ai); err != nil {
+				return err
+			}
+		}
+		return e.taskGenerator.GenerateWorkflowSearchAttrTasks()
+	case activitycontrol.ResetSignalName:
+		ai.Attempt = 0
+		if ai.StartedID == constants.EmptyEventID {
+			// an activity waiting for a retry is scheduled again right away
+			ai.ScheduledTime = time.Unix(0, event.GetTimestamp())
+		}
+	case activitycontrol.UpdateOptionsSignalName:
+		if policy := request.RetryPolicy; policy != nil {
+			ai.HasRetryPolicy = true
+			ai.InitialInterval = policy.GetInitialIntervalInSeconds()
+			ai.BackoffCoefficient = policy.GetBackoffCoefficient()
+			ai.MaximumInterval = policy.GetMaximumIntervalInSeconds()
+			ai.MaximumAttempts = policy.GetMaximumAttempts()
+			ai.NonRetriableErrors = policy.NonRetriableErrorReasons
+			ai.ExpirationTime = time.Time{}
+			if policy.GetExpirationIntervalInSeconds() != 0 {
+				// the expiration interval restarts when the policy is replaced
+				ai.ExpirationTime = time.Unix(0, event.GetTimestamp()).Add(time.Duration(policy.GetExpirationIntervalInSeconds()) * time.Second)
+			}
+		}
+		if request.ScheduleToStartTimeoutSeconds != nil {
+			ai.ScheduleToStartTimeout = *request.ScheduleToStartTimeoutSeconds
+		}
+		if request.ScheduleToCloseTimeoutSeconds != nil {
+			ai.ScheduleToCloseTimeout = *request.ScheduleToCloseTimeoutSeconds
+		}
+		if request.StartToCloseTimeoutSeconds != nil {
+			ai.StartToCloseTimeout = *request.StartToCloseTimeoutSeconds
+		}
+		if request.HeartbeatTimeoutSeconds != nil {
+			ai.HeartbeatTimeout = *request.HeartbeatTimeoutSeconds
+		}
+	default:
+		return nil
+	}
+	ai.Version = event.Version
+	if err := e.UpdateActivity(ai); err != nil {
+		return err
+	}
+	// the tasks are generated again for the new attempt and timeouts to apply right away
+	return e.generateActivityControlTasks(ai)
+}
+
+// generateActivityControlTasks generates the tasks of an activity again after an activity control signal,
+// unless the workflow or the activity is paused, in which case resuming generates them
+func (e *mutableStateBuilder) generateActivityControlTasks(
+	ai *persistence.ActivityInfo,
+) error {
+
+	if workflowpause.IsPaused(e.executionInfo.Memo) || activitycontrol.IsPaused(e.executionInfo.Memo, ai.ScheduleID) {
+		return nil
+	}
+	if err := e.generateActivityTasksOnResume(ai); err != nil {
+		return err
+	}
+	return e.taskGenerator.GenerateActivityTimerTasks()
+}
+
 func (e *mutableStateBuilder) GetActivityScheduledEvent(
 	ctx context.Context,
 	scheduleEventID int64,
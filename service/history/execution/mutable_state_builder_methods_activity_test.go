@@ -31,12 +31,14 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/activitycontrol"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
 	commonconstants "github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/workflowpause"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/constants"
 	"github.com/uber/cadence/service/history/events"
@@ -694,3 +696,147 @@ func Test__AddActivityTaskFailedEvent(t *testing.T) {
 
 	})
 }
+
+func Test__applyActivityControl(t *testing.T) {
+	signaled := func(t *testing.T, signalName string, request *activitycontrol.Request) *types.HistoryEvent {
+		input, err := activitycontrol.EncodeRequest(request)
+		assert.NoError(t, err)
+		return &types.HistoryEvent{
+			Version:   10,
+			Timestamp: common.Int64Ptr(time.Unix(100, 0).UnixNano()),
+			WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{
+				SignalName: signalName,
+				Input:      input,
+				Identity:   "operator",
+			},
+		}
+	}
+	newBuilder := func(t *testing.T) (*mutableStateBuilder, *persistence.ActivityInfo) {
+		mb := testMutableStateBuilder(t)
+		ai := &persistence.ActivityInfo{
+			ScheduleID:          5,
+			ActivityID:          "payment",
+			Attempt:             7,
+			StartToCloseTimeout: 10,
+		}
+		mb.pendingActivityInfoIDs[ai.ScheduleID] = ai
+		mb.pendingActivityIDToEventID[ai.ActivityID] = ai.ScheduleID
+		return mb, ai
+	}
+
+	t.Run("reset", func(t *testing.T) {
+		mb, ai := newBuilder(t)
+		ai.TimerTaskStatus = TimerTaskStatusCreatedStartToClose
+		taskGenerator := NewMockMutableStateTaskGenerator(gomock.NewController(t))
+		mb.taskGenerator = taskGenerator
+		taskGenerator.EXPECT().GenerateActivityTimerTasks().Return(nil)
+
+		err := mb.ReplicateWorkflowExecutionSignaled(signaled(t, activitycontrol.ResetSignalName, &activitycontrol.Request{ActivityID: "payment"}))
+		assert.NoError(t, err)
+		assert.Equal(t, int32(0), ai.Attempt)
+		assert.Equal(t, int64(10), ai.Version)
+		assert.Equal(t, int32(TimerTaskStatusNone), ai.TimerTaskStatus)
+		assert.Equal(t, ai, mb.updateActivityInfos[ai.ScheduleID])
+	})
+	t.Run("reset activity waiting for retry", func(t *testing.T) {
+		mb, ai := newBuilder(t)
+		ai.StartedID = commonconstants.EmptyEventID
+		ai.ScheduledTime = time.Unix(500, 0)
+		taskGenerator := NewMockMutableStateTaskGenerator(gomock.NewController(t))
+		mb.taskGenerator = taskGenerator
+		taskGenerator.EXPECT().GenerateActivityRetryTasks(ai.ScheduleID).Return(nil)
+		taskGenerator.EXPECT().GenerateActivityTimerTasks().Return(nil)
+
+		err := mb.ReplicateWorkflowExecutionSignaled(signaled(t, activitycontrol.ResetSignalName, &activitycontrol.Request{ActivityID: "payment"}))
+		assert.NoError(t, err)
+		assert.Equal(t, int32(0), ai.Attempt)
+		assert.Equal(t, time.Unix(100, 0), ai.ScheduledTime)
+	})
+	t.Run("reset while workflow is paused", func(t *testing.T) {
+		mb, ai := newBuilder(t)
+		mb.executionInfo.Memo = map[string][]byte{workflowpause.MemoKey: []byte(`{"reason":"incident"}`)}
+		taskGenerator := NewMockMutableStateTaskGenerator(gomock.NewController(t))
+		mb.taskGenerator = taskGenerator
+
+		err := mb.ReplicateWorkflowExecutionSignaled(signaled(t, activitycontrol.ResetSignalName, &activitycontrol.Request{ActivityID: "payment"}))
+		assert.NoError(t, err)
+		assert.Equal(t, int32(0), ai.Attempt)
+	})
+	t.Run("update options", func(t *testing.T) {
+		mb, ai := newBuilder(t)
+		taskGenerator := NewMockMutableStateTaskGenerator(gomock.NewController(t))
+		mb.taskGenerator = taskGenerator
+		taskGenerator.EXPECT().GenerateActivityTimerTasks().Return(nil)
+
+		err := mb.ReplicateWorkflowExecutionSignaled(signaled(t, activitycontrol.UpdateOptionsSignalName, &activitycontrol.Request{
+			ActivityID: "payment",
+			RetryPolicy: &types.RetryPolicy{
+				InitialIntervalInSeconds:    1,
+				BackoffCoefficient:          2,
+				MaximumIntervalInSeconds:    60,
+				MaximumAttempts:             20,
+				NonRetriableErrorReasons:    []string{"bad-input"},
+				ExpirationIntervalInSeconds: 3600,
+			},
+			HeartbeatTimeoutSeconds: common.Int32Ptr(15),
+		}))
+		assert.NoError(t, err)
+		assert.True(t, ai.HasRetryPolicy)
+		assert.Equal(t, int32(1), ai.InitialInterval)
+		assert.Equal(t, 2.0, ai.BackoffCoefficient)
+		assert.Equal(t, int32(60), ai.MaximumInterval)
+		assert.Equal(t, int32(20), ai.MaximumAttempts)
+		assert.Equal(t, []string{"bad-input"}, ai.NonRetriableErrors)
+		assert.Equal(t, time.Unix(100+3600, 0), ai.ExpirationTime)
+		assert.Equal(t, int32(15), ai.HeartbeatTimeout)
+		assert.Equal(t, int32(10), ai.StartToCloseTimeout)
+		assert.Equal(t, int32(7), ai.Attempt)
+		assert.Equal(t, ai, mb.updateActivityInfos[ai.ScheduleID])
+	})
+	t.Run("pause and unpause", func(t *testing.T) {
+		mb, ai := newBuilder(t)
+		taskGenerator := NewMockMutableStateTaskGenerator(gomock.NewController(t))
+		mb.taskGenerator = taskGenerator
+		taskGenerator.EXPECT().GenerateWorkflowSearchAttrTasks().Return(nil).Times(2)
+		// the tasks dropped while the activity was paused are generated again on unpause
+		taskGenerator.EXPECT().GenerateActivityTimerTasks().Return(nil)
+
+		request := &activitycontrol.Request{ActivityID: "payment", Reason: "dependency down"}
+		assert.NoError(t, mb.ReplicateWorkflowExecutionSignaled(signaled(t, activitycontrol.PauseSignalName, request)))
+		assert.Equal(t, activitycontrol.PausedActivities{ai.ScheduleID: {
+			ActivityID:     "payment",
+			Reason:         "dependency down",
+			Identity:       "operator",
+			PausedTimeNano: time.Unix(100, 0).UnixNano(),
+		}}, activitycontrol.GetPausedActivities(mb.executionInfo.Memo))
+		assert.NoError(t, mb.ReplicateWorkflowExecutionSignaled(signaled(t, activitycontrol.PauseSignalName, request)))
+		assert.Empty(t, mb.updateActivityInfos)
+
+		assert.NoError(t, mb.ReplicateWorkflowExecutionSignaled(signaled(t, activitycontrol.UnpauseSignalName, request)))
+		assert.False(t, activitycontrol.IsPaused(mb.executionInfo.Memo, ai.ScheduleID))
+	})
+	t.Run("delete paused activity", func(t *testing.T) {
+		mb, ai := newBuilder(t)
+		taskGenerator := NewMockMutableStateTaskGenerator(gomock.NewController(t))
+		mb.taskGenerator = taskGenerator
+		taskGenerator.EXPECT().GenerateWorkflowSearchAttrTasks().Return(nil).Times(2)
+
+		assert.NoError(t, mb.ReplicateWorkflowExecutionSignaled(signaled(t, activitycontrol.PauseSignalName, &activitycontrol.Request{ActivityID: "payment"})))
+		assert.NoError(t, mb.DeleteActivity(ai.ScheduleID))
+		assert.False(t, activitycontrol.IsPaused(mb.executionInfo.Memo, ai.ScheduleID))
+	})
+	t.Run("activity not pending", func(t *testing.T) {
+		mb, ai := newBuilder(t)
+		err := mb.ReplicateWorkflowExecutionSignaled(signaled(t, activitycontrol.ResetSignalName, &activitycontrol.Request{ActivityID: "shipping"}))
+		assert.NoError(t, err)
+		assert.Equal(t, int32(7), ai.Attempt)
+		assert.Empty(t, mb.updateActivityInfos)
+	})
+	t.Run("malformed request", func(t *testing.T) {
+		mb, ai := newBuilder(t)
+		event := signaled(t, activitycontrol.ResetSignalName, &activitycontrol.Request{})
+		event.WorkflowExecutionSignaledEventAttributes.Input = []byte("not json")
+		assert.NoError(t, mb.ReplicateWorkflowExecutionSignaled(event))
+		assert.Equal(t, int32(7), ai.Attempt)
+	})
+}
@@ -25,9 +25,16 @@ package execution
 import (
 	"fmt"
 
+	"github.com/uber/cadence/common/activitycontrol"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/delayedsignal"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/searchattrupdate"
+	"github.com/uber/cadence/common/signaldedup"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/workflowpause"
+	"github.com/uber/cadence/common/workflowupdate"
 )
 
 func (e *mutableStateBuilder) IsSignalRequested(
@@ -84,7 +91,159 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionSignaled(
 		Version:     event.Version,
 		RequestType: persistence.WorkflowRequestTypeSignal,
 	})
-	return nil
+
+	// the request ID is kept in memo for the signal deduplication window set when the run started,
+	// visibility is not updated for it since the request IDs are only read by history
+	if memo, changed := signaldedup.Record(
+		e.executionInfo.Memo,
+		event.WorkflowExecutionSignaledEventAttributes.RequestID,
+		event.GetTimestamp(),
+	); changed {
+		e.executionInfo.Memo = memo
+	}
+	// updates are tracked apart from the signal requested IDs, which are removed once the signal is processed
+	if memo, changed := workflowupdate.Apply(e.executionInfo.Memo, event); changed {
+		e.executionInfo.Memo = memo
+	}
+
+	if activitycontrol.IsSignal(event.WorkflowExecutionSignaledEventAttributes.SignalName) {
+		return e.applyActivityControl(event)
+	}
+	if searchattrupdate.IsSignal(event.WorkflowExecutionSignaledEventAttributes.SignalName) {
+		return e.applySearchAttributesUpdate(event)
+	}
+	if delayedsignal.IsSignal(event.WorkflowExecutionSignaledEventAttributes.SignalName) {
+		return e.applyDelayedSignal(event)
+	}
+	// a delivered delayed signal is no longer pending
+	if id, ok := delayedsignal.GetByRequestID(
+		e.executionInfo.Memo,
+		e.executionInfo.RunID,
+		event.WorkflowExecutionSignaledEventAttributes.RequestID,
+	); ok {
+		memo, _ := delayedsignal.SetPending(e.executionInfo.Memo, id, nil)
+		e.executionInfo.Memo = memo
+		return e.taskGenerator.GenerateWorkflowSearchAttrTasks()
+	}
+
+	// pause and unpause signals flip the paused state, which is kept in memo so that visibility sees it
+	memo, changed := workflowpause.Apply(e.executionInfo.Memo, event)
+	if !changed {
+		return nil
+	}
+	e.executionInfo.Memo = memo
+	if !workflowpause.IsPaused(memo) {
+		if err := e.generateTasksOnResume(); err != nil {
+			return err
+		}
+	}
+	return e.taskGenerator.GenerateWorkflowSearchAttrTasks()
+}
+
+// generateTasksOnResume generates the tasks dropped while the workflow was paused again: the task of the
+// scheduled decision, the tasks of the activities which aren't paused themselves, and the user timers.
+// They are generated while applying the unpause signal so that the standby generates them as well.
+func (e *mutableStateBuilder) generateTasksOnResume() error {
+	if decision, ok := e.GetPendingDecision(); ok && decision.StartedID == constants.EmptyEventID {
+		if err := e.taskGenerator.GenerateDecisionScheduleTasks(decision.ScheduleID); err != nil {
+			return err
+		}
+	}
+	for _, ai := range e.pendingActivityInfoIDs {
+		if activitycontrol.IsPaused(e.executionInfo.Memo, ai.ScheduleID) {
+			continue
+		}
+		if err := e.generateActivityTasksOnResume(ai); err != nil {
+			return err
+		}
+	}
+	if err := e.taskGenerator.GenerateActivityTimerTasks(); err != nil {
+		return err
+	}
+	for _, ti := range e.pendingTimerInfoIDs {
+		// clear the timer task mask so that the user timer tasks are generated again
+		ti.TaskStatus = TimerTaskStatusNone
+		if err := e.UpdateUserTimer(ti); err != nil {
+			return err
+		}
+	}
+	return e.taskGenerator.GenerateUserTimerTasks()
+}
+
+// generateActivityTasksOnResume generates the tasks of a resumed activity again. An activity which isn't started
+// is dispatched by a retry timer at its scheduled time, which has passed unless the activity waits for a retry.
+// Activity timers are created again by GenerateActivityTimerTasks.
+func (e *mutableStateBuilder) generateActivityTasksOnResume(ai *persistence.ActivityInfo) error {
+	// clear the timer task mask so that the activity timer tasks are generated again
+	ai.TimerTaskStatus = TimerTaskStatusNone
+	if err := e.UpdateActivity(ai); err != nil {
+		return err
+	}
+	if ai.StartedID != constants.EmptyEventID {
+		return nil
+	}
+	return e.taskGenerator.GenerateActivityRetryTasks(ai.ScheduleID)
+}
+
+// applySearchAttributesUpdate upserts the search attributes carried by a search attributes update signal,
+// the same way as ReplicateUpsertWorkflowSearchAttributesEvent does
+func (e *mutableStateBuilder) applySearchAttributesUpdate(
+	event *types.HistoryEvent,
+) error {
+
+	searchAttributes, err := searchattrupdate.DecodeRequest(event.WorkflowExecutionSignaledEventAttributes.Input)
+	if err != nil {
+		e.logWarn("ignoring malformed search attributes update request", tag.Error(err), tag.WorkflowEventID(event.ID))
+		return nil
+	}
+	e.executionInfo.SearchAttributes = mergeMapOfByteArray(e.executionInfo.SearchAttributes, searchAttributes.GetIndexedFields())
+	return e.taskGenerator.GenerateWorkflowSearchAttrTasks()
+}
+
+// applyDelayedSignal schedules or cancels the delayed signal carried by a delayed signal or cancel signal.
+// Requests are validated before being recorded, so a cancel request for a delayed signal which isn't
+// pending anymore, e.g. because it was delivered in between, is ignored instead of failing replication.
+func (e *mutableStateBuilder) applyDelayedSignal(
+	event *types.HistoryEvent,
+) error {
+
+	attributes := event.WorkflowExecutionSignaledEventAttributes
+	request, err := delayedsignal.DecodeRequest(attributes.Input)
+	if err != nil {
+		e.logWarn("ignoring malformed delayed signal request", tag.Error(err), tag.WorkflowEventID(event.ID))
+		return nil
+	}
+	if err := request.Validate(attributes.SignalName); err != nil {
+		e.logWarn("ignoring invalid delayed signal request", tag.Error(err), tag.WorkflowEventID(event.ID))
+		return nil
+	}
+
+	if attributes.SignalName == delayedsignal.CancelSignalName {
+		memo, changed := delayedsignal.SetPending(e.executionInfo.Memo, request.ID, nil)
+		if !changed {
+			return nil
+		}
+		e.executionInfo.Memo = memo
+		return e.taskGenerator.GenerateWorkflowSearchAttrTasks()
+	}
+
+	id := int64(e.executionInfo.SignalCount)
+	memo, changed := delayedsignal.SetPending(e.executionInfo.Memo, id, &delayedsignal.Pending{
+		SignalName:        request.SignalName,
+		Input:             request.Input,
+		Identity:          attributes.Identity,
+		DeliverAfterNano:  request.DeliverAfterNano,
+		ScheduledTimeNano: event.GetTimestamp(),
+		Version:           event.Version,
+	})
+	if !changed {
+		return nil
+	}
+	e.executionInfo.Memo = memo
+	if err := e.taskGenerator.GenerateDelayedSignalTasks(id); err != nil {
+		return err
+	}
+	return e.taskGenerator.GenerateWorkflowSearchAttrTasks()
 }
 
 func (e *mutableStateBuilder) AddExternalWorkflowExecutionSignaled(
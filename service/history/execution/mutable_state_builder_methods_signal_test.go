@@ -24,12 +24,24 @@ package execution
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/activitycontrol"
+	commonconstants "github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/delayedsignal"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/searchattrupdate"
+	"github.com/uber/cadence/common/signaldedup"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/workflowpause"
 	"github.com/uber/cadence/service/history/constants"
+	"github.com/uber/cadence/service/history/events"
 )
 
 func Test__IsSignalRequested(t *testing.T) {
@@ -111,6 +123,234 @@ func Test__ReplicateSignalExternalWorkflowExecutionFailedEvent(t *testing.T) {
 	assert.False(t, ok)
 }
 
+func Test__ReplicateWorkflowExecutionSignaled_Pause(t *testing.T) {
+	mb := testMutableStateBuilder(t)
+	ctrl := gomock.NewController(t)
+	taskGenerator := NewMockMutableStateTaskGenerator(ctrl)
+	mb.taskGenerator = taskGenerator
+	startMemo := map[string][]byte{"owner": []byte("payments")}
+	mb.executionInfo.Memo = startMemo
+	signaled := func(signalName string) *types.HistoryEvent {
+		return &types.HistoryEvent{
+			Timestamp: common.Int64Ptr(100),
+			WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{
+				SignalName: signalName,
+				RequestID:  signalName,
+			},
+		}
+	}
+
+	// visibility is updated only when the paused state changes
+	taskGenerator.EXPECT().GenerateWorkflowSearchAttrTasks().Return(nil).Times(2)
+
+	assert.NoError(t, mb.ReplicateWorkflowExecutionSignaled(signaled(workflowpause.PauseSignalName)))
+	assert.True(t, workflowpause.IsPaused(mb.executionInfo.Memo))
+	assert.False(t, workflowpause.IsPaused(startMemo))
+	assert.NoError(t, mb.ReplicateWorkflowExecutionSignaled(signaled(workflowpause.PauseSignalName)))
+
+	// resuming a workflow without pending decision, activities and timers only creates the next timers
+	taskGenerator.EXPECT().GenerateActivityTimerTasks().Return(nil).Times(1)
+	taskGenerator.EXPECT().GenerateUserTimerTasks().Return(nil).Times(1)
+	assert.NoError(t, mb.ReplicateWorkflowExecutionSignaled(signaled(workflowpause.UnpauseSignalName)))
+	assert.False(t, workflowpause.IsPaused(mb.executionInfo.Memo))
+	assert.Equal(t, startMemo, mb.executionInfo.Memo)
+
+	assert.NoError(t, mb.ReplicateWorkflowExecutionSignaled(signaled("other")))
+	assert.Equal(t, int32(4), mb.executionInfo.SignalCount)
+}
+
+func Test__ReplicateWorkflowExecutionSignaled_UnpauseGeneratesTasks(t *testing.T) {
+	mb := testMutableStateBuilder(t)
+	ctrl := gomock.NewController(t)
+	taskGenerator := NewMockMutableStateTaskGenerator(ctrl)
+	mb.taskGenerator = taskGenerator
+	mb.executionInfo.DecisionScheduleID = 5
+	mb.executionInfo.DecisionStartedID = commonconstants.EmptyEventID
+	memo, _ := activitycontrol.SetPaused(map[string][]byte{workflowpause.MemoKey: []byte("{}")}, 12, &activitycontrol.PauseInfo{ActivityID: "paused"})
+	mb.executionInfo.Memo = memo
+	scheduled := &persistence.ActivityInfo{ScheduleID: 10, StartedID: commonconstants.EmptyEventID, TimerTaskStatus: TimerTaskStatusCreatedScheduleToStart}
+	started := &persistence.ActivityInfo{ScheduleID: 11, StartedID: 13, TimerTaskStatus: TimerTaskStatusCreatedStartToClose}
+	paused := &persistence.ActivityInfo{ScheduleID: 12, StartedID: commonconstants.EmptyEventID, TimerTaskStatus: TimerTaskStatusCreatedScheduleToStart}
+	for _, ai := range []*persistence.ActivityInfo{scheduled, started, paused} {
+		mb.pendingActivityInfoIDs[ai.ScheduleID] = ai
+	}
+	timer := &persistence.TimerInfo{TimerID: "timer", StartedID: 14, TaskStatus: TimerTaskStatusCreated}
+	mb.pendingTimerInfoIDs[timer.TimerID] = timer
+	mb.pendingTimerEventIDToID[timer.StartedID] = timer.TimerID
+
+	taskGenerator.EXPECT().GenerateDecisionScheduleTasks(int64(5)).Return(nil).Times(1)
+	taskGenerator.EXPECT().GenerateActivityRetryTasks(int64(10)).Return(nil).Times(1)
+	taskGenerator.EXPECT().GenerateActivityTimerTasks().Return(nil).Times(1)
+	taskGenerator.EXPECT().GenerateUserTimerTasks().Return(nil).Times(1)
+	taskGenerator.EXPECT().GenerateWorkflowSearchAttrTasks().Return(nil).Times(1)
+
+	assert.NoError(t, mb.ReplicateWorkflowExecutionSignaled(&types.HistoryEvent{
+		WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{
+			SignalName: workflowpause.UnpauseSignalName,
+		},
+	}))
+	assert.False(t, workflowpause.IsPaused(mb.executionInfo.Memo))
+	// timer task masks are cleared so that the timers are created again, except for the paused activity
+	assert.Equal(t, int32(TimerTaskStatusNone), scheduled.TimerTaskStatus)
+	assert.Equal(t, int32(TimerTaskStatusNone), started.TimerTaskStatus)
+	assert.Equal(t, int32(TimerTaskStatusCreatedScheduleToStart), paused.TimerTaskStatus)
+	assert.Equal(t, int64(TimerTaskStatusNone), timer.TaskStatus)
+}
+
+func Test__ReplicateWorkflowExecutionSignaled_SearchAttributesUpdate(t *testing.T) {
+	mb := testMutableStateBuilder(t)
+	ctrl := gomock.NewController(t)
+	taskGenerator := NewMockMutableStateTaskGenerator(ctrl)
+	mb.taskGenerator = taskGenerator
+	mb.executionInfo.SearchAttributes = map[string][]byte{"CustomKeywordField": []byte(`"old"`), "CustomIntField": []byte("1")}
+	signaled := func(input []byte) *types.HistoryEvent {
+		return &types.HistoryEvent{
+			WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{
+				SignalName: searchattrupdate.SignalName,
+				Input:      input,
+			},
+		}
+	}
+
+	taskGenerator.EXPECT().GenerateWorkflowSearchAttrTasks().Return(nil).Times(1)
+	input, err := searchattrupdate.EncodeRequest(&types.SearchAttributes{IndexedFields: map[string][]byte{"CustomKeywordField": []byte(`"new"`)}})
+	require.NoError(t, err)
+	assert.NoError(t, mb.ReplicateWorkflowExecutionSignaled(signaled(input)))
+	assert.Equal(t, map[string][]byte{"CustomKeywordField": []byte(`"new"`), "CustomIntField": []byte("1")}, mb.executionInfo.SearchAttributes)
+
+	// malformed requests are rejected by history before they are recorded, replicated ones are ignored
+	assert.NoError(t, mb.ReplicateWorkflowExecutionSignaled(signaled([]byte("{"))))
+	assert.Equal(t, int32(2), mb.executionInfo.SignalCount)
+}
+
+func Test__ReplicateWorkflowExecutionSignaled_DelayedSignal(t *testing.T) {
+	mb := testMutableStateBuilder(t)
+	ctrl := gomock.NewController(t)
+	taskGenerator := NewMockMutableStateTaskGenerator(ctrl)
+	mb.taskGenerator = taskGenerator
+	startMemo := map[string][]byte{"owner": []byte("payments")}
+	mb.executionInfo.Memo = startMemo
+	signaled := func(signalName string, requestID string, request *delayedsignal.Request) *types.HistoryEvent {
+		input, err := delayedsignal.EncodeRequest(request)
+		require.NoError(t, err)
+		return &types.HistoryEvent{
+			ID:        commonconstants.BufferedEventID,
+			Version:   10,
+			Timestamp: common.Int64Ptr(100),
+			WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{
+				SignalName: signalName,
+				Input:      input,
+				Identity:   "operator",
+				RequestID:  requestID,
+			},
+		}
+	}
+
+	// delayed signals are keyed by signal count, as buffered signals have no event ID yet
+	taskGenerator.EXPECT().GenerateDelayedSignalTasks(int64(1)).Return(nil).Times(1)
+	taskGenerator.EXPECT().GenerateDelayedSignalTasks(int64(2)).Return(nil).Times(1)
+	taskGenerator.EXPECT().GenerateWorkflowSearchAttrTasks().Return(nil).Times(4)
+
+	assert.NoError(t, mb.ReplicateWorkflowExecutionSignaled(signaled(delayedsignal.SignalName, "", &delayedsignal.Request{
+		SignalName:       "reminder",
+		Input:            []byte("payload"),
+		DeliverAfterNano: 200,
+	})))
+	assert.NoError(t, mb.ReplicateWorkflowExecutionSignaled(signaled(delayedsignal.SignalName, "", &delayedsignal.Request{
+		SignalName:       "follow-up",
+		DeliverAfterNano: 300,
+	})))
+	assert.Equal(t, delayedsignal.PendingSignals{
+		1: {SignalName: "reminder", Input: []byte("payload"), Identity: "operator", DeliverAfterNano: 200, ScheduledTimeNano: 100, Version: 10},
+		2: {SignalName: "follow-up", Identity: "operator", DeliverAfterNano: 300, ScheduledTimeNano: 100, Version: 10},
+	}, delayedsignal.GetPending(mb.executionInfo.Memo))
+	assert.Nil(t, delayedsignal.GetPending(startMemo))
+
+	// cancelling removes the delayed signal, cancelling it again is ignored
+	assert.NoError(t, mb.ReplicateWorkflowExecutionSignaled(signaled(delayedsignal.CancelSignalName, "", &delayedsignal.Request{ID: 2})))
+	assert.NoError(t, mb.ReplicateWorkflowExecutionSignaled(signaled(delayedsignal.CancelSignalName, "", &delayedsignal.Request{ID: 2})))
+	assert.Len(t, delayedsignal.GetPending(mb.executionInfo.Memo), 1)
+
+	// delivering removes the delayed signal
+	assert.NoError(t, mb.ReplicateWorkflowExecutionSignaled(signaled("reminder", delayedsignal.RequestID(mb.executionInfo.RunID, 1), &delayedsignal.Request{})))
+	assert.Equal(t, startMemo, mb.executionInfo.Memo)
+
+	assert.NoError(t, mb.ReplicateWorkflowExecutionSignaled(signaled(delayedsignal.SignalName, "", nil)))
+	assert.Equal(t, int32(6), mb.executionInfo.SignalCount)
+}
+
+func Test__ReplicateWorkflowExecutionSignaled_SignalDedup(t *testing.T) {
+	mb := testMutableStateBuilder(t)
+	startMemo := map[string][]byte{"owner": []byte("payments")}
+	mb.executionInfo.Memo = startMemo
+	signaled := func(requestID string, timestamp int64) *types.HistoryEvent {
+		return &types.HistoryEvent{
+			ID:        commonconstants.BufferedEventID,
+			Timestamp: common.Int64Ptr(timestamp),
+			WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{
+				SignalName: "signal",
+				RequestID:  requestID,
+			},
+		}
+	}
+
+	// request IDs are not kept without a window, whatever the window of the domain
+	mb.config.SignalDedupWindow = dynamicproperties.GetDurationPropertyFnFilteredByDomain(time.Minute)
+	mb.config.SignalDedupMaxRequestIDs = dynamicproperties.GetIntPropertyFilteredByDomain(10)
+	assert.NoError(t, mb.ReplicateWorkflowExecutionSignaled(signaled("request-1", 100)))
+	assert.Equal(t, startMemo, mb.executionInfo.Memo)
+
+	// the window set when the run started applies, not the one of the domain
+	startMemo = signaldedup.Start(startMemo, nil, 0, time.Minute, 10)
+	mb.executionInfo.Memo = startMemo
+	mb.config.SignalDedupWindow = dynamicproperties.GetDurationPropertyFnFilteredByDomain(0)
+	assert.NoError(t, mb.ReplicateWorkflowExecutionSignaled(signaled("request-1", 100)))
+	assert.NoError(t, mb.ReplicateWorkflowExecutionSignaled(signaled("", 200)))
+	assert.NoError(t, mb.ReplicateWorkflowExecutionSignaled(signaled("request-2", 300)))
+	assert.Equal(t, signaldedup.RequestIDs{"request-1": 100, "request-2": 300}, signaldedup.Get(mb.executionInfo.Memo).RequestIDs)
+	assert.Equal(t, []byte("payments"), mb.executionInfo.Memo["owner"])
+	assert.Empty(t, signaldedup.Get(startMemo).RequestIDs)
+
+	// request IDs older than the window are dropped
+	assert.NoError(t, mb.ReplicateWorkflowExecutionSignaled(signaled("request-3", 100+int64(time.Minute))))
+	assert.Equal(t, signaldedup.RequestIDs{"request-2": 300, "request-3": 100 + int64(time.Minute)}, signaldedup.Get(mb.executionInfo.Memo).RequestIDs)
+}
+
+func Test__AddWorkflowExecutionStartedEvent_SignalDedupWindow(t *testing.T) {
+	mb := testMutableStateBuilder(t)
+	mb.shard.GetEventsCache().(*events.MockCache).EXPECT().PutEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any())
+	mb.config.SignalDedupWindow = dynamicproperties.GetDurationPropertyFnFilteredByDomain(time.Minute)
+	mb.config.SignalDedupMaxRequestIDs = dynamicproperties.GetIntPropertyFilteredByDomain(10)
+	memo := &types.Memo{Fields: map[string][]byte{"owner": []byte("payments")}}
+	request := &types.HistoryStartWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		StartRequest: &types.StartWorkflowExecutionRequest{
+			Domain:                              constants.TestDomainName,
+			WorkflowID:                          constants.TestWorkflowID,
+			WorkflowType:                        &types.WorkflowType{Name: "workflow"},
+			TaskList:                            &types.TaskList{Name: "tasklist"},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(60),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+			Memo:                                memo,
+		},
+	}
+
+	event, err := mb.AddWorkflowExecutionStartedEvent(types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}, request)
+	assert.NoError(t, err)
+	// the window is set in the started event so that every cluster applies the same one
+	assert.Equal(t, &signaldedup.Window{
+		LengthNano:    int64(time.Minute),
+		MaxRequestIDs: 10,
+	}, signaldedup.Get(event.WorkflowExecutionStartedEventAttributes.Memo.GetFields()))
+	assert.Equal(t, event.WorkflowExecutionStartedEventAttributes.Memo.GetFields(), mb.executionInfo.Memo)
+	assert.Equal(t, []byte("payments"), mb.executionInfo.Memo["owner"])
+	assert.Same(t, memo, request.StartRequest.Memo, "request must not be modified")
+	assert.NotContains(t, memo.Fields, signaldedup.MemoKey)
+}
+
 func Test__AddSignalRequested(t *testing.T) {
 	mb := testMutableStateBuilder(t)
 	requestID := "101"
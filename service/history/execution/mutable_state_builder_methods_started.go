@@ -31,7 +31,9 @@ import (
 	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/signaldedup"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/workflowupdate"
 )
 
 func (e *mutableStateBuilder) addWorkflowExecutionStartedEventForContinueAsNew(
@@ -65,6 +67,13 @@ func (e *mutableStateBuilder) addWorkflowExecutionStartedEventForContinueAsNew(
 		decisionTimeout = attributes.GetTaskStartToCloseTimeoutSeconds()
 	}
 
+	// the request IDs of the signal deduplication window and the updates outlive the run, they are
+	// carried over in the started event of the new run rather than in the continued as new event
+	memo := workflowupdate.Carry(previousExecutionInfo.Memo, e.signalDedupStartMemo(
+		attributes.Memo.GetFields(),
+		previousExecutionInfo.Memo,
+	))
+
 	createRequest := &types.StartWorkflowExecutionRequest{
 		RequestID:                           uuid.New(),
 		Domain:                              e.domainEntry.GetInfo().Name,
@@ -77,7 +86,7 @@ func (e *mutableStateBuilder) addWorkflowExecutionStartedEventForContinueAsNew(
 		Header:                              attributes.Header,
 		RetryPolicy:                         attributes.RetryPolicy,
 		CronSchedule:                        attributes.CronSchedule,
-		Memo:                                attributes.Memo,
+		Memo:                                memoOf(memo),
 		SearchAttributes:                    attributes.SearchAttributes,
 		JitterStartSeconds:                  attributes.JitterStartSeconds,
 		CronOverlapPolicy:                   attributes.CronOverlapPolicy,
@@ -162,6 +171,14 @@ func (e *mutableStateBuilder) AddWorkflowExecutionStartedEvent(
 		return nil, e.createInternalServerError(opTag)
 	}
 
+	if memo := e.signalDedupStartMemo(request.Memo.GetFields(), nil); memo[signaldedup.MemoKey] != nil {
+		withWindow := *request
+		withWindow.Memo = &types.Memo{Fields: memo}
+		withRequest := *startRequest
+		withRequest.StartRequest = &withWindow
+		startRequest = &withRequest
+	}
+
 	event := e.hBuilder.AddWorkflowExecutionStartedEvent(startRequest, nil, execution.GetRunID(), execution.GetRunID(),
 		time.Now())
 
@@ -298,3 +315,26 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionStartedEvent(
 	}
 	return nil
 }
+
+// signalDedupStartMemo returns the memo of a started event, with the signal deduplication window of the
+// domain holding the request IDs of the previous run
+func (e *mutableStateBuilder) signalDedupStartMemo(
+	memo map[string][]byte,
+	previousMemo map[string][]byte,
+) map[string][]byte {
+	domainName := e.domainEntry.GetInfo().Name
+	return signaldedup.Start(
+		memo,
+		previousMemo,
+		e.timeSource.Now().UnixNano(),
+		e.config.SignalDedupWindow(domainName),
+		e.config.SignalDedupMaxRequestIDs(domainName),
+	)
+}
+
+func memoOf(fields map[string][]byte) *types.Memo {
+	if fields == nil {
+		return nil
+	}
+	return &types.Memo{Fields: fields}
+}
@@ -3604,6 +3604,76 @@ func TestCloseTransactionAsMutation(t *testing.T) {
 			},
 			expectedErr: nil,
 		},
+		"deletion tasks moved to housekeeping queue": {
+			mutableStateSetup: func(ms *mutableStateBuilder) {
+				ms.executionInfo.DomainID = "some-domain-id"
+				ms.executionInfo.NextEventID = 10
+				ms.executionInfo.LastProcessedEvent = 5
+				ms.executionInfo.State = persistence.WorkflowStateRunning
+				ms.executionInfo.CloseStatus = persistence.WorkflowCloseStatusNone
+				ms.insertTimerTasks = []persistence.Task{
+					&persistence.UserTimerTask{EventID: 3},
+					&persistence.DeleteHistoryEventTask{},
+				}
+			},
+			shardContextExpectations: func(mockCache *events.MockCache, shardContext *shardCtx.MockContext, mockDomainCache *cache.MockDomainCache) {
+				shardContext.EXPECT().IsHousekeepingQueueEnabled().Return(true).Times(1)
+				shardContext.EXPECT().GetConfig().Return(&config.Config{
+					NumberOfShards:                        2,
+					IsAdvancedVisConfigExist:              false,
+					MaxResponseSize:                       0,
+					MutableStateChecksumInvalidateBefore:  dynamicproperties.GetFloatPropertyFn(10),
+					MutableStateChecksumVerifyProbability: dynamicproperties.GetIntPropertyFilteredByDomain(0.0),
+					HostName:                              "test-host",
+					EnableReplicationTaskGeneration:       func(string, string) bool { return true },
+					MaximumBufferedEventsBatch:            func(...dynamicproperties.FilterOption) int { return 100 },
+				}).Times(2)
+
+				shardContext.EXPECT().GetDomainCache().Return(mockDomainCache).Times(1)
+				mockDomainCache.EXPECT().GetDomainByID("some-domain-id").Return(mockDomain, nil)
+
+			},
+			expectedMutation: &persistence.WorkflowMutation{
+				ExecutionInfo: &persistence.WorkflowExecutionInfo{
+					DomainID:             "some-domain-id",
+					NextEventID:          10,
+					LastProcessedEvent:   5,
+					State:                persistence.WorkflowStateRunning,
+					CloseStatus:          persistence.WorkflowCloseStatusNone,
+					LastUpdatedTimestamp: now,
+					DecisionVersion:      commonconstants.EmptyVersion,
+					DecisionScheduleID:   commonconstants.EmptyEventID,
+					DecisionRequestID:    commonconstants.EmptyUUID,
+					DecisionStartedID:    commonconstants.EmptyEventID,
+				},
+				TasksByCategory: map[persistence.HistoryTaskCategory][]persistence.Task{
+					persistence.HistoryTaskCategoryTransfer: nil,
+					persistence.HistoryTaskCategoryTimer: []persistence.Task{
+						&persistence.UserTimerTask{EventID: 3},
+					},
+					persistence.HistoryTaskCategoryReplication: nil,
+					persistence.HistoryTaskCategoryHousekeeping: []persistence.Task{
+						&persistence.DeleteHistoryEventTask{},
+					},
+				},
+				UpsertActivityInfos:       []*persistence.ActivityInfo{},
+				DeleteActivityInfos:       []int64{},
+				UpsertTimerInfos:          []*persistence.TimerInfo{},
+				DeleteTimerInfos:          []string{},
+				UpsertChildExecutionInfos: []*persistence.ChildExecutionInfo{},
+				UpsertRequestCancelInfos:  []*persistence.RequestCancelInfo{},
+				DeleteRequestCancelInfos:  []int64{},
+				UpsertSignalInfos:         []*persistence.SignalInfo{},
+				DeleteSignalInfos:         []int64{},
+				UpsertSignalRequestedIDs:  []string{},
+				DeleteSignalRequestedIDs:  []string{},
+				DeleteChildExecutionInfos: []int64{},
+				WorkflowRequests:          []*persistence.WorkflowRequest{},
+				Condition:                 0,
+			},
+			expectedEvent: nil,
+			expectedErr:   nil,
+		},
 	}
 
 	for name, td := range tests {
@@ -3614,6 +3684,7 @@ func TestCloseTransactionAsMutation(t *testing.T) {
 
 			shardContext := shard.NewMockContext(ctrl)
 			shardContext.EXPECT().GetLogger().Return(testlogger.New(t)).AnyTimes()
+			shardContext.EXPECT().GetShardID().Return(0).AnyTimes()
 
 			activeClusterManager := activecluster.NewMockManager(ctrl)
 			shardContext.EXPECT().GetActiveClusterManager().Return(activeClusterManager).AnyTimes()
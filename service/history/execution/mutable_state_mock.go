@@ -2081,6 +2081,20 @@ func (mr *MockMutableStateMockRecorder) ReplicateExternalWorkflowExecutionSignal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateExternalWorkflowExecutionSignaled", reflect.TypeOf((*MockMutableState)(nil).ReplicateExternalWorkflowExecutionSignaled), arg0)
 }
 
+// ReplicateMarkerRecordedEvent mocks base method.
+func (m *MockMutableState) ReplicateMarkerRecordedEvent(arg0 *types.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateMarkerRecordedEvent", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateMarkerRecordedEvent indicates an expected call of ReplicateMarkerRecordedEvent.
+func (mr *MockMutableStateMockRecorder) ReplicateMarkerRecordedEvent(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateMarkerRecordedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateMarkerRecordedEvent), arg0)
+}
+
 // ReplicateRequestCancelExternalWorkflowExecutionFailedEvent mocks base method.
 func (m *MockMutableState) ReplicateRequestCancelExternalWorkflowExecutionFailedEvent(arg0 *types.HistoryEvent) error {
 	m.ctrl.T.Helper()
@@ -30,6 +30,9 @@ import (
 
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/completioncallback"
+	"github.com/uber/cadence/common/crossdomain"
+	"github.com/uber/cadence/common/delayedsignal"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/persistence"
@@ -77,6 +80,9 @@ type (
 		GenerateSignalExternalTasks(
 			event *types.HistoryEvent,
 		) error
+		GenerateDelayedSignalTasks(
+			delayedSignalID int64,
+		) error
 		GenerateWorkflowSearchAttrTasks() error
 		GenerateWorkflowResetTasks() error
 		// these 2 APIs should only be called when mutable state transaction is being closed
@@ -156,17 +162,35 @@ func (r *mutableStateTaskGeneratorImpl) GenerateWorkflowCloseTasks(
 ) error {
 
 	executionInfo := r.mutableState.GetExecutionInfo()
-	r.mutableState.AddTransferTasks(&persistence.CloseExecutionTask{
-		WorkflowIdentifier: persistence.WorkflowIdentifier{
-			DomainID:   executionInfo.DomainID,
-			WorkflowID: executionInfo.WorkflowID,
-			RunID:      executionInfo.RunID,
-		},
-		TaskData: persistence.TaskData{
-			// TaskID and VisibilityTimestamp are set by shard context
-			Version: closeEvent.Version,
+	transferTasks := []persistence.Task{
+		&persistence.CloseExecutionTask{
+			WorkflowIdentifier: persistence.WorkflowIdentifier{
+				DomainID:   executionInfo.DomainID,
+				WorkflowID: executionInfo.WorkflowID,
+				RunID:      executionInfo.RunID,
+			},
+			TaskData: persistence.TaskData{
+				// TaskID and VisibilityTimestamp are set by shard context
+				Version: closeEvent.Version,
+			},
 		},
-	})
+	}
+	// callbacks are carried over to the new run of a continued as new workflow
+	if completioncallback.HasCallbacks(executionInfo.Memo) &&
+		closeEvent.GetEventType() != types.EventTypeWorkflowExecutionContinuedAsNew {
+		transferTasks = append(transferTasks, &persistence.CompletionCallbackTask{
+			WorkflowIdentifier: persistence.WorkflowIdentifier{
+				DomainID:   executionInfo.DomainID,
+				WorkflowID: executionInfo.WorkflowID,
+				RunID:      executionInfo.RunID,
+			},
+			TaskData: persistence.TaskData{
+				// TaskID and VisibilityTimestamp are set by shard context
+				Version: closeEvent.Version,
+			},
+		})
+	}
+	r.mutableState.AddTransferTasks(transferTasks...)
 
 	retentionInDays := defaultWorkflowRetentionInDays
 	domainEntry, err := r.domainCache.GetDomainByID(executionInfo.DomainID)
@@ -590,6 +614,34 @@ func (r *mutableStateTaskGeneratorImpl) GenerateSignalExternalTasks(
 	return nil
 }
 
+func (r *mutableStateTaskGeneratorImpl) GenerateDelayedSignalTasks(
+	delayedSignalID int64,
+) error {
+
+	signal, ok := delayedsignal.GetPending(r.mutableState.GetExecutionInfo().Memo)[delayedSignalID]
+	if !ok {
+		return &types.InternalServiceError{
+			Message: fmt.Sprintf("it could be a bug, cannot get pending delayed signal: %v", delayedSignalID),
+		}
+	}
+
+	executionInfo := r.mutableState.GetExecutionInfo()
+	r.mutableState.AddTimerTasks(&persistence.DelayedSignalTimerTask{
+		WorkflowIdentifier: persistence.WorkflowIdentifier{
+			DomainID:   executionInfo.DomainID,
+			WorkflowID: executionInfo.WorkflowID,
+			RunID:      executionInfo.RunID,
+		},
+		TaskData: persistence.TaskData{
+			// TaskID is set by shard
+			Version:             signal.Version,
+			VisibilityTimestamp: time.Unix(0, signal.DeliverAfterNano),
+		},
+		DelayedSignalID: delayedSignalID,
+	})
+	return nil
+}
+
 func (r *mutableStateTaskGeneratorImpl) GenerateWorkflowSearchAttrTasks() error {
 
 	currentVersion := r.mutableState.GetCurrentVersion()
@@ -670,6 +722,12 @@ func (r *mutableStateTaskGeneratorImpl) validateChildWorkflowParameters(msbDomai
 	// There is a limited exception for local domains, which do not suffer from this problem can be
 	// handled as an exception where the transfer task may be picked up by another domain in-cluster
 	// without risk of the child workflow may end up in a different cluster.
+	//
+	// The other exception is a target domain allowlisting this domain, in which case the decision
+	// was validated to start the child in a domain active in the current cluster.
+	if crossdomain.IsCallerAllowed(targetDomain, thisDomain.GetInfo().Name) {
+		return nil
+	}
 	if thisDomain.IsGlobalDomain() || targetDomain.IsGlobalDomain() {
 		return &types.BadRequestError{
 			Message: fmt.Sprintf("The child workflow is "+
@@ -140,6 +140,20 @@ func (mr *MockMutableStateTaskGeneratorMockRecorder) GenerateDelayedDecisionTask
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateDelayedDecisionTasks", reflect.TypeOf((*MockMutableStateTaskGenerator)(nil).GenerateDelayedDecisionTasks), startEvent)
 }
 
+// GenerateDelayedSignalTasks mocks base method.
+func (m *MockMutableStateTaskGenerator) GenerateDelayedSignalTasks(delayedSignalID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateDelayedSignalTasks", delayedSignalID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GenerateDelayedSignalTasks indicates an expected call of GenerateDelayedSignalTasks.
+func (mr *MockMutableStateTaskGeneratorMockRecorder) GenerateDelayedSignalTasks(delayedSignalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateDelayedSignalTasks", reflect.TypeOf((*MockMutableStateTaskGenerator)(nil).GenerateDelayedSignalTasks), delayedSignalID)
+}
+
 // GenerateRecordWorkflowStartedTasks mocks base method.
 func (m *MockMutableStateTaskGenerator) GenerateRecordWorkflowStartedTasks(startEvent *types.HistoryEvent) error {
 	m.ctrl.T.Helper()
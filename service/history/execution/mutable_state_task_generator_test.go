@@ -35,6 +35,9 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/completioncallback"
+	commonconstants "github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/delayedsignal"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
@@ -249,6 +252,72 @@ func (s *mutableStateTaskGeneratorSuite) TestGenerateWorkflowCloseTasks_NotActiv
 	s.NoError(err)
 }
 
+func (s *mutableStateTaskGeneratorSuite) TestGenerateWorkflowCloseTasks_CompletionCallbacks() {
+	callbacks, err := completioncallback.Encode([]completioncallback.Callback{
+		{HTTP: &completioncallback.HTTPCallback{URL: "https://hooks.example.com/done"}},
+	})
+	s.NoError(err)
+	workflowIdentifier := persistence.WorkflowIdentifier{
+		DomainID:   constants.TestDomainID,
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+
+	for name, tc := range map[string]struct {
+		eventType       types.EventType
+		expectCallbacks bool
+	}{
+		"completed":        {eventType: types.EventTypeWorkflowExecutionCompleted, expectCallbacks: true},
+		"continued as new": {eventType: types.EventTypeWorkflowExecutionContinuedAsNew, expectCallbacks: false},
+		"timed out":        {eventType: types.EventTypeWorkflowExecutionTimedOut, expectCallbacks: true},
+		"terminated":       {eventType: types.EventTypeWorkflowExecutionTerminated, expectCallbacks: true},
+		"failed":           {eventType: types.EventTypeWorkflowExecutionFailed, expectCallbacks: true},
+	} {
+		s.Run(name, func() {
+			mockMutableState := NewMockMutableState(s.controller)
+			taskGenerator := NewMutableStateTaskGenerator(
+				log.NewNoop(),
+				constants.TestClusterMetadata,
+				s.mockDomainCache,
+				mockMutableState,
+			)
+			closeEvent := &types.HistoryEvent{
+				EventType: tc.eventType.Ptr(),
+				Timestamp: common.Int64Ptr(time.Now().UnixNano()),
+				Version:   constants.TestVersion,
+			}
+
+			mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{
+				DomainID:   constants.TestDomainID,
+				WorkflowID: constants.TestWorkflowID,
+				RunID:      constants.TestRunID,
+				Memo:       map[string][]byte{completioncallback.MemoKey: callbacks},
+			}).AnyTimes()
+			var transferTasks []persistence.Task
+			mockMutableState.EXPECT().AddTransferTasks(gomock.Any()).Do(func(tasks ...persistence.Task) {
+				transferTasks = tasks
+			}).Times(1)
+			mockMutableState.EXPECT().AddTimerTasks(gomock.Any()).Times(1)
+
+			s.NoError(taskGenerator.GenerateWorkflowCloseTasks(closeEvent, 1))
+
+			expected := []persistence.Task{
+				&persistence.CloseExecutionTask{
+					WorkflowIdentifier: workflowIdentifier,
+					TaskData:           persistence.TaskData{Version: constants.TestVersion},
+				},
+			}
+			if tc.expectCallbacks {
+				expected = append(expected, &persistence.CompletionCallbackTask{
+					WorkflowIdentifier: workflowIdentifier,
+					TaskData:           persistence.TaskData{Version: constants.TestVersion},
+				})
+			}
+			s.Equal(expected, transferTasks)
+		})
+	}
+}
+
 func (s *mutableStateTaskGeneratorSuite) TestGenerateFromTransferTask() {
 	now := time.Now()
 	testCases := []struct {
@@ -936,6 +1005,70 @@ func (s *mutableStateTaskGeneratorSuite) TestGenerateActivityRetryTasks() {
 	}
 }
 
+func (s *mutableStateTaskGeneratorSuite) TestGenerateDelayedSignalTasks() {
+	delayedSignalID := int64(3)
+	deliverAfter := time.Unix(0, time.Now().Add(time.Hour).UnixNano())
+	memo, _ := delayedsignal.SetPending(nil, delayedSignalID, &delayedsignal.Pending{
+		SignalName:       "reminder",
+		DeliverAfterNano: deliverAfter.UnixNano(),
+		Version:          constants.TestVersion,
+	})
+
+	testCases := []struct {
+		name      string
+		setupMock func()
+		err       error
+	}{
+		{
+			name: "Success case",
+			setupMock: func() {
+				s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{
+					DomainID:   "domain-id",
+					WorkflowID: "wf-id",
+					RunID:      "rid",
+					Memo:       memo,
+				}).Times(2)
+				s.mockMutableState.EXPECT().AddTimerTasks(&persistence.DelayedSignalTimerTask{
+					WorkflowIdentifier: persistence.WorkflowIdentifier{
+						DomainID:   "domain-id",
+						WorkflowID: "wf-id",
+						RunID:      "rid",
+					},
+					TaskData: persistence.TaskData{
+						Version:             constants.TestVersion,
+						VisibilityTimestamp: deliverAfter,
+					},
+					DelayedSignalID: delayedSignalID,
+				}).Times(1)
+			},
+		},
+		{
+			name: "Error case - delayed signal not pending",
+			setupMock: func() {
+				s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{}).Times(1)
+			},
+			err: &types.InternalServiceError{
+				Message: fmt.Sprintf("it could be a bug, cannot get pending delayed signal: %v", delayedSignalID),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+
+			err := s.taskGenerator.GenerateDelayedSignalTasks(delayedSignalID)
+
+			if tc.err != nil {
+				s.Error(err)
+				s.Equal(tc.err, err)
+			} else {
+				s.NoError(err)
+			}
+		})
+	}
+}
+
 func (s *mutableStateTaskGeneratorSuite) TestGenerateChildWorkflowTasks() {
 	eventID := int64(123)
 
@@ -1337,6 +1470,11 @@ func TestValidationOfChildWorkflowParameters(t *testing.T) {
 	g2 := cache.NewGlobalDomainCacheEntryForTest(&persistence.DomainInfo{ID: "g2", Name: "g2"}, nil, nil, 0)
 	l1 := cache.NewLocalDomainCacheEntryForTest(&persistence.DomainInfo{ID: "l1", Name: "l1"}, nil, "")
 	l2 := cache.NewLocalDomainCacheEntryForTest(&persistence.DomainInfo{ID: "l2", Name: "l2"}, nil, "")
+	g3 := cache.NewGlobalDomainCacheEntryForTest(&persistence.DomainInfo{
+		ID:   "g3",
+		Name: "g3",
+		Data: map[string]string{commonconstants.DomainDataKeyForCrossDomainCallers: "g1"},
+	}, nil, nil, 0)
 
 	tests := map[string]struct {
 		thisDomain    *cache.DomainCacheEntry
@@ -1382,6 +1520,22 @@ func TestValidationOfChildWorkflowParameters(t *testing.T) {
 			},
 			expectedError: &types.BadRequestError{Message: "The child workflow is trying to use domain l2 but it's running in domain g1. Cross-cluster and cross domain child workflows are not supported for global domains"},
 		},
+		"Global domains cross domain call, allowlisted by the target domain": {
+			thisDomain:    g1,
+			childWorkflow: g3,
+			setupCache: func(cache *cache.MockDomainCache) {
+				cache.EXPECT().GetDomainByID(g3.GetInfo().ID).Return(g3, nil).Times(1)
+			},
+			expectedError: nil,
+		},
+		"Global domains cross domain call, not allowlisted by the target domain": {
+			thisDomain:    g2,
+			childWorkflow: g3,
+			setupCache: func(cache *cache.MockDomainCache) {
+				cache.EXPECT().GetDomainByID(g3.GetInfo().ID).Return(g3, nil).Times(1)
+			},
+			expectedError: &types.BadRequestError{Message: "The child workflow is trying to use domain g3 but it's running in domain g2. Cross-cluster and cross domain child workflows are not supported for global domains"},
+		},
 	}
 
 	for name, td := range tests {
@@ -30,6 +30,7 @@ import (
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/delayedsignal"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
@@ -63,6 +64,7 @@ type (
 		refreshTasksForChildWorkflowFn                 func(context.Context, MutableState, MutableStateTaskGenerator, int, events.Cache) error
 		refreshTasksForRequestCancelExternalWorkflowFn func(context.Context, MutableState, MutableStateTaskGenerator, int, events.Cache) error
 		refreshTasksForSignalExternalWorkflowFn        func(context.Context, MutableState, MutableStateTaskGenerator, int, events.Cache) error
+		refreshTasksForDelayedSignalFn                 func(context.Context, MutableState, MutableStateTaskGenerator) error
 		refreshTasksForWorkflowSearchAttrFn            func(context.Context, MutableState, MutableStateTaskGenerator) error
 	}
 )
@@ -94,6 +96,7 @@ func NewMutableStateTaskRefresher(
 		refreshTasksForChildWorkflowFn:                 refreshTasksForChildWorkflow,
 		refreshTasksForRequestCancelExternalWorkflowFn: refreshTasksForRequestCancelExternalWorkflow,
 		refreshTasksForSignalExternalWorkflowFn:        refreshTasksForSignalExternalWorkflow,
+		refreshTasksForDelayedSignalFn:                 refreshTasksForDelayedSignal,
 		refreshTasksForWorkflowSearchAttrFn:            refreshTasksForWorkflowSearchAttr,
 	}
 }
@@ -194,6 +197,14 @@ func (r *mutableStateTaskRefresherImpl) RefreshTasks(
 		return err
 	}
 
+	if err := r.refreshTasksForDelayedSignalFn(
+		ctx,
+		mutableState,
+		taskGenerator,
+	); err != nil {
+		return err
+	}
+
 	if common.IsAdvancedVisibilityWritingEnabled(r.config.WriteVisibilityStoreName(), r.config.IsAdvancedVisConfigExist) {
 		if err := r.refreshTasksForWorkflowSearchAttrFn(
 			ctx,
@@ -496,6 +507,22 @@ func refreshTasksForSignalExternalWorkflow(
 	return nil
 }
 
+func refreshTasksForDelayedSignal(
+	ctx context.Context,
+	mutableState MutableState,
+	taskGenerator MutableStateTaskGenerator,
+) error {
+	if !mutableState.IsWorkflowExecutionRunning() {
+		return nil
+	}
+	for id := range delayedsignal.GetPending(mutableState.GetExecutionInfo().Memo) {
+		if err := taskGenerator.GenerateDelayedSignalTasks(id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func refreshTasksForWorkflowSearchAttr(
 	ctx context.Context,
 	mutableState MutableState,
@@ -34,6 +34,7 @@ import (
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/delayedsignal"
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/persistence"
@@ -583,6 +584,54 @@ func TestRefreshTasksForSignalExternalWorkflow(t *testing.T) {
 	}
 }
 
+func TestRefreshTasksForDelayedSignal(t *testing.T) {
+	memo, _ := delayedsignal.SetPending(nil, 3, &delayedsignal.Pending{SignalName: "reminder", DeliverAfterNano: 100})
+	testCases := []struct {
+		name      string
+		mockSetup func(*MockMutableState, *MockMutableStateTaskGenerator)
+		wantErr   bool
+	}{
+		{
+			name: "workflow closed",
+			mockSetup: func(ms *MockMutableState, mtg *MockMutableStateTaskGenerator) {
+				ms.EXPECT().IsWorkflowExecutionRunning().Return(false)
+			},
+			wantErr: false,
+		},
+		{
+			name: "failed to generate delayed signal tasks",
+			mockSetup: func(ms *MockMutableState, mtg *MockMutableStateTaskGenerator) {
+				ms.EXPECT().IsWorkflowExecutionRunning().Return(true)
+				ms.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{Memo: memo})
+				mtg.EXPECT().GenerateDelayedSignalTasks(int64(3)).Return(errors.New("some error"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "success",
+			mockSetup: func(ms *MockMutableState, mtg *MockMutableStateTaskGenerator) {
+				ms.EXPECT().IsWorkflowExecutionRunning().Return(true)
+				ms.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{Memo: memo})
+				mtg.EXPECT().GenerateDelayedSignalTasks(int64(3)).Return(nil)
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ms := NewMockMutableState(ctrl)
+			mtg := NewMockMutableStateTaskGenerator(ctrl)
+			tc.mockSetup(ms, mtg)
+			err := refreshTasksForDelayedSignal(context.Background(), ms, mtg)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("refreshTasksForDelayedSignal err = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
 func TestRefreshTasksForWorkflowSearchAttr(t *testing.T) {
 	testCases := []struct {
 		name      string
@@ -630,6 +679,7 @@ func TestRefreshTasks(t *testing.T) {
 		refreshTasksForChildWorkflowFn                 func(context.Context, MutableState, MutableStateTaskGenerator, int, events.Cache) error
 		refreshTasksForRequestCancelExternalWorkflowFn func(context.Context, MutableState, MutableStateTaskGenerator, int, events.Cache) error
 		refreshTasksForSignalExternalWorkflowFn        func(context.Context, MutableState, MutableStateTaskGenerator, int, events.Cache) error
+		refreshTasksForDelayedSignalFn                 func(context.Context, MutableState, MutableStateTaskGenerator) error
 		refreshTasksForWorkflowSearchAttrFn            func(context.Context, MutableState, MutableStateTaskGenerator) error
 		wantErr                                        bool
 	}{
@@ -648,6 +698,7 @@ func TestRefreshTasks(t *testing.T) {
 			refreshTasksForChildWorkflowFn:                 func(context.Context, MutableState, MutableStateTaskGenerator, int, events.Cache) error { return nil },
 			refreshTasksForRequestCancelExternalWorkflowFn: func(context.Context, MutableState, MutableStateTaskGenerator, int, events.Cache) error { return nil },
 			refreshTasksForSignalExternalWorkflowFn:        func(context.Context, MutableState, MutableStateTaskGenerator, int, events.Cache) error { return nil },
+			refreshTasksForDelayedSignalFn:                 func(context.Context, MutableState, MutableStateTaskGenerator) error { return nil },
 			refreshTasksForWorkflowSearchAttrFn:            func(context.Context, MutableState, MutableStateTaskGenerator) error { return nil },
 			wantErr:                                        false,
 		},
@@ -677,6 +728,7 @@ func TestRefreshTasks(t *testing.T) {
 				refreshTasksForChildWorkflowFn:                 tc.refreshTasksForChildWorkflowFn,
 				refreshTasksForRequestCancelExternalWorkflowFn: tc.refreshTasksForRequestCancelExternalWorkflowFn,
 				refreshTasksForSignalExternalWorkflowFn:        tc.refreshTasksForSignalExternalWorkflowFn,
+				refreshTasksForDelayedSignalFn:                 tc.refreshTasksForDelayedSignalFn,
 				refreshTasksForWorkflowSearchAttrFn:            tc.refreshTasksForWorkflowSearchAttrFn,
 			}
 			err := refresher.RefreshTasks(context.Background(), time.Now(), ms)
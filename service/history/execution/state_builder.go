@@ -422,7 +422,11 @@ func (b *stateBuilderImpl) ApplyEvents(
 			}
 
 		case types.EventTypeMarkerRecorded:
-			// No mutable state action is needed
+			if err := b.mutableState.ReplicateMarkerRecordedEvent(
+				event,
+			); err != nil {
+				return nil, err
+			}
 
 		case types.EventTypeWorkflowExecutionSignaled:
 			if err := b.mutableState.ReplicateWorkflowExecutionSignaled(
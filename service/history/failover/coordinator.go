@@ -101,6 +101,15 @@ type (
 		failoverVersion int64
 		shards          map[int32]struct{}
 		lastUpdatedTime time.Time
+		timeline        failoverTimeline
+	}
+
+	// failoverTimeline records when a graceful failover reached each phase
+	failoverTimeline struct {
+		fenced      time.Time // the old active cluster stopped accepting writes
+		replicating time.Time // the first failover marker was received
+		replicated  time.Time // failover markers from all shards were received
+		activated   time.Time // the domain was updated from pending-active to active
 	}
 )
 
@@ -270,6 +279,12 @@ func (c *coordinatorImpl) handleFailoverMarkers(
 		// if the local failover version is smaller than the new received marker,
 		// it means there is another failover happened and the local one should be invalid.
 		if record.failoverVersion < marker.GetFailoverVersion() {
+			c.logger.Warn("Graceful failover superseded by another failover",
+				append(record.timeline.tags(),
+					tag.WorkflowDomainID(domainID),
+					tag.FailoverVersion(record.failoverVersion),
+				)...,
+			)
 			delete(c.recorder, domainID)
 		}
 
@@ -285,11 +300,19 @@ func (c *coordinatorImpl) handleFailoverMarkers(
 		c.recorder[marker.GetDomainID()] = &failoverRecord{
 			failoverVersion: marker.GetFailoverVersion(),
 			shards:          make(map[int32]struct{}),
+			timeline: failoverTimeline{
+				replicating: c.timeSource.Now(),
+			},
 		}
 	}
 
 	record := c.recorder[domainID]
 	record.lastUpdatedTime = c.timeSource.Now()
+	// each shard fences the domain on its own, the earliest marker marks the start of the failover
+	fencedTime := time.Unix(0, marker.GetCreationTime())
+	if record.timeline.fenced.IsZero() || fencedTime.Before(record.timeline.fenced) {
+		record.timeline.fenced = fencedTime
+	}
 	for _, shardID := range request.shardIDs {
 		record.shards[shardID] = struct{}{}
 	}
@@ -306,6 +329,9 @@ func (c *coordinatorImpl) handleFailoverMarkers(
 	}
 
 	if len(record.shards) == c.config.NumberOfShards {
+		if record.timeline.replicated.IsZero() {
+			record.timeline.replicated = c.timeSource.Now()
+		}
 		if err := domain.CleanPendingActiveState(
 			c.domainManager,
 			domainID,
@@ -320,16 +346,21 @@ func (c *coordinatorImpl) handleFailoverMarkers(
 			return
 		}
 		delete(c.recorder, domainID)
-		now := c.timeSource.Now()
-		c.scope.Tagged(
-			metrics.DomainTag(domainName),
-		).RecordTimer(
+		record.timeline.activated = c.timeSource.Now()
+		domainScope := c.scope.Tagged(metrics.DomainTag(domainName))
+		domainScope.RecordTimer(
+			metrics.GracefulFailoverReplicationLatency,
+			record.timeline.replicated.Sub(record.timeline.fenced),
+		)
+		domainScope.RecordTimer(
 			metrics.GracefulFailoverLatency,
-			now.Sub(time.Unix(0, marker.GetCreationTime())),
+			record.timeline.activated.Sub(record.timeline.fenced),
 		)
 		c.logger.Info("Updated domain from pending-active to active",
-			tag.WorkflowDomainName(domainName),
-			tag.FailoverVersion(marker.FailoverVersion),
+			append(record.timeline.tags(),
+				tag.WorkflowDomainName(domainName),
+				tag.FailoverVersion(marker.FailoverVersion),
+			)...,
 		)
 	} else {
 		c.scope.Tagged(
@@ -347,6 +378,12 @@ func (c *coordinatorImpl) cleanupInvalidMarkers() {
 
 	for domainID, record := range c.recorder {
 		if c.timeSource.Now().Sub(record.lastUpdatedTime) > invalidMarkerDuration {
+			c.logger.Warn("Graceful failover record expired before all failover markers were received",
+				append(record.timeline.tags(),
+					tag.WorkflowDomainID(domainID),
+					tag.FailoverVersion(record.failoverVersion),
+				)...,
+			)
 			delete(c.recorder, domainID)
 		}
 	}
@@ -400,3 +437,12 @@ func aggregateNotificationRequests(
 		req.shardIDs = append(req.shardIDs, request.shardID)
 	}
 }
+
+func (t failoverTimeline) tags() []tag.Tag {
+	return []tag.Tag{
+		tag.Dynamic("failover-fenced", t.fenced),
+		tag.Dynamic("failover-replicating", t.replicating),
+		tag.Dynamic("failover-replicated", t.replicated),
+		tag.Dynamic("failover-activated", t.activated),
+	}
+}
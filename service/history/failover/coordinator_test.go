@@ -419,6 +419,40 @@ func (s *coordinatorSuite) TestHandleFailoverMarkers_CleanPendingActiveState_Err
 	s.coordinator.handleFailoverMarkers(request1)
 	s.coordinator.handleFailoverMarkers(request2)
 	s.Equal(1, len(s.coordinator.recorder))
+	s.False(s.coordinator.recorder[domainID].timeline.replicated.IsZero())
+	s.True(s.coordinator.recorder[domainID].timeline.activated.IsZero())
+}
+
+func (s *coordinatorSuite) TestHandleFailoverMarkers_Timeline() {
+	domainID := uuid.New()
+	request1 := &receiveRequest{
+		shardIDs: []int32{1},
+		marker: &types.FailoverMarkerAttributes{
+			DomainID:        domainID,
+			FailoverVersion: 2,
+			CreationTime:    common.Int64Ptr(20),
+		},
+	}
+	request2 := &receiveRequest{
+		shardIDs: []int32{1},
+		marker: &types.FailoverMarkerAttributes{
+			DomainID:        domainID,
+			FailoverVersion: 2,
+			CreationTime:    common.Int64Ptr(10),
+		},
+	}
+
+	s.coordinator.handleFailoverMarkers(request1)
+	record := s.coordinator.recorder[domainID]
+	s.Equal(time.Unix(0, 20), record.timeline.fenced)
+	replicating := record.timeline.replicating
+	s.False(replicating.IsZero())
+
+	s.coordinator.handleFailoverMarkers(request2)
+	s.Equal(time.Unix(0, 10), record.timeline.fenced)
+	s.Equal(replicating, record.timeline.replicating)
+	s.True(record.timeline.replicated.IsZero())
+	s.True(record.timeline.activated.IsZero())
 }
 
 func (s *coordinatorSuite) TestGetFailoverInfo_Success() {
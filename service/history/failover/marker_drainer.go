@@ -0,0 +1,336 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination marker_drainer_mock.go -self_package github.com/uber/cadence/service/history/failover
+
+package failover
+
+import (
+	ctx "context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/service/history/config"
+	"github.com/uber/cadence/service/history/queue"
+	"github.com/uber/cadence/service/history/shard"
+)
+
+const (
+	drainCheckInterval = 1 * time.Second
+	drainCheckTimeout  = 5 * time.Second
+)
+
+type (
+	// MarkerDrainer holds the failover markers of domains failed over from the current cluster
+	// until the transfer and timer tasks of the domains created before the failover are processed
+	MarkerDrainer interface {
+		common.Daemon
+
+		// Drain holds the markers, which were generated from the given domain notification version of the shard
+		Drain(markers []*persistence.FailoverMarkerTask, notificationVersion int64)
+		// UpdateDomainNotificationVersion updates the domain notification version of the shard. The version is held at
+		// the one the oldest markers not inserted yet were generated from, so they are generated again if the shard is
+		// reloaded before they are inserted.
+		UpdateDomainNotificationVersion(notificationVersion int64) error
+	}
+
+	markerDrainerImpl struct {
+		status          int32
+		shutdownCh      chan struct{}
+		shard           shard.Context
+		config          *config.Config
+		queueProcessors map[persistence.HistoryTaskCategory]queue.Processor
+		clusterName     string
+		logger          log.Logger
+		metrics         metrics.Client
+
+		sync.Mutex
+		pending             []*drainRequest
+		notificationVersion int64
+	}
+
+	drainRequest struct {
+		markers             []*persistence.FailoverMarkerTask
+		notificationVersion int64
+		fencedTime          time.Time
+		// transferLevel and timerLevel are the exclusive keys of the tasks created before the domains were fenced
+		transferLevel persistence.HistoryTaskKey
+		timerLevel    persistence.HistoryTaskKey
+	}
+)
+
+// NewMarkerDrainer creates a new instance of failover marker drainer
+func NewMarkerDrainer(
+	shard shard.Context,
+	config *config.Config,
+	queueProcessors map[persistence.HistoryTaskCategory]queue.Processor,
+) MarkerDrainer {
+
+	return &markerDrainerImpl{
+		status:          common.DaemonStatusInitialized,
+		shutdownCh:      make(chan struct{}, 1),
+		shard:           shard,
+		config:          config,
+		queueProcessors: queueProcessors,
+		clusterName:     shard.GetClusterMetadata().GetCurrentClusterName(),
+		logger:          shard.GetLogger().WithTags(tag.ComponentFailoverMarkerDrainer),
+		metrics:         shard.GetMetricsClient(),
+	}
+}
+
+func (m *markerDrainerImpl) Start() {
+
+	if !atomic.CompareAndSwapInt32(
+		&m.status,
+		common.DaemonStatusInitialized,
+		common.DaemonStatusStarted,
+	) {
+		return
+	}
+
+	go m.drainLoop()
+	m.logger.Info("Marker drainer state changed", tag.LifeCycleStarted)
+}
+
+func (m *markerDrainerImpl) Stop() {
+
+	if !atomic.CompareAndSwapInt32(
+		&m.status,
+		common.DaemonStatusStarted,
+		common.DaemonStatusStopped,
+	) {
+		return
+	}
+	close(m.shutdownCh)
+	// the shard is moving away, insert the pending markers without waiting,
+	// the markers which fail to be inserted are generated again when the shard is reloaded
+	m.insertPendingMarkers(true)
+	m.logger.Info("Marker drainer state changed", tag.LifeCycleStopped)
+}
+
+// Drain fences the transfer and timer tasks created so far and defers the markers
+// until the active queue processors have processed the tasks of their domains or the drain timeout is reached
+func (m *markerDrainerImpl) Drain(
+	markers []*persistence.FailoverMarkerTask,
+	notificationVersion int64,
+) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	// the markers of a domain are generated again if the domain is updated while they are pending
+	markers = m.excludePendingMarkersLocked(markers)
+	if len(markers) == 0 {
+		return
+	}
+
+	now := m.shard.GetTimeSource().Now()
+	transferMaxReadLevel := m.shard.UpdateIfNeededAndGetQueueMaxReadLevel(persistence.HistoryTaskCategoryTransfer, m.clusterName)
+	request := &drainRequest{
+		markers:             markers,
+		notificationVersion: notificationVersion,
+		fencedTime:          now,
+		transferLevel:       persistence.NewImmediateTaskKey(transferMaxReadLevel.GetTaskID() + 1),
+		timerLevel:          persistence.NewHistoryTaskKey(now, 0),
+	}
+	// the marker creation time is the time the domain was fenced,
+	// so the receiving side measures the whole graceful failover
+	for _, marker := range markers {
+		marker.SetVisibilityTimestamp(now)
+	}
+	m.pending = append(m.pending, request)
+}
+
+func (m *markerDrainerImpl) UpdateDomainNotificationVersion(
+	notificationVersion int64,
+) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	if notificationVersion > m.notificationVersion {
+		m.notificationVersion = notificationVersion
+	}
+	return m.updateDomainNotificationVersionLocked()
+}
+
+func (m *markerDrainerImpl) updateDomainNotificationVersionLocked() error {
+
+	if m.notificationVersion == 0 {
+		// no domain update was handled yet
+		return nil
+	}
+	notificationVersion := m.notificationVersion
+	for _, request := range m.pending {
+		if request.notificationVersion < notificationVersion {
+			notificationVersion = request.notificationVersion
+		}
+	}
+	if notificationVersion == m.shard.GetDomainNotificationVersion() {
+		return nil
+	}
+	return m.shard.UpdateDomainNotificationVersion(notificationVersion)
+}
+
+func (m *markerDrainerImpl) excludePendingMarkersLocked(
+	markers []*persistence.FailoverMarkerTask,
+) []*persistence.FailoverMarkerTask {
+
+	var result []*persistence.FailoverMarkerTask
+	for _, marker := range markers {
+		if !m.isPendingLocked(marker) {
+			result = append(result, marker)
+		}
+	}
+	return result
+}
+
+func (m *markerDrainerImpl) isPendingLocked(
+	marker *persistence.FailoverMarkerTask,
+) bool {
+
+	for _, request := range m.pending {
+		for _, pendingMarker := range request.markers {
+			if pendingMarker.DomainID == marker.DomainID && pendingMarker.GetVersion() == marker.GetVersion() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (m *markerDrainerImpl) drainLoop() {
+
+	ticker := time.NewTicker(drainCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-m.shutdownCh:
+			return
+		case <-ticker.C:
+			m.insertPendingMarkers(false)
+		}
+	}
+}
+
+func (m *markerDrainerImpl) insertPendingMarkers(
+	force bool,
+) {
+
+	// the requests stay pending while they are inserted, so the domain notification version is not moved past them
+	m.Lock()
+	pending := append([]*drainRequest(nil), m.pending...)
+	m.Unlock()
+
+	inserted := make(map[*drainRequest]struct{})
+	for _, request := range pending {
+		drained := m.isDrained(request)
+		timedOut := m.shard.GetTimeSource().Now().Sub(request.fencedTime) > m.config.GracefulFailoverDrainTimeout()
+		if !drained && !timedOut && !force {
+			continue
+		}
+
+		if err := m.shard.ReplicateFailoverMarkers(ctx.Background(), request.markers); err != nil {
+			m.logger.Error("Failed to insert failover marker to replication queue.", tag.Error(err))
+			m.metrics.IncCounter(metrics.FailoverMarkerScope, metrics.FailoverMarkerInsertFailure)
+			// retry on the next check
+			continue
+		}
+		inserted[request] = struct{}{}
+
+		latency := m.shard.GetTimeSource().Now().Sub(request.fencedTime)
+		m.metrics.RecordTimer(metrics.FailoverMarkerScope, metrics.FailoverMarkerDrainLatency, latency)
+		if !drained {
+			m.metrics.IncCounter(metrics.FailoverMarkerScope, metrics.FailoverMarkerDrainTimeout)
+		}
+		for _, marker := range request.markers {
+			logger := m.logger.WithTags(
+				tag.WorkflowDomainID(marker.DomainID),
+				tag.FailoverVersion(marker.GetVersion()),
+				tag.Dynamic("drain-latency", latency),
+			)
+			if drained {
+				logger.Info("In-flight tasks drained, failover marker inserted.")
+			} else {
+				logger.Warn("Failover marker inserted before in-flight tasks were drained.")
+			}
+		}
+	}
+	if len(inserted) == 0 {
+		return
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	remaining := m.pending[:0]
+	for _, request := range m.pending {
+		if _, ok := inserted[request]; !ok {
+			remaining = append(remaining, request)
+		}
+	}
+	m.pending = remaining
+	if err := m.updateDomainNotificationVersionLocked(); err != nil {
+		m.logger.Error("Failed to update domain notification version after inserting failover markers.", tag.Error(err))
+	}
+}
+
+// isDrained checks that the active queue processors have processed the tasks of the domains of the markers
+// created before the domains were fenced, the tasks of other domains are not waited for
+func (m *markerDrainerImpl) isDrained(
+	request *drainRequest,
+) bool {
+
+	for _, marker := range request.markers {
+		if !m.isDomainDrained(persistence.HistoryTaskCategoryTransfer, marker.DomainID, request.transferLevel) ||
+			!m.isDomainDrained(persistence.HistoryTaskCategoryTimer, marker.DomainID, request.timerLevel) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *markerDrainerImpl) isDomainDrained(
+	category persistence.HistoryTaskCategory,
+	domainID string,
+	exclusiveMaxTaskKey persistence.HistoryTaskKey,
+) bool {
+
+	processor, ok := m.queueProcessors[category]
+	if !ok {
+		return true
+	}
+	checkCtx, cancel := ctx.WithTimeout(ctx.Background(), drainCheckTimeout)
+	defer cancel()
+	result, err := processor.HandleAction(checkCtx, m.clusterName, queue.NewIsDomainDrainedAction(domainID, exclusiveMaxTaskKey))
+	if err != nil {
+		m.logger.Warn("Failed to check in-flight tasks of domain.", tag.WorkflowDomainID(domainID), tag.Error(err))
+		return false
+	}
+	return result != nil && result.IsDomainDrainedResult != nil && result.IsDomainDrainedResult.Drained
+}
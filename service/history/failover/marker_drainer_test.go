@@ -0,0 +1,220 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package failover
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/service/history/config"
+	"github.com/uber/cadence/service/history/queue"
+	"github.com/uber/cadence/service/history/shard"
+)
+
+type (
+	markerDrainerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller            *gomock.Controller
+		mockShard             *shard.TestContext
+		mockTransferProcessor *queue.MockProcessor
+		mockTimerProcessor    *queue.MockProcessor
+		timeSource            clock.MockedTimeSource
+		clusterName           string
+		markerDrainer         *markerDrainerImpl
+	}
+)
+
+func TestMarkerDrainerSuite(t *testing.T) {
+	s := new(markerDrainerSuite)
+	suite.Run(t, s)
+}
+
+func (s *markerDrainerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+
+	config := config.NewForTest()
+	config.GracefulFailoverDrainTimeout = dynamicproperties.GetDurationPropertyFn(time.Minute)
+	s.mockShard = shard.NewTestContext(
+		s.T(),
+		s.controller,
+		&persistence.ShardInfo{
+			ShardID:                   10,
+			RangeID:                   1,
+			TransferAckLevel:          0,
+			DomainNotificationVersion: 3,
+		},
+		config,
+	)
+	s.timeSource = clock.NewMockedTimeSource()
+	s.mockShard.Resource.TimeSource = s.timeSource
+	s.mockShard.Resource.ShardMgr.On("UpdateShard", mock.Anything, mock.Anything).Return(nil).Maybe()
+	s.clusterName = s.mockShard.Resource.ClusterMetadata.GetCurrentClusterName()
+	s.mockTransferProcessor = queue.NewMockProcessor(s.controller)
+	s.mockTimerProcessor = queue.NewMockProcessor(s.controller)
+
+	s.markerDrainer = NewMarkerDrainer(
+		s.mockShard,
+		config,
+		map[persistence.HistoryTaskCategory]queue.Processor{
+			persistence.HistoryTaskCategoryTransfer: s.mockTransferProcessor,
+			persistence.HistoryTaskCategoryTimer:    s.mockTimerProcessor,
+		},
+	).(*markerDrainerImpl)
+}
+
+func (s *markerDrainerSuite) TearDownTest() {
+	s.controller.Finish()
+	s.mockShard.Finish(s.T())
+}
+
+func (s *markerDrainerSuite) TestInsertPendingMarkers_Drained() {
+	marker := s.newMarker()
+	fencedTime := s.timeSource.Now()
+	transferLevel := persistence.NewImmediateTaskKey(s.mockShard.UpdateIfNeededAndGetQueueMaxReadLevel(persistence.HistoryTaskCategoryTransfer, s.clusterName).GetTaskID() + 1)
+	s.markerDrainer.Drain([]*persistence.FailoverMarkerTask{marker}, 3)
+
+	// the tasks of other domains are not checked
+	s.expectDomainDrained(s.mockTransferProcessor, marker.DomainID, transferLevel, true)
+	s.expectDomainDrained(s.mockTimerProcessor, marker.DomainID, persistence.NewHistoryTaskKey(fencedTime, 0), false)
+	s.markerDrainer.insertPendingMarkers(false)
+	s.Len(s.markerDrainer.pending, 1)
+	s.mockShard.Resource.ExecutionMgr.AssertNotCalled(s.T(), "CreateFailoverMarkerTasks", mock.Anything, mock.Anything)
+
+	s.timeSource.Advance(time.Second)
+	s.expectDomainDrained(s.mockTransferProcessor, marker.DomainID, transferLevel, true)
+	s.expectDomainDrained(s.mockTimerProcessor, marker.DomainID, persistence.NewHistoryTaskKey(fencedTime, 0), true)
+	s.mockShard.Resource.ExecutionMgr.On("CreateFailoverMarkerTasks", mock.Anything, mock.Anything).Return(nil).Once()
+	s.markerDrainer.insertPendingMarkers(false)
+	s.Empty(s.markerDrainer.pending)
+	s.Equal(fencedTime, marker.GetVisibilityTimestamp())
+}
+
+func (s *markerDrainerSuite) TestInsertPendingMarkers_CheckError() {
+	marker := s.newMarker()
+	s.markerDrainer.Drain([]*persistence.FailoverMarkerTask{marker}, 3)
+
+	s.mockTransferProcessor.EXPECT().HandleAction(gomock.Any(), s.clusterName, gomock.Any()).Return(nil, errors.New("some error"))
+	s.markerDrainer.insertPendingMarkers(false)
+	s.Len(s.markerDrainer.pending, 1)
+}
+
+func (s *markerDrainerSuite) TestInsertPendingMarkers_Timeout() {
+	s.markerDrainer.Drain([]*persistence.FailoverMarkerTask{s.newMarker()}, 3)
+
+	s.timeSource.Advance(2 * time.Minute)
+	s.expectNotDrained()
+	s.mockShard.Resource.ExecutionMgr.On("CreateFailoverMarkerTasks", mock.Anything, mock.Anything).Return(nil).Once()
+	s.markerDrainer.insertPendingMarkers(false)
+	s.Empty(s.markerDrainer.pending)
+}
+
+func (s *markerDrainerSuite) TestInsertPendingMarkers_Error() {
+	s.markerDrainer.Drain([]*persistence.FailoverMarkerTask{s.newMarker()}, 3)
+	s.NoError(s.markerDrainer.UpdateDomainNotificationVersion(5))
+
+	s.timeSource.Advance(2 * time.Minute)
+	s.expectNotDrained()
+	s.mockShard.Resource.ExecutionMgr.On("CreateFailoverMarkerTasks", mock.Anything, mock.Anything).Return(errors.New("some error")).Once()
+	s.markerDrainer.insertPendingMarkers(false)
+	s.Len(s.markerDrainer.pending, 1)
+	s.Equal(int64(3), s.mockShard.GetDomainNotificationVersion())
+}
+
+func (s *markerDrainerSuite) TestDrain_PendingMarker() {
+	marker := s.newMarker()
+	s.markerDrainer.Drain([]*persistence.FailoverMarkerTask{marker}, 3)
+	s.markerDrainer.Drain([]*persistence.FailoverMarkerTask{marker}, 4)
+	s.Len(s.markerDrainer.pending, 1)
+}
+
+func (s *markerDrainerSuite) TestUpdateDomainNotificationVersion() {
+	s.NoError(s.markerDrainer.UpdateDomainNotificationVersion(4))
+	s.Equal(int64(4), s.mockShard.GetDomainNotificationVersion())
+
+	// the version is held until the markers are inserted
+	s.markerDrainer.Drain([]*persistence.FailoverMarkerTask{s.newMarker()}, 4)
+	s.NoError(s.markerDrainer.UpdateDomainNotificationVersion(6))
+	s.NoError(s.markerDrainer.UpdateDomainNotificationVersion(5))
+	s.Equal(int64(4), s.mockShard.GetDomainNotificationVersion())
+
+	s.timeSource.Advance(2 * time.Minute)
+	s.expectNotDrained()
+	s.mockShard.Resource.ExecutionMgr.On("CreateFailoverMarkerTasks", mock.Anything, mock.Anything).Return(nil).Once()
+	s.markerDrainer.insertPendingMarkers(false)
+	s.Equal(int64(6), s.mockShard.GetDomainNotificationVersion())
+}
+
+func (s *markerDrainerSuite) TestStop_InsertPendingMarkers() {
+	s.markerDrainer.Start()
+	s.markerDrainer.Drain([]*persistence.FailoverMarkerTask{s.newMarker()}, 3)
+	s.NoError(s.markerDrainer.UpdateDomainNotificationVersion(5))
+
+	s.expectNotDrained()
+	s.mockShard.Resource.ExecutionMgr.On("CreateFailoverMarkerTasks", mock.Anything, mock.Anything).Return(nil).Once()
+	s.markerDrainer.Stop()
+	s.Empty(s.markerDrainer.pending)
+	s.Equal(int64(5), s.mockShard.GetDomainNotificationVersion())
+}
+
+func (s *markerDrainerSuite) newMarker() *persistence.FailoverMarkerTask {
+	return &persistence.FailoverMarkerTask{
+		TaskData: persistence.TaskData{
+			Version: 2,
+		},
+		DomainID: uuid.New(),
+	}
+}
+
+func (s *markerDrainerSuite) expectDomainDrained(
+	processor *queue.MockProcessor,
+	domainID string,
+	exclusiveMaxTaskKey persistence.HistoryTaskKey,
+	drained bool,
+) {
+	processor.EXPECT().HandleAction(gomock.Any(), s.clusterName, queue.NewIsDomainDrainedAction(domainID, exclusiveMaxTaskKey)).
+		Return(&queue.ActionResult{
+			ActionType:            queue.ActionTypeIsDomainDrained,
+			IsDomainDrainedResult: &queue.IsDomainDrainedResult{Drained: drained},
+		}, nil)
+}
+
+func (s *markerDrainerSuite) expectNotDrained() {
+	s.mockTransferProcessor.EXPECT().HandleAction(gomock.Any(), s.clusterName, gomock.Any()).
+		Return(&queue.ActionResult{
+			ActionType:            queue.ActionTypeIsDomainDrained,
+			IsDomainDrainedResult: &queue.IsDomainDrainedResult{Drained: false},
+		}, nil)
+}
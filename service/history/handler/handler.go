@@ -160,7 +160,17 @@ func (h *handlerImpl) Start() {
 		h.config,
 		h.controller,
 	)
-	h.queueTaskProcessor = task.NewRateLimitedProcessor(taskProcessor, taskRateLimiter)
+	taskConcurrencyLimiter := task.NewConcurrencyLimiter(
+		h.GetLogger(),
+		h.GetMetricsClient(),
+		h.GetDomainCache(),
+		h.config,
+	)
+	// tasks are rate limited and capped per domain, per-domain weights only apply to the tasks of the domain-keyed scheduler
+	h.queueTaskProcessor = task.NewConcurrencyLimitedProcessor(
+		task.NewRateLimitedProcessor(taskProcessor, taskRateLimiter),
+		taskConcurrencyLimiter,
+	)
 	h.queueTaskProcessor.Start()
 
 	h.queueFactories = []queue.Factory{
@@ -173,6 +183,10 @@ func (h *handlerImpl) Start() {
 			h.queueTaskProcessor,
 			h.GetArchiverClient(),
 		),
+		queuev2.NewHousekeepingQueueFactory(
+			h.queueTaskProcessor,
+			h.GetArchiverClient(),
+		),
 	}
 
 	h.historyEventNotifier = events.NewNotifier(h.GetTimeSource(), h.GetMetricsClient(), h.config.GetShardID)
@@ -835,6 +849,8 @@ func (h *handlerImpl) DescribeQueue(
 		resp, err = engine.DescribeTransferQueue(ctx, request.GetClusterName())
 	case commonconstants.TaskTypeTimer:
 		resp, err = engine.DescribeTimerQueue(ctx, request.GetClusterName())
+	case commonconstants.TaskTypeReplication:
+		resp, err = engine.DescribeReplicationQueue(ctx, request.GetClusterName())
 	default:
 		err = constants.ErrInvalidTaskType
 	}
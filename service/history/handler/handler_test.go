@@ -1385,6 +1385,17 @@ func (s *handlerSuite) TestDescribeQueue() {
 				s.mockEngine.EXPECT().DescribeTimerQueue(gomock.Any(), gomock.Any()).Return(&types.DescribeQueueResponse{}, nil).Times(1)
 			},
 		},
+		"replication task": {
+			request: &types.DescribeQueueRequest{
+				ShardID: 0,
+				Type:    common.Int32Ptr(int32(commonconstants.TaskTypeReplication)),
+			},
+			expectedError: false,
+			mockFn: func() {
+				s.mockShardController.EXPECT().GetEngineForShard(0).Return(s.mockEngine, nil).Times(1)
+				s.mockEngine.EXPECT().DescribeReplicationQueue(gomock.Any(), gomock.Any()).Return(&types.DescribeQueueResponse{}, nil).Times(1)
+			},
+		},
 		"invalid task": {
 			request: &types.DescribeQueueRequest{
 				Type: common.Int32Ptr(int32(100)),
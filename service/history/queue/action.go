@@ -20,7 +20,11 @@
 
 package queue
 
-import "github.com/uber/cadence/common/types"
+import (
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/queueedit"
+	"github.com/uber/cadence/common/types"
+)
 
 type (
 	// ActionType specifies the type of the Action
@@ -28,21 +32,24 @@ type (
 
 	// Action specifies the Action should be performed
 	Action struct {
-		ActionType               ActionType
-		ResetActionAttributes    *ResetActionAttributes
-		GetStateActionAttributes *GetStateActionAttributes
-		GetTasksAttributes       *GetTasksAttributes
-		UpdateTaskAttributes     *UpdateTasksAttributes
+		ActionType                 ActionType
+		ResetActionAttributes      *ResetActionAttributes
+		GetStateActionAttributes   *GetStateActionAttributes
+		GetTasksAttributes         *GetTasksAttributes
+		UpdateTaskAttributes       *UpdateTasksAttributes
+		EditVirtualQueueAttributes *EditVirtualQueueAttributes
+		IsDomainDrainedAttributes  *IsDomainDrainedAttributes
 		// add attributes for other action types here
 	}
 
 	// ActionResult is the result for performing an Action
 	ActionResult struct {
-		ActionType           ActionType
-		ResetActionResult    *ResetActionResult
-		GetStateActionResult *GetStateActionResult
-		GetTasksResult       *GetTasksResult
-		UpdateTaskResult     *UpdateTasksResult
+		ActionType            ActionType
+		ResetActionResult     *ResetActionResult
+		GetStateActionResult  *GetStateActionResult
+		GetTasksResult        *GetTasksResult
+		UpdateTaskResult      *UpdateTasksResult
+		IsDomainDrainedResult *IsDomainDrainedResult
 	}
 
 	// ResetActionAttributes contains the parameter for performing Reset Action
@@ -55,6 +62,8 @@ type (
 	// GetStateActionResult is the result for performing GetState Action
 	GetStateActionResult struct {
 		States []ProcessingQueueState
+		// Descriptions is the human readable state of queues which are not made of ProcessingQueues
+		Descriptions []string
 	}
 
 	// GetTasksAttributes contains the parameter to get tasks
@@ -70,6 +79,23 @@ type (
 	// UpdateTasksResult is the result for performing UpdateTask Action
 	UpdateTasksResult struct {
 	}
+
+	// EditVirtualQueueAttributes contains the parameter for performing EditVirtualQueue Action
+	EditVirtualQueueAttributes struct {
+		Edit *queueedit.Edit
+	}
+
+	// IsDomainDrainedAttributes contains the parameter for performing IsDomainDrained Action
+	IsDomainDrainedAttributes struct {
+		DomainID string
+		// ExclusiveMaxTaskKey is the key before which the tasks of the domain are checked
+		ExclusiveMaxTaskKey persistence.HistoryTaskKey
+	}
+	// IsDomainDrainedResult is the result for performing IsDomainDrained Action
+	IsDomainDrainedResult struct {
+		// Drained is true if all the tasks of the domain before the key have been loaded and processed
+		Drained bool
+	}
 )
 
 const (
@@ -81,6 +107,10 @@ const (
 	ActionTypeGetTasks
 	// ActionTypeUpdateTask is the ActionType to update outstanding task
 	ActionTypeUpdateTask
+	// ActionTypeEditVirtualQueue is the ActionType to manually edit virtual queues
+	ActionTypeEditVirtualQueue
+	// ActionTypeIsDomainDrained is the ActionType to check whether the tasks of a domain are processed
+	ActionTypeIsDomainDrained
 	// add more ActionType here
 )
 
@@ -120,3 +150,30 @@ func NewUpdateTasksAction(
 		},
 	}
 }
+
+// NewEditVirtualQueueAction creates a queue action for manually editing virtual queues
+func NewEditVirtualQueueAction(
+	edit *queueedit.Edit,
+) *Action {
+	return &Action{
+		ActionType: ActionTypeEditVirtualQueue,
+		EditVirtualQueueAttributes: &EditVirtualQueueAttributes{
+			Edit: edit,
+		},
+	}
+}
+
+// NewIsDomainDrainedAction creates a queue action for checking whether the tasks of a domain
+// before the given task key are processed
+func NewIsDomainDrainedAction(
+	domainID string,
+	exclusiveMaxTaskKey persistence.HistoryTaskKey,
+) *Action {
+	return &Action{
+		ActionType: ActionTypeIsDomainDrained,
+		IsDomainDrainedAttributes: &IsDomainDrainedAttributes{
+			DomainID:            domainID,
+			ExclusiveMaxTaskKey: exclusiveMaxTaskKey,
+		},
+	}
+}
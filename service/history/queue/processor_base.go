@@ -23,6 +23,7 @@ package queue
 import (
 	"context"
 	"fmt"
+	"math"
 	"sync"
 	"time"
 
@@ -31,7 +32,9 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/quotas"
+	t "github.com/uber/cadence/common/task"
 	"github.com/uber/cadence/service/history/shard"
 	"github.com/uber/cadence/service/history/task"
 )
@@ -325,6 +328,8 @@ func (p *processorBase) handleActionNotification(notification actionNotification
 		result, err = p.resetProcessingQueueStates()
 	case ActionTypeGetState:
 		result = p.getProcessingQueueStates()
+	case ActionTypeIsDomainDrained:
+		result = p.isDomainDrained(notification.action.IsDomainDrainedAttributes)
 	default:
 		err = fmt.Errorf("unknown queue action type: %v", notification.action.ActionType)
 	}
@@ -408,6 +413,66 @@ func (p *processorBase) getProcessingQueueStates() *ActionResult {
 	}
 }
 
+// isDomainDrained checks the processing queues which may contain tasks of the domain, all their tasks before the key
+// must be loaded and none of the loaded tasks of the domain before the key may be pending
+func (p *processorBase) isDomainDrained(attributes *IsDomainDrainedAttributes) *ActionResult {
+	drained := true
+	for _, queueCollection := range p.processingQueueCollections {
+		for _, queue := range queueCollection.Queues() {
+			if !isDomainDrainedInQueue(queue, attributes.DomainID, attributes.ExclusiveMaxTaskKey) {
+				drained = false
+			}
+		}
+	}
+
+	return &ActionResult{
+		ActionType:            ActionTypeIsDomainDrained,
+		IsDomainDrainedResult: &IsDomainDrainedResult{Drained: drained},
+	}
+}
+
+func isDomainDrainedInQueue(
+	queue ProcessingQueue,
+	domainID string,
+	exclusiveMaxTaskKey persistence.HistoryTaskKey,
+) bool {
+	state := queue.State()
+	if !state.DomainFilter().Filter(domainID) ||
+		toExclusiveHistoryTaskKey(state.AckLevel()).Compare(exclusiveMaxTaskKey) >= 0 {
+		return true
+	}
+
+	readLevel := toExclusiveHistoryTaskKey(state.ReadLevel())
+	maxLevel := toExclusiveHistoryTaskKey(state.MaxLevel())
+	if readLevel.Compare(persistence.MinHistoryTaskKey(exclusiveMaxTaskKey, maxLevel)) < 0 {
+		return false
+	}
+	for _, task := range queue.GetTasks() {
+		if task.GetDomainID() == domainID &&
+			task.State() != t.TaskStateAcked &&
+			task.GetTaskKey().Compare(exclusiveMaxTaskKey) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// toExclusiveHistoryTaskKey converts a level of a processing queue to the key of the first task after the level,
+// transfer queue levels are the ID of the last task within the level while timer queue levels are exclusive
+func toExclusiveHistoryTaskKey(key task.Key) persistence.HistoryTaskKey {
+	switch key := key.(type) {
+	case transferTaskKey:
+		if key.taskID == math.MaxInt64 {
+			return persistence.MaximumHistoryTaskKey
+		}
+		return persistence.NewImmediateTaskKey(key.taskID + 1)
+	case timerTaskKey:
+		return persistence.NewHistoryTaskKey(key.visibilityTimestamp, key.taskID)
+	default:
+		panic(fmt.Sprintf("unknown task key type: %T", key))
+	}
+}
+
 func (p *processorBase) submitTask(task task.Task) (bool, error) {
 	submitted, err := p.taskProcessor.TrySubmit(task)
 	if err != nil {
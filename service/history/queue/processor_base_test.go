@@ -35,6 +35,7 @@ import (
 	"github.com/uber/cadence/common/log/testlogger"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	t "github.com/uber/cadence/common/task"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/shard"
@@ -382,6 +383,105 @@ func (s *processorBaseSuite) TestResetProcessingQueueStates() {
 	s.Equal(ActionTypeReset, res.ActionType, "got action type %v, want %v", res.ActionType, ActionTypeReset)
 }
 
+func (s *processorBaseSuite) TestIsDomainDrainedInQueue() {
+	newTask := func(domainID string, taskID int64, state t.State) task.Task {
+		mockTask := task.NewMockTask(s.controller)
+		mockTask.EXPECT().GetDomainID().Return(domainID).AnyTimes()
+		mockTask.EXPECT().State().Return(state).AnyTimes()
+		mockTask.EXPECT().GetTaskKey().Return(persistence.NewImmediateTaskKey(taskID)).AnyTimes()
+		return mockTask
+	}
+
+	testCases := []struct {
+		name     string
+		state    *processingQueueStateImpl
+		tasks    map[task.Key]task.Task
+		expected bool
+	}{
+		{
+			name: "domain filtered out",
+			state: newProcessingQueueState(
+				0,
+				newTransferTaskKey(0),
+				newTransferTaskKey(0),
+				newTransferTaskKey(100),
+				NewDomainFilter(map[string]struct{}{"testDomain1": {}}, true),
+			),
+			expected: true,
+		},
+		{
+			name: "ack level reached the key",
+			state: newProcessingQueueState(
+				0,
+				newTransferTaskKey(9),
+				newTransferTaskKey(9),
+				newTransferTaskKey(100),
+				NewDomainFilter(nil, true),
+			),
+			expected: true,
+		},
+		{
+			name: "tasks before the key not loaded",
+			state: newProcessingQueueState(
+				0,
+				newTransferTaskKey(0),
+				newTransferTaskKey(5),
+				newTransferTaskKey(100),
+				NewDomainFilter(nil, true),
+			),
+			expected: false,
+		},
+		{
+			name: "pending task of the domain before the key",
+			state: newProcessingQueueState(
+				0,
+				newTransferTaskKey(0),
+				newTransferTaskKey(20),
+				newTransferTaskKey(100),
+				NewDomainFilter(nil, true),
+			),
+			tasks: map[task.Key]task.Task{
+				newTransferTaskKey(3): newTask("testDomain1", 3, t.TaskStatePending),
+			},
+			expected: false,
+		},
+		{
+			name: "only acked or unrelated tasks before the key",
+			state: newProcessingQueueState(
+				0,
+				newTransferTaskKey(0),
+				newTransferTaskKey(20),
+				newTransferTaskKey(100),
+				NewDomainFilter(nil, true),
+			),
+			tasks: map[task.Key]task.Task{
+				newTransferTaskKey(3):  newTask("testDomain1", 3, t.TaskStateAcked),
+				newTransferTaskKey(4):  newTask("testDomain2", 4, t.TaskStatePending),
+				newTransferTaskKey(15): newTask("testDomain1", 15, t.TaskStatePending),
+			},
+			expected: true,
+		},
+		{
+			name: "max level before the key",
+			state: newProcessingQueueState(
+				0,
+				newTransferTaskKey(0),
+				newTransferTaskKey(5),
+				newTransferTaskKey(5),
+				NewDomainFilter(nil, true),
+			),
+			expected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			queue := newProcessingQueue(tc.state, tc.tasks, s.logger, s.metricsClient)
+			s.Equal(tc.expected, isDomainDrainedInQueue(queue, "testDomain1", persistence.NewImmediateTaskKey(10)))
+		})
+	}
+}
+
 func (s *processorBaseSuite) newTestProcessorBase(
 	processingQueueStates []ProcessingQueueState,
 	updateMaxReadLevel updateMaxReadLevelFn,
@@ -1,10 +1,20 @@
 package queuev2
 
+import (
+	"fmt"
+	"time"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
 type (
 	// Alert is created by a Monitor when some statistics of the Queue is abnormal
 	Alert struct {
-		AlertType                            AlertType
-		AlertAttributesQueuePendingTaskCount *AlertAttributesQueuePendingTaskCount
+		AlertType                             AlertType
+		AlertAttributesQueuePendingTaskCount  *AlertAttributesQueuePendingTaskCount
+		AlertAttributesQueueStuckSlice        *AlertAttributesQueueStuckSlice
+		AlertAttributesQueueDomainTaskFailure *AlertAttributesQueueDomainTaskFailure
+		AlertAttributesQueueScheduledLag      *AlertAttributesQueueScheduledLag
 	}
 
 	AlertType int
@@ -13,9 +23,76 @@ type (
 		CurrentPendingTaskCount  int
 		CriticalPendingTaskCount int
 	}
+
+	// AlertAttributesQueueStuckSlice is raised when the ack level of the queue makes no progress while there are pending tasks
+	AlertAttributesQueueStuckSlice struct {
+		ExclusiveAckLevel     persistence.HistoryTaskKey
+		StuckDuration         time.Duration
+		CriticalStuckDuration time.Duration
+	}
+
+	// AlertAttributesQueueDomainTaskFailure is raised when most of the pending tasks of some domains have failed at least once
+	AlertAttributesQueueDomainTaskFailure struct {
+		TaskFailureRatePerDomain map[string]float64
+		CriticalTaskFailureRate  float64
+	}
+
+	// AlertAttributesQueueScheduledLag is raised when the ack level of a scheduled queue falls too far behind the current time
+	AlertAttributesQueueScheduledLag struct {
+		ExclusiveAckLevel persistence.HistoryTaskKey
+		Lag               time.Duration
+		MaxLag            time.Duration
+	}
+
+	// AlertRecord is a raised alert kept by the Monitor so that it can be inspected
+	AlertRecord struct {
+		Alert      Alert
+		RaisedAt   time.Time
+		ResolvedAt time.Time
+	}
 )
 
 const (
 	AlertTypeUnspecified AlertType = iota
 	AlertTypeQueuePendingTaskCount
+	AlertTypeQueueStuckSlice
+	AlertTypeQueueDomainTaskFailure
+	AlertTypeQueueScheduledLag
 )
+
+func (t AlertType) String() string {
+	switch t {
+	case AlertTypeQueuePendingTaskCount:
+		return "QueuePendingTaskCount"
+	case AlertTypeQueueStuckSlice:
+		return "QueueStuckSlice"
+	case AlertTypeQueueDomainTaskFailure:
+		return "QueueDomainTaskFailure"
+	case AlertTypeQueueScheduledLag:
+		return "QueueScheduledLag"
+	default:
+		return "Unspecified"
+	}
+}
+
+func (a Alert) String() string {
+	switch a.AlertType {
+	case AlertTypeQueuePendingTaskCount:
+		if attr := a.AlertAttributesQueuePendingTaskCount; attr != nil {
+			return fmt.Sprintf("%v{pending task count: %d, critical: %d}", a.AlertType, attr.CurrentPendingTaskCount, attr.CriticalPendingTaskCount)
+		}
+	case AlertTypeQueueStuckSlice:
+		if attr := a.AlertAttributesQueueStuckSlice; attr != nil {
+			return fmt.Sprintf("%v{ack level: %v, stuck for: %v, critical: %v}", a.AlertType, attr.ExclusiveAckLevel, attr.StuckDuration, attr.CriticalStuckDuration)
+		}
+	case AlertTypeQueueDomainTaskFailure:
+		if attr := a.AlertAttributesQueueDomainTaskFailure; attr != nil {
+			return fmt.Sprintf("%v{failure rate per domain: %v, critical: %v}", a.AlertType, attr.TaskFailureRatePerDomain, attr.CriticalTaskFailureRate)
+		}
+	case AlertTypeQueueScheduledLag:
+		if attr := a.AlertAttributesQueueScheduledLag; attr != nil {
+			return fmt.Sprintf("%v{ack level: %v, lag: %v, max: %v}", a.AlertType, attr.ExclusiveAckLevel, attr.Lag, attr.MaxLag)
+		}
+	}
+	return a.AlertType.String()
+}
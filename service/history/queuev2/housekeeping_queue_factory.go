@@ -0,0 +1,159 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package queuev2
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/ndc"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/reconciliation/invariant"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/queue"
+	"github.com/uber/cadence/service/history/shard"
+	"github.com/uber/cadence/service/history/task"
+	"github.com/uber/cadence/service/worker/archiver"
+)
+
+type (
+	housekeepingQueueFactory struct {
+		taskProcessor  task.Processor
+		archivalClient archiver.Client
+	}
+)
+
+// NewHousekeepingQueueFactory creates the factory for the housekeeping queue,
+// which processes the timer tasks deleting workflows after retention separately from user timers.
+// Visibility records are closed by transfer tasks, which stay in the transfer queue.
+// Housekeeping tasks share the timer task executors, and the queue only has a queue v2 implementation.
+// The queue is only created for shards which have the queue enabled or still have its state persisted,
+// so that the tasks written before the queue was disabled are still processed.
+func NewHousekeepingQueueFactory(
+	taskProcessor task.Processor,
+	archivalClient archiver.Client,
+) queue.Factory {
+	return &housekeepingQueueFactory{
+		taskProcessor:  taskProcessor,
+		archivalClient: archivalClient,
+	}
+}
+
+func (f *housekeepingQueueFactory) Category() persistence.HistoryTaskCategory {
+	return persistence.HistoryTaskCategoryHousekeeping
+}
+
+func (f *housekeepingQueueFactory) CreateQueue(
+	shard shard.Context,
+	executionCache execution.Cache,
+	openExecutionCheck invariant.Invariant,
+) queue.Processor {
+	if !shard.IsHousekeepingQueueEnabled() && !hasHousekeepingQueueState(shard) {
+		return nil
+	}
+
+	logger := shard.GetLogger().WithTags(tag.ComponentHousekeepingQueueV2)
+	activeTaskExecutor := task.NewTimerActiveTaskExecutor(
+		shard,
+		f.archivalClient,
+		executionCache,
+		logger,
+		shard.GetMetricsClient(),
+		shard.GetConfig(),
+	)
+	historyResender := ndc.NewHistoryResender(
+		shard.GetDomainCache(),
+		shard.GetService().GetClientBean(),
+		func(ctx context.Context, request *types.ReplicateEventsV2Request) error {
+			return shard.GetEngine().ReplicateEventsV2(ctx, request)
+		},
+		shard.GetConfig().StandbyTaskReReplicationContextTimeout,
+		openExecutionCheck,
+		logger,
+	)
+	standbyTaskExecutor := task.NewTimerStandbyTaskExecutor(
+		shard,
+		f.archivalClient,
+		executionCache,
+		historyResender,
+		logger,
+		shard.GetMetricsClient(),
+		shard.GetClusterMetadata().GetCurrentClusterName(),
+		shard.GetConfig(),
+	)
+	executorWrapper := task.NewExecutorWrapper(
+		shard.GetClusterMetadata().GetCurrentClusterName(),
+		shard.GetActiveClusterManager(),
+		activeTaskExecutor,
+		standbyTaskExecutor,
+		logger,
+	)
+	config := shard.GetConfig()
+	return NewScheduledQueue(
+		shard,
+		persistence.HistoryTaskCategoryHousekeeping,
+		f.taskProcessor,
+		executorWrapper,
+		logger,
+		shard.GetMetricsClient(),
+		shard.GetMetricsClient().Scope(metrics.HousekeepingQueueProcessorV2Scope).Tagged(metrics.ShardIDTag(shard.GetShardID())),
+		&Options{
+			PageSize:                             config.HousekeepingTaskBatchSize,
+			DeleteBatchSize:                      config.TimerTaskDeleteBatchSize,
+			MaxPollRPS:                           config.HousekeepingProcessorMaxPollRPS,
+			MaxPollInterval:                      config.HousekeepingProcessorMaxPollInterval,
+			MaxPollIntervalJitterCoefficient:     config.TimerProcessorMaxPollIntervalJitterCoefficient,
+			UpdateAckInterval:                    config.HousekeepingProcessorUpdateAckInterval,
+			UpdateAckIntervalJitterCoefficient:   config.TimerProcessorUpdateAckIntervalJitterCoefficient,
+			MaxPendingTasksCount:                 config.QueueMaxPendingTaskCount,
+			PollBackoffInterval:                  config.QueueProcessorPollBackoffInterval,
+			PollBackoffIntervalJitterCoefficient: config.QueueProcessorPollBackoffIntervalJitterCoefficient,
+			VirtualSliceForceAppendInterval:      config.VirtualSliceForceAppendInterval,
+			MaxStartJitterInterval:               dynamicproperties.GetDurationPropertyFn(0),
+			RedispatchInterval:                   config.ActiveTaskRedispatchInterval,
+			CriticalPendingTaskCount:             config.QueueCriticalPendingTaskCount,
+			EnablePendingTaskCountAlert:          func() bool { return config.EnableTimerQueueV2PendingTaskCountAlert(shard.GetShardID()) },
+			MaxVirtualQueueCount:                 config.QueueMaxVirtualQueueCount,
+			EnableStuckSliceAlert:                func() bool { return config.EnableQueueV2StuckSliceAlert(shard.GetShardID()) },
+			CriticalStuckDuration:                config.QueueStuckSliceDuration,
+			EnableDomainTaskFailureAlert:         func() bool { return config.EnableQueueV2DomainTaskFailureAlert(shard.GetShardID()) },
+			CriticalDomainTaskFailureRate:        config.QueueCriticalDomainTaskFailureRate,
+			DomainTaskFailureRateMinTaskCount:    config.QueueDomainTaskFailureRateMinTaskCount,
+			EnableScheduledLagAlert:              func() bool { return config.EnableQueueV2ScheduledLagAlert(shard.GetShardID()) },
+			MaxScheduledLag:                      config.QueueMaxScheduledLag,
+		},
+	)
+}
+
+func hasHousekeepingQueueState(shard shard.Context) bool {
+	state, err := shard.GetQueueState(persistence.HistoryTaskCategoryHousekeeping)
+	if err != nil {
+		// don't leave the persisted tasks unprocessed if the state can't be read
+		return true
+	}
+	// the state defaults to an empty queue starting from the beginning until the queue persists its state
+	return len(state.VirtualQueueStates) > 0 || (state.ExclusiveMaxReadLevel != nil && state.ExclusiveMaxReadLevel.ScheduledTimeNano > 0)
+}
@@ -0,0 +1,82 @@
+package queuev2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/goleak"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/reconciliation/invariant"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/config"
+	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/queue"
+	"github.com/uber/cadence/service/history/shard"
+	"github.com/uber/cadence/service/history/task"
+	"github.com/uber/cadence/service/worker/archiver"
+)
+
+func TestHousekeepingQueueFactory_CreateQueue(t *testing.T) {
+	tests := map[string]struct {
+		enabled     bool
+		queueStates map[int32]*types.QueueState
+		wantQueue   bool
+	}{
+		"enabled": {
+			enabled:   true,
+			wantQueue: true,
+		},
+		"disabled": {
+			enabled:   false,
+			wantQueue: false,
+		},
+		"disabled with persisted queue state": {
+			enabled: false,
+			queueStates: map[int32]*types.QueueState{
+				int32(persistence.HistoryTaskCategoryIDHousekeeping): {
+					ExclusiveMaxReadLevel: &types.TaskKey{ScheduledTimeNano: 100},
+				},
+			},
+			wantQueue: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer goleak.VerifyNone(t)
+			ctrl := gomock.NewController(t)
+			cfg := config.NewForTest()
+			cfg.EnableHousekeepingTaskQueue = func(int) bool { return tt.enabled }
+			mockShard := shard.NewTestContext(
+				t, ctrl, &persistence.ShardInfo{
+					ShardID:          10,
+					RangeID:          1,
+					TransferAckLevel: 0,
+					QueueStates:      tt.queueStates,
+				},
+				cfg)
+
+			factory := NewHousekeepingQueueFactory(task.NewMockProcessor(ctrl), archiver.NewMockClient(ctrl))
+
+			// housekeeping queue is always created as queue v2, regardless of the timer queue v2 flag
+			processor := factory.CreateQueue(mockShard, execution.NewMockCache(ctrl), invariant.NewMockInvariant(ctrl))
+
+			if tt.wantQueue {
+				assert.NotNil(t, processor)
+				assert.Implements(t, (*queue.Processor)(nil), processor)
+			} else {
+				assert.Nil(t, processor)
+			}
+		})
+	}
+}
+
+func TestHousekeepingQueueFactory_Category(t *testing.T) {
+	factory := &housekeepingQueueFactory{}
+
+	category := factory.Category()
+
+	assert.Equal(t, persistence.HistoryTaskCategoryHousekeeping, category)
+}
@@ -42,6 +42,7 @@ type (
 		totalPendingTaskCount             int
 		pendingTaskCountPerDomain         map[string]int
 		pendingTaskCountPerDomainPerSlice map[VirtualSlice]map[string]int
+		failingTaskCountPerDomainPerSlice map[VirtualSlice]map[string]int
 		slicesPerDomain                   map[string][]VirtualSlice
 	}
 )
@@ -61,7 +62,10 @@ func NewMitigator(
 		options:             options,
 	}
 	m.handlers = map[AlertType]func(Alert){
-		AlertTypeQueuePendingTaskCount: m.handleQueuePendingTaskCount,
+		AlertTypeQueuePendingTaskCount:  m.handleQueuePendingTaskCount,
+		AlertTypeQueueStuckSlice:        m.handleQueueStuckSlice,
+		AlertTypeQueueDomainTaskFailure: m.handleQueueDomainTaskFailure,
+		AlertTypeQueueScheduledLag:      m.handleQueueScheduledLag,
 	}
 	return m
 }
@@ -130,6 +134,102 @@ func (m *mitigatorImpl) handleQueuePendingTaskCount(alert Alert) {
 	}
 }
 
+// handleQueueStuckSlice moves the domains holding back the ack level out of the oldest slice into the next virtual queue,
+// the moved tasks are reloaded after a pause instead of blocking the other domains in the slice
+func (m *mitigatorImpl) handleQueueStuckSlice(alert Alert) {
+	virtualQueues := m.virtualQueueManager.VirtualQueues()
+	for _, virtualQueue := range virtualQueues {
+		virtualQueue.UpdateAndGetState()
+	}
+	stats := m.collectPendingTaskStats()
+
+	var oldestSlice VirtualSlice
+	for slice, perDomain := range stats.pendingTaskCountPerDomainPerSlice {
+		if busiestDomain(perDomain) == "" {
+			continue
+		}
+		if oldestSlice == nil || slice.GetState().Range.InclusiveMinTaskKey.Compare(oldestSlice.GetState().Range.InclusiveMinTaskKey) < 0 {
+			oldestSlice = slice
+		}
+	}
+	if oldestSlice == nil {
+		m.logger.Debug("mitigating queue alert, skip mitigation because there is no pending task")
+		return
+	}
+
+	// tasks that keep failing are the most likely reason of a stuck slice, otherwise move the busiest domain
+	var domains []string
+	for domainID, count := range stats.failingTaskCountPerDomainPerSlice[oldestSlice] {
+		if count > 0 {
+			domains = append(domains, domainID)
+		}
+	}
+	if len(domains) == 0 {
+		domains = []string{busiestDomain(stats.pendingTaskCountPerDomainPerSlice[oldestSlice])}
+	}
+	slices.Sort(domains)
+
+	m.logger.Info("mitigating stuck queue slice",
+		tag.Dynamic("slice", ToPersistenceVirtualSliceState(oldestSlice.GetState())),
+		tag.WorkflowDomainIDs(domains),
+		tag.Dynamic("alert", alert.String()),
+	)
+	m.processQueueSplitsAndClear(virtualQueues, map[VirtualSlice][]string{oldestSlice: domains})
+}
+
+// handleQueueDomainTaskFailure moves all the pending tasks of the failing domains into the next virtual queue,
+// which is paused so that those domains are throttled without affecting the other domains
+func (m *mitigatorImpl) handleQueueDomainTaskFailure(alert Alert) {
+	virtualQueues := m.virtualQueueManager.VirtualQueues()
+	stats := m.collectPendingTaskStats()
+
+	domainsToClear := make(map[VirtualSlice][]string)
+	for slice, perDomain := range stats.pendingTaskCountPerDomainPerSlice {
+		for domainID := range alert.AlertAttributesQueueDomainTaskFailure.TaskFailureRatePerDomain {
+			if perDomain[domainID] > 0 {
+				domainsToClear[slice] = append(domainsToClear[slice], domainID)
+			}
+		}
+		slices.Sort(domainsToClear[slice])
+	}
+	if len(domainsToClear) == 0 {
+		m.logger.Debug("mitigating queue alert, skip mitigation because the alert is no longer valid")
+		return
+	}
+
+	m.logger.Info("mitigating domain task failure",
+		tag.WorkflowDomainIDs(slices.Sorted(maps.Keys(alert.AlertAttributesQueueDomainTaskFailure.TaskFailureRatePerDomain))),
+		tag.Dynamic("alert", alert.String()),
+	)
+	m.processQueueSplitsAndClear(virtualQueues, domainsToClear)
+}
+
+// handleQueueScheduledLag moves the domain with the most pending tasks into the next virtual queue,
+// so that the tasks of the other domains are no longer delayed by it
+func (m *mitigatorImpl) handleQueueScheduledLag(alert Alert) {
+	virtualQueues := m.virtualQueueManager.VirtualQueues()
+	for _, virtualQueue := range virtualQueues {
+		virtualQueue.UpdateAndGetState()
+	}
+	stats := m.collectPendingTaskStats()
+
+	domainID := busiestDomain(stats.pendingTaskCountPerDomain)
+	if domainID == "" {
+		m.logger.Debug("mitigating queue alert, skip mitigation because there is no pending task")
+		return
+	}
+	domainsToClear := make(map[VirtualSlice][]string)
+	for _, slice := range stats.slicesPerDomain[domainID] {
+		domainsToClear[slice] = []string{domainID}
+	}
+
+	m.logger.Info("mitigating scheduled queue lag",
+		tag.WorkflowDomainID(domainID),
+		tag.Dynamic("alert", alert.String()),
+	)
+	m.processQueueSplitsAndClear(virtualQueues, domainsToClear)
+}
+
 // The stats of pending tasks are used to calculate the domains to clear. We need:
 // 1. The total number of pending tasks per domain
 // 2. The number of pending tasks per domain per slice
@@ -138,13 +238,16 @@ func (m *mitigatorImpl) collectPendingTaskStats() pendingTaskStats {
 	stats := pendingTaskStats{
 		pendingTaskCountPerDomain:         make(map[string]int),
 		pendingTaskCountPerDomainPerSlice: make(map[VirtualSlice]map[string]int),
+		failingTaskCountPerDomainPerSlice: make(map[VirtualSlice]map[string]int),
 		slicesPerDomain:                   make(map[string][]VirtualSlice),
 	}
 
 	for _, virtualQueue := range m.virtualQueueManager.VirtualQueues() {
 		virtualQueue.IterateSlices(func(slice VirtualSlice) {
-			perDomain := slice.PendingTaskStats().PendingTaskCountPerDomain
+			sliceStats := slice.PendingTaskStats()
+			perDomain := sliceStats.PendingTaskCountPerDomain
 			stats.pendingTaskCountPerDomainPerSlice[slice] = perDomain
+			stats.failingTaskCountPerDomainPerSlice[slice] = sliceStats.FailingTaskCountPerDomain
 			for domain, count := range perDomain {
 				stats.totalPendingTaskCount += count
 				stats.pendingTaskCountPerDomain[domain] += count
@@ -238,3 +341,17 @@ func (m *mitigatorImpl) processQueueSplitsAndClear(virtualQueues map[int64]Virtu
 		}
 	}
 }
+
+// busiestDomain returns the domain with the most pending tasks, or empty string if there is no pending task
+func busiestDomain(pendingTaskCountPerDomain map[string]int) string {
+	busiest := ""
+	for domainID, count := range pendingTaskCountPerDomain {
+		if count <= 0 {
+			continue
+		}
+		if busiest == "" || count > pendingTaskCountPerDomain[busiest] || (count == pendingTaskCountPerDomain[busiest] && domainID < busiest) {
+			busiest = domainID
+		}
+	}
+	return busiest
+}
@@ -44,7 +44,7 @@ func TestNewMitigator(t *testing.T) {
 
 	// Verify handlers are properly initialized
 	assert.NotNil(t, impl.handlers)
-	assert.Len(t, impl.handlers, 1)
+	assert.Len(t, impl.handlers, 4)
 	_, exists := impl.handlers[AlertTypeQueuePendingTaskCount]
 	assert.True(t, exists)
 }
@@ -733,3 +733,141 @@ func TestMitigator_processQueueSplitsAndClear(t *testing.T) {
 		})
 	}
 }
+
+func TestMitigator_handleQueueHealthAlerts(t *testing.T) {
+	type sliceSetup struct {
+		minTaskID     int64
+		pending       map[string]int
+		failing       map[string]int
+		domainsToMove []string
+	}
+	tests := []struct {
+		name   string
+		alert  Alert
+		slices []sliceSetup
+	}{
+		{
+			name: "stuck slice moves failing domains out of the oldest slice",
+			alert: Alert{
+				AlertType:                      AlertTypeQueueStuckSlice,
+				AlertAttributesQueueStuckSlice: &AlertAttributesQueueStuckSlice{},
+			},
+			slices: []sliceSetup{
+				{minTaskID: 100, pending: map[string]int{"domain1": 5, "domain2": 10, "domain3": 1}, failing: map[string]int{"domain1": 2, "domain3": 1}, domainsToMove: []string{"domain1", "domain3"}},
+				{minTaskID: 200, pending: map[string]int{"domain4": 50}, failing: map[string]int{"domain4": 50}},
+			},
+		},
+		{
+			name: "stuck slice moves the busiest domain when no task failed",
+			alert: Alert{
+				AlertType:                      AlertTypeQueueStuckSlice,
+				AlertAttributesQueueStuckSlice: &AlertAttributesQueueStuckSlice{},
+			},
+			slices: []sliceSetup{
+				{minTaskID: 100, pending: map[string]int{"domain1": 5, "domain2": 10}, domainsToMove: []string{"domain2"}},
+				{minTaskID: 200, pending: map[string]int{"domain3": 50}},
+			},
+		},
+		{
+			name: "stuck slice without pending tasks",
+			alert: Alert{
+				AlertType:                      AlertTypeQueueStuckSlice,
+				AlertAttributesQueueStuckSlice: &AlertAttributesQueueStuckSlice{},
+			},
+			slices: []sliceSetup{
+				{minTaskID: 100, pending: map[string]int{"domain1": 0}},
+			},
+		},
+		{
+			name: "domain task failure moves the failing domains out of all slices",
+			alert: Alert{
+				AlertType: AlertTypeQueueDomainTaskFailure,
+				AlertAttributesQueueDomainTaskFailure: &AlertAttributesQueueDomainTaskFailure{
+					TaskFailureRatePerDomain: map[string]float64{"domain2": 0.9},
+				},
+			},
+			slices: []sliceSetup{
+				{minTaskID: 100, pending: map[string]int{"domain1": 5, "domain2": 10}, domainsToMove: []string{"domain2"}},
+				{minTaskID: 200, pending: map[string]int{"domain1": 5}},
+				{minTaskID: 300, pending: map[string]int{"domain2": 3}, domainsToMove: []string{"domain2"}},
+			},
+		},
+		{
+			name: "scheduled lag moves the busiest domain out of all slices",
+			alert: Alert{
+				AlertType:                        AlertTypeQueueScheduledLag,
+				AlertAttributesQueueScheduledLag: &AlertAttributesQueueScheduledLag{},
+			},
+			slices: []sliceSetup{
+				{minTaskID: 100, pending: map[string]int{"domain1": 5, "domain2": 10}, domainsToMove: []string{"domain1"}},
+				{minTaskID: 200, pending: map[string]int{"domain1": 20}, domainsToMove: []string{"domain1"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			mockVirtualQueueManager := NewMockVirtualQueueManager(ctrl)
+			mockVirtualQueue := NewMockVirtualQueue(ctrl)
+			mockNextVirtualQueue := NewMockVirtualQueue(ctrl)
+			mockMonitor := NewMockMonitor(ctrl)
+
+			var virtualSlices []VirtualSlice
+			var expectedMovedSlices []any
+			for _, s := range tt.slices {
+				mockSlice := NewMockVirtualSlice(ctrl)
+				mockSlice.EXPECT().GetState().Return(VirtualSliceState{
+					Range: Range{
+						InclusiveMinTaskKey: persistence.NewImmediateTaskKey(s.minTaskID),
+						ExclusiveMaxTaskKey: persistence.NewImmediateTaskKey(s.minTaskID + 100),
+					},
+					Predicate: NewUniversalPredicate(),
+				}).AnyTimes()
+				mockSlice.EXPECT().PendingTaskStats().Return(PendingTaskStats{
+					PendingTaskCountPerDomain: s.pending,
+					FailingTaskCountPerDomain: s.failing,
+				}).AnyTimes()
+				if len(s.domainsToMove) > 0 {
+					mockSplitSlice := NewMockVirtualSlice(ctrl)
+					mockRemainingSlice := NewMockVirtualSlice(ctrl)
+					mockSlice.EXPECT().TrySplitByPredicate(NewDomainIDPredicate(s.domainsToMove, false)).Return(mockSplitSlice, mockRemainingSlice, true)
+					mockSplitSlice.EXPECT().Clear()
+					expectedMovedSlices = append(expectedMovedSlices, mockSplitSlice)
+				}
+				virtualSlices = append(virtualSlices, mockSlice)
+			}
+
+			mockVirtualQueueManager.EXPECT().VirtualQueues().Return(map[int64]VirtualQueue{0: mockVirtualQueue}).AnyTimes()
+			mockVirtualQueue.EXPECT().UpdateAndGetState().AnyTimes()
+			mockVirtualQueue.EXPECT().IterateSlices(gomock.Any()).Do(func(f func(VirtualSlice)) {
+				for _, s := range virtualSlices {
+					f(s)
+				}
+			}).AnyTimes()
+			mockVirtualQueue.EXPECT().SplitSlices(gomock.Any()).Do(func(f func(VirtualSlice) ([]VirtualSlice, bool)) {
+				for _, s := range virtualSlices {
+					f(s)
+				}
+			}).MaxTimes(1)
+			if len(expectedMovedSlices) > 0 {
+				mockVirtualQueueManager.EXPECT().GetOrCreateVirtualQueue(int64(1)).Return(mockNextVirtualQueue)
+				mockNextVirtualQueue.EXPECT().Pause(clearSliceThrottleDuration)
+				mockNextVirtualQueue.EXPECT().MergeSlices(expectedMovedSlices...)
+			}
+			mockMonitor.EXPECT().ResolveAlert(tt.alert.AlertType)
+
+			mitigator := NewMitigator(
+				mockVirtualQueueManager,
+				mockMonitor,
+				testlogger.New(t),
+				metrics.NoopScope,
+				&MitigatorOptions{
+					MaxVirtualQueueCount: dynamicproperties.GetIntPropertyFn(2),
+				},
+			)
+			mitigator.Mitigate(tt.alert)
+		})
+	}
+}
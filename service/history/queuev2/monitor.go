@@ -3,11 +3,18 @@ package queuev2
 
 import (
 	"sync"
+	"time"
 
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/persistence"
 )
 
+const (
+	// maxAlertRecords is the number of most recent alerts kept by the monitor for inspection
+	maxAlertRecords = 20
+)
+
 type (
 	Monitor interface {
 		Subscribe(chan<- *Alert)
@@ -16,33 +23,56 @@ type (
 		GetSlicePendingTaskCount(VirtualSlice) int
 		SetSlicePendingTaskCount(VirtualSlice, int)
 		RemoveSlice(VirtualSlice)
+		// SetAckLevel records the current exclusive ack level of the queue and checks whether the queue is stuck or lagging
+		SetAckLevel(persistence.HistoryTaskKey)
+		// SetPendingTaskStats records the pending task stats of the whole queue and checks whether the tasks of a domain keep failing
+		SetPendingTaskStats(PendingTaskStats)
+		// GetAlertRecords returns the most recent alerts, oldest first
+		GetAlertRecords() []AlertRecord
 		ResolveAlert(AlertType)
 	}
 
 	MonitorOptions struct {
 		EnablePendingTaskCountAlert func() bool
 		CriticalPendingTaskCount    dynamicproperties.IntPropertyFn
+
+		EnableStuckSliceAlert func() bool
+		CriticalStuckDuration dynamicproperties.DurationPropertyFn
+
+		EnableDomainTaskFailureAlert      func() bool
+		CriticalDomainTaskFailureRate     dynamicproperties.FloatPropertyFn
+		DomainTaskFailureRateMinTaskCount dynamicproperties.IntPropertyFn
+
+		EnableScheduledLagAlert func() bool
+		MaxScheduledLag         dynamicproperties.DurationPropertyFn
 	}
 
 	monitorImpl struct {
 		sync.Mutex
 
-		category persistence.HistoryTaskCategory
-		options  *MonitorOptions
+		category   persistence.HistoryTaskCategory
+		timeSource clock.TimeSource
+		options    *MonitorOptions
 
 		subscriber            chan<- *Alert
 		pendingAlerts         map[AlertType]struct{}
+		resolvedAt            map[AlertType]time.Time
+		alertRecords          []AlertRecord
 		totalPendingTaskCount int
 		slicePendingTaskCount map[VirtualSlice]int
+		ackLevel              persistence.HistoryTaskKey
+		ackLevelUpdatedAt     time.Time
 	}
 )
 
-func NewMonitor(category persistence.HistoryTaskCategory, options *MonitorOptions) Monitor {
+func NewMonitor(category persistence.HistoryTaskCategory, timeSource clock.TimeSource, options *MonitorOptions) Monitor {
 	return &monitorImpl{
-		category: category,
-		options:  options,
+		category:   category,
+		timeSource: timeSource,
+		options:    options,
 
 		pendingAlerts:         make(map[AlertType]struct{}),
+		resolvedAt:            make(map[AlertType]time.Time),
 		totalPendingTaskCount: 0,
 		slicePendingTaskCount: make(map[VirtualSlice]int),
 	}
@@ -103,11 +133,109 @@ func (m *monitorImpl) RemoveSlice(slice VirtualSlice) {
 	}
 }
 
+func (m *monitorImpl) SetAckLevel(ackLevel persistence.HistoryTaskKey) {
+	m.Lock()
+	defer m.Unlock()
+
+	now := m.timeSource.Now()
+	if m.ackLevelUpdatedAt.IsZero() || ackLevel.Compare(m.ackLevel) != 0 {
+		m.ackLevel = ackLevel
+		m.ackLevelUpdatedAt = now
+	}
+	if m.totalPendingTaskCount == 0 {
+		return
+	}
+
+	criticalStuckDuration := m.options.CriticalStuckDuration()
+	if m.options.EnableStuckSliceAlert() && criticalStuckDuration > 0 {
+		// a mitigation restarts the clock, otherwise the same stuck tasks would be mitigated over and over again
+		stuckSince := m.ackLevelUpdatedAt
+		if resolvedAt := m.resolvedAt[AlertTypeQueueStuckSlice]; resolvedAt.After(stuckSince) {
+			stuckSince = resolvedAt
+		}
+		if stuckDuration := now.Sub(stuckSince); stuckDuration > criticalStuckDuration {
+			m.sendAlertLocked(&Alert{
+				AlertType: AlertTypeQueueStuckSlice,
+				AlertAttributesQueueStuckSlice: &AlertAttributesQueueStuckSlice{
+					ExclusiveAckLevel:     ackLevel,
+					StuckDuration:         stuckDuration,
+					CriticalStuckDuration: criticalStuckDuration,
+				},
+			})
+		}
+	}
+
+	if m.category.Type() != persistence.HistoryTaskCategoryTypeScheduled {
+		return
+	}
+	maxLag := m.options.MaxScheduledLag()
+	if m.options.EnableScheduledLagAlert() && maxLag > 0 && now.Sub(m.resolvedAt[AlertTypeQueueScheduledLag]) > maxLag {
+		if lag := now.Sub(ackLevel.GetScheduledTime()); lag > maxLag {
+			m.sendAlertLocked(&Alert{
+				AlertType: AlertTypeQueueScheduledLag,
+				AlertAttributesQueueScheduledLag: &AlertAttributesQueueScheduledLag{
+					ExclusiveAckLevel: ackLevel,
+					Lag:               lag,
+					MaxLag:            maxLag,
+				},
+			})
+		}
+	}
+}
+
+func (m *monitorImpl) SetPendingTaskStats(stats PendingTaskStats) {
+	m.Lock()
+	defer m.Unlock()
+
+	criticalRate := m.options.CriticalDomainTaskFailureRate()
+	if !m.options.EnableDomainTaskFailureAlert() || criticalRate <= 0 {
+		return
+	}
+	minTaskCount := m.options.DomainTaskFailureRateMinTaskCount()
+	failureRatePerDomain := make(map[string]float64)
+	for domainID, pendingCount := range stats.PendingTaskCountPerDomain {
+		if pendingCount == 0 || pendingCount < minTaskCount {
+			continue
+		}
+		rate := float64(stats.FailingTaskCountPerDomain[domainID]) / float64(pendingCount)
+		if rate >= criticalRate {
+			failureRatePerDomain[domainID] = rate
+		}
+	}
+	if len(failureRatePerDomain) == 0 {
+		return
+	}
+	m.sendAlertLocked(&Alert{
+		AlertType: AlertTypeQueueDomainTaskFailure,
+		AlertAttributesQueueDomainTaskFailure: &AlertAttributesQueueDomainTaskFailure{
+			TaskFailureRatePerDomain: failureRatePerDomain,
+			CriticalTaskFailureRate:  criticalRate,
+		},
+	})
+}
+
+func (m *monitorImpl) GetAlertRecords() []AlertRecord {
+	m.Lock()
+	defer m.Unlock()
+
+	records := make([]AlertRecord, len(m.alertRecords))
+	copy(records, m.alertRecords)
+	return records
+}
+
 func (m *monitorImpl) ResolveAlert(alertType AlertType) {
 	m.Lock()
 	defer m.Unlock()
 
 	delete(m.pendingAlerts, alertType)
+	now := m.timeSource.Now()
+	m.resolvedAt[alertType] = now
+	for i := len(m.alertRecords) - 1; i >= 0; i-- {
+		if m.alertRecords[i].Alert.AlertType == alertType && m.alertRecords[i].ResolvedAt.IsZero() {
+			m.alertRecords[i].ResolvedAt = now
+			break
+		}
+	}
 }
 
 func (m *monitorImpl) sendAlertLocked(alert *Alert) {
@@ -119,6 +247,10 @@ func (m *monitorImpl) sendAlertLocked(alert *Alert) {
 	select {
 	case m.subscriber <- alert:
 		m.pendingAlerts[alert.AlertType] = struct{}{}
+		m.alertRecords = append(m.alertRecords, AlertRecord{Alert: *alert, RaisedAt: m.timeSource.Now()})
+		if len(m.alertRecords) > maxAlertRecords {
+			m.alertRecords = m.alertRecords[len(m.alertRecords)-maxAlertRecords:]
+		}
 	default:
 		// do not block if subscriber is not ready
 	}
@@ -13,6 +13,8 @@ import (
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
+
+	persistence "github.com/uber/cadence/common/persistence"
 )
 
 // MockMonitor is a mock of Monitor interface.
@@ -79,6 +81,20 @@ func (mr *MockMonitorMockRecorder) RemoveSlice(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSlice", reflect.TypeOf((*MockMonitor)(nil).RemoveSlice), arg0)
 }
 
+// GetAlertRecords mocks base method.
+func (m *MockMonitor) GetAlertRecords() []AlertRecord {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlertRecords")
+	ret0, _ := ret[0].([]AlertRecord)
+	return ret0
+}
+
+// GetAlertRecords indicates an expected call of GetAlertRecords.
+func (mr *MockMonitorMockRecorder) GetAlertRecords() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlertRecords", reflect.TypeOf((*MockMonitor)(nil).GetAlertRecords))
+}
+
 // ResolveAlert mocks base method.
 func (m *MockMonitor) ResolveAlert(arg0 AlertType) {
 	m.ctrl.T.Helper()
@@ -91,6 +107,30 @@ func (mr *MockMonitorMockRecorder) ResolveAlert(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveAlert", reflect.TypeOf((*MockMonitor)(nil).ResolveAlert), arg0)
 }
 
+// SetAckLevel mocks base method.
+func (m *MockMonitor) SetAckLevel(arg0 persistence.HistoryTaskKey) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetAckLevel", arg0)
+}
+
+// SetAckLevel indicates an expected call of SetAckLevel.
+func (mr *MockMonitorMockRecorder) SetAckLevel(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAckLevel", reflect.TypeOf((*MockMonitor)(nil).SetAckLevel), arg0)
+}
+
+// SetPendingTaskStats mocks base method.
+func (m *MockMonitor) SetPendingTaskStats(arg0 PendingTaskStats) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetPendingTaskStats", arg0)
+}
+
+// SetPendingTaskStats indicates an expected call of SetPendingTaskStats.
+func (mr *MockMonitorMockRecorder) SetPendingTaskStats(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPendingTaskStats", reflect.TypeOf((*MockMonitor)(nil).SetPendingTaskStats), arg0)
+}
+
 // SetSlicePendingTaskCount mocks base method.
 func (m *MockMonitor) SetSlicePendingTaskCount(arg0 VirtualSlice, arg1 int) {
 	m.ctrl.T.Helper()
@@ -2,15 +2,18 @@ package queuev2
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/persistence"
 )
 
 func TestMonitorPendingTaskCount(t *testing.T) {
-	monitor := NewMonitor(persistence.HistoryTaskCategoryTimer, &MonitorOptions{
+	monitor := NewMonitor(persistence.HistoryTaskCategoryTimer, clock.NewMockedTimeSource(), &MonitorOptions{
 		CriticalPendingTaskCount:    dynamicproperties.GetIntPropertyFn(100),
 		EnablePendingTaskCountAlert: func() bool { return true },
 	})
@@ -69,7 +72,7 @@ func TestMonitorPendingTaskCount(t *testing.T) {
 }
 
 func TestMonitorSubscribeAndUnsubscribe(t *testing.T) {
-	monitor := NewMonitor(persistence.HistoryTaskCategoryTimer, &MonitorOptions{})
+	monitor := NewMonitor(persistence.HistoryTaskCategoryTimer, clock.NewMockedTimeSource(), &MonitorOptions{})
 
 	alertCh := make(chan *Alert, alertChSize)
 	monitor.Subscribe(alertCh)
@@ -82,7 +85,7 @@ func TestMonitorSubscribeAndUnsubscribe(t *testing.T) {
 }
 
 func TestMonitorResolveAlert(t *testing.T) {
-	monitor := NewMonitor(persistence.HistoryTaskCategoryTimer, &MonitorOptions{})
+	monitor := NewMonitor(persistence.HistoryTaskCategoryTimer, clock.NewMockedTimeSource(), &MonitorOptions{})
 
 	monitor.(*monitorImpl).pendingAlerts[AlertTypeQueuePendingTaskCount] = struct{}{}
 	assert.Equal(t, 1, len(monitor.(*monitorImpl).pendingAlerts))
@@ -90,3 +93,144 @@ func TestMonitorResolveAlert(t *testing.T) {
 	monitor.ResolveAlert(AlertTypeQueuePendingTaskCount)
 	assert.Equal(t, 0, len(monitor.(*monitorImpl).pendingAlerts))
 }
+
+func TestMonitorStuckSlice(t *testing.T) {
+	timeSource := clock.NewMockedTimeSource()
+	monitor := NewMonitor(persistence.HistoryTaskCategoryTransfer, timeSource, &MonitorOptions{
+		CriticalPendingTaskCount:    dynamicproperties.GetIntPropertyFn(100),
+		EnablePendingTaskCountAlert: func() bool { return true },
+		EnableStuckSliceAlert:       func() bool { return true },
+		CriticalStuckDuration:       dynamicproperties.GetDurationPropertyFn(time.Minute),
+		EnableScheduledLagAlert:     func() bool { return true },
+		MaxScheduledLag:             dynamicproperties.GetDurationPropertyFn(time.Minute),
+	})
+	alertCh := make(chan *Alert, alertChSize)
+	monitor.Subscribe(alertCh)
+	slice := &virtualSliceImpl{}
+
+	// no alert without pending tasks
+	monitor.SetAckLevel(persistence.NewImmediateTaskKey(10))
+	timeSource.Advance(2 * time.Minute)
+	monitor.SetAckLevel(persistence.NewImmediateTaskKey(10))
+	assert.Empty(t, alertCh)
+
+	// the ack level progresses
+	monitor.SetSlicePendingTaskCount(slice, 10)
+	monitor.SetAckLevel(persistence.NewImmediateTaskKey(20))
+	timeSource.Advance(30 * time.Second)
+	monitor.SetAckLevel(persistence.NewImmediateTaskKey(20))
+	assert.Empty(t, alertCh)
+
+	// the ack level is stuck
+	timeSource.Advance(time.Minute)
+	monitor.SetAckLevel(persistence.NewImmediateTaskKey(20))
+	alert := <-alertCh
+	assert.Equal(t, AlertTypeQueueStuckSlice, alert.AlertType)
+	assert.Equal(t, persistence.NewImmediateTaskKey(20), alert.AlertAttributesQueueStuckSlice.ExclusiveAckLevel)
+	assert.Equal(t, 90*time.Second, alert.AlertAttributesQueueStuckSlice.StuckDuration)
+	assert.Equal(t, time.Minute, alert.AlertAttributesQueueStuckSlice.CriticalStuckDuration)
+
+	// the mitigation restarts the clock
+	monitor.ResolveAlert(AlertTypeQueueStuckSlice)
+	timeSource.Advance(30 * time.Second)
+	monitor.SetAckLevel(persistence.NewImmediateTaskKey(20))
+	assert.Empty(t, alertCh)
+	timeSource.Advance(time.Minute)
+	monitor.SetAckLevel(persistence.NewImmediateTaskKey(20))
+	alert = <-alertCh
+	assert.Equal(t, AlertTypeQueueStuckSlice, alert.AlertType)
+}
+
+func TestMonitorScheduledLag(t *testing.T) {
+	timeSource := clock.NewMockedTimeSource()
+	monitor := NewMonitor(persistence.HistoryTaskCategoryTimer, timeSource, &MonitorOptions{
+		CriticalPendingTaskCount:    dynamicproperties.GetIntPropertyFn(100),
+		EnablePendingTaskCountAlert: func() bool { return true },
+		EnableStuckSliceAlert:       func() bool { return false },
+		CriticalStuckDuration:       dynamicproperties.GetDurationPropertyFn(time.Minute),
+		EnableScheduledLagAlert:     func() bool { return true },
+		MaxScheduledLag:             dynamicproperties.GetDurationPropertyFn(time.Minute),
+	})
+	alertCh := make(chan *Alert, alertChSize)
+	monitor.Subscribe(alertCh)
+	monitor.SetSlicePendingTaskCount(&virtualSliceImpl{}, 10)
+
+	now := timeSource.Now()
+	monitor.SetAckLevel(persistence.NewHistoryTaskKey(now.Add(-30*time.Second), 0))
+	assert.Empty(t, alertCh)
+
+	ackLevel := persistence.NewHistoryTaskKey(now.Add(-2*time.Minute), 0)
+	monitor.SetAckLevel(ackLevel)
+	alert := <-alertCh
+	assert.Equal(t, AlertTypeQueueScheduledLag, alert.AlertType)
+	assert.Equal(t, ackLevel, alert.AlertAttributesQueueScheduledLag.ExclusiveAckLevel)
+	assert.Equal(t, 2*time.Minute, alert.AlertAttributesQueueScheduledLag.Lag)
+	assert.Equal(t, time.Minute, alert.AlertAttributesQueueScheduledLag.MaxLag)
+
+	// the lag is not checked again until max lag has passed since the mitigation
+	monitor.ResolveAlert(AlertTypeQueueScheduledLag)
+	monitor.SetAckLevel(ackLevel)
+	assert.Empty(t, alertCh)
+	timeSource.Advance(2 * time.Minute)
+	monitor.SetAckLevel(ackLevel)
+	alert = <-alertCh
+	assert.Equal(t, AlertTypeQueueScheduledLag, alert.AlertType)
+}
+
+func TestMonitorDomainTaskFailure(t *testing.T) {
+	monitor := NewMonitor(persistence.HistoryTaskCategoryTransfer, clock.NewMockedTimeSource(), &MonitorOptions{
+		EnableDomainTaskFailureAlert:      func() bool { return true },
+		CriticalDomainTaskFailureRate:     dynamicproperties.GetFloatPropertyFn(0.5),
+		DomainTaskFailureRateMinTaskCount: dynamicproperties.GetIntPropertyFn(10),
+	})
+	alertCh := make(chan *Alert, alertChSize)
+	monitor.Subscribe(alertCh)
+
+	monitor.SetPendingTaskStats(PendingTaskStats{
+		PendingTaskCountPerDomain: map[string]int{"domain1": 20, "domain2": 5},
+		FailingTaskCountPerDomain: map[string]int{"domain1": 5, "domain2": 5},
+	})
+	assert.Empty(t, alertCh)
+
+	monitor.SetPendingTaskStats(PendingTaskStats{
+		PendingTaskCountPerDomain: map[string]int{"domain1": 20, "domain2": 5, "domain3": 10},
+		FailingTaskCountPerDomain: map[string]int{"domain1": 15, "domain2": 5, "domain3": 1},
+	})
+	alert := <-alertCh
+	assert.Equal(t, AlertTypeQueueDomainTaskFailure, alert.AlertType)
+	assert.Equal(t, map[string]float64{"domain1": 0.75}, alert.AlertAttributesQueueDomainTaskFailure.TaskFailureRatePerDomain)
+	assert.Equal(t, 0.5, alert.AlertAttributesQueueDomainTaskFailure.CriticalTaskFailureRate)
+}
+
+func TestMonitorAlertRecords(t *testing.T) {
+	timeSource := clock.NewMockedTimeSource()
+	monitor := NewMonitor(persistence.HistoryTaskCategoryTimer, timeSource, &MonitorOptions{
+		CriticalPendingTaskCount:    dynamicproperties.GetIntPropertyFn(10),
+		EnablePendingTaskCountAlert: func() bool { return true },
+	})
+	alertCh := make(chan *Alert, maxAlertRecords+2)
+	monitor.Subscribe(alertCh)
+	slice := &virtualSliceImpl{}
+
+	raisedAt := timeSource.Now()
+	monitor.SetSlicePendingTaskCount(slice, 11)
+	timeSource.Advance(time.Second)
+	monitor.ResolveAlert(AlertTypeQueuePendingTaskCount)
+	monitor.SetSlicePendingTaskCount(slice, 12)
+
+	records := monitor.GetAlertRecords()
+	require.Len(t, records, 2)
+	assert.Equal(t, AlertTypeQueuePendingTaskCount, records[0].Alert.AlertType)
+	assert.Equal(t, raisedAt, records[0].RaisedAt)
+	assert.Equal(t, raisedAt.Add(time.Second), records[0].ResolvedAt)
+	assert.Equal(t, 12, records[1].Alert.AlertAttributesQueuePendingTaskCount.CurrentPendingTaskCount)
+	assert.True(t, records[1].ResolvedAt.IsZero())
+
+	for i := 0; i < maxAlertRecords; i++ {
+		monitor.ResolveAlert(AlertTypeQueuePendingTaskCount)
+		monitor.SetSlicePendingTaskCount(slice, 13+i)
+	}
+	records = monitor.GetAlertRecords()
+	require.Len(t, records, maxAlertRecords)
+	assert.Equal(t, 13, records[0].Alert.AlertAttributesQueuePendingTaskCount.CurrentPendingTaskCount)
+}
@@ -24,6 +24,10 @@ package queuev2
 
 import (
 	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
 	"time"
 
 	"github.com/uber/cadence/common/backoff"
@@ -33,7 +37,9 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/queueedit"
 	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/queue"
 	"github.com/uber/cadence/service/history/shard"
 	"github.com/uber/cadence/service/history/task"
@@ -62,9 +68,16 @@ type (
 		PollBackoffIntervalJitterCoefficient dynamicproperties.FloatPropertyFn
 		VirtualSliceForceAppendInterval      dynamicproperties.DurationPropertyFn
 		// monitor & mitigator options
-		CriticalPendingTaskCount    dynamicproperties.IntPropertyFn
-		EnablePendingTaskCountAlert func() bool
-		MaxVirtualQueueCount        dynamicproperties.IntPropertyFn
+		CriticalPendingTaskCount          dynamicproperties.IntPropertyFn
+		EnablePendingTaskCountAlert       func() bool
+		MaxVirtualQueueCount              dynamicproperties.IntPropertyFn
+		EnableStuckSliceAlert             func() bool
+		CriticalStuckDuration             dynamicproperties.DurationPropertyFn
+		EnableDomainTaskFailureAlert      func() bool
+		CriticalDomainTaskFailureRate     dynamicproperties.FloatPropertyFn
+		DomainTaskFailureRateMinTaskCount dynamicproperties.IntPropertyFn
+		EnableScheduledLagAlert           func() bool
+		MaxScheduledLag                   dynamicproperties.DurationPropertyFn
 
 		EnableValidator        dynamicproperties.BoolPropertyFn
 		ValidationInterval     dynamicproperties.DurationPropertyFn
@@ -91,6 +104,10 @@ type (
 		exclusiveAckLevel     persistence.HistoryTaskKey
 		alertCh               chan *Alert
 		newVirtualSliceState  VirtualSliceState
+		// newVirtualSliceStateLock guards the updates of newVirtualSliceState against the reads from other goroutines
+		newVirtualSliceStateLock sync.RWMutex
+		// virtualQueueEditLock serializes manual edits with the mitigation of alerts
+		virtualQueueEditLock sync.Mutex
 
 		updateQueueStateFn func(ctx context.Context)
 	}
@@ -124,7 +141,7 @@ func newQueueBase(
 	var queueType task.QueueType
 	if category == persistence.HistoryTaskCategoryTransfer {
 		queueType = task.QueueTypeTransfer
-	} else if category == persistence.HistoryTaskCategoryTimer {
+	} else if category == persistence.HistoryTaskCategoryTimer || category == persistence.HistoryTaskCategoryHousekeeping {
 		queueType = task.QueueTypeTimer
 	}
 	taskInitializer := func(t persistence.Task) task.Task {
@@ -145,9 +162,17 @@ func newQueueBase(
 	)
 	monitor := NewMonitor(
 		category,
+		timeSource,
 		&MonitorOptions{
-			CriticalPendingTaskCount:    options.CriticalPendingTaskCount,
-			EnablePendingTaskCountAlert: options.EnablePendingTaskCountAlert,
+			CriticalPendingTaskCount:          options.CriticalPendingTaskCount,
+			EnablePendingTaskCountAlert:       options.EnablePendingTaskCountAlert,
+			EnableStuckSliceAlert:             options.EnableStuckSliceAlert,
+			CriticalStuckDuration:             options.CriticalStuckDuration,
+			EnableDomainTaskFailureAlert:      options.EnableDomainTaskFailureAlert,
+			CriticalDomainTaskFailureRate:     options.CriticalDomainTaskFailureRate,
+			DomainTaskFailureRateMinTaskCount: options.DomainTaskFailureRateMinTaskCount,
+			EnableScheduledLagAlert:           options.EnableScheduledLagAlert,
+			MaxScheduledLag:                   options.MaxScheduledLag,
 		},
 	)
 	virtualQueueManager := NewVirtualQueueManager(
@@ -248,7 +273,146 @@ func (q *queueBase) FailoverDomain(domainIDs map[string]struct{}) {
 }
 
 func (q *queueBase) HandleAction(ctx context.Context, clusterName string, action *queue.Action) (*queue.ActionResult, error) {
-	return nil, nil
+	switch action.ActionType {
+	case queue.ActionTypeGetState:
+		return q.describeVirtualQueues()
+	case queue.ActionTypeEditVirtualQueue:
+		if err := q.editVirtualQueue(action.EditVirtualQueueAttributes.Edit); err != nil {
+			return nil, err
+		}
+		return &queue.ActionResult{ActionType: queue.ActionTypeEditVirtualQueue}, nil
+	case queue.ActionTypeIsDomainDrained:
+		return q.isDomainDrained(action.IsDomainDrainedAttributes), nil
+	default:
+		return nil, nil
+	}
+}
+
+func (q *queueBase) describeVirtualQueues() (*queue.ActionResult, error) {
+	virtualQueues := q.virtualQueueManager.VirtualQueues()
+	queueIDs := slices.Sorted(maps.Keys(virtualQueues))
+	descriptions := make([]string, 0, len(queueIDs)+1)
+	for _, queueID := range queueIDs {
+		virtualQueue := virtualQueues[queueID]
+		description := &queueedit.VirtualQueueDescription{
+			VirtualQueueID: queueID,
+			Paused:         virtualQueue.IsPaused(),
+			Slices:         []queueedit.SliceDescription{},
+		}
+		virtualQueue.IterateSlices(func(slice VirtualSlice) {
+			state := slice.GetState()
+			description.Slices = append(description.Slices, queueedit.SliceDescription{
+				Range:                     ToPersistenceTaskRange(state.Range),
+				Predicate:                 ToPersistencePredicate(state.Predicate),
+				PendingTaskCount:          slice.GetPendingTaskCount(),
+				PendingTaskCountPerDomain: slice.PendingTaskStats().PendingTaskCountPerDomain,
+			})
+		})
+		value, err := queueedit.EncodeDescription(description)
+		if err != nil {
+			return nil, err
+		}
+		descriptions = append(descriptions, value)
+	}
+	for _, record := range q.monitor.GetAlertRecords() {
+		resolved := "unresolved"
+		if !record.ResolvedAt.IsZero() {
+			resolved = "mitigated at " + record.ResolvedAt.Format(time.RFC3339)
+		}
+		descriptions = append(descriptions, fmt.Sprintf("Alert %v: raised at %s, %s", record.Alert, record.RaisedAt.Format(time.RFC3339), resolved))
+	}
+	return &queue.ActionResult{
+		ActionType: queue.ActionTypeGetState,
+		GetStateActionResult: &queue.GetStateActionResult{
+			Descriptions: descriptions,
+		},
+	}, nil
+}
+
+// isDomainDrained checks that the tasks before the key have been assigned to virtual slices,
+// and that every virtual slice has read them and has no pending task of the domain before the key
+func (q *queueBase) isDomainDrained(attributes *queue.IsDomainDrainedAttributes) *queue.ActionResult {
+	q.newVirtualSliceStateLock.RLock()
+	exclusiveMaxReadLevel := q.newVirtualSliceState.Range.InclusiveMinTaskKey
+	q.newVirtualSliceStateLock.RUnlock()
+
+	drained := exclusiveMaxReadLevel.Compare(attributes.ExclusiveMaxTaskKey) >= 0
+	for _, virtualQueue := range q.virtualQueueManager.VirtualQueues() {
+		virtualQueue.IterateSlices(func(slice VirtualSlice) {
+			if !slice.IsDomainDrained(attributes.DomainID, attributes.ExclusiveMaxTaskKey) {
+				drained = false
+			}
+		})
+	}
+	return &queue.ActionResult{
+		ActionType:            queue.ActionTypeIsDomainDrained,
+		IsDomainDrainedResult: &queue.IsDomainDrainedResult{Drained: drained},
+	}
+}
+
+// editVirtualQueue applies a manual edit to the virtual queues, the new queue state is persisted by the next queue state update
+func (q *queueBase) editVirtualQueue(edit *queueedit.Edit) error {
+	q.virtualQueueEditLock.Lock()
+	defer q.virtualQueueEditLock.Unlock()
+
+	virtualQueue, ok := q.virtualQueueManager.VirtualQueues()[edit.VirtualQueueID]
+	if !ok {
+		return &types.BadRequestError{Message: fmt.Sprintf("virtual queue %d not found", edit.VirtualQueueID)}
+	}
+	if edit.Operation == queueedit.OperationSplit || edit.Operation == queueedit.OperationMerge {
+		if maxQueueID := int64(q.options.MaxVirtualQueueCount() - 1); edit.TargetVirtualQueueID > maxQueueID {
+			return &types.BadRequestError{Message: fmt.Sprintf("target virtual queue %d exceeds the max virtual queue ID %d", edit.TargetVirtualQueueID, maxQueueID)}
+		}
+	}
+
+	q.logger.Info("editing virtual queue", tag.Dynamic("queue-edit", edit))
+	switch edit.Operation {
+	case queueedit.OperationSplit:
+		return q.splitVirtualSlice(virtualQueue, edit)
+	case queueedit.OperationMerge:
+		var slicesToMove []VirtualSlice
+		virtualQueue.SplitSlices(func(slice VirtualSlice) ([]VirtualSlice, bool) {
+			slice.Clear()
+			slicesToMove = append(slicesToMove, slice)
+			return nil, true
+		})
+		if len(slicesToMove) > 0 {
+			q.virtualQueueManager.GetOrCreateVirtualQueue(edit.TargetVirtualQueueID).MergeSlices(slicesToMove...)
+		}
+	case queueedit.OperationPause:
+		virtualQueue.Pause(edit.PauseDuration)
+	case queueedit.OperationResume:
+		virtualQueue.Resume()
+	default:
+		return &types.BadRequestError{Message: fmt.Sprintf("unknown queue edit operation %q", edit.Operation)}
+	}
+	return nil
+}
+
+func (q *queueBase) splitVirtualSlice(virtualQueue VirtualQueue, edit *queueedit.Edit) error {
+	var sliceToMove VirtualSlice
+	sliceIndex := 0
+	virtualQueue.SplitSlices(func(slice VirtualSlice) ([]VirtualSlice, bool) {
+		defer func() { sliceIndex++ }()
+		if sliceIndex != edit.SliceIndex {
+			return nil, false
+		}
+		splitSlice, remainingSlice, ok := slice.TrySplitByPredicate(NewDomainIDPredicate(edit.DomainIDs, false))
+		if !ok {
+			// the slice only contains tasks of the given domains
+			slice.Clear()
+			sliceToMove = slice
+			return nil, true
+		}
+		splitSlice.Clear()
+		sliceToMove = splitSlice
+		return []VirtualSlice{remainingSlice}, true
+	})
+	if edit.SliceIndex >= sliceIndex {
+		return &types.BadRequestError{Message: fmt.Sprintf("slice %d not found in virtual queue %d which has %d slices", edit.SliceIndex, edit.VirtualQueueID, sliceIndex)}
+	}
+	q.virtualQueueManager.GetOrCreateVirtualQueue(edit.TargetVirtualQueueID).MergeSlices(sliceToMove)
+	return nil
 }
 
 func (q *queueBase) LockTaskProcessing() {}
@@ -265,7 +429,9 @@ func (q *queueBase) processNewTasks() bool {
 	if !ok {
 		return false
 	}
+	q.newVirtualSliceStateLock.Lock()
 	q.newVirtualSliceState = remainingVirtualSliceState
+	q.newVirtualSliceStateLock.Unlock()
 
 	newVirtualSlice := NewVirtualSlice(newVirtualSliceState, q.taskInitializer, q.queueReader, NewPendingTaskTracker(), q.logger)
 
@@ -295,6 +461,11 @@ func (q *queueBase) updateQueueState(ctx context.Context) {
 	// TODO: review the metrics and remove this comment or change the metric from gauge to histogram
 	q.metricsScope.UpdateGauge(metrics.PendingTaskGauge, float64(pendingTaskCount))
 
+	q.monitor.SetAckLevel(newExclusiveAckLevel)
+	if q.options.EnableDomainTaskFailureAlert() {
+		q.monitor.SetPendingTaskStats(q.getPendingTaskStats())
+	}
+
 	q.logger.Debug("complete history tasks", tag.Dynamic("oldExclusiveAckLevel", q.exclusiveAckLevel), tag.Dynamic("newExclusiveAckLevel", newExclusiveAckLevel))
 	if newExclusiveAckLevel.Compare(q.exclusiveAckLevel) > 0 {
 		q.metricsScope.IncCounter(metrics.TaskBatchCompleteCounter)
@@ -347,10 +518,31 @@ func (q *queueBase) handleAlert(ctx context.Context, alert *Alert) {
 		return
 	}
 
+	q.virtualQueueEditLock.Lock()
 	q.mitigator.Mitigate(*alert)
+	q.virtualQueueEditLock.Unlock()
 	q.updateQueueStateFn(ctx)
 }
 
+func (q *queueBase) getPendingTaskStats() PendingTaskStats {
+	stats := PendingTaskStats{
+		PendingTaskCountPerDomain: make(map[string]int),
+		FailingTaskCountPerDomain: make(map[string]int),
+	}
+	for _, virtualQueue := range q.virtualQueueManager.VirtualQueues() {
+		virtualQueue.IterateSlices(func(slice VirtualSlice) {
+			sliceStats := slice.PendingTaskStats()
+			for domainID, count := range sliceStats.PendingTaskCountPerDomain {
+				stats.PendingTaskCountPerDomain[domainID] += count
+			}
+			for domainID, count := range sliceStats.FailingTaskCountPerDomain {
+				stats.FailingTaskCountPerDomain[domainID] += count
+			}
+		})
+	}
+	return stats
+}
+
 func getExclusiveAckLevelAndMaxQueueIDFromQueueState(state *QueueState) (persistence.HistoryTaskKey, int64) {
 	maxQueueID := int64(0)
 	newExclusiveAckLevel := state.ExclusiveMaxReadLevel
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
 	"github.com/uber/cadence/common/clock"
@@ -14,7 +15,9 @@ import (
 	"github.com/uber/cadence/common/log/testlogger"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/queueedit"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/queue"
 	"github.com/uber/cadence/service/history/shard"
 	"github.com/uber/cadence/service/history/task"
 )
@@ -203,6 +206,7 @@ func TestQueueBase_UpdateQueueState(t *testing.T) {
 				mockMitigator := NewMockMitigator(ctrl)
 
 				mockMonitor.EXPECT().GetTotalPendingTaskCount().Return(100).Times(1)
+				mockMonitor.EXPECT().SetAckLevel(gomock.Any()).Times(1)
 				mockShard.EXPECT().GetExecutionManager().Return(mockExecutionManager).AnyTimes()
 				mockExecutionManager.EXPECT().RangeCompleteHistoryTask(gomock.Any(), &persistence.RangeCompleteHistoryTaskRequest{
 					TaskCategory:        persistence.HistoryTaskCategoryTransfer,
@@ -255,6 +259,7 @@ func TestQueueBase_UpdateQueueState(t *testing.T) {
 				mockMitigator := NewMockMitigator(ctrl)
 
 				mockMonitor.EXPECT().GetTotalPendingTaskCount().Return(100).Times(1)
+				mockMonitor.EXPECT().SetAckLevel(gomock.Any()).Times(1)
 				mockShard.EXPECT().GetExecutionManager().Return(mockExecutionManager).AnyTimes()
 				mockExecutionManager.EXPECT().RangeCompleteHistoryTask(gomock.Any(), &persistence.RangeCompleteHistoryTaskRequest{
 					TaskCategory:        persistence.HistoryTaskCategoryTransfer,
@@ -300,6 +305,7 @@ func TestQueueBase_UpdateQueueState(t *testing.T) {
 				mockMitigator := NewMockMitigator(ctrl)
 
 				mockMonitor.EXPECT().GetTotalPendingTaskCount().Return(100).Times(1)
+				mockMonitor.EXPECT().SetAckLevel(gomock.Any()).Times(1)
 				mockShard.EXPECT().UpdateQueueState(
 					persistence.HistoryTaskCategoryTransfer,
 					gomock.Any(),
@@ -342,6 +348,7 @@ func TestQueueBase_UpdateQueueState(t *testing.T) {
 				mockMitigator := NewMockMitigator(ctrl)
 
 				mockMonitor.EXPECT().GetTotalPendingTaskCount().Return(100).Times(1)
+				mockMonitor.EXPECT().SetAckLevel(gomock.Any()).Times(1)
 				mockShard.EXPECT().UpdateQueueState(
 					persistence.HistoryTaskCategoryTransfer,
 					gomock.Any(),
@@ -389,6 +396,7 @@ func TestQueueBase_UpdateQueueState(t *testing.T) {
 					DeleteBatchSize:                    dynamicproperties.GetIntPropertyFn(100),
 					UpdateAckInterval:                  dynamicproperties.GetDurationPropertyFn(time.Second * 10),
 					UpdateAckIntervalJitterCoefficient: dynamicproperties.GetFloatPropertyFn(0.1),
+					EnableDomainTaskFailureAlert:       func() bool { return false },
 				},
 			}
 
@@ -545,3 +553,241 @@ func TestNewQueueBase(t *testing.T) {
 		},
 	}, states)
 }
+
+func TestQueueBase_HandleAction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockVirtualQueueManager := NewMockVirtualQueueManager(ctrl)
+	mockVirtualQueue0 := NewMockVirtualQueue(ctrl)
+	mockVirtualQueue1 := NewMockVirtualQueue(ctrl)
+	mockVirtualSlice := NewMockVirtualSlice(ctrl)
+	mockMonitor := NewMockMonitor(ctrl)
+	raisedAt := time.Unix(1000, 0).UTC()
+
+	mockVirtualQueueManager.EXPECT().VirtualQueues().Return(map[int64]VirtualQueue{
+		0: mockVirtualQueue0,
+		1: mockVirtualQueue1,
+	})
+	mockVirtualQueue0.EXPECT().IsPaused().Return(false)
+	mockVirtualQueue0.EXPECT().IterateSlices(gomock.Any()).Do(func(f func(VirtualSlice)) {
+		f(mockVirtualSlice)
+	})
+	mockVirtualSlice.EXPECT().GetState().Return(VirtualSliceState{
+		Range: Range{
+			InclusiveMinTaskKey: persistence.NewImmediateTaskKey(100),
+			ExclusiveMaxTaskKey: persistence.NewImmediateTaskKey(200),
+		},
+		Predicate: NewDomainIDPredicate([]string{"domain1"}, false),
+	})
+	mockVirtualSlice.EXPECT().GetPendingTaskCount().Return(3)
+	mockVirtualSlice.EXPECT().PendingTaskStats().Return(PendingTaskStats{
+		PendingTaskCountPerDomain: map[string]int{"domain1": 3},
+	})
+	mockVirtualQueue1.EXPECT().IsPaused().Return(true)
+	mockVirtualQueue1.EXPECT().IterateSlices(gomock.Any())
+	mockMonitor.EXPECT().GetAlertRecords().Return([]AlertRecord{
+		{
+			Alert: Alert{
+				AlertType: AlertTypeQueueDomainTaskFailure,
+				AlertAttributesQueueDomainTaskFailure: &AlertAttributesQueueDomainTaskFailure{
+					TaskFailureRatePerDomain: map[string]float64{"domain1": 0.9},
+					CriticalTaskFailureRate:  0.8,
+				},
+			},
+			RaisedAt:   raisedAt,
+			ResolvedAt: raisedAt.Add(time.Second),
+		},
+		{
+			Alert:    Alert{AlertType: AlertTypeQueueStuckSlice},
+			RaisedAt: raisedAt,
+		},
+	})
+
+	queueBase := &queueBase{
+		virtualQueueManager: mockVirtualQueueManager,
+		monitor:             mockMonitor,
+	}
+
+	result, err := queueBase.HandleAction(context.Background(), cluster.TestCurrentClusterName, queue.NewGetStateAction())
+	require.NoError(t, err)
+	require.NotNil(t, result.GetStateActionResult)
+	descriptions := result.GetStateActionResult.Descriptions
+	require.Len(t, descriptions, 4)
+	description, ok := queueedit.DecodeDescription(descriptions[0])
+	require.True(t, ok)
+	assert.Equal(t, &queueedit.VirtualQueueDescription{
+		VirtualQueueID: 0,
+		Slices: []queueedit.SliceDescription{
+			{
+				Range: &types.TaskRange{
+					InclusiveMin: &types.TaskKey{TaskID: 100},
+					ExclusiveMax: &types.TaskKey{TaskID: 200},
+				},
+				Predicate:                 ToPersistencePredicate(NewDomainIDPredicate([]string{"domain1"}, false)),
+				PendingTaskCount:          3,
+				PendingTaskCountPerDomain: map[string]int{"domain1": 3},
+			},
+		},
+	}, description)
+	assert.Equal(t, `{"virtualQueueID":1,"paused":true,"slices":[]}`, descriptions[1])
+	assert.Equal(t, "Alert QueueDomainTaskFailure{failure rate per domain: map[domain1:0.9], critical: 0.8}: raised at 1970-01-01T00:16:40Z, mitigated at 1970-01-01T00:16:41Z", descriptions[2])
+	assert.Equal(t, "Alert QueueStuckSlice: raised at 1970-01-01T00:16:40Z, unresolved", descriptions[3])
+
+	result, err = queueBase.HandleAction(context.Background(), cluster.TestCurrentClusterName, queue.NewResetAction())
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+}
+
+func TestQueueBase_EditVirtualQueue(t *testing.T) {
+	tests := []struct {
+		name    string
+		edit    *queueedit.Edit
+		setup   func(*gomock.Controller, *MockVirtualQueueManager, *MockVirtualQueue)
+		wantErr bool
+	}{
+		{
+			name:    "virtual queue not found",
+			edit:    &queueedit.Edit{Operation: queueedit.OperationResume, VirtualQueueID: 3},
+			setup:   func(_ *gomock.Controller, _ *MockVirtualQueueManager, _ *MockVirtualQueue) {},
+			wantErr: true,
+		},
+		{
+			name:    "target exceeds max virtual queue count",
+			edit:    &queueedit.Edit{Operation: queueedit.OperationMerge, VirtualQueueID: 0, TargetVirtualQueueID: 5},
+			setup:   func(_ *gomock.Controller, _ *MockVirtualQueueManager, _ *MockVirtualQueue) {},
+			wantErr: true,
+		},
+		{
+			name: "pause",
+			edit: &queueedit.Edit{Operation: queueedit.OperationPause, VirtualQueueID: 0, PauseDuration: time.Minute},
+			setup: func(_ *gomock.Controller, _ *MockVirtualQueueManager, vq *MockVirtualQueue) {
+				vq.EXPECT().Pause(time.Minute)
+			},
+		},
+		{
+			name: "resume",
+			edit: &queueedit.Edit{Operation: queueedit.OperationResume, VirtualQueueID: 0},
+			setup: func(_ *gomock.Controller, _ *MockVirtualQueueManager, vq *MockVirtualQueue) {
+				vq.EXPECT().Resume()
+			},
+		},
+		{
+			name: "merge",
+			edit: &queueedit.Edit{Operation: queueedit.OperationMerge, VirtualQueueID: 0, TargetVirtualQueueID: 1},
+			setup: func(ctrl *gomock.Controller, manager *MockVirtualQueueManager, vq *MockVirtualQueue) {
+				slice1 := NewMockVirtualSlice(ctrl)
+				slice2 := NewMockVirtualSlice(ctrl)
+				slice1.EXPECT().Clear()
+				slice2.EXPECT().Clear()
+				vq.EXPECT().SplitSlices(gomock.Any()).Do(func(f func(VirtualSlice) ([]VirtualSlice, bool)) {
+					remaining, split := f(slice1)
+					assert.True(t, split)
+					assert.Empty(t, remaining)
+					remaining, split = f(slice2)
+					assert.True(t, split)
+					assert.Empty(t, remaining)
+				})
+				target := NewMockVirtualQueue(ctrl)
+				manager.EXPECT().GetOrCreateVirtualQueue(int64(1)).Return(target)
+				target.EXPECT().MergeSlices(slice1, slice2)
+			},
+		},
+		{
+			name: "split",
+			edit: &queueedit.Edit{Operation: queueedit.OperationSplit, VirtualQueueID: 0, SliceIndex: 1, DomainIDs: []string{"domain1"}, TargetVirtualQueueID: 2},
+			setup: func(ctrl *gomock.Controller, manager *MockVirtualQueueManager, vq *MockVirtualQueue) {
+				slice0 := NewMockVirtualSlice(ctrl)
+				slice1 := NewMockVirtualSlice(ctrl)
+				splitSlice := NewMockVirtualSlice(ctrl)
+				remainingSlice := NewMockVirtualSlice(ctrl)
+				slice1.EXPECT().TrySplitByPredicate(NewDomainIDPredicate([]string{"domain1"}, false)).Return(splitSlice, remainingSlice, true)
+				splitSlice.EXPECT().Clear()
+				vq.EXPECT().SplitSlices(gomock.Any()).Do(func(f func(VirtualSlice) ([]VirtualSlice, bool)) {
+					_, split := f(slice0)
+					assert.False(t, split)
+					remaining, split := f(slice1)
+					assert.True(t, split)
+					assert.Equal(t, []VirtualSlice{remainingSlice}, remaining)
+				})
+				target := NewMockVirtualQueue(ctrl)
+				manager.EXPECT().GetOrCreateVirtualQueue(int64(2)).Return(target)
+				target.EXPECT().MergeSlices(splitSlice)
+			},
+		},
+		{
+			name: "split slice not found",
+			edit: &queueedit.Edit{Operation: queueedit.OperationSplit, VirtualQueueID: 0, SliceIndex: 1, DomainIDs: []string{"domain1"}, TargetVirtualQueueID: 2},
+			setup: func(ctrl *gomock.Controller, _ *MockVirtualQueueManager, vq *MockVirtualQueue) {
+				vq.EXPECT().SplitSlices(gomock.Any()).Do(func(f func(VirtualSlice) ([]VirtualSlice, bool)) {
+					_, split := f(NewMockVirtualSlice(ctrl))
+					assert.False(t, split)
+				})
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockVirtualQueueManager := NewMockVirtualQueueManager(ctrl)
+			mockVirtualQueue := NewMockVirtualQueue(ctrl)
+			mockVirtualQueueManager.EXPECT().VirtualQueues().Return(map[int64]VirtualQueue{0: mockVirtualQueue})
+			tt.setup(ctrl, mockVirtualQueueManager, mockVirtualQueue)
+
+			queueBase := &queueBase{
+				logger:              testlogger.New(t),
+				virtualQueueManager: mockVirtualQueueManager,
+				options: &Options{
+					MaxVirtualQueueCount: dynamicproperties.GetIntPropertyFn(3),
+				},
+			}
+
+			result, err := queueBase.HandleAction(context.Background(), cluster.TestCurrentClusterName, queue.NewEditVirtualQueueAction(tt.edit))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, queue.ActionTypeEditVirtualQueue, result.ActionType)
+		})
+	}
+}
+
+func TestQueueBase_GetPendingTaskStats(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockVirtualQueueManager := NewMockVirtualQueueManager(ctrl)
+	mockVirtualQueue0 := NewMockVirtualQueue(ctrl)
+	mockVirtualQueue1 := NewMockVirtualQueue(ctrl)
+	mockVirtualSlice1 := NewMockVirtualSlice(ctrl)
+	mockVirtualSlice2 := NewMockVirtualSlice(ctrl)
+
+	mockVirtualQueueManager.EXPECT().VirtualQueues().Return(map[int64]VirtualQueue{
+		0: mockVirtualQueue0,
+		1: mockVirtualQueue1,
+	})
+	mockVirtualQueue0.EXPECT().IterateSlices(gomock.Any()).Do(func(f func(VirtualSlice)) {
+		f(mockVirtualSlice1)
+	})
+	mockVirtualQueue1.EXPECT().IterateSlices(gomock.Any()).Do(func(f func(VirtualSlice)) {
+		f(mockVirtualSlice2)
+	})
+	mockVirtualSlice1.EXPECT().PendingTaskStats().Return(PendingTaskStats{
+		PendingTaskCountPerDomain: map[string]int{"domain1": 10, "domain2": 5},
+		FailingTaskCountPerDomain: map[string]int{"domain1": 8},
+	})
+	mockVirtualSlice2.EXPECT().PendingTaskStats().Return(PendingTaskStats{
+		PendingTaskCountPerDomain: map[string]int{"domain1": 3},
+		FailingTaskCountPerDomain: map[string]int{"domain1": 1},
+	})
+
+	queueBase := &queueBase{
+		virtualQueueManager: mockVirtualQueueManager,
+	}
+
+	assert.Equal(t, PendingTaskStats{
+		PendingTaskCountPerDomain: map[string]int{"domain1": 13, "domain2": 5},
+		FailingTaskCountPerDomain: map[string]int{"domain1": 9},
+	}, queueBase.getPendingTaskStats())
+}
@@ -80,6 +80,13 @@ func TestImmediateQueue_LifeCycle(t *testing.T) {
 		CriticalPendingTaskCount:             dynamicproperties.GetIntPropertyFn(90),
 		VirtualSliceForceAppendInterval:      dynamicproperties.GetDurationPropertyFn(time.Second * 10),
 		EnablePendingTaskCountAlert:          func() bool { return true },
+		EnableStuckSliceAlert:                func() bool { return true },
+		CriticalStuckDuration:                dynamicproperties.GetDurationPropertyFn(time.Minute),
+		EnableDomainTaskFailureAlert:         func() bool { return true },
+		CriticalDomainTaskFailureRate:        dynamicproperties.GetFloatPropertyFn(0.8),
+		DomainTaskFailureRateMinTaskCount:    dynamicproperties.GetIntPropertyFn(10),
+		EnableScheduledLagAlert:              func() bool { return true },
+		MaxScheduledLag:                      dynamicproperties.GetDurationPropertyFn(time.Minute),
 		MaxVirtualQueueCount:                 dynamicproperties.GetIntPropertyFn(2),
 	}
 
@@ -165,6 +165,13 @@ func (f *transferQueueFactory) createQueuev2(
 			CriticalPendingTaskCount:             config.QueueCriticalPendingTaskCount,
 			EnablePendingTaskCountAlert:          func() bool { return config.EnableTransferQueueV2PendingTaskCountAlert(shard.GetShardID()) },
 			MaxVirtualQueueCount:                 config.QueueMaxVirtualQueueCount,
+			EnableStuckSliceAlert:                func() bool { return config.EnableQueueV2StuckSliceAlert(shard.GetShardID()) },
+			CriticalStuckDuration:                config.QueueStuckSliceDuration,
+			EnableDomainTaskFailureAlert:         func() bool { return config.EnableQueueV2DomainTaskFailureAlert(shard.GetShardID()) },
+			CriticalDomainTaskFailureRate:        config.QueueCriticalDomainTaskFailureRate,
+			DomainTaskFailureRateMinTaskCount:    config.QueueDomainTaskFailureRateMinTaskCount,
+			EnableScheduledLagAlert:              func() bool { return config.EnableQueueV2ScheduledLagAlert(shard.GetShardID()) },
+			MaxScheduledLag:                      config.QueueMaxScheduledLag,
 		},
 	)
 }
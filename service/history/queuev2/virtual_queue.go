@@ -67,6 +67,10 @@ type (
 		SplitSlices(func(VirtualSlice) (remaining []VirtualSlice, split bool))
 		// Pause pauses the virtual queue for a while
 		Pause(time.Duration)
+		// Resume resumes the virtual queue immediately if it's paused
+		Resume()
+		// IsPaused returns whether the virtual queue is paused
+		IsPaused() bool
 	}
 
 	VirtualQueueOptions struct {
@@ -317,6 +321,14 @@ func (q *virtualQueueImpl) Pause(duration time.Duration) {
 	q.pauseController.Pause(duration)
 }
 
+func (q *virtualQueueImpl) Resume() {
+	q.pauseController.Resume()
+}
+
+func (q *virtualQueueImpl) IsPaused() bool {
+	return q.pauseController.IsPaused()
+}
+
 func (q *virtualQueueImpl) notify() {
 	select {
 	case q.notifyCh <- struct{}{}:
@@ -82,6 +82,20 @@ func (mr *MockVirtualQueueMockRecorder) GetState() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetState", reflect.TypeOf((*MockVirtualQueue)(nil).GetState))
 }
 
+// IsPaused mocks base method.
+func (m *MockVirtualQueue) IsPaused() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsPaused")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsPaused indicates an expected call of IsPaused.
+func (mr *MockVirtualQueueMockRecorder) IsPaused() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsPaused", reflect.TypeOf((*MockVirtualQueue)(nil).IsPaused))
+}
+
 // IterateSlices mocks base method.
 func (m *MockVirtualQueue) IterateSlices(arg0 func(VirtualSlice)) {
 	m.ctrl.T.Helper()
@@ -134,6 +148,18 @@ func (mr *MockVirtualQueueMockRecorder) Pause(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pause", reflect.TypeOf((*MockVirtualQueue)(nil).Pause), arg0)
 }
 
+// Resume mocks base method.
+func (m *MockVirtualQueue) Resume() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Resume")
+}
+
+// Resume indicates an expected call of Resume.
+func (mr *MockVirtualQueueMockRecorder) Resume() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resume", reflect.TypeOf((*MockVirtualQueue)(nil).Resume))
+}
+
 // SplitSlices mocks base method.
 func (m *MockVirtualQueue) SplitSlices(arg0 func(VirtualSlice) ([]VirtualSlice, bool)) {
 	m.ctrl.T.Helper()
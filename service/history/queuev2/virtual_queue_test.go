@@ -1791,3 +1791,30 @@ func TestVirtualQueue_MergeWithLastSlice(t *testing.T) {
 		})
 	}
 }
+
+func TestVirtualQueue_PauseAndResume(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queue := NewVirtualQueue(
+		task.NewMockProcessor(ctrl),
+		task.NewMockRescheduler(ctrl),
+		testlogger.New(t),
+		metrics.NoopScope,
+		clock.NewMockedTimeSource(),
+		quotas.NewMockLimiter(ctrl),
+		NewMockMonitor(ctrl),
+		nil,
+		&VirtualQueueOptions{
+			PageSize:                             dynamicproperties.GetIntPropertyFn(10),
+			MaxPendingTasksCount:                 dynamicproperties.GetIntPropertyFn(100),
+			PollBackoffInterval:                  dynamicproperties.GetDurationPropertyFn(time.Second * 10),
+			PollBackoffIntervalJitterCoefficient: dynamicproperties.GetFloatPropertyFn(0.0),
+		},
+	)
+
+	assert.False(t, queue.IsPaused())
+	queue.Pause(time.Minute)
+	assert.True(t, queue.IsPaused())
+	queue.Resume()
+	assert.False(t, queue.IsPaused())
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/persistence"
+	ctask "github.com/uber/cadence/common/task"
 	"github.com/uber/cadence/service/history/task"
 )
 
@@ -42,6 +43,7 @@ type (
 		GetPendingTaskCount() int
 		Clear()
 		PendingTaskStats() PendingTaskStats
+		IsDomainDrained(domainID string, exclusiveMaxTaskKey persistence.HistoryTaskKey) bool
 
 		TrySplitByTaskKey(persistence.HistoryTaskKey) (VirtualSlice, VirtualSlice, bool)
 		TrySplitByPredicate(Predicate) (VirtualSlice, VirtualSlice, bool)
@@ -50,6 +52,8 @@ type (
 
 	PendingTaskStats struct {
 		PendingTaskCountPerDomain map[string]int
+		// FailingTaskCountPerDomain is the number of pending tasks per domain which have failed at least once
+		FailingTaskCountPerDomain map[string]int
 	}
 
 	virtualSliceImpl struct {
@@ -162,9 +166,35 @@ func (s *virtualSliceImpl) HasMoreTasks() bool {
 }
 
 func (s *virtualSliceImpl) PendingTaskStats() PendingTaskStats {
+	failingTaskCountPerDomain := make(map[string]int)
+	for _, t := range s.pendingTaskTracker.GetTasks() {
+		if t.GetAttempt() > 0 && t.State() != ctask.TaskStateAcked {
+			failingTaskCountPerDomain[t.GetDomainID()]++
+		}
+	}
 	return PendingTaskStats{
 		PendingTaskCountPerDomain: s.pendingTaskTracker.GetPerDomainPendingTaskCount(),
+		FailingTaskCountPerDomain: failingTaskCountPerDomain,
+	}
+}
+
+// IsDomainDrained returns true if the slice has read all the tasks before the key
+// and none of the tasks of the domain before the key is pending
+func (s *virtualSliceImpl) IsDomainDrained(domainID string, exclusiveMaxTaskKey persistence.HistoryTaskKey) bool {
+	if s.state.Range.InclusiveMinTaskKey.Compare(exclusiveMaxTaskKey) >= 0 ||
+		And(s.state.Predicate, NewDomainIDPredicate([]string{domainID}, false)).IsEmpty() {
+		return true
+	}
+	// the progress is sorted, the tasks not read yet may belong to the domain
+	if len(s.progress) > 0 && s.progress[0].NextTaskKey.Compare(exclusiveMaxTaskKey) < 0 {
+		return false
+	}
+	for key, t := range s.pendingTaskTracker.GetTasks() {
+		if key.Compare(exclusiveMaxTaskKey) < 0 && t.GetDomainID() == domainID && t.State() != ctask.TaskStateAcked {
+			return false
+		}
 	}
+	return true
 }
 
 func (s *virtualSliceImpl) UpdateAndGetState() VirtualSliceState {
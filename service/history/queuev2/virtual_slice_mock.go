@@ -112,6 +112,20 @@ func (mr *MockVirtualSliceMockRecorder) HasMoreTasks() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasMoreTasks", reflect.TypeOf((*MockVirtualSlice)(nil).HasMoreTasks))
 }
 
+// IsDomainDrained mocks base method.
+func (m *MockVirtualSlice) IsDomainDrained(domainID string, exclusiveMaxTaskKey persistence.HistoryTaskKey) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsDomainDrained", domainID, exclusiveMaxTaskKey)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsDomainDrained indicates an expected call of IsDomainDrained.
+func (mr *MockVirtualSliceMockRecorder) IsDomainDrained(domainID, exclusiveMaxTaskKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsDomainDrained", reflect.TypeOf((*MockVirtualSlice)(nil).IsDomainDrained), domainID, exclusiveMaxTaskKey)
+}
+
 // IsEmpty mocks base method.
 func (m *MockVirtualSlice) IsEmpty() bool {
 	m.ctrl.T.Helper()
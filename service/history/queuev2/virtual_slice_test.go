@@ -10,6 +10,7 @@ import (
 
 	"github.com/uber/cadence/common/log/testlogger"
 	"github.com/uber/cadence/common/persistence"
+	ctask "github.com/uber/cadence/common/task"
 	"github.com/uber/cadence/service/history/task"
 )
 
@@ -3008,21 +3009,24 @@ func TestVirtualSliceImpl_TrySplitByPredicate(t *testing.T) {
 func TestPendingTaskStats(t *testing.T) {
 	tests := []struct {
 		name          string
-		mockSetup     func(*MockPendingTaskTracker)
+		mockSetup     func(*gomock.Controller, *MockPendingTaskTracker)
 		expectedStats PendingTaskStats
 	}{
 		{
 			name: "Empty pending task tracker - should return empty stats",
-			mockSetup: func(mock *MockPendingTaskTracker) {
+			mockSetup: func(ctrl *gomock.Controller, mock *MockPendingTaskTracker) {
+				mock.EXPECT().GetTasks().Return(nil)
 				mock.EXPECT().GetPerDomainPendingTaskCount().Return(map[string]int{})
 			},
 			expectedStats: PendingTaskStats{
 				PendingTaskCountPerDomain: map[string]int{},
+				FailingTaskCountPerDomain: map[string]int{},
 			},
 		},
 		{
 			name: "Single domain with tasks - should return correct stats",
-			mockSetup: func(mock *MockPendingTaskTracker) {
+			mockSetup: func(ctrl *gomock.Controller, mock *MockPendingTaskTracker) {
+				mock.EXPECT().GetTasks().Return(nil)
 				mock.EXPECT().GetPerDomainPendingTaskCount().Return(map[string]int{
 					"domain1": 5,
 				})
@@ -3031,11 +3035,13 @@ func TestPendingTaskStats(t *testing.T) {
 				PendingTaskCountPerDomain: map[string]int{
 					"domain1": 5,
 				},
+				FailingTaskCountPerDomain: map[string]int{},
 			},
 		},
 		{
 			name: "Multiple domains with tasks - should return correct stats",
-			mockSetup: func(mock *MockPendingTaskTracker) {
+			mockSetup: func(ctrl *gomock.Controller, mock *MockPendingTaskTracker) {
+				mock.EXPECT().GetTasks().Return(nil)
 				mock.EXPECT().GetPerDomainPendingTaskCount().Return(map[string]int{
 					"domain1": 3,
 					"domain2": 7,
@@ -3048,11 +3054,13 @@ func TestPendingTaskStats(t *testing.T) {
 					"domain2": 7,
 					"domain3": 2,
 				},
+				FailingTaskCountPerDomain: map[string]int{},
 			},
 		},
 		{
 			name: "Domain with zero tasks - should include zero counts",
-			mockSetup: func(mock *MockPendingTaskTracker) {
+			mockSetup: func(ctrl *gomock.Controller, mock *MockPendingTaskTracker) {
+				mock.EXPECT().GetTasks().Return(nil)
 				mock.EXPECT().GetPerDomainPendingTaskCount().Return(map[string]int{
 					"domain1": 5,
 					"domain2": 0,
@@ -3065,6 +3073,37 @@ func TestPendingTaskStats(t *testing.T) {
 					"domain2": 0,
 					"domain3": 3,
 				},
+				FailingTaskCountPerDomain: map[string]int{},
+			},
+		},
+		{
+			name: "Tasks which have failed - should be counted as failing",
+			mockSetup: func(ctrl *gomock.Controller, mock *MockPendingTaskTracker) {
+				failingTask := task.NewMockTask(ctrl)
+				failingTask.EXPECT().GetAttempt().Return(3)
+				failingTask.EXPECT().State().Return(ctask.TaskStatePending)
+				failingTask.EXPECT().GetDomainID().Return("domain1")
+				ackedTask := task.NewMockTask(ctrl)
+				ackedTask.EXPECT().GetAttempt().Return(1)
+				ackedTask.EXPECT().State().Return(ctask.TaskStateAcked)
+				newTask := task.NewMockTask(ctrl)
+				newTask.EXPECT().GetAttempt().Return(0)
+				mock.EXPECT().GetTasks().Return(map[persistence.HistoryTaskKey]task.Task{
+					persistence.NewImmediateTaskKey(1): failingTask,
+					persistence.NewImmediateTaskKey(2): ackedTask,
+					persistence.NewImmediateTaskKey(3): newTask,
+				})
+				mock.EXPECT().GetPerDomainPendingTaskCount().Return(map[string]int{
+					"domain1": 2,
+				})
+			},
+			expectedStats: PendingTaskStats{
+				PendingTaskCountPerDomain: map[string]int{
+					"domain1": 2,
+				},
+				FailingTaskCountPerDomain: map[string]int{
+					"domain1": 1,
+				},
 			},
 		},
 	}
@@ -3087,7 +3126,7 @@ func TestPendingTaskStats(t *testing.T) {
 			}
 
 			// Setup mock expectations
-			tt.mockSetup(mockPendingTaskTracker)
+			tt.mockSetup(ctrl, mockPendingTaskTracker)
 
 			// Call the method under test
 			result := slice.PendingTaskStats()
@@ -3098,6 +3137,90 @@ func TestPendingTaskStats(t *testing.T) {
 	}
 }
 
+func TestIsDomainDrained(t *testing.T) {
+	tests := []struct {
+		name      string
+		predicate Predicate
+		progress  []*GetTaskProgress
+		tasks     func(*gomock.Controller) map[persistence.HistoryTaskKey]task.Task
+		expected  bool
+	}{
+		{
+			name:      "Slice excludes the domain - should be drained",
+			predicate: NewDomainIDPredicate([]string{"domain1"}, true),
+			progress:  []*GetTaskProgress{{NextTaskKey: persistence.NewImmediateTaskKey(1)}},
+			expected:  true,
+		},
+		{
+			name:      "Tasks before the key not read yet - should not be drained",
+			predicate: NewUniversalPredicate(),
+			progress:  []*GetTaskProgress{{NextTaskKey: persistence.NewImmediateTaskKey(3)}},
+			expected:  false,
+		},
+		{
+			name:      "Pending task of the domain before the key - should not be drained",
+			predicate: NewUniversalPredicate(),
+			progress:  []*GetTaskProgress{{NextTaskKey: persistence.NewImmediateTaskKey(5)}},
+			tasks: func(ctrl *gomock.Controller) map[persistence.HistoryTaskKey]task.Task {
+				pendingTask := task.NewMockTask(ctrl)
+				pendingTask.EXPECT().GetDomainID().Return("domain1").AnyTimes()
+				pendingTask.EXPECT().State().Return(ctask.TaskStatePending).AnyTimes()
+				return map[persistence.HistoryTaskKey]task.Task{
+					persistence.NewImmediateTaskKey(2): pendingTask,
+				}
+			},
+			expected: false,
+		},
+		{
+			name:      "Pending tasks of other domains or after the key - should be drained",
+			predicate: NewUniversalPredicate(),
+			tasks: func(ctrl *gomock.Controller) map[persistence.HistoryTaskKey]task.Task {
+				otherDomainTask := task.NewMockTask(ctrl)
+				otherDomainTask.EXPECT().GetDomainID().Return("domain2").AnyTimes()
+				otherDomainTask.EXPECT().State().Return(ctask.TaskStatePending).AnyTimes()
+				ackedTask := task.NewMockTask(ctrl)
+				ackedTask.EXPECT().GetDomainID().Return("domain1").AnyTimes()
+				ackedTask.EXPECT().State().Return(ctask.TaskStateAcked).AnyTimes()
+				laterTask := task.NewMockTask(ctrl)
+				laterTask.EXPECT().GetDomainID().Return("domain1").AnyTimes()
+				laterTask.EXPECT().State().Return(ctask.TaskStatePending).AnyTimes()
+				return map[persistence.HistoryTaskKey]task.Task{
+					persistence.NewImmediateTaskKey(1): otherDomainTask,
+					persistence.NewImmediateTaskKey(2): ackedTask,
+					persistence.NewImmediateTaskKey(4): laterTask,
+				}
+			},
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPendingTaskTracker := NewMockPendingTaskTracker(ctrl)
+			var tasks map[persistence.HistoryTaskKey]task.Task
+			if tt.tasks != nil {
+				tasks = tt.tasks(ctrl)
+			}
+			mockPendingTaskTracker.EXPECT().GetTasks().Return(tasks).AnyTimes()
+
+			slice := &virtualSliceImpl{
+				state: VirtualSliceState{
+					Range: Range{
+						InclusiveMinTaskKey: persistence.NewImmediateTaskKey(1),
+						ExclusiveMaxTaskKey: persistence.NewImmediateTaskKey(10),
+					},
+					Predicate: tt.predicate,
+				},
+				pendingTaskTracker: mockPendingTaskTracker,
+				progress:           tt.progress,
+			}
+
+			assert.Equal(t, tt.expected, slice.IsDomainDrained("domain1", persistence.NewImmediateTaskKey(4)))
+		})
+	}
+}
+
 func TestMergeVirtualSlicesWithDifferentPredicate(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -0,0 +1,154 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package replication
+
+import (
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/replicationstatus"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// domainLagTracker tracks the replication lag of the domains a task processor applies tasks for
+	domainLagTracker struct {
+		timeSource clock.TimeSource
+
+		mu      sync.Mutex
+		domains map[string]*domainLagState
+	}
+
+	domainLagState struct {
+		// pending are the creation times of the tasks fetched and not applied yet, in fetching order
+		pending         []time.Time
+		lastAppliedTime time.Time
+	}
+
+	domainLag struct {
+		lag             time.Duration
+		pendingTasks    int64
+		lastAppliedTime time.Time
+	}
+)
+
+func newDomainLagTracker(timeSource clock.TimeSource) *domainLagTracker {
+	return &domainLagTracker{
+		timeSource: timeSource,
+		domains:    make(map[string]*domainLagState),
+	}
+}
+
+// fetched records the tasks of a new batch as pending, tasks of a previous batch that were not applied will be
+// fetched again so they are dropped
+func (t *domainLagTracker) fetched(tasks []*types.ReplicationTask) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, state := range t.domains {
+		state.pending = state.pending[:0]
+	}
+	for _, task := range tasks {
+		domainID := getReplicationTaskDomainID(task)
+		if domainID == "" {
+			continue
+		}
+		state := t.getOrCreateStateLocked(domainID)
+		state.pending = append(state.pending, time.Unix(0, task.GetCreationTime()))
+	}
+}
+
+// applied records that a task was applied, or put into the DLQ, and returns its lag
+func (t *domainLagTracker) applied(task *types.ReplicationTask) time.Duration {
+	domainID := getReplicationTaskDomainID(task)
+	if domainID == "" {
+		return 0
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.timeSource.Now()
+	state := t.getOrCreateStateLocked(domainID)
+	if len(state.pending) > 0 {
+		state.pending = state.pending[1:]
+	}
+	state.lastAppliedTime = now
+	return now.Sub(time.Unix(0, task.GetCreationTime()))
+}
+
+// lags returns the current lag of each domain from the tasks fetched and not applied yet, and the backlog of the
+// source cluster, which also has the tasks not fetched yet. The lag of a domain without pending tasks is zero.
+func (t *domainLagTracker) lags(backlogs []*replicationstatus.DomainBacklog) map[string]domainLag {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.timeSource.Now()
+	lags := make(map[string]domainLag, len(t.domains))
+	for domainID, state := range t.domains {
+		lag := domainLag{
+			pendingTasks:    int64(len(state.pending)),
+			lastAppliedTime: state.lastAppliedTime,
+		}
+		if len(state.pending) > 0 {
+			lag.lag = now.Sub(state.pending[0])
+		}
+		lags[domainID] = lag
+	}
+	// the backlog has the fetched tasks unless it was read before they were fetched, and keeps the applied tasks
+	// until the source is polled again
+	for _, backlog := range backlogs {
+		lag := lags[backlog.DomainID]
+		if backlog.PendingTasks > lag.pendingTasks {
+			lag.pendingTasks = backlog.PendingTasks
+		}
+		if backlogLag := now.Sub(backlog.OldestTaskTime); backlogLag > lag.lag {
+			lag.lag = backlogLag
+		}
+		lags[backlog.DomainID] = lag
+	}
+	return lags
+}
+
+func (t *domainLagTracker) getOrCreateStateLocked(domainID string) *domainLagState {
+	state, ok := t.domains[domainID]
+	if !ok {
+		state = &domainLagState{}
+		t.domains[domainID] = state
+	}
+	return state
+}
+
+func getReplicationTaskDomainID(task *types.ReplicationTask) string {
+	switch task.GetTaskType() {
+	case types.ReplicationTaskTypeHistoryV2:
+		return task.GetHistoryTaskV2Attributes().GetDomainID()
+	case types.ReplicationTaskTypeSyncActivity:
+		return task.GetSyncActivityTaskAttributes().GetDomainID()
+	case types.ReplicationTaskTypeFailoverMarker:
+		return task.GetFailoverMarkerAttributes().GetDomainID()
+	default:
+		return ""
+	}
+}
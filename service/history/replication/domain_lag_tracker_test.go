@@ -0,0 +1,95 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package replication
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/replicationstatus"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestDomainLagTracker(t *testing.T) {
+	timeSource := clock.NewMockedTimeSource()
+	tracker := newDomainLagTracker(timeSource)
+	start := timeSource.Now()
+
+	historyTask := func(domainID string, created time.Time) *types.ReplicationTask {
+		return &types.ReplicationTask{
+			TaskType:                types.ReplicationTaskTypeHistoryV2.Ptr(),
+			HistoryTaskV2Attributes: &types.HistoryTaskV2Attributes{DomainID: domainID},
+			CreationTime:            common.Int64Ptr(created.UnixNano()),
+		}
+	}
+	tasks := []*types.ReplicationTask{
+		historyTask("domain-a", start.Add(-10*time.Second)),
+		{
+			TaskType:                   types.ReplicationTaskTypeSyncActivity.Ptr(),
+			SyncActivityTaskAttributes: &types.SyncActivityTaskAttributes{DomainID: "domain-b"},
+			CreationTime:               common.Int64Ptr(start.Add(-5 * time.Second).UnixNano()),
+		},
+		historyTask("domain-a", start.Add(-2*time.Second)),
+		{TaskType: types.ReplicationTaskTypeSyncShardStatus.Ptr()},
+	}
+
+	tracker.fetched(tasks)
+	assert.Equal(t, map[string]domainLag{
+		"domain-a": {lag: 10 * time.Second, pendingTasks: 2},
+		"domain-b": {lag: 5 * time.Second, pendingTasks: 1},
+	}, tracker.lags(nil))
+
+	timeSource.Advance(time.Second)
+	assert.Equal(t, 11*time.Second, tracker.applied(tasks[0]))
+	assert.Equal(t, 6*time.Second, tracker.applied(tasks[1]))
+	assert.Equal(t, time.Duration(0), tracker.applied(tasks[3]))
+	applied := timeSource.Now()
+	// the lag of a domain without pending tasks is zero
+	assert.Equal(t, map[string]domainLag{
+		"domain-a": {lag: 3 * time.Second, pendingTasks: 1, lastAppliedTime: applied},
+		"domain-b": {lastAppliedTime: applied},
+	}, tracker.lags(nil))
+
+	// the task not applied is fetched again in the next batch
+	timeSource.Advance(time.Minute)
+	tracker.fetched(tasks[2:3])
+	assert.Equal(t, map[string]domainLag{
+		"domain-a": {lag: time.Minute + 3*time.Second, pendingTasks: 1, lastAppliedTime: applied},
+		"domain-b": {lastAppliedTime: applied},
+	}, tracker.lags(nil))
+
+	// the backlog of the source cluster has the tasks not fetched yet
+	now := timeSource.Now()
+	assert.Equal(t, map[string]domainLag{
+		"domain-a": {lag: 10 * time.Minute, pendingTasks: 50, lastAppliedTime: applied},
+		"domain-b": {lastAppliedTime: applied},
+		"domain-c": {lag: time.Second, pendingTasks: 1},
+	}, tracker.lags([]*replicationstatus.DomainBacklog{
+		{DomainID: "domain-a", PendingTasks: 50, OldestTaskTime: now.Add(-10 * time.Minute)},
+		{DomainID: "domain-c", PendingTasks: 1, OldestTaskTime: now.Add(-time.Second)},
+	}))
+}
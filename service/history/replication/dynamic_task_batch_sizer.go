@@ -46,27 +46,32 @@ import (
 //     the number of messages to be sure that future messages will not be shrunk
 //     Emitted metric with tag reason:"shrunk"
 //
-//  3. If the read level of a passive cluster has not been changed and there are no fetched tasks,
+//  3. If the payload size of messages is larger than the target batch bytes, decrease the task batch size.
+//     Large payloads saturate cross cluster links during catch-up, so we should fetch fewer tasks
+//     before the messages need to be shrunk
+//     Emitted metric with tag reason:"payload_size"
+//
+//  4. If the read level of a passive cluster has not been changed and there are no fetched tasks,
 //     not change the task batch size. There is no need to change because the replication is not stuck,
 //     there just are no new tasks
 //     Metric is not emitted
 //
-//  4. If the read level of a passive cluster has not been changed and if there are fetched tasks,
+//  5. If the read level of a passive cluster has not been changed and if there are fetched tasks,
 //     and number of previously fetched tasks is not zero, decrease the task batch size.
 //     The replication is stuck on the passive side
 //     Emitted metric with tag reason:"possible_stuck"
 //
-//  5. If the read level of a passive cluster has not been changed and if there are fetched tasks,
+//  6. If the read level of a passive cluster has not been changed and if there are fetched tasks,
 //     and number of previously fetched tasks is zero, not change the task batch size.
 //     The replication is not stuck, and there are new tasks to be replicated
 //     Metric is not emitted
 //
-//  6. If the read level of a passive cluster has been changed and if there are more tasks in db,
+//  7. If the read level of a passive cluster has been changed and if there are more tasks in db,
 //     increase the task batch size. We should retrieve the maximum possible value at the next time,
 //     as there are more tasks to be replicated
 //     Emitted metric with tag reason:"more_tasks"
 //
-//  7. If the read level of a passive cluster has been changed and if there are no more tasks in db,
+//  8. If the read level of a passive cluster has been changed and if there are no more tasks in db,
 //     not change the size. The existing size is already enough, and there are no more tasks to be replicated
 //     Metric is not emitted
 type DynamicTaskBatchSizer interface {
@@ -79,6 +84,7 @@ type dynamicTaskBatchSizerImpl struct {
 	// isFetchedTasks indicates that there are fetched tasks in the last GetTasks call
 	isFetchedTasks atomic.Bool
 	iter           rangeiter.Iterator[int]
+	targetBytes    func() int
 	logger         log.Logger
 	scope          metrics.Scope
 }
@@ -98,6 +104,7 @@ func NewDynamicTaskBatchSizer(shardID int, logger log.Logger, config *config.Con
 			func() int { return config.ReplicatorProcessorBatchSizeStepCount(shardID) },
 			logger,
 		),
+		targetBytes: func() int { return config.ReplicatorProcessorTargetBatchBytes(shardID) },
 	}
 }
 
@@ -109,6 +116,9 @@ func (d *dynamicTaskBatchSizerImpl) analyse(err error, state *getTasksResult) {
 	case state.isShrunk:
 		d.decrease("shrunk")
 
+	case state.payloadBytes > d.targetBytes():
+		d.decrease("payload_size")
+
 	case state.previousReadTaskID == state.lastReadTaskID &&
 		len(state.taskInfos) > 0 && d.isFetchedTasks.Load():
 		d.decrease("possible_stuck")
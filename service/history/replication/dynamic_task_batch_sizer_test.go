@@ -44,10 +44,13 @@ func TestDynamicTaskBatchSizer(t *testing.T) {
 		max       = 1000
 		stepCount = 11
 
+		targetBytes = 1000
+
 		sizer = NewDynamicTaskBatchSizer(0, testlogger.New(t), &config.Config{
 			ReplicatorProcessorMinTaskBatchSize:   func(_ int) int { return min },
 			ReplicatorProcessorMaxTaskBatchSize:   func(_ int) int { return max },
 			ReplicatorProcessorBatchSizeStepCount: func(_ int) int { return stepCount },
+			ReplicatorProcessorTargetBatchBytes:   func(_ int) int { return targetBytes },
 		}, metrics.NewNoopMetricsClient()).(*dynamicTaskBatchSizerImpl)
 	)
 
@@ -240,4 +243,26 @@ func TestDynamicTaskBatchSizer(t *testing.T) {
 		assert.Equal(t, 0, sizer.value())
 		assert.True(t, sizer.isFetchedTasks.Load())
 	})
+
+	t.Run("read level has changed, there are tasks in db, payload is below and then above target bytes", func(t *testing.T) {
+		state := &getTasksResult{
+			previousReadTaskID: 0,
+			lastReadTaskID:     10,
+			taskInfos:          make([]persistence.Task, 10),
+			msgs: &types.ReplicationMessages{
+				HasMore: true,
+			},
+			payloadBytes: targetBytes,
+		}
+
+		sizer.analyse(nil, state)
+		assert.Equal(t, 50, sizer.value())
+		assert.True(t, sizer.isFetchedTasks.Load())
+
+		state.payloadBytes = targetBytes + 1
+
+		sizer.analyse(nil, state)
+		assert.Equal(t, 0, sizer.value())
+		assert.True(t, sizer.isFetchedTasks.Load())
+	})
 }
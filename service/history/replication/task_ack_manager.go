@@ -26,6 +26,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"time"
 
@@ -35,6 +36,7 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/replicationstatus"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 )
@@ -120,6 +122,7 @@ type getTasksResult struct {
 	msgs               *types.ReplicationMessages
 	taskInfos          []persistence.Task
 	isShrunk           bool
+	payloadBytes       int
 }
 
 func (t *TaskAckManager) getTasks(ctx context.Context, pollingCluster string, lastReadTaskID int64) (*getTasksResult, error) {
@@ -189,10 +192,11 @@ func (t *TaskAckManager) getTasks(ctx context.Context, pollingCluster string, la
 	// Sometimes the total size of replication tasks can be larger than the max response size
 	// It caused the replication lag until history.replicatorTaskBatchSize is not adjusted to a smaller value
 	// To prevent the lag and manual actions, we stop adding more tasks to the batch if the total size exceeds the limit
-	isShrunk, err := t.shrinkMessagesBySize(msgs)
+	isShrunk, payloadBytes, err := t.shrinkMessagesBySize(msgs)
 	if err != nil {
 		return nil, err
 	}
+	t.scope.RecordTimer(metrics.ReplicationTasksReturnedBytes, time.Duration(payloadBytes))
 
 	t.scope.RecordTimer(metrics.ReplicationTasksLag, time.Duration(t.ackLevels.UpdateIfNeededAndGetQueueMaxReadLevel(persistence.HistoryTaskCategoryReplication, pollingCluster).GetTaskID()-msgs.LastRetrievedMessageID))
 	t.scope.RecordTimer(metrics.ReplicationTasksReturned, time.Duration(len(msgs.ReplicationTasks)))
@@ -213,9 +217,49 @@ func (t *TaskAckManager) getTasks(ctx context.Context, pollingCluster string, la
 		msgs:               msgs,
 		taskInfos:          taskInfos,
 		isShrunk:           isShrunk,
+		payloadBytes:       payloadBytes,
 	}, nil
 }
 
+// GetBacklog returns the replication tasks of each domain that the polling cluster hasn't applied yet, reading at
+// most maxTasks tasks in pages of pageSize tasks
+func (t *TaskAckManager) GetBacklog(ctx context.Context, pollingCluster string, pageSize, maxTasks int) ([]*replicationstatus.DomainBacklog, error) {
+	readLevel := t.ackLevels.GetQueueClusterAckLevel(persistence.HistoryTaskCategoryReplication, pollingCluster).GetTaskID()
+	maxReadLevel := t.ackLevels.UpdateIfNeededAndGetQueueMaxReadLevel(persistence.HistoryTaskCategoryReplication, pollingCluster).GetTaskID()
+
+	backlogs := make(map[string]*replicationstatus.DomainBacklog)
+	for read := 0; read < maxTasks; {
+		taskInfos, hasMore, err := t.reader.Read(ctx, readLevel, maxReadLevel, min(pageSize, maxTasks-read))
+		if err != nil {
+			return nil, err
+		}
+		for _, info := range taskInfos {
+			backlog, ok := backlogs[info.GetDomainID()]
+			if !ok {
+				// tasks are read in the order they were created, the first one of a domain is its oldest
+				backlog = &replicationstatus.DomainBacklog{
+					DomainID:       info.GetDomainID(),
+					OldestTaskTime: info.GetVisibilityTimestamp(),
+				}
+				backlogs[info.GetDomainID()] = backlog
+			}
+			backlog.PendingTasks++
+			readLevel = info.GetTaskID()
+		}
+		read += len(taskInfos)
+		if !hasMore || len(taskInfos) == 0 {
+			break
+		}
+	}
+
+	result := make([]*replicationstatus.DomainBacklog, 0, len(backlogs))
+	for _, backlog := range backlogs {
+		result = append(result, backlog)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].DomainID < result[j].DomainID })
+	return result, nil
+}
+
 // ackLevel updates the ack level for the given cluster
 func (t *TaskAckManager) ackLevel(pollingCluster string, lastReadTaskID int64) {
 	if err := t.ackLevels.UpdateQueueClusterAckLevel(persistence.HistoryTaskCategoryReplication, pollingCluster, persistence.NewImmediateTaskKey(lastReadTaskID)); err != nil {
@@ -228,10 +272,11 @@ func (t *TaskAckManager) ackLevel(pollingCluster string, lastReadTaskID int64) {
 }
 
 // shrinkMessagesBySize shrinks the replication messages by removing the last replication task until the total size is allowed
-func (t *TaskAckManager) shrinkMessagesBySize(msgs *types.ReplicationMessages) (bool, error) {
+// It returns whether the messages were shrunk and the total size of the returned messages
+func (t *TaskAckManager) shrinkMessagesBySize(msgs *types.ReplicationMessages) (bool, int, error) {
 	// if there are no replication tasks, do nothing
 	if len(msgs.ReplicationTasks) == 0 {
-		return false, nil
+		return false, 0, nil
 	}
 
 	maxSize := t.maxReplicationMessagesSize
@@ -242,7 +287,7 @@ func (t *TaskAckManager) shrinkMessagesBySize(msgs *types.ReplicationMessages) (
 
 		// if the total size is allowed, return the replication messages
 		if totalSize < maxSize {
-			return isShrunk, nil
+			return isShrunk, totalSize, nil
 		}
 
 		lastTask := msgs.ReplicationTasks[len(msgs.ReplicationTasks)-1]
@@ -265,7 +310,7 @@ func (t *TaskAckManager) shrinkMessagesBySize(msgs *types.ReplicationMessages) (
 		// should never happen, but just in case
 		// if there are no more replication tasks, return an error
 		if len(msgs.ReplicationTasks) == 0 {
-			return isShrunk, fmt.Errorf("replication messages size is too large and cannot be shrunk anymore, shard will be stuck until the message size is reduced or max size is increased")
+			return isShrunk, 0, fmt.Errorf("replication messages size is too large and cannot be shrunk anymore, shard will be stuck until the message size is reduced or max size is increased")
 
 		}
 
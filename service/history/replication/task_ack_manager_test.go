@@ -26,6 +26,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -35,6 +36,7 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/replicationstatus"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/common/types/mapper/proto"
 	"github.com/uber/cadence/service/history/config"
@@ -398,6 +400,107 @@ func TestTaskAckManager_GetTasks(t *testing.T) {
 	}
 }
 
+func TestTaskAckManager_GetBacklog(t *testing.T) {
+	otherDomainTask := persistence.HistoryReplicationTask{
+		WorkflowIdentifier: persistence.WorkflowIdentifier{DomainID: "other-domain-id"},
+		TaskData:           persistence.TaskData{TaskID: 13, VisibilityTimestamp: time.Unix(13, 0).UTC()},
+	}
+	task := func(taskID int64) *persistence.HistoryReplicationTask {
+		return &persistence.HistoryReplicationTask{
+			WorkflowIdentifier: persistence.WorkflowIdentifier{DomainID: testDomainID},
+			TaskData:           persistence.TaskData{TaskID: taskID, VisibilityTimestamp: time.Unix(taskID, 0).UTC()},
+		}
+	}
+	reader := pagedTaskReader{task(11), task(12), &otherDomainTask, task(14), task(15)}
+
+	tests := []struct {
+		name          string
+		reader        taskReader
+		pageSize      int
+		maxTasks      int
+		expectBacklog []*replicationstatus.DomainBacklog
+		expectErr     bool
+	}{
+		{
+			name:     "reads all the pages",
+			reader:   reader,
+			pageSize: 2,
+			maxTasks: 100,
+			expectBacklog: []*replicationstatus.DomainBacklog{
+				{DomainID: testDomainID, PendingTasks: 3, OldestTaskTime: time.Unix(11, 0).UTC()},
+				{DomainID: "other-domain-id", PendingTasks: 1, OldestTaskTime: time.Unix(13, 0).UTC()},
+			},
+		},
+		{
+			name:     "stops at max tasks",
+			reader:   reader,
+			pageSize: 2,
+			maxTasks: 3,
+			expectBacklog: []*replicationstatus.DomainBacklog{
+				{DomainID: testDomainID, PendingTasks: 2, OldestTaskTime: time.Unix(11, 0).UTC()},
+				{DomainID: "other-domain-id", PendingTasks: 1, OldestTaskTime: time.Unix(13, 0).UTC()},
+			},
+		},
+		{
+			name:      "read error",
+			reader:    pagedTaskReader(nil),
+			pageSize:  2,
+			maxTasks:  100,
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ackLevels := &fakeAckLevelStore{
+				readLevel: 14,
+				remote:    map[string]persistence.HistoryTaskKey{testClusterA: persistence.NewImmediateTaskKey(10)},
+			}
+			ackManager := NewTaskAckManager(
+				testShardID,
+				ackLevels,
+				metrics.NewNoopMetricsClient(),
+				log.NewNoop(),
+				tt.reader,
+				createTestTaskStore(t, fakeDomainCache{}, fakeTaskHydrator{}),
+				clock.NewMockedTimeSource(),
+				testConfig,
+				proto.ReplicationMessagesSize,
+				fakeDynamicTaskBatchSizer(10),
+			)
+			backlog, err := ackManager.GetBacklog(context.Background(), testClusterA, tt.pageSize, tt.maxTasks)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectBacklog, backlog)
+			}
+		})
+	}
+}
+
+// pagedTaskReader reads tasks after the read level up to the max read level, as TaskReader does
+type pagedTaskReader []persistence.Task
+
+func (r pagedTaskReader) Read(ctx context.Context, readLevel int64, maxReadLevel int64, batchSize int) ([]persistence.Task, bool, error) {
+	if r == nil {
+		return nil, false, errors.New("error reading replication tasks")
+	}
+
+	var result []persistence.Task
+	for _, task := range r {
+		if task.GetTaskID() <= readLevel || task.GetTaskID() > maxReadLevel {
+			continue
+		}
+		if len(result) == batchSize {
+			return result, true, nil
+		}
+		result = append(result, task)
+	}
+	return result, false, nil
+}
+
 type fakeAckLevelStore struct {
 	remote    map[string]persistence.HistoryTaskKey
 	readLevel int64
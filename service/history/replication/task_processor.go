@@ -26,6 +26,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sort"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -45,6 +46,7 @@ import (
 	"github.com/uber/cadence/common/quotas"
 	"github.com/uber/cadence/common/reconciliation"
 	"github.com/uber/cadence/common/reconciliation/entity"
+	"github.com/uber/cadence/common/replicationstatus"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/engine"
@@ -69,6 +71,11 @@ type (
 	// TaskProcessor is responsible for processing replication tasks for a shard.
 	TaskProcessor interface {
 		common.Daemon
+		// GetSourceCluster returns the cluster the processor applies the replication tasks of
+		GetSourceCluster() string
+		// DescribeDomainLags returns the replication lag of the domains the processor received tasks for, or which
+		// have tasks not applied yet in the source cluster
+		DescribeDomainLags(ctx context.Context) ([]*replicationstatus.DomainLag, error)
 	}
 
 	// taskProcessorImpl is responsible for processing replication tasks for a shard.
@@ -92,6 +99,7 @@ type (
 
 		lastProcessedMessageID int64
 		lastRetrievedMessageID int64
+		domainLags             *domainLagTracker
 
 		requestChan   chan<- *request
 		syncShardChan chan *types.SyncShardStatus
@@ -154,6 +162,7 @@ func NewTaskProcessor(
 		done:                   make(chan struct{}),
 		lastProcessedMessageID: constants.EmptyMessageID,
 		lastRetrievedMessageID: constants.EmptyMessageID,
+		domainLags:             newDomainLagTracker(clock),
 	}
 }
 
@@ -309,6 +318,7 @@ func (p *taskProcessorImpl) processResponse(response *types.ReplicationMessages)
 	scope := p.metricsClient.Scope(metrics.ReplicationTaskFetcherScope, metrics.TargetClusterTag(p.sourceCluster))
 	batchRequestStartTime := time.Now()
 	ctx := context.Background()
+	p.domainLags.fetched(response.ReplicationTasks)
 	for _, replicationTask := range response.ReplicationTasks {
 		// TODO: move to MultiStageRateLimiter
 		_ = p.hostRateLimiter.Wait(ctx)
@@ -513,6 +523,9 @@ func (p *taskProcessorImpl) processTaskOnce(replicationTask *types.ReplicationTa
 
 		// emit the number of replication tasks
 		mScope.IncCounter(metrics.ReplicationTasksAppliedPerDomain)
+		if lag := p.domainLags.applied(replicationTask); domainName != "" && lag > p.config.ReplicationDomainLagThreshold(domainName) {
+			mScope.IncCounter(metrics.ReplicationLagThresholdExceededPerDomain)
+		}
 		shardScope := p.metricsClient.Scope(scope, metrics.TargetClusterTag(p.sourceCluster), metrics.InstanceTag(strconv.Itoa(p.shard.GetShardID())))
 		shardScope.IncCounter(metrics.ReplicationTasksApplied)
 	}
@@ -520,6 +533,73 @@ func (p *taskProcessorImpl) processTaskOnce(replicationTask *types.ReplicationTa
 	return err
 }
 
+// GetSourceCluster returns the cluster the processor applies the replication tasks of
+func (p *taskProcessorImpl) GetSourceCluster() string {
+	return p.sourceCluster
+}
+
+// DescribeDomainLags returns the replication lag of the domains the processor received tasks for, or which have
+// tasks not applied yet in the source cluster
+func (p *taskProcessorImpl) DescribeDomainLags(ctx context.Context) ([]*replicationstatus.DomainLag, error) {
+	backlogs, err := p.describeSourceBacklog(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lags := p.domainLags.lags(backlogs)
+	result := make([]*replicationstatus.DomainLag, 0, len(lags))
+	for domainID, lag := range lags {
+		// the name is left empty if the domain was deleted, its default thresholds are used then
+		domainName, err := p.shard.GetDomainCache().GetDomainName(domainID)
+		if err != nil {
+			p.logger.Warn("Failed to get domain name when describing replication lag", tag.WorkflowDomainID(domainID), tag.Error(err))
+		}
+		thresholds := replicationstatus.Thresholds{
+			MaxLag:          p.config.ReplicationDomainLagThreshold(domainName),
+			MaxPendingTasks: int64(p.config.ReplicationDomainPendingTaskThreshold(domainName)),
+		}
+		result = append(result, &replicationstatus.DomainLag{
+			DomainID:          domainID,
+			DomainName:        domainName,
+			SourceCluster:     p.sourceCluster,
+			ShardID:           int32(p.shard.GetShardID()),
+			Lag:               lag.lag,
+			PendingTasks:      lag.pendingTasks,
+			LastAppliedTime:   lag.lastAppliedTime,
+			ExceedsThresholds: thresholds.Exceeded(lag.lag, lag.pendingTasks),
+		})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].DomainID < result[j].DomainID })
+	return result, nil
+}
+
+// describeSourceBacklog returns the replication tasks of the shard in the source cluster not applied yet,
+// source hosts which can't describe them return none
+func (p *taskProcessorImpl) describeSourceBacklog(ctx context.Context) ([]*replicationstatus.DomainBacklog, error) {
+	remoteAdminClient, err := p.shard.GetService().GetClientBean().GetRemoteAdminClient(p.sourceCluster)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := remoteAdminClient.DescribeQueue(ctx, &types.DescribeQueueRequest{
+		ShardID:     int32(p.shard.GetShardID()),
+		ClusterName: replicationstatus.BacklogClusterName(p.currentCluster),
+		Type:        common.Int32Ptr(int32(constants.TaskTypeReplication)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	backlogs := make([]*replicationstatus.DomainBacklog, 0, len(resp.ProcessingQueueStates))
+	for _, state := range resp.ProcessingQueueStates {
+		backlog, err := replicationstatus.DecodeDomainBacklog(state)
+		if err != nil {
+			return nil, err
+		}
+		backlogs = append(backlogs, backlog)
+	}
+	return backlogs, nil
+}
+
 func (p *taskProcessorImpl) putReplicationTaskToDLQ(request *persistence.PutReplicationTaskToDLQRequest) error {
 	p.metricsClient.Scope(
 		metrics.ReplicationDLQStatsScope,
@@ -55,6 +55,7 @@ import (
 	"github.com/uber/cadence/common/quotas"
 	"github.com/uber/cadence/common/reconciliation"
 	"github.com/uber/cadence/common/reconciliation/entity"
+	"github.com/uber/cadence/common/replicationstatus"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/engine"
@@ -713,6 +714,74 @@ func TestIsShuttingDown(t *testing.T) {
 	assert.True(t, taskProcessor.isShuttingDown())
 }
 
+func (s *taskProcessorSuite) TestDescribeDomainLags() {
+	s.taskExecutor.EXPECT().execute(gomock.Any(), false).Return(metrics.ScopeIdx(0), nil).Times(1)
+	s.mockDomainCache.EXPECT().GetDomainName(testDomainID).Return(testDomainName, nil).AnyTimes()
+	s.taskProcessor.config.ReplicationDomainLagThreshold = dynamicproperties.GetDurationPropertyFnFilteredByDomain(time.Minute)
+	s.taskProcessor.config.ReplicationDomainPendingTaskThreshold = dynamicproperties.GetIntPropertyFilteredByDomain(1)
+
+	newTask := func(age time.Duration) *types.ReplicationTask {
+		return &types.ReplicationTask{
+			TaskType: types.ReplicationTaskTypeHistoryV2.Ptr(),
+			HistoryTaskV2Attributes: &types.HistoryTaskV2Attributes{
+				DomainID:   testDomainID,
+				WorkflowID: testWorkflowID,
+				RunID:      testRunID,
+			},
+			CreationTime: common.Int64Ptr(s.clock.Now().Add(-age).UnixNano()),
+		}
+	}
+	tasks := []*types.ReplicationTask{newTask(2 * time.Minute), newTask(time.Second), newTask(time.Second)}
+	s.taskProcessor.domainLags.fetched(tasks)
+
+	backlogRequest := &types.DescribeQueueRequest{
+		ShardID:     0,
+		ClusterName: replicationstatus.BacklogClusterName(s.taskProcessor.currentCluster),
+		Type:        common.Int32Ptr(int32(constants.TaskTypeReplication)),
+	}
+	s.adminClient.EXPECT().DescribeQueue(gomock.Any(), backlogRequest).Return(&types.DescribeQueueResponse{}, nil).Times(1)
+	lags, err := s.taskProcessor.DescribeDomainLags(context.Background())
+	s.NoError(err)
+	s.Equal([]*replicationstatus.DomainLag{{
+		DomainID:          testDomainID,
+		DomainName:        testDomainName,
+		SourceCluster:     "standby",
+		ShardID:           0,
+		Lag:               2 * time.Minute,
+		PendingTasks:      3,
+		ExceedsThresholds: true,
+	}}, lags)
+
+	// the lag of the applied tasks isn't reported, the backlog of the source cluster has the tasks not fetched yet
+	s.NoError(s.taskProcessor.processTaskOnce(tasks[0]))
+	s.taskProcessor.domainLags.fetched(tasks[1:2])
+	backlog, err := replicationstatus.EncodeDomainBacklog(&replicationstatus.DomainBacklog{
+		DomainID:       testDomainID,
+		PendingTasks:   1,
+		OldestTaskTime: s.clock.Now().Add(-30 * time.Second),
+	})
+	s.NoError(err)
+	s.adminClient.EXPECT().DescribeQueue(gomock.Any(), backlogRequest).Return(&types.DescribeQueueResponse{
+		ProcessingQueueStates: []string{backlog},
+	}, nil).Times(1)
+	lags, err = s.taskProcessor.DescribeDomainLags(context.Background())
+	s.NoError(err)
+	s.Equal([]*replicationstatus.DomainLag{{
+		DomainID:          testDomainID,
+		DomainName:        testDomainName,
+		SourceCluster:     "standby",
+		ShardID:           0,
+		Lag:               30 * time.Second,
+		PendingTasks:      1,
+		LastAppliedTime:   s.clock.Now(),
+		ExceedsThresholds: false,
+	}}, lags)
+
+	s.adminClient.EXPECT().DescribeQueue(gomock.Any(), backlogRequest).Return(nil, errors.New("some error")).Times(1)
+	_, err = s.taskProcessor.DescribeDomainLags(context.Background())
+	s.Error(err)
+}
+
 func (s *taskProcessorSuite) TestProcessTaskOnce_OverThreshold_WithNoopLogger() {
 	s.taskExecutor.EXPECT().execute(gomock.Any(), false).Return(metrics.ScopeIdx(0), nil).Times(1)
 	// Domain cache is called for tagging
@@ -34,6 +34,8 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/persistence"
 	persistenceutils "github.com/uber/cadence/common/persistence/persistence-utils"
+	"github.com/uber/cadence/common/reapply"
+	"github.com/uber/cadence/common/searchattrupdate"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/execution"
 	"github.com/uber/cadence/service/history/shard"
@@ -57,6 +59,7 @@ type (
 			resetReason string,
 			additionalReapplyEvents []*types.HistoryEvent,
 			skipSignalReapply bool,
+			reapplyPolicy *reapply.Policy,
 		) error
 	}
 
@@ -109,6 +112,7 @@ func (r *workflowResetterImpl) ResetWorkflow(
 	resetReason string,
 	additionalReapplyEvents []*types.HistoryEvent,
 	skipSignalReapply bool,
+	reapplyPolicy *reapply.Policy,
 ) (retError error) {
 
 	domainEntry, err := r.domainCache.GetDomainByID(domainID)
@@ -145,6 +149,7 @@ func (r *workflowResetterImpl) ResetWorkflow(
 		resetReason,
 		additionalReapplyEvents,
 		skipSignalReapply,
+		reapplyPolicy,
 	)
 	if err != nil {
 		return err
@@ -174,6 +179,7 @@ func (r *workflowResetterImpl) prepareResetWorkflow(
 	resetReason string,
 	additionalReapplyEvents []*types.HistoryEvent,
 	skipSignalReapply bool,
+	reapplyPolicy *reapply.Policy,
 ) (execution.Workflow, error) {
 
 	resetWorkflow, err := r.replayResetWorkflow(
@@ -235,6 +241,7 @@ func (r *workflowResetterImpl) prepareResetWorkflow(
 			baseBranchToken,
 			baseRebuildLastEventID+1,
 			baseNextEventID,
+			reapplyPolicy,
 		); err != nil {
 			return nil, err
 		}
@@ -242,7 +249,7 @@ func (r *workflowResetterImpl) prepareResetWorkflow(
 	}
 
 	// NOTE: this is reapplying events that are passing into the API that we shouldn't skip
-	if err := r.reapplyEvents(resetMutableState, additionalReapplyEvents); err != nil {
+	if err := r.reapplyEvents(resetMutableState, additionalReapplyEvents, nil); err != nil {
 		return nil, err
 	}
 
@@ -461,6 +468,7 @@ func (r *workflowResetterImpl) reapplyResetAndContinueAsNewWorkflowEvents(
 	baseBranchToken []byte,
 	baseRebuildNextEventID int64,
 	baseNextEventID int64,
+	reapplyPolicy *reapply.Policy,
 ) error {
 
 	// TODO change this logic to fetching all workflow [baseWorkflow, currentWorkflow]
@@ -476,6 +484,7 @@ func (r *workflowResetterImpl) reapplyResetAndContinueAsNewWorkflowEvents(
 		baseRebuildNextEventID,
 		baseNextEventID,
 		baseBranchToken,
+		reapplyPolicy,
 	); err != nil {
 		return err
 	}
@@ -521,6 +530,7 @@ func (r *workflowResetterImpl) reapplyResetAndContinueAsNewWorkflowEvents(
 			constants.FirstEventID,
 			nextWorkflowNextEventID,
 			nextWorkflowBranchToken,
+			reapplyPolicy,
 		); err != nil {
 			return err
 		}
@@ -534,6 +544,7 @@ func (r *workflowResetterImpl) reapplyWorkflowEvents(
 	firstEventID int64,
 	nextEventID int64,
 	branchToken []byte,
+	reapplyPolicy *reapply.Policy,
 ) (string, error) {
 
 	// TODO change this logic to fetching all workflow [baseWorkflow, currentWorkflow]
@@ -563,7 +574,7 @@ func (r *workflowResetterImpl) reapplyWorkflowEvents(
 			return "", err
 		}
 		lastEvents = batch.(*types.History).Events
-		if err := r.reapplyEvents(mutableState, lastEvents); err != nil {
+		if err := r.reapplyEvents(mutableState, lastEvents, reapplyPolicy); err != nil {
 			return "", err
 		}
 	}
@@ -580,9 +591,13 @@ func (r *workflowResetterImpl) reapplyWorkflowEvents(
 func (r *workflowResetterImpl) reapplyEvents(
 	mutableState execution.MutableState,
 	events []*types.HistoryEvent,
+	reapplyPolicy *reapply.Policy,
 ) error {
 
 	for _, event := range events {
+		if !reapplyPolicy.ShouldReapply(event) {
+			continue
+		}
 		switch event.GetEventType() {
 		case types.EventTypeWorkflowExecutionSignaled:
 			attr := event.GetWorkflowExecutionSignaledEventAttributes()
@@ -594,8 +609,45 @@ func (r *workflowResetterImpl) reapplyEvents(
 			); err != nil {
 				return err
 			}
+		case types.EventTypeUpsertWorkflowSearchAttributes:
+			// upsert events can only follow a completed decision, so the search attributes are reapplied
+			// with a search attributes update signal instead
+			input, err := searchattrupdate.EncodeRequest(
+				event.GetUpsertWorkflowSearchAttributesEventAttributes().GetSearchAttributes(),
+			)
+			if err != nil {
+				return err
+			}
+			if _, err := mutableState.AddWorkflowExecutionSignaled(
+				searchattrupdate.SignalName,
+				input,
+				execution.IdentityHistoryService,
+				"",
+			); err != nil {
+				return err
+			}
+		case types.EventTypeWorkflowExecutionCancelRequested:
+			if cancelRequested, _ := mutableState.IsCancelRequested(); cancelRequested {
+				continue
+			}
+			attr := event.GetWorkflowExecutionCancelRequestedEventAttributes()
+			if _, err := mutableState.AddWorkflowExecutionCancelRequestedEvent(
+				attr.Cause,
+				&types.HistoryRequestCancelWorkflowExecutionRequest{
+					DomainUUID: mutableState.GetExecutionInfo().DomainID,
+					CancelRequest: &types.RequestCancelWorkflowExecutionRequest{
+						Identity: attr.Identity,
+						Cause:    attr.Cause,
+						// Do not set requestID for requests reapplied, because they have already been applied previously
+					},
+					ExternalInitiatedEventID:  attr.ExternalInitiatedEventID,
+					ExternalWorkflowExecution: attr.ExternalWorkflowExecution,
+				},
+			); err != nil {
+				return err
+			}
 		default:
-			// events other than signal will be ignored
+			// events other than the ones above are never reapplied
 		}
 	}
 	return nil
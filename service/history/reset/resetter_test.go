@@ -39,6 +39,8 @@ import (
 	"github.com/uber/cadence/common/log/testlogger"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/reapply"
+	"github.com/uber/cadence/common/searchattrupdate"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/constants"
@@ -485,6 +487,7 @@ func (s *workflowResetterSuite) TestReapplyContinueAsNewWorkflowEvents() {
 		baseBranchToken,
 		baseFirstEventID,
 		baseNextEventID,
+		nil,
 	)
 	s.NoError(err)
 }
@@ -545,6 +548,7 @@ func (s *workflowResetterSuite) TestReapplyWorkflowEvents() {
 		firstEventID,
 		nextEventID,
 		branchToken,
+		nil,
 	)
 	s.NoError(err)
 	s.Equal(newRunID, nextRunID)
@@ -656,7 +660,78 @@ func (s *workflowResetterSuite) TestReapplyEvents() {
 		}
 	}
 
-	err := s.workflowResetter.reapplyEvents(mutableState, events)
+	err := s.workflowResetter.reapplyEvents(mutableState, events, nil)
+	s.NoError(err)
+}
+
+func (s *workflowResetterSuite) TestReapplyEvents_Policy() {
+	searchAttributes := &types.SearchAttributes{IndexedFields: map[string][]byte{"CustomKeywordField": []byte(`"value"`)}}
+	events := []*types.HistoryEvent{
+		{
+			ID:        101,
+			EventType: types.EventTypeWorkflowExecutionSignaled.Ptr(),
+			WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{
+				SignalName: "kept",
+				Input:      []byte("input"),
+				Identity:   "identity",
+			},
+		},
+		{
+			ID:        102,
+			EventType: types.EventTypeWorkflowExecutionSignaled.Ptr(),
+			WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{
+				SignalName: "excluded",
+			},
+		},
+		{
+			ID:        103,
+			EventType: types.EventTypeUpsertWorkflowSearchAttributes.Ptr(),
+			UpsertWorkflowSearchAttributesEventAttributes: &types.UpsertWorkflowSearchAttributesEventAttributes{
+				SearchAttributes: searchAttributes,
+			},
+		},
+		{
+			ID:        104,
+			EventType: types.EventTypeWorkflowExecutionCancelRequested.Ptr(),
+			WorkflowExecutionCancelRequestedEventAttributes: &types.WorkflowExecutionCancelRequestedEventAttributes{
+				Cause:     "cause",
+				Identity:  "identity",
+				RequestID: "cancel-request-id",
+			},
+		},
+		{
+			ID:        105,
+			EventType: types.EventTypeWorkflowExecutionCancelRequested.Ptr(),
+			WorkflowExecutionCancelRequestedEventAttributes: &types.WorkflowExecutionCancelRequestedEventAttributes{
+				Cause: "second cause",
+			},
+		},
+	}
+	policy := &reapply.Policy{
+		EventTypes:          reapply.ReapplicableEventTypes,
+		ExcludedSignalNames: []string{"excluded"},
+	}
+	input, err := searchattrupdate.EncodeRequest(searchAttributes)
+	s.NoError(err)
+
+	mutableState := execution.NewMockMutableState(s.controller)
+	mutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{DomainID: s.domainID}).AnyTimes()
+	gomock.InOrder(
+		mutableState.EXPECT().AddWorkflowExecutionSignaled("kept", []byte("input"), "identity", "").Return(&types.HistoryEvent{}, nil),
+		mutableState.EXPECT().AddWorkflowExecutionSignaled(searchattrupdate.SignalName, input, execution.IdentityHistoryService, "").Return(&types.HistoryEvent{}, nil),
+		mutableState.EXPECT().IsCancelRequested().Return(false, ""),
+		mutableState.EXPECT().AddWorkflowExecutionCancelRequestedEvent("cause", &types.HistoryRequestCancelWorkflowExecutionRequest{
+			DomainUUID: s.domainID,
+			CancelRequest: &types.RequestCancelWorkflowExecutionRequest{
+				Identity: "identity",
+				Cause:    "cause",
+			},
+		}).Return(&types.HistoryEvent{}, nil),
+		// a workflow can only be requested to cancel once
+		mutableState.EXPECT().IsCancelRequested().Return(true, ""),
+	)
+
+	err = s.workflowResetter.reapplyEvents(mutableState, events, policy)
 	s.NoError(err)
 }
 
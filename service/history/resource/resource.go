@@ -27,6 +27,7 @@ import (
 	"sync/atomic"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/quotas"
 	"github.com/uber/cadence/common/quotas/global/algorithm"
@@ -44,6 +45,7 @@ type Resource interface {
 	GetEventCache() events.Cache
 	GetRatelimiterAlgorithm() algorithm.RequestWeighted
 	GetArchiverClient() archiver.Client
+	GetAuthorizer() authorization.Authorizer
 }
 
 type resourceImpl struct {
@@ -53,6 +55,7 @@ type resourceImpl struct {
 	eventCache         events.Cache
 	ratelimitAlgorithm algorithm.RequestWeighted
 	archiverClient     archiver.Client
+	authorizer         authorization.Authorizer
 }
 
 // Start starts all resources
@@ -97,6 +100,11 @@ func (h *resourceImpl) GetArchiverClient() archiver.Client {
 	return h.archiverClient
 }
 
+// GetAuthorizer return the authorizer used for the cross domain decisions
+func (h *resourceImpl) GetAuthorizer() authorization.Authorizer {
+	return h.authorizer
+}
+
 // New create a new resource containing common history dependencies
 func New(
 	params *resource.Params,
@@ -181,11 +189,19 @@ func New(
 		params.ArchiverProvider,
 		config.AllowArchivingIncompleteHistory,
 	)
+	authorizer := params.Authorizer
+	if authorizer == nil {
+		authorizer, err = authorization.NewAuthorizer(params.AuthorizationConfig, params.Logger, serviceResource.GetDomainCache())
+		if err != nil {
+			return nil, fmt.Errorf("invalid authorization config: %w", err)
+		}
+	}
 	historyResource = &resourceImpl{
 		Resource:           serviceResource,
 		eventCache:         eventCache,
 		ratelimitAlgorithm: ratelimitAlgorithm,
 		archiverClient:     archivalClient,
+		authorizer:         authorizer,
 	}
 	return
 }
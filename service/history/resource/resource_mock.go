@@ -25,6 +25,7 @@ import (
 	archiver "github.com/uber/cadence/common/archiver"
 	provider "github.com/uber/cadence/common/archiver/provider"
 	queue "github.com/uber/cadence/common/asyncworkflow/queue"
+	authorization "github.com/uber/cadence/common/authorization"
 	blobstore "github.com/uber/cadence/common/blobstore"
 	cache "github.com/uber/cadence/common/cache"
 	clock "github.com/uber/cadence/common/clock"
@@ -138,6 +139,20 @@ func (mr *MockResourceMockRecorder) GetAsyncWorkflowQueueProvider() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAsyncWorkflowQueueProvider", reflect.TypeOf((*MockResource)(nil).GetAsyncWorkflowQueueProvider))
 }
 
+// GetAuthorizer mocks base method.
+func (m *MockResource) GetAuthorizer() authorization.Authorizer {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuthorizer")
+	ret0, _ := ret[0].(authorization.Authorizer)
+	return ret0
+}
+
+// GetAuthorizer indicates an expected call of GetAuthorizer.
+func (mr *MockResourceMockRecorder) GetAuthorizer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthorizer", reflect.TypeOf((*MockResource)(nil).GetAuthorizer))
+}
+
 // GetBlobstoreClient mocks base method.
 func (m *MockResource) GetBlobstoreClient() blobstore.Client {
 	m.ctrl.T.Helper()
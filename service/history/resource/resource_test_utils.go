@@ -25,6 +25,7 @@ import (
 
 	"go.uber.org/mock/gomock"
 
+	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/quotas/global/algorithm"
 	"github.com/uber/cadence/common/resource"
@@ -39,6 +40,7 @@ type (
 		EventCache           *events.MockCache
 		ratelimiterAlgorithm algorithm.RequestWeighted
 		archiverClient       archiver.Client
+		Authorizer           authorization.Authorizer
 	}
 )
 
@@ -50,10 +52,12 @@ func NewTest(
 	controller *gomock.Controller,
 	serviceMetricsIndex metrics.ServiceIdx,
 ) *Test {
+	authorizer, _ := authorization.NewNopAuthorizer()
 	return &Test{
 		Test:           resource.NewTest(t, controller, serviceMetricsIndex),
 		EventCache:     events.NewMockCache(controller),
 		archiverClient: archiver.NewMockClient(controller),
+		Authorizer:     authorizer,
 	}
 }
 
@@ -69,3 +73,8 @@ func (s *Test) GetRatelimiterAlgorithm() algorithm.RequestWeighted {
 func (s *Test) GetArchiverClient() archiver.Client {
 	return s.archiverClient
 }
+
+// GetAuthorizer for testing
+func (s *Test) GetAuthorizer() authorization.Authorizer {
+	return s.Authorizer
+}
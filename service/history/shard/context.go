@@ -67,6 +67,8 @@ type (
 		GetMetricsClient() metrics.Client
 		GetTimeSource() clock.TimeSource
 		PreviousShardOwnerWasDifferent() bool
+		// IsHousekeepingQueueEnabled returns whether the housekeeping queue was enabled when the shard was loaded
+		IsHousekeepingQueueEnabled() bool
 
 		GetEngine() engine.Engine
 		SetEngine(engine.Engine)
@@ -142,6 +144,9 @@ type (
 
 		// true if previous owner was different from the acquirer's identity.
 		previousShardOwnerWasDifferent bool
+
+		// latched on shard load so that task routing and the housekeeping queue agree until the shard is reloaded
+		housekeepingQueueEnabled bool
 	}
 )
 
@@ -185,6 +190,10 @@ func (s *contextImpl) GetExecutionManager() persistence.ExecutionManager {
 	return s.executionManager
 }
 
+func (s *contextImpl) IsHousekeepingQueueEnabled() bool {
+	return s.housekeepingQueueEnabled
+}
+
 func (s *contextImpl) GetEngine() engine.Engine {
 	return s.engine
 }
@@ -364,6 +373,11 @@ func (s *contextImpl) GetQueueState(category persistence.HistoryTaskCategory) (*
 			queueState = &types.QueueState{
 				ExclusiveMaxReadLevel: &types.TaskKey{ScheduledTimeNano: s.shardInfo.TimerAckLevel.UnixNano()},
 			}
+		case persistence.HistoryTaskCategoryHousekeeping:
+			// housekeeping queue has no legacy ack level, start from the beginning so that no task is missed
+			queueState = &types.QueueState{
+				ExclusiveMaxReadLevel: &types.TaskKey{ScheduledTimeNano: 0},
+			}
 		default:
 			return nil, fmt.Errorf("unknown history task category: %v", category)
 		}
@@ -1252,7 +1266,7 @@ func (s *contextImpl) allocateTaskIDsLocked(
 			}
 			err = s.allocateTransferIDsLocked(tasks, immediateTaskMaxReadLevel)
 		case persistence.HistoryTaskCategoryTypeScheduled:
-			err = s.allocateTimerIDsLocked(domainEntry, workflowID, tasks)
+			err = s.allocateTimerIDsLocked(domainEntry, workflowID, c, tasks)
 		}
 		if err != nil {
 			return err
@@ -1295,6 +1309,7 @@ func (s *contextImpl) allocateTransferIDsLocked(
 func (s *contextImpl) allocateTimerIDsLocked(
 	domainEntry *cache.DomainCacheEntry,
 	workflowID string,
+	category persistence.HistoryTaskCategory,
 	timerTasks []persistence.Task,
 ) error {
 	now := s.GetTimeSource().Now().Truncate(persistence.DBTimestampMinPrecision)
@@ -1304,7 +1319,8 @@ func (s *contextImpl) allocateTimerIDsLocked(
 		ts := task.GetVisibilityTimestamp().Truncate(persistence.DBTimestampMinPrecision)
 		// always use current cluster's max read level for queue v2, and this is safe for rollback,
 		// because if we go back to queue v1, the standby queue and active queue will start from the same ack level to read tasks
-		if task.GetVersion() != constants.EmptyVersion && !s.GetConfig().EnableTimerQueueV2(s.shardID) {
+		// housekeeping queue only has a queue v2 implementation
+		if category == persistence.HistoryTaskCategoryTimer && task.GetVersion() != constants.EmptyVersion && !s.GetConfig().EnableTimerQueueV2(s.shardID) {
 			// cannot use version to determine the corresponding cluster for timer task
 			// this is because during failover, timer task should be created as active
 			// or otherwise, failover + active processing logic may not pick up the task.
@@ -1621,6 +1637,7 @@ func acquireShard(
 		logger:                         shardItem.logger,
 		throttledLogger:                shardItem.throttledLogger,
 		previousShardOwnerWasDifferent: ownershipChanged,
+		housekeepingQueueEnabled:       shardItem.config.EnableHousekeepingTaskQueue(shardItem.shardID),
 	}
 
 	// TODO remove once migrated to global event cache
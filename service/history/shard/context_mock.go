@@ -495,6 +495,20 @@ func (mr *MockContextMockRecorder) GetWorkflowExecution(ctx, request any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecution", reflect.TypeOf((*MockContext)(nil).GetWorkflowExecution), ctx, request)
 }
 
+// IsHousekeepingQueueEnabled mocks base method.
+func (m *MockContext) IsHousekeepingQueueEnabled() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsHousekeepingQueueEnabled")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsHousekeepingQueueEnabled indicates an expected call of IsHousekeepingQueueEnabled.
+func (mr *MockContextMockRecorder) IsHousekeepingQueueEnabled() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsHousekeepingQueueEnabled", reflect.TypeOf((*MockContext)(nil).IsHousekeepingQueueEnabled))
+}
+
 // PreviousShardOwnerWasDifferent mocks base method.
 func (m *MockContext) PreviousShardOwnerWasDifferent() bool {
 	m.ctrl.T.Helper()
@@ -154,6 +154,7 @@ func (s *contextTestSuite) TestAccessorMethods() {
 	s.Assert().EqualValues(testTransferMaxReadLevel, s.context.UpdateIfNeededAndGetQueueMaxReadLevel(persistence.HistoryTaskCategoryTransfer, cluster.TestCurrentClusterName).GetTaskID())
 	s.Assert().Equal(s.logger, s.context.GetLogger())
 	s.Assert().Equal(s.logger, s.context.GetThrottledLogger())
+	s.Assert().False(s.context.IsHousekeepingQueueEnabled())
 
 	mockEngine := engine.NewMockEngine(s.controller)
 	s.context.SetEngine(mockEngine)
@@ -245,6 +246,23 @@ func (s *contextTestSuite) TestTimerQueueState() {
 	}, s.context.shardInfo.TimerProcessingQueueStates.StatesByCluster[cluster.TestCurrentClusterName])
 }
 
+func (s *contextTestSuite) TestHousekeepingQueueState() {
+	queueState, err := s.context.GetQueueState(persistence.HistoryTaskCategoryHousekeeping)
+	s.NoError(err)
+	s.EqualValues(0, queueState.ExclusiveMaxReadLevel.ScheduledTimeNano)
+	s.Empty(queueState.VirtualQueueStates)
+
+	now := time.Now()
+	s.mockShardManager.On("UpdateShard", mock.Anything, mock.Anything).Once().Return(nil)
+	err = s.context.UpdateQueueState(persistence.HistoryTaskCategoryHousekeeping, &types.QueueState{
+		ExclusiveMaxReadLevel: &types.TaskKey{ScheduledTimeNano: now.UnixNano()},
+	})
+	s.NoError(err)
+	queueState, err = s.context.GetQueueState(persistence.HistoryTaskCategoryHousekeeping)
+	s.NoError(err)
+	s.Equal(now.UnixNano(), queueState.ExclusiveMaxReadLevel.ScheduledTimeNano)
+}
+
 func (s *contextTestSuite) TestTransferAckLevel() {
 	// validate default value returned
 	s.context.shardInfo.TransferAckLevel = 5
@@ -1289,7 +1307,7 @@ func (s *contextTestSuite) createMockTimerTask(params createMockTimerTaskParams)
 func (s *contextTestSuite) TestAllocateTimerIDsLocked_WhenNoTasksProvidedReturnsSuccessfully() {
 	domainCacheEntry := s.setupAllocateTimerIDsTest()
 
-	err := s.context.allocateTimerIDsLocked(domainCacheEntry, testWorkflowID, []persistence.Task{})
+	err := s.context.allocateTimerIDsLocked(domainCacheEntry, testWorkflowID, persistence.HistoryTaskCategoryTimer, []persistence.Task{})
 
 	s.NoError(err)
 }
@@ -1306,7 +1324,7 @@ func (s *contextTestSuite) TestAllocateTimerIDsLocked_WhenTaskHasEmptyVersionAll
 	})
 	originalTaskID := task.GetTaskID()
 
-	err := s.context.allocateTimerIDsLocked(domainCacheEntry, testWorkflowID, []persistence.Task{task})
+	err := s.context.allocateTimerIDsLocked(domainCacheEntry, testWorkflowID, persistence.HistoryTaskCategoryTimer, []persistence.Task{task})
 
 	s.NoError(err)
 	s.NotEqual(originalTaskID, task.GetTaskID(), "Task ID should have been updated")
@@ -1330,7 +1348,7 @@ func (s *contextTestSuite) TestAllocateTimerIDsLocked_WhenTaskHasVersionAllocate
 	})
 	originalTaskID := task.GetTaskID()
 
-	err := s.context.allocateTimerIDsLocked(domainCacheEntry, testWorkflowID, []persistence.Task{task})
+	err := s.context.allocateTimerIDsLocked(domainCacheEntry, testWorkflowID, persistence.HistoryTaskCategoryTimer, []persistence.Task{task})
 
 	s.NoError(err)
 	s.NotEqual(originalTaskID, task.GetTaskID(), "Task ID should have been updated")
@@ -1364,7 +1382,7 @@ func (s *contextTestSuite) TestAllocateTimerIDsLocked_WhenTimerQueueV2DisabledUs
 	})
 	originalTaskID := task.GetTaskID()
 
-	err := s.context.allocateTimerIDsLocked(domainCacheEntry, testWorkflowID, []persistence.Task{task})
+	err := s.context.allocateTimerIDsLocked(domainCacheEntry, testWorkflowID, persistence.HistoryTaskCategoryTimer, []persistence.Task{task})
 
 	s.NoError(err)
 	s.NotEqual(originalTaskID, task.GetTaskID(), "Task ID should have been updated")
@@ -1420,7 +1438,53 @@ func (s *contextTestSuite) TestAllocateTimerIDsLocked_WhenDomainIsActiveActiveUs
 	})
 	originalTaskID := task.GetTaskID()
 
-	err := s.context.allocateTimerIDsLocked(domainCacheEntry, testWorkflowID, []persistence.Task{task})
+	err := s.context.allocateTimerIDsLocked(domainCacheEntry, testWorkflowID, persistence.HistoryTaskCategoryTimer, []persistence.Task{task})
+
+	s.NoError(err)
+	s.NotEqual(originalTaskID, task.GetTaskID(), "Task ID should have been updated")
+	s.True(task.GetTaskID() > 0, "Task ID should be positive")
+}
+
+func (s *contextTestSuite) TestAllocateTimerIDsLocked_WhenHousekeepingCategorySkipsClusterManagerLookup() {
+	domainInfo := &persistence.DomainInfo{ID: testDomainID}
+	domainConfig := &persistence.DomainConfig{}
+	replicationConfig := &persistence.DomainReplicationConfig{
+		ActiveClusterName: "active-cluster",
+		Clusters: []*persistence.ClusterReplicationConfig{
+			{ClusterName: testCluster},
+		},
+		ActiveClusters: &types.ActiveClusters{
+			AttributeScopes: map[string]types.ClusterAttributeScope{
+				"region": {
+					ClusterAttributes: map[string]types.ActiveClusterInfo{
+						"region1": {
+							ActiveClusterName: "active-cluster",
+							FailoverVersion:   456,
+						},
+					},
+				},
+			},
+		},
+	}
+	domainCacheEntry := cache.NewDomainCacheEntryForTest(
+		domainInfo, domainConfig, true, replicationConfig, 456, nil, 123, 0, 1,
+	)
+
+	// Disable timer queue v2, housekeeping queue must not depend on it
+	s.context.config.EnableTimerQueueV2 = func(int) bool {
+		return false
+	}
+
+	task := s.createMockTimerTask(createMockTimerTaskParams{
+		Version:    123,
+		Timestamp:  time.Now().Add(time.Hour),
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      "test-run-id",
+	})
+	originalTaskID := task.GetTaskID()
+
+	err := s.context.allocateTimerIDsLocked(domainCacheEntry, testWorkflowID, persistence.HistoryTaskCategoryHousekeeping, []persistence.Task{task})
 
 	s.NoError(err)
 	s.NotEqual(originalTaskID, task.GetTaskID(), "Task ID should have been updated")
@@ -1446,7 +1510,7 @@ func (s *contextTestSuite) TestAllocateTimerIDsLocked_WhenTaskTimestampBeforeRea
 		RunID:      "test-run-id",
 	})
 
-	err := s.context.allocateTimerIDsLocked(domainCacheEntry, testWorkflowID, []persistence.Task{task})
+	err := s.context.allocateTimerIDsLocked(domainCacheEntry, testWorkflowID, persistence.HistoryTaskCategoryTimer, []persistence.Task{task})
 
 	s.NoError(err)
 
@@ -1481,7 +1545,7 @@ func (s *contextTestSuite) TestAllocateTimerIDsLocked_WhenTaskTimestampBeforeNow
 		RunID:      "test-run-id",
 	})
 
-	err := s.context.allocateTimerIDsLocked(domainCacheEntry, testWorkflowID, []persistence.Task{task})
+	err := s.context.allocateTimerIDsLocked(domainCacheEntry, testWorkflowID, persistence.HistoryTaskCategoryTimer, []persistence.Task{task})
 
 	s.NoError(err)
 
@@ -1542,7 +1606,7 @@ func (s *contextTestSuite) TestAllocateTimerIDsLocked_WhenClusterManagerLookupFa
 		RunID:      "test-run-id",
 	})
 
-	err := s.context.allocateTimerIDsLocked(domainCacheEntry, testWorkflowID, []persistence.Task{task})
+	err := s.context.allocateTimerIDsLocked(domainCacheEntry, testWorkflowID, persistence.HistoryTaskCategoryTimer, []persistence.Task{task})
 
 	s.Error(err)
 	s.Equal(assert.AnError, err)
@@ -1567,7 +1631,7 @@ func (s *contextTestSuite) TestAllocateTimerIDsLocked_WhenTaskIDGenerationFailsR
 		RunID:      "test-run-id",
 	})
 
-	err := s.context.allocateTimerIDsLocked(domainCacheEntry, testWorkflowID, []persistence.Task{task})
+	err := s.context.allocateTimerIDsLocked(domainCacheEntry, testWorkflowID, persistence.HistoryTaskCategoryTimer, []persistence.Task{task})
 
 	s.Error(err)
 	s.Equal(assert.AnError, err)
@@ -1611,7 +1675,7 @@ func (s *contextTestSuite) TestAllocateTimerIDsLocked_WhenMultipleTasksProvidedA
 	originalTaskID1 := task1.GetTaskID()
 	originalTaskID2 := task2.GetTaskID()
 
-	err := s.context.allocateTimerIDsLocked(domainCacheEntry, testWorkflowID, []persistence.Task{task1, task2})
+	err := s.context.allocateTimerIDsLocked(domainCacheEntry, testWorkflowID, persistence.HistoryTaskCategoryTimer, []persistence.Task{task1, task2})
 
 	s.NoError(err)
 	s.NotEqual(originalTaskID1, task1.GetTaskID(), "Task 1 ID should have been updated")
@@ -76,6 +76,9 @@ func NewTestContext(
 		remoteClusterCurrentTime:     make(map[string]time.Time),
 		eventsCache:                  eventsCache,
 	}
+	if config != nil && config.EnableHousekeepingTaskQueue != nil {
+		shard.housekeepingQueueEnabled = config.EnableHousekeepingTaskQueue(shardInfo.ShardID)
+	}
 	return &TestContext{
 		contextImpl:     shard,
 		Resource:        resource,
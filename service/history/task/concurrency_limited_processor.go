@@ -0,0 +1,115 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package task
+
+import (
+	"errors"
+	"sync/atomic"
+
+	"github.com/uber/cadence/common"
+)
+
+type (
+	concurrencyLimitedProcessor struct {
+		baseProcessor      Processor
+		concurrencyLimiter ConcurrencyLimiter
+		status             int32
+	}
+
+	// concurrencyLimitedTask releases its concurrency slot when the task is acked or nacked,
+	// every task submitted to the scheduler ends up being acked or nacked
+	concurrencyLimitedTask struct {
+		Task
+		release func()
+	}
+)
+
+var (
+	// ErrDomainConcurrencyLimitExceeded is returned when a task is submitted while its domain has reached the max concurrency
+	ErrDomainConcurrencyLimitExceeded = errors.New("domain task concurrency limit exceeded")
+)
+
+// NewConcurrencyLimitedProcessor creates a processor which rejects tasks of domains with too many outstanding tasks.
+// It doesn't weigh domains against each other, per-domain weights are applied by the domain-keyed scheduler of the
+// base processor, which handles the share of tasks set by TaskSchedulerMigrationRatio.
+func NewConcurrencyLimitedProcessor(
+	baseProcessor Processor,
+	concurrencyLimiter ConcurrencyLimiter,
+) Processor {
+	return &concurrencyLimitedProcessor{
+		baseProcessor:      baseProcessor,
+		concurrencyLimiter: concurrencyLimiter,
+		status:             common.DaemonStatusInitialized,
+	}
+}
+
+func (p *concurrencyLimitedProcessor) Start() {
+	if !atomic.CompareAndSwapInt32(&p.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+
+	p.baseProcessor.Start()
+}
+
+func (p *concurrencyLimitedProcessor) Stop() {
+	if !atomic.CompareAndSwapInt32(&p.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	p.baseProcessor.Stop()
+}
+
+func (p *concurrencyLimitedProcessor) Submit(t Task) error {
+	release, ok := p.concurrencyLimiter.Acquire(t)
+	if !ok {
+		return ErrDomainConcurrencyLimitExceeded
+	}
+	if err := p.baseProcessor.Submit(&concurrencyLimitedTask{Task: t, release: release}); err != nil {
+		release()
+		return err
+	}
+	return nil
+}
+
+func (p *concurrencyLimitedProcessor) TrySubmit(t Task) (bool, error) {
+	release, ok := p.concurrencyLimiter.Acquire(t)
+	if !ok {
+		return false, nil
+	}
+	submitted, err := p.baseProcessor.TrySubmit(&concurrencyLimitedTask{Task: t, release: release})
+	if err != nil || !submitted {
+		release()
+	}
+	return submitted, err
+}
+
+func (t *concurrencyLimitedTask) Ack() {
+	t.release()
+	t.Task.Ack()
+}
+
+func (t *concurrencyLimitedTask) Nack(err error) {
+	// release first as the task may be resubmitted on nack
+	t.release()
+	t.Task.Nack(err)
+}
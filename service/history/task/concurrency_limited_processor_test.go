@@ -0,0 +1,184 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package task
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	ctask "github.com/uber/cadence/common/task"
+)
+
+type concurrencyLimitedProcessorMockDeps struct {
+	mockProcessor          *MockProcessor
+	mockConcurrencyLimiter *MockConcurrencyLimiter
+	released               int
+}
+
+func setupMocksForConcurrencyLimitedProcessor(t *testing.T) (*concurrencyLimitedProcessor, *concurrencyLimitedProcessorMockDeps) {
+	ctrl := gomock.NewController(t)
+
+	deps := &concurrencyLimitedProcessorMockDeps{
+		mockProcessor:          NewMockProcessor(ctrl),
+		mockConcurrencyLimiter: NewMockConcurrencyLimiter(ctrl),
+	}
+
+	processor := NewConcurrencyLimitedProcessor(deps.mockProcessor, deps.mockConcurrencyLimiter)
+	cp, ok := processor.(*concurrencyLimitedProcessor)
+	require.True(t, ok)
+	return cp, deps
+}
+
+func (d *concurrencyLimitedProcessorMockDeps) release() {
+	d.released++
+}
+
+func TestConcurrencyLimitedProcessorLifecycle(t *testing.T) {
+	cp, deps := setupMocksForConcurrencyLimitedProcessor(t)
+
+	deps.mockProcessor.EXPECT().Start().Times(1)
+	cp.Start()
+
+	deps.mockProcessor.EXPECT().Stop().Times(1)
+	cp.Stop()
+}
+
+func TestConcurrencyLimitedProcessorSubmit(t *testing.T) {
+	testCases := []struct {
+		name             string
+		setupMocks       func(*concurrencyLimitedProcessorMockDeps)
+		expectedError    error
+		expectedReleased int
+	}{
+		{
+			name: "success",
+			setupMocks: func(deps *concurrencyLimitedProcessorMockDeps) {
+				deps.mockConcurrencyLimiter.EXPECT().Acquire(gomock.Any()).Return(deps.release, true)
+				deps.mockProcessor.EXPECT().Submit(gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "concurrency limited",
+			setupMocks: func(deps *concurrencyLimitedProcessorMockDeps) {
+				deps.mockConcurrencyLimiter.EXPECT().Acquire(gomock.Any()).Return(nil, false)
+			},
+			expectedError: ErrDomainConcurrencyLimitExceeded,
+		},
+		{
+			name: "processor error",
+			setupMocks: func(deps *concurrencyLimitedProcessorMockDeps) {
+				deps.mockConcurrencyLimiter.EXPECT().Acquire(gomock.Any()).Return(deps.release, true)
+				deps.mockProcessor.EXPECT().Submit(gomock.Any()).Return(errors.New("processor error"))
+			},
+			expectedError:    errors.New("processor error"),
+			expectedReleased: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cp, deps := setupMocksForConcurrencyLimitedProcessor(t)
+			tc.setupMocks(deps)
+
+			err := cp.Submit(&noopTask{})
+			assert.Equal(t, tc.expectedError, err)
+			assert.Equal(t, tc.expectedReleased, deps.released)
+		})
+	}
+}
+
+func TestConcurrencyLimitedProcessorTrySubmit(t *testing.T) {
+	testCases := []struct {
+		name             string
+		setupMocks       func(*concurrencyLimitedProcessorMockDeps)
+		expected         bool
+		expectError      bool
+		expectedReleased int
+	}{
+		{
+			name: "success",
+			setupMocks: func(deps *concurrencyLimitedProcessorMockDeps) {
+				deps.mockConcurrencyLimiter.EXPECT().Acquire(gomock.Any()).Return(deps.release, true)
+				deps.mockProcessor.EXPECT().TrySubmit(gomock.Any()).Return(true, nil)
+			},
+			expected: true,
+		},
+		{
+			name: "concurrency limited",
+			setupMocks: func(deps *concurrencyLimitedProcessorMockDeps) {
+				deps.mockConcurrencyLimiter.EXPECT().Acquire(gomock.Any()).Return(nil, false)
+			},
+		},
+		{
+			name: "not submitted",
+			setupMocks: func(deps *concurrencyLimitedProcessorMockDeps) {
+				deps.mockConcurrencyLimiter.EXPECT().Acquire(gomock.Any()).Return(deps.release, true)
+				deps.mockProcessor.EXPECT().TrySubmit(gomock.Any()).Return(false, nil)
+			},
+			expectedReleased: 1,
+		},
+		{
+			name: "error",
+			setupMocks: func(deps *concurrencyLimitedProcessorMockDeps) {
+				deps.mockConcurrencyLimiter.EXPECT().Acquire(gomock.Any()).Return(deps.release, true)
+				deps.mockProcessor.EXPECT().TrySubmit(gomock.Any()).Return(false, errors.New("submit error"))
+			},
+			expectError:      true,
+			expectedReleased: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cp, deps := setupMocksForConcurrencyLimitedProcessor(t)
+			tc.setupMocks(deps)
+
+			submitted, err := cp.TrySubmit(&noopTask{})
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expected, submitted)
+			assert.Equal(t, tc.expectedReleased, deps.released)
+		})
+	}
+}
+
+func TestConcurrencyLimitedTaskReleasesOnCompletion(t *testing.T) {
+	released := 0
+	task := &noopTask{state: ctask.TaskStatePending}
+	wrapped := &concurrencyLimitedTask{Task: task, release: func() { released++ }}
+
+	wrapped.Nack(nil)
+	assert.Equal(t, 1, released)
+	assert.Equal(t, ctask.TaskStatePending, task.State())
+
+	wrapped.Ack()
+	assert.Equal(t, 2, released)
+	assert.Equal(t, ctask.TaskStateAcked, task.State())
+}
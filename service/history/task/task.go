@@ -70,6 +70,9 @@ var (
 	ErrTaskDiscarded = errors.New("passive task pending for too long")
 	// ErrTaskPendingActive is the error indicating that the task should be re-dispatched
 	ErrTaskPendingActive = errors.New("redispatch the task while the domain is pending-active")
+	// ErrTargetDomainNotActive is the error indicating that the task should be re-dispatched
+	// until the target domain of the task is active in the current cluster
+	ErrTargetDomainNotActive = errors.New("redispatch the task while the target domain is not active")
 
 	activeTaskRedispatchPolicy  = createTaskRedispatchPolicy(activeTaskRedispatchInitialInterval)
 	standbyTaskRedispatchPolicy = createTaskRedispatchPolicy(standbyTaskRedispatchInitialInterval)
@@ -302,6 +305,12 @@ func (t *taskImpl) HandleErr(err error) (retErr error) {
 		return err
 	}
 
+	// this is a transient error until the target domain is failed back to the current cluster
+	if err == ErrTargetDomainNotActive {
+		t.scope.IncCounter(metrics.TaskNotActiveCounterPerDomain)
+		return err
+	}
+
 	if err == ErrTaskDiscarded {
 		t.scope.IncCounter(metrics.TaskDiscardedPerDomain)
 		err = nil
@@ -352,7 +361,7 @@ func (t *taskImpl) RetryErr(err error) bool {
 	}
 
 	var errShardClosed *shard.ErrShardClosed
-	if errors.As(err, &errShardClosed) || err == errWorkflowBusy || isRedispatchErr(err) || err == ErrTaskPendingActive || common.IsContextTimeoutError(err) {
+	if errors.As(err, &errShardClosed) || err == errWorkflowBusy || isRedispatchErr(err) || err == ErrTaskPendingActive || err == ErrTargetDomainNotActive || common.IsContextTimeoutError(err) {
 		return false
 	}
 
@@ -0,0 +1,104 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:generate mockgen -package $GOPACKAGE -destination task_concurrency_limiter_mock.go github.com/uber/cadence/service/history/task ConcurrencyLimiter
+
+package task
+
+import (
+	"sync"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/service/history/config"
+)
+
+type (
+	// ConcurrencyLimiter limits the number of outstanding tasks per domain
+	ConcurrencyLimiter interface {
+		// Acquire reserves a slot for the task, the returned function releases it and must be called once the task is completed
+		Acquire(Task) (release func(), ok bool)
+	}
+
+	taskConcurrencyLimiterImpl struct {
+		sync.Mutex
+
+		logger         log.Logger
+		metricsScope   metrics.Scope
+		domainCache    cache.DomainCache
+		maxConcurrency dynamicproperties.IntPropertyFnWithDomainFilter
+		outstanding    map[string]int
+	}
+)
+
+func NewConcurrencyLimiter(
+	logger log.Logger,
+	metricsClient metrics.Client,
+	domainCache cache.DomainCache,
+	config *config.Config,
+) ConcurrencyLimiter {
+	return &taskConcurrencyLimiterImpl{
+		logger:         logger,
+		metricsScope:   metricsClient.Scope(metrics.TaskSchedulerConcurrencyLimiterScope),
+		domainCache:    domainCache,
+		maxConcurrency: config.TaskSchedulerDomainMaxConcurrency,
+		outstanding:    make(map[string]int),
+	}
+}
+
+func (l *taskConcurrencyLimiterImpl) Acquire(t Task) (func(), bool) {
+	domainID := t.GetDomainID()
+	domainName, err := l.domainCache.GetDomainName(domainID)
+	if err != nil {
+		l.logger.Warn("failed to get domain name from domain cache", tag.WorkflowDomainID(domainID), tag.Error(err))
+	}
+	maxConcurrency := l.maxConcurrency(domainName)
+	scope := l.metricsScope.Tagged(metrics.DomainTag(domainName))
+
+	l.Lock()
+	defer l.Unlock()
+
+	if maxConcurrency > 0 && l.outstanding[domainID] >= maxConcurrency {
+		scope.IncCounter(metrics.TaskSchedulerThrottledCounterPerDomain)
+		return nil, false
+	}
+	l.outstanding[domainID]++
+	scope.IncCounter(metrics.TaskSchedulerAllowedCounterPerDomain)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { l.release(domainID) })
+	}, true
+}
+
+func (l *taskConcurrencyLimiterImpl) release(domainID string) {
+	l.Lock()
+	defer l.Unlock()
+
+	l.outstanding[domainID]--
+	if l.outstanding[domainID] <= 0 {
+		delete(l.outstanding, domainID)
+	}
+}
@@ -0,0 +1,106 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package task
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/service/history/config"
+)
+
+func newNoopTaskForDomain(domainID string) *noopTask {
+	return &noopTask{
+		Task: &persistence.DecisionTask{
+			WorkflowIdentifier: persistence.WorkflowIdentifier{
+				DomainID: domainID,
+			},
+		},
+	}
+}
+
+func TestConcurrencyLimiterAcquire(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockDomainCache := cache.NewMockDomainCache(ctrl)
+	mockDomainCache.EXPECT().GetDomainName("domain-1").Return("domain1", nil).AnyTimes()
+	mockDomainCache.EXPECT().GetDomainName("domain-2").Return("", errors.New("cache error")).AnyTimes()
+	dynamicClient := dynamicconfig.NewInMemoryClient()
+	require.NoError(t, dynamicClient.UpdateValue(dynamicproperties.TaskSchedulerDomainMaxConcurrency, 2))
+	limiter := NewConcurrencyLimiter(
+		testlogger.New(t),
+		metrics.NewNoopMetricsClient(),
+		mockDomainCache,
+		config.New(dynamicconfig.NewCollection(dynamicClient, testlogger.New(t)), 16, 1024, false, "hostname"),
+	)
+
+	release1, ok := limiter.Acquire(newNoopTaskForDomain("domain-1"))
+	require.True(t, ok)
+	release2, ok := limiter.Acquire(newNoopTaskForDomain("domain-1"))
+	require.True(t, ok)
+	_, ok = limiter.Acquire(newNoopTaskForDomain("domain-1"))
+	assert.False(t, ok, "domain-1 is at its limit")
+
+	// other domains are not affected, even when the domain name can't be resolved
+	_, ok = limiter.Acquire(newNoopTaskForDomain("domain-2"))
+	assert.True(t, ok)
+
+	// releasing twice frees a single slot
+	release1()
+	release1()
+	_, ok = limiter.Acquire(newNoopTaskForDomain("domain-1"))
+	assert.True(t, ok)
+	_, ok = limiter.Acquire(newNoopTaskForDomain("domain-1"))
+	assert.False(t, ok)
+
+	release2()
+	_, ok = limiter.Acquire(newNoopTaskForDomain("domain-1"))
+	assert.True(t, ok)
+}
+
+func TestConcurrencyLimiterAcquire_NoLimit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockDomainCache := cache.NewMockDomainCache(ctrl)
+	mockDomainCache.EXPECT().GetDomainName("domain-1").Return("domain1", nil).AnyTimes()
+	limiter := NewConcurrencyLimiter(
+		testlogger.New(t),
+		metrics.NewNoopMetricsClient(),
+		mockDomainCache,
+		config.New(dynamicconfig.NewCollection(dynamicconfig.NewInMemoryClient(), testlogger.New(t)), 16, 1024, false, "hostname"),
+	)
+
+	for i := 0; i < 100; i++ {
+		_, ok := limiter.Acquire(newNoopTaskForDomain("domain-1"))
+		require.True(t, ok)
+	}
+	assert.Equal(t, 100, limiter.(*taskConcurrencyLimiterImpl).outstanding["domain-1"])
+}
@@ -126,6 +126,11 @@ func GetTransferTaskMetricsScope(
 			return metrics.TransferActiveTaskApplyParentClosePolicyScope
 		}
 		return metrics.TransferStandbyTaskApplyParentClosePolicyScope
+	case persistence.TransferTaskTypeCompletionCallback:
+		if isActive {
+			return metrics.TransferActiveTaskCompletionCallbackScope
+		}
+		return metrics.TransferStandbyTaskCompletionCallbackScope
 	default:
 		if isActive {
 			return metrics.TransferActiveQueueProcessorScope
@@ -175,6 +180,11 @@ func GetTimerTaskMetricScope(
 			return metrics.TimerActiveTaskWorkflowBackoffTimerScope
 		}
 		return metrics.TimerStandbyTaskWorkflowBackoffTimerScope
+	case persistence.TaskTypeDelayedSignalTimer:
+		if isActive {
+			return metrics.TimerActiveTaskDelayedSignalTimerScope
+		}
+		return metrics.TimerStandbyTaskDelayedSignalTimerScope
 	default:
 		if isActive {
 			return metrics.TimerActiveQueueProcessorScope
@@ -356,6 +366,34 @@ func shouldPushToMatching(
 	return true, nil
 }
 
+// getActivityPartitionConfig returns the partition config an activity task is pushed to matching with.
+// When withActivityType is set, the activity type is added so that matching can apply per activity type dispatch rates.
+func getActivityPartitionConfig(
+	ctx context.Context,
+	mutableState execution.MutableState,
+	scheduleID int64,
+	withActivityType bool,
+) (map[string]string, error) {
+	partitionConfig := mutableState.GetExecutionInfo().PartitionConfig
+	if !withActivityType {
+		return partitionConfig, nil
+	}
+	scheduledEvent, err := mutableState.GetActivityScheduledEvent(ctx, scheduleID)
+	if err != nil {
+		return nil, err
+	}
+	activityType := scheduledEvent.ActivityTaskScheduledEventAttributes.GetActivityType().GetName()
+	if activityType == "" {
+		return partitionConfig, nil
+	}
+	result := make(map[string]string, len(partitionConfig)+1)
+	for k, v := range partitionConfig {
+		result[k] = v
+	}
+	result[constants.ActivityTypePartitionConfigKey] = activityType
+	return result, nil
+}
+
 // NewMockTaskMatcher creates a gomock matcher for mock Task
 func NewMockTaskMatcher(mockTask *MockTask) gomock.Matcher {
 	return &mockTaskMatcher{
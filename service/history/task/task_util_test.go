@@ -204,6 +204,18 @@ func TestGetTransferTaskMetricsScope(t *testing.T) {
 			isActive:      false,
 			expectedScope: metrics.TransferStandbyTaskRecordWorkflowClosedScope,
 		},
+		{
+			name:          "TransferTaskTypeCompletionCallback - active",
+			taskType:      persistence.TransferTaskTypeCompletionCallback,
+			isActive:      true,
+			expectedScope: metrics.TransferActiveTaskCompletionCallbackScope,
+		},
+		{
+			name:          "TransferTaskTypeCompletionCallback - standby",
+			taskType:      persistence.TransferTaskTypeCompletionCallback,
+			isActive:      false,
+			expectedScope: metrics.TransferStandbyTaskCompletionCallbackScope,
+		},
 		{
 			name:          "TransferTaskTypeRecordChildExecutionCompleted - active",
 			taskType:      persistence.TransferTaskTypeRecordChildExecutionCompleted,
@@ -328,6 +340,18 @@ func TestGetTimerTaskMetricScope(t *testing.T) {
 			isActive:      false,
 			expectedScope: metrics.TimerStandbyTaskWorkflowBackoffTimerScope,
 		},
+		{
+			name:          "TimerTaskTypeDelayedSignalTimer - active",
+			taskType:      persistence.TaskTypeDelayedSignalTimer,
+			isActive:      true,
+			expectedScope: metrics.TimerActiveTaskDelayedSignalTimerScope,
+		},
+		{
+			name:          "TimerTaskTypeDelayedSignalTimer - standby",
+			taskType:      persistence.TaskTypeDelayedSignalTimer,
+			isActive:      false,
+			expectedScope: metrics.TimerStandbyTaskDelayedSignalTimerScope,
+		},
 		{
 			name:          "TimerTaskTypeDeleteHistoryEvent - active",
 			taskType:      persistence.TaskTypeDeleteHistoryEvent,
@@ -976,3 +1000,65 @@ func getDomainCacheEntry(isGlobal, isActiveActive bool) *cache.DomainCacheEntry
 		1,
 	)
 }
+
+func TestGetActivityPartitionConfig(t *testing.T) {
+	partitionConfig := map[string]string{"isolation-group": "zone-a"}
+	scheduledEvent := &types.HistoryEvent{
+		ActivityTaskScheduledEventAttributes: &types.ActivityTaskScheduledEventAttributes{
+			ActivityType: &types.ActivityType{Name: "activity-type"},
+		},
+	}
+
+	tests := []struct {
+		name             string
+		withActivityType bool
+		setupMock        func(mockMutableState *execution.MockMutableState)
+		expectedResult   map[string]string
+		expectedError    string
+	}{
+		{
+			name:             "activity type not requested",
+			withActivityType: false,
+			expectedResult:   partitionConfig,
+		},
+		{
+			name:             "activity type added",
+			withActivityType: true,
+			setupMock: func(mockMutableState *execution.MockMutableState) {
+				mockMutableState.EXPECT().GetActivityScheduledEvent(gomock.Any(), int64(5)).Return(scheduledEvent, nil)
+			},
+			expectedResult: map[string]string{
+				"isolation-group": "zone-a",
+				commonconstants.ActivityTypePartitionConfigKey: "activity-type",
+			},
+		},
+		{
+			name:             "failed to get scheduled event",
+			withActivityType: true,
+			setupMock: func(mockMutableState *execution.MockMutableState) {
+				mockMutableState.EXPECT().GetActivityScheduledEvent(gomock.Any(), int64(5)).Return(nil, errors.New("some error"))
+			},
+			expectedError: "some error",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockMutableState := execution.NewMockMutableState(ctrl)
+			mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{PartitionConfig: partitionConfig}).AnyTimes()
+			if tc.setupMock != nil {
+				tc.setupMock(mockMutableState)
+			}
+
+			result, err := getActivityPartitionConfig(context.Background(), mockMutableState, 5, tc.withActivityType)
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, result)
+			assert.Equal(t, map[string]string{"isolation-group": "zone-a"}, partitionConfig)
+		})
+	}
+}
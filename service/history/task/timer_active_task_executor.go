@@ -27,13 +27,16 @@ import (
 
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/activitycontrol"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/delayedsignal"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/workflowpause"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/execution"
 	"github.com/uber/cadence/service/history/shard"
@@ -121,6 +124,10 @@ func (t *timerActiveTaskExecutor) Execute(task Task) (ExecuteResponse, error) {
 		ctx, cancel := context.WithTimeout(t.ctx, taskDefaultTimeout)
 		defer cancel()
 		return executeResponse, t.executeWorkflowBackoffTimerTask(ctx, timerTask)
+	case *persistence.DelayedSignalTimerTask:
+		ctx, cancel := context.WithTimeout(t.ctx, taskDefaultTimeout)
+		defer cancel()
+		return executeResponse, t.executeDelayedSignalTimerTask(ctx, timerTask)
 	case *persistence.DeleteHistoryEventTask:
 		ctx, cancel := context.WithTimeout(t.ctx, time.Duration(t.config.DeleteHistoryEventContextTimeout())*time.Second)
 		defer cancel()
@@ -160,6 +167,10 @@ func (t *timerActiveTaskExecutor) executeUserTimerTimeoutTask(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if workflowpause.IsPaused(mutableState.GetExecutionInfo().Memo) {
+		// user timers are deferred until the workflow is resumed
+		return nil
+	}
 
 	timerSequence := execution.NewTimerSequence(mutableState)
 	referenceTime := t.shard.GetTimeSource().Now()
@@ -302,6 +313,10 @@ func (t *timerActiveTaskExecutor) executeActivityTimeoutTask(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if workflowpause.IsPaused(mutableState.GetExecutionInfo().Memo) {
+		// activity timeouts are deferred until the workflow is resumed
+		return nil
+	}
 
 	wfType := mutableState.GetWorkflowType()
 	if wfType == nil {
@@ -589,7 +604,6 @@ func (t *timerActiveTaskExecutor) executeWorkflowBackoffTimerTask(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
-
 	if task.TimeoutType == persistence.WorkflowBackoffTimeoutTypeRetry {
 		t.metricsClient.IncCounter(metrics.TimerActiveTaskWorkflowBackoffTimerScope, metrics.WorkflowRetryBackoffTimerCount)
 	} else {
@@ -605,6 +619,56 @@ func (t *timerActiveTaskExecutor) executeWorkflowBackoffTimerTask(
 	return t.updateWorkflowExecution(ctx, wfContext, mutableState, true)
 }
 
+func (t *timerActiveTaskExecutor) executeDelayedSignalTimerTask(
+	ctx context.Context,
+	task *persistence.DelayedSignalTimerTask,
+) (retError error) {
+
+	wfContext, release, err := t.executionCache.GetOrCreateWorkflowExecutionWithTimeout(
+		task.DomainID,
+		getWorkflowExecution(task),
+		taskGetExecutionContextTimeout,
+	)
+	if err != nil {
+		if err == context.DeadlineExceeded {
+			return errWorkflowBusy
+		}
+		return err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := loadMutableState(ctx, wfContext, task, t.metricsClient.Scope(metrics.TimerQueueProcessorScope), t.logger, 0)
+	if err != nil {
+		return err
+	}
+	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
+		return nil
+	}
+
+	signal, ok := delayedsignal.GetPending(mutableState.GetExecutionInfo().Memo)[task.DelayedSignalID]
+	if !ok {
+		// delayed signal is already delivered or cancelled
+		return nil
+	}
+	ok, err = verifyTaskVersion(t.shard, t.logger, task.DomainID, signal.Version, task.Version, task)
+	if err != nil || !ok {
+		return err
+	}
+
+	if _, err := mutableState.AddWorkflowExecutionSignaled(
+		signal.SignalName,
+		signal.Input,
+		signal.Identity,
+		delayedsignal.RequestID(task.RunID, task.DelayedSignalID),
+	); err != nil {
+		return err
+	}
+
+	// same as signals sent to the workflow, do not start a cron workflow before its schedule
+	scheduleDecision := mutableState.GetExecutionInfo().CronSchedule == "" || mutableState.HasProcessedOrPendingDecision()
+	return t.updateWorkflowExecution(ctx, wfContext, mutableState, scheduleDecision)
+}
+
 func (t *timerActiveTaskExecutor) executeActivityRetryTimerTask(
 	ctx context.Context,
 	task *persistence.ActivityRetryTimerTask,
@@ -630,6 +694,10 @@ func (t *timerActiveTaskExecutor) executeActivityRetryTimerTask(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if workflowpause.IsPaused(mutableState.GetExecutionInfo().Memo) {
+		// activity retries are deferred until the workflow is resumed
+		return nil
+	}
 
 	// generate activity task
 	scheduledID := task.EventID
@@ -652,6 +720,10 @@ func (t *timerActiveTaskExecutor) executeActivityRetryTimerTask(
 	if err != nil || !ok {
 		return err
 	}
+	if activitycontrol.IsPaused(mutableState.GetExecutionInfo().Memo, activityInfo.ScheduleID) {
+		// the retry is dispatched when the activity is resumed
+		return nil
+	}
 
 	domainID := task.DomainID
 	targetDomainID := domainID
@@ -682,6 +754,15 @@ func (t *timerActiveTaskExecutor) executeActivityRetryTimerTask(
 		Name: activityInfo.TaskList,
 	}
 	scheduleToStartTimeout := activityInfo.ScheduleToStartTimeout
+	partitionConfig, err := getActivityPartitionConfig(
+		ctx,
+		mutableState,
+		scheduledID,
+		t.config.EnableActivityTypeDispatchRateLimit(mutableState.GetDomainEntry().GetInfo().Name),
+	)
+	if err != nil {
+		return err
+	}
 
 	release(nil) // release earlier as we don't need the lock anymore
 
@@ -700,7 +781,7 @@ func (t *timerActiveTaskExecutor) executeActivityRetryTimerTask(
 		TaskList:                      taskList,
 		ScheduleID:                    scheduledID,
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(scheduleToStartTimeout),
-		PartitionConfig:               mutableState.GetExecutionInfo().PartitionConfig,
+		PartitionConfig:               partitionConfig,
 	})
 	return err
 }
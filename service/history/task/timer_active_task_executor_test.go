@@ -34,15 +34,18 @@ import (
 
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/activitycontrol"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
 	commonconstants "github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/delayedsignal"
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/workflowpause"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/constants"
 	"github.com/uber/cadence/service/history/engine"
@@ -217,6 +220,40 @@ func (s *timerActiveTaskExecutorSuite) TestProcessUserTimerTimeout_Noop() {
 	s.NoError(err)
 }
 
+func (s *timerActiveTaskExecutorSuite) TestProcessUserTimerTimeout_Paused() {
+
+	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
+	s.NoError(err)
+
+	timerID := "timer"
+	timerTimeout := 2 * time.Second
+	_, _ = test.AddTimerStartedEvent(mutableState, decisionCompletionID, timerID, int64(timerTimeout.Seconds()))
+
+	timerSequence := execution.NewTimerSequence(mutableState)
+	mutableState.DeleteTimerTasks()
+	modified, err := timerSequence.CreateNextUserTimer()
+	s.NoError(err)
+	s.True(modified)
+	task := mutableState.GetTimerTasks()[0]
+	timerTask := s.newTimerTaskFromInfo(task)
+
+	_, err = mutableState.AddWorkflowExecutionSignaled(workflowpause.PauseSignalName, nil, "operator", "")
+	s.NoError(err)
+	mutableState.FlushBufferedEvents()
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(s.T(), mutableState, mutableState.GetNextEventID()-1, mutableState.GetCurrentVersion())
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+
+	s.timeSource.Advance(2 * timerTimeout)
+	_, err = s.timerActiveTaskExecutor.Execute(timerTask)
+	s.NoError(err)
+
+	// the timer is deferred until the workflow is resumed
+	_, ok := s.getMutableStateFromCache(s.domainID, workflowExecution.GetWorkflowID(), workflowExecution.GetRunID()).GetUserTimerInfo(timerID)
+	s.True(ok)
+}
+
 func (s *timerActiveTaskExecutorSuite) TestProcessUserTimerTimeout_Resurrected() {
 
 	_, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
@@ -939,6 +976,130 @@ func (s *timerActiveTaskExecutorSuite) TestWorkflowBackoffTimer_Noop() {
 	s.NoError(err)
 }
 
+func (s *timerActiveTaskExecutorSuite) TestWorkflowBackoffTimer_Paused() {
+
+	workflowExecution, mutableState, err := test.StartWorkflow(s.T(), s.mockShard, s.domainID)
+	s.NoError(err)
+	_, err = mutableState.AddWorkflowExecutionSignaled(workflowpause.PauseSignalName, nil, "operator", "")
+	s.NoError(err)
+	mutableState.FlushBufferedEvents()
+
+	timerTask := s.newTimerTaskFromInfo(&persistence.WorkflowBackoffTimerTask{
+		WorkflowIdentifier: persistence.WorkflowIdentifier{
+			DomainID:   s.domainID,
+			WorkflowID: workflowExecution.GetWorkflowID(),
+			RunID:      workflowExecution.GetRunID(),
+		},
+		TaskData: persistence.TaskData{
+			Version:             s.version,
+			TaskID:              int64(100),
+			VisibilityTimestamp: s.timeSource.Now(),
+		},
+		TimeoutType: persistence.WorkflowBackoffTimeoutTypeRetry,
+	})
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(s.T(), mutableState, mutableState.GetNextEventID()-1, mutableState.GetCurrentVersion())
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	_, err = s.timerActiveTaskExecutor.Execute(timerTask)
+	s.NoError(err)
+
+	// the first decision is scheduled, its task is dropped while paused and generated again once resumed
+	_, ok := s.getMutableStateFromCache(s.domainID, workflowExecution.GetWorkflowID(), workflowExecution.GetRunID()).GetPendingDecision()
+	s.True(ok)
+}
+
+func (s *timerActiveTaskExecutorSuite) TestDelayedSignalTimer_Fire() {
+
+	workflowExecution, mutableState, _, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
+	s.NoError(err)
+	input, err := delayedsignal.EncodeRequest(&delayedsignal.Request{
+		SignalName:       "reminder",
+		Input:            []byte("payload"),
+		DeliverAfterNano: s.timeSource.Now().UnixNano(),
+	})
+	s.NoError(err)
+	_, err = mutableState.AddWorkflowExecutionSignaled(delayedsignal.SignalName, input, "operator", "")
+	s.NoError(err)
+	pending := delayedsignal.GetPending(mutableState.GetExecutionInfo().Memo)
+	s.Len(pending, 1)
+
+	timerTask := s.newTimerTaskFromInfo(&persistence.DelayedSignalTimerTask{
+		WorkflowIdentifier: persistence.WorkflowIdentifier{
+			DomainID:   s.domainID,
+			WorkflowID: workflowExecution.GetWorkflowID(),
+			RunID:      workflowExecution.GetRunID(),
+		},
+		TaskData: persistence.TaskData{
+			Version:             pending[1].Version,
+			TaskID:              int64(100),
+			VisibilityTimestamp: s.timeSource.Now(),
+		},
+		DelayedSignalID: 1,
+	})
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(s.T(), mutableState, mutableState.GetNextEventID()-1, mutableState.GetCurrentVersion())
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	_, err = s.timerActiveTaskExecutor.Execute(timerTask)
+	s.NoError(err)
+
+	updatedMutableState := s.getMutableStateFromCache(s.domainID, workflowExecution.GetWorkflowID(), workflowExecution.GetRunID())
+	s.Empty(delayedsignal.GetPending(updatedMutableState.GetExecutionInfo().Memo))
+	s.Equal(int32(2), updatedMutableState.GetExecutionInfo().SignalCount)
+	_, ok := updatedMutableState.GetPendingDecision()
+	s.True(ok)
+}
+
+func (s *timerActiveTaskExecutorSuite) TestDelayedSignalTimer_Cancelled() {
+
+	workflowExecution, mutableState, _, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
+	s.NoError(err)
+	input, err := delayedsignal.EncodeRequest(&delayedsignal.Request{
+		SignalName:       "reminder",
+		DeliverAfterNano: s.timeSource.Now().UnixNano(),
+	})
+	s.NoError(err)
+	_, err = mutableState.AddWorkflowExecutionSignaled(delayedsignal.SignalName, input, "operator", "")
+	s.NoError(err)
+	input, err = delayedsignal.EncodeRequest(&delayedsignal.Request{ID: 1})
+	s.NoError(err)
+	_, err = mutableState.AddWorkflowExecutionSignaled(delayedsignal.CancelSignalName, input, "operator", "")
+	s.NoError(err)
+
+	timerTask := s.newTimerTaskFromInfo(&persistence.DelayedSignalTimerTask{
+		WorkflowIdentifier: persistence.WorkflowIdentifier{
+			DomainID:   s.domainID,
+			WorkflowID: workflowExecution.GetWorkflowID(),
+			RunID:      workflowExecution.GetRunID(),
+		},
+		TaskData: persistence.TaskData{
+			Version:             s.version,
+			TaskID:              int64(100),
+			VisibilityTimestamp: s.timeSource.Now(),
+		},
+		DelayedSignalID: 1,
+	})
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(s.T(), mutableState, mutableState.GetNextEventID()-1, mutableState.GetCurrentVersion())
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil).Once()
+
+	_, err = s.timerActiveTaskExecutor.Execute(timerTask)
+	s.NoError(err)
+
+	updatedMutableState := s.getMutableStateFromCache(s.domainID, workflowExecution.GetWorkflowID(), workflowExecution.GetRunID())
+	s.Equal(int32(2), updatedMutableState.GetExecutionInfo().SignalCount)
+	_, ok := updatedMutableState.GetPendingDecision()
+	s.False(ok)
+}
+
 func (s *timerActiveTaskExecutorSuite) TestActivityRetryTimer_Fire() {
 
 	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
@@ -1004,6 +1165,62 @@ func (s *timerActiveTaskExecutorSuite) TestActivityRetryTimer_Fire() {
 	s.NoError(err)
 }
 
+func (s *timerActiveTaskExecutorSuite) TestActivityRetryTimer_Paused() {
+
+	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
+	s.NoError(err)
+
+	timerTimeout := 2 * time.Second
+	_, activityInfo := test.AddActivityTaskScheduledEventWithRetry(
+		mutableState,
+		decisionCompletionID,
+		"activity",
+		"activity type",
+		mutableState.GetExecutionInfo().TaskList,
+		[]byte(nil),
+		int32(timerTimeout.Seconds()),
+		int32(timerTimeout.Seconds()),
+		int32(timerTimeout.Seconds()),
+		int32(timerTimeout.Seconds()),
+		&types.RetryPolicy{
+			InitialIntervalInSeconds:    1,
+			BackoffCoefficient:          1.2,
+			MaximumIntervalInSeconds:    5,
+			MaximumAttempts:             5,
+			ExpirationIntervalInSeconds: 999,
+		},
+	)
+	activityInfo.Attempt = 1
+	input, err := activitycontrol.EncodeRequest(&activitycontrol.Request{ActivityID: activityInfo.ActivityID})
+	s.NoError(err)
+	_, err = mutableState.AddWorkflowExecutionSignaled(activitycontrol.PauseSignalName, input, "operator", "")
+	s.NoError(err)
+	mutableState.FlushBufferedEvents()
+
+	timerTask := s.newTimerTaskFromInfo(&persistence.ActivityRetryTimerTask{
+		WorkflowIdentifier: persistence.WorkflowIdentifier{
+			DomainID:   s.domainID,
+			WorkflowID: workflowExecution.GetWorkflowID(),
+			RunID:      workflowExecution.GetRunID(),
+		},
+		TaskData: persistence.TaskData{
+			Version:             s.version,
+			TaskID:              int64(100),
+			VisibilityTimestamp: s.timeSource.Now(),
+		},
+		EventID: activityInfo.ScheduleID,
+		Attempt: int64(activityInfo.Attempt),
+	})
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(s.T(), mutableState, mutableState.GetNextEventID()-1, mutableState.GetCurrentVersion())
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+
+	// the retry isn't pushed to matching while the activity is paused
+	_, err = s.timerActiveTaskExecutor.Execute(timerTask)
+	s.NoError(err)
+}
+
 func (s *timerActiveTaskExecutorSuite) TestActivityRetryTimer_Noop() {
 
 	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
@@ -26,12 +26,14 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/uber/cadence/common/delayedsignal"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/ndc"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/workflowpause"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/execution"
 	"github.com/uber/cadence/service/history/shard"
@@ -129,6 +131,10 @@ func (t *timerStandbyTaskExecutor) Execute(task Task) (ExecuteResponse, error) {
 		ctx, cancel := context.WithTimeout(t.ctx, taskDefaultTimeout)
 		defer cancel()
 		return executeResponse, t.executeWorkflowBackoffTimerTask(ctx, timerTask)
+	case *persistence.DelayedSignalTimerTask:
+		ctx, cancel := context.WithTimeout(t.ctx, taskDefaultTimeout)
+		defer cancel()
+		return executeResponse, t.executeDelayedSignalTimerTask(ctx, timerTask)
 	case *persistence.DeleteHistoryEventTask:
 		// special timeout for delete history event
 		deleteHistoryEventContext, deleteHistoryEventCancel := context.WithTimeout(t.ctx, time.Duration(t.config.DeleteHistoryEventContextTimeout())*time.Second)
@@ -146,6 +152,11 @@ func (t *timerStandbyTaskExecutor) executeUserTimerTimeoutTask(
 
 	actionFn := func(ctx context.Context, wfContext execution.Context, mutableState execution.MutableState) (interface{}, error) {
 
+		if workflowpause.IsPaused(mutableState.GetExecutionInfo().Memo) {
+			// user timers don't fire while the workflow is paused
+			return nil, nil
+		}
+
 		timerSequence := execution.NewTimerSequence(mutableState)
 
 	Loop:
@@ -389,6 +400,46 @@ func (t *timerStandbyTaskExecutor) executeWorkflowBackoffTimerTask(
 	)
 }
 
+func (t *timerStandbyTaskExecutor) executeDelayedSignalTimerTask(
+	ctx context.Context,
+	timerTask *persistence.DelayedSignalTimerTask,
+) error {
+
+	actionFn := func(ctx context.Context, wfContext execution.Context, mutableState execution.MutableState) (interface{}, error) {
+
+		signal, ok := delayedsignal.GetPending(mutableState.GetExecutionInfo().Memo)[timerTask.DelayedSignalID]
+		if !ok {
+			// delayed signal is already delivered or cancelled
+			return nil, nil
+		}
+
+		ok, err := verifyTaskVersion(t.shard, t.logger, timerTask.DomainID, signal.Version, timerTask.Version, timerTask)
+		if err != nil || !ok {
+			return nil, err
+		}
+
+		// active cluster will deliver the delayed signal,
+		// standby cluster should wait for the signaled event to be replicated
+		return getHistoryResendInfo(mutableState)
+	}
+
+	return t.processTimer(
+		ctx,
+		timerTask,
+		0,
+		actionFn,
+		getStandbyPostActionFn(
+			t.logger,
+			timerTask,
+			t.getCurrentTime,
+			t.config.StandbyTaskMissingEventsResendDelay(),
+			t.config.StandbyTaskMissingEventsDiscardDelay(),
+			t.fetchHistoryFromRemote,
+			standbyTaskPostActionTaskDiscarded,
+		),
+	)
+}
+
 func (t *timerStandbyTaskExecutor) executeWorkflowTimeoutTask(
 	ctx context.Context,
 	timerTask *persistence.WorkflowTimeoutTask,
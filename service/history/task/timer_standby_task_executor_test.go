@@ -37,6 +37,7 @@ import (
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
 	commonconstants "github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/delayedsignal"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/ndc"
@@ -683,6 +684,79 @@ func (s *timerStandbyTaskExecutorSuite) TestProcessWorkflowBackoffTimer_Success(
 	s.Nil(err)
 }
 
+func (s *timerStandbyTaskExecutorSuite) TestProcessDelayedSignalTimer_Pending() {
+
+	workflowExecution, mutableState, _, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
+	s.NoError(err)
+	input, err := delayedsignal.EncodeRequest(&delayedsignal.Request{
+		SignalName:       "reminder",
+		DeliverAfterNano: s.timeSource.Now().UnixNano(),
+	})
+	s.NoError(err)
+	_, err = mutableState.AddWorkflowExecutionSignaled(delayedsignal.SignalName, input, "operator", "")
+	s.NoError(err)
+
+	timerTask := s.newTimerTaskFromInfo(&persistence.DelayedSignalTimerTask{
+		WorkflowIdentifier: persistence.WorkflowIdentifier{
+			DomainID:   s.domainID,
+			WorkflowID: workflowExecution.GetWorkflowID(),
+			RunID:      workflowExecution.GetRunID(),
+		},
+		TaskData: persistence.TaskData{
+			Version:             mutableState.GetCurrentVersion(),
+			TaskID:              int64(100),
+			VisibilityTimestamp: s.timeSource.Now(),
+		},
+		DelayedSignalID: 1,
+	})
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(s.T(), mutableState, mutableState.GetNextEventID()-1, mutableState.GetCurrentVersion())
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil).Once()
+
+	// the standby cluster waits for the delivered signal to be replicated
+	s.mockShard.SetCurrentTime(s.clusterName, s.timeSource.Now())
+	_, err = s.timerStandbyTaskExecutor.Execute(timerTask)
+	s.True(isRedispatchErr(err))
+}
+
+func (s *timerStandbyTaskExecutorSuite) TestProcessDelayedSignalTimer_Success() {
+
+	workflowExecution, mutableState, _, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
+	s.NoError(err)
+	input, err := delayedsignal.EncodeRequest(&delayedsignal.Request{
+		SignalName:       "reminder",
+		DeliverAfterNano: s.timeSource.Now().UnixNano(),
+	})
+	s.NoError(err)
+	_, err = mutableState.AddWorkflowExecutionSignaled(delayedsignal.SignalName, input, "operator", "")
+	s.NoError(err)
+	_, err = mutableState.AddWorkflowExecutionSignaled("reminder", nil, "operator", delayedsignal.RequestID(workflowExecution.GetRunID(), 1))
+	s.NoError(err)
+
+	timerTask := s.newTimerTaskFromInfo(&persistence.DelayedSignalTimerTask{
+		WorkflowIdentifier: persistence.WorkflowIdentifier{
+			DomainID:   s.domainID,
+			WorkflowID: workflowExecution.GetWorkflowID(),
+			RunID:      workflowExecution.GetRunID(),
+		},
+		TaskData: persistence.TaskData{
+			Version:             mutableState.GetCurrentVersion(),
+			TaskID:              int64(100),
+			VisibilityTimestamp: s.timeSource.Now(),
+		},
+		DelayedSignalID: 1,
+	})
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(s.T(), mutableState, mutableState.GetNextEventID()-1, mutableState.GetCurrentVersion())
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil).Once()
+
+	s.mockShard.SetCurrentTime(s.clusterName, s.timeSource.Now())
+	_, err = s.timerStandbyTaskExecutor.Execute(timerTask)
+	s.Nil(err)
+}
+
 func (s *timerStandbyTaskExecutorSuite) TestProcessWorkflowTimeout_Pending() {
 
 	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
@@ -22,24 +22,31 @@
 package task
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/pborman/uuid"
 
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/activitycontrol"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/completioncallback"
 	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/workerversioning"
+	"github.com/uber/cadence/common/workflowpause"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/execution"
 	"github.com/uber/cadence/service/history/reset"
@@ -51,7 +58,10 @@ import (
 )
 
 const (
-	resetWorkflowTimeout = 30 * time.Second
+	resetWorkflowTimeout      = 30 * time.Second
+	completionCallbackTimeout = 10 * time.Second
+	// completionCallbackIdempotencyKeyHeader carries the completioncallback.DeliveryID of HTTP callbacks
+	completionCallbackIdempotencyKeyHeader = "Idempotency-Key"
 )
 
 var (
@@ -65,6 +75,8 @@ var (
 	errUnknownTransferTask = errors.New("unknown transfer task")
 	errWorkflowBusy        = errors.New("unable to get workflow execution lock within specified timeout")
 	errWorkflowRateLimited = errors.New("workflow is being rate limited for making too many requests")
+
+	errCompletionCallbackPending = errors.New("completion callbacks are pending retry")
 )
 
 type (
@@ -75,6 +87,7 @@ type (
 		parentClosePolicyClient parentclosepolicy.Client
 		workflowResetter        reset.WorkflowResetter
 		wfIDCache               workflowcache.WFCache
+		httpClient              *http.Client
 	}
 
 	generatorF = func(taskGenerator execution.MutableStateTaskGenerator) error
@@ -108,6 +121,14 @@ func NewTransferActiveTaskExecutor(
 		),
 		workflowResetter: workflowResetter,
 		wfIDCache:        wfIDCache,
+		httpClient: &http.Client{
+			Timeout: completionCallbackTimeout,
+			// redirects are not followed since their target isn't checked against the allow list,
+			// the redirect response fails the delivery instead
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
 	}
 }
 
@@ -156,6 +177,8 @@ func (t *transferActiveTaskExecutor) Execute(task Task) (ExecuteResponse, error)
 		return executeResponse, t.processResetWorkflow(ctx, transferTask)
 	case *persistence.UpsertWorkflowSearchAttributesTask:
 		return executeResponse, t.processUpsertWorkflowSearchAttributes(ctx, transferTask)
+	case *persistence.CompletionCallbackTask:
+		return executeResponse, t.processCompletionCallback(ctx, transferTask, task.GetAttempt())
 	default:
 		return executeResponse, errUnknownTransferTask
 	}
@@ -200,8 +223,16 @@ func (t *transferActiveTaskExecutor) processActivityTask(
 	if err != nil || !ok {
 		return err
 	}
+	if workflowpause.IsPaused(mutableState.GetExecutionInfo().Memo) || activitycontrol.IsPaused(mutableState.GetExecutionInfo().Memo, ai.ScheduleID) {
+		// the activity task is regenerated when the workflow or the activity is resumed
+		return nil
+	}
 
 	timeout := min(ai.ScheduleToStartTimeout, constants.MaxTaskTimeout)
+	partitionConfig, err := getActivityPartitionConfig(ctx, mutableState, task.ScheduleID, t.config.EnableActivityTypeDispatchRateLimit(domainName))
+	if err != nil {
+		return err
+	}
 
 	taskList := &types.TaskList{
 		Name: ai.TaskList,
@@ -219,7 +250,7 @@ func (t *transferActiveTaskExecutor) processActivityTask(
 		return errWorkflowRateLimited
 	}
 
-	err = t.pushActivity(ctx, task, taskList, timeout, mutableState.GetExecutionInfo().PartitionConfig)
+	err = t.pushActivity(ctx, task, taskList, timeout, partitionConfig)
 	if err == nil {
 		scope := common.NewPerTaskListScope(domainName, taskList.Name, taskList.GetKind(), t.metricsClient, metrics.TransferActiveTaskActivityScope)
 		scope.RecordTimer(metrics.ScheduleToStartHistoryQueueLatencyPerTaskList, time.Since(task.GetVisibilityTimestamp()))
@@ -262,6 +293,10 @@ func (t *transferActiveTaskExecutor) processDecisionTask(
 	if err != nil || !ok {
 		return err
 	}
+	if workflowpause.IsPaused(mutableState.GetExecutionInfo().Memo) {
+		// the decision task is regenerated when the workflow is resumed
+		return nil
+	}
 
 	domainName := mutableState.GetDomainEntry().GetInfo().Name
 	executionInfo := mutableState.GetExecutionInfo()
@@ -292,6 +327,12 @@ func (t *transferActiveTaskExecutor) processDecisionTask(
 	// for the decision. Using MaxTaskTimeout here for now so at least no
 	// decision will be lost.
 
+	partitionConfig := executionInfo.PartitionConfig
+	versionedPartitionConfig := t.getVersionedPartitionConfig(mutableState)
+	if taskList.GetKind() != types.TaskListKindSticky {
+		partitionConfig = versionedPartitionConfig
+	}
+
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
@@ -301,7 +342,7 @@ func (t *transferActiveTaskExecutor) processDecisionTask(
 		return errWorkflowRateLimited
 	}
 
-	err = t.pushDecision(ctx, task, taskList, decisionTimeout, mutableState.GetExecutionInfo().PartitionConfig)
+	err = t.pushDecision(ctx, task, taskList, decisionTimeout, partitionConfig)
 	if _, ok := err.(*types.StickyWorkerUnavailableError); ok {
 		// sticky worker is unavailable, switch to non-sticky task list
 		taskList = &types.TaskList{
@@ -313,7 +354,7 @@ func (t *transferActiveTaskExecutor) processDecisionTask(
 		// There is no need to reset sticky, because if this task is picked by new worker, the new worker will reset
 		// the sticky queue to a new one. However, if worker is completely down, that schedule_to_start timeout task
 		// will re-create a new non-sticky task and reset sticky.
-		err = t.pushDecision(ctx, task, taskList, decisionTimeout, mutableState.GetExecutionInfo().PartitionConfig)
+		err = t.pushDecision(ctx, task, taskList, decisionTimeout, versionedPartitionConfig)
 	}
 	if err == nil {
 		scope := common.NewPerTaskListScope(domainName, taskList.Name, taskList.GetKind(), t.metricsClient, metrics.TransferActiveTaskDecisionScope)
@@ -322,6 +363,24 @@ func (t *transferActiveTaskExecutor) processDecisionTask(
 	return err
 }
 
+// getVersionedPartitionConfig returns the partition config of the workflow, pinned to the build set of the
+// worker build that last completed a decision task of the workflow when worker versioning is enabled
+func (t *transferActiveTaskExecutor) getVersionedPartitionConfig(mutableState execution.MutableState) map[string]string {
+	executionInfo := mutableState.GetExecutionInfo()
+	domainEntry := mutableState.GetDomainEntry()
+	domainName := domainEntry.GetInfo().Name
+	if !t.config.EnableWorkerVersioning(domainName) {
+		return executionInfo.PartitionConfig
+	}
+	var badBinaries *types.BadBinaries
+	if domainEntry.GetConfig() != nil {
+		badBinaries = &domainEntry.GetConfig().BadBinaries
+	}
+	buildID := workerversioning.GetWorkflowBuildID(executionInfo.AutoResetPoints, badBinaries)
+	buildSet := workerversioning.GetBuildSet(t.config.WorkerVersioningBuildSets(domainName), buildID)
+	return workerversioning.WithBuildSet(executionInfo.PartitionConfig, buildSet)
+}
+
 func (t *transferActiveTaskExecutor) allowTask(task persistence.Task) bool {
 	return t.wfIDCache.AllowInternal(task.GetDomainID(), task.GetWorkflowID())
 }
@@ -424,7 +483,7 @@ func (t *transferActiveTaskExecutor) processCloseExecutionTaskHelper(
 	workflowStartTimestamp := startEvent.GetTimestamp()
 	workflowExecutionTimestamp := getWorkflowExecutionTimestamp(mutableState, startEvent)
 	visibilityMemo := getWorkflowMemo(executionInfo.Memo)
-	searchAttr := executionInfo.SearchAttributes
+	searchAttr := appendActiveClusterToSearchAttributes(executionInfo.SearchAttributes, domainEntry, executionInfo.ActiveClusterSelectionPolicy)
 	headers := getWorkflowHeaders(startEvent)
 	domainName := mutableState.GetDomainEntry().GetInfo().Name
 	children := mutableState.GetPendingChildExecutionInfos()
@@ -685,13 +744,29 @@ func (t *transferActiveTaskExecutor) processSignalExecution(
 		)
 	}
 
-	if err = signalExternalExecutionWithRetry(
-		ctx,
-		t.historyClient,
-		task,
-		targetDomainName,
-		signalInfo,
-	); err != nil {
+	// signals to a domain allowlisting this domain are forwarded to the cluster the domain is active in
+	remoteCluster := ""
+	if !task.TargetChildWorkflowOnly {
+		remoteCluster = remoteActiveCluster(targetDomainEntry, t.shard.GetClusterMetadata().GetCurrentClusterName())
+	}
+	if remoteCluster != "" {
+		err = t.signalRemoteExternalExecutionWithRetry(
+			ctx,
+			remoteCluster,
+			task,
+			targetDomainName,
+			signalInfo,
+		)
+	} else {
+		err = signalExternalExecutionWithRetry(
+			ctx,
+			t.historyClient,
+			task,
+			targetDomainName,
+			signalInfo,
+		)
+	}
+	if err != nil {
 		if !common.IsExpectedError(err) {
 			t.logger.Error("Failed to signal external workflow execution",
 				tag.WorkflowDomainID(task.DomainID),
@@ -741,7 +816,8 @@ func (t *transferActiveTaskExecutor) processSignalExecution(
 		signalInfo.Control,
 		t.shard.GetTimeSource().Now(),
 	)
-	if err != nil {
+	if err != nil || remoteCluster != "" {
+		// the signal request ID of a workflow in another cluster is removed when the workflow is closed
 		return err
 	}
 
@@ -813,6 +889,13 @@ func (t *transferActiveTaskExecutor) processStartChildExecution(
 	} else {
 
 		targetDomainName = targetDomainEntry.GetInfo().Name
+
+		if task.TargetDomainID != task.DomainID &&
+			remoteActiveCluster(targetDomainEntry, t.shard.GetClusterMetadata().GetCurrentClusterName()) != "" {
+			// the target domain was failed over after the child workflow was initiated,
+			// it can only be started once the domain is active in this cluster again
+			return ErrTargetDomainNotActive
+		}
 	}
 
 	// ChildExecution already started, just create DecisionTask and complete transfer task
@@ -986,7 +1069,7 @@ func (t *transferActiveTaskExecutor) processRecordWorkflowStartedOrUpsertHelper(
 	startTimestamp := startEvent.GetTimestamp()
 	executionTimestamp := getWorkflowExecutionTimestamp(mutableState, startEvent)
 	visibilityMemo := getWorkflowMemo(executionInfo.Memo)
-	searchAttr := copySearchAttributes(executionInfo.SearchAttributes)
+	searchAttr := appendActiveClusterToSearchAttributes(copySearchAttributes(executionInfo.SearchAttributes), domainEntry, executionInfo.ActiveClusterSelectionPolicy)
 	headers := getWorkflowHeaders(startEvent)
 	isCron := len(executionInfo.CronSchedule) > 0
 	numClusters := (int16)(len(domainEntry.GetReplicationConfig().Clusters))
@@ -1168,6 +1251,171 @@ func (t *transferActiveTaskExecutor) processResetWorkflow(
 	)
 }
 
+// processCompletionCallback delivers the callbacks of a closed workflow. The task is retried while a callback fails
+// with a retryable error and has attempts left, delivering all callbacks again, so delivery is at least once.
+func (t *transferActiveTaskExecutor) processCompletionCallback(
+	ctx context.Context,
+	task *persistence.CompletionCallbackTask,
+	attempt int,
+) (retError error) {
+
+	wfContext, release, err := t.executionCache.GetOrCreateWorkflowExecutionWithTimeout(
+		task.DomainID,
+		getWorkflowExecution(task),
+		taskGetExecutionContextTimeout,
+	)
+	if err != nil {
+		if err == context.DeadlineExceeded {
+			return errWorkflowBusy
+		}
+		return err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := loadMutableState(ctx, wfContext, task, t.metricsClient.Scope(metrics.TransferQueueProcessorScope), t.logger, 0)
+	if err != nil {
+		return err
+	}
+	if mutableState == nil || mutableState.IsWorkflowExecutionRunning() {
+		return nil
+	}
+
+	lastWriteVersion, err := mutableState.GetLastWriteVersion()
+	if err != nil {
+		return err
+	}
+	ok, err := verifyTaskVersion(t.shard, t.logger, task.DomainID, lastWriteVersion, task.Version, task)
+	if err != nil || !ok {
+		return err
+	}
+
+	executionInfo := mutableState.GetExecutionInfo()
+	callbacks, err := completioncallback.GetCallbacks(executionInfo.Memo)
+	if err != nil {
+		// callbacks are validated when the workflow is started, there is nothing to deliver to
+		t.logger.Error("Dropping invalid completion callbacks.",
+			tag.WorkflowDomainID(task.DomainID),
+			tag.WorkflowID(task.WorkflowID),
+			tag.WorkflowRunID(task.RunID),
+			tag.Error(err),
+		)
+		return nil
+	}
+	domainName := mutableState.GetDomainEntry().GetInfo().Name
+	completionEvent, err := mutableState.GetCompletionEvent(ctx)
+	if err != nil {
+		return err
+	}
+	payload, err := json.Marshal(completioncallback.Notification{
+		Domain:        domainName,
+		WorkflowID:    task.WorkflowID,
+		RunID:         task.RunID,
+		WorkflowType:  executionInfo.WorkflowTypeName,
+		CloseStatus:   persistence.ToInternalWorkflowExecutionCloseStatus(executionInfo.CloseStatus).String(),
+		CloseTimeNano: completionEvent.GetTimestamp(),
+	})
+	if err != nil {
+		return err
+	}
+
+	// release the context lock since we no longer need mutable state builder and
+	// the rest of logic is making RPC call, which takes time.
+	release(nil)
+
+	// the task attempt counts the failed attempts of the callbacks
+	lastAttempt := attempt+1 >= t.config.CompletionCallbackMaxAttempts(domainName)
+	pending := false
+	for i, callback := range callbacks {
+		retryable, err := t.deliverCompletionCallback(ctx, task, domainName, i, callback, payload)
+		if err == nil {
+			continue
+		}
+		if !retryable || lastAttempt {
+			t.logger.Warn("Abandoning completion callback.",
+				tag.WorkflowDomainName(domainName),
+				tag.WorkflowID(task.WorkflowID),
+				tag.WorkflowRunID(task.RunID),
+				tag.AttemptCount(attempt+1),
+				tag.Error(err),
+			)
+			continue
+		}
+		pending = true
+	}
+	if pending {
+		return errCompletionCallbackPending
+	}
+	return nil
+}
+
+// deliverCompletionCallback notifies a single callback, the returned bool tells whether a failed delivery can be retried
+func (t *transferActiveTaskExecutor) deliverCompletionCallback(
+	ctx context.Context,
+	task *persistence.CompletionCallbackTask,
+	domainName string,
+	index int,
+	callback completioncallback.Callback,
+	payload []byte,
+) (bool, error) {
+
+	callbackCtx, cancel := context.WithTimeout(ctx, completionCallbackTimeout)
+	defer cancel()
+
+	// the target is checked again as the allow list may have changed since the workflow was started
+	if err := callback.ValidateTarget(domainName, t.config.CompletionCallbackAllowedURLPrefixes()); err != nil {
+		return false, err
+	}
+	deliveryID := completioncallback.DeliveryID(task.RunID, index)
+
+	if callback.HTTP != nil {
+		request, err := http.NewRequestWithContext(callbackCtx, http.MethodPost, callback.HTTP.URL, bytes.NewReader(payload))
+		if err != nil {
+			return false, err
+		}
+		request.Header.Set("Content-Type", "application/json")
+		request.Header.Set(completionCallbackIdempotencyKeyHeader, deliveryID)
+		response, err := t.httpClient.Do(request)
+		if err != nil {
+			return true, err
+		}
+		defer response.Body.Close()
+		if response.StatusCode >= 200 && response.StatusCode < 300 {
+			return false, nil
+		}
+		retryable := response.StatusCode >= 500 || response.StatusCode == http.StatusTooManyRequests
+		return retryable, fmt.Errorf("completion callback responded with status %v", response.StatusCode)
+	}
+
+	// signal callbacks target the domain of the workflow
+	err := t.historyClient.SignalWorkflowExecution(callbackCtx, &types.HistorySignalWorkflowExecutionRequest{
+		DomainUUID: task.DomainID,
+		SignalRequest: &types.SignalWorkflowExecutionRequest{
+			Domain: domainName,
+			WorkflowExecution: &types.WorkflowExecution{
+				WorkflowID: callback.Signal.WorkflowID,
+				RunID:      callback.Signal.RunID,
+			},
+			Identity:   execution.IdentityHistoryService,
+			SignalName: callback.Signal.SignalName,
+			Input:      payload,
+			// the same request ID is used for every attempt to deduplicate the signal
+			RequestID: deliveryID,
+		},
+		ExternalWorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: task.WorkflowID,
+			RunID:      task.RunID,
+		},
+	})
+	if err != nil {
+		switch err.(type) {
+		case *types.EntityNotExistsError, *types.WorkflowExecutionAlreadyCompletedError, *types.BadRequestError:
+			return false, err
+		}
+		return true, err
+	}
+	return false, nil
+}
+
 func recordChildExecutionStarted(
 	ctx context.Context,
 	logger log.Logger,
@@ -1575,6 +1823,62 @@ func signalExternalExecutionWithRetry(
 	return throttleRetry.Do(signalCtx, op)
 }
 
+func (t *transferActiveTaskExecutor) signalRemoteExternalExecutionWithRetry(
+	ctx context.Context,
+	remoteCluster string,
+	task *persistence.SignalExecutionTask,
+	targetDomain string,
+	signalInfo *persistence.SignalInfo,
+) error {
+
+	frontendClient, err := t.shard.GetService().GetRemoteFrontendClient(remoteCluster)
+	if err != nil {
+		return err
+	}
+
+	request := &types.SignalWorkflowExecutionRequest{
+		Domain: targetDomain,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: task.TargetWorkflowID,
+			RunID:      task.TargetRunID,
+		},
+		Identity:   execution.IdentityHistoryService,
+		SignalName: signalInfo.SignalName,
+		Input:      signalInfo.Input,
+		// Use same request ID to deduplicate SignalWorkflowExecution calls
+		RequestID: signalInfo.SignalRequestID,
+		Control:   signalInfo.Control,
+	}
+
+	signalCtx, cancel := context.WithTimeout(ctx, taskRPCCallTimeout)
+	defer cancel()
+	op := func(ctx context.Context) error {
+		return frontendClient.SignalWorkflowExecution(ctx, request)
+	}
+
+	throttleRetry := backoff.NewThrottleRetry(
+		backoff.WithRetryPolicy(taskRetryPolicy),
+		backoff.WithRetryableError(common.IsServiceTransientError),
+	)
+	return throttleRetry.Do(signalCtx, op)
+}
+
+// remoteActiveCluster returns the cluster an active-passive global domain is active in
+// when it is not the current cluster, and an empty string otherwise
+func remoteActiveCluster(
+	domainEntry *cache.DomainCacheEntry,
+	currentCluster string,
+) string {
+
+	if !domainEntry.IsGlobalDomain() || domainEntry.GetReplicationConfig().IsActiveActive() {
+		return ""
+	}
+	if activeCluster := domainEntry.GetReplicationConfig().ActiveClusterName; activeCluster != currentCluster {
+		return activeCluster
+	}
+	return ""
+}
+
 func removeSignalMutableStateWithRetry(
 	ctx context.Context,
 	historyClient history.Client,
@@ -1747,6 +2051,7 @@ func (t *transferActiveTaskExecutor) resetWorkflow(
 		reason,
 		nil,
 		false,
+		nil,
 	)
 
 	switch err.(type) {
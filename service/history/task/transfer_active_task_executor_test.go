@@ -25,6 +25,8 @@ import (
 	"encoding/json"
 	"errors"
 	"math/rand"
+	"net/http"
+	"net/http/httptest"
 	"strconv"
 	"testing"
 	"time"
@@ -42,15 +44,20 @@ import (
 	hclient "github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/activitycontrol"
 	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/archiver/provider"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/completioncallback"
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/workerversioning"
+	"github.com/uber/cadence/common/workflowpause"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/constants"
 	"github.com/uber/cadence/service/history/engine"
@@ -350,6 +357,90 @@ func (s *transferActiveTaskExecutorSuite) TestProcessActivityTask_Ratelimits() {
 	s.Equal("workflow is being rate limited for making too many requests", err.Error())
 }
 
+func (s *transferActiveTaskExecutorSuite) TestProcessActivityTask_Paused() {
+
+	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
+	s.NoError(err)
+
+	event, _ := test.AddActivityTaskScheduledEvent(
+		mutableState,
+		decisionCompletionID,
+		"activity-1",
+		"some random activity type",
+		mutableState.GetExecutionInfo().TaskList,
+		[]byte{}, 1, 1, 1, 1,
+	)
+	signaledEvent, err := mutableState.AddWorkflowExecutionSignaled(workflowpause.PauseSignalName, nil, "operator", "")
+	s.NoError(err)
+	mutableState.FlushBufferedEvents()
+
+	transferTask := s.newTransferTaskFromInfo(&persistence.ActivityTask{
+		WorkflowIdentifier: persistence.WorkflowIdentifier{
+			DomainID:   s.domainID,
+			WorkflowID: workflowExecution.GetWorkflowID(),
+			RunID:      workflowExecution.GetRunID(),
+		},
+		TaskData: persistence.TaskData{
+			Version: s.version,
+			TaskID:  int64(59),
+		},
+		TargetDomainID: constants.TestDomainID,
+		TaskList:       mutableState.GetExecutionInfo().TaskList,
+		ScheduleID:     event.ID,
+	})
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(s.T(), mutableState, signaledEvent.ID, signaledEvent.Version)
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+
+	// no activity task is pushed to matching while the workflow is paused
+	_, err = s.transferActiveTaskExecutor.Execute(transferTask)
+	s.Nil(err)
+}
+
+func (s *transferActiveTaskExecutorSuite) TestProcessActivityTask_ActivityPaused() {
+
+	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
+	s.NoError(err)
+
+	event, ai := test.AddActivityTaskScheduledEvent(
+		mutableState,
+		decisionCompletionID,
+		"activity-1",
+		"some random activity type",
+		mutableState.GetExecutionInfo().TaskList,
+		[]byte{}, 1, 1, 1, 1,
+	)
+	input, err := activitycontrol.EncodeRequest(&activitycontrol.Request{ActivityID: ai.ActivityID})
+	s.NoError(err)
+	signaledEvent, err := mutableState.AddWorkflowExecutionSignaled(activitycontrol.PauseSignalName, input, "operator", "")
+	s.NoError(err)
+	mutableState.FlushBufferedEvents()
+
+	transferTask := s.newTransferTaskFromInfo(&persistence.ActivityTask{
+		WorkflowIdentifier: persistence.WorkflowIdentifier{
+			DomainID:   s.domainID,
+			WorkflowID: workflowExecution.GetWorkflowID(),
+			RunID:      workflowExecution.GetRunID(),
+		},
+		TaskData: persistence.TaskData{
+			Version: s.version,
+			TaskID:  int64(59),
+		},
+		TargetDomainID: constants.TestDomainID,
+		TaskList:       mutableState.GetExecutionInfo().TaskList,
+		ScheduleID:     event.ID,
+	})
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(s.T(), mutableState, signaledEvent.ID, signaledEvent.Version)
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+
+	// no activity task is pushed to matching while the activity is paused
+	_, err = s.transferActiveTaskExecutor.Execute(transferTask)
+	s.Nil(err)
+}
+
 func (s *transferActiveTaskExecutorSuite) TestProcessActivityTask_Duplication() {
 
 	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
@@ -455,6 +546,47 @@ func (s *transferActiveTaskExecutorSuite) TestProcessDecisionTask_NonFirstDecisi
 	s.Nil(err)
 }
 
+func (s *transferActiveTaskExecutorSuite) TestProcessDecisionTask_WorkerVersioning() {
+
+	workflowExecution, mutableState, _, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
+	s.NoError(err)
+	mutableState.GetExecutionInfo().AutoResetPoints = &types.ResetPoints{
+		Points: []*types.ResetPointInfo{{BinaryChecksum: "build-b"}},
+	}
+	s.mockShard.GetConfig().EnableWorkerVersioning = func(domain string) bool { return true }
+	s.mockShard.GetConfig().WorkerVersioningBuildSets = func(domain string) map[string]interface{} {
+		return map[string]interface{}{"build-a": "set-1", "build-b": "set-1"}
+	}
+
+	// make another round of decision
+	di := test.AddDecisionTaskScheduledEvent(mutableState)
+
+	transferTask := s.newTransferTaskFromInfo(&persistence.DecisionTask{
+		WorkflowIdentifier: persistence.WorkflowIdentifier{
+			DomainID:   s.domainID,
+			WorkflowID: workflowExecution.GetWorkflowID(),
+			RunID:      workflowExecution.GetRunID(),
+		},
+		TaskData: persistence.TaskData{
+			Version: s.version,
+			TaskID:  int64(59),
+		},
+		TaskList:   mutableState.GetExecutionInfo().TaskList,
+		ScheduleID: di.ScheduleID,
+	})
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(s.T(), mutableState, di.ScheduleID, di.Version)
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockWFCache.EXPECT().AllowInternal(constants.TestDomainID, constants.TestWorkflowID).Return(true).Times(1)
+	expectedRequest := createAddDecisionTaskRequest(transferTask, mutableState)
+	expectedRequest.PartitionConfig = workerversioning.WithBuildSet(mutableState.GetExecutionInfo().PartitionConfig, "set-1")
+	s.mockMatchingClient.EXPECT().AddDecisionTask(gomock.Any(), expectedRequest).Return(&types.AddDecisionTaskResponse{}, nil).Times(1)
+
+	_, err = s.transferActiveTaskExecutor.Execute(transferTask)
+	s.Nil(err)
+}
+
 func (s *transferActiveTaskExecutorSuite) TestProcessDecisionTask_Ratelimits() {
 
 	workflowExecution, mutableState, _, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
@@ -596,6 +728,38 @@ func (s *transferActiveTaskExecutorSuite) TestProcessDecisionTask_DecisionNotSti
 	s.Nil(err)
 }
 
+func (s *transferActiveTaskExecutorSuite) TestProcessDecisionTask_Paused() {
+
+	workflowExecution, mutableState, err := test.StartWorkflow(s.T(), s.mockShard, s.domainID)
+	s.NoError(err)
+
+	_, err = mutableState.AddWorkflowExecutionSignaled(workflowpause.PauseSignalName, nil, "operator", "")
+	s.NoError(err)
+	di := test.AddDecisionTaskScheduledEvent(mutableState)
+
+	transferTask := s.newTransferTaskFromInfo(&persistence.DecisionTask{
+		WorkflowIdentifier: persistence.WorkflowIdentifier{
+			DomainID:   s.domainID,
+			WorkflowID: workflowExecution.GetWorkflowID(),
+			RunID:      workflowExecution.GetRunID(),
+		},
+		TaskData: persistence.TaskData{
+			Version: s.version,
+			TaskID:  int64(59),
+		},
+		TaskList:   mutableState.GetExecutionInfo().TaskList,
+		ScheduleID: di.ScheduleID,
+	})
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(s.T(), mutableState, di.ScheduleID, di.Version)
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+
+	// no decision task is pushed to matching while the workflow is paused
+	_, err = s.transferActiveTaskExecutor.Execute(transferTask)
+	s.Nil(err)
+}
+
 func (s *transferActiveTaskExecutorSuite) TestProcessDecisionTask_Duplication() {
 
 	workflowExecution, mutableState, _, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
@@ -1099,6 +1263,133 @@ func (s *transferActiveTaskExecutorSuite) TestProcessCloseExecution_NoParent_Has
 	s.Nil(err)
 }
 
+func (s *transferActiveTaskExecutorSuite) TestProcessCompletionCallback_Delivered() {
+	var notification completioncallback.Notification
+	var idempotencyKey string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.Equal(http.MethodPost, r.Method)
+		s.NoError(json.NewDecoder(r.Body).Decode(&notification))
+		idempotencyKey = r.Header.Get(completionCallbackIdempotencyKeyHeader)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	s.allowCompletionCallbackURLs(server.URL)
+
+	transferTask, workflowExecution := s.setupCompletionCallbackWorkflow(
+		completioncallback.Callback{HTTP: &completioncallback.HTTPCallback{URL: server.URL + "/done"}},
+		completioncallback.Callback{Signal: &completioncallback.SignalCallback{WorkflowID: "waiter", SignalName: "child-done"}},
+	)
+	s.mockHistoryClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.HistorySignalWorkflowExecutionRequest, _ ...yarpc.CallOption) error {
+			s.Equal(s.domainID, request.DomainUUID)
+			s.Equal(s.domainName, request.SignalRequest.Domain)
+			s.Equal("waiter", request.SignalRequest.WorkflowExecution.WorkflowID)
+			s.Equal("child-done", request.SignalRequest.SignalName)
+			s.Equal(completioncallback.DeliveryID(workflowExecution.RunID, 1), request.SignalRequest.RequestID)
+			return nil
+		}).Times(1)
+
+	_, err := s.transferActiveTaskExecutor.Execute(transferTask)
+	s.NoError(err)
+	s.Equal(workflowExecution.RunID, notification.RunID)
+	s.Equal(types.WorkflowExecutionCloseStatusCompleted.String(), notification.CloseStatus)
+	s.Equal(completioncallback.DeliveryID(workflowExecution.RunID, 0), idempotencyKey)
+	s.mockExecutionMgr.AssertNotCalled(s.T(), "UpdateWorkflowExecution", mock.Anything, mock.Anything)
+}
+
+func (s *transferActiveTaskExecutorSuite) TestProcessCompletionCallback_Retry() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+	s.allowCompletionCallbackURLs(server.URL)
+
+	transferTask, _ := s.setupCompletionCallbackWorkflow(
+		completioncallback.Callback{HTTP: &completioncallback.HTTPCallback{URL: server.URL}},
+	)
+
+	_, err := s.transferActiveTaskExecutor.Execute(transferTask)
+	s.Equal(errCompletionCallbackPending, err)
+}
+
+func (s *transferActiveTaskExecutorSuite) TestProcessCompletionCallback_Abandoned() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+	s.allowCompletionCallbackURLs(server.URL)
+	s.mockShard.GetConfig().CompletionCallbackMaxAttempts = dynamicproperties.GetIntPropertyFilteredByDomain(3)
+
+	transferTask, _ := s.setupCompletionCallbackWorkflow(
+		completioncallback.Callback{HTTP: &completioncallback.HTTPCallback{URL: server.URL}},
+		completioncallback.Callback{HTTP: &completioncallback.HTTPCallback{URL: "https://not-allowed.example.com"}},
+		completioncallback.Callback{Signal: &completioncallback.SignalCallback{Domain: "other-domain", WorkflowID: "waiter", SignalName: "child-done"}},
+	)
+	// callbacks are abandoned once they run out of attempts
+	transferTask.(*taskImpl).attempt = 2
+
+	_, err := s.transferActiveTaskExecutor.Execute(transferTask)
+	s.NoError(err)
+}
+
+func (s *transferActiveTaskExecutorSuite) TestProcessCompletionCallback_Redirect() {
+	redirected := false
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		redirected = true
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer target.Close()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, target.URL, http.StatusTemporaryRedirect)
+	}))
+	defer server.Close()
+	s.allowCompletionCallbackURLs(server.URL)
+
+	transferTask, _ := s.setupCompletionCallbackWorkflow(
+		completioncallback.Callback{HTTP: &completioncallback.HTTPCallback{URL: server.URL}},
+	)
+
+	_, err := s.transferActiveTaskExecutor.Execute(transferTask)
+	s.NoError(err, "redirects are not retried")
+	s.False(redirected, "redirects are not followed")
+}
+
+func (s *transferActiveTaskExecutorSuite) allowCompletionCallbackURLs(prefixes ...interface{}) {
+	s.mockShard.GetConfig().CompletionCallbackAllowedURLPrefixes = func(...dynamicproperties.FilterOption) []interface{} {
+		return prefixes
+	}
+}
+
+func (s *transferActiveTaskExecutorSuite) setupCompletionCallbackWorkflow(
+	callbacks ...completioncallback.Callback,
+) (Task, types.WorkflowExecution) {
+
+	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
+	s.NoError(err)
+	value, err := completioncallback.Encode(callbacks)
+	s.NoError(err)
+	executionInfo := mutableState.GetExecutionInfo()
+	executionInfo.Memo = map[string][]byte{completioncallback.MemoKey: value}
+	event := test.AddCompleteWorkflowEvent(mutableState, decisionCompletionID, nil)
+
+	transferTask := s.newTransferTaskFromInfo(&persistence.CompletionCallbackTask{
+		WorkflowIdentifier: persistence.WorkflowIdentifier{
+			DomainID:   s.domainID,
+			WorkflowID: workflowExecution.GetWorkflowID(),
+			RunID:      workflowExecution.GetRunID(),
+		},
+		TaskData: persistence.TaskData{
+			Version: s.version,
+			TaskID:  int64(59),
+		},
+	})
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(s.T(), mutableState, event.ID, event.Version)
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	return transferTask, workflowExecution
+}
+
 func (s *transferActiveTaskExecutorSuite) TestProcessCancelExecution_Success() {
 	s.testProcessCancelExecution(
 		constants.TestDomainID,
@@ -1366,6 +1657,32 @@ func (s *transferActiveTaskExecutorSuite) TestProcessSignalExecution_Duplication
 	)
 }
 
+func (s *transferActiveTaskExecutorSuite) TestProcessSignalExecution_TargetDomainActiveInRemoteCluster() {
+	remoteDomainEntry := newRemoteActiveDomainEntry()
+	s.mockDomainCache.EXPECT().GetDomainByID(remoteDomainEntry.GetInfo().ID).Return(remoteDomainEntry, nil).AnyTimes()
+
+	s.testProcessSignalExecutionWithErrorAndLogs(
+		remoteDomainEntry.GetInfo().ID,
+		func(
+			mutableState execution.MutableState,
+			workflowExecution, targetExecution types.WorkflowExecution,
+			event *types.HistoryEvent,
+			transferTask Task,
+			signalInfo *persistence.SignalInfo,
+		) {
+			persistenceMutableState, err := test.CreatePersistenceMutableState(s.T(), mutableState, event.ID, event.Version)
+			s.NoError(err)
+			s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+			signalRequest := createTestSignalWorkflowExecutionRequest(remoteDomainEntry.GetInfo().Name, transferTask.GetInfo().(*persistence.SignalExecutionTask), signalInfo)
+			s.mockShard.Resource.RemoteFrontendClient.EXPECT().SignalWorkflowExecution(gomock.Any(), signalRequest.SignalRequest).Return(nil).Times(1)
+			s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
+			s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+		},
+		nil,
+		nil,
+	)
+}
+
 func (s *transferActiveTaskExecutorSuite) TestProcessSignalExecution_WorkflowSignalingItself() {
 	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
 	s.NoError(err)
@@ -1608,6 +1925,27 @@ func (s *transferActiveTaskExecutorSuite) TestProcessStartChildExecution_TargetN
 	)
 }
 
+func (s *transferActiveTaskExecutorSuite) TestProcessStartChildExecution_TargetDomainActiveInRemoteCluster() {
+	remoteDomainEntry := newRemoteActiveDomainEntry()
+	s.mockDomainCache.EXPECT().GetDomainByID(remoteDomainEntry.GetInfo().ID).Return(remoteDomainEntry, nil).AnyTimes()
+
+	s.testProcessStartChildExecutionWithError(
+		remoteDomainEntry.GetInfo().ID,
+		func(
+			mutableState execution.MutableState,
+			workflowExecution, childExecution types.WorkflowExecution,
+			event *types.HistoryEvent,
+			transferTask Task,
+			childInfo *persistence.ChildExecutionInfo,
+		) {
+			persistenceMutableState, err := test.CreatePersistenceMutableState(s.T(), mutableState, event.ID, event.Version)
+			s.NoError(err)
+			s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+		},
+		ErrTargetDomainNotActive,
+	)
+}
+
 func (s *transferActiveTaskExecutorSuite) TestProcessStartChildExecution_Success_Dup() {
 	s.testProcessStartChildExecution(
 		constants.TestDomainID,
@@ -2107,7 +2445,8 @@ func (s *transferActiveTaskExecutorSuite) testProcessResetWorkflowWithError(same
 		gomock.Any(),
 		"test-reason",
 		nil,
-		false).Return(resetError).Times(1)
+		false,
+		nil).Return(resetError).Times(1)
 
 	_, err = s.transferActiveTaskExecutor.Execute(transferTask)
 
@@ -2468,3 +2807,18 @@ func createRecordWorkflowExecutionUninitializedRequest(
 		ShardID:          shardID,
 	}
 }
+
+func newRemoteActiveDomainEntry() *cache.DomainCacheEntry {
+	return cache.NewGlobalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: "remote-domain-id", Name: "remote-domain"},
+		&persistence.DomainConfig{Retention: 1},
+		&persistence.DomainReplicationConfig{
+			ActiveClusterName: cluster.TestAlternativeClusterName,
+			Clusters: []*persistence.ClusterReplicationConfig{
+				{ClusterName: cluster.TestCurrentClusterName},
+				{ClusterName: cluster.TestAlternativeClusterName},
+			},
+		},
+		constants.TestVersion,
+	)
+}
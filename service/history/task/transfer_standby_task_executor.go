@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/uber/cadence/common/activitycontrol"
 	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -32,6 +33,7 @@ import (
 	"github.com/uber/cadence/common/ndc"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/workflowpause"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/execution"
 	"github.com/uber/cadence/service/history/shard"
@@ -130,6 +132,9 @@ func (t *transferStandbyTaskExecutor) Execute(task Task) (ExecuteResponse, error
 		return executeResponse, nil
 	case *persistence.UpsertWorkflowSearchAttributesTask:
 		return executeResponse, t.processUpsertWorkflowSearchAttributes(ctx, transferTask)
+	case *persistence.CompletionCallbackTask:
+		// callbacks are delivered by the cluster where the workflow closed
+		return executeResponse, nil
 	default:
 		return executeResponse, errUnknownTransferTask
 	}
@@ -155,6 +160,10 @@ func (t *transferStandbyTaskExecutor) processActivityTask(
 		if err != nil || !ok {
 			return nil, err
 		}
+		if workflowpause.IsPaused(mutableState.GetExecutionInfo().Memo) || activitycontrol.IsPaused(mutableState.GetExecutionInfo().Memo, activityInfo.ScheduleID) {
+			// the activity task is regenerated when the workflow or the activity is resumed
+			return nil, nil
+		}
 
 		taskList := types.TaskList{
 			Name: activityInfo.TaskList,
@@ -220,6 +229,10 @@ func (t *transferStandbyTaskExecutor) processDecisionTask(
 		if err != nil || !ok {
 			return nil, err
 		}
+		if workflowpause.IsPaused(executionInfo.Memo) {
+			// the decision task is regenerated when the workflow is resumed
+			return nil, nil
+		}
 
 		if decisionInfo.StartedID == constants.EmptyEventID {
 			return newPushDecisionToMatchingInfo(
@@ -300,6 +313,7 @@ func (t *transferStandbyTaskExecutor) processCloseExecution(
 			return nil, err
 		}
 		numClusters := (int16)(len(domainEntry.GetReplicationConfig().Clusters))
+		searchAttr = appendActiveClusterToSearchAttributes(searchAttr, domainEntry, executionInfo.ActiveClusterSelectionPolicy)
 
 		// DO NOT REPLY TO PARENT
 		// since event replication should be done by active cluster
@@ -533,7 +547,7 @@ func (t *transferStandbyTaskExecutor) processRecordWorkflowStartedOrUpsertHelper
 	}
 	numClusters := (int16)(len(domainEntry.GetReplicationConfig().Clusters))
 
-	searchAttr := copySearchAttributes(executionInfo.SearchAttributes)
+	searchAttr := appendActiveClusterToSearchAttributes(copySearchAttributes(executionInfo.SearchAttributes), domainEntry, executionInfo.ActiveClusterSelectionPolicy)
 	headers := getWorkflowHeaders(startEvent)
 
 	if isRecordStart {
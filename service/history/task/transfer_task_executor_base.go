@@ -32,6 +32,7 @@ import (
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -489,6 +490,37 @@ func appendContextHeaderToSearchAttributes(attr, context map[string][]byte, allo
 	return attr, errGroup
 }
 
+// the cluster attribute of a workflow in an active-active domain and the cluster it is currently active in
+// are appended to search attributes, so they can be displayed and queried.
+// The keys are reserved by the system, so they can't be set by users.
+// The active cluster is the one when the visibility record is written, the records are not updated when the
+// cluster attribute fails over, so it may be stale until the next visibility update of the workflow
+func appendActiveClusterToSearchAttributes(
+	attr map[string][]byte,
+	domainEntry *cache.DomainCacheEntry,
+	policy *types.ActiveClusterSelectionPolicy,
+) map[string][]byte {
+
+	if domainEntry == nil || !domainEntry.GetReplicationConfig().IsActiveActive() {
+		return attr
+	}
+
+	result := copySearchAttributes(attr)
+	if result == nil {
+		result = make(map[string][]byte)
+	}
+	// ignore error as it can't happen to err on json encoding string
+	clusterAttribute := policy.GetClusterAttribute()
+	if clusterAttribute != nil {
+		result[definition.ClusterAttributeScope], _ = json.Marshal(clusterAttribute.Scope)
+		result[definition.ClusterAttributeName], _ = json.Marshal(clusterAttribute.Name)
+	}
+	if activeClusterInfo, ok := domainEntry.GetActiveClusterInfoByClusterAttribute(clusterAttribute); ok {
+		result[definition.ActiveClusterName], _ = json.Marshal(activeClusterInfo.ActiveClusterName)
+	}
+	return result
+}
+
 func getWorkflowHeaders(startEvent *types.HistoryEvent) map[string][]byte {
 	attr := startEvent.GetWorkflowExecutionStartedEventAttributes()
 	if attr == nil || attr.Header == nil {
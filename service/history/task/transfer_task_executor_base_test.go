@@ -22,6 +22,13 @@ package task
 
 import (
 	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
 )
 
 func TestShouldRedactContextHeader(t *testing.T) {
@@ -70,3 +77,92 @@ func TestShouldRedactContextHeader(t *testing.T) {
 		}
 	}
 }
+
+func TestAppendActiveClusterToSearchAttributes(t *testing.T) {
+	activeActiveDomain := cache.NewDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: "domain-id", Name: "domain"},
+		&persistence.DomainConfig{},
+		true,
+		&persistence.DomainReplicationConfig{
+			ActiveClusterName: "cluster0",
+			ActiveClusters: &types.ActiveClusters{
+				AttributeScopes: map[string]types.ClusterAttributeScope{
+					"region": {
+						ClusterAttributes: map[string]types.ActiveClusterInfo{
+							"us-west": {ActiveClusterName: "cluster1", FailoverVersion: 1},
+						},
+					},
+				},
+			},
+		},
+		0, nil, 0, 0, 0,
+	)
+	activePassiveDomain := cache.NewDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: "domain-id", Name: "domain"},
+		&persistence.DomainConfig{},
+		true,
+		&persistence.DomainReplicationConfig{ActiveClusterName: "cluster0"},
+		0, nil, 0, 0, 0,
+	)
+	attributePolicy := &types.ActiveClusterSelectionPolicy{
+		ClusterAttribute: &types.ClusterAttribute{Scope: "region", Name: "us-west"},
+	}
+
+	cases := map[string]struct {
+		attr        map[string][]byte
+		domainEntry *cache.DomainCacheEntry
+		policy      *types.ActiveClusterSelectionPolicy
+		expected    map[string][]byte
+	}{
+		"active-passive domain": {
+			attr:        map[string][]byte{"CustomKeywordField": []byte(`"value"`)},
+			domainEntry: activePassiveDomain,
+			policy:      attributePolicy,
+			expected:    map[string][]byte{"CustomKeywordField": []byte(`"value"`)},
+		},
+		"unknown domain": {
+			attr:     nil,
+			policy:   attributePolicy,
+			expected: nil,
+		},
+		"active-active domain with cluster attribute": {
+			attr:        map[string][]byte{"CustomKeywordField": []byte(`"value"`)},
+			domainEntry: activeActiveDomain,
+			policy:      attributePolicy,
+			expected: map[string][]byte{
+				"CustomKeywordField":             []byte(`"value"`),
+				definition.ClusterAttributeScope: []byte(`"region"`),
+				definition.ClusterAttributeName:  []byte(`"us-west"`),
+				definition.ActiveClusterName:     []byte(`"cluster1"`),
+			},
+		},
+		"active-active domain without cluster attribute": {
+			attr:        nil,
+			domainEntry: activeActiveDomain,
+			policy:      nil,
+			expected: map[string][]byte{
+				definition.ActiveClusterName: []byte(`"cluster0"`),
+			},
+		},
+		"active-active domain with unknown cluster attribute": {
+			attr:        nil,
+			domainEntry: activeActiveDomain,
+			policy: &types.ActiveClusterSelectionPolicy{
+				ClusterAttribute: &types.ClusterAttribute{Scope: "region", Name: "us-east"},
+			},
+			expected: map[string][]byte{
+				definition.ClusterAttributeScope: []byte(`"region"`),
+				definition.ClusterAttributeName:  []byte(`"us-east"`),
+			},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			input := copySearchAttributes(c.attr)
+			result := appendActiveClusterToSearchAttributes(input, c.domainEntry, c.policy)
+			assert.Equal(t, c.expected, result)
+			assert.Equal(t, c.attr, input, "input search attributes must not be modified")
+		})
+	}
+}
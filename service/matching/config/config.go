@@ -75,6 +75,10 @@ type (
 		IsolationGroupHasPollersSustainedDuration dynamicproperties.DurationPropertyFnWithTaskListInfoFilters
 		IsolationGroupNoPollersSustainedDuration  dynamicproperties.DurationPropertyFnWithTaskListInfoFilters
 		IsolationGroupsPerPartition               dynamicproperties.IntPropertyFnWithTaskListInfoFilters
+		EnableAdaptiveScalerBacklogScaling        dynamicproperties.BoolPropertyFnWithTaskListInfoFilters
+		PartitionUpscaleBacklogAge                dynamicproperties.DurationPropertyFnWithTaskListInfoFilters
+		PartitionUpscaleBacklogPollerRatio        dynamicproperties.FloatPropertyFnWithTaskListInfoFilters
+		PartitionBacklogUpscaleMaxPartitions      dynamicproperties.IntPropertyFnWithTaskListInfoFilters
 
 		// Time to hold a poll request before returning an empty response if there are no tasks
 		LongPollExpirationInterval dynamicproperties.DurationPropertyFnWithTaskListInfoFilters
@@ -96,6 +100,12 @@ type (
 		// isolation configuration
 		EnableTasklistIsolation dynamicproperties.BoolPropertyFnWithDomainFilter
 		AllIsolationGroups      func() []string
+		// worker versioning configuration
+		EnableWorkerVersioning       dynamicproperties.BoolPropertyFnWithDomainFilter
+		WorkerVersioningBuildSets    dynamicproperties.MapPropertyFnWithDomainFilter
+		WorkerVersioningPollerWindow dynamicproperties.DurationPropertyFnWithTaskListInfoFilters
+		// per activity type dispatch rates, summed over all partitions
+		ActivityTypeDispatchRPS dynamicproperties.MapPropertyFnWithTaskListInfoFilters
 		// hostname info
 		HostName string
 		// rate limiter configuration
@@ -143,6 +153,10 @@ type (
 		IsolationGroupHasPollersSustainedDuration func() time.Duration
 		IsolationGroupNoPollersSustainedDuration  func() time.Duration
 		IsolationGroupsPerPartition               func() int
+		EnableAdaptiveScalerBacklogScaling        func() bool
+		PartitionUpscaleBacklogAge                func() time.Duration
+		PartitionUpscaleBacklogPollerRatio        func() float64
+		PartitionBacklogUpscaleMaxPartitions      func() int
 		// taskWriter configuration
 		OutstandingTaskAppendsThreshold      func() int
 		MaxTaskBatchSize                     func() int
@@ -157,6 +171,12 @@ type (
 		AllIsolationGroups        func() []string
 		TaskIsolationDuration     func() time.Duration
 		TaskIsolationPollerWindow func() time.Duration
+		// worker versioning configuration
+		EnableWorkerVersioning       func() bool
+		WorkerVersioningBuildSets    func() map[string]interface{}
+		WorkerVersioningPollerWindow func() time.Duration
+		// per activity type dispatch rates, summed over all partitions
+		ActivityTypeDispatchRPS func() map[string]interface{}
 		// hostname
 		HostName string
 		// rate limiter configuration
@@ -204,6 +224,10 @@ func NewConfig(dc *dynamicconfig.Collection, hostName string, getIsolationGroups
 		EnableTaskInfoLogByDomainID:               dc.GetBoolPropertyFilteredByDomainID(dynamicproperties.MatchingEnableTaskInfoLogByDomainID),
 		ActivityTaskSyncMatchWaitTime:             dc.GetDurationPropertyFilteredByDomain(dynamicproperties.MatchingActivityTaskSyncMatchWaitTime),
 		EnableTasklistIsolation:                   dc.GetBoolPropertyFilteredByDomain(dynamicproperties.EnableTasklistIsolation),
+		EnableWorkerVersioning:                    dc.GetBoolPropertyFilteredByDomain(dynamicproperties.EnableWorkerVersioning),
+		WorkerVersioningBuildSets:                 dc.GetMapPropertyFilteredByDomain(dynamicproperties.WorkerVersioningBuildSets),
+		WorkerVersioningPollerWindow:              dc.GetDurationPropertyFilteredByTaskListInfo(dynamicproperties.MatchingWorkerVersioningPollerWindow),
+		ActivityTypeDispatchRPS:                   dc.GetMapPropertyFilteredByTaskListInfo(dynamicproperties.MatchingActivityTypeDispatchRPS),
 		AsyncTaskDispatchTimeout:                  dc.GetDurationPropertyFilteredByTaskListInfo(dynamicproperties.AsyncTaskDispatchTimeout),
 		EnableTasklistOwnershipGuard:              dc.GetBoolProperty(dynamicproperties.MatchingEnableTasklistGuardAgainstOwnershipShardLoss),
 		LocalPollWaitTime:                         dc.GetDurationPropertyFilteredByTaskListInfo(dynamicproperties.LocalPollWaitTime),
@@ -222,6 +246,10 @@ func NewConfig(dc *dynamicconfig.Collection, hostName string, getIsolationGroups
 		IsolationGroupHasPollersSustainedDuration: dc.GetDurationPropertyFilteredByTaskListInfo(dynamicproperties.MatchingIsolationGroupHasPollersSustainedDuration),
 		IsolationGroupNoPollersSustainedDuration:  dc.GetDurationPropertyFilteredByTaskListInfo(dynamicproperties.MatchingIsolationGroupNoPollersSustainedDuration),
 		IsolationGroupsPerPartition:               dc.GetIntPropertyFilteredByTaskListInfo(dynamicproperties.MatchingIsolationGroupsPerPartition),
+		EnableAdaptiveScalerBacklogScaling:        dc.GetBoolPropertyFilteredByTaskListInfo(dynamicproperties.MatchingEnableAdaptiveScalerBacklogScaling),
+		PartitionUpscaleBacklogAge:                dc.GetDurationPropertyFilteredByTaskListInfo(dynamicproperties.MatchingPartitionUpscaleBacklogAge),
+		PartitionUpscaleBacklogPollerRatio:        dc.GetFloat64PropertyFilteredByTaskListInfo(dynamicproperties.MatchingPartitionUpscaleBacklogPollerRatio),
+		PartitionBacklogUpscaleMaxPartitions:      dc.GetIntPropertyFilteredByTaskListInfo(dynamicproperties.MatchingPartitionBacklogUpscaleMaxPartitions),
 		TaskIsolationDuration:                     dc.GetDurationPropertyFilteredByTaskListInfo(dynamicproperties.TaskIsolationDuration),
 		TaskIsolationPollerWindow:                 dc.GetDurationPropertyFilteredByTaskListInfo(dynamicproperties.TaskIsolationPollerWindow),
 		HostName:                                  hostName,
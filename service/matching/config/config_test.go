@@ -73,6 +73,10 @@ func TestNewConfig(t *testing.T) {
 		"EnableTaskInfoLogByDomainID":               {dynamicproperties.MatchingEnableTaskInfoLogByDomainID, true},
 		"ActivityTaskSyncMatchWaitTime":             {dynamicproperties.MatchingActivityTaskSyncMatchWaitTime, time.Duration(24)},
 		"EnableTasklistIsolation":                   {dynamicproperties.EnableTasklistIsolation, false},
+		"EnableWorkerVersioning":                    {dynamicproperties.EnableWorkerVersioning, true},
+		"WorkerVersioningBuildSets":                 {dynamicproperties.WorkerVersioningBuildSets, map[string]interface{}{"build-a": "set-1"}},
+		"WorkerVersioningPollerWindow":              {dynamicproperties.MatchingWorkerVersioningPollerWindow, time.Duration(45)},
+		"ActivityTypeDispatchRPS":                   {dynamicproperties.MatchingActivityTypeDispatchRPS, map[string]interface{}{"activity-type": 10}},
 		"AsyncTaskDispatchTimeout":                  {dynamicproperties.AsyncTaskDispatchTimeout, time.Duration(25)},
 		"LocalPollWaitTime":                         {dynamicproperties.LocalPollWaitTime, time.Duration(10)},
 		"LocalTaskWaitTime":                         {dynamicproperties.LocalTaskWaitTime, time.Duration(10)},
@@ -101,6 +105,10 @@ func TestNewConfig(t *testing.T) {
 		"IsolationGroupHasPollersSustainedDuration": {dynamicproperties.MatchingIsolationGroupHasPollersSustainedDuration, time.Duration(39)},
 		"IsolationGroupNoPollersSustainedDuration":  {dynamicproperties.MatchingIsolationGroupNoPollersSustainedDuration, time.Duration(40)},
 		"IsolationGroupsPerPartition":               {dynamicproperties.MatchingIsolationGroupsPerPartition, 41},
+		"EnableAdaptiveScalerBacklogScaling":        {dynamicproperties.MatchingEnableAdaptiveScalerBacklogScaling, true},
+		"PartitionUpscaleBacklogAge":                {dynamicproperties.MatchingPartitionUpscaleBacklogAge, time.Duration(42)},
+		"PartitionUpscaleBacklogPollerRatio":        {dynamicproperties.MatchingPartitionUpscaleBacklogPollerRatio, 43.0},
+		"PartitionBacklogUpscaleMaxPartitions":      {dynamicproperties.MatchingPartitionBacklogUpscaleMaxPartitions, 44},
 		"EnableReturnAllTaskListKinds":              {dynamicproperties.MatchingEnableReturnAllTaskListKinds, true},
 	}
 	client := dynamicconfig.NewInMemoryClient()
@@ -168,6 +176,10 @@ func getValue(f *reflect.Value) interface{} {
 			return fn()
 		case dynamicproperties.MapPropertyFn:
 			return fn()
+		case dynamicproperties.MapPropertyFnWithDomainFilter:
+			return fn("domain")
+		case dynamicproperties.MapPropertyFnWithTaskListInfoFilters:
+			return fn("domain", "tasklist", 0)
 		case dynamicproperties.StringPropertyFn:
 			return fn()
 		case dynamicproperties.FloatPropertyFnWithTaskListInfoFilters:
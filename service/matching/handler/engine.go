@@ -28,10 +28,12 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"strconv"
 	"sync"
 	"time"
 
 	"github.com/pborman/uuid"
+	"go.uber.org/yarpc"
 
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
@@ -53,6 +55,7 @@ import (
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/matching/config"
 	"github.com/uber/cadence/service/matching/event"
+	"github.com/uber/cadence/service/matching/poller"
 	"github.com/uber/cadence/service/matching/tasklist"
 )
 
@@ -536,6 +539,8 @@ pollLoop:
 		pollerCtx := tasklist.ContextWithPollerID(hCtx.Context, pollerID)
 		pollerCtx = tasklist.ContextWithIdentity(pollerCtx, request.GetIdentity())
 		pollerCtx = tasklist.ContextWithIsolationGroup(pollerCtx, req.GetIsolationGroup())
+		pollerCtx = tasklist.ContextWithBuildID(pollerCtx, request.GetBinaryChecksum())
+		pollerCtx = tasklist.ContextWithWorkerInfo(pollerCtx, workerInfoFromCall(hCtx.Context, request.GetIdentity(), request.GetBinaryChecksum()))
 		tlMgr, err := e.getTaskListManager(taskListID, taskListKind)
 		if err != nil {
 			return nil, fmt.Errorf("couldn't load tasklist manager: %w", err)
@@ -724,6 +729,7 @@ pollLoop:
 		pollerCtx := tasklist.ContextWithPollerID(hCtx.Context, pollerID)
 		pollerCtx = tasklist.ContextWithIdentity(pollerCtx, request.GetIdentity())
 		pollerCtx = tasklist.ContextWithIsolationGroup(pollerCtx, req.GetIsolationGroup())
+		pollerCtx = tasklist.ContextWithWorkerInfo(pollerCtx, workerInfoFromCall(hCtx.Context, request.GetIdentity(), ""))
 		taskListKind := request.TaskList.GetKind()
 		tlMgr, err := e.getTaskListManager(taskListID, taskListKind)
 		if err != nil {
@@ -841,6 +847,7 @@ func (e *matchingEngineImpl) createSyncMatchPollForActivityTaskResponse(
 	response.PartitionConfig = partitionConfig
 	response.LoadBalancerHints = loadBalancerHints
 	response.AutoConfigHint = task.AutoConfigHint
+	response.HopTrace = task.HopTrace()
 	return response
 }
 
@@ -1215,6 +1222,7 @@ func (e *matchingEngineImpl) createPollForDecisionTaskResponse(
 	response.PartitionConfig = partitionConfig
 	response.LoadBalancerHints = loadBalancerHints
 	response.AutoConfigHint = task.AutoConfigHint
+	response.HopTrace = task.HopTrace()
 	return response
 }
 
@@ -1271,6 +1279,7 @@ func (e *matchingEngineImpl) createPollForActivityTaskResponse(
 	response.PartitionConfig = partitionConfig
 	response.LoadBalancerHints = loadBalancerHints
 	response.AutoConfigHint = task.AutoConfigHint
+	response.HopTrace = task.HopTrace()
 	return response
 }
 
@@ -1549,3 +1558,26 @@ func isDomainEligibleToDisconnectPollers(domain *cache.DomainCacheEntry, current
 		!domain.GetReplicationConfig().IsActiveActive() &&
 		domain.GetFailoverNotificationVersion() > currentVersion
 }
+
+// workerInfoFromCall returns what the polling worker reported about itself. The client library is known from the
+// headers every client sends, the other properties come from optional worker headers. The host falls back to the
+// one in the default identity format of client libraries, and the build ID to the one in the worker headers.
+func workerInfoFromCall(ctx context.Context, identity string, buildID string) poller.WorkerInfo {
+	call := yarpc.CallFromContext(ctx)
+	info := poller.WorkerInfo{
+		ClientImpl:           call.Header(common.ClientImplHeaderName),
+		ClientLibraryVersion: call.Header(common.LibraryVersionHeaderName),
+		Host:                 call.Header(common.WorkerHostHeaderName),
+		BuildID:              buildID,
+	}
+	if info.Host == "" {
+		info.Host = common.HostFromWorkerIdentity(identity)
+	}
+	if info.BuildID == "" {
+		info.BuildID = call.Header(common.WorkerBuildIDHeaderName)
+	}
+	if maxConcurrentTasks, err := strconv.Atoi(call.Header(common.WorkerMaxConcurrentTasksHeaderName)); err == nil && maxConcurrentTasks > 0 {
+		info.MaxConcurrentTasks = maxConcurrentTasks
+	}
+	return info
+}
@@ -32,7 +32,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc/yarpctest"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/clock"
@@ -44,6 +46,7 @@ import (
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/matching/config"
+	"github.com/uber/cadence/service/matching/poller"
 	"github.com/uber/cadence/service/matching/tasklist"
 )
 
@@ -1460,3 +1463,62 @@ func Test_registerDomainFailoverCallback(t *testing.T) {
 	})
 
 }
+
+func TestWorkerInfoFromCall(t *testing.T) {
+	testCases := []struct {
+		name     string
+		headers  map[string]string
+		identity string
+		buildID  string
+		expected poller.WorkerInfo
+	}{
+		{
+			name: "all reported through headers",
+			headers: map[string]string{
+				common.ClientImplHeaderName:               "uber-go",
+				common.LibraryVersionHeaderName:           "1.2.3",
+				common.WorkerHostHeaderName:               "host-a",
+				common.WorkerBuildIDHeaderName:            "build-a",
+				common.WorkerMaxConcurrentTasksHeaderName: "100",
+			},
+			identity: "123@host-b@tasklist",
+			expected: poller.WorkerInfo{
+				ClientImpl:           "uber-go",
+				ClientLibraryVersion: "1.2.3",
+				Host:                 "host-a",
+				BuildID:              "build-a",
+				MaxConcurrentTasks:   100,
+			},
+		},
+		{
+			name: "binary checksum takes precedence over build ID header",
+			headers: map[string]string{
+				common.WorkerBuildIDHeaderName: "build-a",
+			},
+			buildID:  "build-b",
+			expected: poller.WorkerInfo{BuildID: "build-b"},
+		},
+		{
+			name: "host from identity and invalid max concurrent tasks",
+			headers: map[string]string{
+				common.WorkerMaxConcurrentTasksHeaderName: "many",
+			},
+			identity: "123@host-b@tasklist",
+			expected: poller.WorkerInfo{Host: "host-b"},
+		},
+		{
+			name:     "no call",
+			identity: "worker",
+			expected: poller.WorkerInfo{},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.headers != nil {
+				ctx = yarpctest.ContextWithCall(ctx, &yarpctest.Call{Headers: tc.headers})
+			}
+			assert.Equal(t, tc.expected, workerInfoFromCall(ctx, tc.identity, tc.buildID))
+		})
+	}
+}
@@ -22,6 +22,7 @@ package poller
 
 import (
 	"context"
+	"sort"
 	"sync"
 	"time"
 
@@ -42,6 +43,39 @@ type (
 		Identity       string
 		RatePerSecond  float64
 		IsolationGroup string
+		BuildSet       string
+		Worker         WorkerInfo
+	}
+
+	// WorkerInfo describes the worker a poller polls for, as reported by the poller
+	WorkerInfo struct {
+		ClientImpl           string
+		ClientLibraryVersion string
+		Host                 string
+		BuildID              string
+		MaxConcurrentTasks   int
+	}
+
+	// Fleet aggregates the recent pollers of the same worker build in the same isolation group
+	Fleet struct {
+		ClientImpl           string
+		ClientLibraryVersion string
+		BuildID              string
+		IsolationGroup       string
+		Pollers              int
+		Hosts                []string
+		// WaitingPollers is the number of pollers with an outstanding poll. A fleet whose pollers are all
+		// waiting is idle, a fleet without waiting pollers is busy draining the task list.
+		WaitingPollers     int
+		MaxConcurrentTasks int
+		LastAccessTime     time.Time
+	}
+
+	fleetKey struct {
+		clientImpl           string
+		clientLibraryVersion string
+		buildID              string
+		isolationGroup       string
 	}
 
 	Manager interface {
@@ -49,10 +83,12 @@ type (
 		EndPoll(pollerID string)
 		CancelPoll(pollerID string) bool
 		HasPollerFromIsolationGroupAfter(isolationGroup string, after time.Time) bool
+		HasPollerFromBuildSetAfter(buildSet string, after time.Time) bool
 		HasPollerAfter(after time.Time) bool
 		GetCount() int
 		GetCountByIsolationGroup(after time.Time) map[string]int
 		ListInfo() []*types.PollerInfo
+		ListFleets(after time.Time) []*Fleet
 	}
 
 	historicalPoller struct {
@@ -74,6 +110,8 @@ type (
 		mostRecentPollEnd        time.Time
 		mostRecentPollEndByGroup map[string]time.Time
 		outstandingCountByGroup  map[string]int
+		// build sets come from the builds reported by pollers, so entries are removed once they drop to zero
+		outstandingCountByBuildSet map[string]int
 		// pollerID -> outstandingPoller
 		outstanding map[string]outstandingPoller
 
@@ -95,12 +133,13 @@ func NewPollerManager(historyUpdatedFunc HistoryUpdatedFunc, timeSource clock.Ti
 	}
 
 	return &manager{
-		historyCache:             cache.New(opts),
-		timeSource:               timeSource,
-		onHistoryUpdatedFunc:     historyUpdatedFunc,
-		mostRecentPollEndByGroup: make(map[string]time.Time),
-		outstandingCountByGroup:  make(map[string]int),
-		outstanding:              make(map[string]outstandingPoller),
+		historyCache:               cache.New(opts),
+		timeSource:                 timeSource,
+		onHistoryUpdatedFunc:       historyUpdatedFunc,
+		mostRecentPollEndByGroup:   make(map[string]time.Time),
+		outstandingCountByGroup:    make(map[string]int),
+		outstandingCountByBuildSet: make(map[string]int),
+		outstanding:                make(map[string]outstandingPoller),
 	}
 }
 
@@ -127,6 +166,9 @@ func (m *manager) StartPoll(pollerID string, cancelFunc context.CancelFunc, info
 		if info.IsolationGroup != "" {
 			m.outstandingCountByGroup[info.IsolationGroup]++
 		}
+		if info.BuildSet != "" {
+			m.outstandingCountByBuildSet[info.BuildSet]++
+		}
 	}
 }
 
@@ -155,6 +197,12 @@ func (m *manager) tryRemovePoller(pollerID string) (outstandingPoller, bool) {
 			m.mostRecentPollEndByGroup[poller.info.IsolationGroup] = now
 			m.outstandingCountByGroup[poller.info.IsolationGroup]--
 		}
+		if poller.info.BuildSet != "" {
+			m.outstandingCountByBuildSet[poller.info.BuildSet]--
+			if m.outstandingCountByBuildSet[poller.info.BuildSet] <= 0 {
+				delete(m.outstandingCountByBuildSet, poller.info.BuildSet)
+			}
+		}
 	}
 	// reset the mostRecentPollEnd even if we didn't find the poller. They might not have specified a PollerID.
 	// It doesn't seem possible outside of tests, but there's no harm in being safe
@@ -183,6 +231,29 @@ func (m *manager) HasPollerFromIsolationGroupAfter(isolationGroup string, earlie
 	return m.outstandingCountByGroup[isolationGroup] > 0 || m.mostRecentPollEndByGroup[isolationGroup].After(earliest)
 }
 
+// HasPollerFromBuildSetAfter checks if there is an outstanding poller of the build set, or one that polled after the
+// given time. Unlike isolation groups, build sets aren't bounded by configuration so recent pollers are looked up from
+// the poller history instead of being tracked per build set.
+func (m *manager) HasPollerFromBuildSetAfter(buildSet string, earliest time.Time) bool {
+	m.lock.RLock()
+	outstanding := m.outstandingCountByBuildSet[buildSet] > 0
+	m.lock.RUnlock()
+	if outstanding {
+		return true
+	}
+
+	ite := m.historyCache.Iterator()
+	defer ite.Close()
+	for ite.HasNext() {
+		entry := ite.Next()
+		value := entry.Value().(*historicalPoller)
+		if value.info.BuildSet == buildSet && (value.outstanding || earliest.Before(entry.CreateTime())) {
+			return true
+		}
+	}
+	return false
+}
+
 func (m *manager) HasPollerAfter(earliestAccessTime time.Time) bool {
 	m.lock.RLock()
 	defer m.lock.RUnlock()
@@ -222,6 +293,76 @@ func (m *manager) ListInfo() []*types.PollerInfo {
 	return result
 }
 
+// ListFleets groups the pollers that polled after the given time by worker build and isolation group
+func (m *manager) ListFleets(after time.Time) []*Fleet {
+	fleets := make(map[fleetKey]*Fleet)
+	hosts := make(map[fleetKey]map[string]struct{})
+
+	ite := m.historyCache.Iterator()
+	defer ite.Close()
+	for ite.HasNext() {
+		entry := ite.Next()
+		value := entry.Value().(*historicalPoller)
+		lastAccessTime := entry.CreateTime()
+		if !after.IsZero() && !value.outstanding && !after.Before(lastAccessTime) {
+			continue
+		}
+		info := value.info
+		key := fleetKey{
+			clientImpl:           info.Worker.ClientImpl,
+			clientLibraryVersion: info.Worker.ClientLibraryVersion,
+			buildID:              info.Worker.BuildID,
+			isolationGroup:       info.IsolationGroup,
+		}
+		fleet, ok := fleets[key]
+		if !ok {
+			fleet = &Fleet{
+				ClientImpl:           key.clientImpl,
+				ClientLibraryVersion: key.clientLibraryVersion,
+				BuildID:              key.buildID,
+				IsolationGroup:       key.isolationGroup,
+			}
+			fleets[key] = fleet
+			hosts[key] = make(map[string]struct{})
+		}
+		fleet.Pollers++
+		if value.outstanding {
+			fleet.WaitingPollers++
+		}
+		fleet.MaxConcurrentTasks += info.Worker.MaxConcurrentTasks
+		if info.Worker.Host != "" {
+			hosts[key][info.Worker.Host] = struct{}{}
+		}
+		if lastAccessTime.After(fleet.LastAccessTime) {
+			fleet.LastAccessTime = lastAccessTime
+		}
+	}
+
+	result := make([]*Fleet, 0, len(fleets))
+	for key, fleet := range fleets {
+		fleet.Hosts = make([]string, 0, len(hosts[key]))
+		for host := range hosts[key] {
+			fleet.Hosts = append(fleet.Hosts, host)
+		}
+		sort.Strings(fleet.Hosts)
+		result = append(result, fleet)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		if a.ClientImpl != b.ClientImpl {
+			return a.ClientImpl < b.ClientImpl
+		}
+		if a.ClientLibraryVersion != b.ClientLibraryVersion {
+			return a.ClientLibraryVersion < b.ClientLibraryVersion
+		}
+		if a.BuildID != b.BuildID {
+			return a.BuildID < b.BuildID
+		}
+		return a.IsolationGroup < b.IsolationGroup
+	})
+	return result
+}
+
 func (m *manager) forEachPoller(after time.Time, callback func(string, *Info, time.Time)) {
 	ite := m.historyCache.Iterator()
 	defer ite.Close()
@@ -174,6 +174,79 @@ func TestManager_HasPollerFromIsolationGroupAfter(t *testing.T) {
 	}
 }
 
+func TestManager_HasPollerFromBuildSetAfter(t *testing.T) {
+	startTime := time.Date(2024, time.October, 28, 0, 0, 0, 0, time.UTC)
+	buildSet := "the build set"
+	cases := []struct {
+		name   string
+		fn     func(mockTime clock.MockedTimeSource, m Manager)
+		after  time.Time
+		result bool
+	}{
+		{
+			name: "outstanding poller",
+			fn: func(mockTime clock.MockedTimeSource, m Manager) {
+				m.StartPoll("a", NoopFunc, &Info{BuildSet: buildSet})
+			},
+			after:  startTime,
+			result: true,
+		},
+		{
+			name: "recent poller",
+			fn: func(mockTime clock.MockedTimeSource, m Manager) {
+				m.StartPoll("a", NoopFunc, &Info{Identity: "a", BuildSet: buildSet})
+				mockTime.Advance(time.Second)
+				m.EndPoll("a")
+			},
+			after:  startTime,
+			result: true,
+		},
+		{
+			name: "expired poller",
+			fn: func(mockTime clock.MockedTimeSource, m Manager) {
+				m.StartPoll("a", NoopFunc, &Info{Identity: "a", BuildSet: buildSet})
+				m.EndPoll("a")
+			},
+			after:  startTime.Add(time.Minute),
+			result: false,
+		},
+		{
+			name:   "never polled",
+			fn:     func(mockTime clock.MockedTimeSource, m Manager) {},
+			after:  startTime,
+			result: false,
+		},
+		{
+			name: "unrelated poller",
+			fn: func(mockTime clock.MockedTimeSource, m Manager) {
+				m.StartPoll("a", NoopFunc, &Info{Identity: "a", BuildSet: "other"})
+				mockTime.Advance(time.Second)
+				m.EndPoll("a")
+			},
+			after:  startTime,
+			result: false,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockTime := clock.NewMockedTimeSourceAt(startTime)
+			m := NewPollerManager(NoopFunc, mockTime)
+			tc.fn(mockTime, m)
+			assert.Equal(t, tc.result, m.HasPollerFromBuildSetAfter(buildSet, tc.after))
+		})
+	}
+}
+
+func TestManager_OutstandingCountByBuildSetIsBounded(t *testing.T) {
+	m := NewPollerManager(NoopFunc, clock.NewMockedTimeSource()).(*manager)
+	m.StartPoll("a", NoopFunc, &Info{BuildSet: "set-1"})
+	m.StartPoll("b", NoopFunc, &Info{BuildSet: "set-1"})
+	m.EndPoll("a")
+	assert.Equal(t, map[string]int{"set-1": 1}, m.outstandingCountByBuildSet)
+	m.EndPoll("b")
+	assert.Empty(t, m.outstandingCountByBuildSet)
+}
+
 func TestManager_HasPollerAfter(t *testing.T) {
 	startTime := time.Date(2024, time.October, 28, 0, 0, 0, 0, time.UTC)
 	cases := []struct {
@@ -329,6 +402,106 @@ func TestManager_ListInfo(t *testing.T) {
 	}
 }
 
+func TestManager_ListFleets(t *testing.T) {
+	startTime := time.Date(2024, time.October, 28, 0, 0, 0, 0, time.UTC)
+	goV1 := WorkerInfo{ClientImpl: "uber-go", ClientLibraryVersion: "1.0.0", BuildID: "build-a", MaxConcurrentTasks: 10}
+	javaV2 := WorkerInfo{ClientImpl: "uber-java", ClientLibraryVersion: "2.0.0", BuildID: "build-b", MaxConcurrentTasks: 5}
+	withHost := func(info WorkerInfo, host string) WorkerInfo {
+		info.Host = host
+		return info
+	}
+	cases := []struct {
+		name   string
+		fn     func(mockTime clock.MockedTimeSource, m Manager)
+		after  time.Time
+		result []*Fleet
+	}{
+		{
+			name:   "no pollers",
+			fn:     func(mockTime clock.MockedTimeSource, m Manager) {},
+			result: []*Fleet{},
+		},
+		{
+			name: "pollers grouped by build and isolation group",
+			fn: func(mockTime clock.MockedTimeSource, m Manager) {
+				m.StartPoll("a", NoopFunc, &Info{Identity: "a", IsolationGroup: "zone-a", Worker: withHost(goV1, "host-1")})
+				m.StartPoll("b", NoopFunc, &Info{Identity: "b", IsolationGroup: "zone-a", Worker: withHost(goV1, "host-1")})
+				m.StartPoll("c", NoopFunc, &Info{Identity: "c", IsolationGroup: "zone-a", Worker: withHost(goV1, "host-2")})
+				mockTime.Advance(time.Second)
+				m.EndPoll("c")
+				m.StartPoll("d", NoopFunc, &Info{Identity: "d", IsolationGroup: "zone-a", Worker: withHost(javaV2, "host-3")})
+				m.EndPoll("d")
+				m.StartPoll("e", NoopFunc, &Info{Identity: "e", IsolationGroup: "zone-b", Worker: withHost(goV1, "host-4")})
+			},
+			result: []*Fleet{
+				{
+					ClientImpl:           "uber-go",
+					ClientLibraryVersion: "1.0.0",
+					BuildID:              "build-a",
+					IsolationGroup:       "zone-a",
+					Pollers:              3,
+					Hosts:                []string{"host-1", "host-2"},
+					WaitingPollers:       2,
+					MaxConcurrentTasks:   30,
+					LastAccessTime:       startTime.Add(time.Second),
+				},
+				{
+					ClientImpl:           "uber-go",
+					ClientLibraryVersion: "1.0.0",
+					BuildID:              "build-a",
+					IsolationGroup:       "zone-b",
+					Pollers:              1,
+					Hosts:                []string{"host-4"},
+					WaitingPollers:       1,
+					MaxConcurrentTasks:   10,
+					LastAccessTime:       startTime.Add(time.Second),
+				},
+				{
+					ClientImpl:           "uber-java",
+					ClientLibraryVersion: "2.0.0",
+					BuildID:              "build-b",
+					IsolationGroup:       "zone-a",
+					Pollers:              1,
+					Hosts:                []string{"host-3"},
+					WaitingPollers:       0,
+					MaxConcurrentTasks:   5,
+					LastAccessTime:       startTime.Add(time.Second),
+				},
+			},
+		},
+		{
+			name: "old pollers are excluded",
+			fn: func(mockTime clock.MockedTimeSource, m Manager) {
+				m.StartPoll("a", NoopFunc, &Info{Identity: "a", Worker: goV1})
+				m.EndPoll("a")
+				mockTime.Advance(time.Minute)
+				m.StartPoll("b", NoopFunc, &Info{Identity: "b", Worker: javaV2})
+				m.EndPoll("b")
+			},
+			after: startTime.Add(time.Second),
+			result: []*Fleet{
+				{
+					ClientImpl:           "uber-java",
+					ClientLibraryVersion: "2.0.0",
+					BuildID:              "build-b",
+					Pollers:              1,
+					Hosts:                []string{},
+					MaxConcurrentTasks:   5,
+					LastAccessTime:       startTime.Add(time.Minute),
+				},
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockTime := clock.NewMockedTimeSourceAt(startTime)
+			m := NewPollerManager(NoopFunc, mockTime)
+			tc.fn(mockTime, m)
+			assert.Equal(t, tc.result, m.ListFleets(tc.after))
+		})
+	}
+}
+
 func TestManager_GetCountByIsolationGroup(t *testing.T) {
 	startTime := time.Date(2024, time.October, 28, 0, 0, 0, 0, time.UTC)
 	cases := []struct {
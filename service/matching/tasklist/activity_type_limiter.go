@@ -0,0 +1,173 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package tasklist
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/service/matching/config"
+)
+
+// maxActivityTypeRetryDelay bounds how long a throttled task waits before it is offered again, so that rate
+// changes, including from a rate of zero, are picked up
+const maxActivityTypeRetryDelay = 10 * time.Second
+
+type (
+	// activityTypeLimiter limits the rate at which the tasks of individual activity types are dispatched.
+	// The configured rates apply to the whole task list, so every partition enforces its share of them.
+	activityTypeLimiter struct {
+		rps             func() map[string]interface{}
+		minBurst        func() int
+		countPartitions func() int
+
+		lock     sync.Mutex
+		limiters map[string]*activityTypeRatelimiter
+	}
+
+	activityTypeRatelimiter struct {
+		rps        float64
+		partitions int
+		limiter    clock.Ratelimiter
+	}
+
+	// activityTypeThrottledError is returned when a task can't be dispatched because its activity type is throttled.
+	// retryAfter is when the activity type is expected to allow another dispatch.
+	activityTypeThrottledError struct {
+		activityType string
+		retryAfter   time.Duration
+	}
+)
+
+func (e *activityTypeThrottledError) Error() string {
+	return fmt.Sprintf("activity type %v limit exceeded", e.activityType)
+}
+
+func newActivityTypeLimiter(config *config.TaskListConfig, numPartitions func() int) *activityTypeLimiter {
+	return &activityTypeLimiter{
+		rps:             config.ActivityTypeDispatchRPS,
+		minBurst:        config.MinTaskThrottlingBurstSize,
+		countPartitions: numPartitions,
+		limiters:        make(map[string]*activityTypeRatelimiter),
+	}
+}
+
+// Reserve reserves the dispatch of the task according to the rate of its activity type, without blocking.
+// An activityTypeThrottledError is returned if the activity type is throttled. Tasks without an activity type,
+// or whose activity type has no rate configured, get a nil reservation. Otherwise the reservation must be marked
+// as used or unused once the task is dispatched or throttled by the task list.
+func (l *activityTypeLimiter) Reserve(task *InternalTask) (clock.Reservation, error) {
+	activityType := getActivityType(task)
+	limiter := l.getLimiter(activityType)
+	if limiter == nil {
+		return nil, nil
+	}
+	reservation := limiter.Reserve()
+	if !reservation.Allow() {
+		reservation.Used(false)
+		return nil, &activityTypeThrottledError{activityType: activityType, retryAfter: getRetryDelay(limiter.Limit())}
+	}
+	return reservation, nil
+}
+
+func (l *activityTypeLimiter) getLimiter(activityType string) clock.Ratelimiter {
+	if activityType == "" {
+		return nil
+	}
+	rps, ok := toRPS(l.rps()[activityType])
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if !ok {
+		// the rate of the activity type has been removed, or was never configured
+		delete(l.limiters, activityType)
+		return nil
+	}
+	partitions := max(l.countPartitions(), 1)
+	limit, burst := l.getLimitAndBurst(rps, partitions)
+	entry, ok := l.limiters[activityType]
+	if !ok {
+		entry = &activityTypeRatelimiter{rps: rps, partitions: partitions, limiter: clock.NewRatelimiter(limit, burst)}
+		l.limiters[activityType] = entry
+	} else if math.Abs(entry.rps-rps) >= precision || entry.partitions != partitions {
+		entry.rps = rps
+		entry.partitions = partitions
+		entry.limiter.SetLimitAndBurst(limit, burst)
+	}
+	return entry.limiter
+}
+
+func (l *activityTypeLimiter) getLimitAndBurst(rps float64, partitions int) (rate.Limit, int) {
+	rps = rps / float64(partitions)
+	burst := max(int(math.Ceil(rps)), l.minBurst())
+	if rps == 0 {
+		burst = 0
+	}
+	return rate.Limit(rps), burst
+}
+
+func getRetryDelay(limit rate.Limit) time.Duration {
+	if limit <= 0 {
+		return maxActivityTypeRetryDelay
+	}
+	return min(time.Duration(float64(time.Second)/float64(limit)), maxActivityTypeRetryDelay)
+}
+
+// getActivityType returns the activity type of the task, as recorded by history in the partition config of the
+// task or, for tasks dispatched by history right after being scheduled, in their scheduled event
+func getActivityType(task *InternalTask) string {
+	if task.Event == nil || task.Event.TaskInfo == nil {
+		return ""
+	}
+	if activityType, ok := task.Event.PartitionConfig[constants.ActivityTypePartitionConfigKey]; ok {
+		return activityType
+	}
+	if task.ActivityTaskDispatchInfo != nil {
+		return task.ActivityTaskDispatchInfo.ScheduledEvent.GetActivityTaskScheduledEventAttributes().GetActivityType().GetName()
+	}
+	return ""
+}
+
+func toRPS(value interface{}) (float64, bool) {
+	var rps float64
+	switch v := value.(type) {
+	case float64:
+		rps = v
+	case int:
+		rps = float64(v)
+	case int64:
+		rps = float64(v)
+	default:
+		return 0, false
+	}
+	if rps < 0 {
+		return 0, false
+	}
+	return rps, true
+}
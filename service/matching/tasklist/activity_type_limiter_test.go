@@ -0,0 +1,171 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package tasklist
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/matching/config"
+)
+
+func newTestActivityTypeLimiter(rps map[string]interface{}, partitions *int) *activityTypeLimiter {
+	return newActivityTypeLimiter(&config.TaskListConfig{
+		ActivityTypeDispatchRPS:    func() map[string]interface{} { return rps },
+		MinTaskThrottlingBurstSize: func() int { return 1 },
+	}, func() int { return *partitions })
+}
+
+func TestActivityTypeLimiter_GetLimiter(t *testing.T) {
+	partitions := 4
+	rps := map[string]interface{}{"int-type": 40, "float-type": 2.0, "invalid-type": "10"}
+	limiter := newTestActivityTypeLimiter(rps, &partitions)
+
+	assert.Nil(t, limiter.getLimiter(""))
+	assert.Nil(t, limiter.getLimiter("unknown-type"))
+	assert.Nil(t, limiter.getLimiter("invalid-type"))
+
+	intLimiter := limiter.getLimiter("int-type")
+	assert.Equal(t, rate.Limit(10), intLimiter.Limit())
+	assert.Equal(t, 10, intLimiter.Burst())
+	floatLimiter := limiter.getLimiter("float-type")
+	assert.Equal(t, rate.Limit(0.5), floatLimiter.Limit())
+	assert.Equal(t, 1, floatLimiter.Burst())
+	assert.Len(t, limiter.limiters, 2)
+
+	// the limiter is reused and follows changes of the rate and of the number of partitions
+	rps["int-type"] = 80
+	partitions = 2
+	assert.Same(t, intLimiter, limiter.getLimiter("int-type"))
+	assert.Equal(t, rate.Limit(40), intLimiter.Limit())
+
+	delete(rps, "int-type")
+	assert.Nil(t, limiter.getLimiter("int-type"))
+	assert.Len(t, limiter.limiters, 1)
+}
+
+func TestActivityTypeLimiter_Reserve(t *testing.T) {
+	partitions := 1
+	limiter := newTestActivityTypeLimiter(map[string]interface{}{"blocked-type": 0, "slow-type": 0.5}, &partitions)
+
+	reservation, err := limiter.Reserve(newActivityTask("other-type"))
+	assert.NoError(t, err)
+	assert.Nil(t, reservation)
+
+	_, err = limiter.Reserve(newActivityTask("blocked-type"))
+	var throttled *activityTypeThrottledError
+	assert.ErrorAs(t, err, &throttled)
+	assert.Equal(t, &activityTypeThrottledError{activityType: "blocked-type", retryAfter: maxActivityTypeRetryDelay}, throttled)
+
+	reservation, err = limiter.Reserve(newActivityTask("slow-type"))
+	assert.NoError(t, err)
+	reservation.Used(true)
+	_, err = limiter.Reserve(newActivityTask("slow-type"))
+	assert.Equal(t, &activityTypeThrottledError{activityType: "slow-type", retryAfter: 2 * time.Second}, err)
+}
+
+func TestTaskMatcher_RatelimitActivityType(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	partitions := 1
+	limiter := newTestActivityTypeLimiter(map[string]interface{}{"blocked-type": 0, "slow-type": 0.5}, &partitions)
+	taskListLimiter := clock.NewRatelimiter(0.001, 1)
+	matcher := &taskMatcherImpl{limiter: taskListLimiter, activityTypeLimiter: limiter}
+
+	// a throttled activity type doesn't consume the token of the task list
+	var throttled *activityTypeThrottledError
+	assert.ErrorAs(t, matcher.ratelimit(ctx, newActivityTask("blocked-type")), &throttled)
+	assert.NoError(t, matcher.ratelimit(ctx, newActivityTask("other-type")))
+
+	// a task throttled by the task list doesn't consume the token of its activity type
+	assert.ErrorIs(t, matcher.ratelimit(ctx, newActivityTask("slow-type")), ErrTasklistThrottled)
+	taskListLimiter.SetLimitAndBurst(rate.Inf, 1)
+	assert.NoError(t, matcher.ratelimit(ctx, newActivityTask("slow-type")))
+	assert.ErrorAs(t, matcher.ratelimit(ctx, newActivityTask("slow-type")), &throttled)
+}
+
+func newActivityTask(activityType string) *InternalTask {
+	return newInternalTask(&persistence.TaskInfo{
+		PartitionConfig: map[string]string{constants.ActivityTypePartitionConfigKey: activityType},
+	}, nil, types.TaskSourceDbBacklog, "", false, nil, "", "")
+}
+
+func TestGetActivityType(t *testing.T) {
+	cases := []struct {
+		name     string
+		task     *InternalTask
+		expected string
+	}{
+		{
+			name:     "query task",
+			task:     &InternalTask{},
+			expected: "",
+		},
+		{
+			name: "from partition config",
+			task: newInternalTask(&persistence.TaskInfo{
+				PartitionConfig: map[string]string{constants.ActivityTypePartitionConfigKey: "activity-type"},
+			}, nil, types.TaskSourceDbBacklog, "", false, nil, "", ""),
+			expected: "activity-type",
+		},
+		{
+			name: "isolated task keeps activity type",
+			task: newInternalTask(&persistence.TaskInfo{
+				PartitionConfig: map[string]string{
+					constants.ActivityTypePartitionConfigKey: "activity-type",
+					"isolation-group":                        "zone-a",
+				},
+			}, nil, types.TaskSourceDbBacklog, "", false, nil, "zone-b", ""),
+			expected: "activity-type",
+		},
+		{
+			name: "from activity dispatch info",
+			task: newInternalTask(&persistence.TaskInfo{}, nil, types.TaskSourceHistory, "", true, &types.ActivityTaskDispatchInfo{
+				ScheduledEvent: &types.HistoryEvent{
+					ActivityTaskScheduledEventAttributes: &types.ActivityTaskScheduledEventAttributes{
+						ActivityType: &types.ActivityType{Name: "activity-type"},
+					},
+				},
+			}, "", ""),
+			expected: "activity-type",
+		},
+		{
+			name:     "decision task",
+			task:     newInternalTask(&persistence.TaskInfo{}, nil, types.TaskSourceHistory, "", true, nil, "", ""),
+			expected: "",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, getActivityType(tc.task))
+		})
+	}
+}
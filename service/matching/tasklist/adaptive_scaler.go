@@ -27,6 +27,7 @@ import (
 	"math"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"golang.org/x/sync/errgroup"
 
@@ -55,6 +56,8 @@ type (
 		logger         log.Logger
 		scope          metrics.Scope
 		matchingClient matching.Client
+		taskManager    persistence.TaskManager
+		domainName     string
 
 		taskListType *types.TaskListType
 		status       int32
@@ -65,6 +68,9 @@ type (
 		underLoad    clock.Sustain
 		isolation    *isolationBalancer
 		baseEvent    event.E
+
+		// backlogOverLoad tracks how long the backlog has been understaffed
+		backlogOverLoad clock.Sustain
 	}
 
 	aggregatePartitionMetrics struct {
@@ -79,6 +85,17 @@ type (
 		qps      float64
 		readOnly bool
 		empty    bool
+		backlog  int64
+		pollers  []string // identities of the pollers of the partition
+		// oldestTaskCreatedTime is the creation time of the oldest task that has not been completed, zero if unknown
+		oldestTaskCreatedTime time.Time
+	}
+
+	backlogMetrics struct {
+		age         time.Duration
+		count       int64
+		pollers     int
+		pollerRatio float64
 	}
 )
 
@@ -90,6 +107,8 @@ func NewAdaptiveScaler(
 	logger log.Logger,
 	scope metrics.Scope,
 	matchingClient matching.Client,
+	taskManager persistence.TaskManager,
+	domainName string,
 	baseEvent event.E,
 ) AdaptiveScaler {
 	ctx, cancel := context.WithCancel(context.Background())
@@ -101,6 +120,8 @@ func NewAdaptiveScaler(
 		logger:         logger.WithTags(tag.ComponentTaskListAdaptiveScaler),
 		scope:          scope,
 		matchingClient: matchingClient,
+		taskManager:    taskManager,
+		domainName:     domainName,
 		taskListType:   getTaskListType(taskListID.GetType()),
 		ctx:            ctx,
 		cancel:         cancel,
@@ -108,6 +129,8 @@ func NewAdaptiveScaler(
 		overLoad:       clock.NewSustain(timeSource, config.PartitionUpscaleSustainedDuration),
 		underLoad:      clock.NewSustain(timeSource, config.PartitionDownscaleSustainedDuration),
 		baseEvent:      baseEvent,
+
+		backlogOverLoad: clock.NewSustain(timeSource, config.PartitionUpscaleSustainedDuration),
 	}
 }
 
@@ -155,12 +178,21 @@ func (a *adaptiveScalerImpl) run() {
 	if err != nil {
 		a.underLoad.Reset()
 		a.overLoad.Reset()
+		a.backlogOverLoad.Reset()
 		a.isolation.reset()
 		a.logger.Error("Failed to collect partition metrics", tag.Error(err))
 		return
 	}
-	// adjust the number of write partitions based on qps
-	numWritePartitions := a.calculateWritePartitionCount(m.totalQPS, len(partitionConfig.WritePartitions))
+	var backlog *backlogMetrics
+	understaffed := false
+	if a.config.EnableAdaptiveScalerBacklogScaling() {
+		backlog = a.getBacklogMetrics(m)
+		understaffed = a.isBacklogUnderstaffed(backlog)
+	} else {
+		a.backlogOverLoad.Reset()
+	}
+	// adjust the number of write partitions based on qps, without removing partitions while the backlog is understaffed
+	numWritePartitions := a.calculateWritePartitionCount(m.totalQPS, len(partitionConfig.WritePartitions), understaffed)
 	writePartitions, writeChanged := a.adjustWritePartitions(partitionConfig.WritePartitions, numWritePartitions)
 
 	isolationChanged := false
@@ -175,7 +207,12 @@ func (a *adaptiveScalerImpl) run() {
 
 	// adjustReadPartitions will copy over any changes to the writePartitions, so it needs to happen after we
 	// potentially change the isolation group assignments
-	readPartitions, readChanged := a.adjustReadPartitions(m, partitionConfig.ReadPartitions, writePartitions)
+	// the backlog is drained by pollers, so an understaffed backlog is handled by keeping and adding read partitions
+	minReadPartitions := 0
+	if backlog != nil {
+		minReadPartitions = a.calculateBacklogReadPartitionCount(backlog, understaffed, len(partitionConfig.ReadPartitions))
+	}
+	readPartitions, readChanged := a.adjustReadPartitions(m, partitionConfig.ReadPartitions, writePartitions, minReadPartitions)
 
 	e := a.baseEvent
 	e.EventName = "AdaptiveScalerCalculationResult"
@@ -187,6 +224,12 @@ func (a *adaptiveScalerImpl) run() {
 		"IsolationChanged":   isolationChanged,
 		"QPS":                m.totalQPS,
 	}
+	if backlog != nil {
+		e.Payload["BacklogAge"] = backlog.age.String()
+		e.Payload["BacklogCount"] = backlog.count
+		e.Payload["PollerCount"] = backlog.pollers
+		e.Payload["PollerBacklogRatio"] = backlog.pollerRatio
+	}
 	event.Log(e)
 
 	if !writeChanged && !readChanged && !isolationChanged {
@@ -229,7 +272,7 @@ func (a *adaptiveScalerImpl) getPartitionConfig() *types.TaskListPartitionConfig
 	return partitionConfig
 }
 
-func (a *adaptiveScalerImpl) calculateWritePartitionCount(qps float64, numWritePartitions int) int {
+func (a *adaptiveScalerImpl) calculateWritePartitionCount(qps float64, numWritePartitions int, holdDownscale bool) int {
 	upscaleRps := float64(a.config.PartitionUpscaleRPS())
 	partitions := float64(numWritePartitions)
 	downscaleFactor := a.config.PartitionDownscaleFactor()
@@ -245,7 +288,10 @@ func (a *adaptiveScalerImpl) calculateWritePartitionCount(qps float64, numWriteP
 		a.scope.IncCounter(metrics.PartitionUpscale)
 		a.logger.Info("adjust write partitions", tag.CurrentQPS(qps), tag.PartitionUpscaleThreshold(upscaleThreshold), tag.PartitionDownscaleThreshold(downscaleThreshold), tag.PartitionDownscaleFactor(downscaleFactor), tag.CurrentNumWritePartitions(numWritePartitions), tag.NumWritePartitions(result))
 	}
-	if a.underLoad.CheckAndReset(qps < downscaleThreshold) {
+	if holdDownscale {
+		// keep tracking the underload so that partitions are removed as soon as the hold is lifted
+		a.underLoad.Check(qps < downscaleThreshold)
+	} else if a.underLoad.CheckAndReset(qps < downscaleThreshold) {
 		result = getNumberOfPartitions(qps, upscaleRps)
 		a.scope.IncCounter(metrics.PartitionDownscale)
 		a.logger.Info("adjust write partitions", tag.CurrentQPS(qps), tag.PartitionUpscaleThreshold(upscaleThreshold), tag.PartitionDownscaleThreshold(downscaleThreshold), tag.PartitionDownscaleFactor(downscaleFactor), tag.CurrentNumWritePartitions(numWritePartitions), tag.NumWritePartitions(result))
@@ -254,6 +300,58 @@ func (a *adaptiveScalerImpl) calculateWritePartitionCount(qps float64, numWriteP
 	return result
 }
 
+// getBacklogMetrics aggregates the backlog of all partitions. The backlog age is the age of the oldest task
+// that has not been completed in any partition. Pollers are counted once even if they poll from multiple partitions.
+func (a *adaptiveScalerImpl) getBacklogMetrics(m *aggregatePartitionMetrics) *backlogMetrics {
+	now := a.timeSource.Now()
+	result := &backlogMetrics{}
+	pollers := make(map[string]struct{})
+	for _, p := range m.byPartition {
+		result.count += p.backlog
+		for _, identity := range p.pollers {
+			pollers[identity] = struct{}{}
+		}
+		if p.empty || p.oldestTaskCreatedTime.IsZero() {
+			continue
+		}
+		if age := now.Sub(p.oldestTaskCreatedTime); age > result.age {
+			result.age = age
+		}
+	}
+	result.pollers = len(pollers)
+	if result.count > 0 {
+		result.pollerRatio = float64(result.pollers) / float64(result.count)
+	} else {
+		result.pollerRatio = math.Inf(1)
+	}
+	a.scope.UpdateGauge(metrics.TaskListBacklogAgeGauge, result.age.Seconds())
+	if result.count > 0 {
+		a.scope.UpdateGauge(metrics.TaskListPollerBacklogRatioGauge, result.pollerRatio)
+	}
+	return result
+}
+
+// isBacklogUnderstaffed returns true when the oldest task in the backlog is older than the configured age
+// and there are too few pollers to drain it
+func (a *adaptiveScalerImpl) isBacklogUnderstaffed(backlog *backlogMetrics) bool {
+	return backlog.age > a.config.PartitionUpscaleBacklogAge() && backlog.pollerRatio < a.config.PartitionUpscaleBacklogPollerRatio()
+}
+
+// calculateBacklogReadPartitionCount returns the minimum number of read partitions. Read partitions are kept while the
+// backlog is understaffed, and one is added when it has been understaffed for a sustained period.
+func (a *adaptiveScalerImpl) calculateBacklogReadPartitionCount(backlog *backlogMetrics, understaffed bool, numReadPartitions int) int {
+	result := 0
+	if understaffed {
+		result = numReadPartitions
+	}
+	if a.backlogOverLoad.CheckAndReset(understaffed) && numReadPartitions < a.config.PartitionBacklogUpscaleMaxPartitions() {
+		result = numReadPartitions + 1
+		a.scope.IncCounter(metrics.PartitionBacklogUpscale)
+		a.logger.Info("adjust read partitions for backlog", tag.BacklogAge(backlog.age), tag.PollerBacklogRatio(backlog.pollerRatio), tag.CurrentNumReadPartitions(numReadPartitions), tag.NumReadPartitions(result))
+	}
+	return result
+}
+
 func (a *adaptiveScalerImpl) adjustWritePartitions(writePartitions map[int]*types.TaskListPartition, targetWritePartitions int) (map[int]*types.TaskListPartition, bool) {
 	if len(writePartitions) == targetWritePartitions {
 		return writePartitions, false
@@ -270,8 +368,8 @@ func (a *adaptiveScalerImpl) adjustWritePartitions(writePartitions map[int]*type
 	return result, true
 }
 
-func (a *adaptiveScalerImpl) adjustReadPartitions(m *aggregatePartitionMetrics, oldReadPartitions map[int]*types.TaskListPartition, newWritePartitions map[int]*types.TaskListPartition) (map[int]*types.TaskListPartition, bool) {
-	result := make(map[int]*types.TaskListPartition, len(newWritePartitions))
+func (a *adaptiveScalerImpl) adjustReadPartitions(m *aggregatePartitionMetrics, oldReadPartitions map[int]*types.TaskListPartition, newWritePartitions map[int]*types.TaskListPartition, minReadPartitions int) (map[int]*types.TaskListPartition, bool) {
+	result := make(map[int]*types.TaskListPartition, max(len(newWritePartitions), minReadPartitions))
 	changed := false
 	for id, p := range oldReadPartitions {
 		result[id] = p
@@ -282,8 +380,14 @@ func (a *adaptiveScalerImpl) adjustReadPartitions(m *aggregatePartitionMetrics,
 		}
 		result[id] = p
 	}
+	for id := 0; id < minReadPartitions; id++ {
+		if _, ok := result[id]; !ok {
+			changed = true
+			result[id] = &types.TaskListPartition{}
+		}
+	}
 
-	for i := len(result) - 1; i >= len(newWritePartitions); i-- {
+	for i := len(result) - 1; i >= max(len(newWritePartitions), minReadPartitions); i-- {
 		p, ok := m.byPartition[i]
 		if !ok {
 			resp, err := a.describePartition(i)
@@ -308,7 +412,7 @@ func (a *adaptiveScalerImpl) adjustReadPartitions(m *aggregatePartitionMetrics,
 }
 
 func (a *adaptiveScalerImpl) collectPartitionMetrics(config *types.TaskListPartitionConfig) (*aggregatePartitionMetrics, error) {
-	if a.config.EnablePartitionIsolationGroupAssignment() {
+	if a.config.EnablePartitionIsolationGroupAssignment() || a.config.EnableAdaptiveScalerBacklogScaling() {
 		return a.fetchMetricsFromPartitions(config)
 	}
 	return a.assumeEvenQPS(config)
@@ -328,6 +432,7 @@ func (a *adaptiveScalerImpl) assumeEvenQPS(config *types.TaskListPartitionConfig
 func (a *adaptiveScalerImpl) fetchMetricsFromPartitions(config *types.TaskListPartitionConfig) (*aggregatePartitionMetrics, error) {
 	var mutex sync.Mutex
 	results := make(map[int]*types.DescribeTaskListResponse, len(config.ReadPartitions))
+	oldestTasks := make(map[int]time.Time)
 	g := &errgroup.Group{}
 	for p := range config.ReadPartitions {
 		partitionID := p
@@ -338,11 +443,21 @@ func (a *adaptiveScalerImpl) fetchMetricsFromPartitions(config *types.TaskListPa
 			if e != nil {
 				a.logger.Warn("failed to get partition metrics", tag.WorkflowTaskListName(a.taskListID.GetPartition(partitionID)), tag.Error(e))
 			}
-			if result != nil {
-				mutex.Lock()
-				defer mutex.Unlock()
-				results[partitionID] = result
+			if result == nil {
+				return e
+			}
+			var oldestTask time.Time
+			if a.config.EnableAdaptiveScalerBacklogScaling() && !a.isPartitionEmpty(result.TaskListStatus) {
+				var err error
+				oldestTask, err = a.getOldestTaskCreatedTime(partitionID, result.TaskListStatus.AckLevel)
+				if err != nil {
+					a.logger.Warn("failed to get oldest task of partition", tag.WorkflowTaskListName(a.taskListID.GetPartition(partitionID)), tag.Error(err))
+				}
 			}
+			mutex.Lock()
+			defer mutex.Unlock()
+			results[partitionID] = result
+			oldestTasks[partitionID] = oldestTask
 			return e
 		})
 	}
@@ -351,7 +466,33 @@ func (a *adaptiveScalerImpl) fetchMetricsFromPartitions(config *types.TaskListPa
 		return nil, err
 	}
 
-	return a.toAggregateMetrics(results), err
+	m := a.toAggregateMetrics(results)
+	for id, oldestTask := range oldestTasks {
+		m.byPartition[id].oldestTaskCreatedTime = oldestTask
+	}
+	return m, nil
+}
+
+// getOldestTaskCreatedTime reads the first task after the ack level of the partition, which is the oldest task
+// that has not been completed. A zero time is returned if there is no such task.
+func (a *adaptiveScalerImpl) getOldestTaskCreatedTime(partitionID int, ackLevel int64) (time.Time, error) {
+	maxReadLevel := int64(math.MaxInt64)
+	resp, err := a.taskManager.GetTasks(a.ctx, &persistence.GetTasksRequest{
+		DomainID:     a.taskListID.GetDomainID(),
+		TaskList:     a.taskListID.GetPartition(partitionID),
+		TaskType:     a.taskListID.GetType(),
+		BatchSize:    1,
+		ReadLevel:    ackLevel,      // exclusive
+		MaxReadLevel: &maxReadLevel, // inclusive
+		DomainName:   a.domainName,
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+	if len(resp.Tasks) == 0 {
+		return time.Time{}, nil
+	}
+	return resp.Tasks[0].CreatedTime, nil
 }
 
 func (a *adaptiveScalerImpl) describePartition(partitionID int) (*types.DescribeTaskListResponse, error) {
@@ -390,7 +531,7 @@ func (a *adaptiveScalerImpl) toAggregateMetrics(partitions map[int]*types.Descri
 		qpsByIsolationGroup:        byIsolationGroup,
 		hasPollersByIsolationGroup: hasPollersByIsolationGroup,
 		byPartition:                byPartition,
-		isIsolationEnabled:         true,
+		isIsolationEnabled:         a.config.EnablePartitionIsolationGroupAssignment(),
 	}
 }
 
@@ -399,17 +540,24 @@ func (a *adaptiveScalerImpl) toPartitionMetrics(id int, p *types.DescribeTaskLis
 	if p.PartitionConfig != nil {
 		_, hasWritePartition = p.PartitionConfig.WritePartitions[id]
 	}
-	var empty bool
-	if a.config.EnablePartitionEmptyCheck() {
-		empty = p.TaskListStatus.Empty
-	} else {
-		empty = p.TaskListStatus.BacklogCountHint == 0
+	pollers := make([]string, 0, len(p.Pollers))
+	for _, poller := range p.Pollers {
+		pollers = append(pollers, poller.GetIdentity())
 	}
 	return &partitionMetrics{
 		qps:      p.TaskListStatus.NewTasksPerSecond,
-		empty:    empty,
+		empty:    a.isPartitionEmpty(p.TaskListStatus),
 		readOnly: !hasWritePartition,
+		backlog:  p.TaskListStatus.BacklogCountHint,
+		pollers:  pollers,
+	}
+}
+
+func (a *adaptiveScalerImpl) isPartitionEmpty(status *types.TaskListStatus) bool {
+	if a.config.EnablePartitionEmptyCheck() {
+		return status.Empty
 	}
+	return status.BacklogCountHint == 0
 }
 
 func getTaskListType(taskListType int) *types.TaskListType {
@@ -24,6 +24,8 @@ package tasklist
 
 import (
 	"context"
+	"fmt"
+	"math"
 	"testing"
 	"time"
 
@@ -39,6 +41,7 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/testlogger"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/stats"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/matching/config"
@@ -51,6 +54,7 @@ type mockAdaptiveScalerDeps struct {
 	mockQPSTracker     *stats.MockQPSTracker
 	mockTimeSource     clock.MockedTimeSource
 	mockMatchingClient *matching.MockClient
+	mockTaskManager    *persistence.MockTaskManager
 	dynamicClient      dynamicconfig.Client
 
 	config *config.TaskListConfig
@@ -66,6 +70,7 @@ func setupMocksForAdaptiveScaler(t *testing.T, taskListID *Identifier) (*adaptiv
 	mockQPSTracker := stats.NewMockQPSTracker(ctrl)
 	mockTimeSource := clock.NewMockedTimeSourceAt(time.Now())
 	mockMatchingClient := matching.NewMockClient(ctrl)
+	mockTaskManager := persistence.NewMockTaskManager(ctrl)
 	dynamicClient := dynamicconfig.NewInMemoryClient()
 	cfg := newTaskListConfig(taskListID, config.NewConfig(dynamicconfig.NewCollection(dynamicClient, logger), "test-host", func() []string { return nil }), "test-domain")
 
@@ -75,11 +80,12 @@ func setupMocksForAdaptiveScaler(t *testing.T, taskListID *Identifier) (*adaptiv
 		mockQPSTracker:     mockQPSTracker,
 		mockTimeSource:     mockTimeSource,
 		mockMatchingClient: mockMatchingClient,
+		mockTaskManager:    mockTaskManager,
 		dynamicClient:      dynamicClient,
 		config:             cfg,
 	}
 
-	scaler := NewAdaptiveScaler(taskListID, mockManager, cfg, mockTimeSource, logger, scope, mockMatchingClient, event.E{}).(*adaptiveScalerImpl)
+	scaler := NewAdaptiveScaler(taskListID, mockManager, cfg, mockTimeSource, logger, scope, mockMatchingClient, mockTaskManager, "test-domain", event.E{}).(*adaptiveScalerImpl)
 	return scaler, deps
 }
 
@@ -593,6 +599,209 @@ func TestAdaptiveScalerRun(t *testing.T) {
 			},
 			cycles: 3,
 		},
+		{
+			name: "understaffed backlog sustained",
+			mockSetup: func(deps *mockAdaptiveScalerDeps) {
+				require.NoError(t, deps.dynamicClient.UpdateValue(dynamicproperties.MatchingEnableAdaptiveScalerBacklogScaling, true))
+				mockOldestTask(deps, 0, deps.mockTimeSource.Now()).Times(3)
+				// the oldest task was just created
+				mockDescribeTaskList(deps, 0, withPartitionsBacklogAndPollers(1, 100, 0))
+				deps.mockManager.EXPECT().TaskListPartitionConfig().Return(nil)
+
+				// the oldest task is older than the threshold, sustain period starts
+				mockDescribeTaskList(deps, 0, withPartitionsBacklogAndPollers(1, 100, 0))
+				deps.mockManager.EXPECT().TaskListPartitionConfig().Return(nil)
+
+				// sustain period passed, a read partition is added
+				mockDescribeTaskList(deps, 0, withPartitionsBacklogAndPollers(1, 100, 0))
+				deps.mockManager.EXPECT().TaskListPartitionConfig().Return(nil)
+				deps.mockManager.EXPECT().UpdateTaskListPartitionConfig(gomock.Any(), &types.TaskListPartitionConfig{
+					ReadPartitions:  partitions(2),
+					WritePartitions: partitions(1),
+				}).Return(nil)
+			},
+			cycles: 3,
+		},
+		{
+			name: "backlog with enough pollers",
+			mockSetup: func(deps *mockAdaptiveScalerDeps) {
+				require.NoError(t, deps.dynamicClient.UpdateValue(dynamicproperties.MatchingEnableAdaptiveScalerBacklogScaling, true))
+				mockOldestTask(deps, 0, deps.mockTimeSource.Now().Add(-time.Hour)).Times(3)
+				for i := 0; i < 3; i++ {
+					mockDescribeTaskList(deps, 0, withPartitionsBacklogAndPollers(1, 100, 10))
+					deps.mockManager.EXPECT().TaskListPartitionConfig().Return(nil)
+				}
+			},
+			cycles: 3,
+		},
+		{
+			name: "understaffed backlog at max partitions",
+			mockSetup: func(deps *mockAdaptiveScalerDeps) {
+				require.NoError(t, deps.dynamicClient.UpdateValue(dynamicproperties.MatchingEnableAdaptiveScalerBacklogScaling, true))
+				require.NoError(t, deps.dynamicClient.UpdateValue(dynamicproperties.MatchingPartitionBacklogUpscaleMaxPartitions, 1))
+				mockOldestTask(deps, 0, deps.mockTimeSource.Now().Add(-time.Hour)).Times(3)
+				for i := 0; i < 3; i++ {
+					mockDescribeTaskList(deps, 0, withPartitionsBacklogAndPollers(1, 100, 0))
+					deps.mockManager.EXPECT().TaskListPartitionConfig().Return(nil)
+				}
+			},
+			cycles: 3,
+		},
+		{
+			name: "understaffed backlog with pollers shared by partitions",
+			mockSetup: func(deps *mockAdaptiveScalerDeps) {
+				require.NoError(t, deps.dynamicClient.UpdateValue(dynamicproperties.MatchingEnableAdaptiveScalerBacklogScaling, true))
+				// the same 3 pollers poll from both partitions, so the ratio is 3/200 rather than 6/200
+				require.NoError(t, deps.dynamicClient.UpdateValue(dynamicproperties.MatchingPartitionUpscaleBacklogPollerRatio, 0.02))
+				mockOldestTask(deps, 0, deps.mockTimeSource.Now().Add(-time.Hour)).Times(2)
+				mockOldestTask(deps, 1, deps.mockTimeSource.Now().Add(-time.Minute)).Times(2)
+				for i := 0; i < 2; i++ {
+					mockDescribeTaskList(deps, 0, withPartitionsBacklogAndPollers(2, 100, 3))
+					mockDescribeTaskList(deps, 1, withPartitionsBacklogAndPollers(2, 100, 3))
+					deps.mockManager.EXPECT().TaskListPartitionConfig().Return(&types.TaskListPartitionConfig{
+						WritePartitions: partitions(2),
+						ReadPartitions:  partitions(2),
+					})
+				}
+				deps.mockManager.EXPECT().UpdateTaskListPartitionConfig(gomock.Any(), &types.TaskListPartitionConfig{
+					ReadPartitions:  partitions(3),
+					WritePartitions: partitions(2),
+				}).Return(nil)
+			},
+			cycles: 2,
+		},
+		{
+			name: "backlog briefly drained",
+			mockSetup: func(deps *mockAdaptiveScalerDeps) {
+				require.NoError(t, deps.dynamicClient.UpdateValue(dynamicproperties.MatchingEnableAdaptiveScalerBacklogScaling, true))
+				// the oldest task is older than the threshold on the second cycle, which starts the sustain period
+				mockOldestTask(deps, 0, deps.mockTimeSource.Now()).Times(2)
+				for i := 0; i < 2; i++ {
+					mockDescribeTaskList(deps, 0, withPartitionsBacklogAndPollers(1, 100, 0))
+					deps.mockManager.EXPECT().TaskListPartitionConfig().Return(nil)
+				}
+				// drained, which resets the sustain period
+				mockDescribeTaskList(deps, 0, withPartitionsBacklogAndPollers(1, 0, 0))
+				deps.mockManager.EXPECT().TaskListPartitionConfig().Return(nil)
+				// new tasks are backlogged, the sustain period starts over on the second cycle
+				mockOldestTask(deps, 0, deps.mockTimeSource.Now().Add(3*time.Second)).Times(2)
+				for i := 0; i < 2; i++ {
+					mockDescribeTaskList(deps, 0, withPartitionsBacklogAndPollers(1, 100, 0))
+					deps.mockManager.EXPECT().TaskListPartitionConfig().Return(nil)
+				}
+			},
+			cycles: 5,
+		},
+		{
+			name: "backlog with unknown oldest task",
+			mockSetup: func(deps *mockAdaptiveScalerDeps) {
+				require.NoError(t, deps.dynamicClient.UpdateValue(dynamicproperties.MatchingEnableAdaptiveScalerBacklogScaling, true))
+				deps.mockTaskManager.EXPECT().GetTasks(gomock.Any(), gomock.Any()).Return(nil, assert.AnError).Times(3)
+				for i := 0; i < 3; i++ {
+					mockDescribeTaskList(deps, 0, withPartitionsBacklogAndPollers(1, 100, 0))
+					deps.mockManager.EXPECT().TaskListPartitionConfig().Return(nil)
+				}
+			},
+			cycles: 3,
+		},
+		{
+			name: "read partitions kept while backlog is understaffed",
+			mockSetup: func(deps *mockAdaptiveScalerDeps) {
+				require.NoError(t, deps.dynamicClient.UpdateValue(dynamicproperties.MatchingEnableAdaptiveScalerBacklogScaling, true))
+				require.NoError(t, deps.dynamicClient.UpdateValue(dynamicproperties.MatchingPartitionBacklogUpscaleMaxPartitions, 2))
+				mockOldestTask(deps, 0, deps.mockTimeSource.Now().Add(-time.Hour)).Times(2)
+				// partition 1 is read only and empty, but it is not removed while the backlog is understaffed
+				for i := 0; i < 2; i++ {
+					mockDescribeTaskList(deps, 0, withPartitionsBacklogAndPollers(1, 100, 0))
+					mockDescribeTaskList(deps, 1, withPartitionsAndBacklog(2, 1, 0))
+					deps.mockManager.EXPECT().TaskListPartitionConfig().Return(&types.TaskListPartitionConfig{
+						WritePartitions: partitions(1),
+						ReadPartitions:  partitions(2),
+					})
+				}
+				// the backlog is drained, so partition 1 is removed
+				mockDescribeTaskList(deps, 0, withPartitionsBacklogAndPollers(1, 0, 0))
+				mockDescribeTaskList(deps, 1, withPartitionsAndBacklog(2, 1, 0))
+				deps.mockManager.EXPECT().TaskListPartitionConfig().Return(&types.TaskListPartitionConfig{
+					WritePartitions: partitions(1),
+					ReadPartitions:  partitions(2),
+				})
+				deps.mockManager.EXPECT().UpdateTaskListPartitionConfig(gomock.Any(), &types.TaskListPartitionConfig{
+					ReadPartitions:  partitions(1),
+					WritePartitions: partitions(1),
+				}).Return(nil)
+			},
+			cycles: 3,
+		},
+		{
+			name: "underload sustained with understaffed backlog",
+			mockSetup: func(deps *mockAdaptiveScalerDeps) {
+				require.NoError(t, deps.dynamicClient.UpdateValue(dynamicproperties.MatchingEnableAdaptiveScalerBacklogScaling, true))
+				require.NoError(t, deps.dynamicClient.UpdateValue(dynamicproperties.MatchingPartitionBacklogUpscaleMaxPartitions, 2))
+				mockOldestTask(deps, 0, deps.mockTimeSource.Now().Add(-time.Hour)).Times(2)
+				mockOldestTask(deps, 1, deps.mockTimeSource.Now().Add(-time.Hour)).Times(2)
+				for i := 0; i < 2; i++ {
+					mockDescribeTaskList(deps, 0, withPartitionsBacklogAndPollers(2, 100, 0))
+					mockDescribeTaskList(deps, 1, withPartitionsBacklogAndPollers(2, 100, 0))
+					deps.mockManager.EXPECT().TaskListPartitionConfig().Return(&types.TaskListPartitionConfig{
+						WritePartitions: partitions(2),
+						ReadPartitions:  partitions(2),
+					})
+				}
+			},
+			cycles: 2,
+		},
+		{
+			name: "underload sustained with staffed backlog",
+			mockSetup: func(deps *mockAdaptiveScalerDeps) {
+				require.NoError(t, deps.dynamicClient.UpdateValue(dynamicproperties.MatchingEnableAdaptiveScalerBacklogScaling, true))
+				mockOldestTask(deps, 0, deps.mockTimeSource.Now().Add(-time.Hour)).Times(2)
+				mockOldestTask(deps, 1, deps.mockTimeSource.Now().Add(-time.Hour)).Times(2)
+				for i := 0; i < 2; i++ {
+					mockDescribeTaskList(deps, 0, withPartitionsBacklogAndPollers(2, 100, 10))
+					mockDescribeTaskList(deps, 1, withPartitionsBacklogAndPollers(2, 100, 10))
+					deps.mockManager.EXPECT().TaskListPartitionConfig().Return(&types.TaskListPartitionConfig{
+						WritePartitions: partitions(2),
+						ReadPartitions:  partitions(2),
+					})
+				}
+				deps.mockManager.EXPECT().UpdateTaskListPartitionConfig(gomock.Any(), &types.TaskListPartitionConfig{
+					ReadPartitions:  partitions(2),
+					WritePartitions: partitions(1),
+				}).Return(nil)
+			},
+			cycles: 2,
+		},
+		{
+			name: "underload sustained after understaffed backlog drained",
+			mockSetup: func(deps *mockAdaptiveScalerDeps) {
+				require.NoError(t, deps.dynamicClient.UpdateValue(dynamicproperties.MatchingEnableAdaptiveScalerBacklogScaling, true))
+				require.NoError(t, deps.dynamicClient.UpdateValue(dynamicproperties.MatchingPartitionBacklogUpscaleMaxPartitions, 2))
+				mockOldestTask(deps, 0, deps.mockTimeSource.Now().Add(-time.Hour)).Times(2)
+				mockOldestTask(deps, 1, deps.mockTimeSource.Now().Add(-time.Hour)).Times(2)
+				for i := 0; i < 2; i++ {
+					mockDescribeTaskList(deps, 0, withPartitionsBacklogAndPollers(2, 100, 0))
+					mockDescribeTaskList(deps, 1, withPartitionsBacklogAndPollers(2, 100, 0))
+					deps.mockManager.EXPECT().TaskListPartitionConfig().Return(&types.TaskListPartitionConfig{
+						WritePartitions: partitions(2),
+						ReadPartitions:  partitions(2),
+					})
+				}
+
+				// the underload was tracked while downscaling was held, so partitions are removed right away
+				mockDescribeTaskList(deps, 0, withPartitionsBacklogAndPollers(2, 0, 0))
+				mockDescribeTaskList(deps, 1, withPartitionsBacklogAndPollers(2, 0, 0))
+				deps.mockManager.EXPECT().TaskListPartitionConfig().Return(&types.TaskListPartitionConfig{
+					WritePartitions: partitions(2),
+					ReadPartitions:  partitions(2),
+				})
+				deps.mockManager.EXPECT().UpdateTaskListPartitionConfig(gomock.Any(), &types.TaskListPartitionConfig{
+					ReadPartitions:  partitions(2),
+					WritePartitions: partitions(1),
+				}).Return(nil)
+			},
+			cycles: 3,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -611,6 +820,9 @@ func TestAdaptiveScalerRun(t *testing.T) {
 			require.NoError(t, deps.dynamicClient.UpdateValue(dynamicproperties.MatchingIsolationGroupDownscaleSustainedDuration, time.Second))
 			require.NoError(t, deps.dynamicClient.UpdateValue(dynamicproperties.MatchingIsolationGroupHasPollersSustainedDuration, time.Second))
 			require.NoError(t, deps.dynamicClient.UpdateValue(dynamicproperties.MatchingIsolationGroupNoPollersSustainedDuration, time.Second))
+			require.NoError(t, deps.dynamicClient.UpdateValue(dynamicproperties.MatchingPartitionUpscaleBacklogAge, 500*time.Millisecond))
+			require.NoError(t, deps.dynamicClient.UpdateValue(dynamicproperties.MatchingPartitionUpscaleBacklogPollerRatio, 0.01))
+			require.NoError(t, deps.dynamicClient.UpdateValue(dynamicproperties.MatchingPartitionBacklogUpscaleMaxPartitions, 10))
 			tc.mockSetup(deps)
 
 			for i := 0; i < tc.cycles; i++ {
@@ -669,6 +881,21 @@ func withPartitionsAndBacklog(numRead, numWrite int, backlog int64) *types.Descr
 	}
 }
 
+func withPartitionsBacklogAndPollers(numPartitions int, backlog int64, numPollers int) *types.DescribeTaskListResponse {
+	pollers := make([]*types.PollerInfo, 0, numPollers)
+	for i := 0; i < numPollers; i++ {
+		pollers = append(pollers, &types.PollerInfo{Identity: fmt.Sprintf("poller-%d", i)})
+	}
+	return &types.DescribeTaskListResponse{
+		Pollers:        pollers,
+		TaskListStatus: &types.TaskListStatus{NewTasksPerSecond: 0, BacklogCountHint: backlog},
+		PartitionConfig: &types.TaskListPartitionConfig{
+			ReadPartitions:  partitions(numPartitions),
+			WritePartitions: partitions(numPartitions),
+		},
+	}
+}
+
 func mockDescribeTaskList(mocks *mockAdaptiveScalerDeps, partitionID int, resp *types.DescribeTaskListResponse) {
 	if partitionID == 0 {
 		mocks.mockManager.EXPECT().DescribeTaskList(true).Return(resp)
@@ -687,6 +914,19 @@ func mockDescribeTaskList(mocks *mockAdaptiveScalerDeps, partitionID int, resp *
 	}
 }
 
+func mockOldestTask(mocks *mockAdaptiveScalerDeps, partitionID int, createdTime time.Time) *gomock.Call {
+	maxReadLevel := int64(math.MaxInt64)
+	return mocks.mockTaskManager.EXPECT().GetTasks(gomock.Any(), &persistence.GetTasksRequest{
+		DomainID:     mocks.id.domainID,
+		TaskList:     mocks.id.GetPartition(partitionID),
+		TaskType:     mocks.id.GetType(),
+		BatchSize:    1,
+		ReadLevel:    0,
+		MaxReadLevel: &maxReadLevel,
+		DomainName:   "test-domain",
+	}).Return(&persistence.GetTasksResponse{Tasks: []*persistence.TaskInfo{{CreatedTime: createdTime}}}, nil)
+}
+
 func mockDescribeTaskListWithErr(mocks *mockAdaptiveScalerDeps, partitionID int, err error) {
 	mocks.mockMatchingClient.EXPECT().DescribeTaskList(gomock.Any(), &types.MatchingDescribeTaskListRequest{
 		DomainUUID: mocks.id.domainID,
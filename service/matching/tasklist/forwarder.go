@@ -24,6 +24,7 @@ import (
 	"context"
 	"errors"
 	"sync/atomic"
+	"time"
 
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common/metrics"
@@ -129,6 +130,7 @@ func (fwdr *forwarderImpl) ForwardTask(ctx context.Context, task *InternalTask)
 
 	var err error
 
+	partitionConfig := withTaskHop(task.Event.PartitionConfig, newTaskHop(task, fwdr.taskListID.Partition(), time.Now()))
 	sw := fwdr.scope.StartTimer(metrics.ForwardTaskLatencyPerTaskList)
 	defer sw.Stop()
 	switch fwdr.taskListID.GetType() {
@@ -144,7 +146,7 @@ func (fwdr *forwarderImpl) ForwardTask(ctx context.Context, task *InternalTask)
 			ScheduleToStartTimeoutSeconds: &task.Event.ScheduleToStartTimeoutSeconds,
 			Source:                        &task.source,
 			ForwardedFrom:                 fwdr.taskListID.GetName(),
-			PartitionConfig:               task.Event.PartitionConfig,
+			PartitionConfig:               partitionConfig,
 		})
 	case persistence.TaskListTypeActivity:
 		_, err = fwdr.client.AddActivityTask(ctx, &types.AddActivityTaskRequest{
@@ -159,7 +161,7 @@ func (fwdr *forwarderImpl) ForwardTask(ctx context.Context, task *InternalTask)
 			ScheduleToStartTimeoutSeconds: &task.Event.ScheduleToStartTimeoutSeconds,
 			Source:                        &task.source,
 			ForwardedFrom:                 fwdr.taskListID.GetName(),
-			PartitionConfig:               task.Event.PartitionConfig,
+			PartitionConfig:               partitionConfig,
 		})
 	default:
 		return ErrInvalidTaskListType
@@ -225,7 +227,8 @@ func (fwdr *forwarderImpl) ForwardPoll(ctx context.Context) (*InternalTask, erro
 					Name: name,
 					Kind: &fwdr.taskListKind,
 				},
-				Identity: identity,
+				Identity:       identity,
+				BinaryChecksum: BuildIDFromContext(ctx),
 			},
 			ForwardedFrom:  fwdr.taskListID.GetName(),
 			IsolationGroup: isolationGroup,
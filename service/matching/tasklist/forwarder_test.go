@@ -75,7 +75,7 @@ func (t *ForwarderTestSuite) TearDownTest() {
 }
 
 func (t *ForwarderTestSuite) TestForwardTaskError() {
-	task := newInternalTask(&persistence.TaskInfo{}, nil, types.TaskSourceHistory, "", false, nil, "")
+	task := newInternalTask(&persistence.TaskInfo{}, nil, types.TaskSourceHistory, "", false, nil, "", "")
 	t.Equal(ErrNoParent, t.fwdr.ForwardTask(context.Background(), task))
 
 	t.usingTasklistPartition(persistence.TaskListTypeActivity)
@@ -94,7 +94,7 @@ func (t *ForwarderTestSuite) TestForwardDecisionTask() {
 	).Return(&types.AddDecisionTaskResponse{}, nil).Times(1)
 
 	taskInfo := t.newTaskInfo()
-	task := newInternalTask(taskInfo, nil, types.TaskSourceHistory, "", false, nil, "")
+	task := newInternalTask(taskInfo, nil, types.TaskSourceHistory, "", false, nil, "", "")
 	t.NoError(t.fwdr.ForwardTask(context.Background(), task))
 	t.NotNil(request)
 	t.Equal(t.taskList.Parent(20), request.TaskList.GetName())
@@ -118,7 +118,7 @@ func (t *ForwarderTestSuite) TestForwardActivityTask() {
 	).Return(&types.AddActivityTaskResponse{}, nil).Times(1)
 
 	taskInfo := t.newTaskInfo()
-	task := newInternalTask(taskInfo, nil, types.TaskSourceHistory, "", false, nil, "")
+	task := newInternalTask(taskInfo, nil, types.TaskSourceHistory, "", false, nil, "", "")
 	t.NoError(t.fwdr.ForwardTask(context.Background(), task))
 	t.NotNil(request)
 	t.Equal(t.taskList.Parent(20), request.TaskList.GetName())
@@ -130,6 +130,10 @@ func (t *ForwarderTestSuite) TestForwardActivityTask() {
 	t.Equal(taskInfo.ScheduleID, request.GetScheduleID())
 	t.Equal(taskInfo.ScheduleToStartTimeoutSeconds, request.GetScheduleToStartTimeoutSeconds())
 	t.Equal(t.taskList.name, request.GetForwardedFrom())
+	hops := parseHopTrace(request.PartitionConfig[hopTraceKey])
+	t.Len(hops, 1)
+	t.Equal(t.taskList.Partition(), hops[0].partition)
+	t.False(hops[0].backlog)
 }
 
 func (t *ForwarderTestSuite) TestForwardTaskRateExceeded() {
@@ -138,7 +142,7 @@ func (t *ForwarderTestSuite) TestForwardTaskRateExceeded() {
 	rps := 2
 	t.client.EXPECT().AddActivityTask(gomock.Any(), gomock.Any()).Return(&types.AddActivityTaskResponse{}, nil).Times(rps)
 	taskInfo := t.newTaskInfo()
-	task := newInternalTask(taskInfo, nil, types.TaskSourceHistory, "", false, nil, "")
+	task := newInternalTask(taskInfo, nil, types.TaskSourceHistory, "", false, nil, "", "")
 	for i := 0; i < rps; i++ {
 		t.NoError(t.fwdr.ForwardTask(context.Background(), task))
 	}
@@ -0,0 +1,125 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package tasklist
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// hopTraceKey is the partition config key carrying the task list partitions a task was forwarded from
+	hopTraceKey = "matching-hops"
+
+	hopSourceSync    = "sync"
+	hopSourceBacklog = "backlog"
+)
+
+// taskHop describes how long a task waited in a task list partition before being forwarded to its parent or matched.
+// A hop trace is encoded as comma separated "<partition>:<s|b>:<wait in milliseconds>" hops, oldest first.
+type taskHop struct {
+	partition int
+	backlog   bool
+	wait      time.Duration
+}
+
+func (h taskHop) source() string {
+	if h.backlog {
+		return hopSourceBacklog
+	}
+	return hopSourceSync
+}
+
+func (h taskHop) String() string {
+	source := "s"
+	if h.backlog {
+		source = "b"
+	}
+	return fmt.Sprintf("%d:%s:%d", h.partition, source, h.wait.Milliseconds())
+}
+
+func parseTaskHop(s string) (taskHop, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 3 || (parts[1] != "s" && parts[1] != "b") {
+		return taskHop{}, fmt.Errorf("malformed task hop %q", s)
+	}
+	partition, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return taskHop{}, fmt.Errorf("malformed task hop %q: %w", s, err)
+	}
+	waitMillis, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return taskHop{}, fmt.Errorf("malformed task hop %q: %w", s, err)
+	}
+	return taskHop{partition: partition, backlog: parts[1] == "b", wait: time.Duration(waitMillis) * time.Millisecond}, nil
+}
+
+// parseHopTrace returns the hops of the given hop trace, the hops that can't be parsed are skipped
+func parseHopTrace(trace string) []taskHop {
+	if trace == "" {
+		return nil
+	}
+	var hops []taskHop
+	for _, s := range strings.Split(trace, ",") {
+		hop, err := parseTaskHop(s)
+		if err != nil {
+			continue
+		}
+		hops = append(hops, hop)
+	}
+	return hops
+}
+
+// formatHopTrace returns the hop trace of the given hops
+func formatHopTrace(hops []taskHop) string {
+	parts := make([]string, 0, len(hops))
+	for _, hop := range hops {
+		parts = append(parts, hop.String())
+	}
+	return strings.Join(parts, ",")
+}
+
+// withTaskHop returns a copy of the partition config with the given hop appended to its hop trace
+func withTaskHop(partitionConfig map[string]string, hop taskHop) map[string]string {
+	result := make(map[string]string, len(partitionConfig)+1)
+	for k, v := range partitionConfig {
+		result[k] = v
+	}
+	result[hopTraceKey] = formatHopTrace(append(parseHopTrace(result[hopTraceKey]), hop))
+	return result
+}
+
+// newTaskHop returns the hop of the task in the given partition. Tasks read from the backlog of the partition
+// they were created in have waited since they were created, other tasks since they reached the partition.
+func newTaskHop(task *InternalTask, partition int, now time.Time) taskHop {
+	backlog := task.source == types.TaskSourceDbBacklog
+	since := task.receivedTime
+	if backlog && !task.IsForwarded() {
+		since = task.Event.CreatedTime
+	}
+	return taskHop{partition: partition, backlog: backlog, wait: max(now.Sub(since), 0)}
+}
@@ -0,0 +1,70 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package tasklist
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestHopTrace(t *testing.T) {
+	partitionConfig := map[string]string{"isolation-group": "zone-a"}
+
+	withOneHop := withTaskHop(partitionConfig, taskHop{partition: 3, backlog: true, wait: 1500 * time.Millisecond})
+	assert.Equal(t, "3:b:1500", withOneHop[hopTraceKey])
+	withTwoHops := withTaskHop(withOneHop, taskHop{partition: 1, wait: 20 * time.Millisecond})
+	assert.Equal(t, "3:b:1500,1:s:20", withTwoHops[hopTraceKey])
+	assert.Equal(t, "zone-a", withTwoHops["isolation-group"])
+	assert.Equal(t, map[string]string{"isolation-group": "zone-a"}, partitionConfig)
+
+	assert.Equal(t, []taskHop{
+		{partition: 3, backlog: true, wait: 1500 * time.Millisecond},
+		{partition: 1, wait: 20 * time.Millisecond},
+	}, parseHopTrace(withTwoHops[hopTraceKey]))
+	assert.Nil(t, parseHopTrace(""))
+	assert.Equal(t, []taskHop{{partition: 2, wait: time.Millisecond}}, parseHopTrace("x:s:1,2:s:1,2:q:1,2:s"))
+}
+
+func TestNewTaskHop(t *testing.T) {
+	now := time.Now()
+	info := &persistence.TaskInfo{CreatedTime: now.Add(-time.Minute)}
+
+	backlogTask := newInternalTask(info, nil, types.TaskSourceDbBacklog, "", false, nil, "", "")
+	hop := newTaskHop(backlogTask, 2, now)
+	assert.Equal(t, taskHop{partition: 2, backlog: true, wait: time.Minute}, hop)
+
+	forwardedBacklogTask := newInternalTask(info, nil, types.TaskSourceDbBacklog, "/__cadence_sys/tl/2", true, nil, "", "")
+	forwardedBacklogTask.receivedTime = now.Add(-time.Second)
+	hop = newTaskHop(forwardedBacklogTask, 1, now)
+	assert.Equal(t, taskHop{partition: 1, backlog: true, wait: time.Second}, hop)
+
+	syncTask := newInternalTask(info, nil, types.TaskSourceHistory, "", true, nil, "", "")
+	syncTask.receivedTime = now.Add(time.Second)
+	hop = newTaskHop(syncTask, 0, now)
+	assert.Equal(t, taskHop{partition: 0}, hop)
+}
@@ -50,6 +50,10 @@ type taskMatcherImpl struct {
 	// synchronos task channels to match producer/consumer for a certain isolation group
 	// the key is the name of the isolation group
 	isolatedTaskC map[string]chan *InternalTask
+	// synchronous task channels to match producer/consumer for a certain worker build set
+	// the key is the name of the build set, channels only exist while a poller or a task of the build set is using them
+	versionedTaskC     map[string]*versionedTaskChannel
+	versionedTaskCLock sync.Mutex
 	// synchronous task channel to match query task - the reason to have
 	// separate channel for this is because there are cases when consumers
 	// are interested in queryTasks but not others. Example is when domain is
@@ -57,6 +61,8 @@ type taskMatcherImpl struct {
 	queryTaskC chan *InternalTask
 	// ratelimiter that limits the rate at which tasks can be dispatched to consumers
 	limiter quotas.Limiter
+	// ratelimiter that limits the rate at which the tasks of individual activity types can be dispatched
+	activityTypeLimiter *activityTypeLimiter
 
 	fwdr   Forwarder
 	scope  metrics.Scope // domain metric scope
@@ -72,6 +78,11 @@ type taskMatcherImpl struct {
 	numReadPartitionsFn func(*config.TaskListConfig) int
 }
 
+type versionedTaskChannel struct {
+	c    chan *InternalTask
+	refs int // number of pollers and tasks using the channel
+}
+
 // ErrTasklistThrottled implies a tasklist was throttled
 var ErrTasklistThrottled = errors.New("tasklist limit exceeded")
 
@@ -87,6 +98,7 @@ func newTaskMatcher(
 	tasklist *Identifier,
 	tasklistKind types.TaskListKind,
 	limiter quotas.Limiter,
+	activityTypeLimiter *activityTypeLimiter,
 ) TaskMatcher {
 	isolatedTaskC := make(map[string]chan *InternalTask)
 	for _, g := range isolationGroups {
@@ -96,18 +108,20 @@ func newTaskMatcher(
 	cancelCtx, cancelFunc := context.WithCancel(context.Background())
 
 	matcher := &taskMatcherImpl{
-		log:           log,
-		scope:         scope,
-		fwdr:          fwdr,
-		taskC:         make(chan *InternalTask),
-		isolatedTaskC: isolatedTaskC,
-		queryTaskC:    make(chan *InternalTask),
-		config:        config,
-		tasklist:      tasklist,
-		tasklistKind:  tasklistKind,
-		limiter:       limiter,
-		cancelCtx:     cancelCtx,
-		cancelFunc:    cancelFunc,
+		log:                 log,
+		scope:               scope,
+		fwdr:                fwdr,
+		taskC:               make(chan *InternalTask),
+		isolatedTaskC:       isolatedTaskC,
+		versionedTaskC:      make(map[string]*versionedTaskChannel),
+		queryTaskC:          make(chan *InternalTask),
+		config:              config,
+		tasklist:            tasklist,
+		tasklistKind:        tasklistKind,
+		limiter:             limiter,
+		activityTypeLimiter: activityTypeLimiter,
+		cancelCtx:           cancelCtx,
+		cancelFunc:          cancelFunc,
 	}
 
 	return matcher
@@ -150,7 +164,7 @@ func (tm *taskMatcherImpl) DisconnectBlockedPollers() {
 func (tm *taskMatcherImpl) Offer(ctx context.Context, task *InternalTask) (bool, error) {
 	startT := time.Now()
 	if !task.IsForwarded() {
-		err := tm.ratelimit(ctx)
+		err := tm.ratelimit(ctx, task)
 		if err != nil {
 			tm.scope.IncCounter(metrics.SyncThrottlePerTaskListCounter)
 			return false, err
@@ -161,11 +175,13 @@ func (tm *taskMatcherImpl) Offer(ctx context.Context, task *InternalTask) (bool,
 		TaskListType: tm.tasklist.GetType(),
 		TaskListKind: tm.tasklistKind.Ptr(),
 	}
+	taskC, release := tm.getTaskC(task)
+	defer release()
 	localWaitTime := tm.config.LocalTaskWaitTime()
 	if localWaitTime > 0 {
 		childCtx, cancel := context.WithTimeout(ctx, localWaitTime)
 		select {
-		case tm.getTaskC(task) <- task: // poller picked up the task
+		case taskC <- task: // poller picked up the task
 			cancel()
 			if task.ResponseC != nil {
 				// if there is a response channel, block until resp is received
@@ -187,7 +203,7 @@ func (tm *taskMatcherImpl) Offer(ctx context.Context, task *InternalTask) (bool,
 		}
 	}
 	select {
-	case tm.getTaskC(task) <- task: // poller picked up the task
+	case taskC <- task: // poller picked up the task
 		if task.ResponseC != nil {
 			// if there is a response channel, block until resp is received
 			// and return error if the response contains error
@@ -229,8 +245,10 @@ func (tm *taskMatcherImpl) Offer(ctx context.Context, task *InternalTask) (bool,
 
 // OfferOrTimeout offers a task to a poller and blocks until a poller picks up the task or context timeouts
 func (tm *taskMatcherImpl) OfferOrTimeout(ctx context.Context, startT time.Time, task *InternalTask) (bool, error) {
+	taskC, release := tm.getTaskC(task)
+	defer release()
 	select {
-	case tm.getTaskC(task) <- task: // poller picked up the task
+	case taskC <- task: // poller picked up the task
 		if task.ResponseC != nil {
 			select {
 			case err := <-task.ResponseC:
@@ -292,7 +310,7 @@ func (tm *taskMatcherImpl) MustOffer(ctx context.Context, task *InternalTask) er
 		TaskListKind: tm.tasklistKind.Ptr(),
 		TaskInfo:     task.Info(),
 	}
-	if err := tm.ratelimit(ctx); err != nil {
+	if err := tm.ratelimit(ctx, task); err != nil {
 		e.EventName = "Throttled While Dispatching"
 		event.Log(e)
 		return fmt.Errorf("rate limit error dispatching: %w", err)
@@ -301,7 +319,8 @@ func (tm *taskMatcherImpl) MustOffer(ctx context.Context, task *InternalTask) er
 	startT := time.Now()
 	// attempt a match with local poller first. When that
 	// doesn't succeed, try both local match and remote match
-	taskC := tm.getTaskC(task)
+	taskC, release := tm.getTaskC(task)
+	defer release()
 	localWaitTime := tm.config.LocalTaskWaitTime()
 	childCtx, cancel := context.WithTimeout(ctx, localWaitTime)
 	select {
@@ -407,6 +426,8 @@ func (tm *taskMatcherImpl) Poll(ctx context.Context, isolationGroup string) (*In
 		isolatedTaskC = tm.taskC
 		tm.scope.IncCounter(metrics.PollerInvalidIsolationGroupCounter)
 	}
+	versionedTaskC, release := tm.getPollerVersionedTaskC(ctx)
+	defer release()
 
 	// we want cancellation of taskMatcher to be treated as cancellation of client context
 	// original context (ctx) won't be affected
@@ -425,7 +446,7 @@ func (tm *taskMatcherImpl) Poll(ctx context.Context, isolationGroup string) (*In
 	}()
 
 	// try local match first without blocking until context timeout
-	if task, err = tm.pollNonBlocking(ctxWithCancelPropagation, isolatedTaskC, tm.taskC, tm.queryTaskC); err == nil {
+	if task, err = tm.pollNonBlocking(ctxWithCancelPropagation, isolatedTaskC, versionedTaskC, tm.taskC, tm.queryTaskC); err == nil {
 		tm.scope.RecordTimer(metrics.PollLocalMatchLatencyPerTaskList, time.Since(startT))
 		return task, nil
 	}
@@ -435,6 +456,7 @@ func (tm *taskMatcherImpl) Poll(ctx context.Context, isolationGroup string) (*In
 	tm.log.Debug("falling back to non-local polling",
 		tag.IsolationGroup(isolationGroup),
 		tag.Dynamic("isolated channel", len(isolatedTaskC)),
+		tag.Dynamic("versioned channel", len(versionedTaskC)),
 		tag.Dynamic("fallback channel", len(tm.taskC)),
 	)
 	event.Log(event.E{
@@ -443,7 +465,7 @@ func (tm *taskMatcherImpl) Poll(ctx context.Context, isolationGroup string) (*In
 		TaskListKind: tm.tasklistKind.Ptr(),
 		EventName:    "Matcher Falling Back to Non-Local Polling",
 	})
-	task, err = tm.pollOrForward(ctxWithCancelPropagation, startT, isolationGroup, isolatedTaskC, tm.taskC, tm.queryTaskC)
+	task, err = tm.pollOrForward(ctxWithCancelPropagation, startT, isolationGroup, isolatedTaskC, versionedTaskC, tm.taskC, tm.queryTaskC)
 	return task, err
 }
 
@@ -453,7 +475,7 @@ func (tm *taskMatcherImpl) Poll(ctx context.Context, isolationGroup string) (*In
 func (tm *taskMatcherImpl) PollForQuery(ctx context.Context) (*InternalTask, error) {
 	startT := time.Now()
 	// try local match first without blocking until context timeout
-	if task, err := tm.pollNonBlocking(ctx, nil, nil, tm.queryTaskC); err == nil {
+	if task, err := tm.pollNonBlocking(ctx, nil, nil, nil, tm.queryTaskC); err == nil {
 		tm.scope.RecordTimer(metrics.PollLocalMatchLatencyPerTaskList, time.Since(startT))
 		return task, nil
 	}
@@ -464,7 +486,7 @@ func (tm *taskMatcherImpl) PollForQuery(ctx context.Context) (*InternalTask, err
 	// there is no local poller available to pickup this task. Now block waiting
 	// either for a local poller or a forwarding token to be available. When a
 	// forwarding token becomes available, send this poll to a parent partition
-	return tm.pollOrForward(ctxWithCancelPropagation, startT, "", nil, nil, tm.queryTaskC)
+	return tm.pollOrForward(ctxWithCancelPropagation, startT, "", nil, nil, nil, tm.queryTaskC)
 }
 
 func (tm *taskMatcherImpl) RefreshCancelContext() {
@@ -478,6 +500,7 @@ func (tm *taskMatcherImpl) pollOrForward(
 	startT time.Time,
 	isolationGroup string,
 	isolatedTaskC <-chan *InternalTask,
+	versionedTaskC <-chan *InternalTask,
 	taskC <-chan *InternalTask,
 	queryTaskC <-chan *InternalTask,
 ) (*InternalTask, error) {
@@ -502,6 +525,28 @@ func (tm *taskMatcherImpl) pollOrForward(
 			},
 		})
 		return task, nil
+	case task := <-versionedTaskC:
+		if task.ResponseC != nil {
+			tm.scope.IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
+		}
+		tm.scope.RecordTimer(metrics.PollLocalMatchLatencyPerTaskList, time.Since(startT))
+		tm.scope.IncCounter(metrics.PollSuccessPerTaskListCounter)
+		event.Log(event.E{
+			TaskListName: tm.tasklist.GetName(),
+			TaskListType: tm.tasklist.GetType(),
+			TaskListKind: tm.tasklistKind.Ptr(),
+			TaskInfo:     task.Info(),
+			EventName:    "Matched Task (pollOrForward)",
+			Payload: map[string]any{
+				"TaskIsForwarded":    task.IsForwarded(),
+				"SyncMatched":        task.ResponseC != nil,
+				"FromIsolatedTaskC":  false,
+				"FromVersionedTaskC": true,
+				"IsolationGroup":     task.isolationGroup,
+				"BuildSet":           task.buildSet,
+			},
+		})
+		return task, nil
 	case task := <-taskC:
 		if task.ResponseC != nil {
 			tm.scope.IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
@@ -557,7 +602,7 @@ func (tm *taskMatcherImpl) pollOrForward(
 			return task, nil
 		}
 		token.release()
-		return tm.poll(ctx, startT, isolatedTaskC, taskC, queryTaskC)
+		return tm.poll(ctx, startT, isolatedTaskC, versionedTaskC, taskC, queryTaskC)
 	}
 }
 
@@ -565,6 +610,7 @@ func (tm *taskMatcherImpl) poll(
 	ctx context.Context,
 	startT time.Time,
 	isolatedTaskC <-chan *InternalTask,
+	versionedTaskC <-chan *InternalTask,
 	taskC <-chan *InternalTask,
 	queryTaskC <-chan *InternalTask,
 ) (*InternalTask, error) {
@@ -589,6 +635,28 @@ func (tm *taskMatcherImpl) poll(
 			},
 		})
 		return task, nil
+	case task := <-versionedTaskC:
+		if task.ResponseC != nil {
+			tm.scope.IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
+		}
+		tm.scope.RecordTimer(metrics.PollLocalMatchAfterForwardFailedLatencyPerTaskList, time.Since(startT))
+		tm.scope.IncCounter(metrics.PollSuccessPerTaskListCounter)
+		event.Log(event.E{
+			TaskListName: tm.tasklist.GetName(),
+			TaskListType: tm.tasklist.GetType(),
+			TaskListKind: tm.tasklistKind.Ptr(),
+			TaskInfo:     task.Info(),
+			EventName:    "Matched Task (poll)",
+			Payload: map[string]any{
+				"TaskIsForwarded":    task.IsForwarded(),
+				"SyncMatched":        task.ResponseC != nil,
+				"FromIsolatedTaskC":  false,
+				"FromVersionedTaskC": true,
+				"IsolationGroup":     task.isolationGroup,
+				"BuildSet":           task.buildSet,
+			},
+		})
+		return task, nil
 	case task := <-taskC:
 		if task.ResponseC != nil {
 			tm.scope.IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
@@ -628,6 +696,7 @@ func (tm *taskMatcherImpl) poll(
 func (tm *taskMatcherImpl) pollLocalWait(
 	ctx context.Context,
 	isolatedTaskC <-chan *InternalTask,
+	versionedTaskC <-chan *InternalTask,
 	taskC <-chan *InternalTask,
 	queryTaskC <-chan *InternalTask,
 ) (*InternalTask, error) {
@@ -651,6 +720,27 @@ func (tm *taskMatcherImpl) pollLocalWait(
 			},
 		})
 		return task, nil
+	case task := <-versionedTaskC:
+		if task.ResponseC != nil {
+			tm.scope.IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
+		}
+		tm.scope.IncCounter(metrics.PollSuccessPerTaskListCounter)
+		event.Log(event.E{
+			TaskListName: tm.tasklist.GetName(),
+			TaskListType: tm.tasklist.GetType(),
+			TaskListKind: tm.tasklistKind.Ptr(),
+			TaskInfo:     task.Info(),
+			EventName:    "Matched Task Nonblocking",
+			Payload: map[string]any{
+				"TaskIsForwarded":    task.IsForwarded(),
+				"SyncMatched":        task.ResponseC != nil,
+				"FromIsolatedTaskC":  false,
+				"FromVersionedTaskC": true,
+				"IsolationGroup":     task.isolationGroup,
+				"BuildSet":           task.buildSet,
+			},
+		})
+		return task, nil
 	case task := <-taskC:
 		if task.ResponseC != nil {
 			tm.scope.IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
@@ -688,6 +778,7 @@ func (tm *taskMatcherImpl) pollLocalWait(
 func (tm *taskMatcherImpl) pollNonBlocking(
 	ctx context.Context,
 	isolatedTaskC <-chan *InternalTask,
+	versionedTaskC <-chan *InternalTask,
 	taskC <-chan *InternalTask,
 	queryTaskC <-chan *InternalTask,
 ) (*InternalTask, error) {
@@ -695,7 +786,7 @@ func (tm *taskMatcherImpl) pollNonBlocking(
 	if waitTime > 0 {
 		childCtx, cancel := context.WithTimeout(ctx, waitTime)
 		defer cancel()
-		return tm.pollLocalWait(childCtx, isolatedTaskC, taskC, queryTaskC)
+		return tm.pollLocalWait(childCtx, isolatedTaskC, versionedTaskC, taskC, queryTaskC)
 	}
 	select {
 	case task := <-isolatedTaskC:
@@ -717,6 +808,27 @@ func (tm *taskMatcherImpl) pollNonBlocking(
 			},
 		})
 		return task, nil
+	case task := <-versionedTaskC:
+		if task.ResponseC != nil {
+			tm.scope.IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
+		}
+		tm.scope.IncCounter(metrics.PollSuccessPerTaskListCounter)
+		event.Log(event.E{
+			TaskListName: tm.tasklist.GetName(),
+			TaskListType: tm.tasklist.GetType(),
+			TaskListKind: tm.tasklistKind.Ptr(),
+			TaskInfo:     task.Info(),
+			EventName:    "Matched Task Nonblocking",
+			Payload: map[string]any{
+				"TaskIsForwarded":    task.IsForwarded(),
+				"SyncMatched":        task.ResponseC != nil,
+				"FromIsolatedTaskC":  false,
+				"FromVersionedTaskC": true,
+				"IsolationGroup":     task.isolationGroup,
+				"BuildSet":           task.buildSet,
+			},
+		})
+		return task, nil
 	case task := <-taskC:
 		if task.ResponseC != nil {
 			tm.scope.IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
@@ -765,8 +877,21 @@ func (tm *taskMatcherImpl) fwdrAddReqTokenC() <-chan *ForwarderReqToken {
 	return tm.fwdr.AddReqTokenC()
 }
 
-func (tm *taskMatcherImpl) ratelimit(ctx context.Context) error {
+func (tm *taskMatcherImpl) ratelimit(ctx context.Context, task *InternalTask) error {
+	// the activity type is checked first and without blocking, so that a throttled activity type neither consumes
+	// the tokens of the task list nor holds back the tasks of other activity types
+	var reservation clock.Reservation
+	if tm.activityTypeLimiter != nil {
+		var err error
+		reservation, err = tm.activityTypeLimiter.Reserve(task)
+		if err != nil {
+			return err
+		}
+	}
 	err := tm.limiter.Wait(ctx)
+	if reservation != nil {
+		reservation.Used(err == nil)
+	}
 	if errors.Is(err, clock.ErrCannotWait) {
 		// "err != ctx.Err()" may also be correct, as that would mean "gave up due to context".
 		//
@@ -782,10 +907,47 @@ func (tm *taskMatcherImpl) isForwardingAllowed() bool {
 	return tm.fwdr != nil
 }
 
-func (tm *taskMatcherImpl) getTaskC(task *InternalTask) chan<- *InternalTask {
+// getTaskC returns the channel the task should be offered to and a function that must be called once the caller
+// stops offering the task to it. A task pinned to a build set is only offered to pollers of that build set, whatever
+// its isolation group is: running the task on a compatible build takes precedence over keeping it in its isolation group.
+func (tm *taskMatcherImpl) getTaskC(task *InternalTask) (chan<- *InternalTask, func()) {
+	if task.buildSet != "" && tm.config.EnableWorkerVersioning() {
+		return tm.acquireVersionedTaskC(task.buildSet)
+	}
 	taskC := tm.taskC
 	if isolatedTaskC, ok := tm.isolatedTaskC[task.isolationGroup]; ok && task.isolationGroup != "" {
 		taskC = isolatedTaskC
 	}
-	return taskC
+	return taskC, func() {}
+}
+
+// getPollerVersionedTaskC returns the channel of the build set the poller's build belongs to, or nil if tasks
+// aren't routed by build to the poller, and a function that must be called once the poller stops polling
+func (tm *taskMatcherImpl) getPollerVersionedTaskC(ctx context.Context) (<-chan *InternalTask, func()) {
+	buildSet := buildSetFromContext(ctx, tm.config, tm.tasklistKind)
+	if buildSet == "" {
+		return nil, func() {}
+	}
+	return tm.acquireVersionedTaskC(buildSet)
+}
+
+// acquireVersionedTaskC returns the channel of the build set, creating it if needed. Build sets come from the builds
+// reported by pollers, so the channel is removed as soon as the last poller or task using it releases it.
+func (tm *taskMatcherImpl) acquireVersionedTaskC(buildSet string) (chan *InternalTask, func()) {
+	tm.versionedTaskCLock.Lock()
+	defer tm.versionedTaskCLock.Unlock()
+	versionedTaskC, ok := tm.versionedTaskC[buildSet]
+	if !ok {
+		versionedTaskC = &versionedTaskChannel{c: make(chan *InternalTask)}
+		tm.versionedTaskC[buildSet] = versionedTaskC
+	}
+	versionedTaskC.refs++
+	return versionedTaskC.c, func() {
+		tm.versionedTaskCLock.Lock()
+		defer tm.versionedTaskCLock.Unlock()
+		versionedTaskC.refs--
+		if versionedTaskC.refs == 0 {
+			delete(tm.versionedTaskC, buildSet)
+		}
+	}
 }
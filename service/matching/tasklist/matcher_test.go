@@ -48,6 +48,7 @@ import (
 	"github.com/uber/cadence/common/metrics/mocks"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/workerversioning"
 	"github.com/uber/cadence/service/matching/config"
 )
 
@@ -86,11 +87,11 @@ func (t *MatcherTestSuite) SetupTest() {
 	t.cfg = tlCfg
 	t.isolationGroups = []string{"dca1", "dca2"}
 	t.fwdr = newForwarder(&t.cfg.ForwarderConfig, t.taskList, types.TaskListKindNormal, t.client, metrics.NoopScope)
-	t.matcher = newTaskMatcher(tlCfg, t.fwdr, metrics.NoopScope, []string{"dca1", "dca2"}, log.NewNoop(), t.taskList, types.TaskListKindNormal, clock.NewRatelimiter(rate.Limit(100), 100)).(*taskMatcherImpl)
+	t.matcher = newTaskMatcher(tlCfg, t.fwdr, metrics.NoopScope, []string{"dca1", "dca2"}, log.NewNoop(), t.taskList, types.TaskListKindNormal, clock.NewRatelimiter(rate.Limit(100), 100), newActivityTypeLimiter(tlCfg, func() int { return 1 })).(*taskMatcherImpl)
 
 	rootTaskList := NewTestTaskListID(t.T(), t.taskList.GetDomainID(), t.taskList.Parent(20), persistence.TaskListTypeDecision)
 	rootTasklistCfg := newTaskListConfig(rootTaskList, cfg, testDomainName)
-	t.rootMatcher = newTaskMatcher(rootTasklistCfg, nil, metrics.NoopScope, []string{"dca1", "dca2"}, log.NewNoop(), t.taskList, types.TaskListKindNormal, clock.NewRatelimiter(rate.Limit(100), 100)).(*taskMatcherImpl)
+	t.rootMatcher = newTaskMatcher(rootTasklistCfg, nil, metrics.NoopScope, []string{"dca1", "dca2"}, log.NewNoop(), t.taskList, types.TaskListKindNormal, clock.NewRatelimiter(rate.Limit(100), 100), newActivityTypeLimiter(rootTasklistCfg, func() int { return 1 })).(*taskMatcherImpl)
 }
 
 func (t *MatcherTestSuite) TearDownTest() {
@@ -107,7 +108,7 @@ func (t *MatcherTestSuite) TestLocalSyncMatch() {
 		}
 	})
 
-	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, "")
+	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, "", "")
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	syncMatch, err := t.matcher.Offer(ctx, task)
 	cancel()
@@ -128,7 +129,7 @@ func (t *MatcherTestSuite) TestIsolationLocalSyncMatch() {
 		}
 	})
 
-	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, "dca1")
+	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, "dca1", "")
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	syncMatch, err := t.matcher.Offer(ctx, task)
 	cancel()
@@ -185,7 +186,7 @@ func (t *MatcherTestSuite) testRemoteSyncMatch(taskSource types.TaskSource, isol
 		},
 	).AnyTimes()
 
-	task := newInternalTask(t.newTaskInfo(), nil, taskSource, "", true, nil, isolationGroup)
+	task := newInternalTask(t.newTaskInfo(), nil, taskSource, "", true, nil, isolationGroup, "")
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 
 	var err error
@@ -219,7 +220,7 @@ func (t *MatcherTestSuite) testRemoteSyncMatch(taskSource types.TaskSource, isol
 }
 
 func (t *MatcherTestSuite) TestSyncMatchFailure() {
-	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, "")
+	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, "", "")
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 
 	var req *types.AddDecisionTaskRequest
@@ -243,7 +244,7 @@ func (t *MatcherTestSuite) TestRateLimitHandling() {
 	t.matcher.scope = &scope
 	for i := 0; i < 5; i++ {
 		t.client.EXPECT().AddDecisionTask(gomock.Any(), gomock.Any()).Return(&types.AddDecisionTaskResponse{}, nil).AnyTimes()
-		task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, "")
+		task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, "", "")
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 		_, err := t.matcher.Offer(ctx, task)
 		cancel()
@@ -262,7 +263,7 @@ func (t *MatcherTestSuite) TestIsolationSyncMatchFailure() {
 			task.Finish(nil)
 		}
 	})
-	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, "dca1")
+	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, "dca1", "")
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	syncMatch, err := t.matcher.Offer(ctx, task)
 	cancel()
@@ -271,6 +272,87 @@ func (t *MatcherTestSuite) TestIsolationSyncMatchFailure() {
 	t.False(syncMatch)
 }
 
+func (t *MatcherTestSuite) TestVersionedLocalSyncMatch() {
+	t.disableRemoteForwarding()
+	t.enableWorkerVersioning(map[string]interface{}{"build-a": "set-1", "build-b": "set-1"})
+
+	wait := ensureAsyncReady(time.Second, func(ctx context.Context) {
+		task, err := t.matcher.Poll(ContextWithBuildID(ctx, "build-b"), "")
+		if err == nil {
+			task.Finish(nil)
+		}
+	})
+
+	task := newInternalTask(t.newVersionedTaskInfo("set-1"), nil, types.TaskSourceHistory, "", true, nil, "", "set-1")
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	syncMatch, err := t.matcher.Offer(ctx, task)
+	cancel()
+	wait()
+	t.NoError(err)
+	t.True(syncMatch)
+	t.Empty(t.matcher.versionedTaskC, "channels of build sets nobody is using should be removed")
+}
+
+func (t *MatcherTestSuite) TestVersionedSyncMatchOverridesIsolation() {
+	t.disableRemoteForwarding()
+	t.enableWorkerVersioning(map[string]interface{}{"build-a": "set-1"})
+
+	wait := ensureAsyncReady(time.Second, func(ctx context.Context) {
+		task, err := t.matcher.Poll(ContextWithBuildID(ctx, "build-a"), "dca2")
+		if err == nil {
+			task.Finish(nil)
+		}
+	})
+
+	task := newInternalTask(t.newVersionedTaskInfo("set-1"), nil, types.TaskSourceHistory, "", true, nil, "dca1", "set-1")
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	syncMatch, err := t.matcher.Offer(ctx, task)
+	cancel()
+	wait()
+	t.NoError(err)
+	t.True(syncMatch)
+}
+
+func (t *MatcherTestSuite) TestVersionedSyncMatchFailure() {
+	t.disableRemoteForwarding()
+	t.enableWorkerVersioning(map[string]interface{}{"build-a": "set-1"})
+
+	wait := ensureAsyncReady(time.Second, func(ctx context.Context) {
+		task, err := t.matcher.Poll(ContextWithBuildID(ctx, "build-c"), "")
+		if err == nil {
+			task.Finish(nil)
+		}
+	})
+
+	task := newInternalTask(t.newVersionedTaskInfo("set-1"), nil, types.TaskSourceHistory, "", true, nil, "", "set-1")
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	syncMatch, err := t.matcher.Offer(ctx, task)
+	cancel()
+	wait()
+	t.NoError(err)
+	t.False(syncMatch)
+	t.Empty(t.matcher.versionedTaskC, "channels of build sets nobody is using should be removed")
+}
+
+func (t *MatcherTestSuite) TestVersionedSyncMatchWorkerVersioningDisabled() {
+	t.disableRemoteForwarding()
+
+	wait := ensureAsyncReady(time.Second, func(ctx context.Context) {
+		task, err := t.matcher.Poll(ctx, "")
+		if err == nil {
+			task.Finish(nil)
+		}
+	})
+
+	task := newInternalTask(t.newVersionedTaskInfo("set-1"), nil, types.TaskSourceHistory, "", true, nil, "", "set-1")
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	syncMatch, err := t.matcher.Offer(ctx, task)
+	cancel()
+	wait()
+	t.NoError(err)
+	t.True(syncMatch)
+}
+
 func (t *MatcherTestSuite) TestQueryLocalSyncMatch() {
 	t.disableRemoteForwarding()
 
@@ -383,7 +465,7 @@ func (t *MatcherTestSuite) TestMustOfferLocalMatch() {
 		}
 	})
 
-	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", false, nil, "")
+	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", false, nil, "", "")
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	err := t.matcher.MustOffer(ctx, task)
 	cancel()
@@ -402,7 +484,7 @@ func (t *MatcherTestSuite) TestIsolationMustOfferLocalMatch() {
 		}
 	})
 
-	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", false, nil, "dca1")
+	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", false, nil, "dca1", "")
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	err := t.matcher.MustOffer(ctx, task)
 	cancel()
@@ -436,7 +518,7 @@ func (t *MatcherTestSuite) TestMustOfferRemoteMatch() {
 		taskCompleted = true
 	}
 
-	task := newInternalTask(t.newTaskInfo(), completionFunc, types.TaskSourceDbBacklog, "", false, nil, "")
+	task := newInternalTask(t.newTaskInfo(), completionFunc, types.TaskSourceDbBacklog, "", false, nil, "", "")
 	ctx, cancel := context.WithTimeout(context.Background(), 4*time.Second)
 
 	var err error
@@ -446,7 +528,7 @@ func (t *MatcherTestSuite) TestMustOfferRemoteMatch() {
 	t.client.EXPECT().AddDecisionTask(gomock.Any(), gomock.Any()).Do(
 		func(arg0 context.Context, arg1 *types.AddDecisionTaskRequest, option ...yarpc.CallOption) {
 			req = arg1
-			task := newInternalTask(task.Event.TaskInfo, nil, types.TaskSourceDbBacklog, req.GetForwardedFrom(), true, nil, "")
+			task := newInternalTask(task.Event.TaskInfo, nil, types.TaskSourceDbBacklog, req.GetForwardedFrom(), true, nil, "", "")
 			close(pollSigC)
 			remoteSyncMatch, err = t.rootMatcher.Offer(ctx, task)
 		},
@@ -487,7 +569,7 @@ func (t *MatcherTestSuite) TestMustOfferRemoteRateLimit() {
 	for i := 0; i < 5; i++ {
 		scope.On("IncCounter", metrics.AsyncMatchForwardPollCounterPerTaskList)
 		t.client.EXPECT().AddDecisionTask(gomock.Any(), gomock.Any()).Return(&types.AddDecisionTaskResponse{}, nil)
-		task := newInternalTask(t.newTaskInfo(), completionFunc, types.TaskSourceDbBacklog, "", false, nil, "")
+		task := newInternalTask(t.newTaskInfo(), completionFunc, types.TaskSourceDbBacklog, "", false, nil, "", "")
 		ctx, cancel := context.WithTimeout(context.Background(), 4*time.Second)
 		t.NoError(t.matcher.MustOffer(ctx, task))
 		cancel()
@@ -520,7 +602,7 @@ func (t *MatcherTestSuite) TestIsolationMustOfferRemoteMatch() {
 		taskCompleted = true
 	}
 
-	task := newInternalTask(t.newTaskInfo(), completionFunc, types.TaskSourceDbBacklog, "", false, nil, "dca1")
+	task := newInternalTask(t.newTaskInfo(), completionFunc, types.TaskSourceDbBacklog, "", false, nil, "dca1", "")
 	ctx, cancel := context.WithTimeout(context.Background(), 4*time.Second)
 
 	var err error
@@ -530,7 +612,7 @@ func (t *MatcherTestSuite) TestIsolationMustOfferRemoteMatch() {
 	t.client.EXPECT().AddDecisionTask(gomock.Any(), gomock.Any()).Do(
 		func(arg0 context.Context, arg1 *types.AddDecisionTaskRequest, option ...yarpc.CallOption) {
 			req = arg1
-			task := newInternalTask(task.Event.TaskInfo, nil, types.TaskSourceDbBacklog, req.GetForwardedFrom(), true, nil, "dca1")
+			task := newInternalTask(task.Event.TaskInfo, nil, types.TaskSourceDbBacklog, req.GetForwardedFrom(), true, nil, "dca1", "")
 			close(pollSigC)
 			remoteSyncMatch, err = t.rootMatcher.Offer(arg0, task)
 		},
@@ -654,7 +736,7 @@ func (t *MatcherTestSuite) TestIsolationPollFailure() {
 
 func (t *MatcherTestSuite) TestOffer_RateLimited() {
 	t.matcher.limiter = clock.NewRatelimiter(0, 0)
-	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", false, nil, "")
+	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", false, nil, "", "")
 
 	ctx := context.Background()
 
@@ -678,7 +760,7 @@ func (t *MatcherTestSuite) TestOffer_NoTimeoutSyncMatchedNoError() {
 
 	t.disableRemoteForwarding()
 
-	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, "")
+	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, "", "")
 
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	syncMatched, err := t.matcher.Offer(ctx, task)
@@ -703,7 +785,7 @@ func (t *MatcherTestSuite) TestOffer_NoTimeoutSyncMatchedError() {
 
 	t.disableRemoteForwarding()
 
-	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, "")
+	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, "", "")
 	task.ResponseC <- errShutdown
 
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
@@ -729,7 +811,7 @@ func (t *MatcherTestSuite) TestOffer_NoTimeoutAsyncMatchedNoError() {
 
 	t.disableRemoteForwarding()
 
-	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", false, nil, "")
+	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", false, nil, "", "")
 
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	syncMatched, err := t.matcher.Offer(ctx, task)
@@ -752,7 +834,7 @@ func (t *MatcherTestSuite) TestOffer_AsyncMatchedNoError() {
 
 	t.disableRemoteForwarding()
 
-	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", false, nil, "")
+	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", false, nil, "", "")
 
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	syncMatch, err := t.matcher.Offer(ctx, task)
@@ -768,7 +850,7 @@ func (t *MatcherTestSuite) TestOfferOrTimeout_SyncMatchTimedOut() {
 
 	t.disableRemoteForwarding()
 
-	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, "")
+	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, "", "")
 
 	wait := ensureAsyncReady(time.Second, func(ctx context.Context) {
 		time.Sleep(time.Millisecond * 100)
@@ -799,7 +881,7 @@ func (t *MatcherTestSuite) TestOfferOrTimeout_AsyncMatchNotMatched() {
 		}
 	})
 
-	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", false, nil, "")
+	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", false, nil, "", "")
 
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	matched, err := t.matcher.OfferOrTimeout(ctx, time.Now(), task)
@@ -822,7 +904,7 @@ func (t *MatcherTestSuite) TestOfferOrTimeout_AsyncMatchMatched() {
 		}
 	})
 
-	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", false, &types.ActivityTaskDispatchInfo{}, "")
+	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", false, &types.ActivityTaskDispatchInfo{}, "", "")
 
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	matched, err := t.matcher.OfferOrTimeout(ctx, time.Now(), task)
@@ -836,7 +918,7 @@ func (t *MatcherTestSuite) TestOfferOrTimeout_AsyncMatchMatched() {
 func (t *MatcherTestSuite) TestOfferOrTimeout_TimedOut() {
 	t.disableRemoteForwarding()
 
-	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", false, &types.ActivityTaskDispatchInfo{}, "")
+	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", false, &types.ActivityTaskDispatchInfo{}, "", "")
 
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	cancel()
@@ -892,7 +974,7 @@ func (t *MatcherTestSuite) TestMustOffer_ContextExpiredFirstAttempt() {
 	ctx, cancel := context.WithTimeout(context.Background(), t.matcher.config.LocalTaskWaitTime())
 	defer cancel()
 
-	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", false, nil, "")
+	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", false, nil, "", "")
 
 	err := t.matcher.MustOffer(ctx, task)
 
@@ -908,7 +990,7 @@ func (t *MatcherTestSuite) TestMustOffer_ContextExpiredAfterFirstAttempt() {
 
 	mockForwarder.EXPECT().AddReqTokenC().Times(1)
 
-	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", false, nil, "")
+	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", false, nil, "", "")
 
 	err := t.matcher.MustOffer(ctx, task)
 
@@ -925,7 +1007,7 @@ func (t *MatcherTestSuite) TestMustOffer_LocalMatchAfterChildCtxExpired() {
 
 	mockForwarder.EXPECT().AddReqTokenC().AnyTimes()
 
-	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", false, nil, "")
+	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", false, nil, "", "")
 
 	go func() {
 		// Waits for the child context to expire; by default, it is 10 ms.
@@ -959,7 +1041,7 @@ func (t *MatcherTestSuite) TestMustOffer_LocalMatchAfterForwardError() {
 		return c
 	}
 
-	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", false, nil, "")
+	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", false, nil, "", "")
 
 	mockForwarder.EXPECT().AddReqTokenC().Return(fn()).Times(1)
 	mockForwarder.EXPECT().ForwardTask(gomock.Any(), task).Return(ErrNoParent).Times(1)
@@ -991,7 +1073,7 @@ func (t *MatcherTestSuite) TestMustOffer_ContextExpiredAfterForwardError() {
 	c := make(chan *ForwarderReqToken, 1)
 	c <- forwardToken
 
-	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", false, nil, "")
+	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", false, nil, "", "")
 
 	mockForwarder.EXPECT().AddReqTokenC().Return(c).Times(1)
 	mockForwarder.EXPECT().ForwardTask(gomock.Any(), task).Return(ErrNoParent).Times(1)
@@ -1024,9 +1106,9 @@ func (t *MatcherTestSuite) Test_pollOrForward_PollIsolatedTask() {
 	mockForwarder.EXPECT().PollReqTokenC().Return(mockTokenC).AnyTimes()
 
 	// Test pollOrForward for isolated task - poll
-	isolatedTask := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, isolationGroup)
+	isolatedTask := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, isolationGroup, "")
 	isolatedTaskC <- isolatedTask
-	retTask, err := t.matcher.pollOrForward(ctx, startT, isolationGroup, isolatedTaskC, nil, nil)
+	retTask, err := t.matcher.pollOrForward(ctx, startT, isolationGroup, isolatedTaskC, nil, nil, nil)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(isolatedTask, retTask)
@@ -1047,9 +1129,9 @@ func (t *MatcherTestSuite) Test_pollOrForward_PollTask() {
 	mockForwarder.EXPECT().PollReqTokenC().Return(mockTokenC).AnyTimes()
 
 	// Test pollOrForward for regular task - poll
-	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, "")
+	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, "", "")
 	taskC <- task
-	retTask, err := t.matcher.pollOrForward(ctx, startT, "", nil, taskC, nil)
+	retTask, err := t.matcher.pollOrForward(ctx, startT, "", nil, nil, taskC, nil)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(task, retTask)
@@ -1069,7 +1151,7 @@ func (t *MatcherTestSuite) Test_pollOrForward_PollQueryTask() {
 	// Test pollOrForward for query task - poll
 	queryTask := newInternalQueryTask(uuid.New(), &types.MatchingQueryWorkflowRequest{})
 	queryTaskC <- queryTask
-	retTask, err := t.matcher.pollOrForward(ctx, startT, "", nil, nil, queryTaskC)
+	retTask, err := t.matcher.pollOrForward(ctx, startT, "", nil, nil, nil, queryTaskC)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(queryTask, retTask)
@@ -1091,10 +1173,10 @@ func (t *MatcherTestSuite) Test_pollOrForward_ForwardTask() {
 	mockTokenC <- forwardToken
 	mockForwarder.EXPECT().PollReqTokenC().Return(mockTokenC).AnyTimes()
 
-	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, "")
+	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, "", "")
 	mockForwarder.EXPECT().ForwardPoll(ctx).Return(task, nil).Times(1)
 
-	retTask, err := t.matcher.pollOrForward(ctx, startT, isolationGroup, nil, nil, nil)
+	retTask, err := t.matcher.pollOrForward(ctx, startT, isolationGroup, nil, nil, nil, nil)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(task, retTask)
@@ -1117,7 +1199,7 @@ func (t *MatcherTestSuite) Test_pollOrForward_ForwardTaskThenPoll() {
 	mockTokenC <- forwardToken
 	mockForwarder.EXPECT().PollReqTokenC().Return(mockTokenC).AnyTimes()
 
-	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, "")
+	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, "", "")
 	mockForwarder.EXPECT().ForwardPoll(ctx).Return(nil, ErrNoParent).Times(1)
 
 	// Add the task after the forwarderReqToken is released
@@ -1129,7 +1211,7 @@ func (t *MatcherTestSuite) Test_pollOrForward_ForwardTaskThenPoll() {
 		}
 	}()
 
-	retTask, err := t.matcher.pollOrForward(ctx, startT, isolationGroup, nil, taskC, nil)
+	retTask, err := t.matcher.pollOrForward(ctx, startT, isolationGroup, nil, nil, taskC, nil)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(task, retTask)
@@ -1141,22 +1223,35 @@ func (t *MatcherTestSuite) Test_poll_IsolatedTask() {
 	isolationGroup := "dca1"
 	isolatedTaskC := make(chan *InternalTask, 1)
 
-	isolatedTask := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, isolationGroup)
+	isolatedTask := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, isolationGroup, "")
 	isolatedTaskC <- isolatedTask
-	retTask, err := t.matcher.poll(ctx, startT, isolatedTaskC, nil, nil)
+	retTask, err := t.matcher.poll(ctx, startT, isolatedTaskC, nil, nil, nil)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(isolatedTask, retTask)
 }
 
+func (t *MatcherTestSuite) Test_poll_VersionedTask() {
+	ctx := context.Background()
+	startT := time.Now()
+	versionedTaskC := make(chan *InternalTask, 1)
+
+	versionedTask := newInternalTask(t.newVersionedTaskInfo("set-1"), nil, types.TaskSourceHistory, "", true, nil, "", "set-1")
+	versionedTaskC <- versionedTask
+	retTask, err := t.matcher.poll(ctx, startT, nil, versionedTaskC, nil, nil)
+	t.NoError(err)
+	t.NotNil(retTask)
+	t.Equal(versionedTask, retTask)
+}
+
 func (t *MatcherTestSuite) Test_poll_Task() {
 	ctx := context.Background()
 	startT := time.Now()
 	taskC := make(chan *InternalTask, 1)
 
-	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, "")
+	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, "", "")
 	taskC <- task
-	retTask, err := t.matcher.poll(ctx, startT, nil, taskC, nil)
+	retTask, err := t.matcher.poll(ctx, startT, nil, nil, taskC, nil)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(task, retTask)
@@ -1169,7 +1264,7 @@ func (t *MatcherTestSuite) Test_poll_QueryTask() {
 
 	queryTask := newInternalQueryTask(uuid.New(), &types.MatchingQueryWorkflowRequest{})
 	queryTaskC <- queryTask
-	retTask, err := t.matcher.poll(ctx, startT, nil, nil, queryTaskC)
+	retTask, err := t.matcher.poll(ctx, startT, nil, nil, nil, queryTaskC)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(queryTask, retTask)
@@ -1181,7 +1276,7 @@ func (t *MatcherTestSuite) Test_poll_ContextExpired() {
 
 	startT := time.Now()
 
-	retTask, err := t.matcher.poll(ctx, startT, nil, nil, nil)
+	retTask, err := t.matcher.poll(ctx, startT, nil, nil, nil, nil)
 
 	t.ErrorIs(err, ErrNoTasks)
 	t.Nil(retTask)
@@ -1194,23 +1289,37 @@ func (t *MatcherTestSuite) Test_pollNonBlocking_IsolatedTask() {
 	isolationGroup := "dca1"
 	isolatedTaskC := make(chan *InternalTask, 1)
 
-	isolatedTask := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, isolationGroup)
+	isolatedTask := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, isolationGroup, "")
 	isolatedTaskC <- isolatedTask
-	retTask, err := t.matcher.pollNonBlocking(ctx, isolatedTaskC, nil, nil)
+	retTask, err := t.matcher.pollNonBlocking(ctx, isolatedTaskC, nil, nil, nil)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(isolatedTask, retTask)
 }
 
+func (t *MatcherTestSuite) Test_pollNonBlocking_VersionedTask() {
+	t.matcher.config.LocalPollWaitTime = func() time.Duration { return 0 }
+
+	ctx := context.Background()
+	versionedTaskC := make(chan *InternalTask, 1)
+
+	versionedTask := newInternalTask(t.newVersionedTaskInfo("set-1"), nil, types.TaskSourceHistory, "", true, nil, "", "set-1")
+	versionedTaskC <- versionedTask
+	retTask, err := t.matcher.pollNonBlocking(ctx, nil, versionedTaskC, nil, nil)
+	t.NoError(err)
+	t.NotNil(retTask)
+	t.Equal(versionedTask, retTask)
+}
+
 func (t *MatcherTestSuite) Test_pollNonBlocking_Task() {
 	t.matcher.config.LocalPollWaitTime = func() time.Duration { return 0 }
 
 	ctx := context.Background()
 	taskC := make(chan *InternalTask, 1)
 
-	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, "")
+	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, "", "")
 	taskC <- task
-	retTask, err := t.matcher.pollNonBlocking(ctx, nil, taskC, nil)
+	retTask, err := t.matcher.pollNonBlocking(ctx, nil, nil, taskC, nil)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(task, retTask)
@@ -1224,7 +1333,7 @@ func (t *MatcherTestSuite) Test_pollNonBlocking_QueryTask() {
 
 	queryTask := newInternalQueryTask(uuid.New(), &types.MatchingQueryWorkflowRequest{})
 	queryTaskC <- queryTask
-	retTask, err := t.matcher.pollNonBlocking(ctx, nil, nil, queryTaskC)
+	retTask, err := t.matcher.pollNonBlocking(ctx, nil, nil, nil, queryTaskC)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(queryTask, retTask)
@@ -1235,7 +1344,7 @@ func (t *MatcherTestSuite) Test_pollNonBlocking_NoTasks() {
 
 	ctx := context.Background()
 
-	retTask, err := t.matcher.pollNonBlocking(ctx, nil, nil, nil)
+	retTask, err := t.matcher.pollNonBlocking(ctx, nil, nil, nil, nil)
 
 	t.ErrorIs(err, ErrNoTasks)
 	t.Nil(retTask)
@@ -1270,6 +1379,17 @@ func (t *MatcherTestSuite) newTaskInfo() *persistence.TaskInfo {
 	}
 }
 
+func (t *MatcherTestSuite) newVersionedTaskInfo(buildSet string) *persistence.TaskInfo {
+	info := t.newTaskInfo()
+	info.PartitionConfig = map[string]string{workerversioning.BuildSetKey: buildSet}
+	return info
+}
+
+func (t *MatcherTestSuite) enableWorkerVersioning(buildSets map[string]interface{}) {
+	t.matcher.config.EnableWorkerVersioning = func() bool { return true }
+	t.matcher.config.WorkerVersioningBuildSets = func() map[string]interface{} { return buildSets }
+}
+
 func TestRatelimitBehavior(t *testing.T) {
 	// NOT t.Parallel() to avoid noise from cpu-heavy tests
 
@@ -1427,7 +1547,7 @@ func TestRatelimitBehavior(t *testing.T) {
 				perSecond := float64(time.Second / granularity)
 				limiter := clock.NewRatelimiter(rate.Limit(perSecond), int(perSecond))
 				check(t, limiter.Allow, func(ctx context.Context) (*rate.Reservation, error) {
-					return nil, (&taskMatcherImpl{limiter: limiter}).ratelimit(ctx)
+					return nil, (&taskMatcherImpl{limiter: limiter}).ratelimit(ctx, &InternalTask{})
 				})
 			})
 		})
@@ -1530,7 +1650,7 @@ func TestRatelimitBehavior(t *testing.T) {
 				}
 
 				elapsed, err := cancelWhileWaiting(func(ctx context.Context) error {
-					return (&taskMatcherImpl{limiter: limit}).ratelimit(ctx)
+					return (&taskMatcherImpl{limiter: limit}).ratelimit(ctx, &InternalTask{})
 				})
 
 				assert.ErrorIs(t, err, context.Canceled, "gives up and returns context error")
@@ -1623,7 +1743,7 @@ func (t *MatcherTestSuite) Test_LocalSyncMatch_AutoConfigHint() {
 		}
 	})
 
-	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, "")
+	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, "", "")
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	_, err := t.matcher.Offer(ctx, task)
 	cancel()
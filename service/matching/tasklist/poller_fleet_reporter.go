@@ -0,0 +1,160 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package tasklist
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/service/matching/poller"
+)
+
+const pollerFleetReportInterval = 30 * time.Second
+
+type (
+	// pollerFleetReporter periodically reports the recent pollers of a task list grouped by client and isolation
+	// group, so that operators can see which worker fleets are draining the task list and which are idle.
+	// Library versions and build IDs are not reported as they would make the gauges high cardinality.
+	pollerFleetReporter struct {
+		pollers    poller.Manager
+		scope      metrics.Scope
+		timeSource clock.TimeSource
+
+		status int32
+		wg     sync.WaitGroup
+		ctx    context.Context
+		cancel func()
+		// reported is the set of fleets whose gauges were last reported, only accessed by the report loop
+		reported map[pollerFleetKey]struct{}
+	}
+
+	pollerFleetKey struct {
+		clientImpl     string
+		isolationGroup string
+	}
+
+	pollerFleetMetrics struct {
+		pollers            int
+		waitingPollers     int
+		hosts              map[string]struct{}
+		maxConcurrentTasks int
+	}
+)
+
+func newPollerFleetReporter(pollers poller.Manager, scope metrics.Scope, timeSource clock.TimeSource) *pollerFleetReporter {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &pollerFleetReporter{
+		pollers:    pollers,
+		scope:      scope,
+		timeSource: timeSource,
+		ctx:        ctx,
+		cancel:     cancel,
+		reported:   make(map[pollerFleetKey]struct{}),
+	}
+}
+
+func (r *pollerFleetReporter) Start() {
+	if !atomic.CompareAndSwapInt32(&r.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	r.wg.Add(1)
+	go r.runReportLoop()
+}
+
+func (r *pollerFleetReporter) Stop() {
+	if !atomic.CompareAndSwapInt32(&r.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	r.cancel()
+	r.wg.Wait()
+}
+
+func (r *pollerFleetReporter) runReportLoop() {
+	defer r.wg.Done()
+	ticker := r.timeSource.NewTicker(pollerFleetReportInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.ctx.Done():
+			// the task list is unloaded, so its fleets are no longer polling from this host
+			r.clear()
+			return
+		case <-ticker.Chan():
+			r.report()
+		}
+	}
+}
+
+// report updates the gauges of the current fleets and resets the gauges of fleets that stopped polling
+func (r *pollerFleetReporter) report() {
+	fleets := make(map[pollerFleetKey]*pollerFleetMetrics)
+	for _, fleet := range r.pollers.ListFleets(time.Time{}) {
+		key := pollerFleetKey{clientImpl: fleet.ClientImpl, isolationGroup: fleet.IsolationGroup}
+		m, ok := fleets[key]
+		if !ok {
+			m = &pollerFleetMetrics{hosts: make(map[string]struct{})}
+			fleets[key] = m
+		}
+		m.pollers += fleet.Pollers
+		m.waitingPollers += fleet.WaitingPollers
+		m.maxConcurrentTasks += fleet.MaxConcurrentTasks
+		for _, host := range fleet.Hosts {
+			m.hosts[host] = struct{}{}
+		}
+	}
+	for key, m := range fleets {
+		r.updateGauges(key, m)
+	}
+	for key := range r.reported {
+		if _, ok := fleets[key]; !ok {
+			r.updateGauges(key, &pollerFleetMetrics{})
+		}
+	}
+	r.reported = make(map[pollerFleetKey]struct{}, len(fleets))
+	for key := range fleets {
+		r.reported[key] = struct{}{}
+	}
+}
+
+func (r *pollerFleetReporter) clear() {
+	for key := range r.reported {
+		r.updateGauges(key, &pollerFleetMetrics{})
+	}
+	r.reported = make(map[pollerFleetKey]struct{})
+}
+
+func (r *pollerFleetReporter) updateGauges(key pollerFleetKey, m *pollerFleetMetrics) {
+	scope := r.scope.Tagged(
+		metrics.ClientImplTag(key.clientImpl),
+		metrics.PollerIsolationGroupTag(key.isolationGroup),
+	)
+	scope.UpdateGauge(metrics.TaskListPollerFleetPollersGauge, float64(m.pollers))
+	scope.UpdateGauge(metrics.TaskListPollerFleetWaitingPollersGauge, float64(m.waitingPollers))
+	scope.UpdateGauge(metrics.TaskListPollerFleetHostsGauge, float64(len(m.hosts)))
+	scope.UpdateGauge(metrics.TaskListPollerFleetMaxConcurrentTasksGauge, float64(m.maxConcurrentTasks))
+}
@@ -0,0 +1,124 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package tasklist
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally"
+	"go.uber.org/goleak"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/service/matching/poller"
+)
+
+func TestPollerFleetReporterLifecycle(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	timeSource := clock.NewMockedTimeSource()
+	r := newPollerFleetReporter(poller.NewPollerManager(func() {}, timeSource), metrics.NoopScope, timeSource)
+
+	// test idempotency
+	assert.NotPanics(t, r.Start)
+	assert.NotPanics(t, r.Start)
+	assert.NotPanics(t, r.Stop)
+	assert.NotPanics(t, r.Stop)
+}
+
+func TestPollerFleetReporter(t *testing.T) {
+	timeSource := clock.NewMockedTimeSource()
+	pollers := poller.NewPollerManager(func() {}, timeSource)
+	testScope := tally.NewTestScope("", nil)
+	scope := metrics.NewClient(testScope, metrics.Matching, metrics.HistogramMigration{}).Scope(metrics.MatchingTaskListMgrScope)
+	r := newPollerFleetReporter(pollers, scope, timeSource)
+
+	buildA := poller.WorkerInfo{ClientImpl: "uber-go", ClientLibraryVersion: "1.2.3", Host: "host1", BuildID: "build-a", MaxConcurrentTasks: 10}
+	buildB := poller.WorkerInfo{ClientImpl: "uber-go", ClientLibraryVersion: "1.2.4", Host: "host1", BuildID: "build-b", MaxConcurrentTasks: 5}
+	pollers.StartPoll("poller1", func() {}, &poller.Info{Identity: "1@host1", IsolationGroup: "zone-a", Worker: buildA})
+	pollers.EndPoll("poller1")
+	pollers.StartPoll("poller2", func() {}, &poller.Info{Identity: "2@host1", IsolationGroup: "zone-a", Worker: buildA})
+	pollers.StartPoll("poller3", func() {}, &poller.Info{Identity: "3@host1", IsolationGroup: "zone-a", Worker: buildB})
+	pollers.EndPoll("poller3")
+
+	gauges := func() map[string]float64 {
+		result := make(map[string]float64)
+		for _, g := range testScope.Snapshot().Gauges() {
+			tags := g.Tags()
+			assert.Equal(t, "uber-go", tags["client_impl"])
+			assert.Equal(t, "zone-a", tags["poller_isolation_group"])
+			assert.NotContains(t, tags, "client_library_version")
+			assert.NotContains(t, tags, "worker_build_id")
+			result[g.Name()] = g.Value()
+		}
+		return result
+	}
+
+	// builds of the same client are reported together, and the shared host is counted once
+	r.report()
+	assert.Equal(t, map[string]float64{
+		"tasklist_poller_fleet_pollers_per_tl":              3,
+		"tasklist_poller_fleet_waiting_pollers_per_tl":      1,
+		"tasklist_poller_fleet_hosts_per_tl":                1,
+		"tasklist_poller_fleet_max_concurrent_tasks_per_tl": 25,
+	}, gauges())
+
+	// the fleet stops polling and its pollers expire, so its gauges are reset
+	pollers.EndPoll("poller2")
+	timeSource.Advance(time.Hour)
+	r.report()
+	assert.Equal(t, map[string]float64{
+		"tasklist_poller_fleet_pollers_per_tl":              0,
+		"tasklist_poller_fleet_waiting_pollers_per_tl":      0,
+		"tasklist_poller_fleet_hosts_per_tl":                0,
+		"tasklist_poller_fleet_max_concurrent_tasks_per_tl": 0,
+	}, gauges())
+	assert.Empty(t, r.reported)
+}
+
+func TestPollerFleetReporterClearsGaugesOnStop(t *testing.T) {
+	timeSource := clock.NewMockedTimeSource()
+	pollers := poller.NewPollerManager(func() {}, timeSource)
+	testScope := tally.NewTestScope("", nil)
+	scope := metrics.NewClient(testScope, metrics.Matching, metrics.HistogramMigration{}).Scope(metrics.MatchingTaskListMgrScope)
+	r := newPollerFleetReporter(pollers, scope, timeSource)
+	pollers.StartPoll("poller1", func() {}, &poller.Info{Identity: "1@host1", Worker: poller.WorkerInfo{ClientImpl: "uber-go", Host: "host1"}})
+
+	r.Start()
+	timeSource.BlockUntil(1)
+	timeSource.Advance(pollerFleetReportInterval)
+	assert.Eventually(t, func() bool {
+		for _, g := range testScope.Snapshot().Gauges() {
+			if g.Name() == "tasklist_poller_fleet_pollers_per_tl" {
+				return g.Value() == 1
+			}
+		}
+		return false
+	}, time.Second, time.Millisecond)
+
+	r.Stop()
+	for _, g := range testScope.Snapshot().Gauges() {
+		assert.Zero(t, g.Value(), g.Name())
+	}
+}
@@ -21,9 +21,13 @@
 package tasklist
 
 import (
+	"time"
+
+	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/isolationgroup"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/workerversioning"
 )
 
 // TODO: review the usage of InternalTask and provide a better abstraction
@@ -55,6 +59,9 @@ type (
 		source                   types.TaskSource
 		forwardedFrom            string     // name of the child partition this task is forwarded from (empty if not forwarded)
 		isolationGroup           string     // isolation group of this task (empty if it can be polled by workers from any isolation group)
+		buildSet                 string     // worker build set of this task (empty if it can be polled by workers of any build)
+		receivedTime             time.Time  // time at which this task reached this task list partition
+		hopTrace                 string     // partitions this task waited in, including the one it was matched in (empty until matched)
 		ResponseC                chan error // non-nil only where there is a caller waiting for response (sync-match)
 		BacklogCountHint         int64
 		ActivityTaskDispatchInfo *types.ActivityTaskDispatchInfo
@@ -70,6 +77,7 @@ func newInternalTask(
 	forSyncMatch bool,
 	activityTaskDispatchInfo *types.ActivityTaskDispatchInfo,
 	isolationGroup string,
+	buildSet string,
 ) *InternalTask {
 	task := &InternalTask{
 		Event: &genericTaskInfo{
@@ -79,6 +87,8 @@ func newInternalTask(
 		source:                   source,
 		forwardedFrom:            forwardedFrom,
 		isolationGroup:           isolationGroup,
+		buildSet:                 buildSet,
+		receivedTime:             time.Now(),
 		ActivityTaskDispatchInfo: activityTaskDispatchInfo,
 	}
 	if forSyncMatch {
@@ -89,7 +99,7 @@ func newInternalTask(
 	// OriginalIsolationGroup is populated here and isn't written to the DB. If it's already
 	// present then it's a forwarded task and we should respect it.
 	if configIsolationGroup, ok := task.Event.PartitionConfig[isolationgroup.GroupKey]; ok {
-		partitionConfig := make(map[string]string, 3)
+		partitionConfig := make(map[string]string, 5)
 		if originalIsolationGroup, ok := task.Event.PartitionConfig[isolationgroup.OriginalGroupKey]; ok {
 			partitionConfig[isolationgroup.OriginalGroupKey] = originalIsolationGroup
 		} else {
@@ -97,6 +107,17 @@ func newInternalTask(
 		}
 		partitionConfig[isolationgroup.GroupKey] = isolationGroup
 		partitionConfig[isolationgroup.WorkflowIDKey] = task.Event.PartitionConfig[isolationgroup.WorkflowIDKey]
+		// The build set is kept as recorded by history rather than as dispatched, so that the parent partition
+		// can route a forwarded task to its build set even if this partition had no pollers of it
+		if originalBuildSet, ok := task.Event.PartitionConfig[workerversioning.BuildSetKey]; ok {
+			partitionConfig[workerversioning.BuildSetKey] = originalBuildSet
+		}
+		if activityType, ok := task.Event.PartitionConfig[constants.ActivityTypePartitionConfigKey]; ok {
+			partitionConfig[constants.ActivityTypePartitionConfigKey] = activityType
+		}
+		if hopTrace, ok := task.Event.PartitionConfig[hopTraceKey]; ok {
+			partitionConfig[hopTraceKey] = hopTrace
+		}
 		task.Event.PartitionConfig = partitionConfig
 	}
 	return task
@@ -162,6 +183,12 @@ func (task *InternalTask) WorkflowExecution() *types.WorkflowExecution {
 	return &types.WorkflowExecution{}
 }
 
+// HopTrace returns the partitions this task waited in before being matched, oldest first, as
+// comma separated "<partition>:<s|b>:<wait in milliseconds>" hops
+func (task *InternalTask) HopTrace() string {
+	return task.hopTrace
+}
+
 // pollForDecisionResponse returns the poll response for a decision task that is
 // already marked as started. This method should only be called when isStarted() is true
 func (task *InternalTask) PollForDecisionResponse() *types.MatchingPollForDecisionTaskResponse {
@@ -51,6 +51,7 @@ import (
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/stats"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/workerversioning"
 	"github.com/uber/cadence/service/matching/config"
 	"github.com/uber/cadence/service/matching/event"
 	"github.com/uber/cadence/service/matching/liveness"
@@ -85,6 +86,8 @@ type (
 	pollerIDCtxKey       struct{}
 	identityCtxKey       struct{}
 	isolationGroupCtxKey struct{}
+	buildIDCtxKey        struct{}
+	workerInfoCtxKey     struct{}
 
 	AddTaskParams struct {
 		TaskInfo                 *persistence.TaskInfo
@@ -126,8 +129,9 @@ type (
 		closeCallback func(Manager)
 		throttleRetry *backoff.ThrottleRetry
 
-		qpsTracker     stats.QPSTrackerGroup
-		adaptiveScaler AdaptiveScaler
+		qpsTracker          stats.QPSTrackerGroup
+		adaptiveScaler      AdaptiveScaler
+		pollerFleetReporter *pollerFleetReporter
 
 		partitionConfigLock sync.RWMutex
 		partitionConfig     *types.TaskListPartitionConfig
@@ -218,11 +222,12 @@ func NewManager(
 		TaskListType: taskList.GetType(),
 	}
 
+	tlMgr.pollerFleetReporter = newPollerFleetReporter(tlMgr.pollers, scope, timeSource)
 	tlMgr.qpsTracker = stats.NewEmaFixedWindowQPSTracker(timeSource, 0.5, taskListConfig.QPSTrackerInterval(), baseEvent)
 	if taskList.IsRoot() && taskListKind == types.TaskListKindNormal {
 		adaptiveScalerScope := common.NewPerTaskListScope(domainName, taskList.GetName(), taskListKind, metricsClient, metrics.MatchingAdaptiveScalerScope).
 			Tagged(getTaskListTypeTag(taskList.GetType()))
-		tlMgr.adaptiveScaler = NewAdaptiveScaler(taskList, tlMgr, taskListConfig, timeSource, tlMgr.logger, adaptiveScalerScope, matchingClient, baseEvent)
+		tlMgr.adaptiveScaler = NewAdaptiveScaler(taskList, tlMgr, taskListConfig, timeSource, tlMgr.logger, adaptiveScalerScope, matchingClient, taskManager, domainName, baseEvent)
 	}
 
 	var fwdr Forwarder
@@ -241,7 +246,7 @@ func NewManager(
 		return taskListConfig.NumReadPartitions()
 	}
 	tlMgr.limiter = newTaskListLimiter(timeSource, tlMgr.scope, taskListConfig, numReadPartitionsFn)
-	tlMgr.matcher = newTaskMatcher(taskListConfig, fwdr, tlMgr.scope, isolationGroups, tlMgr.logger, taskList, taskListKind, tlMgr.limiter).(*taskMatcherImpl)
+	tlMgr.matcher = newTaskMatcher(taskListConfig, fwdr, tlMgr.scope, isolationGroups, tlMgr.logger, taskList, taskListKind, tlMgr.limiter, newActivityTypeLimiter(taskListConfig, numReadPartitionsFn)).(*taskMatcherImpl)
 	tlMgr.taskWriter = newTaskWriter(tlMgr)
 	tlMgr.taskReader = newTaskReader(tlMgr, isolationGroups)
 	tlMgr.taskCompleter = newTaskCompleter(tlMgr, historyServiceOperationRetryPolicy)
@@ -296,6 +301,7 @@ func (c *taskListManagerImpl) Start() error {
 	c.liveness.Start()
 	c.taskReader.Start()
 	c.qpsTracker.Start()
+	c.pollerFleetReporter.Start()
 	if c.adaptiveScaler != nil {
 		c.adaptiveScaler.Start()
 	}
@@ -315,6 +321,7 @@ func (c *taskListManagerImpl) Stop() {
 		c.adaptiveScaler.Stop()
 	}
 	c.qpsTracker.Stop()
+	c.pollerFleetReporter.Stop()
 	c.liveness.Stop()
 	c.taskWriter.Stop()
 	c.taskReader.Stop()
@@ -568,8 +575,9 @@ func (c *taskListManagerImpl) AddTask(ctx context.Context, params AddTaskParams)
 		}
 
 		isolationGroup, _ := c.getIsolationGroupForTask(ctx, params.TaskInfo)
+		buildSet := c.getBuildSetForTask(params.TaskInfo)
 		// active task, try sync match first
-		syncMatch, err = c.trySyncMatch(ctx, params, isolationGroup)
+		syncMatch, err = c.trySyncMatch(ctx, params, isolationGroup, buildSet)
 		if syncMatch {
 			e.EventName = "SyncMatched so not persisted"
 			event.Log(e)
@@ -677,6 +685,7 @@ func (c *taskListManagerImpl) getTask(ctx context.Context, maxDispatchPerSecond
 	isolationGroup := IsolationGroupFromContext(ctx)
 	pollerID := PollerIDFromContext(ctx)
 	identity := IdentityFromContext(ctx)
+	buildSet := buildSetFromContext(ctx, c.config, c.taskListKind)
 	rps := c.config.TaskDispatchRPS
 	if maxDispatchPerSecond != nil {
 		rps = *maxDispatchPerSecond
@@ -685,7 +694,9 @@ func (c *taskListManagerImpl) getTask(ctx context.Context, maxDispatchPerSecond
 	c.pollers.StartPoll(pollerID, cancel, &poller.Info{
 		Identity:       identity,
 		IsolationGroup: isolationGroup,
+		BuildSet:       buildSet,
 		RatePerSecond:  rps,
+		Worker:         WorkerInfoFromContext(ctx),
 	})
 	defer c.pollers.EndPoll(pollerID)
 
@@ -698,10 +709,44 @@ func (c *taskListManagerImpl) getTask(ctx context.Context, maxDispatchPerSecond
 		return c.matcher.PollForQuery(childCtx)
 	}
 
-	if c.isIsolationMatcherEnabled() {
-		return c.matcher.Poll(childCtx, isolationGroup)
+	if !c.isIsolationMatcherEnabled() {
+		isolationGroup = ""
 	}
-	return c.matcher.Poll(childCtx, "")
+	task, err := c.matcher.Poll(childCtx, isolationGroup)
+	if err == nil {
+		c.recordHopTrace(task)
+	}
+	return task, err
+}
+
+// recordHopTrace completes the hop trace of a matched task with the wait in this partition, so that it can be returned
+// to the poller. For tasks forwarded from child partitions, it also reports how long they waited in each of them.
+// Tasks matched in the partition they were created in are already covered by the sync and async match metrics.
+func (c *taskListManagerImpl) recordHopTrace(task *InternalTask) {
+	if task.Event == nil || task.Event.TaskInfo == nil {
+		return
+	}
+	hops := append(parseHopTrace(task.Event.PartitionConfig[hopTraceKey]), newTaskHop(task, c.taskListID.Partition(), time.Now()))
+	task.hopTrace = formatHopTrace(hops)
+	if !task.IsForwarded() {
+		return
+	}
+	var total time.Duration
+	for i, hop := range hops {
+		total += hop.wait
+		c.scope.Tagged(metrics.MatchingHopTag(i), metrics.MatchingHopSourceTag(hop.source())).
+			RecordTimer(metrics.ForwardedTaskHopLatencyPerTaskList, hop.wait)
+	}
+	c.scope.RecordTimer(metrics.ForwardedTaskMatchLatencyPerTaskList, total)
+	c.scope.RecordTimer(metrics.ForwardedTaskHopsPerTaskList, time.Duration(len(hops)))
+	event.Log(event.E{
+		TaskListName: c.taskListID.GetName(),
+		TaskListType: c.taskListID.GetType(),
+		TaskListKind: &c.taskListKind,
+		TaskInfo:     *task.Event.TaskInfo,
+		EventName:    "Forwarded Task Matched",
+		Payload:      map[string]any{"Hops": task.hopTrace},
+	})
 }
 
 // GetAllPollerInfo returns all pollers that polled from this tasklist in last few minutes
@@ -821,8 +866,8 @@ func (c *taskListManagerImpl) executeWithRetry(
 	return
 }
 
-func (c *taskListManagerImpl) trySyncMatch(ctx context.Context, params AddTaskParams, isolationGroup string) (bool, error) {
-	task := newInternalTask(params.TaskInfo, nil, params.Source, params.ForwardedFrom, true, params.ActivityTaskDispatchInfo, isolationGroup)
+func (c *taskListManagerImpl) trySyncMatch(ctx context.Context, params AddTaskParams, isolationGroup string, buildSet string) (bool, error) {
+	task := newInternalTask(params.TaskInfo, nil, params.Source, params.ForwardedFrom, true, params.ActivityTaskDispatchInfo, isolationGroup, buildSet)
 	childCtx := ctx
 	cancel := func() {}
 	waitTime := maxSyncMatchWaitTime
@@ -935,6 +980,24 @@ func (c *taskListManagerImpl) getIsolationGroupForTask(ctx context.Context, task
 
 }
 
+// getBuildSetForTask returns the worker build set the task should be dispatched to, or an empty string if the task
+// can be dispatched to pollers of any build. Like isolation groups, a task leaks to pollers of any build if no poller
+// of its build set polled recently, so that workflows of a retired build don't get stuck.
+func (c *taskListManagerImpl) getBuildSetForTask(taskInfo *persistence.TaskInfo) string {
+	if !c.config.EnableWorkerVersioning() || c.taskListKind == types.TaskListKindSticky {
+		return ""
+	}
+	buildSet := taskInfo.PartitionConfig[workerversioning.BuildSetKey]
+	if buildSet == "" {
+		return ""
+	}
+	if !c.pollers.HasPollerFromBuildSetAfter(buildSet, c.timeSource.Now().Add(-1*c.config.WorkerVersioningPollerWindow())) {
+		c.scope.IncCounter(metrics.WorkerVersioningLeakPerTaskList)
+		return ""
+	}
+	return buildSet
+}
+
 func (c *taskListManagerImpl) emitMisconfiguredPartitionMetrics() {
 	if !c.taskListID.IsRoot() || c.taskListKind == types.TaskListKindSticky {
 		// only emit the metric in root partition of non-sticky tasklist
@@ -1103,6 +1166,18 @@ func newTaskListConfig(id *Identifier, cfg *config.Config, domainName string) *c
 		IsolationGroupsPerPartition: func() int {
 			return cfg.IsolationGroupsPerPartition(domainName, taskListName, taskType)
 		},
+		EnableAdaptiveScalerBacklogScaling: func() bool {
+			return cfg.EnableAdaptiveScalerBacklogScaling(domainName, taskListName, taskType)
+		},
+		PartitionUpscaleBacklogAge: func() time.Duration {
+			return cfg.PartitionUpscaleBacklogAge(domainName, taskListName, taskType)
+		},
+		PartitionUpscaleBacklogPollerRatio: func() float64 {
+			return cfg.PartitionUpscaleBacklogPollerRatio(domainName, taskListName, taskType)
+		},
+		PartitionBacklogUpscaleMaxPartitions: func() int {
+			return cfg.PartitionBacklogUpscaleMaxPartitions(domainName, taskListName, taskType)
+		},
 		QPSTrackerInterval: func() time.Duration {
 			return cfg.QPSTrackerInterval(domainName, taskListName, taskType)
 		},
@@ -1118,6 +1193,18 @@ func newTaskListConfig(id *Identifier, cfg *config.Config, domainName string) *c
 		TaskIsolationPollerWindow: func() time.Duration {
 			return cfg.TaskIsolationPollerWindow(domainName, taskListName, taskType)
 		},
+		EnableWorkerVersioning: func() bool {
+			return cfg.EnableWorkerVersioning(domainName)
+		},
+		WorkerVersioningBuildSets: func() map[string]interface{} {
+			return cfg.WorkerVersioningBuildSets(domainName)
+		},
+		WorkerVersioningPollerWindow: func() time.Duration {
+			return cfg.WorkerVersioningPollerWindow(domainName, taskListName, taskType)
+		},
+		ActivityTypeDispatchRPS: func() map[string]interface{} {
+			return cfg.ActivityTypeDispatchRPS(domainName, taskListName, taskType)
+		},
 		ForwarderConfig: config.ForwarderConfig{
 			ForwarderMaxOutstandingPolls: func() int {
 				return cfg.ForwarderMaxOutstandingPolls(domainName, taskListName, taskType)
@@ -1180,3 +1267,37 @@ func IsolationGroupFromContext(ctx context.Context) string {
 func ContextWithIsolationGroup(ctx context.Context, isolationGroup string) context.Context {
 	return context.WithValue(ctx, isolationGroupCtxKey{}, isolationGroup)
 }
+
+func BuildIDFromContext(ctx context.Context) string {
+	val, ok := ctx.Value(buildIDCtxKey{}).(string)
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+func ContextWithBuildID(ctx context.Context, buildID string) context.Context {
+	return context.WithValue(ctx, buildIDCtxKey{}, buildID)
+}
+
+func WorkerInfoFromContext(ctx context.Context) poller.WorkerInfo {
+	val, ok := ctx.Value(workerInfoCtxKey{}).(poller.WorkerInfo)
+	if !ok {
+		return poller.WorkerInfo{}
+	}
+	return val
+}
+
+func ContextWithWorkerInfo(ctx context.Context, info poller.WorkerInfo) context.Context {
+	return context.WithValue(ctx, workerInfoCtxKey{}, info)
+}
+
+// buildSetFromContext returns the build set of the poller's build, or an empty string if tasks aren't routed
+// by build to the poller
+func buildSetFromContext(ctx context.Context, cfg *config.TaskListConfig, taskListKind types.TaskListKind) string {
+	buildID := BuildIDFromContext(ctx)
+	if buildID == "" || taskListKind == types.TaskListKindSticky || !cfg.EnableWorkerVersioning() {
+		return ""
+	}
+	return workerversioning.GetBuildSet(cfg.WorkerVersioningBuildSets(), buildID)
+}
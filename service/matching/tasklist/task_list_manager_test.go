@@ -53,6 +53,7 @@ import (
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/stats"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/workerversioning"
 	"github.com/uber/cadence/service/history/constants"
 	"github.com/uber/cadence/service/matching/config"
 	"github.com/uber/cadence/service/matching/poller"
@@ -129,7 +130,7 @@ func TestDeliverBufferTasks(t *testing.T) {
 		func(tlm *taskListManagerImpl) {
 			tlm.limiter.ReportLimit(0.1)
 			tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup] <- &persistence.TaskInfo{}
-			err := tlm.matcher.(*taskMatcherImpl).ratelimit(context.Background()) // consume the token
+			err := tlm.matcher.(*taskMatcherImpl).ratelimit(context.Background(), &InternalTask{}) // consume the token
 			assert.NoError(t, err)
 			tlm.taskReader.cancelFunc()
 		},
@@ -835,6 +836,90 @@ func TestGetIsolationGroupForTask(t *testing.T) {
 	}
 }
 
+func TestGetBuildSetForTask(t *testing.T) {
+	testCases := []struct {
+		name                  string
+		taskBuildSet          string
+		recentPollerBuildSets []string
+		pollerAge             time.Duration
+		disableVersioning     bool
+		sticky                bool
+		expected              string
+	}{
+		{
+			name:                  "success - recent poller of the build set",
+			taskBuildSet:          "set-1",
+			recentPollerBuildSets: []string{"set-1"},
+			expected:              "set-1",
+		},
+		{
+			name:     "task without build set",
+			expected: "",
+		},
+		{
+			name:                  "leak - no poller of the build set",
+			taskBuildSet:          "set-1",
+			recentPollerBuildSets: []string{"set-2"},
+			expected:              "",
+		},
+		{
+			name:                  "leak - poller of the build set outside of the window",
+			taskBuildSet:          "set-1",
+			recentPollerBuildSets: []string{"set-1"},
+			pollerAge:             time.Minute,
+			expected:              "",
+		},
+		{
+			name:                  "worker versioning disabled",
+			taskBuildSet:          "set-1",
+			recentPollerBuildSets: []string{"set-1"},
+			disableVersioning:     true,
+			expected:              "",
+		},
+		{
+			name:                  "sticky tasklist",
+			taskBuildSet:          "set-1",
+			recentPollerBuildSets: []string{"set-1"},
+			sticky:                true,
+			expected:              "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			config := defaultTestConfig()
+			config.EnableWorkerVersioning = func(domain string) bool { return !tc.disableVersioning }
+			config.WorkerVersioningPollerWindow = func(domain string, taskList string, taskType int) time.Duration {
+				return time.Second * 10
+			}
+			mockClock := clock.NewMockedTimeSource()
+			tlm := createTestTaskListManagerWithConfig(t, testlogger.New(t), controller, config, mockClock)
+			if tc.sticky {
+				tlm.taskListKind = types.TaskListKindSticky
+			}
+			for i, buildSet := range tc.recentPollerBuildSets {
+				pollerID := strconv.Itoa(i)
+				tlm.pollers.StartPoll(pollerID, func() {}, &poller.Info{Identity: pollerID, BuildSet: buildSet})
+				tlm.pollers.EndPoll(pollerID)
+			}
+			mockClock.Advance(tc.pollerAge)
+
+			taskInfo := &persistence.TaskInfo{
+				DomainID:   "domainId",
+				RunID:      "run1",
+				WorkflowID: "workflow1",
+				ScheduleID: 5,
+			}
+			if tc.taskBuildSet != "" {
+				taskInfo.PartitionConfig = map[string]string{workerversioning.BuildSetKey: tc.taskBuildSet}
+			}
+
+			assert.Equal(t, tc.expected, tlm.getBuildSetForTask(taskInfo))
+		})
+	}
+}
+
 func TestTaskWriterShutdown(t *testing.T) {
 	controller := gomock.NewController(t)
 	logger := testlogger.New(t)
@@ -1258,6 +1343,42 @@ func TestTaskListManagerImpl_HasPollerAfter(t *testing.T) {
 	}
 }
 
+func TestRecordHopTrace(t *testing.T) {
+	controller := gomock.NewController(t)
+	logger := testlogger.New(t)
+	tlm := createTestTaskListManager(t, logger, controller)
+	testScope := tally.NewTestScope("", nil)
+	tlm.scope = metrics.NewClient(testScope, metrics.Matching, metrics.HistogramMigration{}).Scope(metrics.MatchingTaskListMgrScope)
+
+	// tasks matched in the partition they were created in are not reported, but still get their hop trace
+	local := newInternalTask(&persistence.TaskInfo{}, nil, types.TaskSourceHistory, "", true, nil, "", "")
+	tlm.recordHopTrace(local)
+	assert.Empty(t, testScope.Snapshot().Timers())
+	assert.Regexp(t, `^0:s:\d+$`, local.HopTrace())
+
+	task := newInternalTask(&persistence.TaskInfo{
+		PartitionConfig: map[string]string{hopTraceKey: "3:b:1500,1:s:20"},
+	}, nil, types.TaskSourceDbBacklog, "/__cadence_sys/tl/1", true, nil, "", "")
+	tlm.recordHopTrace(task)
+	assert.Regexp(t, `^3:b:1500,1:s:20,0:b:\d+$`, task.HopTrace())
+
+	hopLatencies := make(map[string][]time.Duration)
+	timers := make(map[string][]time.Duration)
+	for _, timer := range testScope.Snapshot().Timers() {
+		if timer.Name() == "forwarded_task_hop_latency_per_tl" {
+			hopLatencies[timer.Tags()["matching_hop"]+":"+timer.Tags()["matching_hop_source"]] = timer.Values()
+			continue
+		}
+		timers[timer.Name()] = timer.Values()
+	}
+	assert.Equal(t, []time.Duration{1500 * time.Millisecond}, hopLatencies["0:backlog"])
+	assert.Equal(t, []time.Duration{20 * time.Millisecond}, hopLatencies["1:sync"])
+	assert.Len(t, hopLatencies["2:backlog"], 1)
+	assert.Equal(t, []time.Duration{3}, timers["forwarded_task_hops_per_tl"])
+	assert.Len(t, timers["forwarded_task_match_latency_per_tl"], 1)
+	assert.GreaterOrEqual(t, timers["forwarded_task_match_latency_per_tl"][0], 1520*time.Millisecond)
+}
+
 func getIsolationgroupsHelper() []string {
 	return testIsolationGroups
 }
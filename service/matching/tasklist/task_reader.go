@@ -87,6 +87,7 @@ type (
 		onFatalErr               func()
 		dispatchTask             func(context.Context, *InternalTask) error
 		getIsolationGroupForTask func(context.Context, *persistence.TaskInfo) (string, time.Duration)
+		getBuildSetForTask       func(*persistence.TaskInfo) string
 		rateLimit                func() rate.Limit
 
 		// stopWg is used to wait for all dispatchers to stop.
@@ -135,6 +136,7 @@ func newTaskReader(tlMgr *taskListManagerImpl, isolationGroups []string) *taskRe
 		onFatalErr:               tlMgr.Stop,
 		dispatchTask:             tlMgr.DispatchTask,
 		getIsolationGroupForTask: tlMgr.getIsolationGroupForTask,
+		getBuildSetForTask:       tlMgr.getBuildSetForTask,
 		rateLimit:                tlMgr.limiter.Limit,
 		throttleRetry: backoff.NewThrottleRetry(
 			backoff.WithRetryPolicy(persistenceOperationRetryPolicy),
@@ -348,6 +350,10 @@ func (tr *taskReader) addSingleTaskToBuffer(task *persistence.TaskInfo) bool {
 	if err != nil {
 		tr.logger.Fatal("critical bug when adding item to ackManager", tag.Error(err))
 	}
+	return tr.putTaskInBuffer(task)
+}
+
+func (tr *taskReader) putTaskInBuffer(task *persistence.TaskInfo) bool {
 	// Ignore the isolation duration as we're just putting it into a buffer to be dispatched later.
 	isolationGroup, _ := tr.getIsolationGroupForTask(tr.cancelCtx, task)
 	buffer, ok := tr.taskBuffers[isolationGroup]
@@ -362,6 +368,22 @@ func (tr *taskReader) addSingleTaskToBuffer(task *persistence.TaskInfo) bool {
 	}
 }
 
+// deferTask puts a task back into its buffer after the given delay, so that the dispatchers move on to the tasks
+// behind it in the meantime. The task stays outstanding in the ack manager until it is dispatched.
+func (tr *taskReader) deferTask(task *persistence.TaskInfo, delay time.Duration) {
+	tr.stopWg.Add(1)
+	go func() {
+		defer tr.stopWg.Done()
+		timer := tr.timeSource.NewTimer(delay)
+		defer timer.Stop()
+		select {
+		case <-timer.Chan():
+			tr.putTaskInBuffer(task)
+		case <-tr.cancelCtx.Done():
+		}
+	}()
+}
+
 func (tr *taskReader) persistAckLevel() error {
 	ackLevel := tr.taskAckManager.GetAckLevel()
 	if ackLevel >= 0 {
@@ -407,9 +429,9 @@ func (tr *taskReader) completeTask(task *persistence.TaskInfo, err error) {
 	tr.taskGC.Run(ackLevel)
 }
 
-func (tr *taskReader) newDispatchContext(isolationGroup string, isolationDuration time.Duration) (context.Context, context.CancelFunc) {
+func (tr *taskReader) newDispatchContext(isolationGroup string, buildSet string, isolationDuration time.Duration) (context.Context, context.CancelFunc) {
 	rps := float64(tr.rateLimit())
-	if isolationGroup != "" || rps > 1e-7 { // 1e-7 is a random number chosen to avoid overflow, normally user don't set such a low rps
+	if isolationGroup != "" || buildSet != "" || rps > 1e-7 { // 1e-7 is a random number chosen to avoid overflow, normally user don't set such a low rps
 		timeout := tr.getDispatchTimeout(rps, isolationDuration)
 		domainEntry, err := tr.domainCache.GetDomainByID(tr.taskListID.GetDomainID())
 		if err != nil {
@@ -469,8 +491,9 @@ func (tr *taskReader) dispatchSingleTaskFromBuffer(taskInfo *persistence.TaskInf
 		isolationGroup = defaultTaskBufferIsolationGroup
 		isolationDuration = noIsolationTimeout
 	}
-	task := newInternalTask(taskInfo, tr.completeTask, types.TaskSourceDbBacklog, "", false, nil, isolationGroup)
-	dispatchCtx, cancel := tr.newDispatchContext(isolationGroup, isolationDuration)
+	buildSet := tr.getBuildSetForTask(taskInfo)
+	task := newInternalTask(taskInfo, tr.completeTask, types.TaskSourceDbBacklog, "", false, nil, isolationGroup, buildSet)
+	dispatchCtx, cancel := tr.newDispatchContext(isolationGroup, buildSet, isolationDuration)
 	timerScope := tr.scope.StartTimer(metrics.AsyncMatchLatencyPerTaskList)
 	err := tr.dispatchTask(dispatchCtx, task)
 	timerScope.Stop()
@@ -490,10 +513,10 @@ func (tr *taskReader) dispatchSingleTaskFromBuffer(taskInfo *persistence.TaskInf
 	}
 
 	if errors.Is(err, context.DeadlineExceeded) {
-		// it only happens when isolation is enabled and there is no pollers from the given isolation group
-		// if this happens, we don't want to block the task dispatching, because there might be pollers from
-		// other isolation groups, we just simply continue and dispatch the task to a new isolation group which
-		// has pollers
+		// it only happens when isolation or worker versioning is enabled and there is no pollers from the given
+		// isolation group or build set. if this happens, we don't want to block the task dispatching, because there
+		// might be pollers from other isolation groups, we just simply continue and dispatch the task to a new
+		// isolation group which has pollers, or to any build if the build set has no pollers anymore
 		tr.logger.Warn("Async task dispatch timed out",
 			tag.IsolationGroup(isolationGroup),
 			tag.WorkflowRunID(taskInfo.RunID),
@@ -508,6 +531,15 @@ func (tr *taskReader) dispatchSingleTaskFromBuffer(taskInfo *persistence.TaskInf
 		return false, false
 	}
 
+	var activityTypeThrottled *activityTypeThrottledError
+	if errors.As(err, &activityTypeThrottled) {
+		e.EventName = "Dispatch deferred because activity type throttled"
+		event.Log(e)
+		tr.scope.IncCounter(metrics.BufferActivityTypeThrottlePerTaskListCounter)
+		tr.deferTask(taskInfo, activityTypeThrottled.retryAfter)
+		return false, true
+	}
+
 	if errors.Is(err, ErrTasklistThrottled) {
 		e.EventName = "Dispatch failed because throttled. Will retry dispatch"
 		event.Log(e)
@@ -38,6 +38,7 @@ import (
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/log/testlogger"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/workerversioning"
 	"github.com/uber/cadence/service/matching/config"
 )
 
@@ -53,11 +54,12 @@ var defaultIsolationGroups = []string{
 
 func TestDispatchSingleTaskFromBuffer(t *testing.T) {
 	testCases := []struct {
-		name          string
-		allowances    func(t *testing.T, reader *taskReader, mockTime clock.MockedTimeSource)
-		ttl           int
-		breakDispatch bool
-		breakRetries  bool
+		name            string
+		allowances      func(t *testing.T, reader *taskReader, mockTime clock.MockedTimeSource)
+		ttl             int
+		partitionConfig map[string]string
+		breakDispatch   bool
+		breakRetries    bool
 	}{
 		{
 			name: "success - no isolation",
@@ -151,6 +153,65 @@ func TestDispatchSingleTaskFromBuffer(t *testing.T) {
 			breakDispatch: false,
 			breakRetries:  false,
 		},
+		{
+			name: "success - versioned task",
+			allowances: func(t *testing.T, reader *taskReader, mockTime clock.MockedTimeSource) {
+				reader.getIsolationGroupForTask = func(ctx context.Context, info *persistence.TaskInfo) (string, time.Duration) {
+					return defaultIsolationGroup, -1
+				}
+				reader.getBuildSetForTask = func(info *persistence.TaskInfo) string {
+					return info.PartitionConfig[workerversioning.BuildSetKey]
+				}
+				markCalled := requireCallbackInvocation(t, "expected task to be dispatched")
+				reader.dispatchTask = func(ctx context.Context, task *InternalTask) error {
+					assert.Equal(t, "set-1", task.buildSet)
+					_, ok := ctx.Deadline()
+					assert.True(t, ok)
+					markCalled()
+					return nil
+				}
+			},
+			partitionConfig: map[string]string{workerversioning.BuildSetKey: "set-1"},
+			breakDispatch:   false,
+			breakRetries:    true,
+		},
+		{
+			name: "success - versioned task without pollers of the build set",
+			allowances: func(t *testing.T, reader *taskReader, mockTime clock.MockedTimeSource) {
+				reader.getIsolationGroupForTask = func(ctx context.Context, info *persistence.TaskInfo) (string, time.Duration) {
+					return defaultIsolationGroup, -1
+				}
+				reader.getBuildSetForTask = func(info *persistence.TaskInfo) string {
+					return ""
+				}
+				markCalled := requireCallbackInvocation(t, "expected task to be dispatched")
+				reader.dispatchTask = func(ctx context.Context, task *InternalTask) error {
+					assert.Equal(t, "", task.buildSet)
+					markCalled()
+					return nil
+				}
+			},
+			partitionConfig: map[string]string{workerversioning.BuildSetKey: "set-1"},
+			breakDispatch:   false,
+			breakRetries:    true,
+		},
+		{
+			name: "Error - versioned task Deadline Exceeded, should retry",
+			allowances: func(t *testing.T, reader *taskReader, mockTime clock.MockedTimeSource) {
+				reader.getIsolationGroupForTask = func(ctx context.Context, info *persistence.TaskInfo) (string, time.Duration) {
+					return defaultIsolationGroup, -1
+				}
+				reader.getBuildSetForTask = func(info *persistence.TaskInfo) string {
+					return info.PartitionConfig[workerversioning.BuildSetKey]
+				}
+				reader.dispatchTask = func(ctx context.Context, task *InternalTask) error {
+					return context.DeadlineExceeded
+				}
+			},
+			partitionConfig: map[string]string{workerversioning.BuildSetKey: "set-1"},
+			breakDispatch:   false,
+			breakRetries:    false,
+		},
 		{
 			name: "Error - throttled, should retry",
 			allowances: func(t *testing.T, reader *taskReader, mockTime clock.MockedTimeSource) {
@@ -230,6 +291,7 @@ func TestDispatchSingleTaskFromBuffer(t *testing.T) {
 			tc.allowances(t, reader, timeSource)
 			taskInfo := newTask(timeSource)
 			taskInfo.Expiry = timeSource.Now().Add(time.Duration(tc.ttl) * time.Second)
+			taskInfo.PartitionConfig = tc.partitionConfig
 
 			breakDispatch, breakRetries := reader.dispatchSingleTaskFromBuffer(taskInfo)
 			assert.Equal(t, tc.breakDispatch, breakDispatch)
@@ -31,6 +31,7 @@ import (
 	"github.com/uber/cadence/common/isolationgroup"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/workerversioning"
 )
 
 func TestNewInternalTask(t *testing.T) {
@@ -41,6 +42,7 @@ func TestNewInternalTask(t *testing.T) {
 		forwardedFrom           string
 		forSyncMatch            bool
 		isolationGroup          string
+		buildSet                string
 		expectedPartitionConfig map[string]string
 		additionalAssertions    func(t *testing.T, task *InternalTask)
 	}{
@@ -128,18 +130,64 @@ func TestNewInternalTask(t *testing.T) {
 				isolationgroup.WorkflowIDKey:    "workflowID",
 			},
 		},
+		{
+			name:     "worker versioning",
+			source:   types.TaskSourceDbBacklog,
+			buildSet: "set-1",
+			partitionConfig: map[string]string{
+				workerversioning.BuildSetKey: "set-1",
+			},
+			expectedPartitionConfig: map[string]string{
+				workerversioning.BuildSetKey: "set-1",
+			},
+		},
+		{
+			name:           "worker versioning - tasklist isolation, no pollers of the build set",
+			source:         types.TaskSourceDbBacklog,
+			isolationGroup: "a",
+			partitionConfig: map[string]string{
+				isolationgroup.GroupKey:      "a",
+				isolationgroup.WorkflowIDKey: "workflowID",
+				workerversioning.BuildSetKey: "set-1",
+			},
+			expectedPartitionConfig: map[string]string{
+				isolationgroup.OriginalGroupKey: "a",
+				isolationgroup.GroupKey:         "a",
+				isolationgroup.WorkflowIDKey:    "workflowID",
+				workerversioning.BuildSetKey:    "set-1",
+			},
+		},
+		{
+			name:           "hop trace - tasklist isolation",
+			source:         types.TaskSourceDbBacklog,
+			isolationGroup: "a",
+			forwardedFrom:  "elsewhere",
+			partitionConfig: map[string]string{
+				isolationgroup.GroupKey:      "a",
+				isolationgroup.WorkflowIDKey: "workflowID",
+				hopTraceKey:                  "1:b:10",
+				"unknown":                    "dropped",
+			},
+			expectedPartitionConfig: map[string]string{
+				isolationgroup.OriginalGroupKey: "a",
+				isolationgroup.GroupKey:         "a",
+				isolationgroup.WorkflowIDKey:    "workflowID",
+				hopTraceKey:                     "1:b:10",
+			},
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			completionFunc := func(_ *persistence.TaskInfo, _ error) {}
 			taskInfo := defaultTaskInfo(tc.partitionConfig)
 			activityDispatchInfo := &types.ActivityTaskDispatchInfo{WorkflowDomain: "domain"}
-			task := newInternalTask(taskInfo, completionFunc, tc.source, tc.forwardedFrom, tc.forSyncMatch, activityDispatchInfo, tc.isolationGroup)
+			task := newInternalTask(taskInfo, completionFunc, tc.source, tc.forwardedFrom, tc.forSyncMatch, activityDispatchInfo, tc.isolationGroup, tc.buildSet)
 			assert.Equal(t, defaultTaskInfo(tc.expectedPartitionConfig), task.Event.TaskInfo)
 			assert.NotNil(t, task.Event.completionFunc)
 			assert.Equal(t, tc.source, task.source)
 			assert.Equal(t, tc.forwardedFrom, task.forwardedFrom)
 			assert.Equal(t, tc.isolationGroup, task.isolationGroup)
+			assert.Equal(t, tc.buildSet, task.buildSet)
 			assert.Equal(t, activityDispatchInfo, task.ActivityTaskDispatchInfo)
 			if tc.additionalAssertions != nil {
 				tc.additionalAssertions(t, task)
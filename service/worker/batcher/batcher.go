@@ -43,6 +43,10 @@ type (
 		AdminOperationToken dynamicproperties.StringPropertyFn
 		// ClusterMetadata contains the metadata for this cluster
 		ClusterMetadata cluster.Metadata
+		// MaxRPS caps the RPS requested by batch jobs of a domain
+		MaxRPS dynamicproperties.IntPropertyFnWithDomainFilter
+		// MaxConcurrency caps the concurrency requested by batch jobs of a domain
+		MaxConcurrency dynamicproperties.IntPropertyFnWithDomainFilter
 	}
 
 	// BootstrapParams contains the set of params needed to bootstrap
@@ -56,6 +56,18 @@ type ReplicateParams struct {
 	TargetCluster string
 }
 
+// ResetParams is the parameters for resetting workflows to their last completed decision
+type ResetParams struct {
+	// this indicates whether to skip reapplying the signals received after the reset point
+	SkipSignalReapply bool
+}
+
+// UpdateSearchAttributesParams is the parameters for updating search attributes of workflows
+type UpdateSearchAttributesParams struct {
+	// search attributes to upsert, keyed by search attribute name with JSON encoded values
+	SearchAttributes map[string][]byte
+}
+
 // BatchParams is the parameters for batch operation workflow
 type BatchParams struct {
 	// Target domain to execute batch operation
@@ -76,6 +88,10 @@ type BatchParams struct {
 	SignalParams SignalParams
 	// ReplicateParams is params only for BatchTypeReplicate
 	ReplicateParams ReplicateParams
+	// ResetParams is params only for BatchTypeReset
+	ResetParams ResetParams
+	// UpdateSearchAttributesParams is params only for BatchTypeUpdateSearchAttributes
+	UpdateSearchAttributesParams UpdateSearchAttributesParams
 	// RPS of processing. Default to DefaultRPS
 	// TODO we will implement smarter way than this static rate limiter: https://github.com/uber/cadence/issues/2138
 	RPS int
@@ -91,6 +107,10 @@ type BatchParams struct {
 	MaxActivityRetries int
 	// errors that will not retry which consumes AttemptsOnRetryableError. Default to empty
 	NonRetryableErrors []string
+	// Max number of failed workflows listed in the failure report. Default to DefaultMaxFailureReport
+	MaxFailureReport int
+	// Progress of a previous job to resume from instead of starting from the first page
+	Checkpoint *HeartBeatDetails
 	// internal conversion for NonRetryableErrors
 	_nonRetryableErrors map[string]struct{}
 }
@@ -105,6 +125,20 @@ type HeartBeatDetails struct {
 	SuccessCount int
 	// Number of workflows that give up due to errors.
 	ErrorCount int
+	// Workflows that give up due to errors, up to MaxFailureReport of them
+	Failures []WorkflowFailure
+}
+
+// WorkflowFailure is a workflow the batch operation gave up on
+type WorkflowFailure struct {
+	WorkflowID string
+	RunID      string
+	Error      string
+}
+
+type taskResult struct {
+	execution types.WorkflowExecution
+	err       error
 }
 
 type taskDetail struct {
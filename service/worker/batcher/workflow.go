@@ -37,6 +37,8 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/reserved"
+	"github.com/uber/cadence/common/searchattrupdate"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -68,6 +70,8 @@ const (
 	DefaultActivityHeartBeatTimeout = time.Second * 10
 	// DefaultMaxActivityRetries is the default value for MaxActivityRetries
 	DefaultMaxActivityRetries = 4
+	// DefaultMaxFailureReport is the default value for MaxFailureReport
+	DefaultMaxFailureReport = 1000
 )
 
 const (
@@ -79,10 +83,27 @@ const (
 	BatchTypeSignal = "signal"
 	// BatchTypeReplicate is batch type for replicating workflows
 	BatchTypeReplicate = "replicate"
+	// BatchTypeReset is batch type for resetting workflows to their last completed decision
+	BatchTypeReset = "reset"
+	// BatchTypeDelete is batch type for deleting workflows
+	BatchTypeDelete = "delete"
+	// BatchTypeUpdateSearchAttributes is batch type for updating search attributes of workflows
+	BatchTypeUpdateSearchAttributes = "update_search_attributes"
+	// BatchTypeResetSticky is batch type for resetting the sticky task list of workflows
+	BatchTypeResetSticky = "reset_sticky"
 )
 
 // AllBatchTypes is the batch types we supported
-var AllBatchTypes = []string{BatchTypeTerminate, BatchTypeCancel, BatchTypeSignal, BatchTypeReplicate}
+var AllBatchTypes = []string{
+	BatchTypeTerminate,
+	BatchTypeCancel,
+	BatchTypeSignal,
+	BatchTypeReplicate,
+	BatchTypeReset,
+	BatchTypeDelete,
+	BatchTypeUpdateSearchAttributes,
+	BatchTypeResetSticky,
+}
 
 var (
 	BatchActivityRetryPolicy = cadence.RetryPolicy{
@@ -132,6 +153,10 @@ func validateParams(params BatchParams) error {
 		if params.SignalParams.SignalName == "" {
 			return fmt.Errorf("must provide signal name")
 		}
+		// the batcher is authorized for more than the user starting the batch job
+		if reserved.IsSignalName(params.SignalParams.SignalName) {
+			return fmt.Errorf("signal name %v is reserved", params.SignalParams.SignalName)
+		}
 		return nil
 	case BatchTypeReplicate:
 		if params.ReplicateParams.SourceCluster == "" {
@@ -141,9 +166,12 @@ func validateParams(params BatchParams) error {
 			return fmt.Errorf("must provide target cluster")
 		}
 		return nil
-	case BatchTypeCancel:
-		fallthrough
-	case BatchTypeTerminate:
+	case BatchTypeUpdateSearchAttributes:
+		if len(params.UpdateSearchAttributesParams.SearchAttributes) == 0 {
+			return fmt.Errorf("must provide search attributes")
+		}
+		return nil
+	case BatchTypeCancel, BatchTypeTerminate, BatchTypeReset, BatchTypeDelete, BatchTypeResetSticky:
 		return nil
 	default:
 		return fmt.Errorf("not supported batch type: %v", params.BatchType)
@@ -178,6 +206,24 @@ func setDefaultParams(params BatchParams) BatchParams {
 	if params.MaxActivityRetries < 0 {
 		params.MaxActivityRetries = DefaultMaxActivityRetries
 	}
+	if params.MaxFailureReport <= 0 {
+		params.MaxFailureReport = DefaultMaxFailureReport
+	}
+	return params
+}
+
+// applyLimits caps the RPS and concurrency of a batch job to the limits of its domain
+func applyLimits(cfg Config, params BatchParams) BatchParams {
+	if cfg.MaxRPS != nil {
+		if maxRPS := cfg.MaxRPS(params.DomainName); maxRPS > 0 && params.RPS > maxRPS {
+			params.RPS = maxRPS
+		}
+	}
+	if cfg.MaxConcurrency != nil {
+		if maxConcurrency := cfg.MaxConcurrency(params.DomainName); maxConcurrency > 0 && params.Concurrency > maxConcurrency {
+			params.Concurrency = maxConcurrency
+		}
+	}
 	return params
 }
 
@@ -185,8 +231,10 @@ func setDefaultParams(params BatchParams) BatchParams {
 func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetails, error) {
 	batcher := ctx.Value(BatcherContextKey).(*Batcher)
 	client := batcher.clientBean.GetFrontendClient()
+	batchParams = applyLimits(batcher.cfg, batchParams)
 	var adminClient admin.Client
-	if batchParams.BatchType == BatchTypeReplicate {
+	switch batchParams.BatchType {
+	case BatchTypeReplicate:
 		currentCluster := batcher.cfg.ClusterMetadata.GetCurrentClusterName()
 		if currentCluster != batchParams.ReplicateParams.SourceCluster {
 			return HeartBeatDetails{}, cadence.NewCustomError(_nonRetriableReason, fmt.Sprintf("the activity must run in the source cluster, current cluster is %s", currentCluster))
@@ -196,6 +244,12 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 		if err != nil {
 			return HeartBeatDetails{}, cadence.NewCustomError(_nonRetriableReason, err.Error())
 		}
+	case BatchTypeDelete:
+		var err error
+		adminClient, err = batcher.clientBean.GetRemoteAdminClient(batcher.cfg.ClusterMetadata.GetCurrentClusterName())
+		if err != nil {
+			return HeartBeatDetails{}, cadence.NewCustomError(_nonRetriableReason, err.Error())
+		}
 	}
 
 	domainResp, err := client.DescribeDomain(ctx, &types.DescribeDomainRequest{
@@ -206,6 +260,10 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 	}
 	domainID := domainResp.GetDomainInfo().GetUUID()
 	hbd, ok := getHeartBeatDetails(ctx)
+	if !ok && batchParams.Checkpoint != nil {
+		// resuming a previous job, its progress carries over
+		hbd, ok = *batchParams.Checkpoint, true
+	}
 
 	if !ok {
 		resp, err := client.CountWorkflowExecutions(ctx, &types.CountWorkflowExecutionsRequest{
@@ -219,7 +277,7 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 	}
 	rateLimiter := rate.NewLimiter(rate.Limit(batchParams.RPS), batchParams.RPS)
 	taskCh := make(chan taskDetail, batchParams.PageSize)
-	respCh := make(chan error, batchParams.PageSize)
+	respCh := make(chan taskResult, batchParams.PageSize)
 	for i := 0; i < batchParams.Concurrency; i++ {
 		go startTaskProcessor(ctx, batchParams, domainID, taskCh, respCh, rateLimiter, client, adminClient)
 	}
@@ -257,11 +315,18 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 	Loop:
 		for {
 			select {
-			case err := <-respCh:
-				if err == nil {
+			case result := <-respCh:
+				if result.err == nil {
 					succCount++
 				} else {
 					errCount++
+					if len(hbd.Failures) < batchParams.MaxFailureReport {
+						hbd.Failures = append(hbd.Failures, WorkflowFailure{
+							WorkflowID: result.execution.GetWorkflowID(),
+							RunID:      result.execution.GetRunID(),
+							Error:      result.err.Error(),
+						})
+					}
 				}
 				if succCount+errCount == batchCount {
 					break Loop
@@ -304,7 +369,7 @@ func startTaskProcessor(
 	batchParams BatchParams,
 	domainID string,
 	taskCh chan taskDetail,
-	respCh chan error,
+	respCh chan taskResult,
 	limiter *rate.Limiter,
 	client frontend.Client,
 	adminClient admin.Client,
@@ -375,6 +440,56 @@ func startTaskProcessor(
 							RemoteCluster: batchParams.ReplicateParams.SourceCluster,
 						})
 					})
+			case BatchTypeReset:
+				err = processTask(ctx, limiter, task, batchParams, client, common.BoolPtr(false),
+					func(workflowID, runID string) error {
+						return resetToLastDecision(ctx, client, batchParams, workflowID, runID, requestID)
+					})
+			case BatchTypeDelete:
+				err = processTask(ctx, limiter, task, batchParams, client, common.BoolPtr(false),
+					func(workflowID, runID string) error {
+						_, err := adminClient.DeleteWorkflow(ctx, &types.AdminDeleteWorkflowRequest{
+							Domain: batchParams.DomainName,
+							Execution: &types.WorkflowExecution{
+								WorkflowID: workflowID,
+								RunID:      runID,
+							},
+						})
+						return err
+					})
+			case BatchTypeUpdateSearchAttributes:
+				err = processTask(ctx, limiter, task, batchParams, client, common.BoolPtr(false),
+					func(workflowID, runID string) error {
+						input, err := searchattrupdate.EncodeRequest(&types.SearchAttributes{
+							IndexedFields: batchParams.UpdateSearchAttributesParams.SearchAttributes,
+						})
+						if err != nil {
+							return err
+						}
+						return client.SignalWorkflowExecution(ctx, &types.SignalWorkflowExecutionRequest{
+							Domain: batchParams.DomainName,
+							WorkflowExecution: &types.WorkflowExecution{
+								WorkflowID: workflowID,
+								RunID:      runID,
+							},
+							Identity:   BatchWFTypeName,
+							RequestID:  requestID,
+							SignalName: searchattrupdate.SignalName,
+							Input:      input,
+						})
+					})
+			case BatchTypeResetSticky:
+				err = processTask(ctx, limiter, task, batchParams, client, common.BoolPtr(false),
+					func(workflowID, runID string) error {
+						_, err := client.ResetStickyTaskList(ctx, &types.ResetStickyTaskListRequest{
+							Domain: batchParams.DomainName,
+							Execution: &types.WorkflowExecution{
+								WorkflowID: workflowID,
+								RunID:      runID,
+							},
+						})
+						return err
+					})
 			}
 			if err != nil {
 				batcher.metricsClient.IncCounter(metrics.BatcherScope, metrics.BatcherProcessorFailures)
@@ -382,7 +497,7 @@ func startTaskProcessor(
 
 				_, ok := batchParams._nonRetryableErrors[err.Error()]
 				if ok || task.attempts >= batchParams.AttemptsOnRetryableError {
-					respCh <- err
+					respCh <- taskResult{execution: task.execution, err: err}
 				} else {
 					// put back to the channel if less than attemptsOnError
 					task.attempts++
@@ -390,7 +505,7 @@ func startTaskProcessor(
 				}
 			} else {
 				batcher.metricsClient.IncCounter(metrics.BatcherScope, metrics.BatcherProcessorSuccess)
-				respCh <- nil
+				respCh <- taskResult{execution: task.execution}
 			}
 		}
 	}
@@ -455,6 +570,55 @@ func processTask(
 	return nil
 }
 
+// resetToLastDecision resets the given workflow to its last completed decision
+func resetToLastDecision(
+	ctx context.Context,
+	client frontend.Client,
+	batchParams BatchParams,
+	workflowID string,
+	runID string,
+	requestID string,
+) error {
+	execution := &types.WorkflowExecution{
+		WorkflowID: workflowID,
+		RunID:      runID,
+	}
+	req := &types.GetWorkflowExecutionHistoryRequest{
+		Domain:          batchParams.DomainName,
+		Execution:       execution,
+		MaximumPageSize: 1000,
+	}
+	var decisionFinishEventID int64
+	for {
+		resp, err := client.GetWorkflowExecutionHistory(ctx, req)
+		if err != nil {
+			return err
+		}
+		for _, e := range resp.GetHistory().GetEvents() {
+			if e.GetEventType() == types.EventTypeDecisionTaskCompleted {
+				decisionFinishEventID = e.ID
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		req.NextPageToken = resp.NextPageToken
+	}
+	if decisionFinishEventID == 0 {
+		return fmt.Errorf("no completed decision to reset workflow %v to", workflowID)
+	}
+
+	_, err := client.ResetWorkflowExecution(ctx, &types.ResetWorkflowExecutionRequest{
+		Domain:                batchParams.DomainName,
+		WorkflowExecution:     execution,
+		Reason:                batchParams.Reason,
+		DecisionFinishEventID: decisionFinishEventID,
+		RequestID:             requestID,
+		SkipSignalReapply:     batchParams.ResetParams.SkipSignalReapply,
+	})
+	return err
+}
+
 func isDone(ctx context.Context) bool {
 	select {
 	case <-ctx.Done():
@@ -27,24 +27,29 @@ import (
 	"testing"
 
 	"github.com/opentracing/opentracing-go"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-go/tally"
 	"go.uber.org/cadence/testsuite"
 	"go.uber.org/cadence/worker"
 	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/metrics"
 	mmocks "github.com/uber/cadence/common/metrics/mocks"
+	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/types"
 )
 
 type workflowSuite struct {
 	suite.Suite
 	testsuite.WorkflowTestSuite
-	workflowEnv *testsuite.TestWorkflowEnvironment
-	activityEnv *testsuite.TestActivityEnvironment
+	workflowEnv  *testsuite.TestWorkflowEnvironment
+	activityEnv  *testsuite.TestActivityEnvironment
+	mockResource *resource.Test
 }
 
 func TestWorkflowSuite(t *testing.T) {
@@ -59,9 +64,11 @@ func (s *workflowSuite) SetupTest() {
 	s.activityEnv.RegisterActivity(BatchActivity)
 
 	batcher, mockResource := setuptest(s.T())
+	s.mockResource = mockResource
 
 	metricsMock := &mmocks.Client{}
 	metricsMock.On("IncCounter", metrics.BatcherScope, metrics.BatcherProcessorSuccess).Once()
+	metricsMock.On("IncCounter", metrics.BatcherScope, metrics.BatcherProcessorFailures).Maybe()
 	batcher.metricsClient = metricsMock
 
 	mockResource.FrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(&types.DescribeDomainResponse{}, nil).AnyTimes()
@@ -120,6 +127,90 @@ func (s *workflowSuite) TestActivity_BatchReplicate() {
 	s.NoError(err)
 }
 
+func (s *workflowSuite) TestActivity_BatchReset() {
+	params := createParams(BatchTypeReset)
+	s.mockResource.FrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(&types.GetWorkflowExecutionHistoryResponse{
+		History: &types.History{Events: []*types.HistoryEvent{
+			{ID: 4, EventType: types.EventTypeDecisionTaskCompleted.Ptr()},
+			{ID: 5, EventType: types.EventTypeActivityTaskScheduled.Ptr()},
+			{ID: 8, EventType: types.EventTypeDecisionTaskCompleted.Ptr()},
+			{ID: 9, EventType: types.EventTypeWorkflowExecutionSignaled.Ptr()},
+		}},
+	}, nil)
+	s.mockResource.FrontendClient.EXPECT().ResetWorkflowExecution(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, request *types.ResetWorkflowExecutionRequest, _ ...yarpc.CallOption) (*types.ResetWorkflowExecutionResponse, error) {
+			s.Equal(int64(8), request.DecisionFinishEventID)
+			s.Equal(params.Reason, request.Reason)
+			return &types.ResetWorkflowExecutionResponse{RunID: "new-rid"}, nil
+		})
+	result, err := s.activityEnv.ExecuteActivity(BatchActivity, params)
+	s.NoError(err)
+	var hbd HeartBeatDetails
+	s.NoError(result.Get(&hbd))
+	s.Equal(1, hbd.SuccessCount)
+}
+
+func (s *workflowSuite) TestActivity_BatchDelete() {
+	params := createParams(BatchTypeDelete)
+	s.mockResource.RemoteAdminClient.EXPECT().DeleteWorkflow(gomock.Any(), &types.AdminDeleteWorkflowRequest{
+		Domain:    params.DomainName,
+		Execution: &types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"},
+	}).Return(&types.AdminDeleteWorkflowResponse{}, nil)
+	_, err := s.activityEnv.ExecuteActivity(BatchActivity, params)
+	s.NoError(err)
+}
+
+func (s *workflowSuite) TestActivity_BatchUpdateSearchAttributes() {
+	params := createParams(BatchTypeUpdateSearchAttributes)
+	_, err := s.activityEnv.ExecuteActivity(BatchActivity, params)
+	s.NoError(err)
+}
+
+func (s *workflowSuite) TestActivity_BatchResetSticky() {
+	params := createParams(BatchTypeResetSticky)
+	s.mockResource.FrontendClient.EXPECT().ResetStickyTaskList(gomock.Any(), &types.ResetStickyTaskListRequest{
+		Domain:    params.DomainName,
+		Execution: &types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"},
+	}).Return(&types.ResetStickyTaskListResponse{}, nil)
+	_, err := s.activityEnv.ExecuteActivity(BatchActivity, params)
+	s.NoError(err)
+}
+
+func (s *workflowSuite) TestActivity_FailureReport() {
+	params := createParams(BatchTypeReset)
+	// a workflow without completed decision can't be reset
+	s.mockResource.FrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(&types.GetWorkflowExecutionHistoryResponse{
+		History: &types.History{Events: []*types.HistoryEvent{{ID: 1, EventType: types.EventTypeWorkflowExecutionStarted.Ptr()}}},
+	}, nil)
+	result, err := s.activityEnv.ExecuteActivity(BatchActivity, params)
+	s.NoError(err)
+	var hbd HeartBeatDetails
+	s.NoError(result.Get(&hbd))
+	s.Equal(1, hbd.ErrorCount)
+	s.Equal([]WorkflowFailure{{WorkflowID: "wid", RunID: "rid", Error: "no completed decision to reset workflow wid to"}}, hbd.Failures)
+}
+
+func (s *workflowSuite) TestActivity_ResumeFromCheckpoint() {
+	params := createParams(BatchTypeSignal)
+	params.Checkpoint = &HeartBeatDetails{
+		PageToken:     []byte("page-token"),
+		CurrentPage:   3,
+		TotalEstimate: 40,
+		SuccessCount:  29,
+		ErrorCount:    1,
+		Failures:      []WorkflowFailure{{WorkflowID: "failed-wid", RunID: "failed-rid", Error: "failed"}},
+	}
+	result, err := s.activityEnv.ExecuteActivity(BatchActivity, params)
+	s.NoError(err)
+	var hbd HeartBeatDetails
+	s.NoError(result.Get(&hbd))
+	s.Equal(4, hbd.CurrentPage)
+	s.Equal(int64(40), hbd.TotalEstimate)
+	s.Equal(30, hbd.SuccessCount)
+	s.Equal(1, hbd.ErrorCount)
+	s.Len(hbd.Failures, 1)
+}
+
 func (s *workflowSuite) TestWorkflow_BatchTypeCancelValidationError() {
 	params := createParams(BatchTypeCancel)
 	params.Query = ""
@@ -143,6 +234,14 @@ func (s *workflowSuite) TestWorkflow_BatchTypeSignalValidation() {
 	s.ErrorContains(s.workflowEnv.GetWorkflowError(), "must provide signal name")
 }
 
+func (s *workflowSuite) TestWorkflow_BatchTypeSignalReservedName() {
+	params := createParams(BatchTypeSignal)
+	params.SignalParams.SignalName = "__cadence_pause"
+	s.workflowEnv.ExecuteWorkflow(BatchWorkflow, params)
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.ErrorContains(s.workflowEnv.GetWorkflowError(), "signal name __cadence_pause is reserved")
+}
+
 func (s *workflowSuite) TestWorkflow_BatchTypeReplicateSourceCLusterValidation() {
 	params := createParams(BatchTypeReplicate)
 	params.ReplicateParams.SourceCluster = ""
@@ -159,6 +258,14 @@ func (s *workflowSuite) TestWorkflow_BatchTypeReplicateTargetCLusterValidation()
 	s.ErrorContains(s.workflowEnv.GetWorkflowError(), "must provide target cluster")
 }
 
+func (s *workflowSuite) TestWorkflow_BatchTypeUpdateSearchAttributesValidation() {
+	params := createParams(BatchTypeUpdateSearchAttributes)
+	params.UpdateSearchAttributesParams.SearchAttributes = nil
+	s.workflowEnv.ExecuteWorkflow(BatchWorkflow, params)
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.ErrorContains(s.workflowEnv.GetWorkflowError(), "must provide search attributes")
+}
+
 func (s *workflowSuite) TearDownTest() {
 	s.workflowEnv.AssertExpectations(s.T())
 }
@@ -182,6 +289,9 @@ func createParams(batchType string) BatchParams {
 			SourceCluster: "test-primary-cluster",
 			TargetCluster: "test-secondary-cluster",
 		},
+		UpdateSearchAttributesParams: UpdateSearchAttributesParams{
+			SearchAttributes: map[string][]byte{"CustomKeywordField": []byte(`"value"`)},
+		},
 		RPS:                      5,
 		Concurrency:              5,
 		PageSize:                 10,
@@ -189,6 +299,22 @@ func createParams(batchType string) BatchParams {
 		ActivityHeartBeatTimeout: 0,
 		MaxActivityRetries:       0,
 		NonRetryableErrors:       []string{"HeartbeatTimeoutError"},
+		MaxFailureReport:         DefaultMaxFailureReport,
 		_nonRetryableErrors:      nil,
 	}
 }
+
+func TestApplyLimits(t *testing.T) {
+	params := createParams(BatchTypeSignal)
+	params.RPS = 100
+	params.Concurrency = 10
+
+	limited := applyLimits(Config{
+		MaxRPS:         dynamicproperties.GetIntPropertyFilteredByDomain(50),
+		MaxConcurrency: dynamicproperties.GetIntPropertyFilteredByDomain(20),
+	}, params)
+	assert.Equal(t, 50, limited.RPS)
+	assert.Equal(t, 10, limited.Concurrency)
+
+	assert.Equal(t, params, applyLimits(Config{}, params))
+}
@@ -0,0 +1,249 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package dlqtriage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"go.uber.org/cadence/activity"
+	"go.uber.org/zap"
+
+	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// dlqMessage is a DLQ message together with the hydrated task, which is nil
+	// when the source cluster no longer has the workflow
+	dlqMessage struct {
+		info *types.ReplicationTaskInfo
+		task *types.ReplicationTask
+	}
+
+	runKey struct {
+		domainID   string
+		workflowID string
+		runID      string
+	}
+)
+
+// GetDLQShardsActivity returns the shards with messages in the replication DLQ
+func GetDLQShardsActivity(ctx context.Context) ([]DLQShard, error) {
+	triager := ctx.Value(triagerContextKey).(*Triager)
+	adminClient, err := triager.localAdminClient()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := adminClient.CountDLQMessages(ctx, &types.CountDLQMessagesRequest{ForceFetch: true})
+	if err != nil {
+		return nil, err
+	}
+
+	var shards []DLQShard
+	for key, count := range resp.History {
+		if count > 0 {
+			shards = append(shards, DLQShard{ShardID: key.ShardID, SourceCluster: key.SourceCluster})
+		}
+	}
+	sort.Slice(shards, func(i, j int) bool {
+		if shards[i].ShardID != shards[j].ShardID {
+			return shards[i].ShardID < shards[j].ShardID
+		}
+		return shards[i].SourceCluster < shards[j].SourceCluster
+	})
+	return shards, nil
+}
+
+// TriageShardActivity classifies the replication DLQ messages of a shard, backfills
+// missing history from the source cluster and merges the messages back in order.
+// The DLQ is triaged one page at a time and the progress is recorded in heartbeats,
+// a retried attempt resumes from it as the merged messages are no longer in the DLQ.
+func TriageShardActivity(ctx context.Context, params TriageShardParams) (*TriageShardResult, error) {
+	triager := ctx.Value(triagerContextKey).(*Triager)
+	logger := activity.GetLogger(ctx)
+	adminClient, err := triager.localAdminClient()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &TriageShardResult{DLQShard: params.DLQShard}
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, result); err != nil {
+			logger.Warn("Failed to get the progress of the previous attempt", zap.Error(err))
+			result = &TriageShardResult{DLQShard: params.DLQShard}
+		}
+	}
+
+	resent := make(map[runKey]struct{})
+	for {
+		dlqMessages, hasMore, err := readDLQMessages(ctx, adminClient, params)
+		if err != nil {
+			return nil, err
+		}
+		if len(dlqMessages) == 0 {
+			return result, nil
+		}
+		if dlqMessages[0].info.TaskID <= result.LastMergedTaskID {
+			return nil, fmt.Errorf("replication DLQ message %v was merged but is still in the DLQ", dlqMessages[0].info.TaskID)
+		}
+
+		messages := make([]Message, 0, len(dlqMessages))
+		for _, dlqMessage := range dlqMessages {
+			info := dlqMessage.info
+			message := Message{
+				TaskID:     info.TaskID,
+				DomainID:   info.DomainID,
+				WorkflowID: info.WorkflowID,
+				RunID:      info.RunID,
+			}
+			message.Category, err = triager.classify(ctx, dlqMessage)
+			if err != nil {
+				message.Category = CategoryTransient
+				message.Error = err.Error()
+			}
+
+			key := runKey{domainID: info.DomainID, workflowID: info.WorkflowID, runID: info.RunID}
+			if _, ok := resent[key]; message.Category == CategoryMissingHistory && !ok {
+				if err := resendHistory(ctx, adminClient, params.SourceCluster, info); err != nil {
+					logger.Warn("Failed to resend replication tasks", zap.String("workflowID", info.WorkflowID), zap.Error(err))
+					message.Error = err.Error()
+				} else {
+					resent[key] = struct{}{}
+					result.Resent++
+				}
+			}
+			messages = append(messages, message)
+			activity.RecordHeartbeat(ctx, result)
+		}
+
+		// Merge one message at a time so a message that still fails stays in the DLQ
+		// together with everything after it, preserving the replication order.
+		for i := range messages {
+			_, err := adminClient.MergeDLQMessages(ctx, &types.MergeDLQMessagesRequest{
+				Type:                  types.DLQTypeReplication.Ptr(),
+				ShardID:               params.ShardID,
+				SourceCluster:         params.SourceCluster,
+				InclusiveEndMessageID: common.Int64Ptr(messages[i].TaskID),
+				MaximumPageSize:       1,
+			})
+			if err != nil {
+				messages[i].Error = err.Error()
+				result.Remaining = messages[i:]
+				return result, nil
+			}
+			if messages[i].Category == CategoryWorkflowDeleted {
+				result.addDropped(messages[i])
+			} else {
+				result.Merged++
+			}
+			result.LastMergedTaskID = messages[i].TaskID
+			activity.RecordHeartbeat(ctx, result)
+		}
+
+		if !hasMore {
+			return result, nil
+		}
+	}
+}
+
+// readDLQMessages reads the first page of the replication DLQ of the shard, and returns whether it has more messages
+func readDLQMessages(ctx context.Context, adminClient admin.Client, params TriageShardParams) ([]dlqMessage, bool, error) {
+	resp, err := adminClient.ReadDLQMessages(ctx, &types.ReadDLQMessagesRequest{
+		Type:                  types.DLQTypeReplication.Ptr(),
+		ShardID:               params.ShardID,
+		SourceCluster:         params.SourceCluster,
+		InclusiveEndMessageID: common.Int64Ptr(constants.InclusiveEndMessageID),
+		MaximumPageSize:       int32(params.PageSize),
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	tasks := make(map[int64]*types.ReplicationTask, len(resp.ReplicationTasks))
+	for _, task := range resp.ReplicationTasks {
+		tasks[task.SourceTaskID] = task
+	}
+	messages := make([]dlqMessage, 0, len(resp.ReplicationTasksInfo))
+	for _, info := range resp.ReplicationTasksInfo {
+		messages = append(messages, dlqMessage{info: info, task: tasks[info.TaskID]})
+	}
+	return messages, len(resp.NextPageToken) > 0, nil
+}
+
+func (t *Triager) classify(ctx context.Context, message dlqMessage) (Category, error) {
+	if message.task == nil {
+		return CategoryWorkflowDeleted, nil
+	}
+
+	info := message.info
+	resp, err := t.clientBean.GetHistoryClient().GetMutableState(ctx, &types.GetMutableStateRequest{
+		DomainUUID: info.DomainID,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: info.WorkflowID,
+			RunID:      info.RunID,
+		},
+	})
+	var notExists *types.EntityNotExistsError
+	switch {
+	case errors.As(err, &notExists):
+		if info.TaskType == persistence.ReplicationTaskTypeHistory && info.FirstEventID <= constants.FirstEventID {
+			return CategoryTransient, nil
+		}
+		return CategoryMissingHistory, nil
+	case err != nil:
+		return "", err
+	}
+
+	switch info.TaskType {
+	case persistence.ReplicationTaskTypeHistory:
+		if resp.NextEventID < info.FirstEventID {
+			return CategoryMissingHistory, nil
+		}
+	case persistence.ReplicationTaskTypeSyncActivity:
+		if resp.NextEventID <= info.ScheduledID {
+			return CategoryMissingHistory, nil
+		}
+	}
+	return CategoryTransient, nil
+}
+
+func resendHistory(ctx context.Context, adminClient admin.Client, sourceCluster string, info *types.ReplicationTaskInfo) error {
+	endEventID := info.FirstEventID
+	if info.TaskType == persistence.ReplicationTaskTypeSyncActivity {
+		endEventID = info.ScheduledID + 1
+	}
+	return adminClient.ResendReplicationTasks(ctx, &types.ResendReplicationTasksRequest{
+		DomainID:      info.DomainID,
+		WorkflowID:    info.WorkflowID,
+		RunID:         info.RunID,
+		RemoteCluster: sourceCluster,
+		EndEventID:    common.Int64Ptr(endEventID),
+		EndVersion:    common.Int64Ptr(info.Version),
+	})
+}
@@ -0,0 +1,233 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package dlqtriage
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/resource"
+	"github.com/uber/cadence/common/types"
+)
+
+type activitiesTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+	activityEnv  *testsuite.TestActivityEnvironment
+	mockResource *resource.Test
+}
+
+func TestActivitiesTestSuite(t *testing.T) {
+	suite.Run(t, new(activitiesTestSuite))
+}
+
+func (s *activitiesTestSuite) SetupTest() {
+	controller := gomock.NewController(s.T())
+	s.mockResource = resource.NewTest(s.T(), controller, metrics.Worker)
+	triager := &Triager{
+		cfg:        Config{ClusterMetadata: s.mockResource.ClusterMetadata},
+		clientBean: s.mockResource.ClientBean,
+	}
+
+	s.activityEnv = s.NewTestActivityEnvironment()
+	s.activityEnv.RegisterActivityWithOptions(GetDLQShardsActivity, activity.RegisterOptions{Name: getDLQShardsActivityName})
+	s.activityEnv.RegisterActivityWithOptions(TriageShardActivity, activity.RegisterOptions{Name: triageShardActivityName})
+	s.activityEnv.SetTestTimeout(time.Second * 5)
+	s.activityEnv.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), triagerContextKey, triager),
+	})
+}
+
+func (s *activitiesTestSuite) TearDownTest() {
+	s.mockResource.Finish(s.T())
+}
+
+func (s *activitiesTestSuite) TestGetDLQShardsActivity() {
+	s.mockResource.RemoteAdminClient.EXPECT().CountDLQMessages(gomock.Any(), &types.CountDLQMessagesRequest{ForceFetch: true}).
+		Return(&types.CountDLQMessagesResponse{
+			History: map[types.HistoryDLQCountKey]int64{
+				{ShardID: 3, SourceCluster: "c2"}: 1,
+				{ShardID: 1, SourceCluster: "c3"}: 4,
+				{ShardID: 1, SourceCluster: "c2"}: 2,
+				{ShardID: 2, SourceCluster: "c2"}: 0,
+			},
+		}, nil)
+
+	val, err := s.activityEnv.ExecuteActivity(getDLQShardsActivityName)
+	s.NoError(err)
+	var shards []DLQShard
+	s.NoError(val.Get(&shards))
+	s.Equal([]DLQShard{
+		{ShardID: 1, SourceCluster: "c2"},
+		{ShardID: 1, SourceCluster: "c3"},
+		{ShardID: 3, SourceCluster: "c2"},
+	}, shards)
+}
+
+func (s *activitiesTestSuite) TestTriageShardActivity() {
+	params := TriageShardParams{DLQShard: DLQShard{ShardID: 1, SourceCluster: "c2"}, PageSize: 2}
+	deleted := &types.ReplicationTaskInfo{DomainID: "d", WorkflowID: "w1", RunID: "r1", TaskType: persistence.ReplicationTaskTypeHistory, TaskID: 10, FirstEventID: 5}
+	missing := &types.ReplicationTaskInfo{DomainID: "d", WorkflowID: "w2", RunID: "r2", TaskType: persistence.ReplicationTaskTypeHistory, TaskID: 11, Version: 7, FirstEventID: 20}
+	transient := &types.ReplicationTaskInfo{DomainID: "d", WorkflowID: "w3", RunID: "r3", TaskType: persistence.ReplicationTaskTypeSyncActivity, TaskID: 12, ScheduledID: 5}
+
+	// every page is read from the head of the DLQ, as the messages of the previous page were merged
+	gomock.InOrder(
+		s.expectRead(params, []byte("token"), []*types.ReplicationTaskInfo{deleted, missing}, missing),
+		s.expectRead(params, nil, []*types.ReplicationTaskInfo{transient}, transient),
+	)
+	s.mockResource.HistoryClient.EXPECT().GetMutableState(gomock.Any(), s.mutableStateRequest(missing)).
+		Return(nil, &types.EntityNotExistsError{})
+	s.mockResource.HistoryClient.EXPECT().GetMutableState(gomock.Any(), s.mutableStateRequest(transient)).
+		Return(&types.GetMutableStateResponse{NextEventID: 10}, nil)
+	s.mockResource.RemoteAdminClient.EXPECT().ResendReplicationTasks(gomock.Any(), &types.ResendReplicationTasksRequest{
+		DomainID:      "d",
+		WorkflowID:    "w2",
+		RunID:         "r2",
+		RemoteCluster: "c2",
+		EndEventID:    common.Int64Ptr(20),
+		EndVersion:    common.Int64Ptr(7),
+	}).Return(nil)
+	for _, taskID := range []int64{10, 11, 12} {
+		s.expectMerge(params, taskID, nil)
+	}
+
+	val, err := s.activityEnv.ExecuteActivity(triageShardActivityName, params)
+	s.NoError(err)
+	var result TriageShardResult
+	s.NoError(val.Get(&result))
+	s.Equal(TriageShardResult{
+		DLQShard:         params.DLQShard,
+		Merged:           2,
+		Dropped:          1,
+		Resent:           1,
+		DroppedMessages:  []Message{{TaskID: 10, DomainID: "d", WorkflowID: "w1", RunID: "r1", Category: CategoryWorkflowDeleted}},
+		LastMergedTaskID: 12,
+	}, result)
+}
+
+func (s *activitiesTestSuite) TestTriageShardActivity_ResumesFromHeartbeat() {
+	params := TriageShardParams{DLQShard: DLQShard{ShardID: 1, SourceCluster: "c2"}, PageSize: 10}
+	transient := &types.ReplicationTaskInfo{DomainID: "d", WorkflowID: "w3", RunID: "r3", TaskType: persistence.ReplicationTaskTypeSyncActivity, TaskID: 12, ScheduledID: 5}
+	s.activityEnv.SetHeartbeatDetails(&TriageShardResult{DLQShard: params.DLQShard, Merged: 3, LastMergedTaskID: 11})
+
+	s.expectRead(params, nil, []*types.ReplicationTaskInfo{transient}, transient)
+	s.mockResource.HistoryClient.EXPECT().GetMutableState(gomock.Any(), s.mutableStateRequest(transient)).
+		Return(&types.GetMutableStateResponse{NextEventID: 10}, nil)
+	s.expectMerge(params, 12, nil)
+
+	val, err := s.activityEnv.ExecuteActivity(triageShardActivityName, params)
+	s.NoError(err)
+	var result TriageShardResult
+	s.NoError(val.Get(&result))
+	s.Equal(TriageShardResult{DLQShard: params.DLQShard, Merged: 4, LastMergedTaskID: 12}, result)
+}
+
+func (s *activitiesTestSuite) TestTriageShardActivity_MergedMessageNotRemoved() {
+	params := TriageShardParams{DLQShard: DLQShard{ShardID: 1, SourceCluster: "c2"}, PageSize: 10}
+	merged := &types.ReplicationTaskInfo{DomainID: "d", WorkflowID: "w3", RunID: "r3", TaskType: persistence.ReplicationTaskTypeSyncActivity, TaskID: 12, ScheduledID: 5}
+	s.activityEnv.SetHeartbeatDetails(&TriageShardResult{DLQShard: params.DLQShard, Merged: 1, LastMergedTaskID: 12})
+
+	s.expectRead(params, nil, []*types.ReplicationTaskInfo{merged}, merged)
+
+	_, err := s.activityEnv.ExecuteActivity(triageShardActivityName, params)
+	s.Error(err)
+}
+
+func (s *activitiesTestSuite) TestTriageShardActivity_MergeFailure() {
+	params := TriageShardParams{DLQShard: DLQShard{ShardID: 1, SourceCluster: "c2"}, PageSize: 10}
+	first := &types.ReplicationTaskInfo{DomainID: "d", WorkflowID: "w1", RunID: "r1", TaskType: persistence.ReplicationTaskTypeHistory, TaskID: 10, FirstEventID: 5}
+	second := &types.ReplicationTaskInfo{DomainID: "d", WorkflowID: "w1", RunID: "r1", TaskType: persistence.ReplicationTaskTypeHistory, TaskID: 11, FirstEventID: 8}
+
+	s.expectRead(params, nil, []*types.ReplicationTaskInfo{first, second}, first, second)
+	s.mockResource.HistoryClient.EXPECT().GetMutableState(gomock.Any(), s.mutableStateRequest(first)).
+		Return(&types.GetMutableStateResponse{NextEventID: 8}, nil).Times(2)
+	s.expectMerge(params, 10, nil)
+	s.expectMerge(params, 11, errors.New("mockErr"))
+
+	val, err := s.activityEnv.ExecuteActivity(triageShardActivityName, params)
+	s.NoError(err)
+	var result TriageShardResult
+	s.NoError(val.Get(&result))
+	s.Equal(1, result.Merged)
+	s.Equal([]Message{{TaskID: 11, DomainID: "d", WorkflowID: "w1", RunID: "r1", Category: CategoryTransient, Error: "mockErr"}}, result.Remaining)
+}
+
+func (s *activitiesTestSuite) TestTriageShardActivity_ReadError() {
+	params := TriageShardParams{DLQShard: DLQShard{ShardID: 1, SourceCluster: "c2"}, PageSize: 10}
+	s.mockResource.RemoteAdminClient.EXPECT().ReadDLQMessages(gomock.Any(), gomock.Any()).Return(nil, errors.New("mockErr"))
+
+	_, err := s.activityEnv.ExecuteActivity(triageShardActivityName, params)
+	s.Error(err)
+}
+
+func (s *activitiesTestSuite) expectRead(
+	params TriageShardParams,
+	nextPageToken []byte,
+	infos []*types.ReplicationTaskInfo,
+	hydrated ...*types.ReplicationTaskInfo,
+) *gomock.Call {
+	tasks := make([]*types.ReplicationTask, 0, len(hydrated))
+	for _, info := range hydrated {
+		tasks = append(tasks, &types.ReplicationTask{SourceTaskID: info.TaskID})
+	}
+	return s.mockResource.RemoteAdminClient.EXPECT().ReadDLQMessages(gomock.Any(), &types.ReadDLQMessagesRequest{
+		Type:                  types.DLQTypeReplication.Ptr(),
+		ShardID:               params.ShardID,
+		SourceCluster:         params.SourceCluster,
+		InclusiveEndMessageID: common.Int64Ptr(constants.InclusiveEndMessageID),
+		MaximumPageSize:       int32(params.PageSize),
+	}).Return(&types.ReadDLQMessagesResponse{
+		ReplicationTasks:     tasks,
+		ReplicationTasksInfo: infos,
+		NextPageToken:        nextPageToken,
+	}, nil)
+}
+
+func (s *activitiesTestSuite) expectMerge(params TriageShardParams, taskID int64, err error) {
+	s.mockResource.RemoteAdminClient.EXPECT().MergeDLQMessages(gomock.Any(), &types.MergeDLQMessagesRequest{
+		Type:                  types.DLQTypeReplication.Ptr(),
+		ShardID:               params.ShardID,
+		SourceCluster:         params.SourceCluster,
+		InclusiveEndMessageID: common.Int64Ptr(taskID),
+		MaximumPageSize:       1,
+	}).Return(&types.MergeDLQMessagesResponse{}, err)
+}
+
+func (s *activitiesTestSuite) mutableStateRequest(info *types.ReplicationTaskInfo) *types.GetMutableStateRequest {
+	return &types.GetMutableStateRequest{
+		DomainUUID: info.DomainID,
+		Execution:  &types.WorkflowExecution{WorkflowID: info.WorkflowID, RunID: info.RunID},
+	}
+}
@@ -0,0 +1,148 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package dlqtriage
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/uber-go/tally"
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/cadence/.gen/go/shared"
+	"go.uber.org/cadence/activity"
+	cclient "go.uber.org/cadence/client"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/resource"
+	"github.com/uber/cadence/service/worker/workercommon"
+)
+
+type (
+	// Config defines the configuration for replication DLQ triage
+	Config struct {
+		// ClusterMetadata contains the metadata for this cluster
+		ClusterMetadata cluster.Metadata
+	}
+
+	// BootstrapParams contains the set of params needed to bootstrap
+	// replication DLQ triage
+	BootstrapParams struct {
+		// Config contains the configuration for replication DLQ triage
+		Config Config
+		// ServiceClient is an instance of cadence service client
+		ServiceClient workflowserviceclient.Interface
+		// MetricsClient is an instance of metrics object for emitting stats
+		MetricsClient metrics.Client
+		Logger        log.Logger
+		// TallyScope is an instance of tally metrics scope
+		TallyScope tally.Scope
+		// ClientBean is an instance of client.Bean for a collection of clients
+		ClientBean client.Bean
+		// Resource is used to start the cron workflow with the public client
+		Resource resource.Resource
+	}
+
+	// Triager periodically replays and cleans up the replication DLQ of this cluster
+	Triager struct {
+		cfg           Config
+		svcClient     workflowserviceclient.Interface
+		clientBean    client.Bean
+		metricsClient metrics.Client
+		tallyScope    tally.Scope
+		logger        log.Logger
+		resource      resource.Resource
+		worker        worker.Worker
+	}
+)
+
+const (
+	startUpDelay = 10 * time.Second
+)
+
+var startWorkflowOptions = cclient.StartWorkflowOptions{
+	ID:                           WorkflowID,
+	TaskList:                     TaskListName,
+	ExecutionStartToCloseTimeout: 55 * time.Minute,
+	CronSchedule:                 "0 * * * *",
+}
+
+// New returns a new instance of Triager
+func New(params *BootstrapParams) *Triager {
+	return &Triager{
+		cfg:           params.Config,
+		svcClient:     params.ServiceClient,
+		clientBean:    params.ClientBean,
+		metricsClient: params.MetricsClient,
+		tallyScope:    params.TallyScope,
+		logger:        params.Logger.WithTags(tag.ComponentReplicationDLQTriage),
+		resource:      params.Resource,
+	}
+}
+
+// Start starts the worker and the triage cron workflow
+func (t *Triager) Start() error {
+	ctx := context.WithValue(context.Background(), triagerContextKey, t)
+	workerOpts := worker.Options{
+		MetricsScope:              t.tallyScope,
+		BackgroundActivityContext: ctx,
+		Tracer:                    opentracing.GlobalTracer(),
+	}
+	triageWorker := worker.New(t.svcClient, constants.SystemLocalDomainName, TaskListName, workerOpts)
+	triageWorker.RegisterWorkflowWithOptions(TriageWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	triageWorker.RegisterActivityWithOptions(GetDLQShardsActivity, activity.RegisterOptions{Name: getDLQShardsActivityName})
+	triageWorker.RegisterActivityWithOptions(TriageShardActivity, activity.RegisterOptions{Name: triageShardActivityName})
+	t.worker = triageWorker
+	if err := triageWorker.Start(); err != nil {
+		return err
+	}
+
+	go workercommon.StartWorkflowWithRetry(WorkflowTypeName, startUpDelay, t.resource, func(client cclient.Client) error {
+		_, err := client.StartWorkflow(context.Background(), startWorkflowOptions, WorkflowTypeName, &Params{})
+		switch err.(type) {
+		case *shared.WorkflowExecutionAlreadyStartedError:
+			return nil
+		default:
+			t.logger.Error("Failed to start replication DLQ triage workflow", tag.Error(err))
+			return err
+		}
+	})
+	return nil
+}
+
+// Stop stops the worker
+func (t *Triager) Stop() {
+	t.worker.Stop()
+}
+
+func (t *Triager) localAdminClient() (admin.Client, error) {
+	return t.clientBean.GetRemoteAdminClient(t.cfg.ClusterMetadata.GetCurrentClusterName())
+}
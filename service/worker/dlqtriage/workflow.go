@@ -0,0 +1,256 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package dlqtriage
+
+import (
+	"time"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/workflow"
+)
+
+type (
+	contextKey string
+
+	// Category describes why a replication task ended up in the DLQ
+	Category string
+
+	// Params is the input of the triage workflow
+	Params struct {
+		// MaxAttempts is the number of rounds a shard is triaged before it is reported as unresolved
+		MaxAttempts int
+		// RetryInterval is the initial backoff between rounds, doubled after every round
+		RetryInterval time.Duration
+		// PageSize is the page size used to read the DLQ
+		PageSize int
+	}
+
+	// DLQShard identifies a shard's replication DLQ for one source cluster
+	DLQShard struct {
+		ShardID       int32
+		SourceCluster string
+	}
+
+	// TriageShardParams is the input of the triage shard activity
+	TriageShardParams struct {
+		DLQShard
+		PageSize int
+	}
+
+	// Message is a replication DLQ message along with its classification
+	Message struct {
+		TaskID     int64
+		DomainID   string
+		WorkflowID string
+		RunID      string
+		Category   Category
+		Error      string `json:",omitempty"`
+	}
+
+	// TriageShardResult is the outcome of triaging a shard's replication DLQ
+	TriageShardResult struct {
+		DLQShard
+		Merged  int
+		Dropped int
+		Resent  int
+		// DroppedMessages contains the first maxDroppedMessages messages purged as their workflow was deleted
+		DroppedMessages []Message `json:",omitempty"`
+		// LastMergedTaskID is the last message merged or purged from the DLQ
+		LastMergedTaskID int64 `json:",omitempty"`
+		// Remaining contains the messages still in the DLQ after triage
+		Remaining []Message `json:",omitempty"`
+		// Error is set when the shard could not be triaged at all
+		Error string `json:",omitempty"`
+	}
+
+	// Report is the result of the triage workflow
+	Report struct {
+		Shards  int
+		Merged  int
+		Dropped int
+		Resent  int
+		// DroppedMessages contains the first maxDroppedMessages messages purged as their workflow was deleted
+		DroppedMessages []Message `json:",omitempty"`
+		// Unresolved contains the shards that still have messages after all attempts
+		Unresolved []TriageShardResult `json:",omitempty"`
+	}
+)
+
+const (
+	triagerContextKey contextKey = "replicationDLQTriageContext"
+	// TaskListName tasklist
+	TaskListName = "cadence-sys-replication-dlq-triage-tasklist"
+	// WorkflowTypeName workflow type name
+	WorkflowTypeName = "cadence-sys-replication-dlq-triage-workflow"
+	// WorkflowID is the id of the triage cron workflow
+	WorkflowID = "cadence-sys-replication-dlq-triage"
+	// QueryType for the triage report
+	QueryType = "report"
+
+	getDLQShardsActivityName = "cadence-sys-replication-dlq-triage-get-shards-activity"
+	triageShardActivityName  = "cadence-sys-replication-dlq-triage-shard-activity"
+
+	defaultMaxAttempts   = 5
+	defaultRetryInterval = 30 * time.Second
+	maxRetryInterval     = 10 * time.Minute
+	defaultPageSize      = 100
+	// maxDroppedMessages bounds the purged messages listed in a result to keep it small
+	maxDroppedMessages = 1000
+
+	// CategoryMissingHistory means the workflow exists locally but misses the events preceding the task
+	CategoryMissingHistory Category = "missing-history"
+	// CategoryWorkflowDeleted means the workflow no longer exists in the source cluster
+	CategoryWorkflowDeleted Category = "workflow-deleted"
+	// CategoryTransient means the task failed for a reason expected to go away on replay
+	CategoryTransient Category = "transient"
+)
+
+// TriageWorkflow replays the replication DLQ of every shard, backfilling missing history
+// and dropping messages of deleted workflows, and reports what could not be resolved
+func TriageWorkflow(ctx workflow.Context, params *Params) (*Report, error) {
+	params = withDefaults(params)
+	report := &Report{}
+	if err := workflow.SetQueryHandler(ctx, QueryType, func() (*Report, error) {
+		return report, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	var shards []DLQShard
+	ao := workflow.WithActivityOptions(ctx, getDLQShardsActivityOptions())
+	if err := workflow.ExecuteActivity(ao, getDLQShardsActivityName).Get(ctx, &shards); err != nil {
+		return nil, err
+	}
+	report.Shards = len(shards)
+
+	ao = workflow.WithActivityOptions(ctx, getTriageShardActivityOptions())
+	pending := shards
+	for attempt := 1; len(pending) > 0; attempt++ {
+		futures := make([]workflow.Future, 0, len(pending))
+		for _, shard := range pending {
+			futures = append(futures, workflow.ExecuteActivity(ao, triageShardActivityName, TriageShardParams{
+				DLQShard: shard,
+				PageSize: params.PageSize,
+			}))
+		}
+
+		var unresolved []TriageShardResult
+		var next []DLQShard
+		for i, future := range futures {
+			var result TriageShardResult
+			if err := future.Get(ctx, &result); err != nil {
+				result = TriageShardResult{DLQShard: pending[i], Error: err.Error()}
+			}
+			report.Merged += result.Merged
+			report.Dropped += result.Dropped
+			report.Resent += result.Resent
+			report.DroppedMessages = appendDropped(report.DroppedMessages, result.DroppedMessages...)
+			if result.Error != "" || len(result.Remaining) > 0 {
+				unresolved = append(unresolved, result)
+				next = append(next, pending[i])
+			}
+		}
+		report.Unresolved = unresolved
+
+		if attempt >= params.MaxAttempts || len(next) == 0 {
+			break
+		}
+		if err := workflow.Sleep(ctx, backoffInterval(params.RetryInterval, attempt)); err != nil {
+			return nil, err
+		}
+		pending = next
+	}
+
+	if len(report.Unresolved) > 0 {
+		workflow.GetLogger(ctx).Warn("Replication DLQ triage left unresolved messages")
+	}
+	return report, nil
+}
+
+func (r *TriageShardResult) addDropped(message Message) {
+	r.Dropped++
+	r.DroppedMessages = appendDropped(r.DroppedMessages, message)
+}
+
+func appendDropped(dropped []Message, messages ...Message) []Message {
+	if available := maxDroppedMessages - len(dropped); len(messages) > available {
+		messages = messages[:max(available, 0)]
+	}
+	return append(dropped, messages...)
+}
+
+func withDefaults(params *Params) *Params {
+	result := Params{}
+	if params != nil {
+		result = *params
+	}
+	if result.MaxAttempts <= 0 {
+		result.MaxAttempts = defaultMaxAttempts
+	}
+	if result.RetryInterval <= 0 {
+		result.RetryInterval = defaultRetryInterval
+	}
+	if result.PageSize <= 0 {
+		result.PageSize = defaultPageSize
+	}
+	return &result
+}
+
+func backoffInterval(initial time.Duration, attempt int) time.Duration {
+	interval := initial
+	for i := 1; i < attempt && interval < maxRetryInterval; i++ {
+		interval *= 2
+	}
+	if interval > maxRetryInterval {
+		return maxRetryInterval
+	}
+	return interval
+}
+
+func getDLQShardsActivityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		ScheduleToStartTimeout: 10 * time.Second,
+		StartToCloseTimeout:    30 * time.Second,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:    2 * time.Second,
+			BackoffCoefficient: 2,
+			MaximumInterval:    1 * time.Minute,
+			ExpirationInterval: 5 * time.Minute,
+		},
+	}
+}
+
+func getTriageShardActivityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		ScheduleToStartTimeout: 1 * time.Minute,
+		StartToCloseTimeout:    1 * time.Hour,
+		HeartbeatTimeout:       1 * time.Minute,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:    10 * time.Second,
+			BackoffCoefficient: 2,
+			MaximumInterval:    1 * time.Minute,
+			ExpirationInterval: 1 * time.Hour,
+			MaximumAttempts:    3,
+		},
+	}
+}
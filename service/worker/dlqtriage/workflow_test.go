@@ -0,0 +1,130 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package dlqtriage
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/workflow"
+)
+
+type workflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+	workflowEnv *testsuite.TestWorkflowEnvironment
+}
+
+func TestWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(workflowTestSuite))
+}
+
+func (s *workflowTestSuite) SetupTest() {
+	s.workflowEnv = s.NewTestWorkflowEnvironment()
+	s.workflowEnv.RegisterWorkflowWithOptions(TriageWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	s.workflowEnv.RegisterActivityWithOptions(GetDLQShardsActivity, activity.RegisterOptions{Name: getDLQShardsActivityName})
+	s.workflowEnv.RegisterActivityWithOptions(TriageShardActivity, activity.RegisterOptions{Name: triageShardActivityName})
+}
+
+func (s *workflowTestSuite) TearDownTest() {
+	s.workflowEnv.AssertExpectations(s.T())
+}
+
+func (s *workflowTestSuite) TestWorkflow_GetDLQShardsError() {
+	s.workflowEnv.OnActivity(getDLQShardsActivityName, mock.Anything).Return(nil, errors.New("mockErr"))
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &Params{})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.Equal("mockErr", s.workflowEnv.GetWorkflowError().Error())
+}
+
+func (s *workflowTestSuite) TestWorkflow_EmptyDLQ() {
+	s.workflowEnv.OnActivity(getDLQShardsActivityName, mock.Anything).Return([]DLQShard{}, nil)
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &Params{})
+	var report Report
+	s.NoError(s.workflowEnv.GetWorkflowResult(&report))
+	s.Equal(Report{}, report)
+}
+
+func (s *workflowTestSuite) TestWorkflow_Resolved() {
+	shard := DLQShard{ShardID: 1, SourceCluster: "c2"}
+	dropped := []Message{{TaskID: 10, DomainID: "d", WorkflowID: "wid", RunID: "rid", Category: CategoryWorkflowDeleted}}
+	s.workflowEnv.OnActivity(getDLQShardsActivityName, mock.Anything).Return([]DLQShard{shard}, nil)
+	s.workflowEnv.OnActivity(triageShardActivityName, mock.Anything, TriageShardParams{DLQShard: shard, PageSize: defaultPageSize}).
+		Return(&TriageShardResult{DLQShard: shard, Merged: 2, Dropped: 1, Resent: 1, DroppedMessages: dropped}, nil).Once()
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &Params{})
+	var report Report
+	s.NoError(s.workflowEnv.GetWorkflowResult(&report))
+	s.Equal(Report{Shards: 1, Merged: 2, Dropped: 1, Resent: 1, DroppedMessages: dropped}, report)
+}
+
+func (s *workflowTestSuite) TestWorkflow_RetriesUnresolvedShards() {
+	resolved := DLQShard{ShardID: 1, SourceCluster: "c2"}
+	stuck := DLQShard{ShardID: 2, SourceCluster: "c2"}
+	remaining := []Message{{TaskID: 10, DomainID: "d", WorkflowID: "wid", RunID: "rid", Category: CategoryTransient, Error: "mockErr"}}
+	s.workflowEnv.OnActivity(getDLQShardsActivityName, mock.Anything).Return([]DLQShard{resolved, stuck}, nil)
+	s.workflowEnv.OnActivity(triageShardActivityName, mock.Anything, TriageShardParams{DLQShard: resolved, PageSize: 10}).
+		Return(&TriageShardResult{DLQShard: resolved, Merged: 1}, nil).Once()
+	s.workflowEnv.OnActivity(triageShardActivityName, mock.Anything, TriageShardParams{DLQShard: stuck, PageSize: 10}).
+		Return(&TriageShardResult{DLQShard: stuck, Remaining: remaining}, nil).Times(2)
+	// retried by the retry policy of the activity until it gives up
+	s.workflowEnv.OnActivity(triageShardActivityName, mock.Anything, TriageShardParams{DLQShard: stuck, PageSize: 10}).
+		Return(nil, errors.New("mockErr"))
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &Params{MaxAttempts: 3, RetryInterval: time.Second, PageSize: 10})
+	var report Report
+	s.NoError(s.workflowEnv.GetWorkflowResult(&report))
+	s.Equal(2, report.Shards)
+	s.Equal(1, report.Merged)
+	s.Len(report.Unresolved, 1)
+	s.Equal(stuck, report.Unresolved[0].DLQShard)
+	s.Contains(report.Unresolved[0].Error, "mockErr")
+}
+
+func (s *workflowTestSuite) TestWithDefaults() {
+	s.Equal(&Params{MaxAttempts: defaultMaxAttempts, RetryInterval: defaultRetryInterval, PageSize: defaultPageSize}, withDefaults(nil))
+	params := &Params{MaxAttempts: 1, RetryInterval: time.Second, PageSize: 5}
+	s.Equal(params, withDefaults(params))
+}
+
+func (s *workflowTestSuite) TestAppendDropped() {
+	messages := make([]Message, maxDroppedMessages+1)
+	for i := range messages {
+		messages[i].TaskID = int64(i)
+	}
+	dropped := appendDropped(nil, messages[:2]...)
+	s.Equal(messages[:2], dropped)
+	dropped = appendDropped(dropped, messages[2:]...)
+	s.Equal(messages[:maxDroppedMessages], dropped)
+	s.Equal(messages[:maxDroppedMessages], appendDropped(dropped, messages[0]))
+}
+
+func (s *workflowTestSuite) TestBackoffInterval() {
+	s.Equal(time.Minute, backoffInterval(time.Minute, 1))
+	s.Equal(4*time.Minute, backoffInterval(time.Minute, 3))
+	s.Equal(maxRetryInterval, backoffInterval(time.Minute, 10))
+}
@@ -22,6 +22,7 @@ package failovermanager
 
 import (
 	"context"
+	"time"
 
 	"github.com/opentracing/opentracing-go"
 	"github.com/uber-go/tally"
@@ -31,14 +32,20 @@ import (
 	"go.uber.org/cadence/workflow"
 
 	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/failoverreadiness"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 )
 
+// readinessStatusCacheTTL is how long the replication status read by a readiness check is reused by the checks of
+// the next batches, it is longer than the default wait between batches
+const readinessStatusCacheTTL = time.Minute
+
 type (
 
 	// Config defines the configuration for failover
@@ -73,6 +80,7 @@ type (
 		tallyScope    tally.Scope
 		logger        log.Logger
 		worker        worker.Worker
+		statusCache   *failoverreadiness.StatusCache
 	}
 )
 
@@ -85,6 +93,7 @@ func New(params *BootstrapParams) *FailoverManager {
 		tallyScope:    params.TallyScope,
 		logger:        params.Logger.WithTags(tag.ComponentBatcher),
 		clientBean:    params.ClientBean,
+		statusCache:   failoverreadiness.NewStatusCache(readinessStatusCacheTTL, clock.NewRealTimeSource()),
 	}
 }
 
@@ -100,6 +109,7 @@ func (s *FailoverManager) Start() error {
 	failoverWorker.RegisterWorkflowWithOptions(FailoverWorkflow, workflow.RegisterOptions{Name: FailoverWorkflowTypeName})
 	failoverWorker.RegisterWorkflowWithOptions(RebalanceWorkflow, workflow.RegisterOptions{Name: RebalanceWorkflowTypeName})
 	failoverWorker.RegisterActivityWithOptions(FailoverActivity, activity.RegisterOptions{Name: failoverActivityName})
+	failoverWorker.RegisterActivityWithOptions(FailoverReadinessActivity, activity.RegisterOptions{Name: failoverReadinessActivityName})
 	failoverWorker.RegisterActivityWithOptions(GetDomainsActivity, activity.RegisterOptions{Name: getDomainsActivityName})
 	failoverWorker.RegisterActivityWithOptions(GetDomainsForRebalanceActivity, activity.RegisterOptions{Name: getRebalanceDomainsActivityName})
 	s.worker = failoverWorker
@@ -36,6 +36,7 @@ import (
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/failoverreadiness"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -56,6 +57,7 @@ const (
 	RebalanceWorkflowID             = "cadence-rebalance-workflow"
 	DrillWorkflowID                 = FailoverWorkflowID + "-drill"
 	failoverActivityName            = "cadence-sys-failover-activity"
+	failoverReadinessActivityName   = "cadence-sys-failover-readiness-activity"
 	getDomainsActivityName          = "cadence-sys-getDomains-activity"
 	getRebalanceDomainsActivityName = "cadence-sys-getRebalanceDomains-activity"
 
@@ -107,6 +109,8 @@ type (
 		DrillWaitTime time.Duration
 		// GracefulFailoverTimeoutInSeconds
 		GracefulFailoverTimeoutInSeconds *int32
+		// ReadinessCheck if set, domains whose target cluster has not caught up with the source cluster are not failed over
+		ReadinessCheck *failoverreadiness.Options `json:",omitempty"`
 	}
 
 	// FailoverResult is workflow result
@@ -131,6 +135,20 @@ type (
 		GracefulFailoverTimeoutInSeconds *int32
 	}
 
+	// FailoverReadinessActivityParams params for activity
+	FailoverReadinessActivityParams struct {
+		Domains       []string
+		SourceCluster string
+		TargetCluster string
+		Options       failoverreadiness.Options
+	}
+
+	// FailoverReadinessActivityResult result for failover readiness activity
+	FailoverReadinessActivityResult struct {
+		ReadyDomains    []string
+		NotReadyDomains []string
+	}
+
 	// FailoverActivityResult result for failover activity
 	FailoverActivityResult struct {
 		SuccessDomains []string
@@ -252,27 +270,48 @@ func failoverDomainsByBatch(
 	batchSize := params.BatchFailoverSize
 	times := totalNumOfDomains/batchSize + 1
 	ao := workflow.WithActivityOptions(ctx, getFailoverActivityOptions())
-	targetCluster := params.TargetCluster
+	readinessAO := workflow.WithActivityOptions(ctx, getFailoverReadinessActivityOptions())
+	sourceCluster, targetCluster := params.SourceCluster, params.TargetCluster
 	if reverseFailover {
-		targetCluster = params.SourceCluster
+		sourceCluster, targetCluster = params.TargetCluster, params.SourceCluster
 	}
 	for i := 0; i < times; i++ {
 		pauseSignalHandler()
 
-		failoverActivityParams := &FailoverActivityParams{
-			Domains:                          domains[i*batchSize : min((i+1)*batchSize, totalNumOfDomains)],
-			TargetCluster:                    targetCluster,
-			GracefulFailoverTimeoutInSeconds: params.GracefulFailoverTimeoutInSeconds,
+		batch := domains[i*batchSize : min((i+1)*batchSize, totalNumOfDomains)]
+		if params.ReadinessCheck != nil && len(batch) > 0 {
+			readinessParams := &FailoverReadinessActivityParams{
+				Domains:       batch,
+				SourceCluster: sourceCluster,
+				TargetCluster: targetCluster,
+				Options:       *params.ReadinessCheck,
+			}
+			var readinessResult FailoverReadinessActivityResult
+			err := workflow.ExecuteActivity(readinessAO, FailoverReadinessActivity, readinessParams).Get(ctx, &readinessResult)
+			if err != nil {
+				// Domains are not failed over if their readiness cannot be checked
+				readinessResult = FailoverReadinessActivityResult{NotReadyDomains: batch}
+			}
+			failedDomains = append(failedDomains, readinessResult.NotReadyDomains...)
+			batch = readinessResult.ReadyDomains
 		}
-		var actResult FailoverActivityResult
-		err := workflow.ExecuteActivity(ao, FailoverActivity, failoverActivityParams).Get(ctx, &actResult)
-		if err != nil {
-			// Domains in failed activity can be either failovered or not, but we treated them as failed.
-			// This makes the query result for FailedDomains contains false positive results.
-			failedDomains = append(failedDomains, failoverActivityParams.Domains...)
-		} else {
-			successDomains = append(successDomains, actResult.SuccessDomains...)
-			failedDomains = append(failedDomains, actResult.FailedDomains...)
+
+		if len(batch) > 0 || params.ReadinessCheck == nil {
+			failoverActivityParams := &FailoverActivityParams{
+				Domains:                          batch,
+				TargetCluster:                    targetCluster,
+				GracefulFailoverTimeoutInSeconds: params.GracefulFailoverTimeoutInSeconds,
+			}
+			var actResult FailoverActivityResult
+			err := workflow.ExecuteActivity(ao, FailoverActivity, failoverActivityParams).Get(ctx, &actResult)
+			if err != nil {
+				// Domains in failed activity can be either failovered or not, but we treated them as failed.
+				// This makes the query result for FailedDomains contains false positive results.
+				failedDomains = append(failedDomains, failoverActivityParams.Domains...)
+			} else {
+				successDomains = append(successDomains, actResult.SuccessDomains...)
+				failedDomains = append(failedDomains, actResult.FailedDomains...)
+			}
 		}
 
 		if i != times-1 {
@@ -324,6 +363,13 @@ func getFailoverActivityOptions() workflow.ActivityOptions {
 	}
 }
 
+func getFailoverReadinessActivityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		ScheduleToStartTimeout: 10 * time.Second,
+		StartToCloseTimeout:    5 * time.Minute,
+	}
+}
+
 func validateParams(params *FailoverParams) error {
 	if params == nil {
 		return errors.New(errMsgParamsIsNil)
@@ -486,6 +532,48 @@ func FailoverActivity(ctx context.Context, params *FailoverActivityParams) (*Fai
 	}, nil
 }
 
+// FailoverReadinessActivity splits the domains by whether the target cluster has caught up with the source cluster
+func FailoverReadinessActivity(ctx context.Context, params *FailoverReadinessActivityParams) (*FailoverReadinessActivityResult, error) {
+	logger := activity.GetLogger(ctx)
+	manager := ctx.Value(failoverManagerContextKey).(*FailoverManager)
+	frontendClient, err := manager.clientBean.GetRemoteFrontendClient(params.SourceCluster)
+	if err != nil {
+		return nil, err
+	}
+	sourceAdminClient, err := manager.clientBean.GetRemoteAdminClient(params.SourceCluster)
+	if err != nil {
+		return nil, err
+	}
+	targetAdminClient, err := manager.clientBean.GetRemoteAdminClient(params.TargetCluster)
+	if err != nil {
+		return nil, err
+	}
+
+	checker := failoverreadiness.NewChecker(&failoverreadiness.Params{
+		StandbyCluster:     params.TargetCluster,
+		FrontendClient:     frontendClient,
+		ActiveAdminClient:  sourceAdminClient,
+		StandbyAdminClient: targetAdminClient,
+		StatusCache:        manager.statusCache,
+		Options:            params.Options,
+	})
+	reports, err := checker.CheckDomains(ctx, params.Domains)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &FailoverReadinessActivityResult{}
+	for _, report := range reports {
+		if report.Ready {
+			result.ReadyDomains = append(result.ReadyDomains, report.Domain)
+			continue
+		}
+		logger.Warn("Domain is not ready to failover", zap.String("domain", report.Domain), zap.Strings("reasons", report.Reasons))
+		result.NotReadyDomains = append(result.NotReadyDomains, report.Domain)
+	}
+	return result, nil
+}
+
 func cleanupChannel(channel workflow.Channel) {
 	for {
 		if hasValue := channel.ReceiveAsync(nil); !hasValue {
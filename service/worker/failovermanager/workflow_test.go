@@ -36,7 +36,9 @@ import (
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/failoverreadiness"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/replicationstatus"
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/types"
 )
@@ -67,7 +69,9 @@ func (s *failoverWorkflowTestSuite) SetupTest() {
 	s.workflowEnv.RegisterWorkflowWithOptions(FailoverWorkflow, workflow.RegisterOptions{Name: FailoverWorkflowTypeName})
 	s.workflowEnv.RegisterActivityWithOptions(FailoverActivity, activity.RegisterOptions{Name: failoverActivityName})
 	s.workflowEnv.RegisterActivityWithOptions(GetDomainsActivity, activity.RegisterOptions{Name: getDomainsActivityName})
+	s.workflowEnv.RegisterActivityWithOptions(FailoverReadinessActivity, activity.RegisterOptions{Name: failoverReadinessActivityName})
 	s.activityEnv.RegisterActivityWithOptions(FailoverActivity, activity.RegisterOptions{Name: failoverActivityName})
+	s.activityEnv.RegisterActivityWithOptions(FailoverReadinessActivity, activity.RegisterOptions{Name: failoverReadinessActivityName})
 	s.activityEnv.RegisterActivityWithOptions(GetDomainsActivity, activity.RegisterOptions{Name: getDomainsActivityName})
 }
 
@@ -789,6 +793,93 @@ func (s *failoverWorkflowTestSuite) assertQueryState(env *testsuite.TestWorkflow
 	s.Equal(expectedState, res.State)
 }
 
+func (s *failoverWorkflowTestSuite) TestWorkflow_ReadinessCheck() {
+	domains := []string{"d1", "d2"}
+	readinessParams := &FailoverReadinessActivityParams{
+		Domains:       domains,
+		SourceCluster: "s",
+		TargetCluster: "t",
+		Options:       failoverreadiness.Options{SampleSize: 10},
+	}
+	failoverParams := &FailoverActivityParams{
+		Domains:       []string{"d1"},
+		TargetCluster: "t",
+	}
+	s.workflowEnv.OnActivity(getDomainsActivityName, mock.Anything, mock.Anything).Return(domains, nil)
+	s.workflowEnv.OnActivity(failoverReadinessActivityName, mock.Anything, readinessParams).Return(&FailoverReadinessActivityResult{
+		ReadyDomains:    []string{"d1"},
+		NotReadyDomains: []string{"d2"},
+	}, nil)
+	s.workflowEnv.OnActivity(failoverActivityName, mock.Anything, failoverParams).Return(&FailoverActivityResult{
+		SuccessDomains: []string{"d1"},
+	}, nil)
+	params := &FailoverParams{
+		TargetCluster:  "t",
+		SourceCluster:  "s",
+		ReadinessCheck: &failoverreadiness.Options{SampleSize: 10},
+	}
+	s.workflowEnv.ExecuteWorkflow(FailoverWorkflowTypeName, params)
+	var result FailoverResult
+	s.NoError(s.workflowEnv.GetWorkflowResult(&result))
+	s.Equal([]string{"d1"}, result.SuccessDomains)
+	s.Equal([]string{"d2"}, result.FailedDomains)
+}
+
+func (s *failoverWorkflowTestSuite) TestWorkflow_ReadinessCheckError() {
+	domains := []string{"d1", "d2"}
+	s.workflowEnv.OnActivity(getDomainsActivityName, mock.Anything, mock.Anything).Return(domains, nil)
+	s.workflowEnv.OnActivity(failoverReadinessActivityName, mock.Anything, mock.Anything).Return(nil, errors.New("mockErr"))
+	params := &FailoverParams{
+		TargetCluster:  "t",
+		SourceCluster:  "s",
+		ReadinessCheck: &failoverreadiness.Options{},
+	}
+	s.workflowEnv.ExecuteWorkflow(FailoverWorkflowTypeName, params)
+	var result FailoverResult
+	s.NoError(s.workflowEnv.GetWorkflowResult(&result))
+	s.Empty(result.SuccessDomains)
+	s.Equal(domains, result.FailedDomains)
+}
+
+func (s *failoverWorkflowTestSuite) TestFailoverReadinessActivity() {
+	env, mockResource := s.prepareTestActivityEnv()
+
+	mockResource.RemoteFrontendClient.EXPECT().DescribeDomain(gomock.Any(), &types.DescribeDomainRequest{Name: common.StringPtr("d1")}).
+		Return(&types.DescribeDomainResponse{
+			DomainInfo: &types.DomainInfo{Name: "d1", UUID: "d1-id"},
+			ReplicationConfiguration: &types.DomainReplicationConfiguration{
+				ActiveClusterName: "c1",
+				Clusters:          clusters,
+			},
+			IsGlobalDomain: true,
+		}, nil)
+	mockResource.RemoteFrontendClient.EXPECT().DescribeDomain(gomock.Any(), &types.DescribeDomainRequest{Name: common.StringPtr("d2")}).
+		Return(&types.DescribeDomainResponse{
+			DomainInfo:               &types.DomainInfo{Name: "d2", UUID: "d2-id"},
+			ReplicationConfiguration: &types.DomainReplicationConfiguration{ActiveClusterName: "c1"},
+		}, nil)
+	lag, err := replicationstatus.EncodeDomainLag(&replicationstatus.DomainLag{DomainID: "d1-id", SourceCluster: "c1"})
+	s.NoError(err)
+	mockResource.RemoteAdminClient.EXPECT().DescribeShardDistribution(gomock.Any(), gomock.Any()).
+		Return(&types.DescribeShardDistributionResponse{NumberOfShards: 1}, nil)
+	mockResource.RemoteAdminClient.EXPECT().DescribeQueue(gomock.Any(), gomock.Any()).
+		Return(&types.DescribeQueueResponse{ProcessingQueueStates: []string{lag}}, nil)
+	mockResource.RemoteAdminClient.EXPECT().CountDLQMessages(gomock.Any(), gomock.Any()).
+		Return(&types.CountDLQMessagesResponse{}, nil)
+
+	params := &FailoverReadinessActivityParams{
+		Domains:       []string{"d1", "d2"},
+		SourceCluster: "c1",
+		TargetCluster: "c2",
+	}
+	actResult, err := env.ExecuteActivity(failoverReadinessActivityName, params)
+	s.NoError(err)
+	var result FailoverReadinessActivityResult
+	s.NoError(actResult.Get(&result))
+	s.Equal([]string{"d1"}, result.ReadyDomains)
+	s.Equal([]string{"d2"}, result.NotReadyDomains)
+}
+
 func (s *failoverWorkflowTestSuite) prepareTestActivityEnv() (*testsuite.TestActivityEnvironment, *resource.Test) {
 	controller := gomock.NewController(s.T())
 	mockResource := resource.NewTest(s.T(), controller, metrics.Worker)
@@ -0,0 +1,131 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/cadence"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	startWorkflowRequest struct {
+		Domain        string
+		ScheduleID    string
+		Action        Action
+		FireTime      time.Time
+		Trigger       TriggerSource
+		OverlapPolicy OverlapPolicy
+		// Previous is the run the schedule started last, if any
+		Previous  *RunInfo
+		RequestID string
+	}
+
+	startWorkflowResult struct {
+		WorkflowID string
+		RunID      string
+		Skipped    bool
+	}
+)
+
+// StartWorkflowActivity applies the overlap policy to the previous run of the
+// schedule and starts the workflow of the schedule action
+func (s *Scheduler) StartWorkflowActivity(ctx context.Context, request startWorkflowRequest) (startWorkflowResult, error) {
+	client := s.clientBean.GetFrontendClient()
+	result := startWorkflowResult{
+		WorkflowID: request.Action.workflowID(request.ScheduleID, request.FireTime),
+	}
+
+	if request.Previous != nil && request.OverlapPolicy != OverlapPolicyAllowAll {
+		previous := &types.WorkflowExecution{
+			WorkflowID: request.Previous.WorkflowID,
+			RunID:      request.Previous.RunID,
+		}
+		resp, err := client.DescribeWorkflowExecution(ctx, &types.DescribeWorkflowExecutionRequest{
+			Domain:    request.Domain,
+			Execution: previous,
+		})
+		var notExistsErr *types.EntityNotExistsError
+		switch {
+		case errors.As(err, &notExistsErr):
+			// previous run is already deleted
+		case err != nil:
+			return result, fmt.Errorf("failed to describe previous run: %v", err)
+		case resp.WorkflowExecutionInfo.CloseStatus == nil && request.OverlapPolicy == OverlapPolicySkip:
+			s.logger.Info("Skipping schedule run, previous run is still open",
+				tag.WorkflowDomainName(request.Domain),
+				tag.WorkflowID(previous.WorkflowID),
+				tag.WorkflowRunID(previous.RunID))
+			result.Skipped = true
+			return result, nil
+		case resp.WorkflowExecutionInfo.CloseStatus == nil && request.OverlapPolicy == OverlapPolicyTerminatePrevious:
+			err = client.TerminateWorkflowExecution(ctx, &types.TerminateWorkflowExecutionRequest{
+				Domain:            request.Domain,
+				WorkflowExecution: previous,
+				Reason:            fmt.Sprintf("terminated by schedule %v, overlap policy %v", request.ScheduleID, request.OverlapPolicy),
+				Identity:          identity,
+			})
+			var completedErr *types.WorkflowExecutionAlreadyCompletedError
+			if err != nil && !errors.As(err, &completedErr) && !errors.As(err, &notExistsErr) {
+				return result, fmt.Errorf("failed to terminate previous run: %v", err)
+			}
+		}
+	}
+
+	resp, err := client.StartWorkflowExecution(ctx, &types.StartWorkflowExecutionRequest{
+		Domain:                              request.Domain,
+		WorkflowID:                          result.WorkflowID,
+		WorkflowType:                        &types.WorkflowType{Name: request.Action.WorkflowType},
+		TaskList:                            &types.TaskList{Name: request.Action.TaskList},
+		Input:                               request.Action.Input,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(request.Action.ExecutionStartToCloseTimeoutSeconds),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(request.Action.TaskStartToCloseTimeoutSeconds),
+		Identity:                            identity,
+		RequestID:                           request.RequestID,
+		Memo: &types.Memo{Fields: map[string][]byte{
+			memoKeyScheduleID: []byte(request.ScheduleID),
+			memoKeyTrigger:    []byte(request.Trigger),
+		}},
+	})
+	var alreadyStartedErr *types.WorkflowExecutionAlreadyStartedError
+	switch {
+	case errors.As(err, &alreadyStartedErr):
+		// the run of this fire time was started before, e.g. by an overlapping backfill
+		result.RunID = alreadyStartedErr.RunID
+		result.Skipped = alreadyStartedErr.StartRequestID != request.RequestID
+		return result, nil
+	case errors.As(err, new(*types.BadRequestError)), errors.As(err, new(*types.EntityNotExistsError)):
+		return result, cadence.NewCustomError(errNonRetriable, err.Error())
+	case err != nil:
+		return result, fmt.Errorf("failed to start workflow: %v", err)
+	}
+	result.RunID = resp.GetRunID()
+	return result, nil
+}
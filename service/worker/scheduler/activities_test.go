@@ -0,0 +1,210 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestStartWorkflowActivity(t *testing.T) {
+	fireTime := time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC)
+	previous := &RunInfo{WorkflowID: "test-schedule-2024-01-01T00:00:00Z", RunID: "previous-run-id"}
+	openResp := &types.DescribeWorkflowExecutionResponse{WorkflowExecutionInfo: &types.WorkflowExecutionInfo{}}
+	closedResp := &types.DescribeWorkflowExecutionResponse{WorkflowExecutionInfo: &types.WorkflowExecutionInfo{
+		CloseStatus: types.WorkflowExecutionCloseStatusCompleted.Ptr(),
+	}}
+	startResp := &types.StartWorkflowExecutionResponse{RunID: "run-id"}
+
+	tests := []struct {
+		name           string
+		overlapPolicy  OverlapPolicy
+		previous       *RunInfo
+		setupMocks     func(*frontend.MockClient)
+		expectedResult startWorkflowResult
+		expectedErr    bool
+	}{
+		{
+			name:          "first run",
+			overlapPolicy: OverlapPolicySkip,
+			setupMocks: func(c *frontend.MockClient) {
+				c.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, request *types.StartWorkflowExecutionRequest, _ ...interface{}) (*types.StartWorkflowExecutionResponse, error) {
+						assert.Equal(t, "test-domain", request.Domain)
+						assert.Equal(t, "test-schedule-2024-01-01T01:00:00Z", request.WorkflowID)
+						assert.Equal(t, "test-workflow", request.WorkflowType.Name)
+						assert.Equal(t, "test-tasklist", request.TaskList.Name)
+						assert.Equal(t, "request-id", request.RequestID)
+						assert.Equal(t, []byte("test-schedule"), request.Memo.Fields[memoKeyScheduleID])
+						assert.Equal(t, []byte(TriggerSourceSchedule), request.Memo.Fields[memoKeyTrigger])
+						return startResp, nil
+					})
+			},
+			expectedResult: startWorkflowResult{WorkflowID: "test-schedule-2024-01-01T01:00:00Z", RunID: "run-id"},
+		},
+		{
+			name:          "skip while previous run is open",
+			overlapPolicy: OverlapPolicySkip,
+			previous:      previous,
+			setupMocks: func(c *frontend.MockClient) {
+				c.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(openResp, nil)
+			},
+			expectedResult: startWorkflowResult{WorkflowID: "test-schedule-2024-01-01T01:00:00Z", Skipped: true},
+		},
+		{
+			name:          "start after previous run closed",
+			overlapPolicy: OverlapPolicySkip,
+			previous:      previous,
+			setupMocks: func(c *frontend.MockClient) {
+				c.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(closedResp, nil)
+				c.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(startResp, nil)
+			},
+			expectedResult: startWorkflowResult{WorkflowID: "test-schedule-2024-01-01T01:00:00Z", RunID: "run-id"},
+		},
+		{
+			name:          "start after previous run was deleted",
+			overlapPolicy: OverlapPolicySkip,
+			previous:      previous,
+			setupMocks: func(c *frontend.MockClient) {
+				c.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{})
+				c.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(startResp, nil)
+			},
+			expectedResult: startWorkflowResult{WorkflowID: "test-schedule-2024-01-01T01:00:00Z", RunID: "run-id"},
+		},
+		{
+			name:          "terminate previous run",
+			overlapPolicy: OverlapPolicyTerminatePrevious,
+			previous:      previous,
+			setupMocks: func(c *frontend.MockClient) {
+				c.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(openResp, nil)
+				c.EXPECT().TerminateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, request *types.TerminateWorkflowExecutionRequest, _ ...interface{}) error {
+						assert.Equal(t, "previous-run-id", request.WorkflowExecution.RunID)
+						return nil
+					})
+				c.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(startResp, nil)
+			},
+			expectedResult: startWorkflowResult{WorkflowID: "test-schedule-2024-01-01T01:00:00Z", RunID: "run-id"},
+		},
+		{
+			name:          "terminate previous run fails",
+			overlapPolicy: OverlapPolicyTerminatePrevious,
+			previous:      previous,
+			setupMocks: func(c *frontend.MockClient) {
+				c.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(openResp, nil)
+				c.EXPECT().TerminateWorkflowExecution(gomock.Any(), gomock.Any()).Return(assert.AnError)
+			},
+			expectedErr: true,
+		},
+		{
+			name:          "allow all does not check previous run",
+			overlapPolicy: OverlapPolicyAllowAll,
+			previous:      previous,
+			setupMocks: func(c *frontend.MockClient) {
+				c.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(startResp, nil)
+			},
+			expectedResult: startWorkflowResult{WorkflowID: "test-schedule-2024-01-01T01:00:00Z", RunID: "run-id"},
+		},
+		{
+			name:          "describe fails",
+			overlapPolicy: OverlapPolicySkip,
+			previous:      previous,
+			setupMocks: func(c *frontend.MockClient) {
+				c.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, assert.AnError)
+			},
+			expectedErr: true,
+		},
+		{
+			name:          "already started by this request",
+			overlapPolicy: OverlapPolicyAllowAll,
+			setupMocks: func(c *frontend.MockClient) {
+				c.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, &types.WorkflowExecutionAlreadyStartedError{
+					StartRequestID: "request-id",
+					RunID:          "run-id",
+				})
+			},
+			expectedResult: startWorkflowResult{WorkflowID: "test-schedule-2024-01-01T01:00:00Z", RunID: "run-id"},
+		},
+		{
+			name:          "already started by another request",
+			overlapPolicy: OverlapPolicyAllowAll,
+			setupMocks: func(c *frontend.MockClient) {
+				c.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, &types.WorkflowExecutionAlreadyStartedError{
+					StartRequestID: "other-request-id",
+					RunID:          "other-run-id",
+				})
+			},
+			expectedResult: startWorkflowResult{WorkflowID: "test-schedule-2024-01-01T01:00:00Z", RunID: "other-run-id", Skipped: true},
+		},
+		{
+			name:          "start fails",
+			overlapPolicy: OverlapPolicyAllowAll,
+			setupMocks: func(c *frontend.MockClient) {
+				c.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, &types.BadRequestError{})
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := frontend.NewMockClient(ctrl)
+			mockClientBean := client.NewMockBean(ctrl)
+			mockClientBean.EXPECT().GetFrontendClient().Return(mockClient).AnyTimes()
+			tc.setupMocks(mockClient)
+
+			s := &Scheduler{clientBean: mockClientBean, logger: testlogger.New(t)}
+			result, err := s.StartWorkflowActivity(context.Background(), startWorkflowRequest{
+				Domain:     "test-domain",
+				ScheduleID: "test-schedule",
+				Action: Action{
+					WorkflowType:                        "test-workflow",
+					TaskList:                            "test-tasklist",
+					ExecutionStartToCloseTimeoutSeconds: 60,
+					TaskStartToCloseTimeoutSeconds:      10,
+				},
+				FireTime:      fireTime,
+				Trigger:       TriggerSourceSchedule,
+				OverlapPolicy: tc.overlapPolicy,
+				Previous:      tc.previous,
+				RequestID:     "request-id",
+			})
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, result)
+		})
+	}
+}
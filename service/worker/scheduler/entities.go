@@ -0,0 +1,302 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package scheduler
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/uber/cadence/common/backoff"
+)
+
+// OverlapPolicy decides what a schedule does when it fires while the
+// workflow it started previously is still running
+type OverlapPolicy string
+
+const (
+	// OverlapPolicySkip skips the new run
+	OverlapPolicySkip OverlapPolicy = "skip"
+	// OverlapPolicyAllowAll starts the new run next to the running one
+	OverlapPolicyAllowAll OverlapPolicy = "allow_all"
+	// OverlapPolicyTerminatePrevious terminates the running workflow before starting the new run
+	OverlapPolicyTerminatePrevious OverlapPolicy = "terminate_previous"
+)
+
+// AllOverlapPolicies is the overlap policies we support
+var AllOverlapPolicies = []OverlapPolicy{OverlapPolicySkip, OverlapPolicyAllowAll, OverlapPolicyTerminatePrevious}
+
+// TriggerSource tells why a schedule started a workflow
+type TriggerSource string
+
+const (
+	// TriggerSourceSchedule is a run started because the spec fired
+	TriggerSourceSchedule TriggerSource = "schedule"
+	// TriggerSourceManual is a run started by a trigger request
+	TriggerSourceManual TriggerSource = "manual"
+	// TriggerSourceBackfill is a run started by a backfill request
+	TriggerSourceBackfill TriggerSource = "backfill"
+)
+
+type (
+	// Spec defines when a schedule fires
+	Spec struct {
+		// CronSchedule is a standard cron expression, evaluated in UTC
+		CronSchedule string `json:"cron_schedule"`
+		// StartTime is the earliest time the schedule fires, zero means now
+		StartTime time.Time `json:"start_time,omitempty"`
+		// EndTime is the time after which the schedule no longer fires, zero means never
+		EndTime time.Time `json:"end_time,omitempty"`
+		// CatchupWindow is how late a fire time may be taken after the scheduler
+		// was unavailable, older fire times are counted as skipped
+		CatchupWindow time.Duration `json:"catchup_window,omitempty"`
+	}
+
+	// Action is the workflow a schedule starts each time it fires
+	Action struct {
+		WorkflowType string `json:"workflow_type"`
+		TaskList     string `json:"task_list"`
+		Input        []byte `json:"input,omitempty"`
+		// WorkflowIDPrefix prefixes the workflow ID of every run, the schedule ID is used when empty
+		WorkflowIDPrefix                    string `json:"workflow_id_prefix,omitempty"`
+		ExecutionStartToCloseTimeoutSeconds int32  `json:"execution_start_to_close_timeout_seconds"`
+		TaskStartToCloseTimeoutSeconds      int32  `json:"task_start_to_close_timeout_seconds"`
+	}
+
+	// Params is the input of the schedule workflow
+	Params struct {
+		Domain        string        `json:"domain"`
+		ScheduleID    string        `json:"schedule_id"`
+		Spec          Spec          `json:"spec"`
+		Action        Action        `json:"action"`
+		OverlapPolicy OverlapPolicy `json:"overlap_policy"`
+		Paused        bool          `json:"paused,omitempty"`
+		PauseReason   string        `json:"pause_reason,omitempty"`
+		// State is carried over when the schedule workflow continues as new
+		State *State `json:"state,omitempty"`
+	}
+
+	// State is the bookkeeping of a schedule
+	State struct {
+		// LastFireTime is the latest fire time of the spec that was processed
+		LastFireTime time.Time `json:"last_fire_time,omitempty"`
+		TotalRuns    int64     `json:"total_runs"`
+		SkippedRuns  int64     `json:"skipped_runs"`
+		RecentRuns   []RunInfo `json:"recent_runs,omitempty"`
+		// LastStartedRun is the run the overlap policy is applied to
+		LastStartedRun *RunInfo `json:"last_started_run,omitempty"`
+		// PendingBackfills are backfill requests not fully processed yet
+		PendingBackfills []BackfillRequest `json:"pending_backfills,omitempty"`
+	}
+
+	// RunInfo describes a workflow started by a schedule
+	RunInfo struct {
+		FireTime   time.Time     `json:"fire_time"`
+		StartTime  time.Time     `json:"start_time"`
+		WorkflowID string        `json:"workflow_id"`
+		RunID      string        `json:"run_id,omitempty"`
+		Trigger    TriggerSource `json:"trigger"`
+		Skipped    bool          `json:"skipped,omitempty"`
+	}
+
+	// UpdateRequest is the payload of the update signal, nil fields are left unchanged
+	UpdateRequest struct {
+		Spec          *Spec          `json:"spec,omitempty"`
+		Action        *Action        `json:"action,omitempty"`
+		OverlapPolicy *OverlapPolicy `json:"overlap_policy,omitempty"`
+	}
+
+	// PauseRequest is the payload of the pause and unpause signals
+	PauseRequest struct {
+		Reason string `json:"reason,omitempty"`
+	}
+
+	// TriggerRequest is the payload of the trigger signal
+	TriggerRequest struct {
+		// OverlapPolicy overrides the schedule's overlap policy for this run
+		OverlapPolicy OverlapPolicy `json:"overlap_policy,omitempty"`
+	}
+
+	// BackfillRequest is the payload of the backfill signal, every fire time
+	// of the spec within [StartTime, EndTime] is run
+	BackfillRequest struct {
+		StartTime     time.Time     `json:"start_time"`
+		EndTime       time.Time     `json:"end_time"`
+		OverlapPolicy OverlapPolicy `json:"overlap_policy,omitempty"`
+	}
+
+	// Description is the result of the describe query
+	Description struct {
+		Domain        string        `json:"domain"`
+		ScheduleID    string        `json:"schedule_id"`
+		Spec          Spec          `json:"spec"`
+		Action        Action        `json:"action"`
+		OverlapPolicy OverlapPolicy `json:"overlap_policy"`
+		Paused        bool          `json:"paused"`
+		PauseReason   string        `json:"pause_reason,omitempty"`
+		NextFireTime  time.Time     `json:"next_fire_time,omitempty"`
+		State         State         `json:"state"`
+	}
+)
+
+// WorkflowID returns the ID of the workflow running the given schedule
+func WorkflowID(domain, scheduleID string) string {
+	return WorkflowIDPrefix(domain) + scheduleID
+}
+
+// WorkflowIDPrefix returns the prefix shared by the workflow IDs of all schedules of a domain
+func WorkflowIDPrefix(domain string) string {
+	return fmt.Sprintf("%s:%s:", workflowIDPrefix, domain)
+}
+
+// ScheduleIDFromWorkflowID returns the schedule ID of a schedule workflow of the domain
+func ScheduleIDFromWorkflowID(domain, workflowID string) (string, bool) {
+	prefix := WorkflowIDPrefix(domain)
+	if !strings.HasPrefix(workflowID, prefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(workflowID, prefix), true
+}
+
+// DomainFromWorkflowID returns the domain of the schedule run by the given workflow, if it is a schedule workflow
+func DomainFromWorkflowID(workflowID string) (string, bool) {
+	rest, ok := strings.CutPrefix(workflowID, workflowIDPrefix+":")
+	if !ok {
+		return "", false
+	}
+	domain, _, ok := strings.Cut(rest, ":")
+	return domain, ok && domain != ""
+}
+
+// ValidateOverlapPolicy returns an error if the overlap policy is not supported
+func ValidateOverlapPolicy(policy OverlapPolicy) error {
+	for _, p := range AllOverlapPolicies {
+		if p == policy {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown overlap policy %q", policy)
+}
+
+// Validate returns an error if the params cannot be run as a schedule
+func (p Params) Validate() error {
+	if p.Domain == "" {
+		return fmt.Errorf("domain is empty")
+	}
+	if strings.Contains(p.Domain, ":") {
+		// the domain of a schedule is taken from its workflow ID, which must not be ambiguous
+		return fmt.Errorf("domain %q contains ':', which is not supported by schedules", p.Domain)
+	}
+	if p.ScheduleID == "" {
+		return fmt.Errorf("schedule ID is empty")
+	}
+	if err := p.Spec.Validate(); err != nil {
+		return err
+	}
+	if err := p.Action.Validate(); err != nil {
+		return err
+	}
+	return ValidateOverlapPolicy(p.OverlapPolicy)
+}
+
+// Validate returns an error if the spec cannot be evaluated
+func (s Spec) Validate() error {
+	if _, err := backoff.ValidateSchedule(s.CronSchedule); err != nil {
+		return err
+	}
+	if !s.EndTime.IsZero() && s.EndTime.Before(s.StartTime) {
+		return fmt.Errorf("end time %v is before start time %v", s.EndTime, s.StartTime)
+	}
+	if s.CatchupWindow < 0 {
+		return fmt.Errorf("catchup window is negative")
+	}
+	return nil
+}
+
+// NextFireTime returns the first fire time of the spec strictly after the given time,
+// or zero if the spec does not fire anymore
+func (s Spec) NextFireTime(after time.Time) time.Time {
+	sched, err := backoff.ValidateSchedule(s.CronSchedule)
+	if err != nil {
+		return time.Time{}
+	}
+	if after.Before(s.StartTime) {
+		after = s.StartTime.Add(-time.Second)
+	}
+	next := sched.Next(after.In(time.UTC))
+	if next.IsZero() || (!s.EndTime.IsZero() && next.After(s.EndTime)) {
+		return time.Time{}
+	}
+	return next
+}
+
+// Validate returns an error if the action cannot start a workflow
+func (a Action) Validate() error {
+	if a.WorkflowType == "" {
+		return fmt.Errorf("workflow type is empty")
+	}
+	if a.TaskList == "" {
+		return fmt.Errorf("task list is empty")
+	}
+	if a.ExecutionStartToCloseTimeoutSeconds <= 0 {
+		return fmt.Errorf("execution start to close timeout must be positive")
+	}
+	if a.TaskStartToCloseTimeoutSeconds <= 0 {
+		return fmt.Errorf("task start to close timeout must be positive")
+	}
+	return nil
+}
+
+// Validate returns an error if the backfill range is empty
+func (r BackfillRequest) Validate() error {
+	if r.StartTime.IsZero() || r.EndTime.IsZero() {
+		return fmt.Errorf("backfill start and end time are required")
+	}
+	if r.EndTime.Before(r.StartTime) {
+		return fmt.Errorf("backfill end time %v is before start time %v", r.EndTime, r.StartTime)
+	}
+	if r.OverlapPolicy != "" {
+		return ValidateOverlapPolicy(r.OverlapPolicy)
+	}
+	return nil
+}
+
+func (u UpdateRequest) apply(params *Params) {
+	if u.Spec != nil {
+		params.Spec = *u.Spec
+	}
+	if u.Action != nil {
+		params.Action = *u.Action
+	}
+	if u.OverlapPolicy != nil {
+		params.OverlapPolicy = *u.OverlapPolicy
+	}
+}
+
+func (a Action) workflowID(scheduleID string, fireTime time.Time) string {
+	prefix := a.WorkflowIDPrefix
+	if prefix == "" {
+		prefix = scheduleID
+	}
+	return fmt.Sprintf("%s-%s", prefix, fireTime.UTC().Format(time.RFC3339))
+}
@@ -0,0 +1,188 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkflowID(t *testing.T) {
+	workflowID := WorkflowID("test-domain", "test-schedule")
+	assert.Equal(t, "cadence-schedule:test-domain:test-schedule", workflowID)
+
+	scheduleID, ok := ScheduleIDFromWorkflowID("test-domain", workflowID)
+	assert.True(t, ok)
+	assert.Equal(t, "test-schedule", scheduleID)
+
+	_, ok = ScheduleIDFromWorkflowID("other-domain", workflowID)
+	assert.False(t, ok)
+
+	domain, ok := DomainFromWorkflowID(WorkflowID("test-domain", "test:schedule"))
+	assert.True(t, ok)
+	assert.Equal(t, "test-domain", domain)
+
+	for _, id := range []string{"test-schedule", "cadence-schedule:test-domain", "cadence-schedule::test-schedule"} {
+		_, ok = DomainFromWorkflowID(id)
+		assert.False(t, ok, id)
+	}
+}
+
+func TestParamsValidate(t *testing.T) {
+	validParams := func() Params {
+		return Params{
+			Domain:     "test-domain",
+			ScheduleID: "test-schedule",
+			Spec:       Spec{CronSchedule: "@hourly"},
+			Action: Action{
+				WorkflowType:                        "test-workflow",
+				TaskList:                            "test-tasklist",
+				ExecutionStartToCloseTimeoutSeconds: 60,
+				TaskStartToCloseTimeoutSeconds:      10,
+			},
+			OverlapPolicy: OverlapPolicySkip,
+		}
+	}
+	tests := map[string]struct {
+		modify  func(*Params)
+		wantErr bool
+	}{
+		"valid": {
+			modify: func(*Params) {},
+		},
+		"empty domain": {
+			modify:  func(p *Params) { p.Domain = "" },
+			wantErr: true,
+		},
+		"domain with colon": {
+			modify:  func(p *Params) { p.Domain = "test:domain" },
+			wantErr: true,
+		},
+		"empty schedule ID": {
+			modify:  func(p *Params) { p.ScheduleID = "" },
+			wantErr: true,
+		},
+		"invalid cron": {
+			modify:  func(p *Params) { p.Spec.CronSchedule = "every hour" },
+			wantErr: true,
+		},
+		"end before start": {
+			modify: func(p *Params) {
+				p.Spec.StartTime = time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+				p.Spec.EndTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+			},
+			wantErr: true,
+		},
+		"negative catchup window": {
+			modify:  func(p *Params) { p.Spec.CatchupWindow = -time.Second },
+			wantErr: true,
+		},
+		"empty workflow type": {
+			modify:  func(p *Params) { p.Action.WorkflowType = "" },
+			wantErr: true,
+		},
+		"empty task list": {
+			modify:  func(p *Params) { p.Action.TaskList = "" },
+			wantErr: true,
+		},
+		"missing execution timeout": {
+			modify:  func(p *Params) { p.Action.ExecutionStartToCloseTimeoutSeconds = 0 },
+			wantErr: true,
+		},
+		"missing task timeout": {
+			modify:  func(p *Params) { p.Action.TaskStartToCloseTimeoutSeconds = 0 },
+			wantErr: true,
+		},
+		"unknown overlap policy": {
+			modify:  func(p *Params) { p.OverlapPolicy = "buffer" },
+			wantErr: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			params := validParams()
+			tc.modify(&params)
+			err := params.Validate()
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSpecNextFireTime(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 30, 0, 0, time.UTC)
+	tests := map[string]struct {
+		spec     Spec
+		after    time.Time
+		expected time.Time
+	}{
+		"next hour": {
+			spec:     Spec{CronSchedule: "@hourly"},
+			after:    base,
+			expected: time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC),
+		},
+		"strictly after": {
+			spec:     Spec{CronSchedule: "@hourly"},
+			after:    time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC),
+			expected: time.Date(2024, 1, 1, 2, 0, 0, 0, time.UTC),
+		},
+		"start time is a fire time": {
+			spec:     Spec{CronSchedule: "@hourly", StartTime: time.Date(2024, 1, 1, 3, 0, 0, 0, time.UTC)},
+			after:    base,
+			expected: time.Date(2024, 1, 1, 3, 0, 0, 0, time.UTC),
+		},
+		"after end time": {
+			spec:  Spec{CronSchedule: "@hourly", EndTime: time.Date(2024, 1, 1, 0, 59, 0, 0, time.UTC)},
+			after: base,
+		},
+		"invalid cron": {
+			spec:  Spec{CronSchedule: "invalid"},
+			after: base,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.spec.NextFireTime(tc.after))
+		})
+	}
+}
+
+func TestBackfillRequestValidate(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.NoError(t, BackfillRequest{StartTime: start, EndTime: start.Add(time.Hour)}.Validate())
+	assert.NoError(t, BackfillRequest{StartTime: start, EndTime: start, OverlapPolicy: OverlapPolicyAllowAll}.Validate())
+	assert.Error(t, BackfillRequest{StartTime: start}.Validate())
+	assert.Error(t, BackfillRequest{StartTime: start, EndTime: start.Add(-time.Hour)}.Validate())
+	assert.Error(t, BackfillRequest{StartTime: start, EndTime: start, OverlapPolicy: "buffer"}.Validate())
+}
+
+func TestActionWorkflowID(t *testing.T) {
+	fireTime := time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC)
+	assert.Equal(t, "test-schedule-2024-01-01T01:00:00Z", Action{}.workflowID("test-schedule", fireTime))
+	assert.Equal(t, "report-2024-01-01T01:00:00Z", Action{WorkflowIDPrefix: "report"}.workflowID("test-schedule", fireTime))
+}
@@ -0,0 +1,367 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package scheduler
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+)
+
+const (
+	// TaskListName is the tasklist of the schedule workflows
+	TaskListName = "cadence-sys-scheduler-tasklist"
+	// WorkflowTypeName is the workflow type of the schedule workflows
+	WorkflowTypeName          = "cadence-sys-schedule-workflow"
+	startWorkflowActivityName = "cadence-sys-schedule-start-workflow-activity"
+	workflowIDPrefix          = "cadence-schedule"
+	identity                  = "cadence-scheduler"
+
+	// QueryTypeDescribe returns the Description of a schedule
+	QueryTypeDescribe = "describe"
+	// SignalNameUpdate changes the spec, action or overlap policy of a schedule
+	SignalNameUpdate = "update"
+	// SignalNamePause stops a schedule from firing until it is unpaused
+	SignalNamePause = "pause"
+	// SignalNameUnpause resumes a paused schedule, fire times missed while paused are skipped
+	SignalNameUnpause = "unpause"
+	// SignalNameTrigger starts a run of the schedule action right away
+	SignalNameTrigger = "trigger"
+	// SignalNameBackfill runs the schedule action for every fire time within a past time range
+	SignalNameBackfill = "backfill"
+
+	memoKeyScheduleID = "CadenceScheduleID"
+	memoKeyTrigger    = "CadenceScheduleTrigger"
+
+	errNonRetriable = "non-retriable-error"
+
+	// maxRecentRuns is how many runs a schedule keeps in its state for describe
+	maxRecentRuns = 10
+	// maxIterationsPerRun bounds the history of a schedule workflow run before it continues as new
+	maxIterationsPerRun = 500
+	// DefaultExecutionTimeout is the execution timeout of the schedule workflows
+	DefaultExecutionTimeout = 20 * 365 * 24 * time.Hour
+)
+
+var (
+	activityRetryPolicy = cadence.RetryPolicy{
+		InitialInterval:          time.Second,
+		BackoffCoefficient:       2,
+		MaximumInterval:          time.Minute,
+		ExpirationInterval:       10 * time.Minute,
+		NonRetriableErrorReasons: []string{errNonRetriable},
+	}
+
+	activityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+		RetryPolicy:            &activityRetryPolicy,
+	}
+)
+
+type scheduleLoop struct {
+	scheduler *Scheduler
+	params    Params
+	state     State
+	triggers  []TriggerRequest
+
+	updateCh   workflow.Channel
+	pauseCh    workflow.Channel
+	unpauseCh  workflow.Channel
+	triggerCh  workflow.Channel
+	backfillCh workflow.Channel
+}
+
+// ScheduleWorkflow is the workflow that runs a schedule. It starts the action
+// of the schedule on every fire time of its spec and on trigger and backfill
+// signals, and continues as new periodically to keep its history bounded.
+func (s *Scheduler) ScheduleWorkflow(ctx workflow.Context, params Params) error {
+	if err := params.Validate(); err != nil {
+		return cadence.NewCustomError(errNonRetriable, err.Error())
+	}
+	l := &scheduleLoop{
+		scheduler:  s,
+		params:     params,
+		updateCh:   workflow.GetSignalChannel(ctx, SignalNameUpdate),
+		pauseCh:    workflow.GetSignalChannel(ctx, SignalNamePause),
+		unpauseCh:  workflow.GetSignalChannel(ctx, SignalNameUnpause),
+		triggerCh:  workflow.GetSignalChannel(ctx, SignalNameTrigger),
+		backfillCh: workflow.GetSignalChannel(ctx, SignalNameBackfill),
+	}
+	if params.State != nil {
+		l.state = *params.State
+		l.params.State = nil
+	}
+	if l.state.LastFireTime.IsZero() {
+		// fire times before the schedule is created are only run by backfills
+		l.state.LastFireTime = workflow.Now(ctx)
+	}
+	if err := workflow.SetQueryHandler(ctx, QueryTypeDescribe, l.describe); err != nil {
+		return err
+	}
+	return l.run(ctx)
+}
+
+func (l *scheduleLoop) run(ctx workflow.Context) error {
+	logger := workflow.GetLogger(ctx)
+	for iteration := 0; iteration < maxIterationsPerRun || l.receiveSignals(ctx); iteration++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if len(l.triggers) > 0 {
+			trigger := l.triggers[0]
+			l.triggers = l.triggers[1:]
+			l.startRun(ctx, workflow.Now(ctx), TriggerSourceManual, trigger.OverlapPolicy)
+			continue
+		}
+
+		if len(l.state.PendingBackfills) > 0 {
+			l.backfillNext(ctx)
+			continue
+		}
+
+		now := workflow.Now(ctx)
+		next := l.params.Spec.NextFireTime(l.state.LastFireTime)
+		if l.params.Paused {
+			next = time.Time{}
+		}
+		if !next.IsZero() && !next.After(now) {
+			l.state.LastFireTime = next
+			if l.params.Spec.CatchupWindow > 0 && now.Sub(next) > l.params.Spec.CatchupWindow {
+				logger.Warn("Skipping schedule run outside of catchup window",
+					zap.String("schedule", l.params.ScheduleID), zap.Time("fireTime", next))
+				l.recordRun(RunInfo{FireTime: next, StartTime: now, Trigger: TriggerSourceSchedule, Skipped: true})
+				continue
+			}
+			l.startRun(ctx, next, TriggerSourceSchedule, l.params.OverlapPolicy)
+			continue
+		}
+
+		l.waitForNextEvent(ctx, next)
+	}
+	l.params.State = &l.state
+	return workflow.NewContinueAsNewError(ctx, WorkflowTypeName, l.params)
+}
+
+// waitForNextEvent blocks until the next fire time or until a signal is received
+func (l *scheduleLoop) waitForNextEvent(ctx workflow.Context, next time.Time) {
+	timerCtx, cancelTimer := workflow.WithCancel(ctx)
+	defer cancelTimer()
+
+	selector := workflow.NewSelector(ctx)
+	if !next.IsZero() {
+		selector.AddFuture(workflow.NewTimer(timerCtx, next.Sub(workflow.Now(ctx))), func(workflow.Future) {})
+	}
+	l.addSignalHandlers(ctx, selector)
+	selector.Select(ctx)
+}
+
+// receiveSignals handles the buffered signals and reports whether any work is left for this run
+func (l *scheduleLoop) receiveSignals(ctx workflow.Context) bool {
+	var update UpdateRequest
+	for l.updateCh.ReceiveAsync(&update) {
+		l.onUpdate(ctx, update)
+	}
+	var pause PauseRequest
+	for l.pauseCh.ReceiveAsync(&pause) {
+		l.onPause(pause)
+	}
+	var unpause PauseRequest
+	for l.unpauseCh.ReceiveAsync(&unpause) {
+		l.onUnpause(ctx)
+	}
+	var trigger TriggerRequest
+	for l.triggerCh.ReceiveAsync(&trigger) {
+		l.onTrigger(ctx, trigger)
+	}
+	var backfill BackfillRequest
+	for l.backfillCh.ReceiveAsync(&backfill) {
+		l.onBackfill(ctx, backfill)
+	}
+	return len(l.triggers) > 0 || len(l.state.PendingBackfills) > 0
+}
+
+func (l *scheduleLoop) addSignalHandlers(ctx workflow.Context, selector workflow.Selector) {
+	selector.AddReceive(l.updateCh, func(c workflow.Channel, more bool) {
+		var update UpdateRequest
+		c.Receive(ctx, &update)
+		l.onUpdate(ctx, update)
+	})
+	selector.AddReceive(l.pauseCh, func(c workflow.Channel, more bool) {
+		var pause PauseRequest
+		c.Receive(ctx, &pause)
+		l.onPause(pause)
+	})
+	selector.AddReceive(l.unpauseCh, func(c workflow.Channel, more bool) {
+		var unpause PauseRequest
+		c.Receive(ctx, &unpause)
+		l.onUnpause(ctx)
+	})
+	selector.AddReceive(l.triggerCh, func(c workflow.Channel, more bool) {
+		var trigger TriggerRequest
+		c.Receive(ctx, &trigger)
+		l.onTrigger(ctx, trigger)
+	})
+	selector.AddReceive(l.backfillCh, func(c workflow.Channel, more bool) {
+		var backfill BackfillRequest
+		c.Receive(ctx, &backfill)
+		l.onBackfill(ctx, backfill)
+	})
+}
+
+func (l *scheduleLoop) onUpdate(ctx workflow.Context, update UpdateRequest) {
+	params := l.params
+	update.apply(&params)
+	if err := params.Validate(); err != nil {
+		workflow.GetLogger(ctx).Warn("Ignoring invalid schedule update", zap.Error(err))
+		return
+	}
+	l.params = params
+}
+
+func (l *scheduleLoop) onPause(pause PauseRequest) {
+	l.params.Paused = true
+	l.params.PauseReason = pause.Reason
+}
+
+func (l *scheduleLoop) onUnpause(ctx workflow.Context) {
+	if !l.params.Paused {
+		return
+	}
+	l.params.Paused = false
+	l.params.PauseReason = ""
+	// fire times missed while paused are not caught up
+	l.state.LastFireTime = workflow.Now(ctx)
+}
+
+func (l *scheduleLoop) onTrigger(ctx workflow.Context, trigger TriggerRequest) {
+	if trigger.OverlapPolicy != "" {
+		if err := ValidateOverlapPolicy(trigger.OverlapPolicy); err != nil {
+			workflow.GetLogger(ctx).Warn("Ignoring invalid schedule trigger", zap.Error(err))
+			return
+		}
+	}
+	l.triggers = append(l.triggers, trigger)
+}
+
+func (l *scheduleLoop) onBackfill(ctx workflow.Context, backfill BackfillRequest) {
+	if err := backfill.Validate(); err != nil {
+		workflow.GetLogger(ctx).Warn("Ignoring invalid schedule backfill", zap.Error(err))
+		return
+	}
+	l.state.PendingBackfills = append(l.state.PendingBackfills, backfill)
+}
+
+// backfillNext runs the next fire time of the first pending backfill
+func (l *scheduleLoop) backfillNext(ctx workflow.Context) {
+	backfill := &l.state.PendingBackfills[0]
+	// backfills are not bound to the start and end time of the spec
+	spec := Spec{CronSchedule: l.params.Spec.CronSchedule}
+	next := spec.NextFireTime(backfill.StartTime.Add(-time.Second))
+	if next.IsZero() || next.After(backfill.EndTime) {
+		l.state.PendingBackfills = l.state.PendingBackfills[1:]
+		return
+	}
+	backfill.StartTime = next.Add(time.Second)
+	overlapPolicy := backfill.OverlapPolicy
+	if overlapPolicy == "" {
+		overlapPolicy = l.params.OverlapPolicy
+	}
+	l.startRun(ctx, next, TriggerSourceBackfill, overlapPolicy)
+}
+
+func (l *scheduleLoop) startRun(ctx workflow.Context, fireTime time.Time, trigger TriggerSource, overlapPolicy OverlapPolicy) {
+	if overlapPolicy == "" {
+		overlapPolicy = l.params.OverlapPolicy
+	}
+	var requestID string
+	if err := workflow.SideEffect(ctx, func(workflow.Context) interface{} {
+		return uuid.New().String()
+	}).Get(&requestID); err != nil {
+		workflow.GetLogger(ctx).Error("Failed to generate request ID", zap.Error(err))
+		return
+	}
+	request := startWorkflowRequest{
+		Domain:        l.params.Domain,
+		ScheduleID:    l.params.ScheduleID,
+		Action:        l.params.Action,
+		FireTime:      fireTime,
+		Trigger:       trigger,
+		OverlapPolicy: overlapPolicy,
+		Previous:      l.state.LastStartedRun,
+		RequestID:     requestID,
+	}
+	var result startWorkflowResult
+	err := workflow.ExecuteActivity(
+		workflow.WithActivityOptions(ctx, activityOptions),
+		startWorkflowActivityName,
+		request,
+	).Get(ctx, &result)
+	run := RunInfo{
+		FireTime:   fireTime,
+		StartTime:  workflow.Now(ctx),
+		WorkflowID: result.WorkflowID,
+		RunID:      result.RunID,
+		Trigger:    trigger,
+		Skipped:    result.Skipped,
+	}
+	if err != nil {
+		workflow.GetLogger(ctx).Error("Failed to start schedule run",
+			zap.String("schedule", l.params.ScheduleID), zap.Time("fireTime", fireTime), zap.Error(err))
+		run.Skipped = true
+	}
+	l.recordRun(run)
+}
+
+func (l *scheduleLoop) recordRun(run RunInfo) {
+	if run.Skipped {
+		l.state.SkippedRuns++
+	} else {
+		l.state.TotalRuns++
+		l.state.LastStartedRun = &run
+	}
+	l.state.RecentRuns = append(l.state.RecentRuns, run)
+	if len(l.state.RecentRuns) > maxRecentRuns {
+		l.state.RecentRuns = l.state.RecentRuns[len(l.state.RecentRuns)-maxRecentRuns:]
+	}
+}
+
+func (l *scheduleLoop) describe() (Description, error) {
+	description := Description{
+		Domain:        l.params.Domain,
+		ScheduleID:    l.params.ScheduleID,
+		Spec:          l.params.Spec,
+		Action:        l.params.Action,
+		OverlapPolicy: l.params.OverlapPolicy,
+		Paused:        l.params.Paused,
+		PauseReason:   l.params.PauseReason,
+		State:         l.state,
+	}
+	if !l.params.Paused {
+		description.NextFireTime = l.params.Spec.NextFireTime(l.state.LastFireTime)
+	}
+	return description, nil
+}
@@ -0,0 +1,288 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/workflow"
+)
+
+type scheduleWorkflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+	env       *testsuite.TestWorkflowEnvironment
+	startTime time.Time
+	requests  []startWorkflowRequest
+}
+
+func TestScheduleWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(scheduleWorkflowTestSuite))
+}
+
+func (s *scheduleWorkflowTestSuite) SetupTest() {
+	s.env = s.NewTestWorkflowEnvironment()
+	s.startTime = time.Date(2024, 1, 1, 0, 0, 30, 0, time.UTC)
+	s.env.SetStartTime(s.startTime)
+	s.requests = nil
+
+	scheduler := &Scheduler{}
+	s.env.RegisterWorkflowWithOptions(scheduler.ScheduleWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	s.env.RegisterActivityWithOptions(scheduler.StartWorkflowActivity, activity.RegisterOptions{Name: startWorkflowActivityName})
+}
+
+func (s *scheduleWorkflowTestSuite) TearDownTest() {
+	s.env.AssertExpectations(s.T())
+}
+
+func (s *scheduleWorkflowTestSuite) params() Params {
+	return Params{
+		Domain:     "test-domain",
+		ScheduleID: "test-schedule",
+		Spec:       Spec{CronSchedule: "*/10 * * * *"},
+		Action: Action{
+			WorkflowType:                        "test-workflow",
+			TaskList:                            "test-tasklist",
+			ExecutionStartToCloseTimeoutSeconds: 60,
+			TaskStartToCloseTimeoutSeconds:      10,
+		},
+		OverlapPolicy: OverlapPolicySkip,
+	}
+}
+
+func (s *scheduleWorkflowTestSuite) mockActivity(skipped bool) {
+	s.env.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, request startWorkflowRequest) (startWorkflowResult, error) {
+			s.requests = append(s.requests, request)
+			return startWorkflowResult{
+				WorkflowID: request.Action.workflowID(request.ScheduleID, request.FireTime),
+				RunID:      "run-id",
+				Skipped:    skipped,
+			}, nil
+		})
+}
+
+func (s *scheduleWorkflowTestSuite) describe() Description {
+	value, err := s.env.QueryWorkflow(QueryTypeDescribe)
+	s.NoError(err)
+	var description Description
+	s.NoError(value.Get(&description))
+	return description
+}
+
+func (s *scheduleWorkflowTestSuite) fireTimes() []time.Time {
+	var fireTimes []time.Time
+	for _, request := range s.requests {
+		fireTimes = append(fireTimes, request.FireTime.UTC())
+	}
+	return fireTimes
+}
+
+func (s *scheduleWorkflowTestSuite) TestInvalidParams() {
+	params := s.params()
+	params.Spec.CronSchedule = "invalid"
+	s.env.ExecuteWorkflow(WorkflowTypeName, params)
+	s.True(s.env.IsWorkflowCompleted())
+	s.Error(s.env.GetWorkflowError())
+}
+
+func (s *scheduleWorkflowTestSuite) TestFiresOnSpec() {
+	s.mockActivity(false)
+	var description Description
+	s.env.RegisterDelayedCallback(func() {
+		description = s.describe()
+		s.env.CancelWorkflow()
+	}, 25*time.Minute)
+	s.env.ExecuteWorkflow(WorkflowTypeName, s.params())
+
+	s.Equal([]time.Time{
+		s.startTime.Add(10*time.Minute - 30*time.Second),
+		s.startTime.Add(20*time.Minute - 30*time.Second),
+	}, s.fireTimes())
+	s.Equal(int64(2), description.State.TotalRuns)
+	s.Equal(s.startTime.Add(30*time.Minute-30*time.Second), description.NextFireTime.UTC())
+	s.Equal("test-schedule-2024-01-01T00:20:00Z", description.State.LastStartedRun.WorkflowID)
+	s.Len(description.State.RecentRuns, 2)
+	s.Equal(OverlapPolicySkip, s.requests[0].OverlapPolicy)
+	s.Nil(s.requests[0].Previous)
+	s.Equal("test-schedule-2024-01-01T00:10:00Z", s.requests[1].Previous.WorkflowID)
+	s.NotEqual(s.requests[0].RequestID, s.requests[1].RequestID)
+}
+
+func (s *scheduleWorkflowTestSuite) TestSkippedRuns() {
+	s.mockActivity(true)
+	var description Description
+	s.env.RegisterDelayedCallback(func() {
+		description = s.describe()
+		s.env.CancelWorkflow()
+	}, 25*time.Minute)
+	s.env.ExecuteWorkflow(WorkflowTypeName, s.params())
+
+	s.Equal(int64(0), description.State.TotalRuns)
+	s.Equal(int64(2), description.State.SkippedRuns)
+	s.Nil(description.State.LastStartedRun)
+	s.Nil(s.requests[1].Previous)
+}
+
+func (s *scheduleWorkflowTestSuite) TestActivityError() {
+	s.env.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return(startWorkflowResult{}, cadence.NewCustomError(errNonRetriable, "start failed"))
+	var description Description
+	s.env.RegisterDelayedCallback(func() {
+		description = s.describe()
+		s.env.CancelWorkflow()
+	}, 15*time.Minute)
+	s.env.ExecuteWorkflow(WorkflowTypeName, s.params())
+
+	s.Equal(int64(1), description.State.SkippedRuns)
+	s.True(description.State.RecentRuns[0].Skipped)
+}
+
+func (s *scheduleWorkflowTestSuite) TestPauseAndUnpause() {
+	s.mockActivity(false)
+	var paused, unpaused Description
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalNamePause, PauseRequest{Reason: "maintenance"})
+	}, 5*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		paused = s.describe()
+	}, 6*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalNameUnpause, PauseRequest{})
+	}, 35*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		unpaused = s.describe()
+		s.env.CancelWorkflow()
+	}, 45*time.Minute)
+	s.env.ExecuteWorkflow(WorkflowTypeName, s.params())
+
+	s.True(paused.Paused)
+	s.Equal("maintenance", paused.PauseReason)
+	s.True(paused.NextFireTime.IsZero())
+	s.False(unpaused.Paused)
+	s.Empty(unpaused.PauseReason)
+	// fire times at 00:10, 00:20 and 00:30 are missed while paused and not caught up
+	s.Equal([]time.Time{s.startTime.Add(40*time.Minute - 30*time.Second)}, s.fireTimes())
+}
+
+func (s *scheduleWorkflowTestSuite) TestTrigger() {
+	s.mockActivity(false)
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalNameTrigger, TriggerRequest{OverlapPolicy: OverlapPolicyTerminatePrevious})
+	}, 5*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalNameTrigger, TriggerRequest{OverlapPolicy: "invalid"})
+	}, 6*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		s.env.CancelWorkflow()
+	}, 7*time.Minute)
+	s.env.ExecuteWorkflow(WorkflowTypeName, s.params())
+
+	s.Len(s.requests, 1)
+	s.Equal(TriggerSourceManual, s.requests[0].Trigger)
+	s.Equal(OverlapPolicyTerminatePrevious, s.requests[0].OverlapPolicy)
+	s.Equal(s.startTime.Add(5*time.Minute), s.requests[0].FireTime.UTC())
+}
+
+func (s *scheduleWorkflowTestSuite) TestBackfill() {
+	s.mockActivity(false)
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalNameBackfill, BackfillRequest{
+			StartTime: time.Date(2023, 12, 31, 23, 30, 0, 0, time.UTC),
+			EndTime:   time.Date(2023, 12, 31, 23, 50, 0, 0, time.UTC),
+		})
+	}, time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		s.env.CancelWorkflow()
+	}, 2*time.Minute)
+	s.env.ExecuteWorkflow(WorkflowTypeName, s.params())
+
+	s.Equal([]time.Time{
+		time.Date(2023, 12, 31, 23, 30, 0, 0, time.UTC),
+		time.Date(2023, 12, 31, 23, 40, 0, 0, time.UTC),
+		time.Date(2023, 12, 31, 23, 50, 0, 0, time.UTC),
+	}, s.fireTimes())
+	for _, request := range s.requests {
+		s.Equal(TriggerSourceBackfill, request.Trigger)
+	}
+}
+
+func (s *scheduleWorkflowTestSuite) TestUpdate() {
+	s.mockActivity(false)
+	var description Description
+	s.env.RegisterDelayedCallback(func() {
+		invalid := OverlapPolicy("invalid")
+		s.env.SignalWorkflow(SignalNameUpdate, UpdateRequest{OverlapPolicy: &invalid})
+		allowAll := OverlapPolicyAllowAll
+		s.env.SignalWorkflow(SignalNameUpdate, UpdateRequest{
+			Spec:          &Spec{CronSchedule: "*/15 * * * *"},
+			OverlapPolicy: &allowAll,
+		})
+	}, time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		description = s.describe()
+		s.env.CancelWorkflow()
+	}, 20*time.Minute)
+	s.env.ExecuteWorkflow(WorkflowTypeName, s.params())
+
+	s.Equal("*/15 * * * *", description.Spec.CronSchedule)
+	s.Equal(OverlapPolicyAllowAll, description.OverlapPolicy)
+	s.Equal([]time.Time{s.startTime.Add(15*time.Minute - 30*time.Second)}, s.fireTimes())
+	s.Equal(OverlapPolicyAllowAll, s.requests[0].OverlapPolicy)
+}
+
+func (s *scheduleWorkflowTestSuite) TestCatchupWindow() {
+	s.mockActivity(false)
+	params := s.params()
+	params.Spec.CatchupWindow = time.Minute
+	params.State = &State{LastFireTime: s.startTime.Add(-time.Hour)}
+	var description Description
+	s.env.RegisterDelayedCallback(func() {
+		description = s.describe()
+		s.env.CancelWorkflow()
+	}, time.Minute)
+	s.env.ExecuteWorkflow(WorkflowTypeName, params)
+
+	// the fire time at 00:00 is within the catchup window, the five before it are not
+	s.Equal(int64(5), description.State.SkippedRuns)
+	s.Equal([]time.Time{s.startTime.Add(-30 * time.Second)}, s.fireTimes())
+}
+
+func (s *scheduleWorkflowTestSuite) TestContinueAsNew() {
+	s.mockActivity(false)
+	params := s.params()
+	params.Spec.CronSchedule = "* * * * *"
+	s.env.ExecuteWorkflow(WorkflowTypeName, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	var continueAsNewErr *workflow.ContinueAsNewError
+	s.ErrorAs(s.env.GetWorkflowError(), &continueAsNewErr)
+	// every fire time takes one iteration to wait for it and one to start the run
+	s.Len(s.requests, maxIterationsPerRun/2)
+}
@@ -43,6 +43,7 @@ import (
 	"github.com/uber/cadence/service/worker/asyncworkflow"
 	"github.com/uber/cadence/service/worker/batcher"
 	"github.com/uber/cadence/service/worker/diagnostics"
+	"github.com/uber/cadence/service/worker/dlqtriage"
 	"github.com/uber/cadence/service/worker/domaindeprecation"
 	"github.com/uber/cadence/service/worker/esanalyzer"
 	"github.com/uber/cadence/service/worker/failovermanager"
@@ -54,6 +55,7 @@ import (
 	"github.com/uber/cadence/service/worker/scanner/shardscanner"
 	"github.com/uber/cadence/service/worker/scanner/tasklist"
 	"github.com/uber/cadence/service/worker/scanner/timers"
+	"github.com/uber/cadence/service/worker/scheduler"
 )
 
 type (
@@ -87,6 +89,8 @@ type (
 		EnableParentClosePolicyWorker       dynamicproperties.BoolPropertyFn
 		NumParentClosePolicySystemWorkflows dynamicproperties.IntPropertyFn
 		EnableFailoverManager               dynamicproperties.BoolPropertyFn
+		EnableScheduler                     dynamicproperties.BoolPropertyFn
+		EnableReplicationDLQTriage          dynamicproperties.BoolPropertyFn
 		DomainReplicationMaxRetryDuration   dynamicproperties.DurationPropertyFn
 		EnableESAnalyzer                    dynamicproperties.BoolPropertyFn
 		EnableAsyncWorkflowConsumption      dynamicproperties.BoolPropertyFn
@@ -159,6 +163,8 @@ func NewConfig(params *resource.Params) *Config {
 		BatcherCfg: &batcher.Config{
 			AdminOperationToken: dc.GetStringProperty(dynamicproperties.AdminOperationToken),
 			ClusterMetadata:     params.ClusterMetadata,
+			MaxRPS:              dc.GetIntPropertyFilteredByDomain(dynamicproperties.BatcherMaxRPS),
+			MaxConcurrency:      dc.GetIntPropertyFilteredByDomain(dynamicproperties.BatcherMaxConcurrency),
 		},
 		failoverManagerCfg: &failovermanager.Config{
 			AdminOperationToken: dc.GetStringProperty(dynamicproperties.AdminOperationToken),
@@ -184,6 +190,8 @@ func NewConfig(params *resource.Params) *Config {
 		NumParentClosePolicySystemWorkflows: dc.GetIntProperty(dynamicproperties.NumParentClosePolicySystemWorkflows),
 		EnableESAnalyzer:                    dc.GetBoolProperty(dynamicproperties.EnableESAnalyzer),
 		EnableFailoverManager:               dc.GetBoolProperty(dynamicproperties.EnableFailoverManager),
+		EnableScheduler:                     dc.GetBoolProperty(dynamicproperties.EnableScheduler),
+		EnableReplicationDLQTriage:          dc.GetBoolProperty(dynamicproperties.EnableReplicationDLQTriage),
 		ThrottledLogRPS:                     dc.GetIntProperty(dynamicproperties.WorkerThrottledLogRPS),
 		PersistenceGlobalMaxQPS:             dc.GetIntProperty(dynamicproperties.WorkerPersistenceGlobalMaxQPS),
 		PersistenceMaxQPS:                   dc.GetIntProperty(dynamicproperties.WorkerPersistenceMaxQPS),
@@ -252,6 +260,12 @@ func (s *Service) Start() {
 	if s.config.EnableFailoverManager() {
 		s.startFailoverManager()
 	}
+	if s.config.EnableScheduler() {
+		s.startScheduler()
+	}
+	if s.config.EnableReplicationDLQTriage() {
+		s.startReplicationDLQTriage()
+	}
 
 	cm := s.startAsyncWorkflowConsumerManager()
 	defer cm.Stop()
@@ -463,6 +477,38 @@ func (s *Service) startFailoverManager() {
 	}
 }
 
+func (s *Service) startReplicationDLQTriage() {
+	params := &dlqtriage.BootstrapParams{
+		Config: dlqtriage.Config{
+			ClusterMetadata: s.GetClusterMetadata(),
+		},
+		ServiceClient: s.params.PublicClient,
+		MetricsClient: s.GetMetricsClient(),
+		Logger:        s.GetLogger(),
+		TallyScope:    s.params.MetricScope,
+		ClientBean:    s.GetClientBean(),
+		Resource:      s.Resource,
+	}
+	if err := dlqtriage.New(params).Start(); err != nil {
+		s.Stop()
+		s.GetLogger().Fatal("error starting replication DLQ triage", tag.Error(err))
+	}
+}
+
+func (s *Service) startScheduler() {
+	params := &scheduler.BootstrapParams{
+		ServiceClient: s.params.PublicClient,
+		MetricsClient: s.GetMetricsClient(),
+		Logger:        s.GetLogger(),
+		TallyScope:    s.params.MetricScope,
+		ClientBean:    s.GetClientBean(),
+	}
+	if err := scheduler.New(params).Start(); err != nil {
+		s.Stop()
+		s.GetLogger().Fatal("error starting scheduler", tag.Error(err))
+	}
+}
+
 func (s *Service) startAsyncWorkflowConsumerManager() common.Daemon {
 	cm := asyncworkflow.NewConsumerManager(
 		s.GetLogger(),
@@ -436,6 +436,36 @@ func newAdminDomainCommands() []*cli.Command {
 				})
 			},
 		},
+		{
+			Name:    "failover-readiness",
+			Aliases: []string{"fr"},
+			Usage: "Check whether the standby cluster of a global domain has caught up with the active cluster. " +
+				"The global address flag must point to the active cluster of the domain.",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagTargetCluster,
+					Aliases:  []string{"tc"},
+					Usage:    "Standby cluster the domain would failover to",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagDestinationAddress,
+					Usage:    "Standby cadence-frontend address in <host>:<port> format",
+					Required: true,
+				},
+				&cli.IntFlag{
+					Name:  FlagSampleSize,
+					Usage: "Number of open workflows whose version histories are compared between the clusters",
+					Value: defaultReadinessSampleSize,
+				},
+				&cli.Int64Flag{
+					Name:  FlagMaxDLQMessages,
+					Usage: "Number of replication DLQ messages from the active cluster tolerated in the standby cluster",
+				},
+				getFormatFlag(),
+			},
+			Action: AdminFailoverReadiness,
+		},
 		{
 			Name:    "getdomainidorname",
 			Aliases: []string{"getdn"},
@@ -806,6 +836,33 @@ func newAdminClusterCommands() []*cli.Command {
 			Usage:       "Rebalance the domains active cluster",
 			Subcommands: newAdminRebalanceCommands(),
 		},
+		{
+			Name:    "replication-status",
+			Aliases: []string{"rs"},
+			Usage:   "Show how far this cluster is behind the source cluster in replicating each domain",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagSourceCluster,
+					Usage:    "The active cluster the domains are replicated from",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagShards,
+					Usage: "Comma separated shard IDs or inclusive ranges, all shards by default. Example: \"2,5-6,10\".",
+				},
+				&cli.StringFlag{
+					Name:    FlagDomain,
+					Aliases: []string{"do"},
+					Usage:   "Only show the given domain",
+				},
+				&cli.BoolFlag{
+					Name:  FlagLaggingOnly,
+					Usage: "Only show the domains lagging behind over their thresholds in any shard",
+				},
+				getFormatFlag(),
+			},
+			Action: AdminReplicationStatus,
+		},
 	}
 }
 
@@ -900,6 +957,38 @@ func newAdminQueueCommands() []*cli.Command {
 			Flags:   getQueueCommandFlags(),
 			Action:  AdminDescribeQueue,
 		},
+		{
+			Name:  "edit",
+			Usage: "split a slice by domain, merge virtual queues, or pause/resume a virtual queue of transfer or timer queue processor",
+			Flags: append(getQueueCommandFlags(),
+				&cli.StringFlag{
+					Name:     FlagQueueEditOperation,
+					Usage:    "edit operation: split, merge, pause or resume",
+					Required: true,
+				},
+				&cli.Int64Flag{
+					Name:  FlagVirtualQueueID,
+					Usage: "virtual queue to edit",
+				},
+				&cli.IntFlag{
+					Name:  FlagSliceIndex,
+					Usage: "position of the slice to split within the virtual queue, as listed by describe",
+				},
+				&cli.StringSliceFlag{
+					Name:  FlagDomainIDs,
+					Usage: "domain IDs split out of the slice",
+				},
+				&cli.Int64Flag{
+					Name:  FlagTargetVirtualQueueID,
+					Usage: "virtual queue receiving the split or merged slices",
+				},
+				&cli.DurationFlag{
+					Name:  FlagPauseDuration,
+					Usage: "how long the virtual queue stops loading tasks, e.g. 10m",
+				},
+			),
+			Action: AdminEditQueue,
+		},
 	}
 }
 
@@ -1109,6 +1198,11 @@ func newAdminFailoverCommands() []*cli.Command {
 					Usage: "Optional cron schedule on failover drill. Please specify failover drill wait time " +
 						"if this field is specific",
 				},
+				&cli.BoolFlag{
+					Name: FlagRequireReadiness,
+					Usage: "Optional, only failover the domains whose target cluster has caught up with the source cluster, " +
+						"see admin domain failover-readiness",
+				},
 			},
 			Action: AdminFailoverStart,
 		},
@@ -23,13 +23,16 @@ package cli
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/fatih/color"
 	"github.com/pborman/uuid"
 	"github.com/urfave/cli/v2"
 
+	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/replicationstatus"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/common/visibility"
 	"github.com/uber/cadence/service/worker/failovermanager"
@@ -107,6 +110,113 @@ func AdminDescribeCluster(c *cli.Context) error {
 	return nil
 }
 
+// ReplicationStatusRow is the replication lag of a domain on the standby cluster
+type ReplicationStatusRow struct {
+	SourceCluster string        `header:"Source Cluster" json:"sourceCluster"`
+	Domain        string        `header:"Domain" json:"domain"`
+	Lag           time.Duration `header:"Lag" json:"lag"`
+	PendingTasks  int64         `header:"Pending Tasks" json:"pendingTasks"`
+	LastApplied   time.Time     `header:"Last Applied" json:"lastApplied"`
+	LaggingShards []int32       `header:"Lagging Shards" json:"laggingShards"`
+}
+
+// AdminReplicationStatus shows how far the cluster is behind the source cluster in replicating each domain
+func AdminReplicationStatus(c *cli.Context) error {
+	sourceCluster, err := getRequiredOption(c, FlagSourceCluster)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+	adminClient, err := getDeps(c).ServerAdminClient(c)
+	if err != nil {
+		return err
+	}
+
+	shards, err := getReplicationStatusShards(c, adminClient)
+	if err != nil {
+		return err
+	}
+	var lags []*replicationstatus.DomainLag
+	for _, shardID := range shards {
+		shardLags, err := describeShardReplicationLags(c, adminClient, sourceCluster, shardID)
+		if err != nil {
+			return commoncli.Problem(fmt.Sprintf("Failed to describe replication status of shard %d", shardID), err)
+		}
+		lags = append(lags, shardLags...)
+	}
+
+	domain := c.String(FlagDomain)
+	laggingOnly := c.Bool(FlagLaggingOnly)
+	table := []ReplicationStatusRow{}
+	for _, status := range replicationstatus.Aggregate(lags) {
+		if domain != "" && status.DomainName != domain {
+			continue
+		}
+		if laggingOnly && !status.IsLagging() {
+			continue
+		}
+		table = append(table, ReplicationStatusRow{
+			SourceCluster: status.SourceCluster,
+			Domain:        status.DomainName,
+			Lag:           status.Lag,
+			PendingTasks:  status.PendingTasks,
+			LastApplied:   status.LastAppliedTime,
+			LaggingShards: status.LaggingShards,
+		})
+	}
+	return Render(c, table, RenderOptions{Color: true, DefaultTemplate: templateTable})
+}
+
+// getReplicationStatusShards returns the shards given by the shards flag, or all the shards of the cluster
+func getReplicationStatusShards(c *cli.Context, adminClient admin.Client) ([]int, error) {
+	if c.IsSet(FlagShards) {
+		shards, err := parseIntMultiRange(c.String(FlagShards))
+		if err != nil {
+			return nil, commoncli.Problem("Invalid shards flag", err)
+		}
+		return shards, nil
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return nil, commoncli.Problem("Error in creating context: ", err)
+	}
+	resp, err := adminClient.DescribeShardDistribution(ctx, &types.DescribeShardDistributionRequest{})
+	if err != nil {
+		return nil, commoncli.Problem("Failed to get number of shards", err)
+	}
+	shards := make([]int, 0, resp.NumberOfShards)
+	for shardID := 0; shardID < int(resp.NumberOfShards); shardID++ {
+		shards = append(shards, shardID)
+	}
+	return shards, nil
+}
+
+func describeShardReplicationLags(c *cli.Context, adminClient admin.Client, sourceCluster string, shardID int) ([]*replicationstatus.DomainLag, error) {
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := adminClient.DescribeQueue(ctx, &types.DescribeQueueRequest{
+		ShardID:     int32(shardID),
+		ClusterName: sourceCluster,
+		Type:        common.Int32Ptr(int32(constants.TaskTypeReplication)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	lags := make([]*replicationstatus.DomainLag, 0, len(resp.ProcessingQueueStates))
+	for _, state := range resp.ProcessingQueueStates {
+		lag, err := replicationstatus.DecodeDomainLag(state)
+		if err != nil {
+			return nil, err
+		}
+		lags = append(lags, lag)
+	}
+	return lags, nil
+}
+
 func AdminRebalanceStart(c *cli.Context) error {
 	client, err := getCadenceClient(c)
 	if err != nil {
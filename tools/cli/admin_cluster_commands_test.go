@@ -23,13 +23,16 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/urfave/cli/v2"
 	"go.uber.org/mock/gomock"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/common/visibility"
@@ -416,3 +419,96 @@ func TestAdminAddSearchAttribute_errors(t *testing.T) {
 		})
 	}
 }
+
+func TestAdminReplicationStatus(t *testing.T) {
+	replicationQueueType := common.Int32Ptr(int32(constants.TaskTypeReplication))
+	tests := []struct {
+		name         string
+		testSetup    func(td *cliTestData) *cli.Context
+		errContains  string // empty if no error is expected
+		expectedRows []ReplicationStatusRow
+	}{
+		{
+			name: "missing source cluster argument",
+			testSetup: func(td *cliTestData) *cli.Context {
+				return clitest.NewCLIContext(t, td.app)
+			},
+			errContains: "Required flag not found",
+		},
+		{
+			name: "lags of all shards are aggregated",
+			testSetup: func(td *cliTestData) *cli.Context {
+				td.mockAdminClient.EXPECT().DescribeShardDistribution(gomock.Any(), &types.DescribeShardDistributionRequest{}).
+					Return(&types.DescribeShardDistributionResponse{NumberOfShards: 2}, nil)
+				td.mockAdminClient.EXPECT().DescribeQueue(gomock.Any(), &types.DescribeQueueRequest{ShardID: 0, ClusterName: "active", Type: replicationQueueType}).
+					Return(&types.DescribeQueueResponse{ProcessingQueueStates: []string{
+						`{"domainID":"a-id","domainName":"a","sourceCluster":"active","shardID":0,"lag":2000000000,"pendingTasks":3,"lastAppliedTime":"1970-01-01T00:00:00Z","exceedsThresholds":true}`,
+						`{"domainID":"b-id","domainName":"b","sourceCluster":"active","shardID":0,"lag":1000000000,"pendingTasks":0,"lastAppliedTime":"1970-01-01T00:00:00Z"}`,
+					}}, nil)
+				td.mockAdminClient.EXPECT().DescribeQueue(gomock.Any(), &types.DescribeQueueRequest{ShardID: 1, ClusterName: "active", Type: replicationQueueType}).
+					Return(&types.DescribeQueueResponse{ProcessingQueueStates: []string{
+						`{"domainID":"a-id","domainName":"a","sourceCluster":"active","shardID":1,"lag":5000000000,"pendingTasks":1,"lastAppliedTime":"1970-01-01T00:00:00Z"}`,
+					}}, nil)
+				return clitest.NewCLIContext(t, td.app,
+					clitest.StringArgument(FlagSourceCluster, "active"),
+					clitest.StringArgument(FlagFormat, formatJSON),
+				)
+			},
+			expectedRows: []ReplicationStatusRow{
+				{SourceCluster: "active", Domain: "a", Lag: 5 * time.Second, PendingTasks: 4, LastApplied: time.Unix(0, 0).UTC(), LaggingShards: []int32{0}},
+				{SourceCluster: "active", Domain: "b", Lag: time.Second, LastApplied: time.Unix(0, 0).UTC()},
+			},
+		},
+		{
+			name: "only lagging domains of the given shards",
+			testSetup: func(td *cliTestData) *cli.Context {
+				td.mockAdminClient.EXPECT().DescribeQueue(gomock.Any(), &types.DescribeQueueRequest{ShardID: 3, ClusterName: "active", Type: replicationQueueType}).
+					Return(&types.DescribeQueueResponse{ProcessingQueueStates: []string{
+						`{"domainID":"a-id","domainName":"a","sourceCluster":"active","shardID":3,"lag":2000000000,"pendingTasks":3,"lastAppliedTime":"1970-01-01T00:00:00Z","exceedsThresholds":true}`,
+						`{"domainID":"b-id","domainName":"b","sourceCluster":"active","shardID":3,"lag":1000000000,"pendingTasks":0,"lastAppliedTime":"1970-01-01T00:00:00Z"}`,
+					}}, nil)
+				return clitest.NewCLIContext(t, td.app,
+					clitest.StringArgument(FlagSourceCluster, "active"),
+					clitest.StringArgument(FlagShards, "3"),
+					clitest.BoolArgument(FlagLaggingOnly, true),
+					clitest.StringArgument(FlagFormat, formatJSON),
+				)
+			},
+			expectedRows: []ReplicationStatusRow{
+				{SourceCluster: "active", Domain: "a", Lag: 2 * time.Second, PendingTasks: 3, LastApplied: time.Unix(0, 0).UTC(), LaggingShards: []int32{3}},
+			},
+		},
+		{
+			name: "DescribeQueue returns an error",
+			testSetup: func(td *cliTestData) *cli.Context {
+				td.mockAdminClient.EXPECT().DescribeQueue(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("critical error"))
+				return clitest.NewCLIContext(t, td.app,
+					clitest.StringArgument(FlagSourceCluster, "active"),
+					clitest.StringArgument(FlagShards, "3"),
+				)
+			},
+			errContains: "Failed to describe replication status of shard 3",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td := newCLITestData(t)
+			cliCtx := tt.testSetup(td)
+
+			err := AdminReplicationStatus(cliCtx)
+			if tt.errContains == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.errContains)
+			}
+			if tt.expectedRows == nil {
+				assert.Empty(t, td.consoleOutput())
+				return
+			}
+			var rows []ReplicationStatusRow
+			assert.NoError(t, json.Unmarshal([]byte(td.consoleOutput()), &rows))
+			assert.Equal(t, tt.expectedRows, rows)
+		})
+	}
+}
@@ -24,17 +24,22 @@ package cli
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"maps"
+	"slices"
 	"strconv"
 	"time"
 
 	"github.com/urfave/cli/v2"
+	"go.uber.org/yarpc"
 
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/codec"
 	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/queueedit"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/common/types/mapper/thrift"
 	"github.com/uber/cadence/tools/common/commoncli"
@@ -788,7 +793,143 @@ func AdminDescribeQueue(c *cli.Context) error {
 
 	output := getDeps(c).Output()
 	for _, state := range resp.ProcessingQueueStates {
+		if description, ok := queueedit.DecodeDescription(state); ok {
+			printVirtualQueueDescription(output, description)
+			continue
+		}
 		fmt.Fprintln(output, state)
 	}
 	return nil
 }
+
+// AdminEditQueue applies a manual edit to the virtual queues of a task processing queue
+func AdminEditQueue(c *cli.Context) error {
+	adminClient, err := getDeps(c).ServerAdminClient(c)
+	if err != nil {
+		return err
+	}
+
+	shardID, err := getRequiredIntOption(c, FlagShardID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+	clusterName, err := getRequiredOption(c, FlagCluster)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+	typeID, err := getRequiredIntOption(c, FlagQueueType)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+	edit := &queueedit.Edit{
+		Operation:            queueedit.Operation(c.String(FlagQueueEditOperation)),
+		VirtualQueueID:       c.Int64(FlagVirtualQueueID),
+		SliceIndex:           c.Int(FlagSliceIndex),
+		DomainIDs:            c.StringSlice(FlagDomainIDs),
+		TargetVirtualQueueID: c.Int64(FlagTargetVirtualQueueID),
+		PauseDuration:        c.Duration(FlagPauseDuration),
+	}
+	if err := edit.Validate(); err != nil {
+		return commoncli.Problem("Invalid queue edit", err)
+	}
+	value, err := queueedit.Encode(edit)
+	if err != nil {
+		return commoncli.Problem("Failed to encode queue edit", err)
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+	// only hosts describing virtual queues can edit them, the others would reject the edit anyway
+	resp, err := adminClient.DescribeQueue(ctx, &types.DescribeQueueRequest{
+		ShardID:     int32(shardID),
+		ClusterName: clusterName,
+		Type:        common.Int32Ptr(int32(typeID)),
+	})
+	if err != nil {
+		return commoncli.Problem("Failed to describe queue", err)
+	}
+	if !describesVirtualQueues(resp) {
+		return commoncli.Problem("Failed to edit queue", fmt.Errorf("the queue of shard %v has no virtual queues to edit", shardID))
+	}
+
+	req := &types.ResetQueueRequest{
+		ShardID:     int32(shardID),
+		ClusterName: queueedit.EditClusterName(clusterName),
+		Type:        common.Int32Ptr(int32(typeID)),
+	}
+
+	// ResetQueue applies the edit carried in the header instead of resetting the queue, hosts which can't apply
+	// edits don't know the cluster name of the edit and reject it
+	err = adminClient.ResetQueue(ctx, req, yarpc.WithHeader(common.QueueEditHeaderName, value))
+	if err != nil {
+		return commoncli.Problem("Failed to edit queue", err)
+	}
+
+	fmt.Fprintln(getDeps(c).Output(), "Edit queue succeeded")
+	return nil
+}
+
+func describesVirtualQueues(resp *types.DescribeQueueResponse) bool {
+	for _, state := range resp.ProcessingQueueStates {
+		if _, ok := queueedit.DecodeDescription(state); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func printVirtualQueueDescription(output io.Writer, description *queueedit.VirtualQueueDescription) {
+	paused := ""
+	if description.Paused {
+		paused = " (paused)"
+	}
+	fmt.Fprintf(output, "VirtualQueue %d%s\n", description.VirtualQueueID, paused)
+	for i, slice := range description.Slices {
+		taskRange := slice.Range
+		if taskRange == nil {
+			taskRange = &types.TaskRange{}
+		}
+		fmt.Fprintf(output, "  Slice %d: [%s, %s) %s, pending tasks: %d\n",
+			i,
+			formatTaskKey(taskRange.InclusiveMin),
+			formatTaskKey(taskRange.ExclusiveMax),
+			formatPredicate(slice.Predicate),
+			slice.PendingTaskCount,
+		)
+		domainIDs := slices.Sorted(maps.Keys(slice.PendingTaskCountPerDomain))
+		for _, domainID := range domainIDs {
+			fmt.Fprintf(output, "    %s: %d\n", domainID, slice.PendingTaskCountPerDomain[domainID])
+		}
+	}
+}
+
+func formatTaskKey(key *types.TaskKey) string {
+	if key == nil {
+		return "-"
+	}
+	if key.ScheduledTimeNano == 0 {
+		return strconv.FormatInt(key.TaskID, 10)
+	}
+	return fmt.Sprintf("%s/%d", time.Unix(0, key.ScheduledTimeNano).UTC().Format(time.RFC3339Nano), key.TaskID)
+}
+
+func formatPredicate(predicate *types.Predicate) string {
+	if predicate == nil {
+		return "all domains"
+	}
+	switch predicate.PredicateType {
+	case types.PredicateTypeEmpty:
+		return "no domain"
+	case types.PredicateTypeDomainID:
+		attributes := predicate.DomainIDPredicateAttributes
+		if attributes.GetIsExclusive() {
+			return fmt.Sprintf("domains except %v", attributes.DomainIDs)
+		}
+		return fmt.Sprintf("domains %v", attributes.DomainIDs)
+	default:
+		return "all domains"
+	}
+}
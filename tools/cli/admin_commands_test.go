@@ -40,6 +40,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/queueedit"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/tools/cli/clitest"
 )
@@ -57,12 +58,13 @@ const (
 )
 
 type cliTestData struct {
-	ctrl               *gomock.Controller
-	mockFrontendClient *frontend.MockClient
-	mockAdminClient    *admin.MockClient
-	ioHandler          *testIOHandler
-	app                *cli.App
-	mockManagerFactory *MockManagerFactory
+	ctrl                       *gomock.Controller
+	mockFrontendClient         *frontend.MockClient
+	mockAdminClient            *admin.MockClient
+	mockDestinationAdminClient *admin.MockClient
+	ioHandler                  *testIOHandler
+	app                        *cli.App
+	mockManagerFactory         *MockManagerFactory
 }
 
 func newCLITestData(t *testing.T) *cliTestData {
@@ -72,14 +74,16 @@ func newCLITestData(t *testing.T) *cliTestData {
 
 	td.mockFrontendClient = frontend.NewMockClient(td.ctrl)
 	td.mockAdminClient = admin.NewMockClient(td.ctrl)
+	td.mockDestinationAdminClient = admin.NewMockClient(td.ctrl)
 	td.mockManagerFactory = NewMockManagerFactory(td.ctrl)
 	td.ioHandler = &testIOHandler{}
 
 	// Create a new CLI app with client factory and persistence manager factory
 	td.app = NewCliApp(
 		&clientFactoryMock{
-			serverFrontendClient: td.mockFrontendClient,
-			serverAdminClient:    td.mockAdminClient,
+			serverFrontendClient:   td.mockFrontendClient,
+			serverAdminClient:      td.mockAdminClient,
+			destinationAdminClient: td.mockDestinationAdminClient,
 		},
 		WithIOHandler(td.ioHandler),
 		WithManagerFactory(td.mockManagerFactory), // Inject the mocked persistence manager factory
@@ -251,6 +255,34 @@ func TestAdminDescribeQueue(t *testing.T) {
 			errContains:    "",
 			expectedOutput: "state1\nstate2\n",
 		},
+		{
+			name: "virtual queues are rendered as a tree",
+			testSetup: func(td *cliTestData) *cli.Context {
+				cliCtx := clitest.NewCLIContext(
+					t,
+					td.app,
+					clitest.IntArgument(FlagShardID, testShardID),
+					clitest.StringArgument(FlagCluster, testCluster),
+					clitest.IntArgument(FlagQueueType, testQueueType),
+				)
+
+				td.mockAdminClient.EXPECT().DescribeQueue(gomock.Any(), gomock.Any()).Return(&types.DescribeQueueResponse{
+					ProcessingQueueStates: []string{
+						`{"virtualQueueID":0,"slices":[{"range":{"InclusiveMin":{"TaskID":100},"ExclusiveMax":{"TaskID":200}},"predicate":{"PredicateType":2,"DomainIDPredicateAttributes":{"DomainIDs":["d1"],"IsExclusive":true}},"pendingTaskCount":5,"pendingTaskCountPerDomain":{"d3":2,"d2":3}}]}`,
+						`{"virtualQueueID":1,"paused":true,"slices":[]}`,
+						"Alert QueueStuckSlice: raised at 1970-01-01T00:16:40Z, unresolved",
+					},
+				}, nil)
+
+				return cliCtx
+			},
+			expectedOutput: "VirtualQueue 0\n" +
+				"  Slice 0: [100, 200) domains except [d1], pending tasks: 5\n" +
+				"    d2: 3\n" +
+				"    d3: 2\n" +
+				"VirtualQueue 1 (paused)\n" +
+				"Alert QueueStuckSlice: raised at 1970-01-01T00:16:40Z, unresolved\n",
+		},
 		{
 			name: "DescribeQueue returns an error",
 			testSetup: func(td *cliTestData) *cli.Context {
@@ -287,6 +319,118 @@ func TestAdminDescribeQueue(t *testing.T) {
 	}
 }
 
+func TestAdminEditQueue(t *testing.T) {
+	queueArguments := []clitest.CliArgument{
+		clitest.IntArgument(FlagShardID, testShardID),
+		clitest.StringArgument(FlagCluster, testCluster),
+		clitest.IntArgument(FlagQueueType, testQueueType),
+	}
+	describeRequest := &types.DescribeQueueRequest{
+		ShardID:     testShardID,
+		ClusterName: testCluster,
+		Type:        common.Int32Ptr(testQueueType),
+	}
+	virtualQueues := &types.DescribeQueueResponse{
+		ProcessingQueueStates: []string{`{"virtualQueueID":0,"slices":[]}`},
+	}
+	tests := []struct {
+		name           string
+		testSetup      func(td *cliTestData) *cli.Context
+		errContains    string // empty if no error is expected
+		expectedOutput string
+	}{
+		{
+			name: "missing queue type argument",
+			testSetup: func(td *cliTestData) *cli.Context {
+				return clitest.NewCLIContext(
+					t,
+					td.app,
+					clitest.IntArgument(FlagShardID, testShardID),
+					clitest.StringArgument(FlagCluster, testCluster),
+				)
+			},
+			errContains: "Required flag not found",
+		},
+		{
+			name: "invalid edit",
+			testSetup: func(td *cliTestData) *cli.Context {
+				return clitest.NewCLIContext(t, td.app, append(queueArguments,
+					clitest.StringArgument(FlagQueueEditOperation, "pause"),
+				)...)
+			},
+			errContains: "Invalid queue edit",
+		},
+		{
+			name: "split",
+			testSetup: func(td *cliTestData) *cli.Context {
+				cliCtx := clitest.NewCLIContext(t, td.app, append(queueArguments,
+					clitest.StringArgument(FlagQueueEditOperation, "split"),
+					clitest.IntArgument(FlagSliceIndex, 1),
+					clitest.StringSliceArgument(FlagDomainIDs, "d1", "d2"),
+					clitest.Int64Argument(FlagTargetVirtualQueueID, 2),
+				)...)
+
+				td.mockAdminClient.EXPECT().DescribeQueue(gomock.Any(), describeRequest).Return(virtualQueues, nil)
+				td.mockAdminClient.EXPECT().ResetQueue(gomock.Any(), &types.ResetQueueRequest{
+					ShardID:     testShardID,
+					ClusterName: queueedit.EditClusterName(testCluster),
+					Type:        common.Int32Ptr(testQueueType),
+				}, gomock.Any())
+
+				return cliCtx
+			},
+			expectedOutput: "Edit queue succeeded\n",
+		},
+		{
+			name: "queue without virtual queues",
+			testSetup: func(td *cliTestData) *cli.Context {
+				cliCtx := clitest.NewCLIContext(t, td.app, append(queueArguments,
+					clitest.StringArgument(FlagQueueEditOperation, "resume"),
+					clitest.Int64Argument(FlagVirtualQueueID, 1),
+				)...)
+
+				td.mockAdminClient.EXPECT().DescribeQueue(gomock.Any(), describeRequest).Return(&types.DescribeQueueResponse{
+					ProcessingQueueStates: []string{"processing queue state"},
+				}, nil)
+
+				return cliCtx
+			},
+			errContains: "has no virtual queues to edit",
+		},
+		{
+			name: "ResetQueue returns an error",
+			testSetup: func(td *cliTestData) *cli.Context {
+				cliCtx := clitest.NewCLIContext(t, td.app, append(queueArguments,
+					clitest.StringArgument(FlagQueueEditOperation, "resume"),
+					clitest.Int64Argument(FlagVirtualQueueID, 1),
+				)...)
+
+				td.mockAdminClient.EXPECT().DescribeQueue(gomock.Any(), gomock.Any()).Return(virtualQueues, nil)
+				td.mockAdminClient.EXPECT().ResetQueue(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(errors.New("critical error"))
+
+				return cliCtx
+			},
+			errContains: "Failed to edit queue",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td := newCLITestData(t)
+			cliCtx := tt.testSetup(td)
+
+			err := AdminEditQueue(cliCtx)
+			if tt.errContains == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.errContains)
+			}
+			assert.Equal(t, tt.expectedOutput, td.consoleOutput())
+		})
+	}
+}
+
 func TestAdminRefreshWorkflowTasks(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -33,6 +33,7 @@ import (
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/failoverreadiness"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/worker/failovermanager"
 	"github.com/uber/cadence/tools/common/commoncli"
@@ -43,6 +44,7 @@ const (
 	defaultBatchFailoverSize                = 20
 	defaultBatchFailoverWaitTimeInSeconds   = 30
 	defaultFailoverWorkflowTimeoutInSeconds = 1200
+	defaultReadinessSampleSize              = 20
 )
 
 var (
@@ -60,6 +62,7 @@ type startParams struct {
 	domains                        []string
 	drillWaitTime                  int
 	cron                           string
+	requireReadiness               bool
 }
 
 // AdminFailoverStart start failover workflow
@@ -82,6 +85,7 @@ func AdminFailoverStart(c *cli.Context) error {
 		domains:                        c.StringSlice(FlagFailoverDomains),
 		drillWaitTime:                  c.Int(FlagFailoverDrillWaitTime),
 		cron:                           c.String(FlagCronSchedule),
+		requireReadiness:               c.Bool(FlagRequireReadiness),
 	}
 	return failoverStart(c, params)
 }
@@ -373,6 +377,9 @@ func failoverStart(c *cli.Context, params *startParams) error {
 		DrillWaitTime:                    drillWaitTime,
 		GracefulFailoverTimeoutInSeconds: gracefulFailoverTimeoutInSeconds,
 	}
+	if params.requireReadiness {
+		foParams.ReadinessCheck = &failoverreadiness.Options{SampleSize: defaultReadinessSampleSize}
+	}
 	input, err := json.Marshal(foParams)
 	if err != nil {
 		return commoncli.Problem("Failed to serialize Failover Params", err)
@@ -460,3 +467,79 @@ func validateStartParams(params *startParams) error {
 	}
 	return nil
 }
+
+// AdminFailoverReadiness checks whether the standby cluster of a domain has caught up with its active cluster
+func AdminFailoverReadiness(c *cli.Context) error {
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	standbyCluster, err := getRequiredOption(c, FlagTargetCluster)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	frontendClient, err := getDeps(c).ServerFrontendClient(c)
+	if err != nil {
+		return err
+	}
+	activeAdminClient, err := getDeps(c).ServerAdminClient(c)
+	if err != nil {
+		return err
+	}
+	standbyAdminClient, err := getDeps(c).ServerAdminClientForMigration(c)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+	checker := failoverreadiness.NewChecker(&failoverreadiness.Params{
+		StandbyCluster:     standbyCluster,
+		FrontendClient:     frontendClient,
+		ActiveAdminClient:  activeAdminClient,
+		StandbyAdminClient: standbyAdminClient,
+		Options: failoverreadiness.Options{
+			MaxDLQMessages: c.Int64(FlagMaxDLQMessages),
+			SampleSize:     c.Int(FlagSampleSize),
+		},
+	})
+	report, err := checker.Check(ctx, domain)
+	if err != nil {
+		return commoncli.Problem("Failed to check failover readiness", err)
+	}
+	if err := Render(c, report, RenderOptions{DefaultTemplate: failoverReadinessTemplate, Color: true}); err != nil {
+		return fmt.Errorf("failed to render: %w", err)
+	}
+	if !report.Ready {
+		return commoncli.Problem(fmt.Sprintf("Domain %v is not ready to failover to %v", domain, standbyCluster), nil)
+	}
+	return nil
+}
+
+const failoverReadinessTemplate = `Domain: {{.Domain}}
+Active cluster: {{.ActiveCluster}}
+Standby cluster: {{.StandbyCluster}}
+Ready: {{.Ready}}
+Replication lag: {{.ReplicationLag}}
+Pending replication tasks: {{.PendingReplicationTasks}}
+{{- with .LaggingShards}}
+Lagging shards: {{.}}
+{{- end}}
+Replication DLQ messages: {{.DLQMessages}}
+Sampled workflows: {{.SampledWorkflows}}
+{{- with .Mismatches}}
+Mismatched workflows:
+{{- range .}}
+  - {{.WorkflowID}} ({{.RunID}}): {{.Type}}
+{{- end}}
+{{- end}}
+{{- with .Reasons}}
+Not ready because:
+{{- range .}}
+  - {{.}}
+{{- end}}
+{{- end}}
+`
@@ -29,14 +29,19 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
 	"go.uber.org/mock/gomock"
 	"go.uber.org/yarpc"
 
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/replicationstatus"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/worker/failovermanager"
+	"github.com/uber/cadence/tools/cli/clitest"
 )
 
 func TestAdminFailoverStart(t *testing.T) {
@@ -60,6 +65,7 @@ func TestAdminFailoverStart(t *testing.T) {
 		failoverDomains         []string
 		failoverDrillWaitTime   int
 		failoverCron            string
+		requireReadiness        bool
 		runID                   string
 		mockFn                  func(*testing.T, *frontend.MockClient)
 		wantErr                 bool
@@ -102,6 +108,27 @@ func TestAdminFailoverStart(t *testing.T) {
 					}).Times(1)
 			},
 		},
+		{
+			desc:              "success with readiness check",
+			sourceCluster:     "cluster1",
+			targetCluster:     "cluster2",
+			failoverBatchSize: 10,
+			failoverWaitTime:  120,
+			failoverWFTimeout: 600,
+			failoverDomains:   []string{"domain1"},
+			requireReadiness:  true,
+			mockFn: func(t *testing.T, m *frontend.MockClient) {
+				m.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				m.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, gotReq *types.StartWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
+						wantInput := `{"TargetCluster":"cluster2","SourceCluster":"cluster1","BatchFailoverSize":10,"BatchFailoverWaitTimeInSeconds":120,"Domains":["domain1"],"DrillWaitTime":0,"GracefulFailoverTimeoutInSeconds":null,"ReadinessCheck":{"MaxDLQMessages":0,"SampleSize":20}}`
+						if diff := cmp.Diff(wantInput, string(gotReq.Input)); diff != "" {
+							t.Fatalf("Input mismatch (-want +got):\n%s", diff)
+						}
+						return &types.StartWorkflowExecutionResponse{}, nil
+					}).Times(1)
+			},
+		},
 		{
 			desc:          "startworkflow fails",
 			wantErr:       true,
@@ -209,6 +236,7 @@ func TestAdminFailoverStart(t *testing.T) {
 				"--domains", strings.Join(tc.failoverDomains, ","),
 				"--failover_drill_wait_second", strconv.Itoa(tc.failoverDrillWaitTime),
 				"--cron", tc.failoverCron,
+				"--require_readiness=" + strconv.FormatBool(tc.requireReadiness),
 			}
 			err := app.Run(args)
 
@@ -665,3 +693,87 @@ func mustMarshalFailoverParams(t *testing.T, p failovermanager.FailoverParams) [
 	}
 	return res
 }
+
+func TestAdminFailoverReadiness(t *testing.T) {
+	describeDomainResponse := &types.DescribeDomainResponse{
+		DomainInfo: &types.DomainInfo{Name: "test-domain", UUID: "test-domain-id"},
+		ReplicationConfiguration: &types.DomainReplicationConfiguration{
+			ActiveClusterName: "active",
+			Clusters: []*types.ClusterReplicationConfiguration{
+				{ClusterName: "active"},
+				{ClusterName: "standby"},
+			},
+		},
+		IsGlobalDomain: true,
+	}
+	domainLag, err := replicationstatus.EncodeDomainLag(&replicationstatus.DomainLag{DomainID: "test-domain-id", SourceCluster: "active"})
+	require.NoError(t, err)
+	tests := []struct {
+		name           string
+		testSetup      func(td *cliTestData) *cli.Context
+		errContains    string // empty if no error is expected
+		expectedOutput []string
+	}{
+		{
+			name: "missing target cluster argument",
+			testSetup: func(td *cliTestData) *cli.Context {
+				return clitest.NewCLIContext(t, td.app, clitest.StringArgument(FlagDomain, "test-domain"))
+			},
+			errContains: "Required flag not found",
+		},
+		{
+			name: "ready",
+			testSetup: func(td *cliTestData) *cli.Context {
+				td.mockFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(describeDomainResponse, nil)
+				td.mockDestinationAdminClient.EXPECT().DescribeShardDistribution(gomock.Any(), gomock.Any()).
+					Return(&types.DescribeShardDistributionResponse{NumberOfShards: 1}, nil)
+				td.mockDestinationAdminClient.EXPECT().DescribeQueue(gomock.Any(), gomock.Any()).
+					Return(&types.DescribeQueueResponse{ProcessingQueueStates: []string{domainLag}}, nil)
+				td.mockDestinationAdminClient.EXPECT().CountDLQMessages(gomock.Any(), gomock.Any()).
+					Return(&types.CountDLQMessagesResponse{}, nil)
+				return clitest.NewCLIContext(t, td.app,
+					clitest.StringArgument(FlagDomain, "test-domain"),
+					clitest.StringArgument(FlagTargetCluster, "standby"),
+					clitest.IntArgument(FlagSampleSize, 0),
+				)
+			},
+			expectedOutput: []string{"Domain: test-domain", "Active cluster: active", "Ready: true"},
+		},
+		{
+			name: "not ready",
+			testSetup: func(td *cliTestData) *cli.Context {
+				td.mockFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(describeDomainResponse, nil)
+				td.mockDestinationAdminClient.EXPECT().DescribeShardDistribution(gomock.Any(), gomock.Any()).
+					Return(&types.DescribeShardDistributionResponse{}, nil)
+				td.mockDestinationAdminClient.EXPECT().CountDLQMessages(gomock.Any(), gomock.Any()).
+					Return(&types.CountDLQMessagesResponse{
+						History: map[types.HistoryDLQCountKey]int64{{ShardID: 1, SourceCluster: "active"}: 3},
+					}, nil)
+				return clitest.NewCLIContext(t, td.app,
+					clitest.StringArgument(FlagDomain, "test-domain"),
+					clitest.StringArgument(FlagTargetCluster, "standby"),
+					clitest.IntArgument(FlagSampleSize, 0),
+				)
+			},
+			errContains:    "Domain test-domain is not ready to failover to standby",
+			expectedOutput: []string{"Ready: false", "no replication status of the domain in standby", "3 replication DLQ messages from active"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td := newCLITestData(t)
+			cliCtx := tt.testSetup(td)
+
+			err := AdminFailoverReadiness(cliCtx)
+			if tt.errContains == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.errContains)
+			}
+			for _, output := range tt.expectedOutput {
+				assert.Contains(t, td.consoleOutput(), output)
+			}
+		})
+	}
+}
@@ -22,6 +22,7 @@ package cli
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -36,12 +37,17 @@ import (
 	"github.com/stretchr/testify/suite"
 	"github.com/urfave/cli/v2"
 	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc"
 
 	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/activitycontrol"
 	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/delayedsignal"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/workflowpause"
+	"github.com/uber/cadence/common/workflowupdate"
 	"github.com/uber/cadence/tools/cli/clitest"
 )
 
@@ -66,9 +72,10 @@ type (
 var _ ClientFactory = (*clientFactoryMock)(nil)
 
 type clientFactoryMock struct {
-	serverFrontendClient frontend.Client
-	serverAdminClient    admin.Client
-	config               *config.Config
+	serverFrontendClient   frontend.Client
+	serverAdminClient      admin.Client
+	destinationAdminClient admin.Client
+	config                 *config.Config
 }
 
 func (m *clientFactoryMock) ServerFrontendClient(c *cli.Context) (frontend.Client, error) {
@@ -84,7 +91,7 @@ func (m *clientFactoryMock) ServerFrontendClientForMigration(c *cli.Context) (fr
 }
 
 func (m *clientFactoryMock) ServerAdminClientForMigration(c *cli.Context) (admin.Client, error) {
-	panic("not implemented")
+	return m.destinationAdminClient, nil
 }
 
 func (m *clientFactoryMock) ElasticSearchClient(c *cli.Context) (*elastic.Client, error) {
@@ -483,6 +490,172 @@ func (s *cliAppSuite) TestSignalWorkflow_Failed() {
 	s.Error(s.app.Run([]string{"", "--do", domainName, "workflow", "signal", "-w", "wid", "-n", "signal-name"}))
 }
 
+func (s *cliAppSuite) TestSignalWorkflow_DeliverAfter() {
+	s.serverFrontendClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, request *types.SignalWorkflowExecutionRequest, _ ...yarpc.CallOption) error {
+			s.Equal(delayedsignal.SignalName, request.SignalName)
+			delayedSignal, err := delayedsignal.DecodeRequest(request.Input)
+			s.NoError(err)
+			s.Equal("signal-name", delayedSignal.SignalName)
+			s.Equal(`"payload"`, string(delayedSignal.Input))
+			s.Greater(delayedSignal.DeliverAfterNano, time.Now().UnixNano())
+			return nil
+		})
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "signal", "-w", "wid", "-n", "signal-name", "-i", `"payload"`, "--deliver_after", "1h"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestSignalWorkflow_DeliverAfterInvalid() {
+	s.Error(s.app.Run([]string{"", "--do", domainName, "workflow", "signal", "-w", "wid", "-n", "signal-name", "--deliver_after", "-1h"}))
+}
+
+func (s *cliAppSuite) TestCancelDelayedSignal() {
+	s.serverFrontendClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, request *types.SignalWorkflowExecutionRequest, _ ...yarpc.CallOption) error {
+			s.Equal(delayedsignal.CancelSignalName, request.SignalName)
+			delayedSignal, err := delayedsignal.DecodeRequest(request.Input)
+			s.NoError(err)
+			s.Equal(int64(3), delayedSignal.ID)
+			return nil
+		})
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "cancel-signal", "-w", "wid", "--delayed_signal_id", "3"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestCancelDelayedSignal_Failed() {
+	s.serverFrontendClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.BadRequestError{Message: "faked error"})
+	s.ErrorContains(s.app.Run([]string{"", "--do", domainName, "workflow", "cancel-signal", "-w", "wid", "--delayed_signal_id", "3"}), "Cancel delayed signal failed.")
+}
+
+func (s *cliAppSuite) TestPauseWorkflow() {
+	s.serverFrontendClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, request *types.SignalWorkflowExecutionRequest, _ ...yarpc.CallOption) error {
+			s.Equal(workflowpause.PauseSignalName, request.SignalName)
+			s.JSONEq(`{"reason":"incident"}`, string(request.Input))
+			s.NotEmpty(request.RequestID)
+			return nil
+		})
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "pause", "-w", "wid", "--reason", "incident"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestPauseWorkflow_Failed() {
+	s.serverFrontendClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.BadRequestError{Message: "faked error"})
+	s.ErrorContains(s.app.Run([]string{"", "--do", domainName, "workflow", "pause", "-w", "wid"}), "Pause workflow failed.")
+}
+
+func (s *cliAppSuite) TestUnpauseWorkflow() {
+	s.serverFrontendClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, request *types.SignalWorkflowExecutionRequest, _ ...yarpc.CallOption) error {
+			s.Equal(workflowpause.UnpauseSignalName, request.SignalName)
+			s.Equal("rid", request.WorkflowExecution.RunID)
+			return nil
+		})
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "unpause", "-w", "wid", "-r", "rid"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestPauseActivity() {
+	s.serverFrontendClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, request *types.SignalWorkflowExecutionRequest, _ ...yarpc.CallOption) error {
+			s.Equal(activitycontrol.PauseSignalName, request.SignalName)
+			s.JSONEq(`{"activityID":"aid","reason":"incident"}`, string(request.Input))
+			return nil
+		})
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "activity", "pause", "-w", "wid", "--aid", "aid", "--reason", "incident"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestUpdateActivityOptions() {
+	s.serverFrontendClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, request *types.SignalWorkflowExecutionRequest, _ ...yarpc.CallOption) error {
+			s.Equal(activitycontrol.UpdateOptionsSignalName, request.SignalName)
+			decoded, err := activitycontrol.DecodeRequest(request.Input)
+			s.NoError(err)
+			s.Equal("aid", decoded.ActivityID)
+			s.Equal(int32(5), decoded.RetryPolicy.MaximumAttempts)
+			s.Equal(common.Int32Ptr(30), decoded.HeartbeatTimeoutSeconds)
+			s.Nil(decoded.StartToCloseTimeoutSeconds)
+			return nil
+		})
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "activity", "update-options", "-w", "wid", "--aid", "aid",
+		"--retry_attempts", "5", "--heart_beat_timeout_seconds", "30"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestUpdateActivityOptions_NoOptions() {
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "activity", "update-options", "-w", "wid", "--aid", "aid"})
+	s.ErrorContains(err, "Invalid activity request")
+}
+
+func (s *cliAppSuite) TestUpdateWorkflow() {
+	outcome := func(stage workflowupdate.Stage, result string) *types.QueryWorkflowResponse {
+		encoded, err := workflowupdate.EncodeOutcome(workflowupdate.Outcome{Stage: stage, Result: []byte(result)})
+		s.NoError(err)
+		return &types.QueryWorkflowResponse{QueryResult: encoded}
+	}
+	tests := []struct {
+		name      string
+		responses []*types.QueryWorkflowResponse
+		queryErr  error
+		expectErr string
+	}{
+		{
+			name: "completed",
+			responses: []*types.QueryWorkflowResponse{
+				outcome(workflowupdate.StageRequested, ""),
+				outcome(workflowupdate.StageAccepted, ""),
+				outcome(workflowupdate.StageCompleted, "result"),
+			},
+		},
+		{
+			name:      "rejected",
+			responses: []*types.QueryWorkflowResponse{outcome(workflowupdate.StageRejected, "invalid input")},
+			expectErr: "Update update-id was rejected: invalid input",
+		},
+		{
+			name:      "workflow closed",
+			responses: []*types.QueryWorkflowResponse{outcome(workflowupdate.StageAccepted, "")},
+			queryErr:  &types.WorkflowExecutionAlreadyCompletedError{},
+			expectErr: "Workflow closed before update update-id completed, last stage: Accepted.",
+		},
+		{
+			name:      "query failed",
+			queryErr:  &types.EntityNotExistsError{Message: "Update not found."},
+			expectErr: "Failed to wait for the result of update update-id, last stage: Requested.",
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.serverFrontendClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, request *types.SignalWorkflowExecutionRequest, _ ...yarpc.CallOption) error {
+					s.Equal(workflowupdate.SignalName("update-name"), request.SignalName)
+					s.Equal("update-id", request.RequestID)
+					return nil
+				})
+			calls := make([]any, 0, len(tt.responses)+1)
+			for _, resp := range tt.responses {
+				calls = append(calls, s.serverFrontendClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, request *types.QueryWorkflowRequest, _ ...yarpc.CallOption) (*types.QueryWorkflowResponse, error) {
+						s.Equal(workflowupdate.ResultQueryType, request.Query.QueryType)
+						s.Equal([]byte("update-id"), request.Query.QueryArgs)
+						return resp, nil
+					}))
+			}
+			if tt.queryErr != nil {
+				calls = append(calls, s.serverFrontendClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).Return(nil, tt.queryErr))
+			}
+			gomock.InOrder(calls...)
+			err := s.app.Run([]string{"", "--do", domainName, "workflow", "update", "-w", "wid", "-n", "update-name", "--update_id", "update-id"})
+			if tt.expectErr != "" {
+				s.ErrorContains(err, tt.expectErr)
+			} else {
+				s.NoError(err)
+			}
+		})
+	}
+}
+
 func (s *cliAppSuite) TestQueryWorkflowUsingStackTrace() {
 	resp := &types.QueryWorkflowResponse{
 		QueryResult: []byte("query-result"),
@@ -686,6 +859,23 @@ func (s *cliAppSuite) TestDescribeTaskList_Activity() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestDescribeTaskList_GroupByHost() {
+	resp := &types.DescribeTaskListResponse{
+		Pollers: []*types.PollerInfo{
+			{LastAccessTime: common.Int64Ptr(time.Now().UnixNano()), Identity: "1@host-b@tl"},
+			{LastAccessTime: common.Int64Ptr(time.Now().UnixNano()), Identity: "2@host-a"},
+			{LastAccessTime: common.Int64Ptr(time.Now().UnixNano()), Identity: "3@host-a"},
+		},
+	}
+	s.serverFrontendClient.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any()).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "tasklist", "describe", "-tl", "test-taskList", "--group_by_host"})
+	s.Nil(err)
+	output := s.testIOHandler.outputBytes.String()
+	s.Regexp(`host-a\s+\|\s+2\s`, output)
+	s.Regexp(`host-b\s+\|\s+1\s`, output)
+	s.Less(strings.Index(output, "host-a"), strings.Index(output, "host-b"))
+}
+
 func (s *cliAppSuite) TestObserveWorkflow() {
 	history := getWorkflowExecutionHistoryResponse
 	s.serverFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(history, nil).Times(2)
@@ -88,6 +88,13 @@ const resetTypeDecisionCompletedTime = "DecisionCompletedTime"
 const resetTypeFirstDecisionScheduled = "FirstDecisionScheduled"
 const resetTypeLastDecisionScheduled = "LastDecisionScheduled"
 
+// reapplyEventTypesMap maps the event types accepted by reset --reapply_event_types to the reapplied events
+var reapplyEventTypesMap = map[string]types.EventType{
+	"Signal":                 types.EventTypeWorkflowExecutionSignaled,
+	"UpsertSearchAttributes": types.EventTypeUpsertWorkflowSearchAttributes,
+	"CancelRequest":          types.EventTypeWorkflowExecutionCancelRequested,
+}
+
 var resetTypesMap = map[string]string{
 	resetTypeFirstDecisionCompleted: "",
 	resetTypeLastDecisionCompleted:  "",
@@ -20,7 +20,14 @@
 
 package cli
 
-import "github.com/urfave/cli/v2"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/uber/cadence/service/worker/scheduler"
+)
 
 // Flags used to specify cli command line arguments
 const (
@@ -58,6 +65,7 @@ const (
 	FlagEndEventVersion                = "end_event_version"
 	FlagTaskList                       = "tasklist"
 	FlagTaskListType                   = "tasklisttype"
+	FlagGroupByHost                    = "group_by_host"
 	FlagWorkflowIDReusePolicy          = "workflowidreusepolicy"
 	FlagCronSchedule                   = "cron"
 	FlagWorkflowType                   = "workflow_type"
@@ -144,6 +152,7 @@ const (
 	FlagMemoKey                        = "memo_key"
 	FlagMemo                           = "memo"
 	FlagMemoFile                       = "memo_file"
+	FlagCompletionCallbacks            = "completion_callbacks"
 	FlagSearchAttributesKey            = "search_attr_key"
 	FlagSearchAttributesVal            = "search_attr_value"
 	FlagSearchAttributesType           = "search_attr_type"
@@ -154,14 +163,38 @@ const (
 	FlagResetPointsOnly                = "reset_points_only"
 	FlagResetBadBinaryChecksum         = "reset_bad_binary_checksum"
 	FlagSkipSignalReapply              = "skip_signal_reapply"
+	FlagReapplyEventTypes              = "reapply_event_types"
+	FlagReapplySignalNames             = "reapply_signal_names"
+	FlagExcludeSignalNames             = "exclude_signal_names"
+	FlagPreview                        = "preview"
 	FlagListQuery                      = "query"
 	FlagExcludeWorkflowIDByQuery       = "exclude_query"
 	FlagBatchType                      = "batch_type"
 	FlagSignalName                     = "signal_name"
+	FlagUpdateID                       = "update_id"
+	FlagDeliverAfter                   = "deliver_after"
+	FlagDelayedSignalID                = "delayed_signal_id"
+	FlagScheduleID                     = "schedule_id"
+	FlagOverlapPolicy                  = "overlap_policy"
+	FlagStartTime                      = "start_time"
+	FlagEndTime                        = "end_time"
+	FlagCatchupWindow                  = "catchup_window"
+	FlagWorkflowIDPrefix               = "workflow_id_prefix"
+	FlagPaused                         = "paused"
 	FlagTaskID                         = "task_id"
 	FlagTaskType                       = "task_type"
 	FlagTaskVisibilityTimestamp        = "task_timestamp"
 	FlagQueueType                      = "queue_type"
+	FlagQueueEditOperation             = "operation"
+	FlagVirtualQueueID                 = "virtual_queue_id"
+	FlagSliceIndex                     = "slice_index"
+	FlagDomainIDs                      = "domain_ids"
+	FlagTargetVirtualQueueID           = "target_virtual_queue_id"
+	FlagPauseDuration                  = "pause_duration"
+	FlagLaggingOnly                    = "lagging_only"
+	FlagSampleSize                     = "sample_size"
+	FlagMaxDLQMessages                 = "max_dlq_messages"
+	FlagRequireReadiness               = "require_readiness"
 	FlagStartingRPS                    = "starting_rps"
 	FlagRPS                            = "rps"
 	FlagRPSScaleUpSeconds              = "rps_scale_up_seconds"
@@ -187,6 +220,9 @@ const (
 	FlagFailoverType                   = "failover_type"
 	FlagFailoverTimeout                = "failover_timeout_seconds"
 	FlagActivityHeartBeatTimeout       = "heart_beat_timeout_seconds"
+	FlagScheduleToStartTimeout         = "schedule_to_start_timeout_seconds"
+	FlagScheduleToCloseTimeout         = "schedule_to_close_timeout_seconds"
+	FlagStartToCloseTimeout            = "start_to_close_timeout_seconds"
 	FlagFailoverWaitTime               = "failover_wait_time_second"
 	FlagFailoverBatchSize              = "failover_batch_size"
 	FlagFailoverDomains                = "domains"
@@ -195,6 +231,7 @@ const (
 	FlagRetryInterval                  = "retry_interval"
 	FlagRetryAttempts                  = "retry_attempts"
 	FlagMaxActivityRetries             = "max_activity_retries"
+	FlagMaxFailureReport               = "max_failure_report"
 	FlagRetryExpiration                = "retry_expiration"
 	FlagRetryBackoff                   = "retry_backoff"
 	FlagRetryMaxInterval               = "retry_max_interval"
@@ -384,6 +421,11 @@ func getFlagsForStart() []cli.Flag {
 			Usage: "Optional info that can be listed in list workflow, from JSON format file. If there are multiple JSON, concatenate them and separate by space or newline. " +
 				"The order must be same as memo_key",
 		},
+		&cli.StringFlag{
+			Name: FlagCompletionCallbacks,
+			Usage: "Optional callbacks notified when the workflow closes, as a JSON list of " +
+				`{"http":{"url":"..."}} or {"signal":{"domain":"...","workflowID":"...","runID":"...","signalName":"..."}}`,
+		},
 		&cli.StringFlag{
 			Name:  FlagHeaderKey,
 			Usage: "Optional key of header. If there are multiple keys, concatenate them and separate by space",
@@ -490,6 +532,52 @@ func getFlagsForSignal() []cli.Flag {
 			Aliases: []string{"if"},
 			Usage:   "Input for the signal from JSON file.",
 		},
+		&cli.StringFlag{
+			Name: FlagDeliverAfter,
+			Usage: "Optional time to deliver the signal at, in UTC format '2006-01-02T15:04:05Z', raw UnixNano, " +
+				"or a duration from now like '90s' or '2h'. The signal is delivered immediately by default.",
+		},
+	}
+}
+
+func getFlagsForCancelDelayedSignal() []cli.Flag {
+	return append(flagsForExecution, &cli.Int64Flag{
+		Name:  FlagDelayedSignalID,
+		Usage: "ID of the delayed signal to cancel, as shown by describe",
+	})
+}
+
+func getFlagsForUpdate() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:    FlagWorkflowID,
+			Aliases: []string{"w", "wid"},
+			Usage:   "WorkflowID",
+		},
+		&cli.StringFlag{
+			Name:    FlagRunID,
+			Aliases: []string{"r", "rid"},
+			Usage:   "RunID",
+		},
+		&cli.StringFlag{
+			Name:    FlagName,
+			Aliases: []string{"n"},
+			Usage:   "UpdateName",
+		},
+		&cli.StringFlag{
+			Name:  FlagUpdateID,
+			Usage: "Optional ID of the update, used to deduplicate retried updates. A random ID is used by default.",
+		},
+		&cli.StringFlag{
+			Name:    FlagInput,
+			Aliases: []string{"i"},
+			Usage:   "Input for the update, in JSON format.",
+		},
+		&cli.StringFlag{
+			Name:    FlagInputFile,
+			Aliases: []string{"if"},
+			Usage:   "Input for the update from JSON file.",
+		},
 	}
 }
 
@@ -528,6 +616,90 @@ func getFlagsForCancel() []cli.Flag {
 	})
 }
 
+func getFlagsForPause() []cli.Flag {
+	return append(flagsForExecution, &cli.StringFlag{
+		Name:    FlagReason,
+		Aliases: []string{"re"},
+		Usage:   "The reason you want to pause the workflow",
+	})
+}
+
+func getFlagsForUnpause() []cli.Flag {
+	return append(flagsForExecution, &cli.StringFlag{
+		Name:    FlagReason,
+		Aliases: []string{"re"},
+		Usage:   "The reason you want to resume the workflow",
+	})
+}
+
+func getFlagsForActivityControl(reasonUsage string) []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:    FlagWorkflowID,
+			Aliases: []string{"wid", "w"},
+			Usage:   "WorkflowID",
+		},
+		&cli.StringFlag{
+			Name:    FlagRunID,
+			Aliases: []string{"rid", "r"},
+			Usage:   "RunID",
+		},
+		&cli.StringFlag{
+			Name:    FlagActivityID,
+			Aliases: []string{"aid"},
+			Usage:   "The activityID to operate on",
+		},
+		&cli.StringFlag{
+			Name:    FlagReason,
+			Aliases: []string{"re"},
+			Usage:   reasonUsage,
+		},
+	}
+}
+
+func getFlagsForActivityUpdateOptions() []cli.Flag {
+	return append(getFlagsForActivityControl("The reason you want to update the activity options"),
+		&cli.IntFlag{
+			Name:  FlagRetryExpiration,
+			Usage: "Optional retry expiration in seconds, restarting from now. Setting retry_attempts or retry_expiration replaces the retry policy of the activity.",
+		},
+		&cli.IntFlag{
+			Name:  FlagRetryAttempts,
+			Usage: "Optional retry attempts. Setting retry_attempts or retry_expiration replaces the retry policy of the activity.",
+		},
+		&cli.IntFlag{
+			Name:  FlagRetryInterval,
+			Value: 10,
+			Usage: "Optional retry interval in seconds.",
+		},
+		&cli.Float64Flag{
+			Name:  FlagRetryBackoff,
+			Value: 1.0,
+			Usage: "Optional retry backoff coefficient. Must be or equal or greater than 1.",
+		},
+		&cli.IntFlag{
+			Name:  FlagRetryMaxInterval,
+			Usage: "Optional retry maximum interval in seconds. If set will give an upper bound for retry interval. Must be equal or greater than retry interval.",
+		},
+		&cli.IntFlag{
+			Name:  FlagScheduleToStartTimeout,
+			Usage: "Optional new schedule to start timeout in seconds",
+		},
+		&cli.IntFlag{
+			Name:  FlagScheduleToCloseTimeout,
+			Usage: "Optional new schedule to close timeout in seconds",
+		},
+		&cli.IntFlag{
+			Name:  FlagStartToCloseTimeout,
+			Usage: "Optional new start to close timeout in seconds",
+		},
+		&cli.IntFlag{
+			Name:  FlagActivityHeartBeatTimeout,
+			Usage: "Optional new heartbeat timeout in seconds",
+		},
+	)
+}
+
 func getFormatFlag() cli.Flag {
 	return &cli.StringFlag{
 		Name:  FlagFormat,
@@ -785,3 +957,79 @@ func getFlagsForObserveID() []cli.Flag {
 		},
 	}
 }
+
+func getFlagScheduleID() cli.Flag {
+	return &cli.StringFlag{
+		Name:    FlagScheduleID,
+		Aliases: []string{"sid"},
+		Usage:   "ID of the schedule",
+	}
+}
+
+func getFlagOverlapPolicy(usage string) cli.Flag {
+	policies := make([]string, 0, len(scheduler.AllOverlapPolicies))
+	for _, policy := range scheduler.AllOverlapPolicies {
+		policies = append(policies, string(policy))
+	}
+	return &cli.StringFlag{
+		Name:    FlagOverlapPolicy,
+		Aliases: []string{"op"},
+		Usage:   fmt.Sprintf("%v. Available options: %v", usage, strings.Join(policies, ", ")),
+	}
+}
+
+func getFlagsForScheduleSpec() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:  FlagCronSchedule,
+			Usage: "Cron schedule of the schedule, evaluated in UTC",
+		},
+		&cli.StringFlag{
+			Name:  FlagStartTime,
+			Usage: "Optional time before which the schedule does not fire, in UTC format '2006-01-02T15:04:05Z' or raw UnixNano",
+		},
+		&cli.StringFlag{
+			Name:  FlagEndTime,
+			Usage: "Optional time after which the schedule does not fire, in UTC format '2006-01-02T15:04:05Z' or raw UnixNano",
+		},
+		&cli.DurationFlag{
+			Name:  FlagCatchupWindow,
+			Usage: "Optional window within which fire times missed while the scheduler was unavailable are still run, e.g. 10m",
+		},
+		getFlagOverlapPolicy("Policy applied when the schedule fires while its previous run is still open, skip by default"),
+		&cli.StringFlag{
+			Name:    FlagWorkflowType,
+			Aliases: []string{"wt"},
+			Usage:   "Type of the workflow the schedule starts",
+		},
+		&cli.StringFlag{
+			Name:    FlagTaskList,
+			Aliases: []string{"tl"},
+			Usage:   "TaskList of the workflow the schedule starts",
+		},
+		&cli.StringFlag{
+			Name:  FlagWorkflowIDPrefix,
+			Usage: "Optional prefix of the workflow IDs of the runs, the schedule ID is used by default",
+		},
+		&cli.IntFlag{
+			Name:    FlagExecutionTimeout,
+			Aliases: []string{"et"},
+			Usage:   "Execution start to close timeout of the runs in seconds",
+		},
+		&cli.IntFlag{
+			Name:    FlagDecisionTimeout,
+			Aliases: []string{"dt"},
+			Usage:   fmt.Sprintf("Decision task start to close timeout of the runs in seconds, %v by default", defaultDecisionTimeoutInSeconds),
+		},
+		&cli.StringFlag{
+			Name:    FlagInput,
+			Aliases: []string{"i"},
+			Usage:   "Optional input of the runs, in JSON format",
+		},
+		&cli.StringFlag{
+			Name:    FlagInputFile,
+			Aliases: []string{"if"},
+			Usage:   "Optional input of the runs from JSON file",
+		},
+	}
+}
@@ -0,0 +1,129 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cli
+
+import "github.com/urfave/cli/v2"
+
+func newScheduleCommands() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:    "create",
+			Aliases: []string{"c"},
+			Usage:   "Create a schedule that starts a workflow on every fire time of a cron spec",
+			Flags: append(getFlagsForScheduleSpec(),
+				getFlagScheduleID(),
+				&cli.BoolFlag{
+					Name:  FlagPaused,
+					Usage: "Create the schedule in paused state",
+				},
+			),
+			Action: CreateSchedule,
+		},
+		{
+			Name:    "describe",
+			Aliases: []string{"desc"},
+			Usage:   "Describe the spec, action and recent runs of a schedule",
+			Flags:   []cli.Flag{getFlagScheduleID()},
+			Action:  DescribeSchedule,
+		},
+		{
+			Name:    "update",
+			Aliases: []string{"u"},
+			Usage:   "Update the spec, action or overlap policy of a schedule, only the given flags are changed",
+			Flags:   append(getFlagsForScheduleSpec(), getFlagScheduleID()),
+			Action:  UpdateSchedule,
+		},
+		{
+			Name:  "pause",
+			Usage: "Pause a schedule, it does not fire until it is unpaused",
+			Flags: []cli.Flag{
+				getFlagScheduleID(),
+				&cli.StringFlag{
+					Name:    FlagReason,
+					Aliases: []string{"re"},
+					Usage:   "Reason for pausing the schedule",
+				},
+			},
+			Action: PauseSchedule,
+		},
+		{
+			Name:   "unpause",
+			Usage:  "Unpause a schedule, fire times missed while paused are skipped",
+			Flags:  []cli.Flag{getFlagScheduleID()},
+			Action: UnpauseSchedule,
+		},
+		{
+			Name:  "trigger",
+			Usage: "Start a run of the schedule action now",
+			Flags: []cli.Flag{
+				getFlagScheduleID(),
+				getFlagOverlapPolicy("Optional overlap policy for this run, the policy of the schedule is used by default"),
+			},
+			Action: TriggerSchedule,
+		},
+		{
+			Name:  "backfill",
+			Usage: "Run the schedule action for every fire time within a time range",
+			Flags: []cli.Flag{
+				getFlagScheduleID(),
+				&cli.StringFlag{
+					Name:  FlagStartTime,
+					Usage: "Start of the time range, in UTC format '2006-01-02T15:04:05Z', raw UnixNano or a time range such as '3d'",
+				},
+				&cli.StringFlag{
+					Name:  FlagEndTime,
+					Usage: "End of the time range, in the same formats as start time",
+				},
+				getFlagOverlapPolicy("Optional overlap policy for the backfilled runs, the policy of the schedule is used by default"),
+			},
+			Action: BackfillSchedule,
+		},
+		{
+			Name:    "list",
+			Aliases: []string{"l"},
+			Usage:   "List the schedules of a domain",
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:    FlagPageSize,
+					Aliases: []string{"ps"},
+					Value:   100,
+					Usage:   "Result page size",
+				},
+			},
+			Action: ListSchedules,
+		},
+		{
+			Name:  "delete",
+			Usage: "Delete a schedule, workflows it started are not affected",
+			Flags: []cli.Flag{
+				getFlagScheduleID(),
+				&cli.StringFlag{
+					Name:    FlagReason,
+					Aliases: []string{"re"},
+					Usage:   "Reason for deleting the schedule",
+				},
+			},
+			Action: DeleteSchedule,
+		},
+	}
+}
@@ -0,0 +1,446 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/urfave/cli/v2"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/scheduler"
+	"github.com/uber/cadence/tools/common/commoncli"
+)
+
+// CreateSchedule starts the schedule workflow of a new schedule
+func CreateSchedule(c *cli.Context) error {
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	scheduleID, err := getRequiredOption(c, FlagScheduleID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	params := scheduler.Params{
+		Domain:        domain,
+		ScheduleID:    scheduleID,
+		OverlapPolicy: scheduler.OverlapPolicySkip,
+		Paused:        c.Bool(FlagPaused),
+		Action: scheduler.Action{
+			TaskStartToCloseTimeoutSeconds: defaultDecisionTimeoutInSeconds,
+		},
+	}
+	if params.Paused {
+		params.PauseReason = "created paused"
+	}
+	if err := applyScheduleFlags(c, &params); err != nil {
+		return err
+	}
+	if err := params.Validate(); err != nil {
+		return commoncli.Problem("Invalid schedule: ", err)
+	}
+	input, err := json.Marshal(params)
+	if err != nil {
+		return commoncli.Problem("Failed to encode schedule: ", err)
+	}
+
+	svcClient, err := getDeps(c).ServerFrontendClient(c)
+	if err != nil {
+		return err
+	}
+	tcCtx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context:", err)
+	}
+	// schedules run in the system domain, make sure the domain they start workflows in exists
+	if _, err := svcClient.DescribeDomain(tcCtx, &types.DescribeDomainRequest{Name: &domain}); err != nil {
+		return commoncli.Problem("Failed to describe domain", err)
+	}
+	_, err = svcClient.StartWorkflowExecution(tcCtx, &types.StartWorkflowExecutionRequest{
+		Domain:                              constants.SystemLocalDomainName,
+		RequestID:                           uuid.New(),
+		WorkflowID:                          scheduler.WorkflowID(domain, scheduleID),
+		WorkflowType:                        &types.WorkflowType{Name: scheduler.WorkflowTypeName},
+		TaskList:                            &types.TaskList{Name: scheduler.TaskListName},
+		Input:                               input,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(scheduler.DefaultExecutionTimeout.Seconds())),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(defaultDecisionTimeoutInSeconds),
+		WorkflowIDReusePolicy:               types.WorkflowIDReusePolicyAllowDuplicate.Ptr(),
+		Identity:                            getCliIdentity(),
+	})
+	if err != nil {
+		return commoncli.Problem("Failed to create schedule", err)
+	}
+	prettyPrintJSONObject(getDeps(c).Output(), map[string]interface{}{
+		"msg":        "schedule is created",
+		"scheduleID": scheduleID,
+	})
+	return nil
+}
+
+// DescribeSchedule prints the description of a schedule
+func DescribeSchedule(c *cli.Context) error {
+	domain, scheduleID, err := getScheduleOptions(c)
+	if err != nil {
+		return err
+	}
+	svcClient, err := getDeps(c).ServerFrontendClient(c)
+	if err != nil {
+		return err
+	}
+	tcCtx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context:", err)
+	}
+	description, err := describeSchedule(tcCtx, svcClient, domain, scheduleID)
+	if err != nil {
+		return err
+	}
+	prettyPrintJSONObject(getDeps(c).Output(), description)
+	return nil
+}
+
+// UpdateSchedule changes the spec, action or overlap policy of a schedule
+func UpdateSchedule(c *cli.Context) error {
+	domain, scheduleID, err := getScheduleOptions(c)
+	if err != nil {
+		return err
+	}
+	svcClient, err := getDeps(c).ServerFrontendClient(c)
+	if err != nil {
+		return err
+	}
+	tcCtx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context:", err)
+	}
+	description, err := describeSchedule(tcCtx, svcClient, domain, scheduleID)
+	if err != nil {
+		return err
+	}
+	params := scheduler.Params{
+		Domain:        domain,
+		ScheduleID:    scheduleID,
+		Spec:          description.Spec,
+		Action:        description.Action,
+		OverlapPolicy: description.OverlapPolicy,
+	}
+	if err := applyScheduleFlags(c, &params); err != nil {
+		return err
+	}
+	if err := params.Validate(); err != nil {
+		return commoncli.Problem("Invalid schedule: ", err)
+	}
+	update := scheduler.UpdateRequest{
+		Spec:          &params.Spec,
+		Action:        &params.Action,
+		OverlapPolicy: &params.OverlapPolicy,
+	}
+	return signalSchedule(c, svcClient, domain, scheduleID, scheduler.SignalNameUpdate, update, "schedule is updated")
+}
+
+// PauseSchedule stops a schedule from firing
+func PauseSchedule(c *cli.Context) error {
+	domain, scheduleID, err := getScheduleOptions(c)
+	if err != nil {
+		return err
+	}
+	svcClient, err := getDeps(c).ServerFrontendClient(c)
+	if err != nil {
+		return err
+	}
+	pause := scheduler.PauseRequest{Reason: c.String(FlagReason)}
+	return signalSchedule(c, svcClient, domain, scheduleID, scheduler.SignalNamePause, pause, "schedule is paused")
+}
+
+// UnpauseSchedule resumes a paused schedule
+func UnpauseSchedule(c *cli.Context) error {
+	domain, scheduleID, err := getScheduleOptions(c)
+	if err != nil {
+		return err
+	}
+	svcClient, err := getDeps(c).ServerFrontendClient(c)
+	if err != nil {
+		return err
+	}
+	return signalSchedule(c, svcClient, domain, scheduleID, scheduler.SignalNameUnpause, scheduler.PauseRequest{}, "schedule is unpaused")
+}
+
+// TriggerSchedule starts a run of the schedule action now
+func TriggerSchedule(c *cli.Context) error {
+	domain, scheduleID, err := getScheduleOptions(c)
+	if err != nil {
+		return err
+	}
+	trigger := scheduler.TriggerRequest{OverlapPolicy: scheduler.OverlapPolicy(c.String(FlagOverlapPolicy))}
+	if trigger.OverlapPolicy != "" {
+		if err := scheduler.ValidateOverlapPolicy(trigger.OverlapPolicy); err != nil {
+			return commoncli.Problem("Invalid overlap policy: ", err)
+		}
+	}
+	svcClient, err := getDeps(c).ServerFrontendClient(c)
+	if err != nil {
+		return err
+	}
+	return signalSchedule(c, svcClient, domain, scheduleID, scheduler.SignalNameTrigger, trigger, "schedule is triggered")
+}
+
+// BackfillSchedule runs the schedule action for every fire time within a time range
+func BackfillSchedule(c *cli.Context) error {
+	domain, scheduleID, err := getScheduleOptions(c)
+	if err != nil {
+		return err
+	}
+	startTime, err := getRequiredOption(c, FlagStartTime)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	endTime, err := getRequiredOption(c, FlagEndTime)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	backfill := scheduler.BackfillRequest{OverlapPolicy: scheduler.OverlapPolicy(c.String(FlagOverlapPolicy))}
+	if backfill.StartTime, err = parseScheduleTime(startTime); err != nil {
+		return commoncli.Problem("Invalid start time: ", err)
+	}
+	if backfill.EndTime, err = parseScheduleTime(endTime); err != nil {
+		return commoncli.Problem("Invalid end time: ", err)
+	}
+	if err := backfill.Validate(); err != nil {
+		return commoncli.Problem("Invalid backfill: ", err)
+	}
+	svcClient, err := getDeps(c).ServerFrontendClient(c)
+	if err != nil {
+		return err
+	}
+	return signalSchedule(c, svcClient, domain, scheduleID, scheduler.SignalNameBackfill, backfill, "schedule backfill is requested")
+}
+
+// ListSchedules lists the schedules of a domain
+func ListSchedules(c *cli.Context) error {
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	pageSize := c.Int(FlagPageSize)
+	if pageSize <= 0 {
+		pageSize = defaultPageSizeForList
+	}
+	svcClient, err := getDeps(c).ServerFrontendClient(c)
+	if err != nil {
+		return err
+	}
+	tcCtx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context:", err)
+	}
+
+	output := make([]interface{}, 0)
+	request := &types.ListOpenWorkflowExecutionsRequest{
+		Domain:          constants.SystemLocalDomainName,
+		MaximumPageSize: int32(pageSize),
+		StartTimeFilter: &types.StartTimeFilter{
+			EarliestTime: common.Int64Ptr(0),
+			LatestTime:   common.Int64Ptr(time.Now().UnixNano()),
+		},
+		TypeFilter: &types.WorkflowTypeFilter{Name: scheduler.WorkflowTypeName},
+	}
+	for {
+		resp, err := svcClient.ListOpenWorkflowExecutions(tcCtx, request)
+		if err != nil {
+			return commoncli.Problem("Failed to list schedules", err)
+		}
+		for _, wf := range resp.Executions {
+			scheduleID, ok := scheduler.ScheduleIDFromWorkflowID(domain, wf.Execution.GetWorkflowID())
+			if !ok {
+				continue
+			}
+			output = append(output, map[string]string{
+				"scheduleID": scheduleID,
+				"createTime": timestampToString(wf.GetStartTime(), false),
+			})
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+	prettyPrintJSONObject(getDeps(c).Output(), output)
+	return nil
+}
+
+// DeleteSchedule stops a schedule for good, workflows it started are not affected
+func DeleteSchedule(c *cli.Context) error {
+	domain, scheduleID, err := getScheduleOptions(c)
+	if err != nil {
+		return err
+	}
+	reason := c.String(FlagReason)
+	if reason == "" {
+		reason = "schedule is deleted"
+	}
+	svcClient, err := getDeps(c).ServerFrontendClient(c)
+	if err != nil {
+		return err
+	}
+	tcCtx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context:", err)
+	}
+	err = svcClient.TerminateWorkflowExecution(tcCtx, &types.TerminateWorkflowExecutionRequest{
+		Domain:            constants.SystemLocalDomainName,
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: scheduler.WorkflowID(domain, scheduleID)},
+		Reason:            reason,
+		Identity:          getCliIdentity(),
+	})
+	if err != nil {
+		return commoncli.Problem("Failed to delete schedule", err)
+	}
+	prettyPrintJSONObject(getDeps(c).Output(), map[string]interface{}{
+		"msg": "schedule is deleted",
+	})
+	return nil
+}
+
+func getScheduleOptions(c *cli.Context) (string, string, error) {
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return "", "", commoncli.Problem("Required flag not found: ", err)
+	}
+	scheduleID, err := getRequiredOption(c, FlagScheduleID)
+	if err != nil {
+		return "", "", commoncli.Problem("Required flag not found: ", err)
+	}
+	return domain, scheduleID, nil
+}
+
+// applyScheduleFlags overrides the spec, action and overlap policy of the params with the flags that are set
+func applyScheduleFlags(c *cli.Context, params *scheduler.Params) error {
+	var err error
+	if c.IsSet(FlagCronSchedule) {
+		params.Spec.CronSchedule = c.String(FlagCronSchedule)
+	}
+	if c.IsSet(FlagStartTime) {
+		if params.Spec.StartTime, err = parseScheduleTime(c.String(FlagStartTime)); err != nil {
+			return commoncli.Problem("Invalid start time: ", err)
+		}
+	}
+	if c.IsSet(FlagEndTime) {
+		if params.Spec.EndTime, err = parseScheduleTime(c.String(FlagEndTime)); err != nil {
+			return commoncli.Problem("Invalid end time: ", err)
+		}
+	}
+	if c.IsSet(FlagCatchupWindow) {
+		params.Spec.CatchupWindow = c.Duration(FlagCatchupWindow)
+	}
+	if c.IsSet(FlagOverlapPolicy) {
+		params.OverlapPolicy = scheduler.OverlapPolicy(c.String(FlagOverlapPolicy))
+	}
+	if c.IsSet(FlagWorkflowType) {
+		params.Action.WorkflowType = c.String(FlagWorkflowType)
+	}
+	if c.IsSet(FlagTaskList) {
+		params.Action.TaskList = c.String(FlagTaskList)
+	}
+	if c.IsSet(FlagWorkflowIDPrefix) {
+		params.Action.WorkflowIDPrefix = c.String(FlagWorkflowIDPrefix)
+	}
+	if c.IsSet(FlagExecutionTimeout) {
+		params.Action.ExecutionStartToCloseTimeoutSeconds = int32(c.Int(FlagExecutionTimeout))
+	}
+	if c.IsSet(FlagDecisionTimeout) {
+		params.Action.TaskStartToCloseTimeoutSeconds = int32(c.Int(FlagDecisionTimeout))
+	}
+	if c.IsSet(FlagInput) || c.IsSet(FlagInputFile) {
+		input, err := processJSONInput(c)
+		if err != nil {
+			return commoncli.Problem("Error processing JSON input: ", err)
+		}
+		params.Action.Input = []byte(input)
+	}
+	return nil
+}
+
+func parseScheduleTime(timeStr string) (time.Time, error) {
+	nanos, err := parseTime(timeStr, 0)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(0, nanos).UTC(), nil
+}
+
+func describeSchedule(ctx context.Context, svcClient frontend.Client, domain, scheduleID string) (*scheduler.Description, error) {
+	resp, err := svcClient.QueryWorkflow(ctx, &types.QueryWorkflowRequest{
+		Domain:    constants.SystemLocalDomainName,
+		Execution: &types.WorkflowExecution{WorkflowID: scheduler.WorkflowID(domain, scheduleID)},
+		Query:     &types.WorkflowQuery{QueryType: scheduler.QueryTypeDescribe},
+	})
+	if err != nil {
+		return nil, commoncli.Problem("Failed to describe schedule", err)
+	}
+	var description scheduler.Description
+	if err := json.Unmarshal(resp.GetQueryResult(), &description); err != nil {
+		return nil, commoncli.Problem("Failed to decode schedule description", err)
+	}
+	return &description, nil
+}
+
+func signalSchedule(c *cli.Context, svcClient frontend.Client, domain, scheduleID, signalName string, payload interface{}, msg string) error {
+	input, err := json.Marshal(payload)
+	if err != nil {
+		return commoncli.Problem("Failed to encode signal input", err)
+	}
+	tcCtx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context:", err)
+	}
+	err = svcClient.SignalWorkflowExecution(tcCtx, &types.SignalWorkflowExecutionRequest{
+		Domain:            constants.SystemLocalDomainName,
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: scheduler.WorkflowID(domain, scheduleID)},
+		SignalName:        signalName,
+		Input:             input,
+		Identity:          getCliIdentity(),
+		RequestID:         uuid.New(),
+	})
+	if err != nil {
+		return commoncli.Problem(fmt.Sprintf("Failed to send %v request to schedule", signalName), err)
+	}
+	prettyPrintJSONObject(getDeps(c).Output(), map[string]interface{}{
+		"msg": msg,
+	})
+	return nil
+}
@@ -0,0 +1,438 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/scheduler"
+)
+
+func runScheduleCommand(
+	t *testing.T,
+	command func(*cli.Context) error,
+	flags map[string]interface{},
+	setup func(*frontend.MockClient),
+) (string, error) {
+	mockCtrl := gomock.NewController(t)
+	mockClient := frontend.NewMockClient(mockCtrl)
+	ioHandler := &testIOHandler{}
+	app := NewCliApp(&clientFactoryMock{
+		serverFrontendClient: mockClient,
+	}, WithIOHandler(ioHandler))
+
+	set := flag.NewFlagSet("test", 0)
+	for k, v := range flags {
+		switch val := v.(type) {
+		case string:
+			_ = set.String(k, val, "")
+		case int:
+			_ = set.Int(k, val, "")
+		case bool:
+			_ = set.Bool(k, val, "")
+		case time.Duration:
+			_ = set.Duration(k, val, "")
+		}
+		require.NoError(t, set.Set(k, fmt.Sprint(v)))
+	}
+	setup(mockClient)
+	err := command(cli.NewContext(app, set, nil))
+	return ioHandler.outputBytes.String(), err
+}
+
+func expectScheduleSignal(t *testing.T, mockClient *frontend.MockClient, signalName string, payload interface{}) {
+	mockClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.SignalWorkflowExecutionRequest, _ ...interface{}) error {
+			assert.Equal(t, constants.SystemLocalDomainName, request.Domain)
+			assert.Equal(t, scheduler.WorkflowID("test-domain", "test-schedule"), request.WorkflowExecution.WorkflowID)
+			assert.Equal(t, signalName, request.SignalName)
+			assert.NotEmpty(t, request.RequestID)
+			expected, err := json.Marshal(payload)
+			require.NoError(t, err)
+			assert.JSONEq(t, string(expected), string(request.Input))
+			return nil
+		})
+}
+
+func testScheduleDescription() scheduler.Description {
+	return scheduler.Description{
+		Domain:     "test-domain",
+		ScheduleID: "test-schedule",
+		Spec:       scheduler.Spec{CronSchedule: "@hourly"},
+		Action: scheduler.Action{
+			WorkflowType:                        "test-workflow",
+			TaskList:                            "test-tasklist",
+			ExecutionStartToCloseTimeoutSeconds: 60,
+			TaskStartToCloseTimeoutSeconds:      10,
+		},
+		OverlapPolicy: scheduler.OverlapPolicySkip,
+	}
+}
+
+func expectScheduleDescribe(t *testing.T, mockClient *frontend.MockClient) {
+	result, err := json.Marshal(testScheduleDescription())
+	require.NoError(t, err)
+	mockClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.QueryWorkflowRequest, _ ...interface{}) (*types.QueryWorkflowResponse, error) {
+			assert.Equal(t, constants.SystemLocalDomainName, request.Domain)
+			assert.Equal(t, scheduler.WorkflowID("test-domain", "test-schedule"), request.Execution.WorkflowID)
+			assert.Equal(t, scheduler.QueryTypeDescribe, request.Query.QueryType)
+			return &types.QueryWorkflowResponse{QueryResult: result}, nil
+		})
+}
+
+func TestCreateSchedule(t *testing.T) {
+	validFlags := func() map[string]interface{} {
+		return map[string]interface{}{
+			FlagDomain:           "test-domain",
+			FlagScheduleID:       "test-schedule",
+			FlagCronSchedule:     "@hourly",
+			FlagWorkflowType:     "test-workflow",
+			FlagTaskList:         "test-tasklist",
+			FlagExecutionTimeout: 60,
+			FlagInput:            `{"key":"value"}`,
+			FlagCatchupWindow:    time.Minute,
+			FlagPaused:           true,
+		}
+	}
+	tests := []struct {
+		name          string
+		flags         func() map[string]interface{}
+		setup         func(*frontend.MockClient)
+		expectedError string
+	}{
+		{
+			name:  "success",
+			flags: validFlags,
+			setup: func(mockClient *frontend.MockClient) {
+				mockClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(&types.DescribeDomainResponse{}, nil)
+				mockClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, request *types.StartWorkflowExecutionRequest, _ ...interface{}) (*types.StartWorkflowExecutionResponse, error) {
+						assert.Equal(t, constants.SystemLocalDomainName, request.Domain)
+						assert.Equal(t, scheduler.WorkflowID("test-domain", "test-schedule"), request.WorkflowID)
+						assert.Equal(t, scheduler.WorkflowTypeName, request.WorkflowType.Name)
+						assert.Equal(t, scheduler.TaskListName, request.TaskList.Name)
+						var params scheduler.Params
+						require.NoError(t, json.Unmarshal(request.Input, &params))
+						assert.Equal(t, scheduler.Params{
+							Domain:     "test-domain",
+							ScheduleID: "test-schedule",
+							Spec:       scheduler.Spec{CronSchedule: "@hourly", CatchupWindow: time.Minute},
+							Action: scheduler.Action{
+								WorkflowType:                        "test-workflow",
+								TaskList:                            "test-tasklist",
+								Input:                               []byte(`{"key":"value"}`),
+								ExecutionStartToCloseTimeoutSeconds: 60,
+								TaskStartToCloseTimeoutSeconds:      defaultDecisionTimeoutInSeconds,
+							},
+							OverlapPolicy: scheduler.OverlapPolicySkip,
+							Paused:        true,
+							PauseReason:   "created paused",
+						}, params)
+						return &types.StartWorkflowExecutionResponse{}, nil
+					})
+			},
+		},
+		{
+			name: "missing schedule ID",
+			flags: func() map[string]interface{} {
+				flags := validFlags()
+				delete(flags, FlagScheduleID)
+				return flags
+			},
+			setup:         func(*frontend.MockClient) {},
+			expectedError: "option schedule_id is required",
+		},
+		{
+			name: "invalid cron",
+			flags: func() map[string]interface{} {
+				flags := validFlags()
+				flags[FlagCronSchedule] = "every hour"
+				return flags
+			},
+			setup:         func(*frontend.MockClient) {},
+			expectedError: "Invalid schedule",
+		},
+		{
+			name: "invalid overlap policy",
+			flags: func() map[string]interface{} {
+				flags := validFlags()
+				flags[FlagOverlapPolicy] = "buffer"
+				return flags
+			},
+			setup:         func(*frontend.MockClient) {},
+			expectedError: "unknown overlap policy",
+		},
+		{
+			name:  "domain does not exist",
+			flags: validFlags,
+			setup: func(mockClient *frontend.MockClient) {
+				mockClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{})
+			},
+			expectedError: "Failed to describe domain",
+		},
+		{
+			name:  "start failure",
+			flags: validFlags,
+			setup: func(mockClient *frontend.MockClient) {
+				mockClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(&types.DescribeDomainResponse{}, nil)
+				mockClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, &types.WorkflowExecutionAlreadyStartedError{})
+			},
+			expectedError: "Failed to create schedule",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := runScheduleCommand(t, CreateSchedule, tt.flags(), tt.setup)
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Contains(t, output, "schedule is created")
+		})
+	}
+}
+
+func TestDescribeSchedule(t *testing.T) {
+	flags := map[string]interface{}{
+		FlagDomain:     "test-domain",
+		FlagScheduleID: "test-schedule",
+	}
+	output, err := runScheduleCommand(t, DescribeSchedule, flags, func(mockClient *frontend.MockClient) {
+		expectScheduleDescribe(t, mockClient)
+	})
+	assert.NoError(t, err)
+	var description scheduler.Description
+	require.NoError(t, json.Unmarshal([]byte(output), &description))
+	assert.Equal(t, testScheduleDescription(), description)
+
+	_, err = runScheduleCommand(t, DescribeSchedule, flags, func(mockClient *frontend.MockClient) {
+		mockClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{})
+	})
+	assert.ErrorContains(t, err, "Failed to describe schedule")
+}
+
+func TestUpdateSchedule(t *testing.T) {
+	expected := testScheduleDescription()
+	expected.Spec.CronSchedule = "@daily"
+	expected.OverlapPolicy = scheduler.OverlapPolicyAllowAll
+
+	output, err := runScheduleCommand(t, UpdateSchedule, map[string]interface{}{
+		FlagDomain:        "test-domain",
+		FlagScheduleID:    "test-schedule",
+		FlagCronSchedule:  "@daily",
+		FlagOverlapPolicy: string(scheduler.OverlapPolicyAllowAll),
+	}, func(mockClient *frontend.MockClient) {
+		expectScheduleDescribe(t, mockClient)
+		expectScheduleSignal(t, mockClient, scheduler.SignalNameUpdate, scheduler.UpdateRequest{
+			Spec:          &expected.Spec,
+			Action:        &expected.Action,
+			OverlapPolicy: &expected.OverlapPolicy,
+		})
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, output, "schedule is updated")
+
+	_, err = runScheduleCommand(t, UpdateSchedule, map[string]interface{}{
+		FlagDomain:           "test-domain",
+		FlagScheduleID:       "test-schedule",
+		FlagExecutionTimeout: 0,
+	}, func(mockClient *frontend.MockClient) {
+		expectScheduleDescribe(t, mockClient)
+	})
+	assert.ErrorContains(t, err, "Invalid schedule")
+}
+
+func TestPauseAndUnpauseSchedule(t *testing.T) {
+	output, err := runScheduleCommand(t, PauseSchedule, map[string]interface{}{
+		FlagDomain:     "test-domain",
+		FlagScheduleID: "test-schedule",
+		FlagReason:     "maintenance",
+	}, func(mockClient *frontend.MockClient) {
+		expectScheduleSignal(t, mockClient, scheduler.SignalNamePause, scheduler.PauseRequest{Reason: "maintenance"})
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, output, "schedule is paused")
+
+	output, err = runScheduleCommand(t, UnpauseSchedule, map[string]interface{}{
+		FlagDomain:     "test-domain",
+		FlagScheduleID: "test-schedule",
+	}, func(mockClient *frontend.MockClient) {
+		expectScheduleSignal(t, mockClient, scheduler.SignalNameUnpause, scheduler.PauseRequest{})
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, output, "schedule is unpaused")
+
+	_, err = runScheduleCommand(t, PauseSchedule, map[string]interface{}{
+		FlagDomain:     "test-domain",
+		FlagScheduleID: "test-schedule",
+	}, func(mockClient *frontend.MockClient) {
+		mockClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.EntityNotExistsError{})
+	})
+	assert.ErrorContains(t, err, "Failed to send pause request to schedule")
+}
+
+func TestTriggerSchedule(t *testing.T) {
+	output, err := runScheduleCommand(t, TriggerSchedule, map[string]interface{}{
+		FlagDomain:        "test-domain",
+		FlagScheduleID:    "test-schedule",
+		FlagOverlapPolicy: string(scheduler.OverlapPolicyTerminatePrevious),
+	}, func(mockClient *frontend.MockClient) {
+		expectScheduleSignal(t, mockClient, scheduler.SignalNameTrigger, scheduler.TriggerRequest{
+			OverlapPolicy: scheduler.OverlapPolicyTerminatePrevious,
+		})
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, output, "schedule is triggered")
+
+	_, err = runScheduleCommand(t, TriggerSchedule, map[string]interface{}{
+		FlagDomain:        "test-domain",
+		FlagScheduleID:    "test-schedule",
+		FlagOverlapPolicy: "buffer",
+	}, func(*frontend.MockClient) {})
+	assert.ErrorContains(t, err, "Invalid overlap policy")
+}
+
+func TestBackfillSchedule(t *testing.T) {
+	output, err := runScheduleCommand(t, BackfillSchedule, map[string]interface{}{
+		FlagDomain:     "test-domain",
+		FlagScheduleID: "test-schedule",
+		FlagStartTime:  "2024-01-01T00:00:00Z",
+		FlagEndTime:    "2024-01-02T00:00:00Z",
+	}, func(mockClient *frontend.MockClient) {
+		expectScheduleSignal(t, mockClient, scheduler.SignalNameBackfill, scheduler.BackfillRequest{
+			StartTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			EndTime:   time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+		})
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, output, "schedule backfill is requested")
+
+	_, err = runScheduleCommand(t, BackfillSchedule, map[string]interface{}{
+		FlagDomain:     "test-domain",
+		FlagScheduleID: "test-schedule",
+		FlagStartTime:  "2024-01-01T00:00:00Z",
+	}, func(*frontend.MockClient) {})
+	assert.ErrorContains(t, err, "option end_time is required")
+
+	_, err = runScheduleCommand(t, BackfillSchedule, map[string]interface{}{
+		FlagDomain:     "test-domain",
+		FlagScheduleID: "test-schedule",
+		FlagStartTime:  "2024-01-02T00:00:00Z",
+		FlagEndTime:    "2024-01-01T00:00:00Z",
+	}, func(*frontend.MockClient) {})
+	assert.ErrorContains(t, err, "Invalid backfill")
+}
+
+func TestListSchedules(t *testing.T) {
+	execution := func(workflowID string) *types.WorkflowExecutionInfo {
+		return &types.WorkflowExecutionInfo{
+			Execution: &types.WorkflowExecution{WorkflowID: workflowID},
+			StartTime: common.Int64Ptr(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).UnixNano()),
+		}
+	}
+	output, err := runScheduleCommand(t, ListSchedules, map[string]interface{}{
+		FlagDomain:   "test-domain",
+		FlagPageSize: 2,
+	}, func(mockClient *frontend.MockClient) {
+		gomock.InOrder(
+			mockClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, request *types.ListOpenWorkflowExecutionsRequest, _ ...interface{}) (*types.ListOpenWorkflowExecutionsResponse, error) {
+					assert.Equal(t, constants.SystemLocalDomainName, request.Domain)
+					assert.Equal(t, scheduler.WorkflowTypeName, request.TypeFilter.Name)
+					assert.Equal(t, int32(2), request.MaximumPageSize)
+					assert.Nil(t, request.NextPageToken)
+					return &types.ListOpenWorkflowExecutionsResponse{
+						Executions: []*types.WorkflowExecutionInfo{
+							execution(scheduler.WorkflowID("test-domain", "schedule-1")),
+							execution(scheduler.WorkflowID("other-domain", "schedule-2")),
+						},
+						NextPageToken: []byte("next"),
+					}, nil
+				}),
+			mockClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, request *types.ListOpenWorkflowExecutionsRequest, _ ...interface{}) (*types.ListOpenWorkflowExecutionsResponse, error) {
+					assert.Equal(t, []byte("next"), request.NextPageToken)
+					return &types.ListOpenWorkflowExecutionsResponse{
+						Executions: []*types.WorkflowExecutionInfo{
+							execution(scheduler.WorkflowID("test-domain", "schedule-3")),
+						},
+					}, nil
+				}),
+		)
+	})
+	assert.NoError(t, err)
+	var schedules []map[string]string
+	require.NoError(t, json.Unmarshal([]byte(output), &schedules))
+	require.Len(t, schedules, 2)
+	assert.Equal(t, "schedule-1", schedules[0]["scheduleID"])
+	assert.Equal(t, "schedule-3", schedules[1]["scheduleID"])
+
+	_, err = runScheduleCommand(t, ListSchedules, map[string]interface{}{
+		FlagDomain: "test-domain",
+	}, func(mockClient *frontend.MockClient) {
+		mockClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).Return(nil, errors.New("list error"))
+	})
+	assert.ErrorContains(t, err, "Failed to list schedules")
+}
+
+func TestDeleteSchedule(t *testing.T) {
+	output, err := runScheduleCommand(t, DeleteSchedule, map[string]interface{}{
+		FlagDomain:     "test-domain",
+		FlagScheduleID: "test-schedule",
+	}, func(mockClient *frontend.MockClient) {
+		mockClient.EXPECT().TerminateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, request *types.TerminateWorkflowExecutionRequest, _ ...interface{}) error {
+				assert.Equal(t, constants.SystemLocalDomainName, request.Domain)
+				assert.Equal(t, scheduler.WorkflowID("test-domain", "test-schedule"), request.WorkflowExecution.WorkflowID)
+				assert.Equal(t, "schedule is deleted", request.Reason)
+				return nil
+			})
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, output, "schedule is deleted")
+
+	_, err = runScheduleCommand(t, DeleteSchedule, map[string]interface{}{
+		FlagDomain:     "test-domain",
+		FlagScheduleID: "test-schedule",
+	}, func(mockClient *frontend.MockClient) {
+		mockClient.EXPECT().TerminateWorkflowExecution(gomock.Any(), gomock.Any()).Return(errors.New("terminate error"))
+	})
+	assert.ErrorContains(t, err, "Failed to delete schedule")
+}
@@ -40,6 +40,10 @@ func newTaskListCommands() []*cli.Command {
 					Value:   "decision",
 					Usage:   "Optional TaskList type [decision|activity]",
 				},
+				&cli.BoolFlag{
+					Name:  FlagGroupByHost,
+					Usage: "Aggregate the pollers by the host in their identity",
+				},
 			},
 			Action: DescribeTaskList,
 		},
@@ -23,10 +23,12 @@ package cli
 import (
 	"io"
 	"os"
+	"sort"
 	"time"
 
 	"github.com/urfave/cli/v2"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/tools/common/commoncli"
 )
@@ -35,7 +37,14 @@ type (
 	TaskListPollerRow struct {
 		ActivityIdentity string    `header:"Activity Poller Identity"`
 		DecisionIdentity string    `header:"Decision Poller Identity"`
+		Host             string    `header:"Host"`
 		LastAccessTime   time.Time `header:"Last Access Time"`
+		RatePerSecond    float64   `header:"Rate Per Second"`
+	}
+	TaskListPollerHostRow struct {
+		Host           string    `header:"Host"`
+		Pollers        int       `header:"Pollers"`
+		LastAccessTime time.Time `header:"Last Access Time"`
 	}
 	TaskListPartitionRow struct {
 		ActivityPartition string `header:"Activity Task List Partition"`
@@ -82,6 +91,9 @@ func DescribeTaskList(c *cli.Context) error {
 		return commoncli.Problem(colorMagenta("No poller for tasklist: "+taskList), nil)
 	}
 
+	if c.Bool(FlagGroupByHost) {
+		return printTaskListPollerHosts(getDeps(c).Output(), pollers)
+	}
 	return printTaskListPollers(getDeps(c).Output(), pollers, taskListType)
 }
 
@@ -133,7 +145,9 @@ func printTaskListPollers(w io.Writer, pollers []*types.PollerInfo, taskListType
 		table = append(table, TaskListPollerRow{
 			ActivityIdentity: poller.GetIdentity(),
 			DecisionIdentity: poller.GetIdentity(),
-			LastAccessTime:   time.Unix(0, poller.GetLastAccessTime())})
+			Host:             common.HostFromWorkerIdentity(poller.GetIdentity()),
+			LastAccessTime:   time.Unix(0, poller.GetLastAccessTime()),
+			RatePerSecond:    poller.GetRatePerSecond()})
 	}
 	return RenderTable(w, table, RenderOptions{Color: true, PrintDateTime: true, OptionalColumns: map[string]bool{
 		"Activity Poller Identity": taskListType == types.TaskListTypeActivity,
@@ -141,6 +155,32 @@ func printTaskListPollers(w io.Writer, pollers []*types.PollerInfo, taskListType
 	}})
 }
 
+// printTaskListPollerHosts prints one row per worker host, pollers whose identity doesn't contain a host are
+// grouped together
+func printTaskListPollerHosts(w io.Writer, pollers []*types.PollerInfo) error {
+	rows := make(map[string]*TaskListPollerHostRow)
+	for _, poller := range pollers {
+		host := common.HostFromWorkerIdentity(poller.GetIdentity())
+		row, ok := rows[host]
+		if !ok {
+			row = &TaskListPollerHostRow{Host: host}
+			rows[host] = row
+		}
+		row.Pollers++
+		if lastAccessTime := time.Unix(0, poller.GetLastAccessTime()); lastAccessTime.After(row.LastAccessTime) {
+			row.LastAccessTime = lastAccessTime
+		}
+	}
+	table := make([]TaskListPollerHostRow, 0, len(rows))
+	for _, row := range rows {
+		table = append(table, *row)
+	}
+	sort.Slice(table, func(i, j int) bool {
+		return table[i].Host < table[j].Host
+	})
+	return RenderTable(w, table, RenderOptions{Color: true, PrintDateTime: true})
+}
+
 func printTaskListPartitions(taskListType types.TaskListType, partitions []*types.TaskListPartitionMetadata) error {
 	table := []TaskListPartitionRow{}
 	for _, partition := range partitions {
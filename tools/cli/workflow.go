@@ -83,6 +83,19 @@ func newWorkflowCommands() []*cli.Command {
 			Flags:   getFlagsForTerminate(),
 			Action:  TerminateWorkflow,
 		},
+		{
+			Name:        "pause",
+			Usage:       "pause a workflow execution",
+			Description: "no decision or activity task is dispatched and no user timer fires until the workflow is resumed",
+			Flags:       getFlagsForPause(),
+			Action:      PauseWorkflow,
+		},
+		{
+			Name:   "unpause",
+			Usage:  "resume a paused workflow execution",
+			Flags:  getFlagsForUnpause(),
+			Action: UnpauseWorkflow,
+		},
 		{
 			Name:    "cancel",
 			Aliases: []string{"c"},
@@ -97,6 +110,19 @@ func newWorkflowCommands() []*cli.Command {
 			Flags:   getFlagsForSignal(),
 			Action:  SignalWorkflow,
 		},
+		{
+			Name:   "cancel-signal",
+			Usage:  "cancel a delayed signal before it is delivered",
+			Flags:  getFlagsForCancelDelayedSignal(),
+			Action: CancelDelayedSignal,
+		},
+		{
+			Name:        "update",
+			Usage:       "update a workflow execution and wait for the result of the update",
+			Description: "the update is delivered to the workflow as a signal, the workflow answers it by recording update markers",
+			Flags:       getFlagsForUpdate(),
+			Action:      UpdateWorkflow,
+		},
 		{
 			Name:   "signalwithstart",
 			Usage:  "signal the current open workflow if exists, or attempt to start a new run based on IDResuePolicy and signals it",
@@ -236,6 +262,22 @@ func newWorkflowCommands() []*cli.Command {
 					Name:  FlagSkipSignalReapply,
 					Usage: "whether or not skipping signals reapply after the reset point",
 				},
+				&cli.StringSliceFlag{
+					Name:  FlagReapplyEventTypes,
+					Usage: "types of events to reapply after the reset point, default to Signal. Support: Signal, UpsertSearchAttributes, CancelRequest",
+				},
+				&cli.StringSliceFlag{
+					Name:  FlagReapplySignalNames,
+					Usage: "only reapply the signals with these names",
+				},
+				&cli.StringSliceFlag{
+					Name:  FlagExcludeSignalNames,
+					Usage: "do not reapply the signals with these names",
+				},
+				&cli.BoolFlag{
+					Name:  FlagPreview,
+					Usage: "print the events the reset would reapply without resetting the workflow",
+				},
 			},
 			Action: ResetWorkflow,
 		},
@@ -416,6 +458,31 @@ func newActivityCommands() []*cli.Command {
 			},
 			Action: FailActivity,
 		},
+		{
+			Name:        "pause",
+			Usage:       "pause the retries of a pending activity",
+			Description: "no new attempt of the activity is dispatched until it is resumed, its timeouts keep running",
+			Flags:       getFlagsForActivityControl("The reason you want to pause the activity"),
+			Action:      PauseActivity,
+		},
+		{
+			Name:   "unpause",
+			Usage:  "resume a paused activity",
+			Flags:  getFlagsForActivityControl("The reason you want to resume the activity"),
+			Action: UnpauseActivity,
+		},
+		{
+			Name:   "reset",
+			Usage:  "reset the attempt of a pending activity",
+			Flags:  getFlagsForActivityControl("The reason you want to reset the activity"),
+			Action: ResetActivity,
+		},
+		{
+			Name:   "update-options",
+			Usage:  "update the retry policy and timeouts of a pending activity",
+			Flags:  getFlagsForActivityUpdateOptions(),
+			Action: UpdateActivityOptions,
+		},
 	}
 }
 
@@ -451,6 +518,39 @@ func newBatchCommands() []*cli.Command {
 			},
 			Action: TerminateBatchJob,
 		},
+		{
+			Name:  "resume",
+			Usage: "Resume a terminated batch operation job from its last progress",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagJobID,
+					Aliases: []string{"jid"},
+					Usage:   "Batch Job ID",
+				},
+				&cli.BoolFlag{
+					Name:  FlagYes,
+					Usage: "Optional flag to disable confirmation prompt",
+				},
+			},
+			Action: ResumeBatchJob,
+		},
+		{
+			Name:  "report",
+			Usage: "Report the workflows a batch operation job failed to process",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagJobID,
+					Aliases: []string{"jid"},
+					Usage:   "Batch Job ID",
+				},
+				&cli.StringFlag{
+					Name:    FlagOutputFilename,
+					Aliases: []string{"of"},
+					Usage:   "Optional file to write the report to instead of stdout",
+				},
+			},
+			Action: ReportBatchJob,
+		},
 		{
 			Name:    "list",
 			Aliases: []string{"l"},
@@ -505,6 +605,18 @@ func newBatchCommands() []*cli.Command {
 					Aliases: []string{"tc"},
 					Usage:   "Required for batch replicate",
 				},
+				&cli.BoolFlag{
+					Name:  FlagSkipSignalReapply,
+					Usage: "Optional for batch reset, whether to skip reapplying the signals received after the last completed decision",
+				},
+				&cli.StringFlag{
+					Name:  FlagSearchAttributesKey,
+					Usage: "Required for batch update_search_attributes, search attribute keys to update. If there are multiple keys, concatenate them and separate by |.",
+				},
+				&cli.StringFlag{
+					Name:  FlagSearchAttributesVal,
+					Usage: "Required for batch update_search_attributes, search attribute values to update. If there are multiple values, concatenate them and separate by |.",
+				},
 				&cli.IntFlag{
 					Name:  FlagRPS,
 					Value: batcher.DefaultRPS,
@@ -541,6 +653,11 @@ func newBatchCommands() []*cli.Command {
 					Value: batcher.DefaultMaxActivityRetries,
 					Usage: "Max retries of batch activity, before retrying the whole workflow (0 means unlimited)",
 				},
+				&cli.IntFlag{
+					Name:  FlagMaxFailureReport,
+					Value: batcher.DefaultMaxFailureReport,
+					Usage: "Max number of failed workflows listed in the report of the batch job",
+				},
 			},
 			Action: StartBatchJob,
 		},
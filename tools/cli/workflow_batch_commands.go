@@ -22,6 +22,7 @@ package cli
 
 import (
 	"bufio"
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -31,6 +32,7 @@ import (
 	"github.com/pborman/uuid"
 	"github.com/urfave/cli/v2"
 
+	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/types"
@@ -58,6 +60,12 @@ func TerminateBatchJob(c *cli.Context) error {
 		return commoncli.Problem("Error in creating context:", err)
 	}
 
+	// the progress of the job is kept in the termination details, so that it can be resumed later
+	var details []byte
+	if _, progress, err := getBatchJobProgress(tcCtx, svcClient, jobID); err == nil && progress != nil {
+		details, _ = json.Marshal(progress)
+	}
+
 	err = svcClient.TerminateWorkflowExecution(
 		tcCtx,
 		&types.TerminateWorkflowExecutionRequest{
@@ -67,6 +75,7 @@ func TerminateBatchJob(c *cli.Context) error {
 				RunID:      "",
 			},
 			Reason:   reason,
+			Details:  details,
 			Identity: getCliIdentity(),
 		},
 	)
@@ -217,6 +226,16 @@ func StartBatchJob(c *cli.Context) error {
 			return commoncli.Problem("Required flag not found: ", err)
 		}
 	}
+	var searchAttributes map[string][]byte
+	if batchType == batcher.BatchTypeUpdateSearchAttributes {
+		searchAttributes, err = processSearchAttr(c)
+		if err != nil {
+			return commoncli.Problem("Failed to parse search attributes", err)
+		}
+		if len(searchAttributes) == 0 {
+			return commoncli.Problem("Required flag not found: ", fmt.Errorf("option %s is required", FlagSearchAttributesKey))
+		}
+	}
 	var sourceCluster, targetCluster string
 	if batchType == batcher.BatchTypeReplicate {
 		sourceCluster, err = getRequiredOption(c, FlagSourceCluster)
@@ -273,11 +292,6 @@ func StartBatchJob(c *cli.Context) error {
 		}
 
 	}
-	tcCtx, cancel, err = newContext(c)
-	defer cancel()
-	if err != nil {
-		return commoncli.Problem("Error in creating context:", err)
-	}
 
 	params := batcher.BatchParams{
 		DomainName: domain,
@@ -292,25 +306,47 @@ func StartBatchJob(c *cli.Context) error {
 			SourceCluster: sourceCluster,
 			TargetCluster: targetCluster,
 		},
+		ResetParams: batcher.ResetParams{
+			SkipSignalReapply: c.Bool(FlagSkipSignalReapply),
+		},
+		UpdateSearchAttributesParams: batcher.UpdateSearchAttributesParams{
+			SearchAttributes: searchAttributes,
+		},
 		RPS:                      rps,
 		Concurrency:              concurrency,
 		PageSize:                 pageSize,
 		AttemptsOnRetryableError: retryAttempt,
 		ActivityHeartBeatTimeout: heartBeatTimeout,
 		MaxActivityRetries:       maxActivityRetries,
+		MaxFailureReport:         c.Int(FlagMaxFailureReport),
 	}
+	return startBatchJob(c, params, operator)
+}
+
+// startBatchJob starts the batch workflow with the given parameters in the batcher domain
+func startBatchJob(c *cli.Context, params batcher.BatchParams, operator string) error {
+	svcClient, err := getDeps(c).ServerFrontendClient(c)
+	if err != nil {
+		return err
+	}
+	tcCtx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context:", err)
+	}
+
 	input, err := json.Marshal(params)
 	if err != nil {
 		return commoncli.Problem("Failed to encode batch job parameters", err)
 	}
 	memo, err := getWorkflowMemo(map[string]interface{}{
-		"Reason": reason,
+		"Reason": params.Reason,
 	})
 	if err != nil {
 		return commoncli.Problem("Failed to encode batch job memo", err)
 	}
 	searchAttributes, err := serializeSearchAttributes(map[string]interface{}{
-		"CustomDomain": domain,
+		"CustomDomain": params.DomainName,
 		"Operator":     operator,
 	})
 	if err != nil {
@@ -342,6 +378,164 @@ func StartBatchJob(c *cli.Context) error {
 	return nil
 }
 
+// ResumeBatchJob starts a new batch job from where a terminated batch job stopped
+func ResumeBatchJob(c *cli.Context) error {
+	jobID, err := getRequiredOption(c, FlagJobID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	svcClient, err := getDeps(c).ServerFrontendClient(c)
+	if err != nil {
+		return err
+	}
+	tcCtx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context:", err)
+	}
+
+	closeStatus, progress, err := getBatchJobProgress(tcCtx, svcClient, jobID)
+	if err != nil {
+		return commoncli.Problem("Failed to describe batch job", err)
+	}
+	switch {
+	case closeStatus == nil:
+		return commoncli.Problem("batch job is still running, terminate it before resuming", nil)
+	case *closeStatus == types.WorkflowExecutionCloseStatusCompleted:
+		return commoncli.Problem("batch job is finished, there is nothing to resume", nil)
+	case progress == nil:
+		return commoncli.Problem("batch job has no recorded progress to resume from", nil)
+	}
+
+	resp, err := svcClient.GetWorkflowExecutionHistory(tcCtx, &types.GetWorkflowExecutionHistoryRequest{
+		Domain:          constants.BatcherLocalDomainName,
+		Execution:       &types.WorkflowExecution{WorkflowID: jobID},
+		MaximumPageSize: 1,
+	})
+	if err != nil {
+		return commoncli.Problem("Failed to get batch job parameters", err)
+	}
+	events := resp.GetHistory().GetEvents()
+	if len(events) == 0 || events[0].WorkflowExecutionStartedEventAttributes == nil {
+		return commoncli.Problem("Failed to get batch job parameters", fmt.Errorf("no started event for batch job %v", jobID))
+	}
+	var params batcher.BatchParams
+	if err := json.Unmarshal(events[0].WorkflowExecutionStartedEventAttributes.Input, &params); err != nil {
+		return commoncli.Problem("Failed to decode batch job parameters", err)
+	}
+	params.Checkpoint = progress
+
+	fmt.Printf("This batch job will resume from page %v, %v of about %v workflows are already processed.\n",
+		progress.CurrentPage, progress.SuccessCount+progress.ErrorCount, progress.TotalEstimate)
+	if !c.Bool(FlagYes) {
+		fmt.Print("Please confirm[Yes/No]:")
+		text, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil {
+			return commoncli.Problem("Failed to get confirmation for resuming a batch job", err)
+		}
+		if !strings.EqualFold(strings.TrimSpace(text), "yes") {
+			fmt.Println("Batch job is not resumed")
+			return nil
+		}
+	}
+	return startBatchJob(c, params, getCurrentUserFromEnv())
+}
+
+// ReportBatchJob reports the workflows a batch job failed to process
+func ReportBatchJob(c *cli.Context) error {
+	jobID, err := getRequiredOption(c, FlagJobID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	svcClient, err := getDeps(c).ServerFrontendClient(c)
+	if err != nil {
+		return err
+	}
+	tcCtx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context:", err)
+	}
+
+	_, progress, err := getBatchJobProgress(tcCtx, svcClient, jobID)
+	if err != nil {
+		return commoncli.Problem("Failed to describe batch job", err)
+	}
+	if progress == nil {
+		progress = &batcher.HeartBeatDetails{}
+	}
+	report := map[string]interface{}{
+		"successCount": progress.SuccessCount,
+		"errorCount":   progress.ErrorCount,
+		"failures":     progress.Failures,
+	}
+	if progress.Failures == nil {
+		report["failures"] = []batcher.WorkflowFailure{}
+	}
+
+	outputFileName := c.String(FlagOutputFilename)
+	if outputFileName == "" {
+		prettyPrintJSONObject(getDeps(c).Output(), report)
+		return nil
+	}
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return commoncli.Problem("Failed to encode batch job report", err)
+	}
+	if err := os.WriteFile(outputFileName, data, 0666); err != nil {
+		return commoncli.Problem("Failed to write batch job report", err)
+	}
+	return nil
+}
+
+// getBatchJobProgress returns the close status of a batch job, nil if it is running, and its last known progress:
+// the latest heartbeat of a running job, the result of a completed job or the termination details of a terminated one
+func getBatchJobProgress(
+	ctx context.Context,
+	svcClient frontend.Client,
+	jobID string,
+) (*types.WorkflowExecutionCloseStatus, *batcher.HeartBeatDetails, error) {
+	wf, err := svcClient.DescribeWorkflowExecution(ctx, &types.DescribeWorkflowExecutionRequest{
+		Domain:    constants.BatcherLocalDomainName,
+		Execution: &types.WorkflowExecution{WorkflowID: jobID},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	closeStatus := wf.GetWorkflowExecutionInfo().CloseStatus
+	var data []byte
+	if closeStatus == nil {
+		if len(wf.PendingActivities) > 0 {
+			data = wf.PendingActivities[0].HeartbeatDetails
+		}
+	} else {
+		resp, err := svcClient.GetWorkflowExecutionHistory(ctx, &types.GetWorkflowExecutionHistoryRequest{
+			Domain:                 constants.BatcherLocalDomainName,
+			Execution:              wf.GetWorkflowExecutionInfo().GetExecution(),
+			HistoryEventFilterType: types.HistoryEventFilterTypeCloseEvent.Ptr(),
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, event := range resp.GetHistory().GetEvents() {
+			if attributes := event.WorkflowExecutionCompletedEventAttributes; attributes != nil {
+				data = attributes.Result
+			}
+			if attributes := event.WorkflowExecutionTerminatedEventAttributes; attributes != nil {
+				data = attributes.Details
+			}
+		}
+	}
+	if len(data) == 0 {
+		return closeStatus, nil, nil
+	}
+	progress := &batcher.HeartBeatDetails{}
+	if err := json.Unmarshal(data, progress); err != nil {
+		return nil, nil, err
+	}
+	return closeStatus, progress, nil
+}
+
 func copyRetryPolicyFromWorkflow() *types.RetryPolicy {
 	return &types.RetryPolicy{
 		InitialIntervalInSeconds:    int32(batcher.BatchActivityRetryPolicy.InitialInterval.Seconds()),
@@ -21,14 +21,18 @@
 package cli
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"flag"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/urfave/cli/v2"
 	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc"
 
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
@@ -66,6 +70,43 @@ func TestStartBatchJob(t *testing.T) {
 			expectedError:  "",
 			expectedOutput: "batch job is started",
 		},
+		{
+			name: "Valid Update Search Attributes Batch Job",
+			setup: func(mockClient *frontend.MockClient) {
+				mockClient.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.CountWorkflowExecutionsResponse{
+					Count: 100,
+				}, nil)
+				mockClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, request *types.StartWorkflowExecutionRequest, _ ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
+						var params batcher.BatchParams
+						assert.NoError(t, json.Unmarshal(request.Input, &params))
+						assert.Equal(t, map[string][]byte{"CustomKeywordField": []byte(`"value"`)}, params.UpdateSearchAttributesParams.SearchAttributes)
+						return &types.StartWorkflowExecutionResponse{RunID: "run-id-example"}, nil
+					})
+			},
+			flags: map[string]interface{}{
+				FlagDomain:              "test-domain",
+				FlagListQuery:           "workflowType='batch'",
+				FlagReason:              "Testing batch job",
+				FlagBatchType:           batcher.BatchTypeUpdateSearchAttributes,
+				FlagSearchAttributesKey: "CustomKeywordField",
+				FlagSearchAttributesVal: "value",
+				FlagYes:                 true,
+			},
+			expectedError:  "",
+			expectedOutput: "batch job is started",
+		},
+		{
+			name:  "Missing Search Attributes",
+			setup: func(mockClient *frontend.MockClient) {},
+			flags: map[string]interface{}{
+				FlagDomain:    "test-domain",
+				FlagListQuery: "workflowType='batch'",
+				FlagReason:    "Testing batch job",
+				FlagBatchType: batcher.BatchTypeUpdateSearchAttributes,
+			},
+			expectedError: "Required flag not found: : option search_attr_key is required",
+		},
 		{
 			name:  "Missing Domain",
 			setup: func(mockClient *frontend.MockClient) {},
@@ -219,7 +260,19 @@ func TestTerminateBatchJob(t *testing.T) {
 		{
 			name: "Valid Termination",
 			setup: func(mockClient *frontend.MockClient) {
-				mockClient.EXPECT().TerminateWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil)
+				mockClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.DescribeWorkflowExecutionResponse{
+					WorkflowExecutionInfo: &types.WorkflowExecutionInfo{},
+					PendingActivities:     []*types.PendingActivityInfo{{HeartbeatDetails: []byte(`{"CurrentPage":2,"SuccessCount":10}`)}},
+				}, nil)
+				mockClient.EXPECT().TerminateWorkflowExecution(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, request *types.TerminateWorkflowExecutionRequest, _ ...yarpc.CallOption) error {
+						// the progress is kept for resuming the job
+						var progress batcher.HeartBeatDetails
+						assert.NoError(t, json.Unmarshal(request.Details, &progress))
+						assert.Equal(t, 2, progress.CurrentPage)
+						assert.Equal(t, 10, progress.SuccessCount)
+						return nil
+					})
 			},
 			flags: map[string]interface{}{
 				FlagJobID:  "example-workflow-1",
@@ -247,6 +300,7 @@ func TestTerminateBatchJob(t *testing.T) {
 		{
 			name: "Terminate Failure",
 			setup: func(mockClient *frontend.MockClient) {
+				mockClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, errors.New("describe error"))
 				mockClient.EXPECT().TerminateWorkflowExecution(gomock.Any(), gomock.Any()).Return(errors.New("termination error"))
 			},
 			flags: map[string]interface{}{
@@ -554,3 +608,121 @@ func TestValidateBatchType(t *testing.T) {
 		})
 	}
 }
+
+func TestResumeBatchJob(t *testing.T) {
+	params := batcher.BatchParams{DomainName: "test-domain", Query: "workflowType='batch'", Reason: "Testing batch job", BatchType: batcher.BatchTypeSignal}
+	paramsInput, err := json.Marshal(params)
+	assert.NoError(t, err)
+	terminated := func(mockClient *frontend.MockClient, details []byte) {
+		mockClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.DescribeWorkflowExecutionResponse{
+			WorkflowExecutionInfo: &types.WorkflowExecutionInfo{
+				Execution:   &types.WorkflowExecution{WorkflowID: "job-id", RunID: "run-id"},
+				CloseStatus: types.WorkflowExecutionCloseStatusTerminated.Ptr(),
+			},
+		}, nil)
+		mockClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(&types.GetWorkflowExecutionHistoryResponse{
+			History: &types.History{Events: []*types.HistoryEvent{{
+				WorkflowExecutionTerminatedEventAttributes: &types.WorkflowExecutionTerminatedEventAttributes{Details: details},
+			}}},
+		}, nil)
+	}
+	tests := []struct {
+		name          string
+		setup         func(*frontend.MockClient)
+		expectedError string
+	}{
+		{
+			name: "Valid Resume",
+			setup: func(mockClient *frontend.MockClient) {
+				terminated(mockClient, []byte(`{"PageToken":"dG9rZW4=","CurrentPage":2,"SuccessCount":10}`))
+				mockClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(&types.GetWorkflowExecutionHistoryResponse{
+					History: &types.History{Events: []*types.HistoryEvent{{
+						WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{Input: paramsInput},
+					}}},
+				}, nil)
+				mockClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, request *types.StartWorkflowExecutionRequest, _ ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
+						var resumed batcher.BatchParams
+						assert.NoError(t, json.Unmarshal(request.Input, &resumed))
+						assert.Equal(t, params.Query, resumed.Query)
+						assert.Equal(t, &batcher.HeartBeatDetails{PageToken: []byte("token"), CurrentPage: 2, SuccessCount: 10}, resumed.Checkpoint)
+						return &types.StartWorkflowExecutionResponse{RunID: "run-id-example"}, nil
+					})
+			},
+		},
+		{
+			name: "Running",
+			setup: func(mockClient *frontend.MockClient) {
+				mockClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.DescribeWorkflowExecutionResponse{
+					WorkflowExecutionInfo: &types.WorkflowExecutionInfo{},
+				}, nil)
+			},
+			expectedError: "batch job is still running",
+		},
+		{
+			name: "No Progress",
+			setup: func(mockClient *frontend.MockClient) {
+				terminated(mockClient, nil)
+			},
+			expectedError: "batch job has no recorded progress to resume from",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockClient := frontend.NewMockClient(mockCtrl)
+			ioHandler := &testIOHandler{}
+			app := NewCliApp(&clientFactoryMock{
+				serverFrontendClient: mockClient,
+			}, WithIOHandler(ioHandler))
+
+			set := flag.NewFlagSet("test", 0)
+			_ = set.String(FlagJobID, "job-id", "")
+			_ = set.Bool(FlagYes, true, "")
+			c := cli.NewContext(app, set, nil)
+			tt.setup(mockClient)
+
+			err := ResumeBatchJob(c)
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Contains(t, ioHandler.outputBytes.String(), "batch job is started")
+			}
+		})
+	}
+}
+
+func TestReportBatchJob(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockClient := frontend.NewMockClient(mockCtrl)
+	ioHandler := &testIOHandler{}
+	app := NewCliApp(&clientFactoryMock{
+		serverFrontendClient: mockClient,
+	}, WithIOHandler(ioHandler))
+	mockClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &types.WorkflowExecutionInfo{
+			Execution:   &types.WorkflowExecution{WorkflowID: "job-id", RunID: "run-id"},
+			CloseStatus: types.WorkflowExecutionCloseStatusCompleted.Ptr(),
+		},
+	}, nil)
+	mockClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(&types.GetWorkflowExecutionHistoryResponse{
+		History: &types.History{Events: []*types.HistoryEvent{{
+			WorkflowExecutionCompletedEventAttributes: &types.WorkflowExecutionCompletedEventAttributes{
+				Result: []byte(`{"SuccessCount":9,"ErrorCount":1,"Failures":[{"WorkflowID":"wid","RunID":"rid","Error":"failed"}]}`),
+			},
+		}}},
+	}, nil)
+
+	outputFile := filepath.Join(t.TempDir(), "report.json")
+	set := flag.NewFlagSet("test", 0)
+	_ = set.String(FlagJobID, "job-id", "")
+	_ = set.String(FlagOutputFilename, outputFile, "")
+	c := cli.NewContext(app, set, nil)
+
+	assert.NoError(t, ReportBatchJob(c))
+	data, err := os.ReadFile(outputFile)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"successCount":9,"errorCount":1,"failures":[{"WorkflowID":"wid","RunID":"rid","Error":"failed"}]}`, string(data))
+}
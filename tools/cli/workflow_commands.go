@@ -31,6 +31,7 @@ import (
 	"math/rand"
 	"os"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -40,11 +41,20 @@ import (
 	"github.com/opentracing/opentracing-go"
 	"github.com/pborman/uuid"
 	"github.com/urfave/cli/v2"
+	"go.uber.org/yarpc"
 
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/activitycontrol"
 	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/completioncallback"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/delayedsignal"
+	"github.com/uber/cadence/common/reapply"
+	"github.com/uber/cadence/common/searchattrupdate"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/workflowpause"
+	"github.com/uber/cadence/common/workflowupdate"
 	"github.com/uber/cadence/service/history/execution"
 	"github.com/uber/cadence/tools/common/commoncli"
 )
@@ -485,6 +495,17 @@ func constructStartWorkflowRequest(c *cli.Context) (*types.StartWorkflowExecutio
 	if err != nil {
 		return nil, commoncli.Problem("error processing memo: ", err)
 	}
+	if c.IsSet(FlagCompletionCallbacks) {
+		var callbacks []completioncallback.Callback
+		if err := json.Unmarshal([]byte(c.String(FlagCompletionCallbacks)), &callbacks); err != nil {
+			return nil, commoncli.Problem("error parsing completion callbacks: ", err)
+		}
+		value, err := completioncallback.Encode(callbacks)
+		if err != nil {
+			return nil, commoncli.Problem("invalid completion callbacks: ", err)
+		}
+		memoFields[completioncallback.MemoKey] = value
+	}
 	if len(memoFields) != 0 {
 		startRequest.Memo = &types.Memo{Fields: memoFields}
 	}
@@ -701,6 +722,62 @@ func TerminateWorkflow(c *cli.Context) error {
 	return nil
 }
 
+// PauseWorkflow pauses a workflow execution
+func PauseWorkflow(c *cli.Context) error {
+	return signalPause(c, workflowpause.PauseSignalName, "Pause")
+}
+
+// UnpauseWorkflow resumes a paused workflow execution
+func UnpauseWorkflow(c *cli.Context) error {
+	return signalPause(c, workflowpause.UnpauseSignalName, "Unpause")
+}
+
+func signalPause(c *cli.Context, signalName string, operation string) error {
+	serviceClient, err := getDeps(c).ServerFrontendClient(c)
+	if err != nil {
+		return err
+	}
+
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	rid := c.String(FlagRunID)
+	input, err := workflowpause.EncodeRequest(c.String(FlagReason))
+	if err != nil {
+		return commoncli.Problem("Failed to encode reason: ", err)
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error creating context: ", err)
+	}
+	err = serviceClient.SignalWorkflowExecution(
+		ctx,
+		&types.SignalWorkflowExecutionRequest{
+			Domain: domain,
+			WorkflowExecution: &types.WorkflowExecution{
+				WorkflowID: wid,
+				RunID:      rid,
+			},
+			SignalName: signalName,
+			Input:      input,
+			Identity:   getCliIdentity(),
+			RequestID:  uuid.New(),
+		},
+	)
+	if err != nil {
+		return commoncli.Problem(operation+" workflow failed.", err)
+	}
+	fmt.Printf("%s workflow succeeded.\n", operation)
+	return nil
+}
+
 // CancelWorkflow cancels a workflow execution
 func CancelWorkflow(c *cli.Context) error {
 	wfClient, err := getWorkflowClient(c)
@@ -768,6 +845,24 @@ func SignalWorkflow(c *cli.Context) error {
 	if err != nil {
 		return commoncli.Problem("Error proccessing JSON input: ", err)
 	}
+	signalName, signalInput := name, []byte(input)
+	var deliverAfter time.Time
+	if c.IsSet(FlagDeliverAfter) {
+		deliverAfter, err = parseDeliverAfter(c.String(FlagDeliverAfter), time.Now())
+		if err != nil {
+			return commoncli.Problem("Invalid delivery time: ", err)
+		}
+		// delayed signals are sent as a request to the reserved delayed signal, which history delivers when due
+		signalInput, err = delayedsignal.EncodeRequest(&delayedsignal.Request{
+			SignalName:       name,
+			Input:            signalInput,
+			DeliverAfterNano: deliverAfter.UnixNano(),
+		})
+		if err != nil {
+			return commoncli.Problem("Failed to encode delayed signal: ", err)
+		}
+		signalName = delayedsignal.SignalName
+	}
 	tcCtx, cancel, err := newContext(c)
 	defer cancel()
 	if err != nil {
@@ -781,8 +876,8 @@ func SignalWorkflow(c *cli.Context) error {
 				WorkflowID: wid,
 				RunID:      rid,
 			},
-			SignalName: name,
-			Input:      []byte(input),
+			SignalName: signalName,
+			Input:      signalInput,
 			Identity:   getCliIdentity(),
 			RequestID:  uuid.New(),
 		},
@@ -791,10 +886,175 @@ func SignalWorkflow(c *cli.Context) error {
 	if err != nil {
 		return commoncli.Problem("Signal workflow failed.", err)
 	}
+	if !deliverAfter.IsZero() {
+		fmt.Printf("Signal workflow succeeded, the signal will be delivered at %s.\n", deliverAfter.UTC().Format(time.RFC3339))
+		return nil
+	}
 	fmt.Println("Signal workflow succeeded.")
 	return nil
 }
 
+// parseDeliverAfter parses the delivery time of a delayed signal, given as a timestamp or as a duration from now
+func parseDeliverAfter(value string, now time.Time) (time.Time, error) {
+	if parsedTime, err := time.Parse(defaultDateTimeFormat, value); err == nil {
+		return parsedTime, nil
+	}
+	if nanos, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(0, nanos), nil
+	}
+	delay, err := time.ParseDuration(value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("cannot parse '%s', use UTC format '2006-01-02T15:04:05Z', raw UnixNano or a duration like '90s'", value)
+	}
+	if delay <= 0 {
+		return time.Time{}, fmt.Errorf("delay must be positive: %s", value)
+	}
+	return now.Add(delay), nil
+}
+
+// CancelDelayedSignal cancels a delayed signal before it is delivered
+func CancelDelayedSignal(c *cli.Context) error {
+	serviceClient, err := getDeps(c).ServerFrontendClient(c)
+	if err != nil {
+		return err
+	}
+
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	rid := c.String(FlagRunID)
+	id, err := getRequiredInt64Option(c, FlagDelayedSignalID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	input, err := delayedsignal.EncodeRequest(&delayedsignal.Request{ID: id})
+	if err != nil {
+		return commoncli.Problem("Failed to encode delayed signal ID: ", err)
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error creating context: ", err)
+	}
+	err = serviceClient.SignalWorkflowExecution(
+		ctx,
+		&types.SignalWorkflowExecutionRequest{
+			Domain: domain,
+			WorkflowExecution: &types.WorkflowExecution{
+				WorkflowID: wid,
+				RunID:      rid,
+			},
+			SignalName: delayedsignal.CancelSignalName,
+			Input:      input,
+			Identity:   getCliIdentity(),
+			RequestID:  uuid.New(),
+		},
+	)
+	if err != nil {
+		return commoncli.Problem("Cancel delayed signal failed.", err)
+	}
+	fmt.Println("Cancel delayed signal succeeded.")
+	return nil
+}
+
+// UpdateWorkflow updates a workflow execution and waits for the result of the update
+func UpdateWorkflow(c *cli.Context) error {
+	serviceClient, err := getDeps(c).ServerFrontendClient(c)
+	if err != nil {
+		return err
+	}
+
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	rid := c.String(FlagRunID)
+	name, err := getRequiredOption(c, FlagName)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	updateID := c.String(FlagUpdateID)
+	if updateID == "" {
+		updateID = uuid.New()
+	}
+	input, err := processJSONInput(c)
+	if err != nil {
+		return commoncli.Problem("Error proccessing JSON input: ", err)
+	}
+	tcCtx, cancel, err := newContextForLongPoll(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error creating context: ", err)
+	}
+	// the update ID is used as the request ID of the signal, so that history ignores the signal of a retried update
+	err = serviceClient.SignalWorkflowExecution(
+		tcCtx,
+		&types.SignalWorkflowExecutionRequest{
+			Domain: domain,
+			WorkflowExecution: &types.WorkflowExecution{
+				WorkflowID: wid,
+				RunID:      rid,
+			},
+			SignalName: workflowupdate.SignalName(name),
+			Input:      []byte(input),
+			Identity:   getCliIdentity(),
+			RequestID:  updateID,
+		},
+	)
+	if err != nil {
+		return commoncli.Problem("Update workflow failed.", err)
+	}
+	fmt.Printf("Update %v requested, waiting for its result.\n", updateID)
+
+	// history answers the result query from the updates it tracks and holds each query until the update is answered
+	// or the long poll expires, so keep asking until the update is completed or rejected
+	var outcome workflowupdate.Outcome
+	for !outcome.IsFinal() {
+		resp, err := serviceClient.QueryWorkflow(tcCtx, &types.QueryWorkflowRequest{
+			Domain: domain,
+			Execution: &types.WorkflowExecution{
+				WorkflowID: wid,
+				RunID:      rid,
+			},
+			Query: &types.WorkflowQuery{
+				QueryType: workflowupdate.ResultQueryType,
+				QueryArgs: []byte(updateID),
+			},
+		})
+		var alreadyCompleted *types.WorkflowExecutionAlreadyCompletedError
+		if errors.As(err, &alreadyCompleted) {
+			break
+		}
+		if err != nil {
+			return commoncli.Problem(fmt.Sprintf("Failed to wait for the result of update %v, last stage: %v.", updateID, outcome.Stage), err)
+		}
+		if outcome, err = workflowupdate.DecodeOutcome(resp.GetQueryResult()); err != nil {
+			return commoncli.Problem(fmt.Sprintf("Failed to decode the result of update %v.", updateID), err)
+		}
+	}
+
+	switch outcome.Stage {
+	case workflowupdate.StageCompleted:
+		fmt.Println("Update workflow succeeded.")
+		fmt.Printf("Result: %v\n", string(outcome.Result))
+		return nil
+	case workflowupdate.StageRejected:
+		return commoncli.Problem(fmt.Sprintf("Update %v was rejected: %v", updateID, string(outcome.Result)), nil)
+	default:
+		return commoncli.Problem(fmt.Sprintf("Workflow closed before update %v completed, last stage: %v.", updateID, outcome.Stage), nil)
+	}
+}
+
 // SignalWithStartWorkflowExecution starts a workflow execution if not already exists and signals it
 func SignalWithStartWorkflowExecution(c *cli.Context) error {
 	serviceClient, err := getDeps(c).ServerFrontendClient(c)
@@ -1144,6 +1404,7 @@ type describeWorkflowExecutionResponse struct {
 	PendingActivities      []*pendingActivityInfo
 	PendingChildren        []*types.PendingChildExecutionInfo
 	PendingDecision        *pendingDecisionInfo
+	PendingDelayedSignals  []*pendingDelayedSignalInfo `json:",omitempty"`
 }
 
 // workflowExecutionInfo has same fields as types.WorkflowExecutionInfo, but has datetime instead of raw time
@@ -1161,6 +1422,8 @@ type workflowExecutionInfo struct {
 	AutoResetPoints   *types.ResetPoints
 	PartitionConfig   map[string]string
 	CronOverlapPolicy *types.CronOverlapPolicy
+	ClusterAttribute  *types.ClusterAttribute `json:",omitempty"`
+	ActiveCluster     string                  `json:",omitempty"` // resolved from ClusterAttribute
 }
 
 // pendingActivityInfo has same fields as types.PendingActivityInfo, but different field type for better display
@@ -1181,6 +1444,16 @@ type pendingActivityInfo struct {
 	ScheduleID             int64   `json:",omitempty"`
 }
 
+// pendingDelayedSignalInfo describes a delayed signal kept in the workflow memo, with datetime instead of raw time
+type pendingDelayedSignalInfo struct {
+	ID            int64
+	SignalName    string
+	Input         *string `json:",omitempty"` // change from []byte
+	Identity      string  `json:",omitempty"`
+	DeliverAfter  string  // change from int64
+	ScheduledTime string  // change from int64
+}
+
 type pendingDecisionInfo struct {
 	State                      *types.PendingDecisionState
 	OriginalScheduledTimestamp *string `json:",omitempty"` // change from *int64
@@ -1213,6 +1486,13 @@ func convertDescribeWorkflowExecutionResponse(resp *types.DescribeWorkflowExecut
 		PartitionConfig:   info.PartitionConfig,
 		CronOverlapPolicy: info.CronOverlapPolicy,
 	}
+	if clusterAttribute := info.ActiveClusterSelectionPolicy.GetClusterAttribute(); clusterAttribute != nil {
+		executionInfo.ClusterAttribute = clusterAttribute
+		executionInfo.ActiveCluster, err = getActiveClusterByClusterAttribute(clusterAttribute, wfClient, c)
+		if err != nil {
+			return nil, fmt.Errorf("error resolving active cluster: %w", err)
+		}
+	}
 
 	var pendingActs []*pendingActivityInfo
 	var tmpAct *pendingActivityInfo
@@ -1261,12 +1541,31 @@ func convertDescribeWorkflowExecutionResponse(resp *types.DescribeWorkflowExecut
 		// }
 	}
 
+	var pendingDelayedSignals []*pendingDelayedSignalInfo
+	for id, signal := range delayedsignal.GetPending(info.Memo.GetFields()) {
+		delayedSignal := &pendingDelayedSignalInfo{
+			ID:            id,
+			SignalName:    signal.SignalName,
+			Identity:      signal.Identity,
+			DeliverAfter:  timestampToString(signal.DeliverAfterNano, false),
+			ScheduledTime: timestampToString(signal.ScheduledTimeNano, false),
+		}
+		if signal.Input != nil {
+			delayedSignal.Input = common.StringPtr(string(signal.Input))
+		}
+		pendingDelayedSignals = append(pendingDelayedSignals, delayedSignal)
+	}
+	sort.Slice(pendingDelayedSignals, func(i, j int) bool {
+		return pendingDelayedSignals[i].ID < pendingDelayedSignals[j].ID
+	})
+
 	return &describeWorkflowExecutionResponse{
 		ExecutionConfiguration: resp.ExecutionConfiguration,
 		WorkflowExecutionInfo:  executionInfo,
 		PendingActivities:      pendingActs,
 		PendingChildren:        resp.PendingChildren,
 		PendingDecision:        pendingDecision,
+		PendingDelayedSignals:  pendingDelayedSignals,
 	}, nil
 }
 
@@ -1302,6 +1601,29 @@ func convertSearchAttributesToMapOfInterface(searchAttributes *types.SearchAttri
 	return result, nil
 }
 
+func getActiveClusterByClusterAttribute(clusterAttribute *types.ClusterAttribute,
+	wfClient frontend.Client, c *cli.Context) (string, error) {
+
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return "", commoncli.Problem("Required flag not found: ", err)
+	}
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return "", commoncli.Problem("Error creating context: ", err)
+	}
+	resp, err := wfClient.DescribeDomain(ctx, &types.DescribeDomainRequest{Name: &domain})
+	if err != nil {
+		return "", commoncli.Problem("Error when describe domain", err)
+	}
+	activeClusterInfo, err := resp.ReplicationConfiguration.GetActiveClusters().GetActiveClusterByClusterAttribute(clusterAttribute.Scope, clusterAttribute.Name)
+	if err != nil {
+		return "", commoncli.Problem("Error when resolve cluster attribute", err)
+	}
+	return activeClusterInfo.ActiveClusterName, nil
+}
+
 func getAllWorkflowIDsByQuery(c *cli.Context, query string) (map[string]bool, error) {
 	wfClient, err := getWorkflowClient(c)
 	if err != nil {
@@ -1841,6 +2163,10 @@ func ResetWorkflow(c *cli.Context) error {
 	if ok && len(extraForResetType) > 0 {
 		getRequiredOption(c, extraForResetType)
 	}
+	reapplyPolicy, err := getResetReapplyPolicy(c)
+	if err != nil {
+		return commoncli.Problem("Invalid reapply policy", err)
+	}
 
 	ctx, cancel, err := newContext(c)
 	defer cancel()
@@ -1868,6 +2194,23 @@ func ResetWorkflow(c *cli.Context) error {
 			return commoncli.Problem("getResetEventIDByType failed", err)
 		}
 	}
+	if c.Bool(FlagPreview) {
+		preview, err := previewReset(ctx, frontendClient, domain, wid, resetBaseRunID, decisionFinishID, reapplyPolicy, c.Bool(FlagSkipSignalReapply))
+		if err != nil {
+			return commoncli.Problem("Reset preview failed", err)
+		}
+		prettyPrintJSONObject(getDeps(c).Output(), preview)
+		return nil
+	}
+
+	var opts []yarpc.CallOption
+	if reapplyPolicy != nil {
+		value, err := reapply.Encode(reapplyPolicy)
+		if err != nil {
+			return commoncli.Problem("Failed to encode reapply policy", err)
+		}
+		opts = append(opts, yarpc.WithHeader(common.ResetReapplyPolicyHeaderName, value))
+	}
 	resp, err := frontendClient.ResetWorkflowExecution(ctx, &types.ResetWorkflowExecutionRequest{
 		Domain: domain,
 		WorkflowExecution: &types.WorkflowExecution{
@@ -1878,7 +2221,7 @@ func ResetWorkflow(c *cli.Context) error {
 		DecisionFinishEventID: decisionFinishID,
 		RequestID:             uuid.New(),
 		SkipSignalReapply:     c.Bool(FlagSkipSignalReapply),
-	})
+	}, opts...)
 	if err != nil {
 		return commoncli.Problem("reset failed", err)
 	}
@@ -1886,6 +2229,121 @@ func ResetWorkflow(c *cli.Context) error {
 	return nil
 }
 
+// getResetReapplyPolicy returns the reapply policy given with the reset flags, or nil for the default policy
+func getResetReapplyPolicy(c *cli.Context) (*reapply.Policy, error) {
+	if !c.IsSet(FlagReapplyEventTypes) && !c.IsSet(FlagReapplySignalNames) && !c.IsSet(FlagExcludeSignalNames) {
+		return nil, nil
+	}
+	if c.Bool(FlagSkipSignalReapply) {
+		return nil, fmt.Errorf("--%s cannot be used with a reapply policy", FlagSkipSignalReapply)
+	}
+	policy := &reapply.Policy{
+		SignalNames:         c.StringSlice(FlagReapplySignalNames),
+		ExcludedSignalNames: c.StringSlice(FlagExcludeSignalNames),
+	}
+	eventTypes := c.StringSlice(FlagReapplyEventTypes)
+	if len(eventTypes) == 0 {
+		policy.EventTypes = reapply.DefaultPolicy().EventTypes
+	}
+	for _, eventType := range eventTypes {
+		reapplied, ok := reapplyEventTypesMap[eventType]
+		if !ok {
+			return nil, fmt.Errorf("unknown event type %v, support: Signal, UpsertSearchAttributes, CancelRequest", eventType)
+		}
+		policy.EventTypes = append(policy.EventTypes, reapplied)
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// resetPreview describes the events a reset would add to the reset run after the reset point
+type resetPreview struct {
+	BaseRunID string
+	// ResetEventID is the first event of the base run which is not kept by the reset run
+	ResetEventID    int64
+	ReappliedEvents []*resetPreviewEvent
+}
+
+// resetPreviewEvent is an event a reset would reapply, with the event it comes from
+type resetPreviewEvent struct {
+	EventType     types.EventType
+	SignalName    string `json:",omitempty"`
+	SourceRunID   string
+	SourceEventID int64
+}
+
+// previewReset returns the events reapplied by a reset, the same way history selects them: events of the base run
+// from the reset point, then events of the runs it continued as new to, until the current run
+func previewReset(
+	ctx context.Context,
+	frontendClient frontend.Client,
+	domain string,
+	workflowID string,
+	baseRunID string,
+	decisionFinishID int64,
+	reapplyPolicy *reapply.Policy,
+	skipSignalReapply bool,
+) (*resetPreview, error) {
+	preview := &resetPreview{
+		BaseRunID:       baseRunID,
+		ResetEventID:    decisionFinishID,
+		ReappliedEvents: []*resetPreviewEvent{},
+	}
+	if skipSignalReapply {
+		return preview, nil
+	}
+
+	cancelRequested := false
+	runID := baseRunID
+	firstEventID := decisionFinishID
+	for runID != "" {
+		history, err := GetHistory(ctx, frontendClient, domain, workflowID, runID, nil)
+		if err != nil {
+			return nil, err
+		}
+		nextRunID := ""
+		for _, event := range history.GetEvents() {
+			if event.ID < firstEventID {
+				// a workflow can only be requested to cancel once
+				if event.GetEventType() == types.EventTypeWorkflowExecutionCancelRequested {
+					cancelRequested = true
+				}
+				continue
+			}
+			if event.GetEventType() == types.EventTypeWorkflowExecutionContinuedAsNew {
+				nextRunID = event.GetWorkflowExecutionContinuedAsNewEventAttributes().GetNewExecutionRunID()
+			}
+			if !reapplyPolicy.ShouldReapply(event) {
+				continue
+			}
+			reapplied := &resetPreviewEvent{
+				EventType:     event.GetEventType(),
+				SourceRunID:   runID,
+				SourceEventID: event.ID,
+			}
+			switch event.GetEventType() {
+			case types.EventTypeWorkflowExecutionSignaled:
+				reapplied.SignalName = event.GetWorkflowExecutionSignaledEventAttributes().GetSignalName()
+			case types.EventTypeUpsertWorkflowSearchAttributes:
+				// history reapplies upserts with a search attributes update signal
+				reapplied.EventType = types.EventTypeWorkflowExecutionSignaled
+				reapplied.SignalName = searchattrupdate.SignalName
+			case types.EventTypeWorkflowExecutionCancelRequested:
+				if cancelRequested {
+					continue
+				}
+				cancelRequested = true
+			}
+			preview.ReappliedEvents = append(preview.ReappliedEvents, reapplied)
+		}
+		runID = nextRunID
+		firstEventID = constants.FirstEventID
+	}
+	return preview, nil
+}
+
 func processResets(c *cli.Context, domain string, wes chan types.WorkflowExecution, done chan bool, wg *sync.WaitGroup, params batchResetParamsType) {
 	for {
 		select {
@@ -2547,6 +3005,100 @@ func CompleteActivity(c *cli.Context) error {
 	return nil
 }
 
+// PauseActivity pauses the retries of a pending activity
+func PauseActivity(c *cli.Context) error {
+	return signalActivityControl(c, activitycontrol.PauseSignalName, "Pause", &activitycontrol.Request{})
+}
+
+// UnpauseActivity resumes a paused activity
+func UnpauseActivity(c *cli.Context) error {
+	return signalActivityControl(c, activitycontrol.UnpauseSignalName, "Unpause", &activitycontrol.Request{})
+}
+
+// ResetActivity resets the attempt of a pending activity
+func ResetActivity(c *cli.Context) error {
+	return signalActivityControl(c, activitycontrol.ResetSignalName, "Reset", &activitycontrol.Request{})
+}
+
+// UpdateActivityOptions updates the retry policy and timeouts of a pending activity
+func UpdateActivityOptions(c *cli.Context) error {
+	request := &activitycontrol.Request{}
+	if c.IsSet(FlagRetryAttempts) || c.IsSet(FlagRetryExpiration) {
+		request.RetryPolicy = &types.RetryPolicy{
+			InitialIntervalInSeconds:    int32(c.Int(FlagRetryInterval)),
+			BackoffCoefficient:          c.Float64(FlagRetryBackoff),
+			MaximumAttempts:             int32(c.Int(FlagRetryAttempts)),
+			ExpirationIntervalInSeconds: int32(c.Int(FlagRetryExpiration)),
+			MaximumIntervalInSeconds:    int32(c.Int(FlagRetryMaxInterval)),
+		}
+	}
+	for flag, timeout := range map[string]**int32{
+		FlagScheduleToStartTimeout:   &request.ScheduleToStartTimeoutSeconds,
+		FlagScheduleToCloseTimeout:   &request.ScheduleToCloseTimeoutSeconds,
+		FlagStartToCloseTimeout:      &request.StartToCloseTimeoutSeconds,
+		FlagActivityHeartBeatTimeout: &request.HeartbeatTimeoutSeconds,
+	} {
+		if c.IsSet(flag) {
+			*timeout = common.Int32Ptr(int32(c.Int(flag)))
+		}
+	}
+	return signalActivityControl(c, activitycontrol.UpdateOptionsSignalName, "Update options of", request)
+}
+
+func signalActivityControl(c *cli.Context, signalName string, operation string, request *activitycontrol.Request) error {
+	serviceClient, err := getDeps(c).ServerFrontendClient(c)
+	if err != nil {
+		return err
+	}
+
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	rid := c.String(FlagRunID)
+	request.ActivityID, err = getRequiredOption(c, FlagActivityID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	request.Reason = c.String(FlagReason)
+	if err := request.Validate(signalName); err != nil {
+		return commoncli.Problem("Invalid activity request: ", replaceRetryPropertiesInErrorMessageWithRetryArguments(err))
+	}
+	input, err := activitycontrol.EncodeRequest(request)
+	if err != nil {
+		return commoncli.Problem("Failed to encode activity request: ", err)
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error creating context: ", err)
+	}
+	err = serviceClient.SignalWorkflowExecution(
+		ctx,
+		&types.SignalWorkflowExecutionRequest{
+			Domain: domain,
+			WorkflowExecution: &types.WorkflowExecution{
+				WorkflowID: wid,
+				RunID:      rid,
+			},
+			SignalName: signalName,
+			Input:      input,
+			Identity:   getCliIdentity(),
+			RequestID:  uuid.New(),
+		},
+	)
+	if err != nil {
+		return commoncli.Problem(operation+" activity failed.", err)
+	}
+	fmt.Printf("%s activity succeeded.\n", operation)
+	return nil
+}
+
 // FailActivity fails an activity
 func FailActivity(c *cli.Context) error {
 	domain, err := getRequiredOption(c, FlagDomain)
@@ -37,12 +37,16 @@ import (
 	"github.com/opentracing/opentracing-go/mocktracer"
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/urfave/cli/v2"
 	"go.uber.org/mock/gomock"
 
 	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/completioncallback"
+	"github.com/uber/cadence/common/reapply"
+	"github.com/uber/cadence/common/searchattrupdate"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/tools/cli/clitest"
 )
@@ -453,6 +457,53 @@ func Test_ConvertDescribeWorkflowExecutionResponse(t *testing.T) {
 	assert.Equal(t, "test-workflow-id", resp.WorkflowExecutionInfo.Execution.WorkflowID)
 }
 
+func Test_ConvertDescribeWorkflowExecutionResponse_ClusterAttribute(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	serverFrontendClient := frontend.NewMockClient(mockCtrl)
+	app := NewCliApp(&clientFactoryMock{
+		serverFrontendClient: serverFrontendClient,
+	})
+	clusterAttribute := &types.ClusterAttribute{Scope: "region", Name: "us-west"}
+	mockResp := &types.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &types.WorkflowExecutionInfo{
+			Execution: &types.WorkflowExecution{
+				WorkflowID: "test-workflow-id",
+				RunID:      "test-run-id",
+			},
+			ActiveClusterSelectionPolicy: &types.ActiveClusterSelectionPolicy{
+				ClusterAttribute: clusterAttribute,
+			},
+		},
+	}
+	serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), &types.DescribeDomainRequest{Name: common.StringPtr("test-domain")}).Return(&types.DescribeDomainResponse{
+		ReplicationConfiguration: &types.DomainReplicationConfiguration{
+			ActiveClusterName: "cluster0",
+			ActiveClusters: &types.ActiveClusters{
+				AttributeScopes: map[string]types.ClusterAttributeScope{
+					"region": {
+						ClusterAttributes: map[string]types.ActiveClusterInfo{
+							"us-west": {ActiveClusterName: "cluster1"},
+						},
+					},
+				},
+			},
+		},
+	}, nil).Times(1)
+
+	resp, err := convertDescribeWorkflowExecutionResponse(mockResp, serverFrontendClient, getMockContext(t, nil, app))
+	assert.NoError(t, err)
+	assert.Equal(t, clusterAttribute, resp.WorkflowExecutionInfo.ClusterAttribute)
+	assert.Equal(t, "cluster1", resp.WorkflowExecutionInfo.ActiveCluster)
+
+	// the cluster attribute is no longer configured for the domain
+	mockResp.WorkflowExecutionInfo.ActiveClusterSelectionPolicy.ClusterAttribute = &types.ClusterAttribute{Scope: "region", Name: "us-east"}
+	serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(&types.DescribeDomainResponse{
+		ReplicationConfiguration: &types.DomainReplicationConfiguration{ActiveClusterName: "cluster0"},
+	}, nil).Times(1)
+	_, err = convertDescribeWorkflowExecutionResponse(mockResp, serverFrontendClient, getMockContext(t, nil, app))
+	assert.Error(t, err)
+}
+
 func Test_PrintRunStatus(t *testing.T) {
 	// this method only prints results, no need to test the output
 	tests := []struct {
@@ -950,6 +1001,36 @@ func (s *cliAppSuite) TestResetWorkflow() {
 				s.serverFrontendClient.EXPECT().ResetWorkflowExecution(gomock.Any(), gomock.Any()).Return(resp, nil)
 			},
 		},
+		{
+			name:    "with reapply policy",
+			command: `cadence --do test-domain wf reset -w wid -r rid -reason test-reason --event_id 1 --reapply_event_types Signal --reapply_event_types CancelRequest --exclude_signal_names noisy`,
+			err:     "",
+			mock: func() {
+				resp := &types.ResetWorkflowExecutionResponse{RunID: uuid.New()}
+				// the policy is sent as a request header
+				s.serverFrontendClient.EXPECT().ResetWorkflowExecution(gomock.Any(), gomock.Any(), gomock.Any()).Return(resp, nil)
+			},
+		},
+		{
+			name:    "reapply policy with skip signal reapply",
+			command: `cadence --do test-domain wf reset -w wid -r rid -reason test-reason --event_id 1 --reapply_event_types Signal --skip_signal_reapply`,
+			err:     "cannot be used with a reapply policy",
+		},
+		{
+			name:    "unknown reapply event type",
+			command: `cadence --do test-domain wf reset -w wid -r rid -reason test-reason --event_id 1 --reapply_event_types Timer`,
+			err:     "unknown event type Timer",
+		},
+		{
+			name:    "preview",
+			command: `cadence --do test-domain wf reset -w wid -r rid -reason test-reason --event_id 1 --preview`,
+			err:     "",
+			mock: func() {
+				s.serverFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(&types.GetWorkflowExecutionHistoryResponse{
+					History: &types.History{},
+				}, nil)
+			},
+		},
 	}
 
 	for _, tt := range testCases {
@@ -2118,6 +2199,17 @@ func Test_ConstructStartWorkflowRequest(t *testing.T) {
 			expectedError:        true,
 			expectedErrorMessage: "error processing search attributes",
 		},
+		{
+			name: "invalid completion callbacks",
+			cliArguments: append(
+				baseArgs,
+				clitest.StringArgument(FlagExecutionTimeout, "10"),
+				clitest.IntArgument(FlagWorkflowIDReusePolicy, 1),
+				clitest.StringArgument(FlagCompletionCallbacks, `[{"http":{"url":"file:///etc/passwd"}}]`),
+			),
+			expectedError:        true,
+			expectedErrorMessage: "invalid completion callbacks",
+		},
 		{
 			name: "no error",
 			cliArguments: append(
@@ -2148,6 +2240,25 @@ func Test_ConstructStartWorkflowRequest(t *testing.T) {
 	}
 }
 
+func Test_ConstructStartWorkflowRequest_CompletionCallbacks(t *testing.T) {
+	app := NewCliApp(&clientFactoryMock{})
+	ctx := clitest.NewCLIContext(t, app,
+		clitest.StringArgument(FlagDomain, "test-domain"),
+		clitest.StringArgument(FlagTaskList, "test-tasklist"),
+		clitest.StringArgument(FlagWorkflowType, "test-workflow-type"),
+		clitest.StringArgument(FlagExecutionTimeout, "10"),
+		clitest.StringArgument(FlagCompletionCallbacks, `[{"signal":{"workflowID":"waiter","signalName":"done"}}]`),
+	)
+
+	request, err := constructStartWorkflowRequest(ctx)
+	require.NoError(t, err)
+	callbacks, err := completioncallback.GetCallbacks(request.Memo.GetFields())
+	require.NoError(t, err)
+	assert.Equal(t, []completioncallback.Callback{
+		{Signal: &completioncallback.SignalCallback{WorkflowID: "waiter", SignalName: "done"}},
+	}, callbacks)
+}
+
 func Test_StartWorkflowHelper_RetryErrorMapping(t *testing.T) {
 	requiredArguments := []clitest.CliArgument{
 		clitest.StringArgument(FlagDomain, "test-domain"),
@@ -2913,3 +3024,89 @@ func TestMapQueryRejectConditionFromFlag(t *testing.T) {
 		})
 	}
 }
+
+func Test_ParseDeliverAfter(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	deliverAfter, err := parseDeliverAfter("90s", now)
+	assert.NoError(t, err)
+	assert.Equal(t, now.Add(90*time.Second), deliverAfter)
+
+	deliverAfter, err = parseDeliverAfter("2024-01-03T00:00:00Z", now)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC), deliverAfter)
+
+	deliverAfter, err = parseDeliverAfter(fmt.Sprint(now.UnixNano()), now)
+	assert.NoError(t, err)
+	assert.Equal(t, now.UnixNano(), deliverAfter.UnixNano())
+
+	_, err = parseDeliverAfter("-1m", now)
+	assert.Error(t, err)
+
+	_, err = parseDeliverAfter("tomorrow", now)
+	assert.Error(t, err)
+}
+
+func Test_PreviewReset(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	serverFrontendClient := frontend.NewMockClient(ctrl)
+	signaled := func(id int64, signalName string) *types.HistoryEvent {
+		return &types.HistoryEvent{
+			ID:        id,
+			EventType: types.EventTypeWorkflowExecutionSignaled.Ptr(),
+			WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{
+				SignalName: signalName,
+			},
+		}
+	}
+	histories := map[string][]*types.HistoryEvent{
+		"base-run": {
+			{ID: 1, EventType: types.EventTypeWorkflowExecutionStarted.Ptr()},
+			signaled(2, "before-reset-point"),
+			{ID: 3, EventType: types.EventTypeDecisionTaskCompleted.Ptr()},
+			signaled(4, "a"),
+			{ID: 5, EventType: types.EventTypeUpsertWorkflowSearchAttributes.Ptr()},
+			{ID: 6, EventType: types.EventTypeWorkflowExecutionCancelRequested.Ptr()},
+			signaled(7, "noisy"),
+			{
+				ID:        8,
+				EventType: types.EventTypeWorkflowExecutionContinuedAsNew.Ptr(),
+				WorkflowExecutionContinuedAsNewEventAttributes: &types.WorkflowExecutionContinuedAsNewEventAttributes{
+					NewExecutionRunID: "next-run",
+				},
+			},
+		},
+		"next-run": {
+			{ID: 1, EventType: types.EventTypeWorkflowExecutionStarted.Ptr()},
+			{ID: 2, EventType: types.EventTypeWorkflowExecutionCancelRequested.Ptr()},
+			signaled(3, "b"),
+		},
+	}
+	serverFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.GetWorkflowExecutionHistoryRequest, _ ...interface{}) (*types.GetWorkflowExecutionHistoryResponse, error) {
+			return &types.GetWorkflowExecutionHistoryResponse{
+				History: &types.History{Events: histories[request.Execution.RunID]},
+			}, nil
+		}).Times(2)
+	policy := &reapply.Policy{
+		EventTypes:          reapply.ReapplicableEventTypes,
+		ExcludedSignalNames: []string{"noisy"},
+	}
+
+	preview, err := previewReset(context.Background(), serverFrontendClient, "domain", "wid", "base-run", 3, policy, false)
+	require.NoError(t, err)
+	assert.Equal(t, &resetPreview{
+		BaseRunID:    "base-run",
+		ResetEventID: 3,
+		ReappliedEvents: []*resetPreviewEvent{
+			{EventType: types.EventTypeWorkflowExecutionSignaled, SignalName: "a", SourceRunID: "base-run", SourceEventID: 4},
+			{EventType: types.EventTypeWorkflowExecutionSignaled, SignalName: searchattrupdate.SignalName, SourceRunID: "base-run", SourceEventID: 5},
+			{EventType: types.EventTypeWorkflowExecutionCancelRequested, SourceRunID: "base-run", SourceEventID: 6},
+			{EventType: types.EventTypeWorkflowExecutionSignaled, SignalName: "b", SourceRunID: "next-run", SourceEventID: 3},
+		},
+	}, preview)
+
+	preview, err = previewReset(context.Background(), serverFrontendClient, "domain", "wid", "base-run", 3, nil, true)
+	require.NoError(t, err)
+	assert.Empty(t, preview.ReappliedEvents)
+}